@@ -47,6 +47,12 @@ func buildWebsocketHandler(
 	c *app.RequestContext,
 ) func(conn *websocket.Conn) {
 	return func(conn *websocket.Conn) {
+		if !track(conn) {
+			writeError(conn, errShuttingDown)
+			return
+		}
+		defer untrack(conn)
+
 		token := string(c.GetHeader(constants.AuthHeader))
 		if token == "" {
 			writeTokenError(conn)
@@ -92,6 +98,7 @@ func buildWebsocketHandler(
 			turn += 1
 			ctx = context.WithValue(ctx, service.CtxKeyTurn, turn)
 		}
+		waitDialogsOnShutdown()
 		// although the service is like a non-stateful service, we still need to log out
 		// in this case, we need to log out to clean up the dialog
 		// in order to avoid the over-accumulation of memory
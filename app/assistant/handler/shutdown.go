@@ -0,0 +1,91 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handler
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/hertz-contrib/websocket"
+
+	"github.com/west2-online/DomTok/app/assistant/service"
+)
+
+var (
+	conns    = make(map[*websocket.Conn]struct{})
+	connsMu  sync.Mutex
+	closing  bool
+	handlers sync.WaitGroup
+
+	errShuttingDown = errors.New("server is shutting down, please reconnect later")
+)
+
+// track 记录新建立的连接, 服务正在退出时返回 false
+func track(conn *websocket.Conn) bool {
+	connsMu.Lock()
+	defer connsMu.Unlock()
+	if closing {
+		return false
+	}
+	conns[conn] = struct{}{}
+	handlers.Add(1)
+	return true
+}
+
+func untrack(conn *websocket.Conn) {
+	connsMu.Lock()
+	defer connsMu.Unlock()
+	delete(conns, conn)
+	handlers.Done()
+}
+
+func isClosing() bool {
+	connsMu.Lock()
+	defer connsMu.Unlock()
+	return closing
+}
+
+// Shutdown 注册为 Hertz 的 OnShutdown 回调, 停止读取新的消息并等待进行中的对话结束
+// 对话在读取下一条消息之前不会被打断, 超过 ctx 的时间后不再等待
+func Shutdown(ctx context.Context) {
+	connsMu.Lock()
+	closing = true
+	for conn := range conns {
+		// 让阻塞在读取上的 Accept 立即返回, 不影响正在发送的回复
+		_ = conn.SetReadDeadline(time.Now())
+	}
+	connsMu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		handlers.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+	}
+}
+
+// waitDialogsOnShutdown 在服务退出时等待当前轮次的回复发送完, 连接在 handler 返回后就会被关闭
+func waitDialogsOnShutdown() {
+	if isClosing() {
+		service.WaitDialogs()
+	}
+}
@@ -29,7 +29,16 @@ import (
 	"github.com/west2-online/DomTok/pkg/errno"
 )
 
-var busy = sync.Map{}
+var (
+	busy = sync.Map{}
+	// dialogs 记录进行中的对话, 服务退出时需要等待它们把回复发送完
+	dialogs sync.WaitGroup
+)
+
+// WaitDialogs 等待所有进行中的对话结束
+func WaitDialogs() {
+	dialogs.Wait()
+}
 
 // Accept accepts a websocket message.
 func (s Core) Accept(conn *websocket.Conn, ctx context.Context) (err error) {
@@ -54,7 +63,9 @@ func (s Core) Accept(conn *websocket.Conn, ctx context.Context) (err error) {
 	}
 	busy.Store(id, true)
 
+	dialogs.Add(1)
 	go func() {
+		defer dialogs.Done()
 		// set the message in the context
 		ctx := context.WithValue(ctx, CtxKeyInput, string(m))
 		switch t {
@@ -92,3 +92,17 @@ func (h *CartHandler) PurChaseCartGoods(ctx context.Context, req *cart.PurChaseC
 	r.OrderId = orderId
 	return
 }
+
+func (h *CartHandler) ExportUserData(ctx context.Context, req *cart.ExportUserDataRequest) (r *cart.ExportUserDataResponse, err error) {
+	r = new(cart.ExportUserDataResponse)
+	r.Data, err = h.useCase.ExportUserData(ctx, req.Uid)
+	r.Base = base.BuildBaseResp(err)
+	return r, nil
+}
+
+func (h *CartHandler) EraseUserData(ctx context.Context, req *cart.EraseUserDataRequest) (r *cart.EraseUserDataResponse, err error) {
+	r = new(cart.EraseUserDataResponse)
+	err = h.useCase.EraseUserData(ctx, req.Uid)
+	r.Base = base.BuildBaseResp(err)
+	return r, nil
+}
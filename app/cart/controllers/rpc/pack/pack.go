@@ -27,6 +27,7 @@ func BuildCartGoods(goods *model.CartGoods) *kmodel.CartGoods {
 	d, _ := goods.DiscountAmount.Float64()
 	return &kmodel.CartGoods{
 		MerchantId:       goods.MerchantID,
+		MerchantName:     goods.MerchantName,
 		GoodsId:          goods.GoodsID,
 		GoodsName:        goods.GoodsName,
 		SkuId:            goods.SkuID,
@@ -32,6 +32,7 @@ type GoodInfo struct {
 
 type CartGoods struct {
 	MerchantID       int64
+	MerchantName     string
 	GoodsID          int64
 	GoodsName        string
 	SkuID            int64
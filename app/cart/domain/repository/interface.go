@@ -28,12 +28,14 @@ type PersistencePort interface {
 	GetCartByUserId(ctx context.Context, uid int64) (bool, *model.Cart, error)
 	SaveCart(ctx context.Context, uid int64, cart string) error
 	DeleteCart(ctx context.Context, uid int64) error
+	EraseCart(ctx context.Context, uid int64) error
 }
 
 type CachePort interface {
 	SetCartCache(ctx context.Context, key string, cart string) error
 	GetCartCache(ctx context.Context, key string) (string, error)
 	IsKeyExist(ctx context.Context, key string) bool
+	DeleteCartCache(ctx context.Context, key string) error
 }
 
 type MqPort interface {
@@ -29,6 +29,8 @@ import (
 	kmodel "github.com/west2-online/DomTok/kitex_gen/model"
 	"github.com/west2-online/DomTok/kitex_gen/order"
 	"github.com/west2-online/DomTok/kitex_gen/order/orderservice"
+	"github.com/west2-online/DomTok/kitex_gen/user"
+	"github.com/west2-online/DomTok/kitex_gen/user/userservice"
 	"github.com/west2-online/DomTok/pkg/constants"
 	"github.com/west2-online/DomTok/pkg/errno"
 	"github.com/west2-online/DomTok/pkg/utils"
@@ -37,12 +39,14 @@ import (
 type CartRpcImpl struct {
 	commodity commodityservice.Client
 	order     orderservice.Client
+	user      userservice.Client
 }
 
-func NewCartRpcImpl(c commodityservice.Client, o orderservice.Client) repository.RpcPort {
+func NewCartRpcImpl(c commodityservice.Client, o orderservice.Client, u userservice.Client) repository.RpcPort {
 	return &CartRpcImpl{
 		commodity: c,
 		order:     o,
+		user:      u,
 	}
 }
 
@@ -66,7 +70,7 @@ func (rpc *CartRpcImpl) GetGoodsInfo(ctx context.Context, cartGoodsIds []*model.
 	cartGoods := lo.Map(skuInfoResp.SkuInfos, func(item *kmodel.SkuInfo, index int) *model.CartGoods {
 		purchaseCount := cartGoodsIds[index].PurchaseQuantity
 		return &model.CartGoods{
-			MerchantID:       item.CreatorID,
+			MerchantID:       item.ShopID,
 			GoodsID:          item.SpuID,
 			GoodsName:        item.Name,
 			SkuID:            item.SkuID,
@@ -78,9 +82,34 @@ func (rpc *CartRpcImpl) GetGoodsInfo(ctx context.Context, cartGoodsIds []*model.
 			DiscountAmount:   decimal.NewFromInt(int64(item.Price) * purchaseCount), // 暂时不去调rpc了，时间不是很够
 		}
 	})
+
+	// 购物车按店铺展示, 需要把店铺 ID 换成店铺名称
+	shopNames, err := rpc.getShopNames(ctx, lo.Map(cartGoods, func(item *model.CartGoods, index int) int64 {
+		return item.MerchantID
+	}))
+	if err != nil {
+		return nil, err
+	}
+	for _, goods := range cartGoods {
+		goods.MerchantName = shopNames[goods.MerchantID]
+	}
 	return cartGoods, nil
 }
 
+func (rpc *CartRpcImpl) getShopNames(ctx context.Context, shopIDs []int64) (map[int64]string, error) {
+	shopIDs = lo.Uniq(shopIDs)
+	if len(shopIDs) == 0 {
+		return map[int64]string{}, nil
+	}
+	resp, err := rpc.user.GetShops(ctx, &user.GetShopsReq{ShopIDs: shopIDs})
+	if err = utils.ProcessRpcError("user.GetShops", resp, err); err != nil {
+		return nil, errno.Errorf(errno.InternalRPCErrorCode, "call user.GetShops failed : %v", err)
+	}
+	return lo.SliceToMap(resp.Shops, func(item *kmodel.ShopInfo) (int64, string) {
+		return item.ShopID, item.Name
+	}), nil
+}
+
 func (rpc *CartRpcImpl) PurchaseCartGoods(ctx context.Context, cartGoods []*model.CartGoods) (int64, error) {
 	baseOrderGoods := lo.Map(cartGoods, func(item *model.CartGoods, index int) *kmodel.BaseOrderGoods {
 		return &kmodel.BaseOrderGoods{
@@ -27,8 +27,10 @@ import (
 	"github.com/west2-online/DomTok/kitex_gen/cart"
 	"github.com/west2-online/DomTok/pkg/base/client"
 	"github.com/west2-online/DomTok/pkg/constants"
+	"github.com/west2-online/DomTok/pkg/health"
 	"github.com/west2-online/DomTok/pkg/kafka"
 	"github.com/west2-online/DomTok/pkg/logger"
+	"github.com/west2-online/DomTok/pkg/shutdown"
 )
 
 // InjectCartHandler 注入外部调用
@@ -38,7 +40,8 @@ func InjectCartHandler() cart.CartService {
 	dbAdapter := db.NewDBAdapter(dbClient)
 	cacheClient, _ := client.NewRedisClient(constants.RedisDBCart)
 	cacheAdapter := cache.NewCacheAdapter(cacheClient)
-	kafkaAdapter := mq.NewKafkaAdapter(kafka.NewKafkaInstance())
+	kafkaIns := kafka.NewKafkaInstance()
+	kafkaAdapter := mq.NewKafkaAdapter(kafkaIns)
 	cClient, err := client.InitCommodityRPC()
 	if err != nil {
 		logger.Errorf("Failed to init commodity rpc client: %v", err)
@@ -47,8 +50,22 @@ func InjectCartHandler() cart.CartService {
 	if err != nil {
 		logger.Errorf("Failed to init order rpc client: %v", err)
 	}
-	rpcImpl := rpccli.NewCartRpcImpl(*cClient, *oClient)
+	uClient, err := client.InitUserRPC()
+	if err != nil {
+		logger.Errorf("Failed to init user rpc client: %v", err)
+	}
+	rpcImpl := rpccli.NewCartRpcImpl(*cClient, *oClient, *uClient)
 	svc := service.NewCartService(dbAdapter, cacheAdapter, kafkaAdapter, rpcImpl)
 	serviceAdapter := usecase.NewCartCase(dbAdapter, cacheAdapter, kafkaAdapter, rpcImpl, svc)
+	// 注册健康检查项, 由 health.Serve 对外暴露
+	health.Register(constants.HealthCheckMySQL, health.MySQL(dbClient))
+	health.Register(constants.HealthCheckRedis, health.Redis(cacheClient))
+	health.Register(constants.HealthCheckKafka, client.PingKafka)
+
+	// 退出时逆序执行: 先停止消费并刷新 kafka writer, 再关闭连接池
+	shutdown.Register("mysql", func() error { return client.CloseMySQL(dbClient) })
+	shutdown.Register("redis", cacheClient.Close)
+	shutdown.Register("kafka", func() error { kafkaIns.Close(); return nil })
+
 	return rpc.NewCartHandler(serviceAdapter)
 }
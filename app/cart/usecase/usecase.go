@@ -29,6 +29,8 @@ type CartCasePort interface {
 	ShowCartGoods(ctx context.Context, pageNum int64) ([]*model.CartGoods, error)
 	DeleteCartGoods(ctx context.Context) error
 	PurChaseCartGoods(ctx context.Context, goodsList []*model.CartGoods) (int64, error)
+	ExportUserData(ctx context.Context, uid int64) (string, error)
+	EraseUserData(ctx context.Context, uid int64) error
 }
 
 type UseCase struct {
@@ -0,0 +1,46 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// ExportUserData 导出用户的购物车, 购物车本身就是以 JSON 存储的, 直接返回即可
+func (u *UseCase) ExportUserData(ctx context.Context, uid int64) (string, error) {
+	exist, cart, err := u.DB.GetCartByUserId(ctx, uid)
+	if err != nil {
+		return "", fmt.Errorf("ExportUserData get cart by user id error: %w", err)
+	}
+	if !exist {
+		return "{}", nil
+	}
+	return cart.SkuJson, nil
+}
+
+// EraseUserData 注销账号时清除用户的购物车和对应的缓存
+func (u *UseCase) EraseUserData(ctx context.Context, uid int64) error {
+	if err := u.DB.EraseCart(ctx, uid); err != nil {
+		return fmt.Errorf("EraseUserData erase cart error: %w", err)
+	}
+	if err := u.Cache.DeleteCartCache(ctx, strconv.FormatInt(uid, 10)); err != nil {
+		return fmt.Errorf("EraseUserData delete cart cache error: %w", err)
+	}
+	return nil
+}
@@ -194,6 +194,20 @@ func (c CommodityHandler) ViewUserAllCoupon(ctx context.Context, req *commodity.
 	return r, nil
 }
 
+func (c CommodityHandler) ExportUserCoupon(ctx context.Context, req *commodity.ExportUserCouponReq) (r *commodity.ExportUserCouponResp, err error) {
+	r = new(commodity.ExportUserCouponResp)
+	r.Data, err = c.useCase.ExportUserCoupons(ctx, req.Uid)
+	r.Base = base.BuildBaseResp(err)
+	return r, nil
+}
+
+func (c CommodityHandler) EraseUserCoupon(ctx context.Context, req *commodity.EraseUserCouponReq) (r *commodity.EraseUserCouponResp, err error) {
+	r = new(commodity.EraseUserCouponResp)
+	err = c.useCase.EraseUserCoupons(ctx, req.Uid)
+	r.Base = base.BuildBaseResp(err)
+	return r, nil
+}
+
 func (c CommodityHandler) GetCouponAndPrice(ctx context.Context, req *commodity.GetCouponAndPriceReq) (r *commodity.GetCouponAndPriceResp, err error) {
 	r = new(commodity.GetCouponAndPriceResp)
 	goodsList := pack.ConvertOrderGoodsList(req.GoodsList)
@@ -234,6 +248,7 @@ func (c CommodityHandler) CreateSpu(streamServer commodity.CommodityService_Crea
 		ForSale:          int(req.ForSale),
 		Shipping:         req.Shipping,
 		GoodsHeadDrawing: req.GoodsHeadDrawing,
+		ShopId:           req.ShopID,
 	})
 	if err != nil {
 		resp.Base = base.BuildBaseResp(err)
@@ -42,6 +42,7 @@ func BuildSpu(spu *model.Spu) *modelKitex.Spu {
 		SpuID:            spu.SpuId,
 		Name:             spu.Name,
 		CreatorID:        spu.CreatorId,
+		ShopID:           spu.ShopId,
 		CategoryID:       spu.CategoryId,
 		Description:      spu.Description,
 		GoodsHeadDrawing: upyun.GetImageUrl(spu.GoodsHeadDrawingUrl),
@@ -109,15 +110,18 @@ func BuildSkuInfo(s *model.Sku) *modelKitex.SkuInfo {
 		sku.SkuID = -1
 		sku.CreatorID = -1
 		sku.HistoryID = -1
+		sku.ShopID = -1
 	} else {
 		sku.SkuID = s.SkuID
 		sku.CreatorID = s.CreatorID
 		sku.HistoryID = s.HistoryID
+		sku.ShopID = s.ShopID
 	}
 	result := &modelKitex.SkuInfo{
 		SkuID:     sku.SkuID,
 		CreatorID: sku.CreatorID,
 		HistoryID: sku.HistoryID,
+		ShopID:    sku.ShopID,
 	}
 	return result
 }
@@ -135,6 +139,7 @@ func BuildSkuInfos(i []*model.Sku) []*modelKitex.SkuInfo {
 			StyleHeadDrawing: v.StyleHeadDrawingUrl,
 			SpuID:            v.SpuID,
 			HistoryID:        v.HistoryID,
+			ShopID:           v.ShopID,
 		})
 	}
 	return result
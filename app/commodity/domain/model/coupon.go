@@ -38,9 +38,9 @@ type Coupon struct {
 }
 
 type UserCoupon struct {
-	Uid           int64
-	CouponId      int64
-	RemainingUses int64
+	Uid           int64 `json:"uid"`
+	CouponId      int64 `json:"coupon_id"`
+	RemainingUses int64 `json:"remaining_uses"`
 }
 
 type OrderGoods struct {
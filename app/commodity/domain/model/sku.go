@@ -30,6 +30,7 @@ type Sku struct {
 	Price               float64
 	ForSale             int
 	SpuID               int64
+	ShopID              int64
 	Stock               int64
 	HistoryStock        int64
 	CreatedAt           int64
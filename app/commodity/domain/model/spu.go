@@ -20,6 +20,7 @@ type Spu struct {
 	SpuId               int64
 	Name                string
 	CreatorId           int64
+	ShopId              int64
 	Description         string
 	CategoryId          int64
 	GoodsHeadDrawing    []byte
@@ -24,6 +24,7 @@ import (
 
 	"github.com/west2-online/DomTok/app/commodity/domain/model"
 	"github.com/west2-online/DomTok/kitex_gen/commodity"
+	"github.com/west2-online/DomTok/pkg/audit"
 	"github.com/west2-online/DomTok/pkg/kafka"
 )
 
@@ -58,6 +59,7 @@ type CommodityDB interface {
 	GetUserCouponsByUId(ctx context.Context, uid int64, pageNum int64) ([]*model.UserCoupon, error)
 	GetFullUserCouponsByUId(ctx context.Context, uid int64) ([]*model.UserCoupon, error)
 	DeleteUserCoupon(ctx context.Context, coupon *model.UserCoupon) error
+	EraseUserCoupons(ctx context.Context, uid int64) error
 
 	IncrLockStock(ctx context.Context, infos []*model.SkuBuyInfo) error
 	DecrLockStock(ctx context.Context, infos []*model.SkuBuyInfo) error
@@ -115,6 +117,7 @@ type CommodityMQ interface {
 	ConsumeCreateSpuInfo(ctx context.Context) <-chan *kafka.Message
 	ConsumeUpdateSpuInfo(ctx context.Context) <-chan *kafka.Message
 	ConsumeDeleteSpuInfo(ctx context.Context) <-chan *kafka.Message
+	SendAuditEvent(ctx context.Context, e *audit.Event) error
 }
 
 type CommodityElastic interface {
@@ -126,3 +129,7 @@ type CommodityElastic interface {
 	SearchItems(ctx context.Context, indexName string, query *commodity.ViewSpuReq) ([]int64, int64, error)
 	BuildQuery(req *commodity.ViewSpuReq) *elastic.BoolQuery
 }
+
+type CommodityRPC interface {
+	IsShopStaff(ctx context.Context, shopID int64, uid int64) (bool, *model.Shop, error)
+}
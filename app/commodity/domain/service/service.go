@@ -640,6 +640,7 @@ func (svc *CommodityService) CreateSku(ctx context.Context, sku *model.Sku, ext
 		SkuID:     sku.SkuID,
 		CreatorID: sku.CreatorID,
 		HistoryID: sku.HistoryID,
+		ShopID:    sku.ShopID,
 	}
 	return s, nil
 }
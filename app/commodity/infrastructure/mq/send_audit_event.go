@@ -0,0 +1,44 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mq
+
+import (
+	"context"
+
+	"github.com/west2-online/DomTok/pkg/audit"
+	"github.com/west2-online/DomTok/pkg/constants"
+	"github.com/west2-online/DomTok/pkg/errno"
+	"github.com/west2-online/DomTok/pkg/kafka"
+)
+
+// SendAuditEvent 发布审计事件, 由用户服务消费并持久化
+func (c *CommodityMQ) SendAuditEvent(ctx context.Context, e *audit.Event) error {
+	v, err := audit.Encode(e)
+	if err != nil {
+		return errno.Errorf(errno.InternalServiceErrorCode, "CommodityMQ.SendAuditEvent failed: %v", err)
+	}
+
+	msg := &kafka.Message{
+		K: []byte(e.Target), // 同一对象的事件落在同一分区, 保证顺序
+		V: v,
+	}
+	err = c.Send(ctx, constants.KafkaAuditTopic, []*kafka.Message{msg})
+	if err != nil {
+		return errno.Errorf(errno.InternalKafkaErrorCode, "CommodityMQ.SendAuditEvent failed: %v", err)
+	}
+	return nil
+}
@@ -90,6 +90,7 @@ func (db *commodityDB) GetSpuByIds(ctx context.Context, spuIds []int64) ([]*mode
 			SpuId:               spu.Id,
 			Name:                spu.Name,
 			CreatorId:           spu.CreatorId,
+			ShopId:              spu.ShopId,
 			Description:         spu.Description,
 			CategoryId:          spu.CategoryId,
 			Price:               spu.Price,
@@ -109,6 +110,7 @@ func (db *commodityDB) CreateSpu(ctx context.Context, spu *model.Spu) error {
 		Id:               spu.SpuId,
 		Name:             spu.Name,
 		CreatorId:        spu.CreatorId,
+		ShopId:           spu.ShopId,
 		Description:      spu.Description,
 		CategoryId:       spu.CategoryId,
 		GoodsHeadDrawing: spu.GoodsHeadDrawingUrl,
@@ -183,6 +185,7 @@ func (db *commodityDB) GetSpuBySpuId(ctx context.Context, spuId int64) (*model.S
 		SpuId:               s.Id,
 		Name:                s.Name,
 		CreatorId:           s.CreatorId,
+		ShopId:              s.ShopId,
 		CategoryId:          s.CategoryId,
 		Description:         s.Description,
 		GoodsHeadDrawingUrl: s.GoodsHeadDrawing,
@@ -201,6 +204,7 @@ func (db *commodityDB) UpdateSpu(ctx context.Context, spu *model.Spu) error {
 		Id:               spu.SpuId,
 		Name:             spu.Name,
 		CreatorId:        spu.CreatorId,
+		ShopId:           spu.ShopId,
 		Description:      spu.Description,
 		CategoryId:       spu.CategoryId,
 		GoodsHeadDrawing: spu.GoodsHeadDrawingUrl,
@@ -697,6 +701,21 @@ func (db *commodityDB) ListSkuInfo(ctx context.Context, skuInfo []*model.SkuVers
 		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to get sku to spu: %v", err)
 	}
 
+	// 商品归属于店铺, 需要通过 spu 拿到 sku 所属的店铺
+	spuIDList := make([]int64, 0, len(skuToSpuList))
+	for _, skuToSpu := range skuToSpuList {
+		spuIDList = append(spuIDList, skuToSpu.SpuId)
+	}
+
+	var spus []Spu
+	if err := db.client.WithContext(ctx).Table((&Spu{}).TableName()).Select("id", "shop_id").Where("id IN (?)", spuIDList).Find(&spus).Error; err != nil {
+		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to get spu shop: %v", err)
+	}
+	spuToShop := make(map[int64]int64, len(spus))
+	for _, spu := range spus {
+		spuToShop[spu.Id] = spu.ShopId
+	}
+
 	result := make([]*model.Sku, 0, len(skus))
 
 	for _, sku := range skus {
@@ -717,6 +736,7 @@ func (db *commodityDB) ListSkuInfo(ctx context.Context, skuInfo []*model.SkuVers
 			LockStock:           sku.LockStock,
 			StyleHeadDrawingUrl: sku.StyleHeadDrawing,
 			SpuID:               spuID,
+			ShopID:              spuToShop[spuID],
 			HistoryID:           sku.HistoryVersionId,
 		})
 	}
@@ -819,8 +839,21 @@ func (db *commodityDB) GetSkuBySkuId(ctx context.Context, skuId int64) (*model.S
 		}
 		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to view sku: %v", err)
 	}
+
+	// 商品归属于店铺, 需要通过 spu 拿到 sku 所属的店铺, 用于校验店员身份
+	var skuToSpu SpuToSku
+	if err := db.client.WithContext(ctx).Table(skuToSpu.TableName()).Where("sku_id = ?", skuId).First(&skuToSpu).Error; err != nil {
+		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to get sku to spu: %v", err)
+	}
+	var spu Spu
+	if err := db.client.WithContext(ctx).Table(spu.TableName()).Select("id", "shop_id").Where("id = ?", skuToSpu.SpuId).First(&spu).Error; err != nil {
+		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to get spu shop: %v", err)
+	}
+
 	result := &model.Sku{
 		SkuID:               sku.Id,
+		SpuID:               spu.Id,
+		ShopID:              spu.ShopId,
 		CreatorID:           sku.CreatorId,
 		Price:               sku.Price,
 		Name:                sku.Name,
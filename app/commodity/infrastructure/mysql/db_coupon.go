@@ -210,3 +210,11 @@ func (db *commodityDB) DeleteUserCoupon(ctx context.Context, coupon *model.UserC
 	}
 	return nil
 }
+
+// EraseUserCoupons 物理删除用户持有的全部优惠券, 用于注销账号
+func (db *commodityDB) EraseUserCoupons(ctx context.Context, uid int64) error {
+	if err := db.client.WithContext(ctx).Unscoped().Where("uid = ?", uid).Delete(&UserCoupon{}).Error; err != nil {
+		return errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to erase user coupons: %v", err)
+	}
+	return nil
+}
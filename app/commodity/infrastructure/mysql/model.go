@@ -38,6 +38,7 @@ type Spu struct {
 	Id               int64 `gorm:"primary_key"`
 	Name             string
 	CreatorId        int64
+	ShopId           int64
 	Description      string
 	CategoryId       int64
 	GoodsHeadDrawing string
@@ -0,0 +1,50 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rpc
+
+import (
+	"context"
+
+	"github.com/west2-online/DomTok/app/commodity/domain/model"
+	"github.com/west2-online/DomTok/app/commodity/domain/repository"
+	"github.com/west2-online/DomTok/kitex_gen/user"
+	"github.com/west2-online/DomTok/kitex_gen/user/userservice"
+	"github.com/west2-online/DomTok/pkg/utils"
+)
+
+type commodityRpcImpl struct {
+	user userservice.Client
+}
+
+func NewCommodityRpcImpl(u userservice.Client) repository.CommodityRPC {
+	return &commodityRpcImpl{user: u}
+}
+
+// IsShopStaff 查询用户是否为店铺成员, 同时返回店铺信息
+func (rpc *commodityRpcImpl) IsShopStaff(ctx context.Context, shopID int64, uid int64) (bool, *model.Shop, error) {
+	resp, err := rpc.user.IsShopStaff(ctx, &user.IsShopStaffReq{ShopID: shopID, Uid: uid})
+	if err = utils.ProcessRpcError("user.IsShopStaff", resp, err); err != nil {
+		return false, nil, err
+	}
+
+	shop := resp.GetShop()
+	return resp.IsStaff, &model.Shop{
+		ShopId: shop.GetShopID(),
+		Name:   shop.GetName(),
+		Status: int(shop.GetStatus()),
+	}, nil
+}
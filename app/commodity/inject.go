@@ -23,12 +23,15 @@ import (
 	"github.com/west2-online/DomTok/app/commodity/infrastructure/mq"
 	"github.com/west2-online/DomTok/app/commodity/infrastructure/mysql"
 	"github.com/west2-online/DomTok/app/commodity/infrastructure/redis"
+	rpcimpl "github.com/west2-online/DomTok/app/commodity/infrastructure/rpc"
 	"github.com/west2-online/DomTok/app/commodity/usecase"
 	"github.com/west2-online/DomTok/config"
 	"github.com/west2-online/DomTok/kitex_gen/commodity"
 	"github.com/west2-online/DomTok/pkg/base/client"
 	"github.com/west2-online/DomTok/pkg/constants"
+	"github.com/west2-online/DomTok/pkg/health"
 	"github.com/west2-online/DomTok/pkg/kafka"
+	"github.com/west2-online/DomTok/pkg/shutdown"
 	"github.com/west2-online/DomTok/pkg/utils"
 )
 
@@ -54,12 +57,30 @@ func InjectCommodityHandler() commodity.CommodityService {
 
 	kafMQ := kafka.NewKafkaInstance()
 
+	userClient, err := client.InitUserRPC()
+	if err != nil {
+		panic(err)
+	}
+
 	db := mysql.NewCommodityDB(gormDB)
 	re := redis.NewCommodityCache(redisCache)
 	kaf := mq.NewCommodityMQ(kafMQ)
 	e := es.NewCommodityElastic(elastic)
 	svc := service.NewCommodityService(db, sf, re, kaf, e)
-	uc := usecase.NewCommodityCase(db, svc, re, kaf, e)
+	r := rpcimpl.NewCommodityRpcImpl(*userClient)
+	uc := usecase.NewCommodityCase(db, svc, re, kaf, e, r)
+
+	// 注册健康检查项, 由 health.Serve 对外暴露
+	health.Register(constants.HealthCheckMySQL, health.MySQL(gormDB))
+	health.Register(constants.HealthCheckRedis, health.Redis(redisCache))
+	health.Register(constants.HealthCheckElasticsearch, health.Elasticsearch(elastic))
+	health.Register(constants.HealthCheckKafka, client.PingKafka)
+
+	// 退出时逆序执行: 先停止消费并刷新 kafka writer, 再关闭连接池
+	shutdown.Register("mysql", func() error { return client.CloseMySQL(gormDB) })
+	shutdown.Register("redis", redisCache.Close)
+	shutdown.Register("elasticsearch", func() error { elastic.Stop(); return nil })
+	shutdown.Register("kafka", func() error { kafMQ.Close(); return nil })
 
 	return rpc.NewCommodityHandler(uc)
 }
@@ -22,6 +22,7 @@ import (
 
 	"github.com/west2-online/DomTok/app/commodity/domain/model"
 	"github.com/west2-online/DomTok/kitex_gen/commodity"
+	"github.com/west2-online/DomTok/pkg/audit"
 	contextLogin "github.com/west2-online/DomTok/pkg/base/context"
 	"github.com/west2-online/DomTok/pkg/constants"
 	"github.com/west2-online/DomTok/pkg/errno"
@@ -61,6 +62,8 @@ func (uc *useCase) DeleteCategory(ctx context.Context, category *model.Category)
 	if err != nil {
 		return err
 	}
+	audit.Record(ctx, audit.PublisherFunc(uc.mq.SendAuditEvent),
+		constants.AuditActionDeleteCategory, audit.Target("category", category.Id), category, nil)
 	return nil
 }
 
@@ -100,6 +103,11 @@ func (us *useCase) CreateSpu(ctx context.Context, spu *model.Spu) (id int64, err
 		return 0, fmt.Errorf("usecase.CreateSpu verify failed: %w", err)
 	}
 
+	// 商品必须发布在调用者所属的店铺下, 并且店铺需要处于营业状态
+	if err = us.identifyShopStaff(ctx, spu.ShopId, loginData, true); err != nil {
+		return 0, err
+	}
+
 	id, err = us.svc.CreateSpu(ctx, spu)
 	if err != nil {
 		return 0, fmt.Errorf("usecase.CreateSpu failed: %w", err)
@@ -125,9 +133,12 @@ func (us *useCase) DeleteSpu(ctx context.Context, spuId int64) error {
 		return fmt.Errorf("usecase.DeleteSpu failed: %w", err)
 	}
 
-	err = us.svc.IdentifyUser(ctx, ret.CreatorId)
+	uid, err := contextLogin.GetLoginData(ctx)
 	if err != nil {
-		return fmt.Errorf("usecase.DeleteSpu identify user failed: %w", err)
+		return fmt.Errorf("usecase.DeleteSpu failed: %w", err)
+	}
+	if err = us.identifyCommodityManager(ctx, ret.ShopId, ret.CreatorId, uid, false); err != nil {
+		return err
 	}
 
 	if err = us.svc.DeleteSpu(ctx, spuId, ret.GoodsHeadDrawingUrl); err != nil {
@@ -147,9 +158,12 @@ func (us *useCase) UpdateSpu(ctx context.Context, spu *model.Spu) error {
 		return fmt.Errorf("usecase.UpdateSpu failed: %w", err)
 	}
 
-	err = us.svc.IdentifyUserInStreamCtx(ctx, ret.CreatorId)
+	uid, err := contextLogin.GetStreamLoginData(ctx)
 	if err != nil {
-		return fmt.Errorf("usecase.UpdateSpu identify user failed: %w", err)
+		return fmt.Errorf("usecase.UpdateSpu failed: %w", err)
+	}
+	if err = us.identifyCommodityManager(ctx, ret.ShopId, ret.CreatorId, uid, true); err != nil {
+		return err
 	}
 
 	if err = us.svc.Verify(us.svc.VerifyForSaleStatus(spu.ForSale)); err != nil {
@@ -271,13 +285,15 @@ func (us *useCase) CreateSku(ctx context.Context, sku *model.Sku, ext string) (s
 	}
 	sku.CreatorID = loginData
 
-	ok, err := us.db.IsSpuExist(ctx, sku.SpuID)
+	spu, err := us.db.GetSpuBySpuId(ctx, sku.SpuID)
 	if err != nil {
-		return nil, fmt.Errorf("usecase.CreateSku: check spu exist failed: %w", err)
+		return nil, fmt.Errorf("usecase.CreateSku: get spu failed: %w", err)
 	}
-	if !ok {
-		return nil, errno.NewErrNo(errno.ServiceSpuNotExist, "spu does not exist")
+	if err = us.identifyCommodityManager(ctx, spu.ShopId, spu.CreatorId, loginData, true); err != nil {
+		return nil, err
 	}
+	sku.ShopID = spu.ShopId
+
 	s, err = us.svc.CreateSku(ctx, sku, ext)
 	if err != nil {
 		return nil, fmt.Errorf("usecase.CreateSku failed: %w", err)
@@ -292,8 +308,12 @@ func (us *useCase) UpdateSku(ctx context.Context, sku *model.Sku, ext string) (e
 		return fmt.Errorf("service.UpdateSku: get sku by sku id failed: %w", err)
 	}
 
-	if err := us.svc.IdentifyUserInStreamCtx(ctx, ret.CreatorID); err != nil {
-		return fmt.Errorf("service.UpdateSku: %w", err)
+	uid, err := contextLogin.GetStreamLoginData(ctx)
+	if err != nil {
+		return fmt.Errorf("usecase.UpdateSku failed: %w", err)
+	}
+	if err = us.identifyCommodityManager(ctx, ret.ShopID, ret.CreatorID, uid, true); err != nil {
+		return err
 	}
 
 	sku.StyleHeadDrawingUrl = utils.GenerateFileName(constants.SkuDirDest, sku.SkuID) + ext
@@ -310,8 +330,12 @@ func (us *useCase) DeleteSku(ctx context.Context, sku *model.Sku) (err error) {
 		return fmt.Errorf("service.UpdateSku: get sku by sku id failed: %w", err)
 	}
 
-	if err := us.svc.IdentifyUser(ctx, ret.CreatorID); err != nil {
-		return fmt.Errorf("service.UpdateSku: %w", err)
+	uid, err := contextLogin.GetLoginData(ctx)
+	if err != nil {
+		return fmt.Errorf("usecase.DeleteSku failed: %w", err)
+	}
+	if err = us.identifyCommodityManager(ctx, ret.ShopID, ret.CreatorID, uid, false); err != nil {
+		return err
 	}
 
 	if err = us.svc.DeleteSku(ctx, ret); err != nil {
@@ -451,3 +475,31 @@ func (us *useCase) DeleteSkuImage(ctx context.Context, imageId int64) (err error
 	}
 	return nil
 }
+
+// identifyCommodityManager 校验调用者是否可以管理已有的商品
+// shop_id 字段上线前发布的商品没有所属店铺(shop_id = 0), 这类商品只允许创建者本人管理
+func (us *useCase) identifyCommodityManager(ctx context.Context, shopID int64, creatorID int64, uid int64, requireOpen bool) error {
+	if shopID == 0 {
+		if creatorID != uid {
+			return errno.NewErrNo(errno.ServiceNotShopStaff, "current user is not creator of the commodity")
+		}
+		return nil
+	}
+	return us.identifyShopStaff(ctx, shopID, uid, requireOpen)
+}
+
+// identifyShopStaff 校验调用者是否是商品所属店铺的店员, 被移除的店员不能继续管理店铺的商品
+// 发布和修改商品还要求店铺处于营业状态, 关闭的店铺仍然可以下架商品
+func (us *useCase) identifyShopStaff(ctx context.Context, shopID int64, uid int64, requireOpen bool) error {
+	isStaff, shop, err := us.rpc.IsShopStaff(ctx, shopID, uid)
+	if err != nil {
+		return fmt.Errorf("usecase.identifyShopStaff failed: %w", err)
+	}
+	if !isStaff {
+		return errno.NewErrNo(errno.ServiceNotShopStaff, "current user is not staff of the shop")
+	}
+	if requireOpen && shop.Status == constants.ShopStatusClosed {
+		return errno.NewErrNo(errno.ServiceShopClosed, "shop is closed")
+	}
+	return nil
+}
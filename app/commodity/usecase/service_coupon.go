@@ -20,8 +20,12 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/bytedance/sonic"
+
 	"github.com/west2-online/DomTok/app/commodity/domain/model"
+	"github.com/west2-online/DomTok/pkg/audit"
 	contextLogin "github.com/west2-online/DomTok/pkg/base/context"
+	"github.com/west2-online/DomTok/pkg/constants"
 	"github.com/west2-online/DomTok/pkg/errno"
 )
 
@@ -60,6 +64,8 @@ func (uc *useCase) DeleteCoupon(ctx context.Context, coupon *model.Coupon) (err
 	if err != nil {
 		return fmt.Errorf("usecase.DeleteCoupon error: %w", err)
 	}
+	audit.Record(ctx, audit.PublisherFunc(uc.mq.SendAuditEvent),
+		constants.AuditActionDeleteCoupon, audit.Target("coupon", coupon.Id), coupon, nil)
 	return
 }
 
@@ -120,6 +126,27 @@ func (uc *useCase) SearchUserCoupons(ctx context.Context, pageNum int64) (coupon
 	return
 }
 
+// ExportUserCoupons 导出用户持有的优惠券, 该方法由 user 服务调用
+func (uc *useCase) ExportUserCoupons(ctx context.Context, uid int64) (string, error) {
+	coupons, err := uc.db.GetFullUserCouponsByUId(ctx, uid)
+	if err != nil {
+		return "", fmt.Errorf("usecase.ExportUserCoupons error: %w", err)
+	}
+	data, err := sonic.MarshalString(map[string][]*model.UserCoupon{"coupons": coupons})
+	if err != nil {
+		return "", errno.Errorf(errno.InternalServiceErrorCode, "usecase.ExportUserCoupons marshal failed: %v", err)
+	}
+	return data, nil
+}
+
+// EraseUserCoupons 注销账号时清除用户持有的优惠券, 该方法由 user 服务调用
+func (uc *useCase) EraseUserCoupons(ctx context.Context, uid int64) error {
+	if err := uc.db.EraseUserCoupons(ctx, uid); err != nil {
+		return fmt.Errorf("usecase.EraseUserCoupons error: %w", err)
+	}
+	return nil
+}
+
 func (uc *useCase) GetCouponAndPrice(ctx context.Context, goods []*model.OrderGoods) ([]*model.OrderGoods, float64, error) {
 	return uc.svc.CalculateWithCoupon(ctx, goods)
 }
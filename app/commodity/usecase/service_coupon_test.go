@@ -29,6 +29,7 @@ import (
 
 	"github.com/west2-online/DomTok/app/commodity/domain/model"
 	"github.com/west2-online/DomTok/app/commodity/domain/service"
+	"github.com/west2-online/DomTok/app/commodity/infrastructure/mq"
 	"github.com/west2-online/DomTok/app/commodity/infrastructure/mysql"
 	contextLogin "github.com/west2-online/DomTok/pkg/base/context"
 	"github.com/west2-online/DomTok/pkg/errno"
@@ -230,9 +231,14 @@ func TestUseCase_DeleteCoupon(t *testing.T) {
 			// Patch DeleteCouponById method.
 			mockey.Mock(mockey.GetMethod(db, "DeleteCouponById")).Return(tc.MockDeleteError).Build()
 
+			// Patch SendAuditEvent method.
+			kaf := mq.NewCommodityMQ(nil)
+			mockey.Mock(mockey.GetMethod(kaf, "SendAuditEvent")).Return(nil).Build()
+
 			uc := &useCase{
 				svc: new(service.CommodityService), // not used in DeleteCoupon
 				db:  db,
+				mq:  kaf,
 			}
 
 			err := uc.DeleteCoupon(ctx.Background(), coupon)
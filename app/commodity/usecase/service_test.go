@@ -34,15 +34,29 @@ import (
 	redisCommodity "github.com/west2-online/DomTok/app/commodity/infrastructure/redis"
 	"github.com/west2-online/DomTok/kitex_gen/commodity"
 	"github.com/west2-online/DomTok/pkg/base/context"
+	"github.com/west2-online/DomTok/pkg/constants"
+	"github.com/west2-online/DomTok/pkg/errno"
 	"github.com/west2-online/DomTok/pkg/utils"
 )
 
+type mockCommodityRPC struct {
+	isStaff bool
+	shop    *model.Shop
+	err     error
+}
+
+func (m *mockCommodityRPC) IsShopStaff(ctx ctx.Context, shopID int64, uid int64) (bool, *model.Shop, error) {
+	return m.isStaff, m.shop, m.err
+}
+
 func TestUseCase_CreateSpu(t *testing.T) {
 	type TestCase struct {
 		Name            string
 		MockUid         int64
 		MockCtxError    error
 		MockVerifyError error
+		MockNotStaff    bool
+		MockShopStatus  int
 		MockCreateError error
 		MockSpuId       int64
 		ExpectedError   error
@@ -63,6 +77,18 @@ func TestUseCase_CreateSpu(t *testing.T) {
 			ExpectedError:   errors.New("usecase.CreateSpu verify failed: VerifyError"),
 			ExpectedSpuId:   0,
 		},
+		{
+			Name:          "NotShopStaff",
+			MockNotStaff:  true,
+			ExpectedError: errno.NewErrNo(errno.ServiceNotShopStaff, "current user is not staff of the shop"),
+			ExpectedSpuId: 0,
+		},
+		{
+			Name:           "ShopClosed",
+			MockShopStatus: constants.ShopStatusClosed,
+			ExpectedError:  errno.NewErrNo(errno.ServiceShopClosed, "shop is closed"),
+			ExpectedSpuId:  0,
+		},
 		{
 			Name:            "CreateError",
 			MockCreateError: errors.New("CreateError"),
@@ -94,6 +120,10 @@ func TestUseCase_CreateSpu(t *testing.T) {
 			us := new(useCase)
 			svc := new(service.CommodityService)
 			us.svc = svc
+			us.rpc = &mockCommodityRPC{
+				isStaff: !tc.MockNotStaff,
+				shop:    &model.Shop{Status: tc.MockShopStatus},
+			}
 
 			id, err := us.CreateSpu(ctx.Background(), spu)
 			if err != nil {
@@ -174,7 +204,7 @@ func TestUseCase_DeleteSpu(t *testing.T) {
 	type TestCase struct {
 		Name                          string
 		MockMatchDeleteConditionError error
-		MockIdentifyError             error
+		MockNotStaff                  bool
 		MockDeleteSpuError            error
 		MockDeleteAllSpuImagesError   error
 		MockSpuInfo                   *model.Spu
@@ -189,30 +219,29 @@ func TestUseCase_DeleteSpu(t *testing.T) {
 			ExpectedError:                 errors.New("usecase.DeleteSpu failed: MatchConditionFailed"),
 		},
 		{
-			Name:                          "IdentifyError",
+			Name:                          "NotShopStaff",
 			MockMatchDeleteConditionError: nil,
-			MockIdentifyError:             errors.New("IdentifyError"),
-			MockSpuInfo:                   &model.Spu{SpuId: 1, CreatorId: 1},
-			ExpectedError:                 errors.New("usecase.DeleteSpu identify user failed: IdentifyError"),
+			MockNotStaff:                  true,
+			MockSpuInfo:                   &model.Spu{SpuId: 1, ShopId: 1, CreatorId: 1},
+			ExpectedError:                 errno.NewErrNo(errno.ServiceNotShopStaff, "current user is not staff of the shop"),
 		},
 		{
 			Name:               "DeleteSpuError",
 			MockDeleteSpuError: errors.New("DeleteSpuError"),
-			MockSpuInfo:        &model.Spu{SpuId: 1, CreatorId: 1},
+			MockSpuInfo:        &model.Spu{SpuId: 1, ShopId: 1, CreatorId: 1},
 			ExpectedError:      errors.New("usecase.DeleteSpu failed: DeleteSpuError"),
 		},
 		{
 			Name:                        "DeleteAllSpuImagesError",
 			MockDeleteAllSpuImagesError: errors.New("DeleteAllSpuImagesError"),
-			MockSpuInfo:                 &model.Spu{SpuId: 1, CreatorId: 1},
+			MockSpuInfo:                 &model.Spu{SpuId: 1, ShopId: 1, CreatorId: 1},
 			ExpectedError:               errors.New("usecase.DeleteSpu failed: DeleteAllSpuImagesError"),
 		},
 		{
 			Name:                          "DeleteSpuSuccessfully",
-			MockSpuInfo:                   &model.Spu{SpuId: 1, CreatorId: 1},
+			MockSpuInfo:                   &model.Spu{SpuId: 1, ShopId: 1, CreatorId: 1},
 			MockDeleteSpuError:            nil,
 			MockDeleteAllSpuImagesError:   nil,
-			MockIdentifyError:             nil,
 			MockMatchDeleteConditionError: nil,
 			ExpectedError:                 nil,
 		},
@@ -226,10 +255,14 @@ func TestUseCase_DeleteSpu(t *testing.T) {
 			svc := new(service.CommodityService)
 			us := &useCase{
 				svc: svc,
+				rpc: &mockCommodityRPC{
+					isStaff: !tc.MockNotStaff,
+					shop:    &model.Shop{Status: constants.ShopStatusClosed},
+				},
 			}
 
 			mockey.Mock((*service.CommodityService).MatchDeleteSpuCondition).Return(tc.MockSpuInfo, tc.MockMatchDeleteConditionError).Build()
-			mockey.Mock((*service.CommodityService).IdentifyUser).Return(tc.MockIdentifyError).Build()
+			mockey.Mock(context.GetLoginData).Return(int64(1), nil).Build()
 			mockey.Mock((*service.CommodityService).DeleteSpu).Return(tc.MockDeleteSpuError).Build()
 			mockey.Mock((*service.CommodityService).DeleteAllSpuImages).Return(tc.MockDeleteAllSpuImagesError).Build()
 
@@ -245,13 +278,14 @@ func TestUseCase_DeleteSpu(t *testing.T) {
 
 func TestUseCase_UpdateSpu(t *testing.T) {
 	type TestCase struct {
-		Name              string
-		MockGetSpuError   error
-		MockSpuInfo       *model.Spu
-		MockIdentifyError error
-		MockVerifyError   error
-		MockUpdateError   error
-		ExpectedError     error
+		Name            string
+		MockGetSpuError error
+		MockSpuInfo     *model.Spu
+		MockNotStaff    bool
+		MockShopStatus  int
+		MockVerifyError error
+		MockUpdateError error
+		ExpectedError   error
 	}
 
 	testcase := []TestCase{
@@ -262,29 +296,46 @@ func TestUseCase_UpdateSpu(t *testing.T) {
 			ExpectedError:   errors.New("usecase.UpdateSpu failed: GetSpuError"),
 		},
 		{
-			Name:              "IdentifyError",
-			MockIdentifyError: errors.New("IdentifyError"),
-			MockSpuInfo:       &model.Spu{SpuId: 1, CreatorId: 1},
-			ExpectedError:     errors.New("usecase.UpdateSpu identify user failed: IdentifyError"),
+			Name:          "NotShopStaff",
+			MockNotStaff:  true,
+			MockSpuInfo:   &model.Spu{SpuId: 1, ShopId: 1, CreatorId: 1},
+			ExpectedError: errno.NewErrNo(errno.ServiceNotShopStaff, "current user is not staff of the shop"),
+		},
+		{
+			Name:           "ShopClosed",
+			MockShopStatus: constants.ShopStatusClosed,
+			MockSpuInfo:    &model.Spu{SpuId: 1, ShopId: 1, CreatorId: 1},
+			ExpectedError:  errno.NewErrNo(errno.ServiceShopClosed, "shop is closed"),
 		},
 		{
 			Name:            "VerifyError",
 			MockVerifyError: errors.New("VerifyError"),
-			MockSpuInfo:     &model.Spu{SpuId: 1, CreatorId: 1},
+			MockSpuInfo:     &model.Spu{SpuId: 1, ShopId: 1, CreatorId: 1},
 			ExpectedError:   errors.New("usecase.UpdateSpu verify failed: VerifyError"),
 		},
 		{
 			Name:            "UpdateSpuError",
 			MockUpdateError: errors.New("UpdateSpuError"),
-			MockSpuInfo:     &model.Spu{SpuId: 1, CreatorId: 1},
+			MockSpuInfo:     &model.Spu{SpuId: 1, ShopId: 1, CreatorId: 1},
 			ExpectedError:   errors.New("usecase.UpdateSpu failed: UpdateSpuError"),
 		},
 		{
 			Name:            "UpdateSpuSuccessfully",
 			MockUpdateError: nil,
-			MockSpuInfo:     &model.Spu{SpuId: 1, CreatorId: 1},
+			MockSpuInfo:     &model.Spu{SpuId: 1, ShopId: 1, CreatorId: 1},
 			ExpectedError:   nil,
 		},
+		{
+			// 没有所属店铺的旧商品只允许创建者管理, 不再校验店员身份
+			Name:         "LegacySpuUpdatedByCreator",
+			MockNotStaff: true,
+			MockSpuInfo:  &model.Spu{SpuId: 1, CreatorId: 1},
+		},
+		{
+			Name:          "LegacySpuNotCreator",
+			MockSpuInfo:   &model.Spu{SpuId: 1, CreatorId: 2},
+			ExpectedError: errno.NewErrNo(errno.ServiceNotShopStaff, "current user is not creator of the commodity"),
+		},
 	}
 
 	defer mockey.UnPatchAll()
@@ -299,9 +350,13 @@ func TestUseCase_UpdateSpu(t *testing.T) {
 			us := &useCase{
 				svc: svc,
 				db:  db,
+				rpc: &mockCommodityRPC{
+					isStaff: !tc.MockNotStaff,
+					shop:    &model.Shop{Status: tc.MockShopStatus},
+				},
 			}
 			mockey.Mock(mockey.GetMethod(us.db, "GetSpuBySpuId")).Return(tc.MockSpuInfo, tc.MockGetSpuError).Build()
-			mockey.Mock((*service.CommodityService).IdentifyUserInStreamCtx).Return(tc.MockIdentifyError).Build()
+			mockey.Mock(context.GetStreamLoginData).Return(int64(1), nil).Build()
 			mockey.Mock((*service.CommodityService).Verify).Return(tc.MockVerifyError).Build()
 			mockey.Mock((*service.CommodityService).UpdateSpu).Return(tc.MockUpdateError).Build()
 			mockey.Mock(utils.GenerateFileName).Return("").Build()
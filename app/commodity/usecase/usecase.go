@@ -50,6 +50,8 @@ type CommodityUseCase interface {
 	CreateUserCoupon(ctx context.Context, coupon *model.UserCoupon) (err error)
 	SearchUserCoupons(ctx context.Context, pageNum int64) (coupons []*model.Coupon, err error)
 	GetCouponAndPrice(ctx context.Context, goods []*model.OrderGoods) ([]*model.OrderGoods, float64, error)
+	ExportUserCoupons(ctx context.Context, uid int64) (string, error)
+	EraseUserCoupons(ctx context.Context, uid int64) error
 
 	CreateSku(ctx context.Context, sku *model.Sku, ext string) (s *model.Sku, err error)
 	UpdateSku(ctx context.Context, sku *model.Sku, ext string) (err error)
@@ -70,10 +72,11 @@ type useCase struct {
 	cache repository.CommodityCache
 	mq    repository.CommodityMQ
 	es    repository.CommodityElastic
+	rpc   repository.CommodityRPC
 }
 
 func NewCommodityCase(db repository.CommodityDB, svc *service.CommodityService, cache repository.CommodityCache,
-	mq repository.CommodityMQ, es repository.CommodityElastic,
+	mq repository.CommodityMQ, es repository.CommodityElastic, rpc repository.CommodityRPC,
 ) *useCase {
 	return &useCase{
 		db:    db,
@@ -81,5 +84,6 @@ func NewCommodityCase(db repository.CommodityDB, svc *service.CommodityService,
 		cache: cache,
 		mq:    mq,
 		es:    es,
+		rpc:   rpc,
 	}
 }
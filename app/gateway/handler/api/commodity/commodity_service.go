@@ -207,6 +207,7 @@ func CreateSpu(ctx context.Context, c *app.RequestContext) {
 		ForSale:     req.ForSale,
 		Shipping:    req.Shipping,
 		BufferCount: int64(len(datas)),
+		ShopID:      req.ShopID,
 	}, datas)
 	if err != nil {
 		pack.RespError(c, err)
@@ -402,7 +403,7 @@ func CreateSku(ctx context.Context, c *app.RequestContext) {
 	}{
 		Id:         skuInfo.SkuID,
 		HistoryId:  skuInfo.HistoryID,
-		MerchantId: skuInfo.CreatorID,
+		MerchantId: skuInfo.ShopID,
 	})
 }
 
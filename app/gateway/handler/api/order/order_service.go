@@ -30,6 +30,7 @@ import (
 	"github.com/west2-online/DomTok/app/gateway/rpc"
 	kmodel "github.com/west2-online/DomTok/kitex_gen/model"
 	orderrpc "github.com/west2-online/DomTok/kitex_gen/order"
+	"github.com/west2-online/DomTok/pkg/errno"
 )
 
 // CreateOrder .
@@ -115,6 +116,26 @@ func ViewOrder(ctx context.Context, c *app.RequestContext) {
 	pack.RespData(c, resp)
 }
 
+// ViewOrderDetail .
+// @router /api/v1/order/detail [GET]
+func ViewOrderDetail(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.ViewOrderDetailReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	resp, err := rpc.ViewOrderDetailRPC(ctx, req.OrderID)
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+
+	pack.RespData(c, resp)
+}
+
 // CancelOrder .
 // @router /api/v1/cancel [DELETE]
 func CancelOrder(ctx context.Context, c *app.RequestContext) {
@@ -22,6 +22,7 @@ import (
 	"context"
 
 	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/samber/lo"
 
 	api "github.com/west2-online/DomTok/app/gateway/model/api/user"
 	"github.com/west2-online/DomTok/app/gateway/pack"
@@ -29,7 +30,6 @@ import (
 	"github.com/west2-online/DomTok/kitex_gen/user"
 	"github.com/west2-online/DomTok/pkg/constants"
 	"github.com/west2-online/DomTok/pkg/errno"
-	"github.com/west2-online/DomTok/pkg/utils"
 )
 
 // Register .
@@ -65,25 +65,45 @@ func Login(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
-	resp, err := rpc.LoginRPC(ctx, &user.LoginRequest{
+	resp, token, err := rpc.LoginRPC(ctx, &user.LoginRequest{
 		Username: req.Name,
 		Password: req.Password,
+		Device:   lo.ToPtr(string(c.UserAgent())),
+		Ip:       lo.ToPtr(c.ClientIP()),
+		TotpCode: req.TotpCode,
 	})
 	if err != nil {
 		pack.RespError(c, err)
 		return
 	}
 
-	accessToken, refreshToken, err := utils.CreateAllToken(resp.User.UserId)
+	c.Header(constants.AccessTokenHeader, token.AccessToken)
+	c.Header(constants.RefreshTokenHeader, token.RefreshToken)
+
+	pack.RespData(c, resp)
+}
+
+// RefreshToken .
+// @router api/v1/user/refresh [POST]
+func RefreshToken(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.RefreshTokenRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	token, err := rpc.RefreshTokenRPC(ctx, &user.RefreshTokenReq{RefreshToken: req.RefreshToken})
 	if err != nil {
 		pack.RespError(c, err)
 		return
 	}
 
-	c.Header(constants.AccessTokenHeader, accessToken)
-	c.Header(constants.RefreshTokenHeader, refreshToken)
+	c.Header(constants.AccessTokenHeader, token.AccessToken)
+	c.Header(constants.RefreshTokenHeader, token.RefreshToken)
 
-	pack.RespData(c, resp)
+	pack.RespSuccess(c)
 }
 
 // GetAddress .
@@ -110,7 +130,7 @@ func GetAddress(ctx context.Context, c *app.RequestContext) {
 // @router api/v1/user/address [POST]
 func AddAddress(ctx context.Context, c *app.RequestContext) {
 	var err error
-	var req user.AddAddressRequest
+	var req api.AddAddressRequest
 	err = c.BindAndValidate(&req)
 	if err != nil {
 		pack.RespError(c, errno.ParamVerifyError.WithError(err))
@@ -119,9 +139,12 @@ func AddAddress(ctx context.Context, c *app.RequestContext) {
 
 	resp := new(api.AddAddressResponse)
 	resp.AddressID, err = rpc.AddAddressRPC(ctx, &user.AddAddressRequest{
-		Province: req.Province,
-		City:     req.City,
-		Detail:   req.Detail,
+		Province:      req.Province,
+		City:          req.City,
+		Detail:        req.Detail,
+		RecipientName: req.RecipientName,
+		Phone:         req.Phone,
+		IsDefault:     req.IsDefault,
 	})
 	if err != nil {
 		pack.RespError(c, err)
@@ -130,11 +153,94 @@ func AddAddress(ctx context.Context, c *app.RequestContext) {
 	pack.RespData(c, resp.AddressID)
 }
 
+// ListAddress .
+// @router api/v1/user/address/list [GET]
+func ListAddress(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.ListAddressRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	resp, err := rpc.ListAddressRPC(ctx, &user.ListAddressReq{})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespList(c, resp.Addresses)
+}
+
+// UpdateAddress .
+// @router api/v1/user/address/update [PUT]
+func UpdateAddress(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.UpdateAddressRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	err = rpc.UpdateAddressRPC(ctx, &user.UpdateAddressReq{
+		AddressID:     req.AddressID,
+		Province:      req.Province,
+		City:          req.City,
+		Detail:        req.Detail,
+		RecipientName: req.RecipientName,
+		Phone:         req.Phone,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespSuccess(c)
+}
+
+// DeleteAddress .
+// @router api/v1/user/address/delete [DELETE]
+func DeleteAddress(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.DeleteAddressRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	err = rpc.DeleteAddressRPC(ctx, &user.DeleteAddressReq{AddressID: req.AddressID})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespSuccess(c)
+}
+
+// SetDefaultAddress .
+// @router api/v1/user/address/default [PUT]
+func SetDefaultAddress(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.SetDefaultAddressRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	err = rpc.SetDefaultAddressRPC(ctx, &user.SetDefaultAddressReq{AddressID: req.AddressID})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespSuccess(c)
+}
+
 // BanUser .
 // @router api/v1/user/ban [POST]
 func BanUser(ctx context.Context, c *app.RequestContext) {
 	var err error
-	var req user.BanUserReq
+	var req api.BanUserReq
 	err = c.BindAndValidate(&req)
 	if err != nil {
 		pack.RespError(c, errno.ParamVerifyError.WithError(err))
@@ -142,7 +248,9 @@ func BanUser(ctx context.Context, c *app.RequestContext) {
 	}
 
 	err = rpc.BanUserRPC(ctx, &user.BanUserReq{
-		Uid: req.Uid,
+		Uid:      req.UID,
+		Reason:   req.Reason,
+		Duration: req.Duration,
 	})
 	if err != nil {
 		pack.RespError(c, err)
@@ -151,6 +259,25 @@ func BanUser(ctx context.Context, c *app.RequestContext) {
 	pack.RespSuccess(c)
 }
 
+// ListBanHistory .
+// @router api/v1/user/ban/history [GET]
+func ListBanHistory(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.ListBanHistoryRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	resp, err := rpc.ListBanHistoryRPC(ctx, &user.ListBanHistoryReq{Uid: req.UID})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespList(c, resp.Bans)
+}
+
 // LiftBandUser .
 // @router api/v1/user/lift [POST]
 func LiftBandUser(ctx context.Context, c *app.RequestContext) {
@@ -172,6 +299,27 @@ func LiftBandUser(ctx context.Context, c *app.RequestContext) {
 	pack.RespSuccess(c)
 }
 
+// UnlockUser .
+// @router api/v1/user/unlock [POST]
+func UnlockUser(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.UnlockUserReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	err = rpc.UnlockUserRPC(ctx, &user.UnlockUserReq{
+		Uid: req.UID,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespSuccess(c)
+}
+
 // Logout .
 // @router api/v1/user/logout [POST]
 func Logout(ctx context.Context, c *app.RequestContext) {
@@ -191,6 +339,63 @@ func Logout(ctx context.Context, c *app.RequestContext) {
 	pack.RespSuccess(c)
 }
 
+// ListSessions .
+// @router api/v1/user/session/list [GET]
+func ListSessions(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.ListSessionsRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	resp, err := rpc.ListSessionsRPC(ctx, &user.ListSessionsReq{})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespList(c, resp.Sessions)
+}
+
+// RevokeSession .
+// @router api/v1/user/session/revoke [DELETE]
+func RevokeSession(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.RevokeSessionRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	err = rpc.RevokeSessionRPC(ctx, &user.RevokeSessionReq{SessionID: req.SessionID})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespSuccess(c)
+}
+
+// RevokeAllSessions .
+// @router api/v1/user/session/revoke-all [DELETE]
+func RevokeAllSessions(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.RevokeAllSessionsRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	err = rpc.RevokeAllSessionsRPC(ctx, &user.RevokeAllSessionsReq{})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespSuccess(c)
+}
+
 // SetAdministrator .
 // @router api/v1/user/administrator [POST]
 func SetAdministrator(ctx context.Context, c *app.RequestContext) {
@@ -213,3 +418,553 @@ func SetAdministrator(ctx context.Context, c *app.RequestContext) {
 	}
 	pack.RespSuccess(c)
 }
+
+// CreateShop .
+// @router api/v1/user/shop [POST]
+func CreateShop(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.CreateShopRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	resp := new(api.CreateShopResponse)
+	resp.ShopID, err = rpc.CreateShopRPC(ctx, &user.CreateShopReq{
+		Name:        req.Name,
+		Logo:        req.Logo,
+		Description: req.Description,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, resp.ShopID)
+}
+
+// UpdateShop .
+// @router api/v1/user/shop/update [PUT]
+func UpdateShop(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.UpdateShopRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	err = rpc.UpdateShopRPC(ctx, &user.UpdateShopReq{
+		ShopID:      req.ShopID,
+		Name:        req.Name,
+		Logo:        req.Logo,
+		Description: req.Description,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespSuccess(c)
+}
+
+// CloseShop .
+// @router api/v1/user/shop/close [PUT]
+func CloseShop(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.CloseShopRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	err = rpc.CloseShopRPC(ctx, &user.CloseShopReq{ShopID: req.ShopID})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespSuccess(c)
+}
+
+// GetShop .
+// @router api/v1/user/shop/info [GET]
+func GetShop(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.GetShopRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	resp, err := rpc.GetShopRPC(ctx, &user.GetShopReq{ShopID: req.ShopID})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, resp.Shop)
+}
+
+// AddShopStaff .
+// @router api/v1/user/shop/staff [POST]
+func AddShopStaff(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.AddShopStaffRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	err = rpc.AddShopStaffRPC(ctx, &user.AddShopStaffReq{ShopID: req.ShopID, Uid: req.UID})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespSuccess(c)
+}
+
+// RemoveShopStaff .
+// @router api/v1/user/shop/staff [DELETE]
+func RemoveShopStaff(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.RemoveShopStaffRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	err = rpc.RemoveShopStaffRPC(ctx, &user.RemoveShopStaffReq{ShopID: req.ShopID, Uid: req.UID})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespSuccess(c)
+}
+
+// ListShopStaff .
+// @router api/v1/user/shop/staff/list [GET]
+func ListShopStaff(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.ListShopStaffRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	resp, err := rpc.ListShopStaffRPC(ctx, &user.ListShopStaffReq{ShopID: req.ShopID})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespList(c, resp.Staff)
+}
+
+// UpdateProfile .
+// @router api/v1/user/profile [PUT]
+func UpdateProfile(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.UpdateProfileRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	resp, err := rpc.UpdateProfileRPC(ctx, &user.UpdateProfileReq{
+		Name:     req.Name,
+		Email:    req.Email,
+		Phone:    req.Phone,
+		Password: req.Password,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, resp.User)
+}
+
+// ChangePassword .
+// @router api/v1/user/password [PUT]
+func ChangePassword(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.ChangePasswordRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	err = rpc.ChangePasswordRPC(ctx, &user.ChangePasswordReq{
+		OldPassword: req.OldPassword,
+		Password:    req.NewPassword,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespSuccess(c)
+}
+
+// RequestPasswordReset .
+// @router api/v1/user/password/reset/code [POST]
+func RequestPasswordReset(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.RequestPasswordResetRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	err = rpc.RequestPasswordResetRPC(ctx, &user.RequestPasswordResetReq{Name: req.Name})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespSuccess(c)
+}
+
+// ResetPassword .
+// @router api/v1/user/password/reset [POST]
+func ResetPassword(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.ResetPasswordRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	err = rpc.ResetPasswordRPC(ctx, &user.ResetPasswordReq{
+		Name:     req.Name,
+		Code:     req.Code,
+		Password: req.NewPassword,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespSuccess(c)
+}
+
+// ExportUserData .
+// @router api/v1/user/export [GET]
+func ExportUserData(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.ExportUserDataRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	data, err := rpc.ExportUserDataRPC(ctx, &user.ExportUserDataReq{})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespAttachment(c, constants.UserDataExportFileName, "application/json; charset=utf-8", []byte(data))
+}
+
+// CloseAccount .
+// @router api/v1/user/account/close [POST]
+func CloseAccount(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.CloseAccountRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	err = rpc.CloseAccountRPC(ctx, &user.CloseAccountReq{
+		Password: req.Password,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespSuccess(c)
+}
+
+// EnrollTOTP .
+// @router api/v1/user/totp/enroll [POST]
+func EnrollTOTP(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.EnrollTOTPRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	resp, err := rpc.EnrollTOTPRPC(ctx, &user.EnrollTOTPReq{
+		Password: req.Password,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, resp)
+}
+
+// ConfirmTOTP .
+// @router api/v1/user/totp/confirm [POST]
+func ConfirmTOTP(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.ConfirmTOTPRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	err = rpc.ConfirmTOTPRPC(ctx, &user.ConfirmTOTPReq{
+		Code: req.Code,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespSuccess(c)
+}
+
+// DisableTOTP .
+// @router api/v1/user/totp/disable [POST]
+func DisableTOTP(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.DisableTOTPRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	err = rpc.DisableTOTPRPC(ctx, &user.DisableTOTPReq{
+		Password: req.Password,
+		Code:     req.Code,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespSuccess(c)
+}
+
+// CreateApiKey .
+// @router api/v1/user/apikey [POST]
+func CreateApiKey(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.CreateApiKeyRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	resp, err := rpc.CreateApiKeyRPC(ctx, &user.CreateApiKeyReq{
+		Name:   req.Name,
+		Scopes: req.Scopes,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, resp)
+}
+
+// ListApiKeys .
+// @router api/v1/user/apikey/list [GET]
+func ListApiKeys(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.ListApiKeysRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	resp, err := rpc.ListApiKeysRPC(ctx, &user.ListApiKeysReq{})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespList(c, resp.Keys)
+}
+
+// RevokeApiKey .
+// @router api/v1/user/apikey [DELETE]
+func RevokeApiKey(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.RevokeApiKeyRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	err = rpc.RevokeApiKeyRPC(ctx, &user.RevokeApiKeyReq{
+		KeyID: req.KeyID,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespSuccess(c)
+}
+
+// ListAuditLogs .
+// @router api/v1/user/audit/list [GET]
+func ListAuditLogs(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.ListAuditLogsRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	resp, err := rpc.ListAuditLogsRPC(ctx, &user.ListAuditLogsReq{
+		ActorID:   req.ActorID,
+		Action:    req.Action,
+		StartTime: req.StartTime,
+		EndTime:   req.EndTime,
+		PageNum:   req.PageNum,
+		PageSize:  req.PageSize,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, resp)
+}
+
+// CreateWebhookEndpoint .
+// @router api/v1/user/shop/webhook [POST]
+func CreateWebhookEndpoint(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.CreateWebhookEndpointRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	resp, err := rpc.CreateWebhookEndpointRPC(ctx, &user.CreateWebhookEndpointReq{
+		ShopID:     req.ShopID,
+		Url:        req.URL,
+		EventTypes: req.EventTypes,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, resp)
+}
+
+// ListWebhookEndpoints .
+// @router api/v1/user/shop/webhook/list [GET]
+func ListWebhookEndpoints(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.ListWebhookEndpointsRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	resp, err := rpc.ListWebhookEndpointsRPC(ctx, &user.ListWebhookEndpointsReq{ShopID: req.ShopID})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespList(c, resp.Endpoints)
+}
+
+// UpdateWebhookEndpoint .
+// @router api/v1/user/shop/webhook/update [PUT]
+func UpdateWebhookEndpoint(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.UpdateWebhookEndpointRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	err = rpc.UpdateWebhookEndpointRPC(ctx, &user.UpdateWebhookEndpointReq{
+		EndpointID: req.EndpointID,
+		Url:        req.URL,
+		EventTypes: req.EventTypes,
+		Enabled:    req.Enabled,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespSuccess(c)
+}
+
+// DeleteWebhookEndpoint .
+// @router api/v1/user/shop/webhook [DELETE]
+func DeleteWebhookEndpoint(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.DeleteWebhookEndpointRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	err = rpc.DeleteWebhookEndpointRPC(ctx, &user.DeleteWebhookEndpointReq{EndpointID: req.EndpointID})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespSuccess(c)
+}
+
+// ListWebhookDeliveries .
+// @router api/v1/user/shop/webhook/delivery/list [GET]
+func ListWebhookDeliveries(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.ListWebhookDeliveriesRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	resp, err := rpc.ListWebhookDeliveriesRPC(ctx, &user.ListWebhookDeliveriesReq{
+		EndpointID: req.EndpointID,
+		PageNum:    req.PageNum,
+		PageSize:   req.PageSize,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, resp)
+}
+
+// RedeliverWebhook .
+// @router api/v1/user/shop/webhook/delivery/redeliver [POST]
+func RedeliverWebhook(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.RedeliverWebhookRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	resp, err := rpc.RedeliverWebhookRPC(ctx, &user.RedeliverWebhookReq{DeliveryID: req.DeliveryID})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, resp.Delivery)
+}
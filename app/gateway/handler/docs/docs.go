@@ -0,0 +1,45 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docs
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+// openapi.json 由 make openapi 根据 idl/api 生成, index.html 会从 constants.OpenAPIPath 加载它
+var (
+	//go:embed openapi.json
+	openAPISpec []byte
+	//go:embed index.html
+	indexPage []byte
+)
+
+// OpenAPI 返回网关的 OpenAPI 3 文档
+// @router /openapi.json [GET]
+func OpenAPI(ctx context.Context, c *app.RequestContext) {
+	c.Data(consts.StatusOK, "application/json; charset=utf-8", openAPISpec)
+}
+
+// Index 返回本地的接口文档页面
+// @router /docs [GET]
+func Index(ctx context.Context, c *app.RequestContext) {
+	c.Data(consts.StatusOK, "text/html; charset=utf-8", indexPage)
+}
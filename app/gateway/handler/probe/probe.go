@@ -0,0 +1,39 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package probe
+
+import (
+	"context"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+
+	"github.com/west2-online/DomTok/pkg/health"
+)
+
+// Healthz 存活检查, 网关能处理请求即可
+// @router /healthz [GET]
+func Healthz(ctx context.Context, c *app.RequestContext) {
+	c.JSON(consts.StatusOK, &health.Report{Status: health.StatusUp, Checks: map[string]health.Result{}})
+}
+
+// Readyz 就绪检查, 返回每个依赖的状态和耗时, 任意依赖不可用时返回 503
+// @router /readyz [GET]
+func Readyz(ctx context.Context, c *app.RequestContext) {
+	report := health.Check(ctx)
+	c.JSON(report.StatusCode(), report)
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package push
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/hertz-contrib/websocket"
+
+	"github.com/west2-online/DomTok/app/gateway/mw"
+	"github.com/west2-online/DomTok/app/gateway/pack"
+	metainfoContext "github.com/west2-online/DomTok/pkg/base/context"
+	"github.com/west2-online/DomTok/pkg/constants"
+	"github.com/west2-online/DomTok/pkg/errno"
+	"github.com/west2-online/DomTok/pkg/logger"
+)
+
+var upgrader = websocket.HertzUpgrader{}
+
+// Entrypoint 建立订单和退款状态的推送连接, 连接只下发事件, 不接收业务消息
+// 推送不保证送达, 客户端重连后需要重新查询订单状态
+// @router /api/v1/order/push [GET]
+func Entrypoint(ctx context.Context, c *app.RequestContext) {
+	uid, err := metainfoContext.GetLoginData(ctx)
+	if err != nil {
+		pack.RespError(c, errno.AuthNoToken)
+		return
+	}
+	ch, cancel, ok := mw.GateWayService.Push.Subscribe(uid)
+	if !ok {
+		pack.RespError(c, errno.TooManyRequests)
+		return
+	}
+
+	err = upgrader.Upgrade(c, func(conn *websocket.Conn) {
+		defer cancel()
+		serve(conn, ch)
+	})
+	if err != nil {
+		// 升级失败时不会执行上面的回调, 需要在这里注销
+		cancel()
+		logger.Errorf("gateway: upgrade push connection of user %d failed: %v", uid, err)
+	}
+}
+
+// serve 持续把事件写给客户端, 并定时发送 ping 检测连接是否存活
+func serve(conn *websocket.Conn, ch <-chan []byte) {
+	closed := make(chan struct{})
+	go readLoop(conn, closed)
+
+	ticker := time.NewTicker(constants.PushPingInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case payload := <-ch:
+			_ = conn.SetWriteDeadline(time.Now().Add(constants.PushWriteTimeout))
+			if err := conn.WriteMessage(websocket.TextMessage, payload); err != nil {
+				return
+			}
+		case <-ticker.C:
+			deadline := time.Now().Add(constants.PushWriteTimeout)
+			if err := conn.WriteControl(websocket.PingMessage, nil, deadline); err != nil {
+				return
+			}
+		case <-closed:
+			return
+		}
+	}
+}
+
+// readLoop 只用于处理 pong 和关闭帧, 读取出错说明连接已经断开
+func readLoop(conn *websocket.Conn, closed chan<- struct{}) {
+	defer close(closed)
+	conn.SetReadLimit(constants.PushReadLimit)
+	_ = conn.SetReadDeadline(time.Now().Add(constants.PushPongTimeout))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(constants.PushPongTimeout))
+	})
+	for {
+		if _, _, err := conn.ReadMessage(); err != nil {
+			return
+		}
+	}
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wellknown
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+
+	"github.com/west2-online/DomTok/app/gateway/pack"
+	"github.com/west2-online/DomTok/pkg/constants"
+	"github.com/west2-online/DomTok/pkg/utils"
+)
+
+// JWKSet 是 RFC 7517 中定义的 JWK Set, 为了兼容通用的 jwt 库, 不使用统一的响应格式
+type JWKSet struct {
+	Keys []utils.JWK `json:"keys"`
+}
+
+// JWKS 公开所有可以用于校验 token 的公钥, 其他组件可以据此自行校验 token
+// @router /.well-known/jwks.json [GET]
+func JWKS(ctx context.Context, c *app.RequestContext) {
+	keys, err := utils.JWKS()
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	c.Header("Cache-Control", fmt.Sprintf("public, max-age=%d", int(constants.JWKSCacheAge.Seconds())))
+	c.JSON(consts.StatusOK, JWKSet{Keys: keys})
+}
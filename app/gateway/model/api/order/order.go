@@ -2755,6 +2755,1449 @@ func (p *DeleteOrderResp) String() string {
 
 }
 
+type ViewOrderDetailReq struct {
+	OrderID int64 `thrift:"orderID,1,required" form:"orderID,required" json:"orderID,required" query:"orderID,required"`
+}
+
+func NewViewOrderDetailReq() *ViewOrderDetailReq {
+	return &ViewOrderDetailReq{}
+}
+
+func (p *ViewOrderDetailReq) InitDefault() {
+}
+
+func (p *ViewOrderDetailReq) GetOrderID() (v int64) {
+	return p.OrderID
+}
+
+var fieldIDToName_ViewOrderDetailReq = map[int16]string{
+	1: "orderID",
+}
+
+func (p *ViewOrderDetailReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetOrderID bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetOrderID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetOrderID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ViewOrderDetailReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ViewOrderDetailReq[fieldId]))
+}
+
+func (p *ViewOrderDetailReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.OrderID = _field
+	return nil
+}
+
+func (p *ViewOrderDetailReq) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ViewOrderDetailReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ViewOrderDetailReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("orderID", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.OrderID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ViewOrderDetailReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ViewOrderDetailReq(%+v)", *p)
+
+}
+
+// 聚合接口中单个分区的错误, 分区失败不影响其他分区的返回
+type SectionError struct {
+	Code int64  `thrift:"code,1,required" form:"code,required" json:"code,required" query:"code,required"`
+	Msg  string `thrift:"msg,2,required" form:"msg,required" json:"msg,required" query:"msg,required"`
+}
+
+func NewSectionError() *SectionError {
+	return &SectionError{}
+}
+
+func (p *SectionError) InitDefault() {
+}
+
+func (p *SectionError) GetCode() (v int64) {
+	return p.Code
+}
+
+func (p *SectionError) GetMsg() (v string) {
+	return p.Msg
+}
+
+var fieldIDToName_SectionError = map[int16]string{
+	1: "code",
+	2: "msg",
+}
+
+func (p *SectionError) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetCode bool = false
+	var issetMsg bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCode = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetMsg = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetCode {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetMsg {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SectionError[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_SectionError[fieldId]))
+}
+
+func (p *SectionError) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Code = _field
+	return nil
+}
+func (p *SectionError) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Msg = _field
+	return nil
+}
+
+func (p *SectionError) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SectionError"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SectionError) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("code", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.Code); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SectionError) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Msg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *SectionError) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SectionError(%+v)", *p)
+
+}
+
+type OrderAddressSection struct {
+	// 收货地址
+	Address *model.AddressInfo `thrift:"address,1,optional" form:"address" json:"address,omitempty" query:"address"`
+	Error   *SectionError      `thrift:"error,2,optional" form:"error" json:"error,omitempty" query:"error"`
+}
+
+func NewOrderAddressSection() *OrderAddressSection {
+	return &OrderAddressSection{}
+}
+
+func (p *OrderAddressSection) InitDefault() {
+}
+
+var OrderAddressSection_Address_DEFAULT *model.AddressInfo
+
+func (p *OrderAddressSection) GetAddress() (v *model.AddressInfo) {
+	if !p.IsSetAddress() {
+		return OrderAddressSection_Address_DEFAULT
+	}
+	return p.Address
+}
+
+var OrderAddressSection_Error_DEFAULT *SectionError
+
+func (p *OrderAddressSection) GetError() (v *SectionError) {
+	if !p.IsSetError() {
+		return OrderAddressSection_Error_DEFAULT
+	}
+	return p.Error
+}
+
+var fieldIDToName_OrderAddressSection = map[int16]string{
+	1: "address",
+	2: "error",
+}
+
+func (p *OrderAddressSection) IsSetAddress() bool {
+	return p.Address != nil
+}
+
+func (p *OrderAddressSection) IsSetError() bool {
+	return p.Error != nil
+}
+
+func (p *OrderAddressSection) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderAddressSection[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *OrderAddressSection) ReadField1(iprot thrift.TProtocol) error {
+	_field := model.NewAddressInfo()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Address = _field
+	return nil
+}
+func (p *OrderAddressSection) ReadField2(iprot thrift.TProtocol) error {
+	_field := NewSectionError()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Error = _field
+	return nil
+}
+
+func (p *OrderAddressSection) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("OrderAddressSection"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *OrderAddressSection) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAddress() {
+		if err = oprot.WriteFieldBegin("address", thrift.STRUCT, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Address.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *OrderAddressSection) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetError() {
+		if err = oprot.WriteFieldBegin("error", thrift.STRUCT, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Error.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *OrderAddressSection) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderAddressSection(%+v)", *p)
+
+}
+
+type OrderPaymentSection struct {
+	// 支付单, 尚未发起支付时为空
+	Payment *model.PaymentStatusInfo `thrift:"payment,1,optional" form:"payment" json:"payment,omitempty" query:"payment"`
+	// 退款单, 没有退款时为空
+	Refund *model.RefundStatusInfo `thrift:"refund,2,optional" form:"refund" json:"refund,omitempty" query:"refund"`
+	Error  *SectionError           `thrift:"error,3,optional" form:"error" json:"error,omitempty" query:"error"`
+}
+
+func NewOrderPaymentSection() *OrderPaymentSection {
+	return &OrderPaymentSection{}
+}
+
+func (p *OrderPaymentSection) InitDefault() {
+}
+
+var OrderPaymentSection_Payment_DEFAULT *model.PaymentStatusInfo
+
+func (p *OrderPaymentSection) GetPayment() (v *model.PaymentStatusInfo) {
+	if !p.IsSetPayment() {
+		return OrderPaymentSection_Payment_DEFAULT
+	}
+	return p.Payment
+}
+
+var OrderPaymentSection_Refund_DEFAULT *model.RefundStatusInfo
+
+func (p *OrderPaymentSection) GetRefund() (v *model.RefundStatusInfo) {
+	if !p.IsSetRefund() {
+		return OrderPaymentSection_Refund_DEFAULT
+	}
+	return p.Refund
+}
+
+var OrderPaymentSection_Error_DEFAULT *SectionError
+
+func (p *OrderPaymentSection) GetError() (v *SectionError) {
+	if !p.IsSetError() {
+		return OrderPaymentSection_Error_DEFAULT
+	}
+	return p.Error
+}
+
+var fieldIDToName_OrderPaymentSection = map[int16]string{
+	1: "payment",
+	2: "refund",
+	3: "error",
+}
+
+func (p *OrderPaymentSection) IsSetPayment() bool {
+	return p.Payment != nil
+}
+
+func (p *OrderPaymentSection) IsSetRefund() bool {
+	return p.Refund != nil
+}
+
+func (p *OrderPaymentSection) IsSetError() bool {
+	return p.Error != nil
+}
+
+func (p *OrderPaymentSection) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderPaymentSection[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *OrderPaymentSection) ReadField1(iprot thrift.TProtocol) error {
+	_field := model.NewPaymentStatusInfo()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Payment = _field
+	return nil
+}
+func (p *OrderPaymentSection) ReadField2(iprot thrift.TProtocol) error {
+	_field := model.NewRefundStatusInfo()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Refund = _field
+	return nil
+}
+func (p *OrderPaymentSection) ReadField3(iprot thrift.TProtocol) error {
+	_field := NewSectionError()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Error = _field
+	return nil
+}
+
+func (p *OrderPaymentSection) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("OrderPaymentSection"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *OrderPaymentSection) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPayment() {
+		if err = oprot.WriteFieldBegin("payment", thrift.STRUCT, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Payment.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *OrderPaymentSection) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRefund() {
+		if err = oprot.WriteFieldBegin("refund", thrift.STRUCT, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Refund.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *OrderPaymentSection) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetError() {
+		if err = oprot.WriteFieldBegin("error", thrift.STRUCT, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Error.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *OrderPaymentSection) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderPaymentSection(%+v)", *p)
+
+}
+
+type OrderSkuImageSection struct {
+	// 即订单商品的 styleId
+	SkuID  int64             `thrift:"skuID,1,required" form:"skuID,required" json:"skuID,required" query:"skuID,required"`
+	Images []*model.SkuImage `thrift:"images,2,optional" form:"images" json:"images,omitempty" query:"images"`
+	Error  *SectionError     `thrift:"error,3,optional" form:"error" json:"error,omitempty" query:"error"`
+}
+
+func NewOrderSkuImageSection() *OrderSkuImageSection {
+	return &OrderSkuImageSection{}
+}
+
+func (p *OrderSkuImageSection) InitDefault() {
+}
+
+func (p *OrderSkuImageSection) GetSkuID() (v int64) {
+	return p.SkuID
+}
+
+var OrderSkuImageSection_Images_DEFAULT []*model.SkuImage
+
+func (p *OrderSkuImageSection) GetImages() (v []*model.SkuImage) {
+	if !p.IsSetImages() {
+		return OrderSkuImageSection_Images_DEFAULT
+	}
+	return p.Images
+}
+
+var OrderSkuImageSection_Error_DEFAULT *SectionError
+
+func (p *OrderSkuImageSection) GetError() (v *SectionError) {
+	if !p.IsSetError() {
+		return OrderSkuImageSection_Error_DEFAULT
+	}
+	return p.Error
+}
+
+var fieldIDToName_OrderSkuImageSection = map[int16]string{
+	1: "skuID",
+	2: "images",
+	3: "error",
+}
+
+func (p *OrderSkuImageSection) IsSetImages() bool {
+	return p.Images != nil
+}
+
+func (p *OrderSkuImageSection) IsSetError() bool {
+	return p.Error != nil
+}
+
+func (p *OrderSkuImageSection) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetSkuID bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSkuID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetSkuID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderSkuImageSection[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_OrderSkuImageSection[fieldId]))
+}
+
+func (p *OrderSkuImageSection) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.SkuID = _field
+	return nil
+}
+func (p *OrderSkuImageSection) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*model.SkuImage, 0, size)
+	values := make([]model.SkuImage, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Images = _field
+	return nil
+}
+func (p *OrderSkuImageSection) ReadField3(iprot thrift.TProtocol) error {
+	_field := NewSectionError()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Error = _field
+	return nil
+}
+
+func (p *OrderSkuImageSection) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("OrderSkuImageSection"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *OrderSkuImageSection) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("skuID", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.SkuID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *OrderSkuImageSection) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetImages() {
+		if err = oprot.WriteFieldBegin("images", thrift.LIST, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Images)); err != nil {
+			return err
+		}
+		for _, v := range p.Images {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *OrderSkuImageSection) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetError() {
+		if err = oprot.WriteFieldBegin("error", thrift.STRUCT, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Error.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *OrderSkuImageSection) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderSkuImageSection(%+v)", *p)
+
+}
+
+type ViewOrderDetailResp struct {
+	// 订单本身查询失败时整个接口返回错误
+	Order     *model.OrderWithGoods   `thrift:"order,1,required" form:"order,required" json:"order,required" query:"order,required"`
+	Address   *OrderAddressSection    `thrift:"address,2,required" form:"address,required" json:"address,required" query:"address,required"`
+	Payment   *OrderPaymentSection    `thrift:"payment,3,required" form:"payment,required" json:"payment,required" query:"payment,required"`
+	SkuImages []*OrderSkuImageSection `thrift:"skuImages,4,required" form:"skuImages,required" json:"skuImages,required" query:"skuImages,required"`
+}
+
+func NewViewOrderDetailResp() *ViewOrderDetailResp {
+	return &ViewOrderDetailResp{}
+}
+
+func (p *ViewOrderDetailResp) InitDefault() {
+}
+
+var ViewOrderDetailResp_Order_DEFAULT *model.OrderWithGoods
+
+func (p *ViewOrderDetailResp) GetOrder() (v *model.OrderWithGoods) {
+	if !p.IsSetOrder() {
+		return ViewOrderDetailResp_Order_DEFAULT
+	}
+	return p.Order
+}
+
+var ViewOrderDetailResp_Address_DEFAULT *OrderAddressSection
+
+func (p *ViewOrderDetailResp) GetAddress() (v *OrderAddressSection) {
+	if !p.IsSetAddress() {
+		return ViewOrderDetailResp_Address_DEFAULT
+	}
+	return p.Address
+}
+
+var ViewOrderDetailResp_Payment_DEFAULT *OrderPaymentSection
+
+func (p *ViewOrderDetailResp) GetPayment() (v *OrderPaymentSection) {
+	if !p.IsSetPayment() {
+		return ViewOrderDetailResp_Payment_DEFAULT
+	}
+	return p.Payment
+}
+
+func (p *ViewOrderDetailResp) GetSkuImages() (v []*OrderSkuImageSection) {
+	return p.SkuImages
+}
+
+var fieldIDToName_ViewOrderDetailResp = map[int16]string{
+	1: "order",
+	2: "address",
+	3: "payment",
+	4: "skuImages",
+}
+
+func (p *ViewOrderDetailResp) IsSetOrder() bool {
+	return p.Order != nil
+}
+
+func (p *ViewOrderDetailResp) IsSetAddress() bool {
+	return p.Address != nil
+}
+
+func (p *ViewOrderDetailResp) IsSetPayment() bool {
+	return p.Payment != nil
+}
+
+func (p *ViewOrderDetailResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetOrder bool = false
+	var issetAddress bool = false
+	var issetPayment bool = false
+	var issetSkuImages bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetOrder = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetAddress = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetPayment = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSkuImages = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetOrder {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetAddress {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetPayment {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetSkuImages {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ViewOrderDetailResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ViewOrderDetailResp[fieldId]))
+}
+
+func (p *ViewOrderDetailResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := model.NewOrderWithGoods()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Order = _field
+	return nil
+}
+func (p *ViewOrderDetailResp) ReadField2(iprot thrift.TProtocol) error {
+	_field := NewOrderAddressSection()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Address = _field
+	return nil
+}
+func (p *ViewOrderDetailResp) ReadField3(iprot thrift.TProtocol) error {
+	_field := NewOrderPaymentSection()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Payment = _field
+	return nil
+}
+func (p *ViewOrderDetailResp) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*OrderSkuImageSection, 0, size)
+	values := make([]OrderSkuImageSection, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.SkuImages = _field
+	return nil
+}
+
+func (p *ViewOrderDetailResp) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ViewOrderDetailResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ViewOrderDetailResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("order", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Order.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ViewOrderDetailResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("address", thrift.STRUCT, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Address.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ViewOrderDetailResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("payment", thrift.STRUCT, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Payment.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *ViewOrderDetailResp) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("skuImages", thrift.LIST, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.SkuImages)); err != nil {
+		return err
+	}
+	for _, v := range p.SkuImages {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *ViewOrderDetailResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ViewOrderDetailResp(%+v)", *p)
+
+}
+
 type OrderService interface {
 	CreateOrder(ctx context.Context, req *CreateOrderReq) (r *CreateOrderResp, err error)
 
@@ -2762,6 +4205,8 @@ type OrderService interface {
 
 	ViewOrder(ctx context.Context, req *ViewOrderReq) (r *ViewOrderResp, err error)
 
+	ViewOrderDetail(ctx context.Context, req *ViewOrderDetailReq) (r *ViewOrderDetailResp, err error)
+
 	CancelOrder(ctx context.Context, req *CancelOrderReq) (r *CancelOrderResp, err error)
 
 	ChangeDeliverAddress(ctx context.Context, req *ChangeDeliverAddressReq) (r *ChangeDeliverAddressResp, err error)
@@ -2822,6 +4267,15 @@ func (p *OrderServiceClient) ViewOrder(ctx context.Context, req *ViewOrderReq) (
 	}
 	return _result.GetSuccess(), nil
 }
+func (p *OrderServiceClient) ViewOrderDetail(ctx context.Context, req *ViewOrderDetailReq) (r *ViewOrderDetailResp, err error) {
+	var _args OrderServiceViewOrderDetailArgs
+	_args.Req = req
+	var _result OrderServiceViewOrderDetailResult
+	if err = p.Client_().Call(ctx, "ViewOrderDetail", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
 func (p *OrderServiceClient) CancelOrder(ctx context.Context, req *CancelOrderReq) (r *CancelOrderResp, err error) {
 	var _args OrderServiceCancelOrderArgs
 	_args.Req = req
@@ -2873,6 +4327,7 @@ func NewOrderServiceProcessor(handler OrderService) *OrderServiceProcessor {
 	self.AddToProcessorMap("CreateOrder", &orderServiceProcessorCreateOrder{handler: handler})
 	self.AddToProcessorMap("ViewOrderList", &orderServiceProcessorViewOrderList{handler: handler})
 	self.AddToProcessorMap("ViewOrder", &orderServiceProcessorViewOrder{handler: handler})
+	self.AddToProcessorMap("ViewOrderDetail", &orderServiceProcessorViewOrderDetail{handler: handler})
 	self.AddToProcessorMap("CancelOrder", &orderServiceProcessorCancelOrder{handler: handler})
 	self.AddToProcessorMap("ChangeDeliverAddress", &orderServiceProcessorChangeDeliverAddress{handler: handler})
 	self.AddToProcessorMap("DeleteOrder", &orderServiceProcessorDeleteOrder{handler: handler})
@@ -3022,7 +4477,55 @@ func (p *orderServiceProcessorViewOrder) Process(ctx context.Context, seqId int3
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("ViewOrder", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("ViewOrder", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type orderServiceProcessorViewOrderDetail struct {
+	handler OrderService
+}
+
+func (p *orderServiceProcessorViewOrderDetail) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := OrderServiceViewOrderDetailArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ViewOrderDetail", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := OrderServiceViewOrderDetailResult{}
+	var retval *ViewOrderDetailResp
+	if retval, err2 = p.handler.ViewOrderDetail(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ViewOrderDetail: "+err2.Error())
+		oprot.WriteMessageBegin("ViewOrderDetail", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ViewOrderDetail", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -3166,53 +4669,349 @@ func (p *orderServiceProcessorDeleteOrder) Process(ctx context.Context, seqId in
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("DeleteOrder", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if err2 = oprot.WriteMessageBegin("DeleteOrder", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type OrderServiceCreateOrderArgs struct {
+	Req *CreateOrderReq `thrift:"req,1"`
+}
+
+func NewOrderServiceCreateOrderArgs() *OrderServiceCreateOrderArgs {
+	return &OrderServiceCreateOrderArgs{}
+}
+
+func (p *OrderServiceCreateOrderArgs) InitDefault() {
+}
+
+var OrderServiceCreateOrderArgs_Req_DEFAULT *CreateOrderReq
+
+func (p *OrderServiceCreateOrderArgs) GetReq() (v *CreateOrderReq) {
+	if !p.IsSetReq() {
+		return OrderServiceCreateOrderArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_OrderServiceCreateOrderArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *OrderServiceCreateOrderArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *OrderServiceCreateOrderArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceCreateOrderArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *OrderServiceCreateOrderArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewCreateOrderReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *OrderServiceCreateOrderArgs) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateOrder_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *OrderServiceCreateOrderArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *OrderServiceCreateOrderArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceCreateOrderArgs(%+v)", *p)
+
+}
+
+type OrderServiceCreateOrderResult struct {
+	Success *CreateOrderResp `thrift:"success,0,optional"`
+}
+
+func NewOrderServiceCreateOrderResult() *OrderServiceCreateOrderResult {
+	return &OrderServiceCreateOrderResult{}
+}
+
+func (p *OrderServiceCreateOrderResult) InitDefault() {
+}
+
+var OrderServiceCreateOrderResult_Success_DEFAULT *CreateOrderResp
+
+func (p *OrderServiceCreateOrderResult) GetSuccess() (v *CreateOrderResp) {
+	if !p.IsSetSuccess() {
+		return OrderServiceCreateOrderResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_OrderServiceCreateOrderResult = map[int16]string{
+	0: "success",
+}
+
+func (p *OrderServiceCreateOrderResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *OrderServiceCreateOrderResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceCreateOrderResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *OrderServiceCreateOrderResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewCreateOrderResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *OrderServiceCreateOrderResult) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateOrder_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err != nil {
-		return
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *OrderServiceCreateOrderResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	return true, err
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-type OrderServiceCreateOrderArgs struct {
-	Req *CreateOrderReq `thrift:"req,1"`
+func (p *OrderServiceCreateOrderResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceCreateOrderResult(%+v)", *p)
+
 }
 
-func NewOrderServiceCreateOrderArgs() *OrderServiceCreateOrderArgs {
-	return &OrderServiceCreateOrderArgs{}
+type OrderServiceViewOrderListArgs struct {
+	Req *ViewOrderListReq `thrift:"req,1"`
 }
 
-func (p *OrderServiceCreateOrderArgs) InitDefault() {
+func NewOrderServiceViewOrderListArgs() *OrderServiceViewOrderListArgs {
+	return &OrderServiceViewOrderListArgs{}
 }
 
-var OrderServiceCreateOrderArgs_Req_DEFAULT *CreateOrderReq
+func (p *OrderServiceViewOrderListArgs) InitDefault() {
+}
 
-func (p *OrderServiceCreateOrderArgs) GetReq() (v *CreateOrderReq) {
+var OrderServiceViewOrderListArgs_Req_DEFAULT *ViewOrderListReq
+
+func (p *OrderServiceViewOrderListArgs) GetReq() (v *ViewOrderListReq) {
 	if !p.IsSetReq() {
-		return OrderServiceCreateOrderArgs_Req_DEFAULT
+		return OrderServiceViewOrderListArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_OrderServiceCreateOrderArgs = map[int16]string{
+var fieldIDToName_OrderServiceViewOrderListArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *OrderServiceCreateOrderArgs) IsSetReq() bool {
+func (p *OrderServiceViewOrderListArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *OrderServiceCreateOrderArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *OrderServiceViewOrderListArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3258,7 +5057,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceCreateOrderArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceViewOrderListArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3268,8 +5067,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *OrderServiceCreateOrderArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewCreateOrderReq()
+func (p *OrderServiceViewOrderListArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewViewOrderListReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -3277,10 +5076,10 @@ func (p *OrderServiceCreateOrderArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *OrderServiceCreateOrderArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *OrderServiceViewOrderListArgs) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreateOrder_args"); err != nil {
+	if err = oprot.WriteStructBegin("ViewOrderList_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3306,7 +5105,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *OrderServiceCreateOrderArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *OrderServiceViewOrderListArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -3323,43 +5122,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *OrderServiceCreateOrderArgs) String() string {
+func (p *OrderServiceViewOrderListArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OrderServiceCreateOrderArgs(%+v)", *p)
+	return fmt.Sprintf("OrderServiceViewOrderListArgs(%+v)", *p)
 
 }
 
-type OrderServiceCreateOrderResult struct {
-	Success *CreateOrderResp `thrift:"success,0,optional"`
+type OrderServiceViewOrderListResult struct {
+	Success *ViewOrderListResp `thrift:"success,0,optional"`
 }
 
-func NewOrderServiceCreateOrderResult() *OrderServiceCreateOrderResult {
-	return &OrderServiceCreateOrderResult{}
+func NewOrderServiceViewOrderListResult() *OrderServiceViewOrderListResult {
+	return &OrderServiceViewOrderListResult{}
 }
 
-func (p *OrderServiceCreateOrderResult) InitDefault() {
+func (p *OrderServiceViewOrderListResult) InitDefault() {
 }
 
-var OrderServiceCreateOrderResult_Success_DEFAULT *CreateOrderResp
+var OrderServiceViewOrderListResult_Success_DEFAULT *ViewOrderListResp
 
-func (p *OrderServiceCreateOrderResult) GetSuccess() (v *CreateOrderResp) {
+func (p *OrderServiceViewOrderListResult) GetSuccess() (v *ViewOrderListResp) {
 	if !p.IsSetSuccess() {
-		return OrderServiceCreateOrderResult_Success_DEFAULT
+		return OrderServiceViewOrderListResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_OrderServiceCreateOrderResult = map[int16]string{
+var fieldIDToName_OrderServiceViewOrderListResult = map[int16]string{
 	0: "success",
 }
 
-func (p *OrderServiceCreateOrderResult) IsSetSuccess() bool {
+func (p *OrderServiceViewOrderListResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *OrderServiceCreateOrderResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *OrderServiceViewOrderListResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3405,7 +5204,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceCreateOrderResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceViewOrderListResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3415,8 +5214,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *OrderServiceCreateOrderResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewCreateOrderResp()
+func (p *OrderServiceViewOrderListResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewViewOrderListResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -3424,10 +5223,10 @@ func (p *OrderServiceCreateOrderResult) ReadField0(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *OrderServiceCreateOrderResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *OrderServiceViewOrderListResult) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreateOrder_result"); err != nil {
+	if err = oprot.WriteStructBegin("ViewOrderList_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3453,7 +5252,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *OrderServiceCreateOrderResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *OrderServiceViewOrderListResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -3472,43 +5271,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *OrderServiceCreateOrderResult) String() string {
+func (p *OrderServiceViewOrderListResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OrderServiceCreateOrderResult(%+v)", *p)
+	return fmt.Sprintf("OrderServiceViewOrderListResult(%+v)", *p)
 
 }
 
-type OrderServiceViewOrderListArgs struct {
-	Req *ViewOrderListReq `thrift:"req,1"`
+type OrderServiceViewOrderArgs struct {
+	Req *ViewOrderReq `thrift:"req,1"`
 }
 
-func NewOrderServiceViewOrderListArgs() *OrderServiceViewOrderListArgs {
-	return &OrderServiceViewOrderListArgs{}
+func NewOrderServiceViewOrderArgs() *OrderServiceViewOrderArgs {
+	return &OrderServiceViewOrderArgs{}
 }
 
-func (p *OrderServiceViewOrderListArgs) InitDefault() {
+func (p *OrderServiceViewOrderArgs) InitDefault() {
 }
 
-var OrderServiceViewOrderListArgs_Req_DEFAULT *ViewOrderListReq
+var OrderServiceViewOrderArgs_Req_DEFAULT *ViewOrderReq
 
-func (p *OrderServiceViewOrderListArgs) GetReq() (v *ViewOrderListReq) {
+func (p *OrderServiceViewOrderArgs) GetReq() (v *ViewOrderReq) {
 	if !p.IsSetReq() {
-		return OrderServiceViewOrderListArgs_Req_DEFAULT
+		return OrderServiceViewOrderArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_OrderServiceViewOrderListArgs = map[int16]string{
+var fieldIDToName_OrderServiceViewOrderArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *OrderServiceViewOrderListArgs) IsSetReq() bool {
+func (p *OrderServiceViewOrderArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *OrderServiceViewOrderListArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *OrderServiceViewOrderArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3554,7 +5353,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceViewOrderListArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceViewOrderArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3564,8 +5363,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *OrderServiceViewOrderListArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewViewOrderListReq()
+func (p *OrderServiceViewOrderArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewViewOrderReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -3573,10 +5372,10 @@ func (p *OrderServiceViewOrderListArgs) ReadField1(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *OrderServiceViewOrderListArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *OrderServiceViewOrderArgs) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ViewOrderList_args"); err != nil {
+	if err = oprot.WriteStructBegin("ViewOrder_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3602,7 +5401,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *OrderServiceViewOrderListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *OrderServiceViewOrderArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -3619,43 +5418,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *OrderServiceViewOrderListArgs) String() string {
+func (p *OrderServiceViewOrderArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OrderServiceViewOrderListArgs(%+v)", *p)
+	return fmt.Sprintf("OrderServiceViewOrderArgs(%+v)", *p)
 
 }
 
-type OrderServiceViewOrderListResult struct {
-	Success *ViewOrderListResp `thrift:"success,0,optional"`
+type OrderServiceViewOrderResult struct {
+	Success *ViewOrderResp `thrift:"success,0,optional"`
 }
 
-func NewOrderServiceViewOrderListResult() *OrderServiceViewOrderListResult {
-	return &OrderServiceViewOrderListResult{}
+func NewOrderServiceViewOrderResult() *OrderServiceViewOrderResult {
+	return &OrderServiceViewOrderResult{}
 }
 
-func (p *OrderServiceViewOrderListResult) InitDefault() {
+func (p *OrderServiceViewOrderResult) InitDefault() {
 }
 
-var OrderServiceViewOrderListResult_Success_DEFAULT *ViewOrderListResp
+var OrderServiceViewOrderResult_Success_DEFAULT *ViewOrderResp
 
-func (p *OrderServiceViewOrderListResult) GetSuccess() (v *ViewOrderListResp) {
+func (p *OrderServiceViewOrderResult) GetSuccess() (v *ViewOrderResp) {
 	if !p.IsSetSuccess() {
-		return OrderServiceViewOrderListResult_Success_DEFAULT
+		return OrderServiceViewOrderResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_OrderServiceViewOrderListResult = map[int16]string{
+var fieldIDToName_OrderServiceViewOrderResult = map[int16]string{
 	0: "success",
 }
 
-func (p *OrderServiceViewOrderListResult) IsSetSuccess() bool {
+func (p *OrderServiceViewOrderResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *OrderServiceViewOrderListResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *OrderServiceViewOrderResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3701,7 +5500,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceViewOrderListResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceViewOrderResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3711,8 +5510,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *OrderServiceViewOrderListResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewViewOrderListResp()
+func (p *OrderServiceViewOrderResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewViewOrderResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -3720,10 +5519,10 @@ func (p *OrderServiceViewOrderListResult) ReadField0(iprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *OrderServiceViewOrderListResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *OrderServiceViewOrderResult) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ViewOrderList_result"); err != nil {
+	if err = oprot.WriteStructBegin("ViewOrder_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3749,7 +5548,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *OrderServiceViewOrderListResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *OrderServiceViewOrderResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -3768,43 +5567,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *OrderServiceViewOrderListResult) String() string {
+func (p *OrderServiceViewOrderResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OrderServiceViewOrderListResult(%+v)", *p)
+	return fmt.Sprintf("OrderServiceViewOrderResult(%+v)", *p)
 
 }
 
-type OrderServiceViewOrderArgs struct {
-	Req *ViewOrderReq `thrift:"req,1"`
+type OrderServiceViewOrderDetailArgs struct {
+	Req *ViewOrderDetailReq `thrift:"req,1"`
 }
 
-func NewOrderServiceViewOrderArgs() *OrderServiceViewOrderArgs {
-	return &OrderServiceViewOrderArgs{}
+func NewOrderServiceViewOrderDetailArgs() *OrderServiceViewOrderDetailArgs {
+	return &OrderServiceViewOrderDetailArgs{}
 }
 
-func (p *OrderServiceViewOrderArgs) InitDefault() {
+func (p *OrderServiceViewOrderDetailArgs) InitDefault() {
 }
 
-var OrderServiceViewOrderArgs_Req_DEFAULT *ViewOrderReq
+var OrderServiceViewOrderDetailArgs_Req_DEFAULT *ViewOrderDetailReq
 
-func (p *OrderServiceViewOrderArgs) GetReq() (v *ViewOrderReq) {
+func (p *OrderServiceViewOrderDetailArgs) GetReq() (v *ViewOrderDetailReq) {
 	if !p.IsSetReq() {
-		return OrderServiceViewOrderArgs_Req_DEFAULT
+		return OrderServiceViewOrderDetailArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_OrderServiceViewOrderArgs = map[int16]string{
+var fieldIDToName_OrderServiceViewOrderDetailArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *OrderServiceViewOrderArgs) IsSetReq() bool {
+func (p *OrderServiceViewOrderDetailArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *OrderServiceViewOrderArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *OrderServiceViewOrderDetailArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3850,7 +5649,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceViewOrderArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceViewOrderDetailArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3860,8 +5659,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *OrderServiceViewOrderArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewViewOrderReq()
+func (p *OrderServiceViewOrderDetailArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewViewOrderDetailReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -3869,10 +5668,10 @@ func (p *OrderServiceViewOrderArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *OrderServiceViewOrderArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *OrderServiceViewOrderDetailArgs) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ViewOrder_args"); err != nil {
+	if err = oprot.WriteStructBegin("ViewOrderDetail_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3898,7 +5697,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *OrderServiceViewOrderArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *OrderServiceViewOrderDetailArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -3915,43 +5714,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *OrderServiceViewOrderArgs) String() string {
+func (p *OrderServiceViewOrderDetailArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OrderServiceViewOrderArgs(%+v)", *p)
+	return fmt.Sprintf("OrderServiceViewOrderDetailArgs(%+v)", *p)
 
 }
 
-type OrderServiceViewOrderResult struct {
-	Success *ViewOrderResp `thrift:"success,0,optional"`
+type OrderServiceViewOrderDetailResult struct {
+	Success *ViewOrderDetailResp `thrift:"success,0,optional"`
 }
 
-func NewOrderServiceViewOrderResult() *OrderServiceViewOrderResult {
-	return &OrderServiceViewOrderResult{}
+func NewOrderServiceViewOrderDetailResult() *OrderServiceViewOrderDetailResult {
+	return &OrderServiceViewOrderDetailResult{}
 }
 
-func (p *OrderServiceViewOrderResult) InitDefault() {
+func (p *OrderServiceViewOrderDetailResult) InitDefault() {
 }
 
-var OrderServiceViewOrderResult_Success_DEFAULT *ViewOrderResp
+var OrderServiceViewOrderDetailResult_Success_DEFAULT *ViewOrderDetailResp
 
-func (p *OrderServiceViewOrderResult) GetSuccess() (v *ViewOrderResp) {
+func (p *OrderServiceViewOrderDetailResult) GetSuccess() (v *ViewOrderDetailResp) {
 	if !p.IsSetSuccess() {
-		return OrderServiceViewOrderResult_Success_DEFAULT
+		return OrderServiceViewOrderDetailResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_OrderServiceViewOrderResult = map[int16]string{
+var fieldIDToName_OrderServiceViewOrderDetailResult = map[int16]string{
 	0: "success",
 }
 
-func (p *OrderServiceViewOrderResult) IsSetSuccess() bool {
+func (p *OrderServiceViewOrderDetailResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *OrderServiceViewOrderResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *OrderServiceViewOrderDetailResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3997,7 +5796,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceViewOrderResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceViewOrderDetailResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4007,8 +5806,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *OrderServiceViewOrderResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewViewOrderResp()
+func (p *OrderServiceViewOrderDetailResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewViewOrderDetailResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -4016,10 +5815,10 @@ func (p *OrderServiceViewOrderResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *OrderServiceViewOrderResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *OrderServiceViewOrderDetailResult) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ViewOrder_result"); err != nil {
+	if err = oprot.WriteStructBegin("ViewOrderDetail_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4045,7 +5844,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *OrderServiceViewOrderResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *OrderServiceViewOrderDetailResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -4064,11 +5863,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *OrderServiceViewOrderResult) String() string {
+func (p *OrderServiceViewOrderDetailResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OrderServiceViewOrderResult(%+v)", *p)
+	return fmt.Sprintf("OrderServiceViewOrderDetailResult(%+v)", *p)
 
 }
 
@@ -215,6 +215,8 @@ type UserInfo struct {
 	UserId int64  `thrift:"userId,1" form:"userId" json:"userId" query:"userId"`
 	Name   string `thrift:"name,2" form:"name" json:"name" query:"name"`
 	Role   int64  `thrift:"role,3" form:"role" json:"role" query:"role"`
+	Email  string `thrift:"email,4" form:"email" json:"email" query:"email"`
+	Phone  string `thrift:"phone,5" form:"phone" json:"phone" query:"phone"`
 }
 
 func NewUserInfo() *UserInfo {
@@ -236,10 +238,20 @@ func (p *UserInfo) GetRole() (v int64) {
 	return p.Role
 }
 
+func (p *UserInfo) GetEmail() (v string) {
+	return p.Email
+}
+
+func (p *UserInfo) GetPhone() (v string) {
+	return p.Phone
+}
+
 var fieldIDToName_UserInfo = map[int16]string{
 	1: "userId",
 	2: "name",
 	3: "role",
+	4: "email",
+	5: "phone",
 }
 
 func (p *UserInfo) Read(iprot thrift.TProtocol) (err error) {
@@ -285,6 +297,22 @@ func (p *UserInfo) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -347,6 +375,28 @@ func (p *UserInfo) ReadField3(iprot thrift.TProtocol) error {
 	p.Role = _field
 	return nil
 }
+func (p *UserInfo) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Email = _field
+	return nil
+}
+func (p *UserInfo) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Phone = _field
+	return nil
+}
 
 func (p *UserInfo) Write(oprot thrift.TProtocol) (err error) {
 
@@ -367,6 +417,14 @@ func (p *UserInfo) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -436,6 +494,40 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
+func (p *UserInfo) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("email", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Email); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *UserInfo) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("phone", thrift.STRING, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Phone); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
 func (p *UserInfo) String() string {
 	if p == nil {
 		return "<nil>"
@@ -450,6 +542,12 @@ type AddressInfo struct {
 	// 具体地址信息
 	Detail    string `thrift:"detail,3" form:"detail" json:"detail" query:"detail"`
 	AddressID int64  `thrift:"addressID,4" form:"addressID" json:"addressID" query:"addressID"`
+	// 收件人
+	RecipientName string `thrift:"recipientName,5" form:"recipientName" json:"recipientName" query:"recipientName"`
+	// 收件人手机号
+	Phone string `thrift:"phone,6" form:"phone" json:"phone" query:"phone"`
+	// 是否为默认地址
+	IsDefault bool `thrift:"isDefault,7" form:"isDefault" json:"isDefault" query:"isDefault"`
 }
 
 func NewAddressInfo() *AddressInfo {
@@ -475,11 +573,26 @@ func (p *AddressInfo) GetAddressID() (v int64) {
 	return p.AddressID
 }
 
+func (p *AddressInfo) GetRecipientName() (v string) {
+	return p.RecipientName
+}
+
+func (p *AddressInfo) GetPhone() (v string) {
+	return p.Phone
+}
+
+func (p *AddressInfo) GetIsDefault() (v bool) {
+	return p.IsDefault
+}
+
 var fieldIDToName_AddressInfo = map[int16]string{
 	1: "province",
 	2: "city",
 	3: "detail",
 	4: "addressID",
+	5: "recipientName",
+	6: "phone",
+	7: "isDefault",
 }
 
 func (p *AddressInfo) Read(iprot thrift.TProtocol) (err error) {
@@ -533,6 +646,30 @@ func (p *AddressInfo) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -606,6 +743,39 @@ func (p *AddressInfo) ReadField4(iprot thrift.TProtocol) error {
 	p.AddressID = _field
 	return nil
 }
+func (p *AddressInfo) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.RecipientName = _field
+	return nil
+}
+func (p *AddressInfo) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Phone = _field
+	return nil
+}
+func (p *AddressInfo) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.IsDefault = _field
+	return nil
+}
 
 func (p *AddressInfo) Write(oprot thrift.TProtocol) (err error) {
 
@@ -630,6 +800,18 @@ func (p *AddressInfo) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 4
 			goto WriteFieldError
 		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -716,6 +898,57 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
+func (p *AddressInfo) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("recipientName", thrift.STRING, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.RecipientName); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *AddressInfo) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("phone", thrift.STRING, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Phone); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *AddressInfo) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("isDefault", thrift.BOOL, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.IsDefault); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
 func (p *AddressInfo) String() string {
 	if p == nil {
 		return "<nil>"
@@ -724,26 +957,32 @@ func (p *AddressInfo) String() string {
 
 }
 
-type LoginData struct {
-	UserId int64 `thrift:"userId,1" form:"userId" json:"userId" query:"userId"`
+type TokenInfo struct {
+	AccessToken  string `thrift:"accessToken,1" form:"accessToken" json:"accessToken" query:"accessToken"`
+	RefreshToken string `thrift:"refreshToken,2" form:"refreshToken" json:"refreshToken" query:"refreshToken"`
 }
 
-func NewLoginData() *LoginData {
-	return &LoginData{}
+func NewTokenInfo() *TokenInfo {
+	return &TokenInfo{}
 }
 
-func (p *LoginData) InitDefault() {
+func (p *TokenInfo) InitDefault() {
 }
 
-func (p *LoginData) GetUserId() (v int64) {
-	return p.UserId
+func (p *TokenInfo) GetAccessToken() (v string) {
+	return p.AccessToken
 }
 
-var fieldIDToName_LoginData = map[int16]string{
-	1: "userId",
+func (p *TokenInfo) GetRefreshToken() (v string) {
+	return p.RefreshToken
 }
 
-func (p *LoginData) Read(iprot thrift.TProtocol) (err error) {
+var fieldIDToName_TokenInfo = map[int16]string{
+	1: "accessToken",
+	2: "refreshToken",
+}
+
+func (p *TokenInfo) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -763,13 +1002,21 @@ func (p *LoginData) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -789,7 +1036,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_LoginData[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TokenInfo[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -799,22 +1046,33 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *LoginData) ReadField1(iprot thrift.TProtocol) error {
+func (p *TokenInfo) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.UserId = _field
+	p.AccessToken = _field
 	return nil
 }
+func (p *TokenInfo) ReadField2(iprot thrift.TProtocol) error {
 
-func (p *LoginData) Write(oprot thrift.TProtocol) (err error) {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.RefreshToken = _field
+	return nil
+}
+
+func (p *TokenInfo) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("LoginData"); err != nil {
+	if err = oprot.WriteStructBegin("TokenInfo"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -822,6 +1080,10 @@ func (p *LoginData) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -840,11 +1102,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *LoginData) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("userId", thrift.I64, 1); err != nil {
+func (p *TokenInfo) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("accessToken", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.UserId); err != nil {
+	if err := oprot.WriteString(p.AccessToken); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -857,45 +1119,89 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *LoginData) String() string {
+func (p *TokenInfo) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("refreshToken", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.RefreshToken); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TokenInfo) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("LoginData(%+v)", *p)
+	return fmt.Sprintf("TokenInfo(%+v)", *p)
 
 }
 
-type CategoryInfo struct {
-	CategoryID int64  `thrift:"categoryID,1,required" form:"categoryID,required" json:"categoryID,required" query:"categoryID,required"`
-	Name       string `thrift:"name,2,required" form:"name,required" json:"name,required" query:"name,required"`
+type SessionInfo struct {
+	SessionID string `thrift:"sessionID,1" form:"sessionID" json:"sessionID" query:"sessionID"`
+	// 登录设备, 一般为 User-Agent
+	Device string `thrift:"device,2" form:"device" json:"device" query:"device"`
+	// 登录时的 IP
+	IP string `thrift:"ip,3" form:"ip" json:"ip" query:"ip"`
+	// 登录时间
+	CreatedAt int64 `thrift:"createdAt,4" form:"createdAt" json:"createdAt" query:"createdAt"`
+	// 最后一次刷新 token 的时间
+	ActiveAt int64 `thrift:"activeAt,5" form:"activeAt" json:"activeAt" query:"activeAt"`
+	// 是否为当前请求所在的会话
+	Current bool `thrift:"current,6" form:"current" json:"current" query:"current"`
 }
 
-func NewCategoryInfo() *CategoryInfo {
-	return &CategoryInfo{}
+func NewSessionInfo() *SessionInfo {
+	return &SessionInfo{}
 }
 
-func (p *CategoryInfo) InitDefault() {
+func (p *SessionInfo) InitDefault() {
 }
 
-func (p *CategoryInfo) GetCategoryID() (v int64) {
-	return p.CategoryID
+func (p *SessionInfo) GetSessionID() (v string) {
+	return p.SessionID
 }
 
-func (p *CategoryInfo) GetName() (v string) {
-	return p.Name
+func (p *SessionInfo) GetDevice() (v string) {
+	return p.Device
 }
 
-var fieldIDToName_CategoryInfo = map[int16]string{
-	1: "categoryID",
-	2: "name",
+func (p *SessionInfo) GetIP() (v string) {
+	return p.IP
 }
 
-func (p *CategoryInfo) Read(iprot thrift.TProtocol) (err error) {
+func (p *SessionInfo) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+
+func (p *SessionInfo) GetActiveAt() (v int64) {
+	return p.ActiveAt
+}
+
+func (p *SessionInfo) GetCurrent() (v bool) {
+	return p.Current
+}
+
+var fieldIDToName_SessionInfo = map[int16]string{
+	1: "sessionID",
+	2: "device",
+	3: "ip",
+	4: "createdAt",
+	5: "activeAt",
+	6: "current",
+}
+
+func (p *SessionInfo) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetCategoryID bool = false
-	var issetName bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -912,11 +1218,10 @@ func (p *CategoryInfo) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetCategoryID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -925,7 +1230,38 @@ func (p *CategoryInfo) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetName = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -942,22 +1278,13 @@ func (p *CategoryInfo) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetCategoryID {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetName {
-		fieldId = 2
-		goto RequiredFieldNotSetError
-	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CategoryInfo[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SessionInfo[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -965,22 +1292,20 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_CategoryInfo[fieldId]))
 }
 
-func (p *CategoryInfo) ReadField1(iprot thrift.TProtocol) error {
+func (p *SessionInfo) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.CategoryID = _field
+	p.SessionID = _field
 	return nil
 }
-func (p *CategoryInfo) ReadField2(iprot thrift.TProtocol) error {
+func (p *SessionInfo) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -988,14 +1313,58 @@ func (p *CategoryInfo) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.Name = _field
+	p.Device = _field
 	return nil
 }
+func (p *SessionInfo) ReadField3(iprot thrift.TProtocol) error {
 
-func (p *CategoryInfo) Write(oprot thrift.TProtocol) (err error) {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.IP = _field
+	return nil
+}
+func (p *SessionInfo) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CreatedAt = _field
+	return nil
+}
+func (p *SessionInfo) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ActiveAt = _field
+	return nil
+}
+func (p *SessionInfo) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Current = _field
+	return nil
+}
+
+func (p *SessionInfo) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CategoryInfo"); err != nil {
+	if err = oprot.WriteStructBegin("SessionInfo"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1007,6 +1376,22 @@ func (p *CategoryInfo) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1025,11 +1410,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *CategoryInfo) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("categoryID", thrift.I64, 1); err != nil {
+func (p *SessionInfo) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("sessionID", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.CategoryID); err != nil {
+	if err := oprot.WriteString(p.SessionID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1042,11 +1427,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *CategoryInfo) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("name", thrift.STRING, 2); err != nil {
+func (p *SessionInfo) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("device", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Name); err != nil {
+	if err := oprot.WriteString(p.Device); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1059,168 +1444,149 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *CategoryInfo) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *SessionInfo) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("ip", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
 	}
-	return fmt.Sprintf("CategoryInfo(%+v)", *p)
-
-}
-
-type Coupon struct {
-	CouponID       int64    `thrift:"couponID,1,required" form:"couponID,required" json:"couponID,required" query:"couponID,required"`
-	CreatorID      int64    `thrift:"creatorID,2,required" form:"creatorID,required" json:"creatorID,required" query:"creatorID,required"`
-	DeadlineForGet int64    `thrift:"deadlineForGet,3,required" form:"deadlineForGet,required" json:"deadlineForGet,required" query:"deadlineForGet,required"`
-	Name           string   `thrift:"name,4,required" form:"name,required" json:"name,required" query:"name,required"`
-	TypeInfo       int32    `thrift:"typeInfo,5,required" form:"typeInfo,required" json:"typeInfo,required" query:"typeInfo,required"`
-	ConditionCost  float64  `thrift:"conditionCost,6,required" form:"conditionCost,required" json:"conditionCost,required" query:"conditionCost,required"`
-	DiscountAmount *float64 `thrift:"discountAmount,7,optional" form:"discountAmount" json:"discountAmount,omitempty" query:"discountAmount"`
-	Discount       *float64 `thrift:"discount,8,optional" form:"discount" json:"discount,omitempty" query:"discount"`
-	RangeType      int32    `thrift:"rangeType,9,required" form:"rangeType,required" json:"rangeType,required" query:"rangeType,required"`
-	RangeId        int64    `thrift:"rangeId,10,required" form:"rangeId,required" json:"rangeId,required" query:"rangeId,required"`
-	ExpireTime     int64    `thrift:"expireTime,11,required" form:"expireTime,required" json:"expireTime,required" query:"expireTime,required"`
-	Description    string   `thrift:"description,12,required" form:"description,required" json:"description,required" query:"description,required"`
-	CreatedAt      int64    `thrift:"createdAt,13,required" form:"createdAt,required" json:"createdAt,required" query:"createdAt,required"`
-	UpdatedAt      *int64   `thrift:"updatedAt,14,optional" form:"updatedAt" json:"updatedAt,omitempty" query:"updatedAt"`
-	DeletedAt      *int64   `thrift:"deletedAt,15,optional" form:"deletedAt" json:"deletedAt,omitempty" query:"deletedAt"`
+	if err := oprot.WriteString(p.IP); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func NewCoupon() *Coupon {
-	return &Coupon{}
+func (p *SessionInfo) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("createdAt", thrift.I64, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.CreatedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *Coupon) InitDefault() {
+func (p *SessionInfo) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("activeAt", thrift.I64, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ActiveAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func (p *Coupon) GetCouponID() (v int64) {
-	return p.CouponID
+func (p *SessionInfo) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("current", thrift.BOOL, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Current); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
 
-func (p *Coupon) GetCreatorID() (v int64) {
-	return p.CreatorID
-}
+func (p *SessionInfo) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SessionInfo(%+v)", *p)
 
-func (p *Coupon) GetDeadlineForGet() (v int64) {
-	return p.DeadlineForGet
 }
 
-func (p *Coupon) GetName() (v string) {
-	return p.Name
+type ShopInfo struct {
+	ShopID      int64  `thrift:"shopID,1" form:"shopID" json:"shopID" query:"shopID"`
+	Name        string `thrift:"name,2" form:"name" json:"name" query:"name"`
+	Logo        string `thrift:"logo,3" form:"logo" json:"logo" query:"logo"`
+	Description string `thrift:"description,4" form:"description" json:"description" query:"description"`
+	// 店铺状态: 0 营业中, 1 已关闭
+	Status int32 `thrift:"status,5" form:"status" json:"status" query:"status"`
+	// 店主的 uid
+	OwnerID   int64 `thrift:"ownerID,6" form:"ownerID" json:"ownerID" query:"ownerID"`
+	CreatedAt int64 `thrift:"createdAt,7" form:"createdAt" json:"createdAt" query:"createdAt"`
+	UpdatedAt int64 `thrift:"updatedAt,8" form:"updatedAt" json:"updatedAt" query:"updatedAt"`
 }
 
-func (p *Coupon) GetTypeInfo() (v int32) {
-	return p.TypeInfo
+func NewShopInfo() *ShopInfo {
+	return &ShopInfo{}
 }
 
-func (p *Coupon) GetConditionCost() (v float64) {
-	return p.ConditionCost
+func (p *ShopInfo) InitDefault() {
 }
 
-var Coupon_DiscountAmount_DEFAULT float64
-
-func (p *Coupon) GetDiscountAmount() (v float64) {
-	if !p.IsSetDiscountAmount() {
-		return Coupon_DiscountAmount_DEFAULT
-	}
-	return *p.DiscountAmount
+func (p *ShopInfo) GetShopID() (v int64) {
+	return p.ShopID
 }
 
-var Coupon_Discount_DEFAULT float64
-
-func (p *Coupon) GetDiscount() (v float64) {
-	if !p.IsSetDiscount() {
-		return Coupon_Discount_DEFAULT
-	}
-	return *p.Discount
+func (p *ShopInfo) GetName() (v string) {
+	return p.Name
 }
 
-func (p *Coupon) GetRangeType() (v int32) {
-	return p.RangeType
+func (p *ShopInfo) GetLogo() (v string) {
+	return p.Logo
 }
 
-func (p *Coupon) GetRangeId() (v int64) {
-	return p.RangeId
+func (p *ShopInfo) GetDescription() (v string) {
+	return p.Description
 }
 
-func (p *Coupon) GetExpireTime() (v int64) {
-	return p.ExpireTime
+func (p *ShopInfo) GetStatus() (v int32) {
+	return p.Status
 }
 
-func (p *Coupon) GetDescription() (v string) {
-	return p.Description
+func (p *ShopInfo) GetOwnerID() (v int64) {
+	return p.OwnerID
 }
 
-func (p *Coupon) GetCreatedAt() (v int64) {
+func (p *ShopInfo) GetCreatedAt() (v int64) {
 	return p.CreatedAt
 }
 
-var Coupon_UpdatedAt_DEFAULT int64
+func (p *ShopInfo) GetUpdatedAt() (v int64) {
+	return p.UpdatedAt
+}
 
-func (p *Coupon) GetUpdatedAt() (v int64) {
-	if !p.IsSetUpdatedAt() {
-		return Coupon_UpdatedAt_DEFAULT
-	}
-	return *p.UpdatedAt
+var fieldIDToName_ShopInfo = map[int16]string{
+	1: "shopID",
+	2: "name",
+	3: "logo",
+	4: "description",
+	5: "status",
+	6: "ownerID",
+	7: "createdAt",
+	8: "updatedAt",
 }
 
-var Coupon_DeletedAt_DEFAULT int64
-
-func (p *Coupon) GetDeletedAt() (v int64) {
-	if !p.IsSetDeletedAt() {
-		return Coupon_DeletedAt_DEFAULT
-	}
-	return *p.DeletedAt
-}
-
-var fieldIDToName_Coupon = map[int16]string{
-	1:  "couponID",
-	2:  "creatorID",
-	3:  "deadlineForGet",
-	4:  "name",
-	5:  "typeInfo",
-	6:  "conditionCost",
-	7:  "discountAmount",
-	8:  "discount",
-	9:  "rangeType",
-	10: "rangeId",
-	11: "expireTime",
-	12: "description",
-	13: "createdAt",
-	14: "updatedAt",
-	15: "deletedAt",
-}
-
-func (p *Coupon) IsSetDiscountAmount() bool {
-	return p.DiscountAmount != nil
-}
-
-func (p *Coupon) IsSetDiscount() bool {
-	return p.Discount != nil
-}
-
-func (p *Coupon) IsSetUpdatedAt() bool {
-	return p.UpdatedAt != nil
-}
-
-func (p *Coupon) IsSetDeletedAt() bool {
-	return p.DeletedAt != nil
-}
-
-func (p *Coupon) Read(iprot thrift.TProtocol) (err error) {
+func (p *ShopInfo) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetCouponID bool = false
-	var issetCreatorID bool = false
-	var issetDeadlineForGet bool = false
-	var issetName bool = false
-	var issetTypeInfo bool = false
-	var issetConditionCost bool = false
-	var issetRangeType bool = false
-	var issetRangeId bool = false
-	var issetExpireTime bool = false
-	var issetDescription bool = false
-	var issetCreatedAt bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -1241,25 +1607,22 @@ func (p *Coupon) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetCouponID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetCreatorID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetDeadlineForGet = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -1268,7 +1631,6 @@ func (p *Coupon) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetName = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -1277,21 +1639,19 @@ func (p *Coupon) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField5(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetTypeInfo = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 6:
-			if fieldTypeId == thrift.DOUBLE {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField6(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetConditionCost = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 7:
-			if fieldTypeId == thrift.DOUBLE {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField7(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -1299,69 +1659,8 @@ func (p *Coupon) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 8:
-			if fieldTypeId == thrift.DOUBLE {
-				if err = p.ReadField8(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 9:
-			if fieldTypeId == thrift.I32 {
-				if err = p.ReadField9(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetRangeType = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 10:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField10(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetRangeId = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 11:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField11(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetExpireTime = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 12:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField12(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetDescription = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 13:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField13(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetCreatedAt = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 14:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField14(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 15:
 			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField15(iprot); err != nil {
+				if err = p.ReadField8(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
@@ -1380,67 +1679,13 @@ func (p *Coupon) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetCouponID {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetCreatorID {
-		fieldId = 2
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetDeadlineForGet {
-		fieldId = 3
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetName {
-		fieldId = 4
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetTypeInfo {
-		fieldId = 5
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetConditionCost {
-		fieldId = 6
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetRangeType {
-		fieldId = 9
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetRangeId {
-		fieldId = 10
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetExpireTime {
-		fieldId = 11
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetDescription {
-		fieldId = 12
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetCreatedAt {
-		fieldId = 13
-		goto RequiredFieldNotSetError
-	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Coupon[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ShopInfo[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1448,11 +1693,9 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_Coupon[fieldId]))
 }
 
-func (p *Coupon) ReadField1(iprot thrift.TProtocol) error {
+func (p *ShopInfo) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -1460,32 +1703,32 @@ func (p *Coupon) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.CouponID = _field
+	p.ShopID = _field
 	return nil
 }
-func (p *Coupon) ReadField2(iprot thrift.TProtocol) error {
+func (p *ShopInfo) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.CreatorID = _field
+	p.Name = _field
 	return nil
 }
-func (p *Coupon) ReadField3(iprot thrift.TProtocol) error {
+func (p *ShopInfo) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.DeadlineForGet = _field
+	p.Logo = _field
 	return nil
 }
-func (p *Coupon) ReadField4(iprot thrift.TProtocol) error {
+func (p *ShopInfo) ReadField4(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -1493,10 +1736,10 @@ func (p *Coupon) ReadField4(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.Name = _field
+	p.Description = _field
 	return nil
 }
-func (p *Coupon) ReadField5(iprot thrift.TProtocol) error {
+func (p *ShopInfo) ReadField5(iprot thrift.TProtocol) error {
 
 	var _field int32
 	if v, err := iprot.ReadI32(); err != nil {
@@ -1504,54 +1747,21 @@ func (p *Coupon) ReadField5(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.TypeInfo = _field
-	return nil
-}
-func (p *Coupon) ReadField6(iprot thrift.TProtocol) error {
-
-	var _field float64
-	if v, err := iprot.ReadDouble(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.ConditionCost = _field
-	return nil
-}
-func (p *Coupon) ReadField7(iprot thrift.TProtocol) error {
-
-	var _field *float64
-	if v, err := iprot.ReadDouble(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.DiscountAmount = _field
-	return nil
-}
-func (p *Coupon) ReadField8(iprot thrift.TProtocol) error {
-
-	var _field *float64
-	if v, err := iprot.ReadDouble(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.Discount = _field
+	p.Status = _field
 	return nil
 }
-func (p *Coupon) ReadField9(iprot thrift.TProtocol) error {
+func (p *ShopInfo) ReadField6(iprot thrift.TProtocol) error {
 
-	var _field int32
-	if v, err := iprot.ReadI32(); err != nil {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.RangeType = _field
+	p.OwnerID = _field
 	return nil
 }
-func (p *Coupon) ReadField10(iprot thrift.TProtocol) error {
+func (p *ShopInfo) ReadField7(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -1559,10 +1769,10 @@ func (p *Coupon) ReadField10(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.RangeId = _field
+	p.CreatedAt = _field
 	return nil
 }
-func (p *Coupon) ReadField11(iprot thrift.TProtocol) error {
+func (p *ShopInfo) ReadField8(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -1570,58 +1780,14 @@ func (p *Coupon) ReadField11(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.ExpireTime = _field
+	p.UpdatedAt = _field
 	return nil
 }
-func (p *Coupon) ReadField12(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.Description = _field
-	return nil
-}
-func (p *Coupon) ReadField13(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.CreatedAt = _field
-	return nil
-}
-func (p *Coupon) ReadField14(iprot thrift.TProtocol) error {
-
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.UpdatedAt = _field
-	return nil
-}
-func (p *Coupon) ReadField15(iprot thrift.TProtocol) error {
-
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.DeletedAt = _field
-	return nil
-}
-
-func (p *Coupon) Write(oprot thrift.TProtocol) (err error) {
+func (p *ShopInfo) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Coupon"); err != nil {
+	if err = oprot.WriteStructBegin("ShopInfo"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1657,34 +1823,6 @@ func (p *Coupon) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 8
 			goto WriteFieldError
 		}
-		if err = p.writeField9(oprot); err != nil {
-			fieldId = 9
-			goto WriteFieldError
-		}
-		if err = p.writeField10(oprot); err != nil {
-			fieldId = 10
-			goto WriteFieldError
-		}
-		if err = p.writeField11(oprot); err != nil {
-			fieldId = 11
-			goto WriteFieldError
-		}
-		if err = p.writeField12(oprot); err != nil {
-			fieldId = 12
-			goto WriteFieldError
-		}
-		if err = p.writeField13(oprot); err != nil {
-			fieldId = 13
-			goto WriteFieldError
-		}
-		if err = p.writeField14(oprot); err != nil {
-			fieldId = 14
-			goto WriteFieldError
-		}
-		if err = p.writeField15(oprot); err != nil {
-			fieldId = 15
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1703,11 +1841,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *Coupon) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("couponID", thrift.I64, 1); err != nil {
+func (p *ShopInfo) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("shopID", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.CouponID); err != nil {
+	if err := oprot.WriteI64(p.ShopID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1720,11 +1858,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *Coupon) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("creatorID", thrift.I64, 2); err != nil {
+func (p *ShopInfo) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("name", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.CreatorID); err != nil {
+	if err := oprot.WriteString(p.Name); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1737,11 +1875,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *Coupon) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("deadlineForGet", thrift.I64, 3); err != nil {
+func (p *ShopInfo) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("logo", thrift.STRING, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.DeadlineForGet); err != nil {
+	if err := oprot.WriteString(p.Logo); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1754,11 +1892,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *Coupon) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("name", thrift.STRING, 4); err != nil {
+func (p *ShopInfo) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("description", thrift.STRING, 4); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Name); err != nil {
+	if err := oprot.WriteString(p.Description); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1771,11 +1909,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *Coupon) writeField5(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("typeInfo", thrift.I32, 5); err != nil {
+func (p *ShopInfo) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status", thrift.I32, 5); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.TypeInfo); err != nil {
+	if err := oprot.WriteI32(p.Status); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1788,11 +1926,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func (p *Coupon) writeField6(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("conditionCost", thrift.DOUBLE, 6); err != nil {
+func (p *ShopInfo) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("ownerID", thrift.I64, 6); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteDouble(p.ConditionCost); err != nil {
+	if err := oprot.WriteI64(p.OwnerID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1805,49 +1943,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
 
-func (p *Coupon) writeField7(oprot thrift.TProtocol) (err error) {
-	if p.IsSetDiscountAmount() {
-		if err = oprot.WriteFieldBegin("discountAmount", thrift.DOUBLE, 7); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteDouble(*p.DiscountAmount); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
-}
-
-func (p *Coupon) writeField8(oprot thrift.TProtocol) (err error) {
-	if p.IsSetDiscount() {
-		if err = oprot.WriteFieldBegin("discount", thrift.DOUBLE, 8); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteDouble(*p.Discount); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
-}
-
-func (p *Coupon) writeField9(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("rangeType", thrift.I32, 9); err != nil {
+func (p *ShopInfo) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("createdAt", thrift.I64, 7); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.RangeType); err != nil {
+	if err := oprot.WriteI64(p.CreatedAt); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1855,16 +1955,16 @@ func (p *Coupon) writeField9(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
 
-func (p *Coupon) writeField10(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("rangeId", thrift.I64, 10); err != nil {
+func (p *ShopInfo) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("updatedAt", thrift.I64, 8); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.RangeId); err != nil {
+	if err := oprot.WriteI64(p.UpdatedAt); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1872,155 +1972,61 @@ func (p *Coupon) writeField10(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
 }
 
-func (p *Coupon) writeField11(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("expireTime", thrift.I64, 11); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.ExpireTime); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *ShopInfo) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
-}
+	return fmt.Sprintf("ShopInfo(%+v)", *p)
 
-func (p *Coupon) writeField12(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("description", thrift.STRING, 12); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.Description); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
 }
 
-func (p *Coupon) writeField13(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("createdAt", thrift.I64, 13); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.CreatedAt); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 13 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 13 end error: ", p), err)
+type ShopStaffInfo struct {
+	ShopID int64 `thrift:"shopID,1" form:"shopID" json:"shopID" query:"shopID"`
+	UID    int64 `thrift:"uid,2" form:"uid" json:"uid" query:"uid"`
+	// 店铺内角色: 0 店员, 1 店主
+	Role      int32 `thrift:"role,3" form:"role" json:"role" query:"role"`
+	CreatedAt int64 `thrift:"createdAt,4" form:"createdAt" json:"createdAt" query:"createdAt"`
 }
 
-func (p *Coupon) writeField14(oprot thrift.TProtocol) (err error) {
-	if p.IsSetUpdatedAt() {
-		if err = oprot.WriteFieldBegin("updatedAt", thrift.I64, 14); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI64(*p.UpdatedAt); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 14 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 14 end error: ", p), err)
+func NewShopStaffInfo() *ShopStaffInfo {
+	return &ShopStaffInfo{}
 }
 
-func (p *Coupon) writeField15(oprot thrift.TProtocol) (err error) {
-	if p.IsSetDeletedAt() {
-		if err = oprot.WriteFieldBegin("deletedAt", thrift.I64, 15); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI64(*p.DeletedAt); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 15 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 15 end error: ", p), err)
+func (p *ShopStaffInfo) InitDefault() {
 }
 
-func (p *Coupon) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("Coupon(%+v)", *p)
-
+func (p *ShopStaffInfo) GetShopID() (v int64) {
+	return p.ShopID
 }
 
-type AssignedCouponSpuInfo struct {
-	SpuId         int64   `thrift:"spuId,1,required" form:"spuId,required" json:"spuId,required" query:"spuId,required"`
-	Coupon        *Coupon `thrift:"coupon,2,required" form:"coupon,required" json:"coupon,required" query:"coupon,required"`
-	DiscountPrice float64 `thrift:"discount_price,3,required" form:"discount_price,required" json:"discount_price,required" query:"discount_price,required"`
+func (p *ShopStaffInfo) GetUID() (v int64) {
+	return p.UID
 }
 
-func NewAssignedCouponSpuInfo() *AssignedCouponSpuInfo {
-	return &AssignedCouponSpuInfo{}
+func (p *ShopStaffInfo) GetRole() (v int32) {
+	return p.Role
 }
 
-func (p *AssignedCouponSpuInfo) InitDefault() {
+func (p *ShopStaffInfo) GetCreatedAt() (v int64) {
+	return p.CreatedAt
 }
 
-func (p *AssignedCouponSpuInfo) GetSpuId() (v int64) {
-	return p.SpuId
+var fieldIDToName_ShopStaffInfo = map[int16]string{
+	1: "shopID",
+	2: "uid",
+	3: "role",
+	4: "createdAt",
 }
 
-var AssignedCouponSpuInfo_Coupon_DEFAULT *Coupon
-
-func (p *AssignedCouponSpuInfo) GetCoupon() (v *Coupon) {
-	if !p.IsSetCoupon() {
-		return AssignedCouponSpuInfo_Coupon_DEFAULT
-	}
-	return p.Coupon
-}
-
-func (p *AssignedCouponSpuInfo) GetDiscountPrice() (v float64) {
-	return p.DiscountPrice
-}
-
-var fieldIDToName_AssignedCouponSpuInfo = map[int16]string{
-	1: "spuId",
-	2: "coupon",
-	3: "discount_price",
-}
-
-func (p *AssignedCouponSpuInfo) IsSetCoupon() bool {
-	return p.Coupon != nil
-}
-
-func (p *AssignedCouponSpuInfo) Read(iprot thrift.TProtocol) (err error) {
+func (p *ShopStaffInfo) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetSpuId bool = false
-	var issetCoupon bool = false
-	var issetDiscountPrice bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -2041,25 +2047,30 @@ func (p *AssignedCouponSpuInfo) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetSpuId = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetCoupon = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.DOUBLE {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetDiscountPrice = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -2076,27 +2087,13 @@ func (p *AssignedCouponSpuInfo) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetSpuId {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetCoupon {
-		fieldId = 2
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetDiscountPrice {
-		fieldId = 3
-		goto RequiredFieldNotSetError
-	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AssignedCouponSpuInfo[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ShopStaffInfo[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2104,11 +2101,9 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AssignedCouponSpuInfo[fieldId]))
 }
 
-func (p *AssignedCouponSpuInfo) ReadField1(iprot thrift.TProtocol) error {
+func (p *ShopStaffInfo) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -2116,33 +2111,47 @@ func (p *AssignedCouponSpuInfo) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.SpuId = _field
+	p.ShopID = _field
 	return nil
 }
-func (p *AssignedCouponSpuInfo) ReadField2(iprot thrift.TProtocol) error {
-	_field := NewCoupon()
-	if err := _field.Read(iprot); err != nil {
+func (p *ShopStaffInfo) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	p.Coupon = _field
+	p.UID = _field
 	return nil
 }
-func (p *AssignedCouponSpuInfo) ReadField3(iprot thrift.TProtocol) error {
+func (p *ShopStaffInfo) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field float64
-	if v, err := iprot.ReadDouble(); err != nil {
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.DiscountPrice = _field
+	p.Role = _field
 	return nil
 }
+func (p *ShopStaffInfo) ReadField4(iprot thrift.TProtocol) error {
 
-func (p *AssignedCouponSpuInfo) Write(oprot thrift.TProtocol) (err error) {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CreatedAt = _field
+	return nil
+}
+
+func (p *ShopStaffInfo) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AssignedCouponSpuInfo"); err != nil {
+	if err = oprot.WriteStructBegin("ShopStaffInfo"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2158,6 +2167,10 @@ func (p *AssignedCouponSpuInfo) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -2176,11 +2189,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AssignedCouponSpuInfo) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("spuId", thrift.I64, 1); err != nil {
+func (p *ShopStaffInfo) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("shopID", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.SpuId); err != nil {
+	if err := oprot.WriteI64(p.ShopID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2193,11 +2206,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *AssignedCouponSpuInfo) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("coupon", thrift.STRUCT, 2); err != nil {
+func (p *ShopStaffInfo) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("uid", thrift.I64, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.Coupon.Write(oprot); err != nil {
+	if err := oprot.WriteI64(p.UID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2210,11 +2223,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *AssignedCouponSpuInfo) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("discount_price", thrift.DOUBLE, 3); err != nil {
+func (p *ShopStaffInfo) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("role", thrift.I32, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteDouble(p.DiscountPrice); err != nil {
+	if err := oprot.WriteI32(p.Role); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2227,54 +2240,100 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *AssignedCouponSpuInfo) String() string {
+func (p *ShopStaffInfo) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("createdAt", thrift.I64, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.CreatedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *ShopStaffInfo) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AssignedCouponSpuInfo(%+v)", *p)
+	return fmt.Sprintf("ShopStaffInfo(%+v)", *p)
 
 }
 
-type UserCoupon struct {
-	Coupon             *Coupon `thrift:"coupon,1,required" form:"coupon,required" json:"coupon,required" query:"coupon,required"`
-	RemainUserUseCount int64   `thrift:"remainUserUseCount,13,required" form:"remainUserUseCount,required" json:"remainUserUseCount,required" query:"remainUserUseCount,required"`
+type BanInfo struct {
+	BanID  int64  `thrift:"banID,1" form:"banID" json:"banID" query:"banID"`
+	UID    int64  `thrift:"uid,2" form:"uid" json:"uid" query:"uid"`
+	Reason string `thrift:"reason,3" form:"reason" json:"reason" query:"reason"`
+	// 执行封禁的管理员 uid
+	OperatorID int64 `thrift:"operatorID,4" form:"operatorID" json:"operatorID" query:"operatorID"`
+	StartAt    int64 `thrift:"startAt,5" form:"startAt" json:"startAt" query:"startAt"`
+	// 封禁结束时间, 0 表示永久封禁
+	EndAt int64 `thrift:"endAt,6" form:"endAt" json:"endAt" query:"endAt"`
+	// 解封时间, 0 表示没有被手动解封
+	LiftedAt int64 `thrift:"liftedAt,7" form:"liftedAt" json:"liftedAt" query:"liftedAt"`
+	// 执行解封的管理员 uid
+	LiftedBy int64 `thrift:"liftedBy,8" form:"liftedBy" json:"liftedBy" query:"liftedBy"`
 }
 
-func NewUserCoupon() *UserCoupon {
-	return &UserCoupon{}
+func NewBanInfo() *BanInfo {
+	return &BanInfo{}
 }
 
-func (p *UserCoupon) InitDefault() {
+func (p *BanInfo) InitDefault() {
 }
 
-var UserCoupon_Coupon_DEFAULT *Coupon
+func (p *BanInfo) GetBanID() (v int64) {
+	return p.BanID
+}
 
-func (p *UserCoupon) GetCoupon() (v *Coupon) {
-	if !p.IsSetCoupon() {
-		return UserCoupon_Coupon_DEFAULT
-	}
-	return p.Coupon
+func (p *BanInfo) GetUID() (v int64) {
+	return p.UID
 }
 
-func (p *UserCoupon) GetRemainUserUseCount() (v int64) {
-	return p.RemainUserUseCount
+func (p *BanInfo) GetReason() (v string) {
+	return p.Reason
 }
 
-var fieldIDToName_UserCoupon = map[int16]string{
-	1:  "coupon",
-	13: "remainUserUseCount",
+func (p *BanInfo) GetOperatorID() (v int64) {
+	return p.OperatorID
 }
 
-func (p *UserCoupon) IsSetCoupon() bool {
-	return p.Coupon != nil
+func (p *BanInfo) GetStartAt() (v int64) {
+	return p.StartAt
 }
 
-func (p *UserCoupon) Read(iprot thrift.TProtocol) (err error) {
+func (p *BanInfo) GetEndAt() (v int64) {
+	return p.EndAt
+}
+
+func (p *BanInfo) GetLiftedAt() (v int64) {
+	return p.LiftedAt
+}
+
+func (p *BanInfo) GetLiftedBy() (v int64) {
+	return p.LiftedBy
+}
+
+var fieldIDToName_BanInfo = map[int16]string{
+	1: "banID",
+	2: "uid",
+	3: "reason",
+	4: "operatorID",
+	5: "startAt",
+	6: "endAt",
+	7: "liftedAt",
+	8: "liftedBy",
+}
+
+func (p *BanInfo) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetCoupon bool = false
-	var issetRemainUserUseCount bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -2291,20 +2350,66 @@ func (p *UserCoupon) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetCoupon = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 13:
+		case 2:
 			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField13(iprot); err != nil {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField8(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetRemainUserUseCount = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -2321,22 +2426,13 @@ func (p *UserCoupon) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetCoupon {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetRemainUserUseCount {
-		fieldId = 13
-		goto RequiredFieldNotSetError
-	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserCoupon[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BanInfo[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2344,19 +2440,20 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_UserCoupon[fieldId]))
 }
 
-func (p *UserCoupon) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewCoupon()
-	if err := _field.Read(iprot); err != nil {
+func (p *BanInfo) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	p.Coupon = _field
+	p.BanID = _field
 	return nil
 }
-func (p *UserCoupon) ReadField13(iprot thrift.TProtocol) error {
+func (p *BanInfo) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -2364,36 +2461,126 @@ func (p *UserCoupon) ReadField13(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.RemainUserUseCount = _field
+	p.UID = _field
 	return nil
 }
+func (p *BanInfo) ReadField3(iprot thrift.TProtocol) error {
 
-func (p *UserCoupon) Write(oprot thrift.TProtocol) (err error) {
-
-	var fieldId int16
-	if err = oprot.WriteStructBegin("UserCoupon"); err != nil {
-		goto WriteStructBeginError
-	}
-	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
-			goto WriteFieldError
-		}
-		if err = p.writeField13(oprot); err != nil {
-			fieldId = 13
-			goto WriteFieldError
-		}
-	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
-	}
-	if err = oprot.WriteStructEnd(); err != nil {
-		goto WriteStructEndError
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
+	p.Reason = _field
 	return nil
-WriteStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldError:
+}
+func (p *BanInfo) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.OperatorID = _field
+	return nil
+}
+func (p *BanInfo) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.StartAt = _field
+	return nil
+}
+func (p *BanInfo) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.EndAt = _field
+	return nil
+}
+func (p *BanInfo) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.LiftedAt = _field
+	return nil
+}
+func (p *BanInfo) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.LiftedBy = _field
+	return nil
+}
+
+func (p *BanInfo) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("BanInfo"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
 WriteFieldStopError:
 	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
@@ -2401,11 +2588,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserCoupon) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("coupon", thrift.STRUCT, 1); err != nil {
+func (p *BanInfo) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("banID", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.Coupon.Write(oprot); err != nil {
+	if err := oprot.WriteI64(p.BanID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2418,11 +2605,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserCoupon) writeField13(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("remainUserUseCount", thrift.I64, 13); err != nil {
+func (p *BanInfo) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("uid", thrift.I64, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.RemainUserUseCount); err != nil {
+	if err := oprot.WriteI64(p.UID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2430,50 +2617,169 @@ func (p *UserCoupon) writeField13(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 13 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 13 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *UserCoupon) String() string {
+func (p *BanInfo) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("reason", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Reason); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *BanInfo) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("operatorID", thrift.I64, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.OperatorID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *BanInfo) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("startAt", thrift.I64, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.StartAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *BanInfo) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("endAt", thrift.I64, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.EndAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *BanInfo) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("liftedAt", thrift.I64, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.LiftedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *BanInfo) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("liftedBy", thrift.I64, 8); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.LiftedBy); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *BanInfo) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserCoupon(%+v)", *p)
+	return fmt.Sprintf("BanInfo(%+v)", *p)
 
 }
 
-type AttrValue struct {
-	SaleAttr  string `thrift:"saleAttr,1,required" form:"saleAttr,required" json:"saleAttr,required" query:"saleAttr,required"`
-	SaleValue string `thrift:"saleValue,2,required" form:"saleValue,required" json:"saleValue,required" query:"saleValue,required"`
+type ApiKeyInfo struct {
+	KeyID int64  `thrift:"keyID,1" form:"keyID" json:"keyID" query:"keyID"`
+	Name  string `thrift:"name,2" form:"name" json:"name" query:"name"`
+	// key 的前几位, 用于区分不同的 key, 完整的 key 只会在创建时返回一次
+	Prefix    string   `thrift:"prefix,3" form:"prefix" json:"prefix" query:"prefix"`
+	Scopes    []string `thrift:"scopes,4" form:"scopes" json:"scopes" query:"scopes"`
+	CreatedAt int64    `thrift:"createdAt,5" form:"createdAt" json:"createdAt" query:"createdAt"`
 }
 
-func NewAttrValue() *AttrValue {
-	return &AttrValue{}
+func NewApiKeyInfo() *ApiKeyInfo {
+	return &ApiKeyInfo{}
 }
 
-func (p *AttrValue) InitDefault() {
+func (p *ApiKeyInfo) InitDefault() {
 }
 
-func (p *AttrValue) GetSaleAttr() (v string) {
-	return p.SaleAttr
+func (p *ApiKeyInfo) GetKeyID() (v int64) {
+	return p.KeyID
 }
 
-func (p *AttrValue) GetSaleValue() (v string) {
-	return p.SaleValue
+func (p *ApiKeyInfo) GetName() (v string) {
+	return p.Name
 }
 
-var fieldIDToName_AttrValue = map[int16]string{
-	1: "saleAttr",
-	2: "saleValue",
+func (p *ApiKeyInfo) GetPrefix() (v string) {
+	return p.Prefix
 }
 
-func (p *AttrValue) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiKeyInfo) GetScopes() (v []string) {
+	return p.Scopes
+}
+
+func (p *ApiKeyInfo) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+
+var fieldIDToName_ApiKeyInfo = map[int16]string{
+	1: "keyID",
+	2: "name",
+	3: "prefix",
+	4: "scopes",
+	5: "createdAt",
+}
+
+func (p *ApiKeyInfo) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetSaleAttr bool = false
-	var issetSaleValue bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -2490,11 +2796,10 @@ func (p *AttrValue) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetSaleAttr = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -2503,7 +2808,30 @@ func (p *AttrValue) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetSaleValue = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -2520,22 +2848,13 @@ func (p *AttrValue) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetSaleAttr {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetSaleValue {
-		fieldId = 2
-		goto RequiredFieldNotSetError
-	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AttrValue[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiKeyInfo[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2543,11 +2862,20 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AttrValue[fieldId]))
 }
 
-func (p *AttrValue) ReadField1(iprot thrift.TProtocol) error {
+func (p *ApiKeyInfo) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.KeyID = _field
+	return nil
+}
+func (p *ApiKeyInfo) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -2555,10 +2883,10 @@ func (p *AttrValue) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.SaleAttr = _field
+	p.Name = _field
 	return nil
 }
-func (p *AttrValue) ReadField2(iprot thrift.TProtocol) error {
+func (p *ApiKeyInfo) ReadField3(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -2566,14 +2894,48 @@ func (p *AttrValue) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.SaleValue = _field
+	p.Prefix = _field
+	return nil
+}
+func (p *ApiKeyInfo) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Scopes = _field
 	return nil
 }
+func (p *ApiKeyInfo) ReadField5(iprot thrift.TProtocol) error {
 
-func (p *AttrValue) Write(oprot thrift.TProtocol) (err error) {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CreatedAt = _field
+	return nil
+}
+
+func (p *ApiKeyInfo) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AttrValue"); err != nil {
+	if err = oprot.WriteStructBegin("ApiKeyInfo"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2585,6 +2947,18 @@ func (p *AttrValue) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -2603,11 +2977,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AttrValue) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("saleAttr", thrift.STRING, 1); err != nil {
+func (p *ApiKeyInfo) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("keyID", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.SaleAttr); err != nil {
+	if err := oprot.WriteI64(p.KeyID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2620,11 +2994,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *AttrValue) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("saleValue", thrift.STRING, 2); err != nil {
+func (p *ApiKeyInfo) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("name", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.SaleValue); err != nil {
+	if err := oprot.WriteString(p.Name); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2637,81 +3011,148 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *AttrValue) String() string {
+func (p *ApiKeyInfo) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("prefix", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Prefix); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *ApiKeyInfo) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("scopes", thrift.LIST, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRING, len(p.Scopes)); err != nil {
+		return err
+	}
+	for _, v := range p.Scopes {
+		if err := oprot.WriteString(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *ApiKeyInfo) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("createdAt", thrift.I64, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.CreatedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *ApiKeyInfo) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AttrValue(%+v)", *p)
+	return fmt.Sprintf("ApiKeyInfo(%+v)", *p)
 
 }
 
-type SpuImage struct {
-	ImageID   int64  `thrift:"imageID,1,required" form:"imageID,required" json:"imageID,required" query:"imageID,required"`
-	SpuID     int64  `thrift:"spuID,2,required" form:"spuID,required" json:"spuID,required" query:"spuID,required"`
-	URL       string `thrift:"url,3,required" form:"url,required" json:"url,required" query:"url,required"`
-	CreatedAt int64  `thrift:"createdAt,4,required" form:"createdAt,required" json:"createdAt,required" query:"createdAt,required"`
-	DeletedAt *int64 `thrift:"deletedAt,5,optional" form:"deletedAt" json:"deletedAt,omitempty" query:"deletedAt"`
-	UpdatedAt int64  `thrift:"updatedAt,6,required" form:"updatedAt,required" json:"updatedAt,required" query:"updatedAt,required"`
+type AuditLogInfo struct {
+	LogID int64 `thrift:"logID,1" form:"logID" json:"logID" query:"logID"`
+	// 执行操作的用户 uid
+	ActorID int64  `thrift:"actorID,2" form:"actorID" json:"actorID" query:"actorID"`
+	Action  string `thrift:"action,3" form:"action" json:"action" query:"action"`
+	// 被操作的对象, 如 user:1, coupon:2
+	Target string `thrift:"target,4" form:"target" json:"target" query:"target"`
+	// 操作前的快照, JSON 格式
+	Before string `thrift:"before,5" form:"before" json:"before" query:"before"`
+	// 操作后的快照, JSON 格式
+	After     string `thrift:"after,6" form:"after" json:"after" query:"after"`
+	ClientIP  string `thrift:"clientIP,7" form:"clientIP" json:"clientIP" query:"clientIP"`
+	TraceID   string `thrift:"traceID,8" form:"traceID" json:"traceID" query:"traceID"`
+	CreatedAt int64  `thrift:"createdAt,9" form:"createdAt" json:"createdAt" query:"createdAt"`
 }
 
-func NewSpuImage() *SpuImage {
-	return &SpuImage{}
+func NewAuditLogInfo() *AuditLogInfo {
+	return &AuditLogInfo{}
 }
 
-func (p *SpuImage) InitDefault() {
+func (p *AuditLogInfo) InitDefault() {
 }
 
-func (p *SpuImage) GetImageID() (v int64) {
-	return p.ImageID
+func (p *AuditLogInfo) GetLogID() (v int64) {
+	return p.LogID
 }
 
-func (p *SpuImage) GetSpuID() (v int64) {
-	return p.SpuID
+func (p *AuditLogInfo) GetActorID() (v int64) {
+	return p.ActorID
 }
 
-func (p *SpuImage) GetURL() (v string) {
-	return p.URL
+func (p *AuditLogInfo) GetAction() (v string) {
+	return p.Action
 }
 
-func (p *SpuImage) GetCreatedAt() (v int64) {
-	return p.CreatedAt
+func (p *AuditLogInfo) GetTarget() (v string) {
+	return p.Target
 }
 
-var SpuImage_DeletedAt_DEFAULT int64
+func (p *AuditLogInfo) GetBefore() (v string) {
+	return p.Before
+}
 
-func (p *SpuImage) GetDeletedAt() (v int64) {
-	if !p.IsSetDeletedAt() {
-		return SpuImage_DeletedAt_DEFAULT
-	}
-	return *p.DeletedAt
+func (p *AuditLogInfo) GetAfter() (v string) {
+	return p.After
 }
 
-func (p *SpuImage) GetUpdatedAt() (v int64) {
-	return p.UpdatedAt
+func (p *AuditLogInfo) GetClientIP() (v string) {
+	return p.ClientIP
 }
 
-var fieldIDToName_SpuImage = map[int16]string{
-	1: "imageID",
-	2: "spuID",
-	3: "url",
-	4: "createdAt",
-	5: "deletedAt",
-	6: "updatedAt",
+func (p *AuditLogInfo) GetTraceID() (v string) {
+	return p.TraceID
 }
 
-func (p *SpuImage) IsSetDeletedAt() bool {
-	return p.DeletedAt != nil
+func (p *AuditLogInfo) GetCreatedAt() (v int64) {
+	return p.CreatedAt
 }
 
-func (p *SpuImage) Read(iprot thrift.TProtocol) (err error) {
+var fieldIDToName_AuditLogInfo = map[int16]string{
+	1: "logID",
+	2: "actorID",
+	3: "action",
+	4: "target",
+	5: "before",
+	6: "after",
+	7: "clientIP",
+	8: "traceID",
+	9: "createdAt",
+}
+
+func (p *AuditLogInfo) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetImageID bool = false
-	var issetSpuID bool = false
-	var issetURL bool = false
-	var issetCreatedAt bool = false
-	var issetUpdatedAt bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -2732,7 +3173,6 @@ func (p *SpuImage) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetImageID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -2741,7 +3181,6 @@ func (p *SpuImage) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetSpuID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -2750,21 +3189,19 @@ func (p *SpuImage) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetURL = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 4:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetCreatedAt = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 5:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField5(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -2772,11 +3209,34 @@ func (p *SpuImage) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 6:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField6(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetUpdatedAt = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -2793,37 +3253,13 @@ func (p *SpuImage) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetImageID {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetSpuID {
-		fieldId = 2
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetURL {
-		fieldId = 3
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetCreatedAt {
-		fieldId = 4
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetUpdatedAt {
-		fieldId = 6
-		goto RequiredFieldNotSetError
-	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SpuImage[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AuditLogInfo[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2831,11 +3267,9 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_SpuImage[fieldId]))
 }
 
-func (p *SpuImage) ReadField1(iprot thrift.TProtocol) error {
+func (p *AuditLogInfo) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -2843,10 +3277,10 @@ func (p *SpuImage) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.ImageID = _field
+	p.LogID = _field
 	return nil
 }
-func (p *SpuImage) ReadField2(iprot thrift.TProtocol) error {
+func (p *AuditLogInfo) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -2854,10 +3288,10 @@ func (p *SpuImage) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.SpuID = _field
+	p.ActorID = _field
 	return nil
 }
-func (p *SpuImage) ReadField3(iprot thrift.TProtocol) error {
+func (p *AuditLogInfo) ReadField3(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -2865,47 +3299,80 @@ func (p *SpuImage) ReadField3(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.URL = _field
+	p.Action = _field
 	return nil
 }
-func (p *SpuImage) ReadField4(iprot thrift.TProtocol) error {
+func (p *AuditLogInfo) ReadField4(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.CreatedAt = _field
+	p.Target = _field
 	return nil
 }
-func (p *SpuImage) ReadField5(iprot thrift.TProtocol) error {
+func (p *AuditLogInfo) ReadField5(iprot thrift.TProtocol) error {
 
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		_field = &v
+		_field = v
 	}
-	p.DeletedAt = _field
+	p.Before = _field
 	return nil
 }
-func (p *SpuImage) ReadField6(iprot thrift.TProtocol) error {
+func (p *AuditLogInfo) ReadField6(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.UpdatedAt = _field
+	p.After = _field
 	return nil
 }
+func (p *AuditLogInfo) ReadField7(iprot thrift.TProtocol) error {
 
-func (p *SpuImage) Write(oprot thrift.TProtocol) (err error) {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ClientIP = _field
+	return nil
+}
+func (p *AuditLogInfo) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.TraceID = _field
+	return nil
+}
+func (p *AuditLogInfo) ReadField9(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CreatedAt = _field
+	return nil
+}
+
+func (p *AuditLogInfo) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SpuImage"); err != nil {
+	if err = oprot.WriteStructBegin("AuditLogInfo"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2933,6 +3400,18 @@ func (p *SpuImage) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 6
 			goto WriteFieldError
 		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -2951,11 +3430,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SpuImage) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("imageID", thrift.I64, 1); err != nil {
+func (p *AuditLogInfo) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("logID", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.ImageID); err != nil {
+	if err := oprot.WriteI64(p.LogID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2968,11 +3447,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *SpuImage) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("spuID", thrift.I64, 2); err != nil {
+func (p *AuditLogInfo) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("actorID", thrift.I64, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.SpuID); err != nil {
+	if err := oprot.WriteI64(p.ActorID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2985,11 +3464,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *SpuImage) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("url", thrift.STRING, 3); err != nil {
+func (p *AuditLogInfo) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("action", thrift.STRING, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.URL); err != nil {
+	if err := oprot.WriteString(p.Action); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3002,11 +3481,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *SpuImage) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("createdAt", thrift.I64, 4); err != nil {
+func (p *AuditLogInfo) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("target", thrift.STRING, 4); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.CreatedAt); err != nil {
+	if err := oprot.WriteString(p.Target); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3019,17 +3498,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *SpuImage) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetDeletedAt() {
-		if err = oprot.WriteFieldBegin("deletedAt", thrift.I64, 5); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI64(*p.DeletedAt); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *AuditLogInfo) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("before", thrift.STRING, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Before); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
@@ -3038,11 +3515,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func (p *SpuImage) writeField6(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("updatedAt", thrift.I64, 6); err != nil {
+func (p *AuditLogInfo) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("after", thrift.STRING, 6); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.UpdatedAt); err != nil {
+	if err := oprot.WriteString(p.After); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3055,74 +3532,132 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
 
-func (p *SpuImage) String() string {
+func (p *AuditLogInfo) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("clientIP", thrift.STRING, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.ClientIP); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *AuditLogInfo) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("traceID", thrift.STRING, 8); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.TraceID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *AuditLogInfo) writeField9(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("createdAt", thrift.I64, 9); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.CreatedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+
+func (p *AuditLogInfo) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SpuImage(%+v)", *p)
+	return fmt.Sprintf("AuditLogInfo(%+v)", *p)
 
 }
 
-type SkuImage struct {
-	ImageID   int64  `thrift:"imageID,1,required" form:"imageID,required" json:"imageID,required" query:"imageID,required"`
-	SkuID     int64  `thrift:"skuID,2,required" form:"skuID,required" json:"skuID,required" query:"skuID,required"`
-	URL       string `thrift:"url,3,required" form:"url,required" json:"url,required" query:"url,required"`
-	CreatedAt int64  `thrift:"createdAt,4,required" form:"createdAt,required" json:"createdAt,required" query:"createdAt,required"`
-	DeletedAt *int64 `thrift:"deletedAt,5,optional" form:"deletedAt" json:"deletedAt,omitempty" query:"deletedAt"`
+type WebhookEndpointInfo struct {
+	EndpointID int64  `thrift:"endpointID,1" form:"endpointID" json:"endpointID" query:"endpointID"`
+	ShopID     int64  `thrift:"shopID,2" form:"shopID" json:"shopID" query:"shopID"`
+	URL        string `thrift:"url,3" form:"url" json:"url" query:"url"`
+	// 订阅的事件类型, 如 order.paid, refund.approved
+	EventTypes []string `thrift:"eventTypes,4" form:"eventTypes" json:"eventTypes" query:"eventTypes"`
+	// 连续投递失败过多时会被自动停用
+	Enabled             bool  `thrift:"enabled,5" form:"enabled" json:"enabled" query:"enabled"`
+	ConsecutiveFailures int64 `thrift:"consecutiveFailures,6" form:"consecutiveFailures" json:"consecutiveFailures" query:"consecutiveFailures"`
+	CreatedAt           int64 `thrift:"createdAt,7" form:"createdAt" json:"createdAt" query:"createdAt"`
+	UpdatedAt           int64 `thrift:"updatedAt,8" form:"updatedAt" json:"updatedAt" query:"updatedAt"`
 }
 
-func NewSkuImage() *SkuImage {
-	return &SkuImage{}
+func NewWebhookEndpointInfo() *WebhookEndpointInfo {
+	return &WebhookEndpointInfo{}
 }
 
-func (p *SkuImage) InitDefault() {
+func (p *WebhookEndpointInfo) InitDefault() {
 }
 
-func (p *SkuImage) GetImageID() (v int64) {
-	return p.ImageID
+func (p *WebhookEndpointInfo) GetEndpointID() (v int64) {
+	return p.EndpointID
 }
 
-func (p *SkuImage) GetSkuID() (v int64) {
-	return p.SkuID
+func (p *WebhookEndpointInfo) GetShopID() (v int64) {
+	return p.ShopID
 }
 
-func (p *SkuImage) GetURL() (v string) {
+func (p *WebhookEndpointInfo) GetURL() (v string) {
 	return p.URL
 }
 
-func (p *SkuImage) GetCreatedAt() (v int64) {
-	return p.CreatedAt
+func (p *WebhookEndpointInfo) GetEventTypes() (v []string) {
+	return p.EventTypes
 }
 
-var SkuImage_DeletedAt_DEFAULT int64
+func (p *WebhookEndpointInfo) GetEnabled() (v bool) {
+	return p.Enabled
+}
 
-func (p *SkuImage) GetDeletedAt() (v int64) {
-	if !p.IsSetDeletedAt() {
-		return SkuImage_DeletedAt_DEFAULT
-	}
-	return *p.DeletedAt
+func (p *WebhookEndpointInfo) GetConsecutiveFailures() (v int64) {
+	return p.ConsecutiveFailures
 }
 
-var fieldIDToName_SkuImage = map[int16]string{
-	1: "imageID",
-	2: "skuID",
-	3: "url",
-	4: "createdAt",
-	5: "deletedAt",
+func (p *WebhookEndpointInfo) GetCreatedAt() (v int64) {
+	return p.CreatedAt
 }
 
-func (p *SkuImage) IsSetDeletedAt() bool {
-	return p.DeletedAt != nil
+func (p *WebhookEndpointInfo) GetUpdatedAt() (v int64) {
+	return p.UpdatedAt
 }
 
-func (p *SkuImage) Read(iprot thrift.TProtocol) (err error) {
+var fieldIDToName_WebhookEndpointInfo = map[int16]string{
+	1: "endpointID",
+	2: "shopID",
+	3: "url",
+	4: "eventTypes",
+	5: "enabled",
+	6: "consecutiveFailures",
+	7: "createdAt",
+	8: "updatedAt",
+}
+
+func (p *WebhookEndpointInfo) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetImageID bool = false
-	var issetSkuID bool = false
-	var issetURL bool = false
-	var issetCreatedAt bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -3143,7 +3678,6 @@ func (p *SkuImage) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetImageID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -3152,7 +3686,6 @@ func (p *SkuImage) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetSkuID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -3161,27 +3694,49 @@ func (p *SkuImage) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetURL = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 4:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetCreatedAt = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 5:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.BOOL {
 				if err = p.ReadField5(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -3195,32 +3750,13 @@ func (p *SkuImage) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetImageID {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetSkuID {
-		fieldId = 2
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetURL {
-		fieldId = 3
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetCreatedAt {
-		fieldId = 4
-		goto RequiredFieldNotSetError
-	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SkuImage[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_WebhookEndpointInfo[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3228,11 +3764,9 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_SkuImage[fieldId]))
 }
 
-func (p *SkuImage) ReadField1(iprot thrift.TProtocol) error {
+func (p *WebhookEndpointInfo) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -3240,10 +3774,10 @@ func (p *SkuImage) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.ImageID = _field
+	p.EndpointID = _field
 	return nil
 }
-func (p *SkuImage) ReadField2(iprot thrift.TProtocol) error {
+func (p *WebhookEndpointInfo) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -3251,10 +3785,10 @@ func (p *SkuImage) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.SkuID = _field
+	p.ShopID = _field
 	return nil
 }
-func (p *SkuImage) ReadField3(iprot thrift.TProtocol) error {
+func (p *WebhookEndpointInfo) ReadField3(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -3265,7 +3799,52 @@ func (p *SkuImage) ReadField3(iprot thrift.TProtocol) error {
 	p.URL = _field
 	return nil
 }
-func (p *SkuImage) ReadField4(iprot thrift.TProtocol) error {
+func (p *WebhookEndpointInfo) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.EventTypes = _field
+	return nil
+}
+func (p *WebhookEndpointInfo) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Enabled = _field
+	return nil
+}
+func (p *WebhookEndpointInfo) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ConsecutiveFailures = _field
+	return nil
+}
+func (p *WebhookEndpointInfo) ReadField7(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -3276,22 +3855,22 @@ func (p *SkuImage) ReadField4(iprot thrift.TProtocol) error {
 	p.CreatedAt = _field
 	return nil
 }
-func (p *SkuImage) ReadField5(iprot thrift.TProtocol) error {
+func (p *WebhookEndpointInfo) ReadField8(iprot thrift.TProtocol) error {
 
-	var _field *int64
+	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		_field = &v
+		_field = v
 	}
-	p.DeletedAt = _field
+	p.UpdatedAt = _field
 	return nil
 }
 
-func (p *SkuImage) Write(oprot thrift.TProtocol) (err error) {
+func (p *WebhookEndpointInfo) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SkuImage"); err != nil {
+	if err = oprot.WriteStructBegin("WebhookEndpointInfo"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3315,10 +3894,22 @@ func (p *SkuImage) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 5
 			goto WriteFieldError
 		}
-	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
-	}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
 	if err = oprot.WriteStructEnd(); err != nil {
 		goto WriteStructEndError
 	}
@@ -3333,11 +3924,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SkuImage) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("imageID", thrift.I64, 1); err != nil {
+func (p *WebhookEndpointInfo) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("endpointID", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.ImageID); err != nil {
+	if err := oprot.WriteI64(p.EndpointID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3350,11 +3941,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *SkuImage) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("skuID", thrift.I64, 2); err != nil {
+func (p *WebhookEndpointInfo) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("shopID", thrift.I64, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.SkuID); err != nil {
+	if err := oprot.WriteI64(p.ShopID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3367,7 +3958,7 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *SkuImage) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *WebhookEndpointInfo) writeField3(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("url", thrift.STRING, 3); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -3384,11 +3975,19 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *SkuImage) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("createdAt", thrift.I64, 4); err != nil {
+func (p *WebhookEndpointInfo) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("eventTypes", thrift.LIST, 4); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.CreatedAt); err != nil {
+	if err := oprot.WriteListBegin(thrift.STRING, len(p.EventTypes)); err != nil {
+		return err
+	}
+	for _, v := range p.EventTypes {
+		if err := oprot.WriteString(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3401,17 +4000,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *SkuImage) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetDeletedAt() {
-		if err = oprot.WriteFieldBegin("deletedAt", thrift.I64, 5); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI64(*p.DeletedAt); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *WebhookEndpointInfo) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("enabled", thrift.BOOL, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Enabled); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
@@ -3420,123 +4017,159 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func (p *SkuImage) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *WebhookEndpointInfo) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("consecutiveFailures", thrift.I64, 6); err != nil {
+		goto WriteFieldBeginError
 	}
-	return fmt.Sprintf("SkuImage(%+v)", *p)
+	if err := oprot.WriteI64(p.ConsecutiveFailures); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
 
+func (p *WebhookEndpointInfo) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("createdAt", thrift.I64, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.CreatedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
 
-type Spu struct {
-	SpuID            int64   `thrift:"spuID,1,required" form:"spuID,required" json:"spuID,required" query:"spuID,required"`
-	Name             string  `thrift:"name,2,required" form:"name,required" json:"name,required" query:"name,required"`
-	CreatorID        int64   `thrift:"creatorID,3,required" form:"creatorID,required" json:"creatorID,required" query:"creatorID,required"`
-	Description      string  `thrift:"description,4,required" form:"description,required" json:"description,required" query:"description,required"`
-	CategoryID       int64   `thrift:"categoryID,5,required" form:"categoryID,required" json:"categoryID,required" query:"categoryID,required"`
-	GoodsHeadDrawing string  `thrift:"goodsHeadDrawing,6,required" form:"goodsHeadDrawing,required" json:"goodsHeadDrawing,required" query:"goodsHeadDrawing,required"`
-	Price            float64 `thrift:"price,7,required" form:"price,required" json:"price,required" query:"price,required"`
-	ForSale          int32   `thrift:"forSale,8,required" form:"forSale,required" json:"forSale,required" query:"forSale,required"`
-	Shipping         float64 `thrift:"shipping,9,required" form:"shipping,required" json:"shipping,required" query:"shipping,required"`
-	CreatedAt        int64   `thrift:"createdAt,10,required" form:"createdAt,required" json:"createdAt,required" query:"createdAt,required"`
-	UpdatedAt        int64   `thrift:"updatedAt,11,required" form:"updatedAt,required" json:"updatedAt,required" query:"updatedAt,required"`
-	DeletedAt        *int64  `thrift:"deletedAt,12,optional" form:"deletedAt" json:"deletedAt,omitempty" query:"deletedAt"`
+func (p *WebhookEndpointInfo) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("updatedAt", thrift.I64, 8); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UpdatedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
 }
 
-func NewSpu() *Spu {
-	return &Spu{}
+func (p *WebhookEndpointInfo) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("WebhookEndpointInfo(%+v)", *p)
+
 }
 
-func (p *Spu) InitDefault() {
+type WebhookDeliveryInfo struct {
+	DeliveryID int64 `thrift:"deliveryID,1" form:"deliveryID" json:"deliveryID" query:"deliveryID"`
+	EndpointID int64 `thrift:"endpointID,2" form:"endpointID" json:"endpointID" query:"endpointID"`
+	// 重试和重新投递时保持不变, 商家可以据此去重
+	EventID   string `thrift:"eventID,3" form:"eventID" json:"eventID" query:"eventID"`
+	EventType string `thrift:"eventType,4" form:"eventType" json:"eventType" query:"eventType"`
+	// 投递的请求体, JSON 格式
+	Payload string `thrift:"payload,5" form:"payload" json:"payload" query:"payload"`
+	// 0-等待投递, 1-成功, 2-失败
+	Status   int32 `thrift:"status,6" form:"status" json:"status" query:"status"`
+	Attempts int64 `thrift:"attempts,7" form:"attempts" json:"attempts" query:"attempts"`
+	// 毫秒时间戳
+	NextRetryAt int64 `thrift:"nextRetryAt,8" form:"nextRetryAt" json:"nextRetryAt" query:"nextRetryAt"`
+	// 最后一次投递时商家返回的 HTTP 状态码, 没有收到响应时为 0
+	LastStatusCode int64  `thrift:"lastStatusCode,9" form:"lastStatusCode" json:"lastStatusCode" query:"lastStatusCode"`
+	LastError      string `thrift:"lastError,10" form:"lastError" json:"lastError" query:"lastError"`
+	CreatedAt      int64  `thrift:"createdAt,11" form:"createdAt" json:"createdAt" query:"createdAt"`
+	UpdatedAt      int64  `thrift:"updatedAt,12" form:"updatedAt" json:"updatedAt" query:"updatedAt"`
 }
 
-func (p *Spu) GetSpuID() (v int64) {
-	return p.SpuID
+func NewWebhookDeliveryInfo() *WebhookDeliveryInfo {
+	return &WebhookDeliveryInfo{}
 }
 
-func (p *Spu) GetName() (v string) {
-	return p.Name
+func (p *WebhookDeliveryInfo) InitDefault() {
 }
 
-func (p *Spu) GetCreatorID() (v int64) {
-	return p.CreatorID
+func (p *WebhookDeliveryInfo) GetDeliveryID() (v int64) {
+	return p.DeliveryID
 }
 
-func (p *Spu) GetDescription() (v string) {
-	return p.Description
+func (p *WebhookDeliveryInfo) GetEndpointID() (v int64) {
+	return p.EndpointID
 }
 
-func (p *Spu) GetCategoryID() (v int64) {
-	return p.CategoryID
+func (p *WebhookDeliveryInfo) GetEventID() (v string) {
+	return p.EventID
 }
 
-func (p *Spu) GetGoodsHeadDrawing() (v string) {
-	return p.GoodsHeadDrawing
+func (p *WebhookDeliveryInfo) GetEventType() (v string) {
+	return p.EventType
 }
 
-func (p *Spu) GetPrice() (v float64) {
-	return p.Price
+func (p *WebhookDeliveryInfo) GetPayload() (v string) {
+	return p.Payload
 }
 
-func (p *Spu) GetForSale() (v int32) {
-	return p.ForSale
+func (p *WebhookDeliveryInfo) GetStatus() (v int32) {
+	return p.Status
 }
 
-func (p *Spu) GetShipping() (v float64) {
-	return p.Shipping
+func (p *WebhookDeliveryInfo) GetAttempts() (v int64) {
+	return p.Attempts
 }
 
-func (p *Spu) GetCreatedAt() (v int64) {
-	return p.CreatedAt
+func (p *WebhookDeliveryInfo) GetNextRetryAt() (v int64) {
+	return p.NextRetryAt
 }
 
-func (p *Spu) GetUpdatedAt() (v int64) {
-	return p.UpdatedAt
+func (p *WebhookDeliveryInfo) GetLastStatusCode() (v int64) {
+	return p.LastStatusCode
 }
 
-var Spu_DeletedAt_DEFAULT int64
+func (p *WebhookDeliveryInfo) GetLastError() (v string) {
+	return p.LastError
+}
 
-func (p *Spu) GetDeletedAt() (v int64) {
-	if !p.IsSetDeletedAt() {
-		return Spu_DeletedAt_DEFAULT
-	}
-	return *p.DeletedAt
+func (p *WebhookDeliveryInfo) GetCreatedAt() (v int64) {
+	return p.CreatedAt
 }
 
-var fieldIDToName_Spu = map[int16]string{
-	1:  "spuID",
-	2:  "name",
-	3:  "creatorID",
-	4:  "description",
-	5:  "categoryID",
-	6:  "goodsHeadDrawing",
-	7:  "price",
-	8:  "forSale",
-	9:  "shipping",
-	10: "createdAt",
-	11: "updatedAt",
-	12: "deletedAt",
+func (p *WebhookDeliveryInfo) GetUpdatedAt() (v int64) {
+	return p.UpdatedAt
 }
 
-func (p *Spu) IsSetDeletedAt() bool {
-	return p.DeletedAt != nil
+var fieldIDToName_WebhookDeliveryInfo = map[int16]string{
+	1:  "deliveryID",
+	2:  "endpointID",
+	3:  "eventID",
+	4:  "eventType",
+	5:  "payload",
+	6:  "status",
+	7:  "attempts",
+	8:  "nextRetryAt",
+	9:  "lastStatusCode",
+	10: "lastError",
+	11: "createdAt",
+	12: "updatedAt",
 }
 
-func (p *Spu) Read(iprot thrift.TProtocol) (err error) {
+func (p *WebhookDeliveryInfo) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetSpuID bool = false
-	var issetName bool = false
-	var issetCreatorID bool = false
-	var issetDescription bool = false
-	var issetCategoryID bool = false
-	var issetGoodsHeadDrawing bool = false
-	var issetPrice bool = false
-	var issetForSale bool = false
-	var issetShipping bool = false
-	var issetCreatedAt bool = false
-	var issetUpdatedAt bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -3557,25 +4190,22 @@ func (p *Spu) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetSpuID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetName = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetCreatorID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -3584,61 +4214,54 @@ func (p *Spu) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetDescription = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 5:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField5(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetCategoryID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 6:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField6(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetGoodsHeadDrawing = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 7:
-			if fieldTypeId == thrift.DOUBLE {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField7(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetPrice = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 8:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField8(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetForSale = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 9:
-			if fieldTypeId == thrift.DOUBLE {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField9(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetShipping = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 10:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField10(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetCreatedAt = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -3647,7 +4270,6 @@ func (p *Spu) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField11(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetUpdatedAt = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -3672,67 +4294,13 @@ func (p *Spu) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetSpuID {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetName {
-		fieldId = 2
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetCreatorID {
-		fieldId = 3
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetDescription {
-		fieldId = 4
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetCategoryID {
-		fieldId = 5
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetGoodsHeadDrawing {
-		fieldId = 6
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetPrice {
-		fieldId = 7
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetForSale {
-		fieldId = 8
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetShipping {
-		fieldId = 9
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetCreatedAt {
-		fieldId = 10
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetUpdatedAt {
-		fieldId = 11
-		goto RequiredFieldNotSetError
-	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Spu[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_WebhookDeliveryInfo[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3740,11 +4308,9 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_Spu[fieldId]))
 }
 
-func (p *Spu) ReadField1(iprot thrift.TProtocol) error {
+func (p *WebhookDeliveryInfo) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -3752,32 +4318,32 @@ func (p *Spu) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.SpuID = _field
+	p.DeliveryID = _field
 	return nil
 }
-func (p *Spu) ReadField2(iprot thrift.TProtocol) error {
+func (p *WebhookDeliveryInfo) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.Name = _field
+	p.EndpointID = _field
 	return nil
 }
-func (p *Spu) ReadField3(iprot thrift.TProtocol) error {
+func (p *WebhookDeliveryInfo) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.CreatorID = _field
+	p.EventID = _field
 	return nil
 }
-func (p *Spu) ReadField4(iprot thrift.TProtocol) error {
+func (p *WebhookDeliveryInfo) ReadField4(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -3785,76 +4351,76 @@ func (p *Spu) ReadField4(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.Description = _field
+	p.EventType = _field
 	return nil
 }
-func (p *Spu) ReadField5(iprot thrift.TProtocol) error {
+func (p *WebhookDeliveryInfo) ReadField5(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.CategoryID = _field
+	p.Payload = _field
 	return nil
 }
-func (p *Spu) ReadField6(iprot thrift.TProtocol) error {
+func (p *WebhookDeliveryInfo) ReadField6(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.GoodsHeadDrawing = _field
+	p.Status = _field
 	return nil
 }
-func (p *Spu) ReadField7(iprot thrift.TProtocol) error {
+func (p *WebhookDeliveryInfo) ReadField7(iprot thrift.TProtocol) error {
 
-	var _field float64
-	if v, err := iprot.ReadDouble(); err != nil {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.Price = _field
+	p.Attempts = _field
 	return nil
 }
-func (p *Spu) ReadField8(iprot thrift.TProtocol) error {
+func (p *WebhookDeliveryInfo) ReadField8(iprot thrift.TProtocol) error {
 
-	var _field int32
-	if v, err := iprot.ReadI32(); err != nil {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.ForSale = _field
+	p.NextRetryAt = _field
 	return nil
 }
-func (p *Spu) ReadField9(iprot thrift.TProtocol) error {
+func (p *WebhookDeliveryInfo) ReadField9(iprot thrift.TProtocol) error {
 
-	var _field float64
-	if v, err := iprot.ReadDouble(); err != nil {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.Shipping = _field
+	p.LastStatusCode = _field
 	return nil
 }
-func (p *Spu) ReadField10(iprot thrift.TProtocol) error {
+func (p *WebhookDeliveryInfo) ReadField10(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.CreatedAt = _field
+	p.LastError = _field
 	return nil
 }
-func (p *Spu) ReadField11(iprot thrift.TProtocol) error {
+func (p *WebhookDeliveryInfo) ReadField11(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -3862,25 +4428,25 @@ func (p *Spu) ReadField11(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.UpdatedAt = _field
+	p.CreatedAt = _field
 	return nil
 }
-func (p *Spu) ReadField12(iprot thrift.TProtocol) error {
+func (p *WebhookDeliveryInfo) ReadField12(iprot thrift.TProtocol) error {
 
-	var _field *int64
+	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		_field = &v
+		_field = v
 	}
-	p.DeletedAt = _field
+	p.UpdatedAt = _field
 	return nil
 }
 
-func (p *Spu) Write(oprot thrift.TProtocol) (err error) {
+func (p *WebhookDeliveryInfo) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Spu"); err != nil {
+	if err = oprot.WriteStructBegin("WebhookDeliveryInfo"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3950,11 +4516,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *Spu) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("spuID", thrift.I64, 1); err != nil {
+func (p *WebhookDeliveryInfo) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("deliveryID", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.SpuID); err != nil {
+	if err := oprot.WriteI64(p.DeliveryID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3967,11 +4533,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *Spu) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("name", thrift.STRING, 2); err != nil {
+func (p *WebhookDeliveryInfo) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("endpointID", thrift.I64, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Name); err != nil {
+	if err := oprot.WriteI64(p.EndpointID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3984,11 +4550,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *Spu) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("creatorID", thrift.I64, 3); err != nil {
+func (p *WebhookDeliveryInfo) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("eventID", thrift.STRING, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.CreatorID); err != nil {
+	if err := oprot.WriteString(p.EventID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4001,11 +4567,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *Spu) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("description", thrift.STRING, 4); err != nil {
+func (p *WebhookDeliveryInfo) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("eventType", thrift.STRING, 4); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Description); err != nil {
+	if err := oprot.WriteString(p.EventType); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4018,11 +4584,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *Spu) writeField5(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("categoryID", thrift.I64, 5); err != nil {
+func (p *WebhookDeliveryInfo) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("payload", thrift.STRING, 5); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.CategoryID); err != nil {
+	if err := oprot.WriteString(p.Payload); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4035,11 +4601,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func (p *Spu) writeField6(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("goodsHeadDrawing", thrift.STRING, 6); err != nil {
+func (p *WebhookDeliveryInfo) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status", thrift.I32, 6); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.GoodsHeadDrawing); err != nil {
+	if err := oprot.WriteI32(p.Status); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4052,11 +4618,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
 
-func (p *Spu) writeField7(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("price", thrift.DOUBLE, 7); err != nil {
+func (p *WebhookDeliveryInfo) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("attempts", thrift.I64, 7); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteDouble(p.Price); err != nil {
+	if err := oprot.WriteI64(p.Attempts); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4069,11 +4635,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
 
-func (p *Spu) writeField8(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("forSale", thrift.I32, 8); err != nil {
+func (p *WebhookDeliveryInfo) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("nextRetryAt", thrift.I64, 8); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.ForSale); err != nil {
+	if err := oprot.WriteI64(p.NextRetryAt); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4086,11 +4652,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
 }
 
-func (p *Spu) writeField9(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("shipping", thrift.DOUBLE, 9); err != nil {
+func (p *WebhookDeliveryInfo) writeField9(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("lastStatusCode", thrift.I64, 9); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteDouble(p.Shipping); err != nil {
+	if err := oprot.WriteI64(p.LastStatusCode); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4103,11 +4669,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
 }
 
-func (p *Spu) writeField10(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("createdAt", thrift.I64, 10); err != nil {
+func (p *WebhookDeliveryInfo) writeField10(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("lastError", thrift.STRING, 10); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.CreatedAt); err != nil {
+	if err := oprot.WriteString(p.LastError); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4120,11 +4686,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
 }
 
-func (p *Spu) writeField11(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("updatedAt", thrift.I64, 11); err != nil {
+func (p *WebhookDeliveryInfo) writeField11(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("createdAt", thrift.I64, 11); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.UpdatedAt); err != nil {
+	if err := oprot.WriteI64(p.CreatedAt); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4137,17 +4703,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
 }
 
-func (p *Spu) writeField12(oprot thrift.TProtocol) (err error) {
-	if p.IsSetDeletedAt() {
-		if err = oprot.WriteFieldBegin("deletedAt", thrift.I64, 12); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI64(*p.DeletedAt); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *WebhookDeliveryInfo) writeField12(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("updatedAt", thrift.I64, 12); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UpdatedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
@@ -4156,152 +4720,37 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
 }
 
-func (p *Spu) String() string {
+func (p *WebhookDeliveryInfo) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("Spu(%+v)", *p)
-
-}
-
-type Sku struct {
-	SkuID            int64        `thrift:"skuID,1,required" form:"skuID,required" json:"skuID,required" query:"skuID,required"`
-	CreatorID        int64        `thrift:"creatorID,2,required" form:"creatorID,required" json:"creatorID,required" query:"creatorID,required"`
-	Price            float64      `thrift:"price,3,required" form:"price,required" json:"price,required" query:"price,required"`
-	Name             string       `thrift:"name,4,required" form:"name,required" json:"name,required" query:"name,required"`
-	Description      string       `thrift:"description,5,required" form:"description,required" json:"description,required" query:"description,required"`
-	ForSale          int32        `thrift:"forSale,6,required" form:"forSale,required" json:"forSale,required" query:"forSale,required"`
-	Stock            int64        `thrift:"stock,7,required" form:"stock,required" json:"stock,required" query:"stock,required"`
-	StyleHeadDrawing string       `thrift:"styleHeadDrawing,8,required" form:"styleHeadDrawing,required" json:"styleHeadDrawing,required" query:"styleHeadDrawing,required"`
-	CreatedAt        int64        `thrift:"createdAt,9,required" form:"createdAt,required" json:"createdAt,required" query:"createdAt,required"`
-	UpdatedAt        int64        `thrift:"updatedAt,10,required" form:"updatedAt,required" json:"updatedAt,required" query:"updatedAt,required"`
-	DeletedAt        *int64       `thrift:"deletedAt,11,optional" form:"deletedAt" json:"deletedAt,omitempty" query:"deletedAt"`
-	SpuID            int64        `thrift:"spuID,12,required" form:"spuID,required" json:"spuID,required" query:"spuID,required"`
-	SaleAttr         []*AttrValue `thrift:"saleAttr,13,optional" form:"saleAttr" json:"saleAttr,omitempty" query:"saleAttr"`
-	HistoryID        int64        `thrift:"historyID,14,required" form:"historyID,required" json:"historyID,required" query:"historyID,required"`
-	LockStock        int64        `thrift:"lockStock,15,required" form:"lockStock,required" json:"lockStock,required" query:"lockStock,required"`
-}
-
-func NewSku() *Sku {
-	return &Sku{}
-}
-
-func (p *Sku) InitDefault() {
-}
-
-func (p *Sku) GetSkuID() (v int64) {
-	return p.SkuID
-}
-
-func (p *Sku) GetCreatorID() (v int64) {
-	return p.CreatorID
-}
-
-func (p *Sku) GetPrice() (v float64) {
-	return p.Price
-}
-
-func (p *Sku) GetName() (v string) {
-	return p.Name
-}
-
-func (p *Sku) GetDescription() (v string) {
-	return p.Description
-}
-
-func (p *Sku) GetForSale() (v int32) {
-	return p.ForSale
-}
-
-func (p *Sku) GetStock() (v int64) {
-	return p.Stock
-}
-
-func (p *Sku) GetStyleHeadDrawing() (v string) {
-	return p.StyleHeadDrawing
-}
-
-func (p *Sku) GetCreatedAt() (v int64) {
-	return p.CreatedAt
-}
-
-func (p *Sku) GetUpdatedAt() (v int64) {
-	return p.UpdatedAt
-}
-
-var Sku_DeletedAt_DEFAULT int64
-
-func (p *Sku) GetDeletedAt() (v int64) {
-	if !p.IsSetDeletedAt() {
-		return Sku_DeletedAt_DEFAULT
-	}
-	return *p.DeletedAt
-}
-
-func (p *Sku) GetSpuID() (v int64) {
-	return p.SpuID
-}
-
-var Sku_SaleAttr_DEFAULT []*AttrValue
+	return fmt.Sprintf("WebhookDeliveryInfo(%+v)", *p)
 
-func (p *Sku) GetSaleAttr() (v []*AttrValue) {
-	if !p.IsSetSaleAttr() {
-		return Sku_SaleAttr_DEFAULT
-	}
-	return p.SaleAttr
 }
 
-func (p *Sku) GetHistoryID() (v int64) {
-	return p.HistoryID
+type LoginData struct {
+	UserId int64 `thrift:"userId,1" form:"userId" json:"userId" query:"userId"`
 }
 
-func (p *Sku) GetLockStock() (v int64) {
-	return p.LockStock
+func NewLoginData() *LoginData {
+	return &LoginData{}
 }
 
-var fieldIDToName_Sku = map[int16]string{
-	1:  "skuID",
-	2:  "creatorID",
-	3:  "price",
-	4:  "name",
-	5:  "description",
-	6:  "forSale",
-	7:  "stock",
-	8:  "styleHeadDrawing",
-	9:  "createdAt",
-	10: "updatedAt",
-	11: "deletedAt",
-	12: "spuID",
-	13: "saleAttr",
-	14: "historyID",
-	15: "lockStock",
+func (p *LoginData) InitDefault() {
 }
 
-func (p *Sku) IsSetDeletedAt() bool {
-	return p.DeletedAt != nil
+func (p *LoginData) GetUserId() (v int64) {
+	return p.UserId
 }
 
-func (p *Sku) IsSetSaleAttr() bool {
-	return p.SaleAttr != nil
+var fieldIDToName_LoginData = map[int16]string{
+	1: "userId",
 }
 
-func (p *Sku) Read(iprot thrift.TProtocol) (err error) {
+func (p *LoginData) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetSkuID bool = false
-	var issetCreatorID bool = false
-	var issetPrice bool = false
-	var issetName bool = false
-	var issetDescription bool = false
-	var issetForSale bool = false
-	var issetStock bool = false
-	var issetStyleHeadDrawing bool = false
-	var issetCreatedAt bool = false
-	var issetUpdatedAt bool = false
-	var issetSpuID bool = false
-	var issetHistoryID bool = false
-	var issetLockStock bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -4322,131 +4771,6 @@ func (p *Sku) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetSkuID = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 2:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetCreatorID = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 3:
-			if fieldTypeId == thrift.DOUBLE {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetPrice = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 4:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetName = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 5:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetDescription = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 6:
-			if fieldTypeId == thrift.I32 {
-				if err = p.ReadField6(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetForSale = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 7:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField7(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetStock = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 8:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField8(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetStyleHeadDrawing = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 9:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField9(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetCreatedAt = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 10:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField10(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetUpdatedAt = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 11:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField11(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 12:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField12(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetSpuID = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 13:
-			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField13(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 14:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField14(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetHistoryID = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 15:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField15(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetLockStock = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -4463,77 +4787,13 @@ func (p *Sku) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetSkuID {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetCreatorID {
-		fieldId = 2
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetPrice {
-		fieldId = 3
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetName {
-		fieldId = 4
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetDescription {
-		fieldId = 5
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetForSale {
-		fieldId = 6
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetStock {
-		fieldId = 7
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetStyleHeadDrawing {
-		fieldId = 8
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetCreatedAt {
-		fieldId = 9
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetUpdatedAt {
-		fieldId = 10
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetSpuID {
-		fieldId = 12
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetHistoryID {
-		fieldId = 14
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetLockStock {
-		fieldId = 15
-		goto RequiredFieldNotSetError
-	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Sku[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_LoginData[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4541,11 +4801,9 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_Sku[fieldId]))
 }
 
-func (p *Sku) ReadField1(iprot thrift.TProtocol) error {
+func (p *LoginData) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -4553,154 +4811,169 @@ func (p *Sku) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.SkuID = _field
+	p.UserId = _field
 	return nil
 }
-func (p *Sku) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
+func (p *LoginData) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("LoginData"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	p.CreatorID = _field
 	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
-func (p *Sku) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field float64
-	if v, err := iprot.ReadDouble(); err != nil {
+func (p *LoginData) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("userId", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UserId); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.Price = _field
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
 	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *Sku) ReadField4(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
+func (p *LoginData) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	p.Name = _field
-	return nil
+	return fmt.Sprintf("LoginData(%+v)", *p)
+
 }
-func (p *Sku) ReadField5(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.Description = _field
-	return nil
+type CategoryInfo struct {
+	CategoryID int64  `thrift:"categoryID,1,required" form:"categoryID,required" json:"categoryID,required" query:"categoryID,required"`
+	Name       string `thrift:"name,2,required" form:"name,required" json:"name,required" query:"name,required"`
 }
-func (p *Sku) ReadField6(iprot thrift.TProtocol) error {
 
-	var _field int32
-	if v, err := iprot.ReadI32(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.ForSale = _field
-	return nil
+func NewCategoryInfo() *CategoryInfo {
+	return &CategoryInfo{}
 }
-func (p *Sku) ReadField7(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.Stock = _field
-	return nil
+func (p *CategoryInfo) InitDefault() {
 }
-func (p *Sku) ReadField8(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.StyleHeadDrawing = _field
-	return nil
+func (p *CategoryInfo) GetCategoryID() (v int64) {
+	return p.CategoryID
 }
-func (p *Sku) ReadField9(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.CreatedAt = _field
-	return nil
+func (p *CategoryInfo) GetName() (v string) {
+	return p.Name
 }
-func (p *Sku) ReadField10(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.UpdatedAt = _field
-	return nil
+var fieldIDToName_CategoryInfo = map[int16]string{
+	1: "categoryID",
+	2: "name",
 }
-func (p *Sku) ReadField11(iprot thrift.TProtocol) error {
 
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.DeletedAt = _field
-	return nil
-}
-func (p *Sku) ReadField12(iprot thrift.TProtocol) error {
+func (p *CategoryInfo) Read(iprot thrift.TProtocol) (err error) {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.SpuID = _field
-	return nil
-}
-func (p *Sku) ReadField13(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetCategoryID bool = false
+	var issetName bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	_field := make([]*AttrValue, 0, size)
-	values := make([]AttrValue, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
 
-		if err := _elem.Read(iprot); err != nil {
-			return err
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
 		}
 
-		_field = append(_field, _elem)
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCategoryID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetName = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return err
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetCategoryID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetName {
+		fieldId = 2
+		goto RequiredFieldNotSetError
 	}
-	p.SaleAttr = _field
 	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CategoryInfo[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_CategoryInfo[fieldId]))
 }
-func (p *Sku) ReadField14(iprot thrift.TProtocol) error {
+
+func (p *CategoryInfo) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -4708,25 +4981,25 @@ func (p *Sku) ReadField14(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.HistoryID = _field
+	p.CategoryID = _field
 	return nil
 }
-func (p *Sku) ReadField15(iprot thrift.TProtocol) error {
+func (p *CategoryInfo) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.LockStock = _field
+	p.Name = _field
 	return nil
 }
 
-func (p *Sku) Write(oprot thrift.TProtocol) (err error) {
+func (p *CategoryInfo) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Sku"); err != nil {
+	if err = oprot.WriteStructBegin("CategoryInfo"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4738,58 +5011,6 @@ func (p *Sku) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
-		if err = p.writeField6(oprot); err != nil {
-			fieldId = 6
-			goto WriteFieldError
-		}
-		if err = p.writeField7(oprot); err != nil {
-			fieldId = 7
-			goto WriteFieldError
-		}
-		if err = p.writeField8(oprot); err != nil {
-			fieldId = 8
-			goto WriteFieldError
-		}
-		if err = p.writeField9(oprot); err != nil {
-			fieldId = 9
-			goto WriteFieldError
-		}
-		if err = p.writeField10(oprot); err != nil {
-			fieldId = 10
-			goto WriteFieldError
-		}
-		if err = p.writeField11(oprot); err != nil {
-			fieldId = 11
-			goto WriteFieldError
-		}
-		if err = p.writeField12(oprot); err != nil {
-			fieldId = 12
-			goto WriteFieldError
-		}
-		if err = p.writeField13(oprot); err != nil {
-			fieldId = 13
-			goto WriteFieldError
-		}
-		if err = p.writeField14(oprot); err != nil {
-			fieldId = 14
-			goto WriteFieldError
-		}
-		if err = p.writeField15(oprot); err != nil {
-			fieldId = 15
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -4808,11 +5029,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *Sku) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("skuID", thrift.I64, 1); err != nil {
+func (p *CategoryInfo) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("categoryID", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.SkuID); err != nil {
+	if err := oprot.WriteI64(p.CategoryID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4825,11 +5046,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *Sku) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("creatorID", thrift.I64, 2); err != nil {
+func (p *CategoryInfo) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("name", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.CreatorID); err != nil {
+	if err := oprot.WriteString(p.Name); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4842,10 +5063,4569 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *Sku) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("price", thrift.DOUBLE, 3); err != nil {
-		goto WriteFieldBeginError
-	}
+func (p *CategoryInfo) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CategoryInfo(%+v)", *p)
+
+}
+
+type Coupon struct {
+	CouponID       int64    `thrift:"couponID,1,required" form:"couponID,required" json:"couponID,required" query:"couponID,required"`
+	CreatorID      int64    `thrift:"creatorID,2,required" form:"creatorID,required" json:"creatorID,required" query:"creatorID,required"`
+	DeadlineForGet int64    `thrift:"deadlineForGet,3,required" form:"deadlineForGet,required" json:"deadlineForGet,required" query:"deadlineForGet,required"`
+	Name           string   `thrift:"name,4,required" form:"name,required" json:"name,required" query:"name,required"`
+	TypeInfo       int32    `thrift:"typeInfo,5,required" form:"typeInfo,required" json:"typeInfo,required" query:"typeInfo,required"`
+	ConditionCost  float64  `thrift:"conditionCost,6,required" form:"conditionCost,required" json:"conditionCost,required" query:"conditionCost,required"`
+	DiscountAmount *float64 `thrift:"discountAmount,7,optional" form:"discountAmount" json:"discountAmount,omitempty" query:"discountAmount"`
+	Discount       *float64 `thrift:"discount,8,optional" form:"discount" json:"discount,omitempty" query:"discount"`
+	RangeType      int32    `thrift:"rangeType,9,required" form:"rangeType,required" json:"rangeType,required" query:"rangeType,required"`
+	RangeId        int64    `thrift:"rangeId,10,required" form:"rangeId,required" json:"rangeId,required" query:"rangeId,required"`
+	ExpireTime     int64    `thrift:"expireTime,11,required" form:"expireTime,required" json:"expireTime,required" query:"expireTime,required"`
+	Description    string   `thrift:"description,12,required" form:"description,required" json:"description,required" query:"description,required"`
+	CreatedAt      int64    `thrift:"createdAt,13,required" form:"createdAt,required" json:"createdAt,required" query:"createdAt,required"`
+	UpdatedAt      *int64   `thrift:"updatedAt,14,optional" form:"updatedAt" json:"updatedAt,omitempty" query:"updatedAt"`
+	DeletedAt      *int64   `thrift:"deletedAt,15,optional" form:"deletedAt" json:"deletedAt,omitempty" query:"deletedAt"`
+}
+
+func NewCoupon() *Coupon {
+	return &Coupon{}
+}
+
+func (p *Coupon) InitDefault() {
+}
+
+func (p *Coupon) GetCouponID() (v int64) {
+	return p.CouponID
+}
+
+func (p *Coupon) GetCreatorID() (v int64) {
+	return p.CreatorID
+}
+
+func (p *Coupon) GetDeadlineForGet() (v int64) {
+	return p.DeadlineForGet
+}
+
+func (p *Coupon) GetName() (v string) {
+	return p.Name
+}
+
+func (p *Coupon) GetTypeInfo() (v int32) {
+	return p.TypeInfo
+}
+
+func (p *Coupon) GetConditionCost() (v float64) {
+	return p.ConditionCost
+}
+
+var Coupon_DiscountAmount_DEFAULT float64
+
+func (p *Coupon) GetDiscountAmount() (v float64) {
+	if !p.IsSetDiscountAmount() {
+		return Coupon_DiscountAmount_DEFAULT
+	}
+	return *p.DiscountAmount
+}
+
+var Coupon_Discount_DEFAULT float64
+
+func (p *Coupon) GetDiscount() (v float64) {
+	if !p.IsSetDiscount() {
+		return Coupon_Discount_DEFAULT
+	}
+	return *p.Discount
+}
+
+func (p *Coupon) GetRangeType() (v int32) {
+	return p.RangeType
+}
+
+func (p *Coupon) GetRangeId() (v int64) {
+	return p.RangeId
+}
+
+func (p *Coupon) GetExpireTime() (v int64) {
+	return p.ExpireTime
+}
+
+func (p *Coupon) GetDescription() (v string) {
+	return p.Description
+}
+
+func (p *Coupon) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+
+var Coupon_UpdatedAt_DEFAULT int64
+
+func (p *Coupon) GetUpdatedAt() (v int64) {
+	if !p.IsSetUpdatedAt() {
+		return Coupon_UpdatedAt_DEFAULT
+	}
+	return *p.UpdatedAt
+}
+
+var Coupon_DeletedAt_DEFAULT int64
+
+func (p *Coupon) GetDeletedAt() (v int64) {
+	if !p.IsSetDeletedAt() {
+		return Coupon_DeletedAt_DEFAULT
+	}
+	return *p.DeletedAt
+}
+
+var fieldIDToName_Coupon = map[int16]string{
+	1:  "couponID",
+	2:  "creatorID",
+	3:  "deadlineForGet",
+	4:  "name",
+	5:  "typeInfo",
+	6:  "conditionCost",
+	7:  "discountAmount",
+	8:  "discount",
+	9:  "rangeType",
+	10: "rangeId",
+	11: "expireTime",
+	12: "description",
+	13: "createdAt",
+	14: "updatedAt",
+	15: "deletedAt",
+}
+
+func (p *Coupon) IsSetDiscountAmount() bool {
+	return p.DiscountAmount != nil
+}
+
+func (p *Coupon) IsSetDiscount() bool {
+	return p.Discount != nil
+}
+
+func (p *Coupon) IsSetUpdatedAt() bool {
+	return p.UpdatedAt != nil
+}
+
+func (p *Coupon) IsSetDeletedAt() bool {
+	return p.DeletedAt != nil
+}
+
+func (p *Coupon) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetCouponID bool = false
+	var issetCreatorID bool = false
+	var issetDeadlineForGet bool = false
+	var issetName bool = false
+	var issetTypeInfo bool = false
+	var issetConditionCost bool = false
+	var issetRangeType bool = false
+	var issetRangeId bool = false
+	var issetExpireTime bool = false
+	var issetDescription bool = false
+	var issetCreatedAt bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCouponID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCreatorID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetDeadlineForGet = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetName = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetTypeInfo = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetConditionCost = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetRangeType = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetRangeId = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetExpireTime = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 12:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField12(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetDescription = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 13:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField13(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCreatedAt = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 14:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField14(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 15:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField15(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetCouponID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetCreatorID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetDeadlineForGet {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetName {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetTypeInfo {
+		fieldId = 5
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetConditionCost {
+		fieldId = 6
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetRangeType {
+		fieldId = 9
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetRangeId {
+		fieldId = 10
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetExpireTime {
+		fieldId = 11
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetDescription {
+		fieldId = 12
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetCreatedAt {
+		fieldId = 13
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Coupon[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_Coupon[fieldId]))
+}
+
+func (p *Coupon) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CouponID = _field
+	return nil
+}
+func (p *Coupon) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CreatorID = _field
+	return nil
+}
+func (p *Coupon) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.DeadlineForGet = _field
+	return nil
+}
+func (p *Coupon) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Name = _field
+	return nil
+}
+func (p *Coupon) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.TypeInfo = _field
+	return nil
+}
+func (p *Coupon) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ConditionCost = _field
+	return nil
+}
+func (p *Coupon) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.DiscountAmount = _field
+	return nil
+}
+func (p *Coupon) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Discount = _field
+	return nil
+}
+func (p *Coupon) ReadField9(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.RangeType = _field
+	return nil
+}
+func (p *Coupon) ReadField10(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.RangeId = _field
+	return nil
+}
+func (p *Coupon) ReadField11(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ExpireTime = _field
+	return nil
+}
+func (p *Coupon) ReadField12(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Description = _field
+	return nil
+}
+func (p *Coupon) ReadField13(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CreatedAt = _field
+	return nil
+}
+func (p *Coupon) ReadField14(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.UpdatedAt = _field
+	return nil
+}
+func (p *Coupon) ReadField15(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.DeletedAt = _field
+	return nil
+}
+
+func (p *Coupon) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Coupon"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+		if err = p.writeField12(oprot); err != nil {
+			fieldId = 12
+			goto WriteFieldError
+		}
+		if err = p.writeField13(oprot); err != nil {
+			fieldId = 13
+			goto WriteFieldError
+		}
+		if err = p.writeField14(oprot); err != nil {
+			fieldId = 14
+			goto WriteFieldError
+		}
+		if err = p.writeField15(oprot); err != nil {
+			fieldId = 15
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *Coupon) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("couponID", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.CouponID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *Coupon) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("creatorID", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.CreatorID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *Coupon) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("deadlineForGet", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.DeadlineForGet); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *Coupon) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("name", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Name); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *Coupon) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("typeInfo", thrift.I32, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.TypeInfo); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *Coupon) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("conditionCost", thrift.DOUBLE, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteDouble(p.ConditionCost); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *Coupon) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDiscountAmount() {
+		if err = oprot.WriteFieldBegin("discountAmount", thrift.DOUBLE, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.DiscountAmount); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *Coupon) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDiscount() {
+		if err = oprot.WriteFieldBegin("discount", thrift.DOUBLE, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.Discount); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *Coupon) writeField9(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("rangeType", thrift.I32, 9); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.RangeType); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+
+func (p *Coupon) writeField10(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("rangeId", thrift.I64, 10); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.RangeId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+
+func (p *Coupon) writeField11(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("expireTime", thrift.I64, 11); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ExpireTime); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+}
+
+func (p *Coupon) writeField12(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("description", thrift.STRING, 12); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Description); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
+}
+
+func (p *Coupon) writeField13(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("createdAt", thrift.I64, 13); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.CreatedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 end error: ", p), err)
+}
+
+func (p *Coupon) writeField14(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUpdatedAt() {
+		if err = oprot.WriteFieldBegin("updatedAt", thrift.I64, 14); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.UpdatedAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 14 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 14 end error: ", p), err)
+}
+
+func (p *Coupon) writeField15(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDeletedAt() {
+		if err = oprot.WriteFieldBegin("deletedAt", thrift.I64, 15); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.DeletedAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 15 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 15 end error: ", p), err)
+}
+
+func (p *Coupon) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Coupon(%+v)", *p)
+
+}
+
+type AssignedCouponSpuInfo struct {
+	SpuId         int64   `thrift:"spuId,1,required" form:"spuId,required" json:"spuId,required" query:"spuId,required"`
+	Coupon        *Coupon `thrift:"coupon,2,required" form:"coupon,required" json:"coupon,required" query:"coupon,required"`
+	DiscountPrice float64 `thrift:"discount_price,3,required" form:"discount_price,required" json:"discount_price,required" query:"discount_price,required"`
+}
+
+func NewAssignedCouponSpuInfo() *AssignedCouponSpuInfo {
+	return &AssignedCouponSpuInfo{}
+}
+
+func (p *AssignedCouponSpuInfo) InitDefault() {
+}
+
+func (p *AssignedCouponSpuInfo) GetSpuId() (v int64) {
+	return p.SpuId
+}
+
+var AssignedCouponSpuInfo_Coupon_DEFAULT *Coupon
+
+func (p *AssignedCouponSpuInfo) GetCoupon() (v *Coupon) {
+	if !p.IsSetCoupon() {
+		return AssignedCouponSpuInfo_Coupon_DEFAULT
+	}
+	return p.Coupon
+}
+
+func (p *AssignedCouponSpuInfo) GetDiscountPrice() (v float64) {
+	return p.DiscountPrice
+}
+
+var fieldIDToName_AssignedCouponSpuInfo = map[int16]string{
+	1: "spuId",
+	2: "coupon",
+	3: "discount_price",
+}
+
+func (p *AssignedCouponSpuInfo) IsSetCoupon() bool {
+	return p.Coupon != nil
+}
+
+func (p *AssignedCouponSpuInfo) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetSpuId bool = false
+	var issetCoupon bool = false
+	var issetDiscountPrice bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSpuId = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCoupon = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetDiscountPrice = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetSpuId {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetCoupon {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetDiscountPrice {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AssignedCouponSpuInfo[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AssignedCouponSpuInfo[fieldId]))
+}
+
+func (p *AssignedCouponSpuInfo) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.SpuId = _field
+	return nil
+}
+func (p *AssignedCouponSpuInfo) ReadField2(iprot thrift.TProtocol) error {
+	_field := NewCoupon()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Coupon = _field
+	return nil
+}
+func (p *AssignedCouponSpuInfo) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.DiscountPrice = _field
+	return nil
+}
+
+func (p *AssignedCouponSpuInfo) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AssignedCouponSpuInfo"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AssignedCouponSpuInfo) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("spuId", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.SpuId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AssignedCouponSpuInfo) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("coupon", thrift.STRUCT, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Coupon.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *AssignedCouponSpuInfo) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("discount_price", thrift.DOUBLE, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteDouble(p.DiscountPrice); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *AssignedCouponSpuInfo) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AssignedCouponSpuInfo(%+v)", *p)
+
+}
+
+type UserCoupon struct {
+	Coupon             *Coupon `thrift:"coupon,1,required" form:"coupon,required" json:"coupon,required" query:"coupon,required"`
+	RemainUserUseCount int64   `thrift:"remainUserUseCount,13,required" form:"remainUserUseCount,required" json:"remainUserUseCount,required" query:"remainUserUseCount,required"`
+}
+
+func NewUserCoupon() *UserCoupon {
+	return &UserCoupon{}
+}
+
+func (p *UserCoupon) InitDefault() {
+}
+
+var UserCoupon_Coupon_DEFAULT *Coupon
+
+func (p *UserCoupon) GetCoupon() (v *Coupon) {
+	if !p.IsSetCoupon() {
+		return UserCoupon_Coupon_DEFAULT
+	}
+	return p.Coupon
+}
+
+func (p *UserCoupon) GetRemainUserUseCount() (v int64) {
+	return p.RemainUserUseCount
+}
+
+var fieldIDToName_UserCoupon = map[int16]string{
+	1:  "coupon",
+	13: "remainUserUseCount",
+}
+
+func (p *UserCoupon) IsSetCoupon() bool {
+	return p.Coupon != nil
+}
+
+func (p *UserCoupon) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetCoupon bool = false
+	var issetRemainUserUseCount bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCoupon = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 13:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField13(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetRemainUserUseCount = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetCoupon {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetRemainUserUseCount {
+		fieldId = 13
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserCoupon[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_UserCoupon[fieldId]))
+}
+
+func (p *UserCoupon) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewCoupon()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Coupon = _field
+	return nil
+}
+func (p *UserCoupon) ReadField13(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.RemainUserUseCount = _field
+	return nil
+}
+
+func (p *UserCoupon) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UserCoupon"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField13(oprot); err != nil {
+			fieldId = 13
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserCoupon) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("coupon", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Coupon.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UserCoupon) writeField13(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("remainUserUseCount", thrift.I64, 13); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.RemainUserUseCount); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 end error: ", p), err)
+}
+
+func (p *UserCoupon) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserCoupon(%+v)", *p)
+
+}
+
+type AttrValue struct {
+	SaleAttr  string `thrift:"saleAttr,1,required" form:"saleAttr,required" json:"saleAttr,required" query:"saleAttr,required"`
+	SaleValue string `thrift:"saleValue,2,required" form:"saleValue,required" json:"saleValue,required" query:"saleValue,required"`
+}
+
+func NewAttrValue() *AttrValue {
+	return &AttrValue{}
+}
+
+func (p *AttrValue) InitDefault() {
+}
+
+func (p *AttrValue) GetSaleAttr() (v string) {
+	return p.SaleAttr
+}
+
+func (p *AttrValue) GetSaleValue() (v string) {
+	return p.SaleValue
+}
+
+var fieldIDToName_AttrValue = map[int16]string{
+	1: "saleAttr",
+	2: "saleValue",
+}
+
+func (p *AttrValue) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetSaleAttr bool = false
+	var issetSaleValue bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSaleAttr = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSaleValue = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetSaleAttr {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetSaleValue {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AttrValue[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AttrValue[fieldId]))
+}
+
+func (p *AttrValue) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.SaleAttr = _field
+	return nil
+}
+func (p *AttrValue) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.SaleValue = _field
+	return nil
+}
+
+func (p *AttrValue) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AttrValue"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AttrValue) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("saleAttr", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.SaleAttr); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AttrValue) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("saleValue", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.SaleValue); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *AttrValue) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AttrValue(%+v)", *p)
+
+}
+
+type SpuImage struct {
+	ImageID   int64  `thrift:"imageID,1,required" form:"imageID,required" json:"imageID,required" query:"imageID,required"`
+	SpuID     int64  `thrift:"spuID,2,required" form:"spuID,required" json:"spuID,required" query:"spuID,required"`
+	URL       string `thrift:"url,3,required" form:"url,required" json:"url,required" query:"url,required"`
+	CreatedAt int64  `thrift:"createdAt,4,required" form:"createdAt,required" json:"createdAt,required" query:"createdAt,required"`
+	DeletedAt *int64 `thrift:"deletedAt,5,optional" form:"deletedAt" json:"deletedAt,omitempty" query:"deletedAt"`
+	UpdatedAt int64  `thrift:"updatedAt,6,required" form:"updatedAt,required" json:"updatedAt,required" query:"updatedAt,required"`
+}
+
+func NewSpuImage() *SpuImage {
+	return &SpuImage{}
+}
+
+func (p *SpuImage) InitDefault() {
+}
+
+func (p *SpuImage) GetImageID() (v int64) {
+	return p.ImageID
+}
+
+func (p *SpuImage) GetSpuID() (v int64) {
+	return p.SpuID
+}
+
+func (p *SpuImage) GetURL() (v string) {
+	return p.URL
+}
+
+func (p *SpuImage) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+
+var SpuImage_DeletedAt_DEFAULT int64
+
+func (p *SpuImage) GetDeletedAt() (v int64) {
+	if !p.IsSetDeletedAt() {
+		return SpuImage_DeletedAt_DEFAULT
+	}
+	return *p.DeletedAt
+}
+
+func (p *SpuImage) GetUpdatedAt() (v int64) {
+	return p.UpdatedAt
+}
+
+var fieldIDToName_SpuImage = map[int16]string{
+	1: "imageID",
+	2: "spuID",
+	3: "url",
+	4: "createdAt",
+	5: "deletedAt",
+	6: "updatedAt",
+}
+
+func (p *SpuImage) IsSetDeletedAt() bool {
+	return p.DeletedAt != nil
+}
+
+func (p *SpuImage) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetImageID bool = false
+	var issetSpuID bool = false
+	var issetURL bool = false
+	var issetCreatedAt bool = false
+	var issetUpdatedAt bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetImageID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSpuID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetURL = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCreatedAt = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetUpdatedAt = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetImageID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetSpuID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetURL {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetCreatedAt {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetUpdatedAt {
+		fieldId = 6
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SpuImage[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_SpuImage[fieldId]))
+}
+
+func (p *SpuImage) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ImageID = _field
+	return nil
+}
+func (p *SpuImage) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.SpuID = _field
+	return nil
+}
+func (p *SpuImage) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.URL = _field
+	return nil
+}
+func (p *SpuImage) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CreatedAt = _field
+	return nil
+}
+func (p *SpuImage) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.DeletedAt = _field
+	return nil
+}
+func (p *SpuImage) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UpdatedAt = _field
+	return nil
+}
+
+func (p *SpuImage) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SpuImage"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SpuImage) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("imageID", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ImageID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SpuImage) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("spuID", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.SpuID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *SpuImage) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("url", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.URL); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *SpuImage) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("createdAt", thrift.I64, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.CreatedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *SpuImage) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDeletedAt() {
+		if err = oprot.WriteFieldBegin("deletedAt", thrift.I64, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.DeletedAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *SpuImage) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("updatedAt", thrift.I64, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UpdatedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *SpuImage) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SpuImage(%+v)", *p)
+
+}
+
+type SkuImage struct {
+	ImageID   int64  `thrift:"imageID,1,required" form:"imageID,required" json:"imageID,required" query:"imageID,required"`
+	SkuID     int64  `thrift:"skuID,2,required" form:"skuID,required" json:"skuID,required" query:"skuID,required"`
+	URL       string `thrift:"url,3,required" form:"url,required" json:"url,required" query:"url,required"`
+	CreatedAt int64  `thrift:"createdAt,4,required" form:"createdAt,required" json:"createdAt,required" query:"createdAt,required"`
+	DeletedAt *int64 `thrift:"deletedAt,5,optional" form:"deletedAt" json:"deletedAt,omitempty" query:"deletedAt"`
+}
+
+func NewSkuImage() *SkuImage {
+	return &SkuImage{}
+}
+
+func (p *SkuImage) InitDefault() {
+}
+
+func (p *SkuImage) GetImageID() (v int64) {
+	return p.ImageID
+}
+
+func (p *SkuImage) GetSkuID() (v int64) {
+	return p.SkuID
+}
+
+func (p *SkuImage) GetURL() (v string) {
+	return p.URL
+}
+
+func (p *SkuImage) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+
+var SkuImage_DeletedAt_DEFAULT int64
+
+func (p *SkuImage) GetDeletedAt() (v int64) {
+	if !p.IsSetDeletedAt() {
+		return SkuImage_DeletedAt_DEFAULT
+	}
+	return *p.DeletedAt
+}
+
+var fieldIDToName_SkuImage = map[int16]string{
+	1: "imageID",
+	2: "skuID",
+	3: "url",
+	4: "createdAt",
+	5: "deletedAt",
+}
+
+func (p *SkuImage) IsSetDeletedAt() bool {
+	return p.DeletedAt != nil
+}
+
+func (p *SkuImage) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetImageID bool = false
+	var issetSkuID bool = false
+	var issetURL bool = false
+	var issetCreatedAt bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetImageID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSkuID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetURL = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCreatedAt = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetImageID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetSkuID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetURL {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetCreatedAt {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SkuImage[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_SkuImage[fieldId]))
+}
+
+func (p *SkuImage) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ImageID = _field
+	return nil
+}
+func (p *SkuImage) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.SkuID = _field
+	return nil
+}
+func (p *SkuImage) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.URL = _field
+	return nil
+}
+func (p *SkuImage) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CreatedAt = _field
+	return nil
+}
+func (p *SkuImage) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.DeletedAt = _field
+	return nil
+}
+
+func (p *SkuImage) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SkuImage"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SkuImage) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("imageID", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ImageID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SkuImage) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("skuID", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.SkuID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *SkuImage) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("url", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.URL); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *SkuImage) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("createdAt", thrift.I64, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.CreatedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *SkuImage) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDeletedAt() {
+		if err = oprot.WriteFieldBegin("deletedAt", thrift.I64, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.DeletedAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *SkuImage) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SkuImage(%+v)", *p)
+
+}
+
+type Spu struct {
+	SpuID            int64   `thrift:"spuID,1,required" form:"spuID,required" json:"spuID,required" query:"spuID,required"`
+	Name             string  `thrift:"name,2,required" form:"name,required" json:"name,required" query:"name,required"`
+	CreatorID        int64   `thrift:"creatorID,3,required" form:"creatorID,required" json:"creatorID,required" query:"creatorID,required"`
+	Description      string  `thrift:"description,4,required" form:"description,required" json:"description,required" query:"description,required"`
+	CategoryID       int64   `thrift:"categoryID,5,required" form:"categoryID,required" json:"categoryID,required" query:"categoryID,required"`
+	GoodsHeadDrawing string  `thrift:"goodsHeadDrawing,6,required" form:"goodsHeadDrawing,required" json:"goodsHeadDrawing,required" query:"goodsHeadDrawing,required"`
+	Price            float64 `thrift:"price,7,required" form:"price,required" json:"price,required" query:"price,required"`
+	ForSale          int32   `thrift:"forSale,8,required" form:"forSale,required" json:"forSale,required" query:"forSale,required"`
+	Shipping         float64 `thrift:"shipping,9,required" form:"shipping,required" json:"shipping,required" query:"shipping,required"`
+	CreatedAt        int64   `thrift:"createdAt,10,required" form:"createdAt,required" json:"createdAt,required" query:"createdAt,required"`
+	UpdatedAt        int64   `thrift:"updatedAt,11,required" form:"updatedAt,required" json:"updatedAt,required" query:"updatedAt,required"`
+	DeletedAt        *int64  `thrift:"deletedAt,12,optional" form:"deletedAt" json:"deletedAt,omitempty" query:"deletedAt"`
+	ShopID           int64   `thrift:"shopID,13,required" form:"shopID,required" json:"shopID,required" query:"shopID,required"`
+}
+
+func NewSpu() *Spu {
+	return &Spu{}
+}
+
+func (p *Spu) InitDefault() {
+}
+
+func (p *Spu) GetSpuID() (v int64) {
+	return p.SpuID
+}
+
+func (p *Spu) GetName() (v string) {
+	return p.Name
+}
+
+func (p *Spu) GetCreatorID() (v int64) {
+	return p.CreatorID
+}
+
+func (p *Spu) GetDescription() (v string) {
+	return p.Description
+}
+
+func (p *Spu) GetCategoryID() (v int64) {
+	return p.CategoryID
+}
+
+func (p *Spu) GetGoodsHeadDrawing() (v string) {
+	return p.GoodsHeadDrawing
+}
+
+func (p *Spu) GetPrice() (v float64) {
+	return p.Price
+}
+
+func (p *Spu) GetForSale() (v int32) {
+	return p.ForSale
+}
+
+func (p *Spu) GetShipping() (v float64) {
+	return p.Shipping
+}
+
+func (p *Spu) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+
+func (p *Spu) GetUpdatedAt() (v int64) {
+	return p.UpdatedAt
+}
+
+var Spu_DeletedAt_DEFAULT int64
+
+func (p *Spu) GetDeletedAt() (v int64) {
+	if !p.IsSetDeletedAt() {
+		return Spu_DeletedAt_DEFAULT
+	}
+	return *p.DeletedAt
+}
+
+func (p *Spu) GetShopID() (v int64) {
+	return p.ShopID
+}
+
+var fieldIDToName_Spu = map[int16]string{
+	1:  "spuID",
+	2:  "name",
+	3:  "creatorID",
+	4:  "description",
+	5:  "categoryID",
+	6:  "goodsHeadDrawing",
+	7:  "price",
+	8:  "forSale",
+	9:  "shipping",
+	10: "createdAt",
+	11: "updatedAt",
+	12: "deletedAt",
+	13: "shopID",
+}
+
+func (p *Spu) IsSetDeletedAt() bool {
+	return p.DeletedAt != nil
+}
+
+func (p *Spu) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetSpuID bool = false
+	var issetName bool = false
+	var issetCreatorID bool = false
+	var issetDescription bool = false
+	var issetCategoryID bool = false
+	var issetGoodsHeadDrawing bool = false
+	var issetPrice bool = false
+	var issetForSale bool = false
+	var issetShipping bool = false
+	var issetCreatedAt bool = false
+	var issetUpdatedAt bool = false
+	var issetShopID bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSpuID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetName = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCreatorID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetDescription = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCategoryID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetGoodsHeadDrawing = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetPrice = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetForSale = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetShipping = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCreatedAt = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetUpdatedAt = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 12:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField12(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 13:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField13(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetShopID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetSpuID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetName {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetCreatorID {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetDescription {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetCategoryID {
+		fieldId = 5
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetGoodsHeadDrawing {
+		fieldId = 6
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetPrice {
+		fieldId = 7
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetForSale {
+		fieldId = 8
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetShipping {
+		fieldId = 9
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetCreatedAt {
+		fieldId = 10
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetUpdatedAt {
+		fieldId = 11
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetShopID {
+		fieldId = 13
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Spu[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_Spu[fieldId]))
+}
+
+func (p *Spu) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.SpuID = _field
+	return nil
+}
+func (p *Spu) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Name = _field
+	return nil
+}
+func (p *Spu) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CreatorID = _field
+	return nil
+}
+func (p *Spu) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Description = _field
+	return nil
+}
+func (p *Spu) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CategoryID = _field
+	return nil
+}
+func (p *Spu) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.GoodsHeadDrawing = _field
+	return nil
+}
+func (p *Spu) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Price = _field
+	return nil
+}
+func (p *Spu) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ForSale = _field
+	return nil
+}
+func (p *Spu) ReadField9(iprot thrift.TProtocol) error {
+
+	var _field float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Shipping = _field
+	return nil
+}
+func (p *Spu) ReadField10(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CreatedAt = _field
+	return nil
+}
+func (p *Spu) ReadField11(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UpdatedAt = _field
+	return nil
+}
+func (p *Spu) ReadField12(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.DeletedAt = _field
+	return nil
+}
+func (p *Spu) ReadField13(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ShopID = _field
+	return nil
+}
+
+func (p *Spu) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Spu"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+		if err = p.writeField12(oprot); err != nil {
+			fieldId = 12
+			goto WriteFieldError
+		}
+		if err = p.writeField13(oprot); err != nil {
+			fieldId = 13
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *Spu) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("spuID", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.SpuID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *Spu) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("name", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Name); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *Spu) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("creatorID", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.CreatorID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *Spu) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("description", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Description); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *Spu) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("categoryID", thrift.I64, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.CategoryID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *Spu) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("goodsHeadDrawing", thrift.STRING, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.GoodsHeadDrawing); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *Spu) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("price", thrift.DOUBLE, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteDouble(p.Price); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *Spu) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("forSale", thrift.I32, 8); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.ForSale); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *Spu) writeField9(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("shipping", thrift.DOUBLE, 9); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteDouble(p.Shipping); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+
+func (p *Spu) writeField10(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("createdAt", thrift.I64, 10); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.CreatedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+
+func (p *Spu) writeField11(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("updatedAt", thrift.I64, 11); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UpdatedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+}
+
+func (p *Spu) writeField12(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDeletedAt() {
+		if err = oprot.WriteFieldBegin("deletedAt", thrift.I64, 12); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.DeletedAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
+}
+
+func (p *Spu) writeField13(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("shopID", thrift.I64, 13); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ShopID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 end error: ", p), err)
+}
+
+func (p *Spu) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Spu(%+v)", *p)
+
+}
+
+type Sku struct {
+	SkuID            int64        `thrift:"skuID,1,required" form:"skuID,required" json:"skuID,required" query:"skuID,required"`
+	CreatorID        int64        `thrift:"creatorID,2,required" form:"creatorID,required" json:"creatorID,required" query:"creatorID,required"`
+	Price            float64      `thrift:"price,3,required" form:"price,required" json:"price,required" query:"price,required"`
+	Name             string       `thrift:"name,4,required" form:"name,required" json:"name,required" query:"name,required"`
+	Description      string       `thrift:"description,5,required" form:"description,required" json:"description,required" query:"description,required"`
+	ForSale          int32        `thrift:"forSale,6,required" form:"forSale,required" json:"forSale,required" query:"forSale,required"`
+	Stock            int64        `thrift:"stock,7,required" form:"stock,required" json:"stock,required" query:"stock,required"`
+	StyleHeadDrawing string       `thrift:"styleHeadDrawing,8,required" form:"styleHeadDrawing,required" json:"styleHeadDrawing,required" query:"styleHeadDrawing,required"`
+	CreatedAt        int64        `thrift:"createdAt,9,required" form:"createdAt,required" json:"createdAt,required" query:"createdAt,required"`
+	UpdatedAt        int64        `thrift:"updatedAt,10,required" form:"updatedAt,required" json:"updatedAt,required" query:"updatedAt,required"`
+	DeletedAt        *int64       `thrift:"deletedAt,11,optional" form:"deletedAt" json:"deletedAt,omitempty" query:"deletedAt"`
+	SpuID            int64        `thrift:"spuID,12,required" form:"spuID,required" json:"spuID,required" query:"spuID,required"`
+	SaleAttr         []*AttrValue `thrift:"saleAttr,13,optional" form:"saleAttr" json:"saleAttr,omitempty" query:"saleAttr"`
+	HistoryID        int64        `thrift:"historyID,14,required" form:"historyID,required" json:"historyID,required" query:"historyID,required"`
+	LockStock        int64        `thrift:"lockStock,15,required" form:"lockStock,required" json:"lockStock,required" query:"lockStock,required"`
+}
+
+func NewSku() *Sku {
+	return &Sku{}
+}
+
+func (p *Sku) InitDefault() {
+}
+
+func (p *Sku) GetSkuID() (v int64) {
+	return p.SkuID
+}
+
+func (p *Sku) GetCreatorID() (v int64) {
+	return p.CreatorID
+}
+
+func (p *Sku) GetPrice() (v float64) {
+	return p.Price
+}
+
+func (p *Sku) GetName() (v string) {
+	return p.Name
+}
+
+func (p *Sku) GetDescription() (v string) {
+	return p.Description
+}
+
+func (p *Sku) GetForSale() (v int32) {
+	return p.ForSale
+}
+
+func (p *Sku) GetStock() (v int64) {
+	return p.Stock
+}
+
+func (p *Sku) GetStyleHeadDrawing() (v string) {
+	return p.StyleHeadDrawing
+}
+
+func (p *Sku) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+
+func (p *Sku) GetUpdatedAt() (v int64) {
+	return p.UpdatedAt
+}
+
+var Sku_DeletedAt_DEFAULT int64
+
+func (p *Sku) GetDeletedAt() (v int64) {
+	if !p.IsSetDeletedAt() {
+		return Sku_DeletedAt_DEFAULT
+	}
+	return *p.DeletedAt
+}
+
+func (p *Sku) GetSpuID() (v int64) {
+	return p.SpuID
+}
+
+var Sku_SaleAttr_DEFAULT []*AttrValue
+
+func (p *Sku) GetSaleAttr() (v []*AttrValue) {
+	if !p.IsSetSaleAttr() {
+		return Sku_SaleAttr_DEFAULT
+	}
+	return p.SaleAttr
+}
+
+func (p *Sku) GetHistoryID() (v int64) {
+	return p.HistoryID
+}
+
+func (p *Sku) GetLockStock() (v int64) {
+	return p.LockStock
+}
+
+var fieldIDToName_Sku = map[int16]string{
+	1:  "skuID",
+	2:  "creatorID",
+	3:  "price",
+	4:  "name",
+	5:  "description",
+	6:  "forSale",
+	7:  "stock",
+	8:  "styleHeadDrawing",
+	9:  "createdAt",
+	10: "updatedAt",
+	11: "deletedAt",
+	12: "spuID",
+	13: "saleAttr",
+	14: "historyID",
+	15: "lockStock",
+}
+
+func (p *Sku) IsSetDeletedAt() bool {
+	return p.DeletedAt != nil
+}
+
+func (p *Sku) IsSetSaleAttr() bool {
+	return p.SaleAttr != nil
+}
+
+func (p *Sku) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetSkuID bool = false
+	var issetCreatorID bool = false
+	var issetPrice bool = false
+	var issetName bool = false
+	var issetDescription bool = false
+	var issetForSale bool = false
+	var issetStock bool = false
+	var issetStyleHeadDrawing bool = false
+	var issetCreatedAt bool = false
+	var issetUpdatedAt bool = false
+	var issetSpuID bool = false
+	var issetHistoryID bool = false
+	var issetLockStock bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSkuID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCreatorID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetPrice = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetName = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetDescription = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetForSale = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetStock = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetStyleHeadDrawing = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCreatedAt = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetUpdatedAt = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 12:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField12(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSpuID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 13:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField13(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 14:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField14(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetHistoryID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 15:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField15(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetLockStock = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetSkuID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetCreatorID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetPrice {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetName {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetDescription {
+		fieldId = 5
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetForSale {
+		fieldId = 6
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetStock {
+		fieldId = 7
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetStyleHeadDrawing {
+		fieldId = 8
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetCreatedAt {
+		fieldId = 9
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetUpdatedAt {
+		fieldId = 10
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetSpuID {
+		fieldId = 12
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetHistoryID {
+		fieldId = 14
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetLockStock {
+		fieldId = 15
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Sku[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_Sku[fieldId]))
+}
+
+func (p *Sku) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.SkuID = _field
+	return nil
+}
+func (p *Sku) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CreatorID = _field
+	return nil
+}
+func (p *Sku) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Price = _field
+	return nil
+}
+func (p *Sku) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Name = _field
+	return nil
+}
+func (p *Sku) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Description = _field
+	return nil
+}
+func (p *Sku) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ForSale = _field
+	return nil
+}
+func (p *Sku) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Stock = _field
+	return nil
+}
+func (p *Sku) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.StyleHeadDrawing = _field
+	return nil
+}
+func (p *Sku) ReadField9(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CreatedAt = _field
+	return nil
+}
+func (p *Sku) ReadField10(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UpdatedAt = _field
+	return nil
+}
+func (p *Sku) ReadField11(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.DeletedAt = _field
+	return nil
+}
+func (p *Sku) ReadField12(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.SpuID = _field
+	return nil
+}
+func (p *Sku) ReadField13(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*AttrValue, 0, size)
+	values := make([]AttrValue, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.SaleAttr = _field
+	return nil
+}
+func (p *Sku) ReadField14(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.HistoryID = _field
+	return nil
+}
+func (p *Sku) ReadField15(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.LockStock = _field
+	return nil
+}
+
+func (p *Sku) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Sku"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+		if err = p.writeField12(oprot); err != nil {
+			fieldId = 12
+			goto WriteFieldError
+		}
+		if err = p.writeField13(oprot); err != nil {
+			fieldId = 13
+			goto WriteFieldError
+		}
+		if err = p.writeField14(oprot); err != nil {
+			fieldId = 14
+			goto WriteFieldError
+		}
+		if err = p.writeField15(oprot); err != nil {
+			fieldId = 15
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *Sku) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("skuID", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.SkuID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *Sku) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("creatorID", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.CreatorID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *Sku) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("price", thrift.DOUBLE, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteDouble(p.Price); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *Sku) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("name", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Name); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *Sku) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("description", thrift.STRING, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Description); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *Sku) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("forSale", thrift.I32, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.ForSale); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *Sku) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("stock", thrift.I64, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.Stock); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *Sku) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("styleHeadDrawing", thrift.STRING, 8); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.StyleHeadDrawing); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *Sku) writeField9(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("createdAt", thrift.I64, 9); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.CreatedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+
+func (p *Sku) writeField10(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("updatedAt", thrift.I64, 10); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UpdatedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+
+func (p *Sku) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDeletedAt() {
+		if err = oprot.WriteFieldBegin("deletedAt", thrift.I64, 11); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.DeletedAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+}
+
+func (p *Sku) writeField12(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("spuID", thrift.I64, 12); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.SpuID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
+}
+
+func (p *Sku) writeField13(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSaleAttr() {
+		if err = oprot.WriteFieldBegin("saleAttr", thrift.LIST, 13); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.SaleAttr)); err != nil {
+			return err
+		}
+		for _, v := range p.SaleAttr {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 end error: ", p), err)
+}
+
+func (p *Sku) writeField14(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("historyID", thrift.I64, 14); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.HistoryID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 14 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 14 end error: ", p), err)
+}
+
+func (p *Sku) writeField15(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("lockStock", thrift.I64, 15); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.LockStock); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 15 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 15 end error: ", p), err)
+}
+
+func (p *Sku) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Sku(%+v)", *p)
+
+}
+
+type SkuInfo struct {
+	SkuID            int64   `thrift:"skuID,1,required" form:"skuID,required" json:"skuID,required" query:"skuID,required"`
+	CreatorID        int64   `thrift:"creatorID,2,required" form:"creatorID,required" json:"creatorID,required" query:"creatorID,required"`
+	Price            float64 `thrift:"price,3,required" form:"price,required" json:"price,required" query:"price,required"`
+	Name             string  `thrift:"name,4,required" form:"name,required" json:"name,required" query:"name,required"`
+	ForSale          int32   `thrift:"forSale,5,required" form:"forSale,required" json:"forSale,required" query:"forSale,required"`
+	LockStock        int64   `thrift:"lockStock,6,required" form:"lockStock,required" json:"lockStock,required" query:"lockStock,required"`
+	StyleHeadDrawing string  `thrift:"styleHeadDrawing,7,required" form:"styleHeadDrawing,required" json:"styleHeadDrawing,required" query:"styleHeadDrawing,required"`
+	SpuID            int64   `thrift:"spuID,8,required" form:"spuID,required" json:"spuID,required" query:"spuID,required"`
+	HistoryID        int64   `thrift:"historyID,9,required" form:"historyID,required" json:"historyID,required" query:"historyID,required"`
+	ShopID           int64   `thrift:"shopID,10,required" form:"shopID,required" json:"shopID,required" query:"shopID,required"`
+}
+
+func NewSkuInfo() *SkuInfo {
+	return &SkuInfo{}
+}
+
+func (p *SkuInfo) InitDefault() {
+}
+
+func (p *SkuInfo) GetSkuID() (v int64) {
+	return p.SkuID
+}
+
+func (p *SkuInfo) GetCreatorID() (v int64) {
+	return p.CreatorID
+}
+
+func (p *SkuInfo) GetPrice() (v float64) {
+	return p.Price
+}
+
+func (p *SkuInfo) GetName() (v string) {
+	return p.Name
+}
+
+func (p *SkuInfo) GetForSale() (v int32) {
+	return p.ForSale
+}
+
+func (p *SkuInfo) GetLockStock() (v int64) {
+	return p.LockStock
+}
+
+func (p *SkuInfo) GetStyleHeadDrawing() (v string) {
+	return p.StyleHeadDrawing
+}
+
+func (p *SkuInfo) GetSpuID() (v int64) {
+	return p.SpuID
+}
+
+func (p *SkuInfo) GetHistoryID() (v int64) {
+	return p.HistoryID
+}
+
+func (p *SkuInfo) GetShopID() (v int64) {
+	return p.ShopID
+}
+
+var fieldIDToName_SkuInfo = map[int16]string{
+	1:  "skuID",
+	2:  "creatorID",
+	3:  "price",
+	4:  "name",
+	5:  "forSale",
+	6:  "lockStock",
+	7:  "styleHeadDrawing",
+	8:  "spuID",
+	9:  "historyID",
+	10: "shopID",
+}
+
+func (p *SkuInfo) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetSkuID bool = false
+	var issetCreatorID bool = false
+	var issetPrice bool = false
+	var issetName bool = false
+	var issetForSale bool = false
+	var issetLockStock bool = false
+	var issetStyleHeadDrawing bool = false
+	var issetSpuID bool = false
+	var issetHistoryID bool = false
+	var issetShopID bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSkuID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCreatorID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetPrice = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetName = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetForSale = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetLockStock = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetStyleHeadDrawing = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSpuID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetHistoryID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetShopID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetSkuID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetCreatorID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetPrice {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetName {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetForSale {
+		fieldId = 5
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetLockStock {
+		fieldId = 6
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetStyleHeadDrawing {
+		fieldId = 7
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetSpuID {
+		fieldId = 8
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetHistoryID {
+		fieldId = 9
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetShopID {
+		fieldId = 10
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SkuInfo[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_SkuInfo[fieldId]))
+}
+
+func (p *SkuInfo) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.SkuID = _field
+	return nil
+}
+func (p *SkuInfo) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CreatorID = _field
+	return nil
+}
+func (p *SkuInfo) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Price = _field
+	return nil
+}
+func (p *SkuInfo) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Name = _field
+	return nil
+}
+func (p *SkuInfo) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ForSale = _field
+	return nil
+}
+func (p *SkuInfo) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.LockStock = _field
+	return nil
+}
+func (p *SkuInfo) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.StyleHeadDrawing = _field
+	return nil
+}
+func (p *SkuInfo) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.SpuID = _field
+	return nil
+}
+func (p *SkuInfo) ReadField9(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.HistoryID = _field
+	return nil
+}
+func (p *SkuInfo) ReadField10(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ShopID = _field
+	return nil
+}
+
+func (p *SkuInfo) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SkuInfo"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SkuInfo) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("skuID", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.SkuID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SkuInfo) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("creatorID", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.CreatorID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *SkuInfo) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("price", thrift.DOUBLE, 3); err != nil {
+		goto WriteFieldBeginError
+	}
 	if err := oprot.WriteDouble(p.Price); err != nil {
 		return err
 	}
@@ -4854,154 +9634,615 @@ func (p *Sku) writeField3(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *SkuInfo) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("name", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Name); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *SkuInfo) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("forSale", thrift.I32, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.ForSale); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *SkuInfo) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("lockStock", thrift.I64, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.LockStock); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *SkuInfo) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("styleHeadDrawing", thrift.STRING, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.StyleHeadDrawing); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *SkuInfo) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("spuID", thrift.I64, 8); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.SpuID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *SkuInfo) writeField9(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("historyID", thrift.I64, 9); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.HistoryID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+
+func (p *SkuInfo) writeField10(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("shopID", thrift.I64, 10); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ShopID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+
+func (p *SkuInfo) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SkuInfo(%+v)", *p)
+
+}
+
+type SkuVersion struct {
+	SkuID     int64 `thrift:"skuID,1,required" form:"skuID,required" json:"skuID,required" query:"skuID,required"`
+	VersionID int64 `thrift:"versionID,2,required" form:"versionID,required" json:"versionID,required" query:"versionID,required"`
+}
+
+func NewSkuVersion() *SkuVersion {
+	return &SkuVersion{}
+}
+
+func (p *SkuVersion) InitDefault() {
+}
+
+func (p *SkuVersion) GetSkuID() (v int64) {
+	return p.SkuID
+}
+
+func (p *SkuVersion) GetVersionID() (v int64) {
+	return p.VersionID
+}
+
+var fieldIDToName_SkuVersion = map[int16]string{
+	1: "skuID",
+	2: "versionID",
+}
+
+func (p *SkuVersion) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetSkuID bool = false
+	var issetVersionID bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSkuID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetVersionID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetSkuID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetVersionID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SkuVersion[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_SkuVersion[fieldId]))
+}
+
+func (p *SkuVersion) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.SkuID = _field
+	return nil
+}
+func (p *SkuVersion) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.VersionID = _field
+	return nil
+}
+
+func (p *SkuVersion) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SkuVersion"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SkuVersion) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("skuID", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.SkuID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SkuVersion) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("versionID", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.VersionID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *SkuVersion) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SkuVersion(%+v)", *p)
+
+}
+
+type PriceHistory struct {
+	HistoryID   int64  `thrift:"historyID,1,required" form:"historyID,required" json:"historyID,required" query:"historyID,required"`
+	SkuID       int64  `thrift:"skuID,2,required" form:"skuID,required" json:"skuID,required" query:"skuID,required"`
+	Price       int64  `thrift:"price,3,required" form:"price,required" json:"price,required" query:"price,required"`
+	CreatedAt   int64  `thrift:"createdAt,4,required" form:"createdAt,required" json:"createdAt,required" query:"createdAt,required"`
+	PrevVersion *int64 `thrift:"prevVersion,5,optional" form:"prevVersion" json:"prevVersion,omitempty" query:"prevVersion"`
+}
+
+func NewPriceHistory() *PriceHistory {
+	return &PriceHistory{}
+}
+
+func (p *PriceHistory) InitDefault() {
+}
+
+func (p *PriceHistory) GetHistoryID() (v int64) {
+	return p.HistoryID
+}
+
+func (p *PriceHistory) GetSkuID() (v int64) {
+	return p.SkuID
+}
+
+func (p *PriceHistory) GetPrice() (v int64) {
+	return p.Price
+}
+
+func (p *PriceHistory) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+
+var PriceHistory_PrevVersion_DEFAULT int64
+
+func (p *PriceHistory) GetPrevVersion() (v int64) {
+	if !p.IsSetPrevVersion() {
+		return PriceHistory_PrevVersion_DEFAULT
+	}
+	return *p.PrevVersion
+}
+
+var fieldIDToName_PriceHistory = map[int16]string{
+	1: "historyID",
+	2: "skuID",
+	3: "price",
+	4: "createdAt",
+	5: "prevVersion",
+}
+
+func (p *PriceHistory) IsSetPrevVersion() bool {
+	return p.PrevVersion != nil
 }
 
-func (p *Sku) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("name", thrift.STRING, 4); err != nil {
-		goto WriteFieldBeginError
+func (p *PriceHistory) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetHistoryID bool = false
+	var issetSkuID bool = false
+	var issetPrice bool = false
+	var issetCreatedAt bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetHistoryID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSkuID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetPrice = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCreatedAt = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err := oprot.WriteString(p.Name); err != nil {
-		return err
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+
+	if !issetHistoryID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
-}
 
-func (p *Sku) writeField5(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("description", thrift.STRING, 5); err != nil {
-		goto WriteFieldBeginError
+	if !issetSkuID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
 	}
-	if err := oprot.WriteString(p.Description); err != nil {
-		return err
+
+	if !issetPrice {
+		fieldId = 3
+		goto RequiredFieldNotSetError
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+
+	if !issetCreatedAt {
+		fieldId = 4
+		goto RequiredFieldNotSetError
 	}
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PriceHistory[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_PriceHistory[fieldId]))
 }
 
-func (p *Sku) writeField6(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("forSale", thrift.I32, 6); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI32(p.ForSale); err != nil {
+func (p *PriceHistory) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
+	p.HistoryID = _field
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
+func (p *PriceHistory) ReadField2(iprot thrift.TProtocol) error {
 
-func (p *Sku) writeField7(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("stock", thrift.I64, 7); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.Stock); err != nil {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
+	p.SkuID = _field
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
+func (p *PriceHistory) ReadField3(iprot thrift.TProtocol) error {
 
-func (p *Sku) writeField8(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("styleHeadDrawing", thrift.STRING, 8); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.StyleHeadDrawing); err != nil {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
+	p.Price = _field
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
 }
+func (p *PriceHistory) ReadField4(iprot thrift.TProtocol) error {
 
-func (p *Sku) writeField9(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("createdAt", thrift.I64, 9); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.CreatedAt); err != nil {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
+	p.CreatedAt = _field
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
 }
+func (p *PriceHistory) ReadField5(iprot thrift.TProtocol) error {
 
-func (p *Sku) writeField10(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("updatedAt", thrift.I64, 10); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.UpdatedAt); err != nil {
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
+	p.PrevVersion = _field
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
 }
 
-func (p *Sku) writeField11(oprot thrift.TProtocol) (err error) {
-	if p.IsSetDeletedAt() {
-		if err = oprot.WriteFieldBegin("deletedAt", thrift.I64, 11); err != nil {
-			goto WriteFieldBeginError
+func (p *PriceHistory) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("PriceHistory"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
 		}
-		if err := oprot.WriteI64(*p.DeletedAt); err != nil {
-			return err
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
 		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
 		}
 	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *Sku) writeField12(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("spuID", thrift.I64, 12); err != nil {
+func (p *PriceHistory) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("historyID", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.SpuID); err != nil {
+	if err := oprot.WriteI64(p.HistoryID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -5009,43 +10250,33 @@ func (p *Sku) writeField12(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *Sku) writeField13(oprot thrift.TProtocol) (err error) {
-	if p.IsSetSaleAttr() {
-		if err = oprot.WriteFieldBegin("saleAttr", thrift.LIST, 13); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.SaleAttr)); err != nil {
-			return err
-		}
-		for _, v := range p.SaleAttr {
-			if err := v.Write(oprot); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteListEnd(); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *PriceHistory) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("skuID", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.SkuID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 13 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 13 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *Sku) writeField14(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("historyID", thrift.I64, 14); err != nil {
+func (p *PriceHistory) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("price", thrift.I64, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.HistoryID); err != nil {
+	if err := oprot.WriteI64(p.Price); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -5053,16 +10284,16 @@ func (p *Sku) writeField14(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 14 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 14 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *Sku) writeField15(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("lockStock", thrift.I64, 15); err != nil {
+func (p *PriceHistory) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("createdAt", thrift.I64, 4); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.LockStock); err != nil {
+	if err := oprot.WriteI64(p.CreatedAt); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -5070,99 +10301,74 @@ func (p *Sku) writeField15(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 15 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 15 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *Sku) String() string {
+func (p *PriceHistory) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPrevVersion() {
+		if err = oprot.WriteFieldBegin("prevVersion", thrift.I64, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.PrevVersion); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *PriceHistory) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("Sku(%+v)", *p)
+	return fmt.Sprintf("PriceHistory(%+v)", *p)
 
 }
 
-type SkuInfo struct {
-	SkuID            int64   `thrift:"skuID,1,required" form:"skuID,required" json:"skuID,required" query:"skuID,required"`
-	CreatorID        int64   `thrift:"creatorID,2,required" form:"creatorID,required" json:"creatorID,required" query:"creatorID,required"`
-	Price            float64 `thrift:"price,3,required" form:"price,required" json:"price,required" query:"price,required"`
-	Name             string  `thrift:"name,4,required" form:"name,required" json:"name,required" query:"name,required"`
-	ForSale          int32   `thrift:"forSale,5,required" form:"forSale,required" json:"forSale,required" query:"forSale,required"`
-	LockStock        int64   `thrift:"lockStock,6,required" form:"lockStock,required" json:"lockStock,required" query:"lockStock,required"`
-	StyleHeadDrawing string  `thrift:"styleHeadDrawing,7,required" form:"styleHeadDrawing,required" json:"styleHeadDrawing,required" query:"styleHeadDrawing,required"`
-	SpuID            int64   `thrift:"spuID,8,required" form:"spuID,required" json:"spuID,required" query:"spuID,required"`
-	HistoryID        int64   `thrift:"historyID,9,required" form:"historyID,required" json:"historyID,required" query:"historyID,required"`
+/*
+* struct SkuBuyInfo 实际扣除商品
+* @Param skuID skuID
+* @Param count 购买商品数
+ */
+type SkuBuyInfo struct {
+	SkuID int64 `thrift:"skuID,1,required" form:"skuID,required" json:"skuID,required" query:"skuID,required"`
+	Count int64 `thrift:"count,2,required" form:"count,required" json:"count,required" query:"count,required"`
 }
 
-func NewSkuInfo() *SkuInfo {
-	return &SkuInfo{}
+func NewSkuBuyInfo() *SkuBuyInfo {
+	return &SkuBuyInfo{}
 }
 
-func (p *SkuInfo) InitDefault() {
+func (p *SkuBuyInfo) InitDefault() {
 }
 
-func (p *SkuInfo) GetSkuID() (v int64) {
+func (p *SkuBuyInfo) GetSkuID() (v int64) {
 	return p.SkuID
 }
 
-func (p *SkuInfo) GetCreatorID() (v int64) {
-	return p.CreatorID
-}
-
-func (p *SkuInfo) GetPrice() (v float64) {
-	return p.Price
-}
-
-func (p *SkuInfo) GetName() (v string) {
-	return p.Name
-}
-
-func (p *SkuInfo) GetForSale() (v int32) {
-	return p.ForSale
-}
-
-func (p *SkuInfo) GetLockStock() (v int64) {
-	return p.LockStock
-}
-
-func (p *SkuInfo) GetStyleHeadDrawing() (v string) {
-	return p.StyleHeadDrawing
-}
-
-func (p *SkuInfo) GetSpuID() (v int64) {
-	return p.SpuID
-}
-
-func (p *SkuInfo) GetHistoryID() (v int64) {
-	return p.HistoryID
+func (p *SkuBuyInfo) GetCount() (v int64) {
+	return p.Count
 }
 
-var fieldIDToName_SkuInfo = map[int16]string{
+var fieldIDToName_SkuBuyInfo = map[int16]string{
 	1: "skuID",
-	2: "creatorID",
-	3: "price",
-	4: "name",
-	5: "forSale",
-	6: "lockStock",
-	7: "styleHeadDrawing",
-	8: "spuID",
-	9: "historyID",
+	2: "count",
 }
 
-func (p *SkuInfo) Read(iprot thrift.TProtocol) (err error) {
+func (p *SkuBuyInfo) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
 	var issetSkuID bool = false
-	var issetCreatorID bool = false
-	var issetPrice bool = false
-	var issetName bool = false
-	var issetForSale bool = false
-	var issetLockStock bool = false
-	var issetStyleHeadDrawing bool = false
-	var issetSpuID bool = false
-	var issetHistoryID bool = false
+	var issetCount bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -5192,70 +10398,7 @@ func (p *SkuInfo) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetCreatorID = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 3:
-			if fieldTypeId == thrift.DOUBLE {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetPrice = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 4:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetName = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 5:
-			if fieldTypeId == thrift.I32 {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetForSale = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 6:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField6(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetLockStock = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 7:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField7(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetStyleHeadDrawing = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 8:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField8(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetSpuID = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 9:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField9(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetHistoryID = true
+				issetCount = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -5264,56 +10407,21 @@ func (p *SkuInfo) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		}
-		if err = iprot.ReadFieldEnd(); err != nil {
-			goto ReadFieldEndError
-		}
-	}
-	if err = iprot.ReadStructEnd(); err != nil {
-		goto ReadStructEndError
-	}
-
-	if !issetSkuID {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetCreatorID {
-		fieldId = 2
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetPrice {
-		fieldId = 3
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetName {
-		fieldId = 4
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetForSale {
-		fieldId = 5
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetLockStock {
-		fieldId = 6
-		goto RequiredFieldNotSetError
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-
-	if !issetStyleHeadDrawing {
-		fieldId = 7
-		goto RequiredFieldNotSetError
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
 
-	if !issetSpuID {
-		fieldId = 8
+	if !issetSkuID {
+		fieldId = 1
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetHistoryID {
-		fieldId = 9
+	if !issetCount {
+		fieldId = 2
 		goto RequiredFieldNotSetError
 	}
 	return nil
@@ -5322,7 +10430,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SkuInfo[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SkuBuyInfo[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5331,10 +10439,10 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_SkuInfo[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_SkuBuyInfo[fieldId]))
 }
 
-func (p *SkuInfo) ReadField1(iprot thrift.TProtocol) error {
+func (p *SkuBuyInfo) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -5345,84 +10453,7 @@ func (p *SkuInfo) ReadField1(iprot thrift.TProtocol) error {
 	p.SkuID = _field
 	return nil
 }
-func (p *SkuInfo) ReadField2(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.CreatorID = _field
-	return nil
-}
-func (p *SkuInfo) ReadField3(iprot thrift.TProtocol) error {
-
-	var _field float64
-	if v, err := iprot.ReadDouble(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.Price = _field
-	return nil
-}
-func (p *SkuInfo) ReadField4(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.Name = _field
-	return nil
-}
-func (p *SkuInfo) ReadField5(iprot thrift.TProtocol) error {
-
-	var _field int32
-	if v, err := iprot.ReadI32(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.ForSale = _field
-	return nil
-}
-func (p *SkuInfo) ReadField6(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.LockStock = _field
-	return nil
-}
-func (p *SkuInfo) ReadField7(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.StyleHeadDrawing = _field
-	return nil
-}
-func (p *SkuInfo) ReadField8(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.SpuID = _field
-	return nil
-}
-func (p *SkuInfo) ReadField9(iprot thrift.TProtocol) error {
+func (p *SkuBuyInfo) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -5430,14 +10461,14 @@ func (p *SkuInfo) ReadField9(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.HistoryID = _field
+	p.Count = _field
 	return nil
 }
 
-func (p *SkuInfo) Write(oprot thrift.TProtocol) (err error) {
+func (p *SkuBuyInfo) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SkuInfo"); err != nil {
+	if err = oprot.WriteStructBegin("SkuBuyInfo"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5449,34 +10480,6 @@ func (p *SkuInfo) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
-		if err = p.writeField6(oprot); err != nil {
-			fieldId = 6
-			goto WriteFieldError
-		}
-		if err = p.writeField7(oprot); err != nil {
-			fieldId = 7
-			goto WriteFieldError
-		}
-		if err = p.writeField8(oprot); err != nil {
-			fieldId = 8
-			goto WriteFieldError
-		}
-		if err = p.writeField9(oprot); err != nil {
-			fieldId = 9
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -5495,7 +10498,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SkuInfo) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *SkuBuyInfo) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("skuID", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -5512,11 +10515,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *SkuInfo) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("creatorID", thrift.I64, 2); err != nil {
+func (p *SkuBuyInfo) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("count", thrift.I64, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.CreatorID); err != nil {
+	if err := oprot.WriteI64(p.Count); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -5529,164 +10532,150 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *SkuInfo) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("price", thrift.DOUBLE, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteDouble(p.Price); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *SkuBuyInfo) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+	return fmt.Sprintf("SkuBuyInfo(%+v)", *p)
+
 }
 
-func (p *SkuInfo) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("name", thrift.STRING, 4); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.Name); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+type Order struct {
+	ID                    int64   `thrift:"id,1,required" form:"id,required" json:"id,required" query:"id,required"`
+	Status                string  `thrift:"status,2,required" form:"status,required" json:"status,required" query:"status,required"`
+	UID                   int64   `thrift:"uid,3,required" form:"uid,required" json:"uid,required" query:"uid,required"`
+	TotalAmountOfGoods    float64 `thrift:"totalAmountOfGoods,4,required" form:"totalAmountOfGoods,required" json:"totalAmountOfGoods,required" query:"totalAmountOfGoods,required"`
+	TotalAmountOfFreight  float64 `thrift:"totalAmountOfFreight,5,required" form:"totalAmountOfFreight,required" json:"totalAmountOfFreight,required" query:"totalAmountOfFreight,required"`
+	TotalAmountOfDiscount float64 `thrift:"totalAmountOfDiscount,6,required" form:"totalAmountOfDiscount,required" json:"totalAmountOfDiscount,required" query:"totalAmountOfDiscount,required"`
+	PaymentAmount         float64 `thrift:"paymentAmount,7,required" form:"paymentAmount,required" json:"paymentAmount,required" query:"paymentAmount,required"`
+	PaymentStatus         string  `thrift:"paymentStatus,8,required" form:"paymentStatus,required" json:"paymentStatus,required" query:"paymentStatus,required"`
+	PaymentAt             int64   `thrift:"paymentAt,9,required" form:"paymentAt,required" json:"paymentAt,required" query:"paymentAt,required"`
+	PaymentStyle          string  `thrift:"paymentStyle,10,required" form:"paymentStyle,required" json:"paymentStyle,required" query:"paymentStyle,required"`
+	OrderedAt             int64   `thrift:"orderedAt,11,required" form:"orderedAt,required" json:"orderedAt,required" query:"orderedAt,required"`
+	DeletedAt             int64   `thrift:"deletedAt,12,required" form:"deletedAt,required" json:"deletedAt,required" query:"deletedAt,required"`
+	DeliveryAt            int64   `thrift:"deliveryAt,13,required" form:"deliveryAt,required" json:"deliveryAt,required" query:"deliveryAt,required"`
+	AddressID             int64   `thrift:"addressID,14,required" form:"addressID,required" json:"addressID,required" query:"addressID,required"`
+	AddressInfo           string  `thrift:"addressInfo,15,required" form:"addressInfo,required" json:"addressInfo,required" query:"addressInfo,required"`
+	// 优惠券 ID
+	CouponId int64 `thrift:"couponId,16" form:"couponId" json:"couponId" query:"couponId"`
+	// 优惠券名称
+	CouponName string `thrift:"couponName,17" form:"couponName" json:"couponName" query:"couponName"`
 }
 
-func (p *SkuInfo) writeField5(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("forSale", thrift.I32, 5); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI32(p.ForSale); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+func NewOrder() *Order {
+	return &Order{}
 }
 
-func (p *SkuInfo) writeField6(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("lockStock", thrift.I64, 6); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.LockStock); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+func (p *Order) InitDefault() {
+}
+
+func (p *Order) GetID() (v int64) {
+	return p.ID
+}
+
+func (p *Order) GetStatus() (v string) {
+	return p.Status
+}
+
+func (p *Order) GetUID() (v int64) {
+	return p.UID
+}
+
+func (p *Order) GetTotalAmountOfGoods() (v float64) {
+	return p.TotalAmountOfGoods
+}
+
+func (p *Order) GetTotalAmountOfFreight() (v float64) {
+	return p.TotalAmountOfFreight
+}
+
+func (p *Order) GetTotalAmountOfDiscount() (v float64) {
+	return p.TotalAmountOfDiscount
+}
+
+func (p *Order) GetPaymentAmount() (v float64) {
+	return p.PaymentAmount
 }
 
-func (p *SkuInfo) writeField7(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("styleHeadDrawing", thrift.STRING, 7); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.StyleHeadDrawing); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+func (p *Order) GetPaymentStatus() (v string) {
+	return p.PaymentStatus
 }
 
-func (p *SkuInfo) writeField8(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("spuID", thrift.I64, 8); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.SpuID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+func (p *Order) GetPaymentAt() (v int64) {
+	return p.PaymentAt
 }
 
-func (p *SkuInfo) writeField9(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("historyID", thrift.I64, 9); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.HistoryID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+func (p *Order) GetPaymentStyle() (v string) {
+	return p.PaymentStyle
 }
 
-func (p *SkuInfo) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("SkuInfo(%+v)", *p)
+func (p *Order) GetOrderedAt() (v int64) {
+	return p.OrderedAt
+}
 
+func (p *Order) GetDeletedAt() (v int64) {
+	return p.DeletedAt
 }
 
-type SkuVersion struct {
-	SkuID     int64 `thrift:"skuID,1,required" form:"skuID,required" json:"skuID,required" query:"skuID,required"`
-	VersionID int64 `thrift:"versionID,2,required" form:"versionID,required" json:"versionID,required" query:"versionID,required"`
+func (p *Order) GetDeliveryAt() (v int64) {
+	return p.DeliveryAt
 }
 
-func NewSkuVersion() *SkuVersion {
-	return &SkuVersion{}
+func (p *Order) GetAddressID() (v int64) {
+	return p.AddressID
 }
 
-func (p *SkuVersion) InitDefault() {
+func (p *Order) GetAddressInfo() (v string) {
+	return p.AddressInfo
 }
 
-func (p *SkuVersion) GetSkuID() (v int64) {
-	return p.SkuID
+func (p *Order) GetCouponId() (v int64) {
+	return p.CouponId
 }
 
-func (p *SkuVersion) GetVersionID() (v int64) {
-	return p.VersionID
+func (p *Order) GetCouponName() (v string) {
+	return p.CouponName
 }
 
-var fieldIDToName_SkuVersion = map[int16]string{
-	1: "skuID",
-	2: "versionID",
+var fieldIDToName_Order = map[int16]string{
+	1:  "id",
+	2:  "status",
+	3:  "uid",
+	4:  "totalAmountOfGoods",
+	5:  "totalAmountOfFreight",
+	6:  "totalAmountOfDiscount",
+	7:  "paymentAmount",
+	8:  "paymentStatus",
+	9:  "paymentAt",
+	10: "paymentStyle",
+	11: "orderedAt",
+	12: "deletedAt",
+	13: "deliveryAt",
+	14: "addressID",
+	15: "addressInfo",
+	16: "couponId",
+	17: "couponName",
 }
 
-func (p *SkuVersion) Read(iprot thrift.TProtocol) (err error) {
+func (p *Order) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetSkuID bool = false
-	var issetVersionID bool = false
+	var issetID bool = false
+	var issetStatus bool = false
+	var issetUID bool = false
+	var issetTotalAmountOfGoods bool = false
+	var issetTotalAmountOfFreight bool = false
+	var issetTotalAmountOfDiscount bool = false
+	var issetPaymentAmount bool = false
+	var issetPaymentStatus bool = false
+	var issetPaymentAt bool = false
+	var issetPaymentStyle bool = false
+	var issetOrderedAt bool = false
+	var issetDeletedAt bool = false
+	var issetDeliveryAt bool = false
+	var issetAddressID bool = false
+	var issetAddressInfo bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -5704,19 +10693,152 @@ func (p *SkuVersion) Read(iprot thrift.TProtocol) (err error) {
 		switch fieldId {
 		case 1:
 			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField1(iprot); err != nil {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetStatus = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetUID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetTotalAmountOfGoods = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetTotalAmountOfFreight = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetTotalAmountOfDiscount = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetPaymentAmount = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetPaymentStatus = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetPaymentAt = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetPaymentStyle = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetOrderedAt = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 12:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField12(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetDeletedAt = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 13:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField13(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetDeliveryAt = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 14:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField14(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetAddressID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 15:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField15(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetAddressInfo = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 16:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField16(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetSkuID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 2:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField2(iprot); err != nil {
+		case 17:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField17(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetVersionID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -5733,22 +10855,87 @@ func (p *SkuVersion) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetSkuID {
+	if !issetID {
 		fieldId = 1
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetVersionID {
+	if !issetStatus {
 		fieldId = 2
 		goto RequiredFieldNotSetError
 	}
+
+	if !issetUID {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetTotalAmountOfGoods {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetTotalAmountOfFreight {
+		fieldId = 5
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetTotalAmountOfDiscount {
+		fieldId = 6
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetPaymentAmount {
+		fieldId = 7
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetPaymentStatus {
+		fieldId = 8
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetPaymentAt {
+		fieldId = 9
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetPaymentStyle {
+		fieldId = 10
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetOrderedAt {
+		fieldId = 11
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetDeletedAt {
+		fieldId = 12
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetDeliveryAt {
+		fieldId = 13
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetAddressID {
+		fieldId = 14
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetAddressInfo {
+		fieldId = 15
+		goto RequiredFieldNotSetError
+	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SkuVersion[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Order[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5757,10 +10944,10 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_SkuVersion[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_Order[fieldId]))
 }
 
-func (p *SkuVersion) ReadField1(iprot thrift.TProtocol) error {
+func (p *Order) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -5768,25 +10955,190 @@ func (p *SkuVersion) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.SkuID = _field
+	p.ID = _field
 	return nil
 }
-func (p *SkuVersion) ReadField2(iprot thrift.TProtocol) error {
+func (p *Order) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Status = _field
+	return nil
+}
+func (p *Order) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UID = _field
+	return nil
+}
+func (p *Order) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.TotalAmountOfGoods = _field
+	return nil
+}
+func (p *Order) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.TotalAmountOfFreight = _field
+	return nil
+}
+func (p *Order) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.TotalAmountOfDiscount = _field
+	return nil
+}
+func (p *Order) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.PaymentAmount = _field
+	return nil
+}
+func (p *Order) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.PaymentStatus = _field
+	return nil
+}
+func (p *Order) ReadField9(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.PaymentAt = _field
+	return nil
+}
+func (p *Order) ReadField10(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.PaymentStyle = _field
+	return nil
+}
+func (p *Order) ReadField11(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.OrderedAt = _field
+	return nil
+}
+func (p *Order) ReadField12(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.DeletedAt = _field
+	return nil
+}
+func (p *Order) ReadField13(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.DeliveryAt = _field
+	return nil
+}
+func (p *Order) ReadField14(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.AddressID = _field
+	return nil
+}
+func (p *Order) ReadField15(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.AddressInfo = _field
+	return nil
+}
+func (p *Order) ReadField16(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CouponId = _field
+	return nil
+}
+func (p *Order) ReadField17(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.VersionID = _field
+	p.CouponName = _field
 	return nil
 }
 
-func (p *SkuVersion) Write(oprot thrift.TProtocol) (err error) {
+func (p *Order) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SkuVersion"); err != nil {
+	if err = oprot.WriteStructBegin("Order"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5798,6 +11150,66 @@ func (p *SkuVersion) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+		if err = p.writeField12(oprot); err != nil {
+			fieldId = 12
+			goto WriteFieldError
+		}
+		if err = p.writeField13(oprot); err != nil {
+			fieldId = 13
+			goto WriteFieldError
+		}
+		if err = p.writeField14(oprot); err != nil {
+			fieldId = 14
+			goto WriteFieldError
+		}
+		if err = p.writeField15(oprot); err != nil {
+			fieldId = 15
+			goto WriteFieldError
+		}
+		if err = p.writeField16(oprot); err != nil {
+			fieldId = 16
+			goto WriteFieldError
+		}
+		if err = p.writeField17(oprot); err != nil {
+			fieldId = 17
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -5816,11 +11228,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SkuVersion) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("skuID", thrift.I64, 1); err != nil {
+func (p *Order) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.SkuID); err != nil {
+	if err := oprot.WriteI64(p.ID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -5833,11 +11245,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *SkuVersion) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("versionID", thrift.I64, 2); err != nil {
+func (p *Order) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.VersionID); err != nil {
+	if err := oprot.WriteString(p.Status); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -5850,289 +11262,181 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *SkuVersion) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *Order) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("uid", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
 	}
-	return fmt.Sprintf("SkuVersion(%+v)", *p)
-
-}
-
-type PriceHistory struct {
-	HistoryID   int64  `thrift:"historyID,1,required" form:"historyID,required" json:"historyID,required" query:"historyID,required"`
-	SkuID       int64  `thrift:"skuID,2,required" form:"skuID,required" json:"skuID,required" query:"skuID,required"`
-	Price       int64  `thrift:"price,3,required" form:"price,required" json:"price,required" query:"price,required"`
-	CreatedAt   int64  `thrift:"createdAt,4,required" form:"createdAt,required" json:"createdAt,required" query:"createdAt,required"`
-	PrevVersion *int64 `thrift:"prevVersion,5,optional" form:"prevVersion" json:"prevVersion,omitempty" query:"prevVersion"`
-}
-
-func NewPriceHistory() *PriceHistory {
-	return &PriceHistory{}
-}
-
-func (p *PriceHistory) InitDefault() {
-}
-
-func (p *PriceHistory) GetHistoryID() (v int64) {
-	return p.HistoryID
-}
-
-func (p *PriceHistory) GetSkuID() (v int64) {
-	return p.SkuID
-}
-
-func (p *PriceHistory) GetPrice() (v int64) {
-	return p.Price
-}
-
-func (p *PriceHistory) GetCreatedAt() (v int64) {
-	return p.CreatedAt
-}
-
-var PriceHistory_PrevVersion_DEFAULT int64
-
-func (p *PriceHistory) GetPrevVersion() (v int64) {
-	if !p.IsSetPrevVersion() {
-		return PriceHistory_PrevVersion_DEFAULT
+	if err := oprot.WriteI64(p.UID); err != nil {
+		return err
 	}
-	return *p.PrevVersion
-}
-
-var fieldIDToName_PriceHistory = map[int16]string{
-	1: "historyID",
-	2: "skuID",
-	3: "price",
-	4: "createdAt",
-	5: "prevVersion",
-}
-
-func (p *PriceHistory) IsSetPrevVersion() bool {
-	return p.PrevVersion != nil
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *PriceHistory) Read(iprot thrift.TProtocol) (err error) {
-
-	var fieldTypeId thrift.TType
-	var fieldId int16
-	var issetHistoryID bool = false
-	var issetSkuID bool = false
-	var issetPrice bool = false
-	var issetCreatedAt bool = false
-
-	if _, err = iprot.ReadStructBegin(); err != nil {
-		goto ReadStructBeginError
+func (p *Order) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("totalAmountOfGoods", thrift.DOUBLE, 4); err != nil {
+		goto WriteFieldBeginError
 	}
-
-	for {
-		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
-		if err != nil {
-			goto ReadFieldBeginError
-		}
-		if fieldTypeId == thrift.STOP {
-			break
-		}
-
-		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField1(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetHistoryID = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 2:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetSkuID = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 3:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetPrice = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 4:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetCreatedAt = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 5:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		}
-		if err = iprot.ReadFieldEnd(); err != nil {
-			goto ReadFieldEndError
-		}
+	if err := oprot.WriteDouble(p.TotalAmountOfGoods); err != nil {
+		return err
 	}
-	if err = iprot.ReadStructEnd(); err != nil {
-		goto ReadStructEndError
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
 
-	if !issetHistoryID {
-		fieldId = 1
-		goto RequiredFieldNotSetError
+func (p *Order) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("totalAmountOfFreight", thrift.DOUBLE, 5); err != nil {
+		goto WriteFieldBeginError
 	}
-
-	if !issetSkuID {
-		fieldId = 2
-		goto RequiredFieldNotSetError
+	if err := oprot.WriteDouble(p.TotalAmountOfFreight); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
 
-	if !issetPrice {
-		fieldId = 3
-		goto RequiredFieldNotSetError
+func (p *Order) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("totalAmountOfDiscount", thrift.DOUBLE, 6); err != nil {
+		goto WriteFieldBeginError
 	}
-
-	if !issetCreatedAt {
-		fieldId = 4
-		goto RequiredFieldNotSetError
+	if err := oprot.WriteDouble(p.TotalAmountOfDiscount); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
-ReadStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
-ReadFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PriceHistory[fieldId]), err)
-SkipFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-
-ReadFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
-ReadStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_PriceHistory[fieldId]))
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
 
-func (p *PriceHistory) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+func (p *Order) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("paymentAmount", thrift.DOUBLE, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteDouble(p.PaymentAmount); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.HistoryID = _field
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
 	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
-func (p *PriceHistory) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+func (p *Order) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("paymentStatus", thrift.STRING, 8); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.PaymentStatus); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.SkuID = _field
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
 	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
 }
-func (p *PriceHistory) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+func (p *Order) writeField9(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("paymentAt", thrift.I64, 9); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.PaymentAt); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.Price = _field
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
 	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
 }
-func (p *PriceHistory) ReadField4(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+func (p *Order) writeField10(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("paymentStyle", thrift.STRING, 10); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.PaymentStyle); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.CreatedAt = _field
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
 	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
 }
-func (p *PriceHistory) ReadField5(iprot thrift.TProtocol) error {
 
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
+func (p *Order) writeField11(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("orderedAt", thrift.I64, 11); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.OrderedAt); err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.PrevVersion = _field
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
 	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
 }
 
-func (p *PriceHistory) Write(oprot thrift.TProtocol) (err error) {
-
-	var fieldId int16
-	if err = oprot.WriteStructBegin("PriceHistory"); err != nil {
-		goto WriteStructBeginError
-	}
-	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
-			goto WriteFieldError
-		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
+func (p *Order) writeField12(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("deletedAt", thrift.I64, 12); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
+	if err := oprot.WriteI64(p.DeletedAt); err != nil {
+		return err
 	}
-	if err = oprot.WriteStructEnd(); err != nil {
-		goto WriteStructEndError
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
-WriteStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
-WriteFieldStopError:
-	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
-WriteStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
 }
 
-func (p *PriceHistory) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("historyID", thrift.I64, 1); err != nil {
+func (p *Order) writeField13(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("deliveryAt", thrift.I64, 13); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.HistoryID); err != nil {
+	if err := oprot.WriteI64(p.DeliveryAt); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -6140,16 +11444,16 @@ func (p *PriceHistory) writeField1(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 end error: ", p), err)
 }
 
-func (p *PriceHistory) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("skuID", thrift.I64, 2); err != nil {
+func (p *Order) writeField14(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("addressID", thrift.I64, 14); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.SkuID); err != nil {
+	if err := oprot.WriteI64(p.AddressID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -6157,16 +11461,16 @@ func (p *PriceHistory) writeField2(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 14 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 14 end error: ", p), err)
 }
 
-func (p *PriceHistory) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("price", thrift.I64, 3); err != nil {
+func (p *Order) writeField15(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("addressInfo", thrift.STRING, 15); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.Price); err != nil {
+	if err := oprot.WriteString(p.AddressInfo); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -6174,16 +11478,16 @@ func (p *PriceHistory) writeField3(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 15 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 15 end error: ", p), err)
 }
 
-func (p *PriceHistory) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("createdAt", thrift.I64, 4); err != nil {
+func (p *Order) writeField16(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("couponId", thrift.I64, 16); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.CreatedAt); err != nil {
+	if err := oprot.WriteI64(p.CouponId); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -6191,74 +11495,88 @@ func (p *PriceHistory) writeField4(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 16 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 16 end error: ", p), err)
 }
 
-func (p *PriceHistory) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetPrevVersion() {
-		if err = oprot.WriteFieldBegin("prevVersion", thrift.I64, 5); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI64(*p.PrevVersion); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *Order) writeField17(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("couponName", thrift.STRING, 17); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.CouponName); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 17 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 17 end error: ", p), err)
+}
+
+func (p *Order) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Order(%+v)", *p)
+
+}
+
+type BaseOrder struct {
+	ID                 int64   `thrift:"id,1,required" form:"id,required" json:"id,required" query:"id,required"`
+	Status             string  `thrift:"status,2,required" form:"status,required" json:"status,required" query:"status,required"`
+	TotalAmountOfGoods float64 `thrift:"totalAmountOfGoods,3,required" form:"totalAmountOfGoods,required" json:"totalAmountOfGoods,required" query:"totalAmountOfGoods,required"`
+	PaymentAmount      float64 `thrift:"paymentAmount,4,required" form:"paymentAmount,required" json:"paymentAmount,required" query:"paymentAmount,required"`
+	PaymentStatus      string  `thrift:"paymentStatus,5,required" form:"paymentStatus,required" json:"paymentStatus,required" query:"paymentStatus,required"`
 }
 
-func (p *PriceHistory) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("PriceHistory(%+v)", *p)
+func NewBaseOrder() *BaseOrder {
+	return &BaseOrder{}
+}
 
+func (p *BaseOrder) InitDefault() {
 }
 
-/*
-* struct SkuBuyInfo 实际扣除商品
-* @Param skuID skuID
-* @Param count 购买商品数
- */
-type SkuBuyInfo struct {
-	SkuID int64 `thrift:"skuID,1,required" form:"skuID,required" json:"skuID,required" query:"skuID,required"`
-	Count int64 `thrift:"count,2,required" form:"count,required" json:"count,required" query:"count,required"`
+func (p *BaseOrder) GetID() (v int64) {
+	return p.ID
 }
 
-func NewSkuBuyInfo() *SkuBuyInfo {
-	return &SkuBuyInfo{}
+func (p *BaseOrder) GetStatus() (v string) {
+	return p.Status
 }
 
-func (p *SkuBuyInfo) InitDefault() {
+func (p *BaseOrder) GetTotalAmountOfGoods() (v float64) {
+	return p.TotalAmountOfGoods
 }
 
-func (p *SkuBuyInfo) GetSkuID() (v int64) {
-	return p.SkuID
+func (p *BaseOrder) GetPaymentAmount() (v float64) {
+	return p.PaymentAmount
 }
 
-func (p *SkuBuyInfo) GetCount() (v int64) {
-	return p.Count
+func (p *BaseOrder) GetPaymentStatus() (v string) {
+	return p.PaymentStatus
 }
 
-var fieldIDToName_SkuBuyInfo = map[int16]string{
-	1: "skuID",
-	2: "count",
+var fieldIDToName_BaseOrder = map[int16]string{
+	1: "id",
+	2: "status",
+	3: "totalAmountOfGoods",
+	4: "paymentAmount",
+	5: "paymentStatus",
 }
 
-func (p *SkuBuyInfo) Read(iprot thrift.TProtocol) (err error) {
+func (p *BaseOrder) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetSkuID bool = false
-	var issetCount bool = false
+	var issetID bool = false
+	var issetStatus bool = false
+	var issetTotalAmountOfGoods bool = false
+	var issetPaymentAmount bool = false
+	var issetPaymentStatus bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -6279,16 +11597,43 @@ func (p *SkuBuyInfo) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetSkuID = true
+				issetID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetCount = true
+				issetStatus = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetTotalAmountOfGoods = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetPaymentAmount = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetPaymentStatus = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -6305,22 +11650,37 @@ func (p *SkuBuyInfo) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetSkuID {
+	if !issetID {
 		fieldId = 1
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetCount {
+	if !issetStatus {
 		fieldId = 2
 		goto RequiredFieldNotSetError
 	}
+
+	if !issetTotalAmountOfGoods {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetPaymentAmount {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetPaymentStatus {
+		fieldId = 5
+		goto RequiredFieldNotSetError
+	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SkuBuyInfo[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BaseOrder[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6329,10 +11689,10 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_SkuBuyInfo[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_BaseOrder[fieldId]))
 }
 
-func (p *SkuBuyInfo) ReadField1(iprot thrift.TProtocol) error {
+func (p *BaseOrder) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -6340,25 +11700,58 @@ func (p *SkuBuyInfo) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.SkuID = _field
+	p.ID = _field
 	return nil
 }
-func (p *SkuBuyInfo) ReadField2(iprot thrift.TProtocol) error {
+func (p *BaseOrder) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.Count = _field
+	p.Status = _field
 	return nil
 }
+func (p *BaseOrder) ReadField3(iprot thrift.TProtocol) error {
 
-func (p *SkuBuyInfo) Write(oprot thrift.TProtocol) (err error) {
+	var _field float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.TotalAmountOfGoods = _field
+	return nil
+}
+func (p *BaseOrder) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.PaymentAmount = _field
+	return nil
+}
+func (p *BaseOrder) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.PaymentStatus = _field
+	return nil
+}
+
+func (p *BaseOrder) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SkuBuyInfo"); err != nil {
+	if err = oprot.WriteStructBegin("BaseOrder"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6370,6 +11763,18 @@ func (p *SkuBuyInfo) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -6388,11 +11793,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SkuBuyInfo) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("skuID", thrift.I64, 1); err != nil {
+func (p *BaseOrder) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.SkuID); err != nil {
+	if err := oprot.WriteI64(p.ID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -6405,11 +11810,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *SkuBuyInfo) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("count", thrift.I64, 2); err != nil {
+func (p *BaseOrder) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.Count); err != nil {
+	if err := oprot.WriteString(p.Status); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -6422,150 +11827,105 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *SkuBuyInfo) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *BaseOrder) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("totalAmountOfGoods", thrift.DOUBLE, 3); err != nil {
+		goto WriteFieldBeginError
 	}
-	return fmt.Sprintf("SkuBuyInfo(%+v)", *p)
-
-}
-
-type Order struct {
-	ID                    int64   `thrift:"id,1,required" form:"id,required" json:"id,required" query:"id,required"`
-	Status                string  `thrift:"status,2,required" form:"status,required" json:"status,required" query:"status,required"`
-	UID                   int64   `thrift:"uid,3,required" form:"uid,required" json:"uid,required" query:"uid,required"`
-	TotalAmountOfGoods    float64 `thrift:"totalAmountOfGoods,4,required" form:"totalAmountOfGoods,required" json:"totalAmountOfGoods,required" query:"totalAmountOfGoods,required"`
-	TotalAmountOfFreight  float64 `thrift:"totalAmountOfFreight,5,required" form:"totalAmountOfFreight,required" json:"totalAmountOfFreight,required" query:"totalAmountOfFreight,required"`
-	TotalAmountOfDiscount float64 `thrift:"totalAmountOfDiscount,6,required" form:"totalAmountOfDiscount,required" json:"totalAmountOfDiscount,required" query:"totalAmountOfDiscount,required"`
-	PaymentAmount         float64 `thrift:"paymentAmount,7,required" form:"paymentAmount,required" json:"paymentAmount,required" query:"paymentAmount,required"`
-	PaymentStatus         string  `thrift:"paymentStatus,8,required" form:"paymentStatus,required" json:"paymentStatus,required" query:"paymentStatus,required"`
-	PaymentAt             int64   `thrift:"paymentAt,9,required" form:"paymentAt,required" json:"paymentAt,required" query:"paymentAt,required"`
-	PaymentStyle          string  `thrift:"paymentStyle,10,required" form:"paymentStyle,required" json:"paymentStyle,required" query:"paymentStyle,required"`
-	OrderedAt             int64   `thrift:"orderedAt,11,required" form:"orderedAt,required" json:"orderedAt,required" query:"orderedAt,required"`
-	DeletedAt             int64   `thrift:"deletedAt,12,required" form:"deletedAt,required" json:"deletedAt,required" query:"deletedAt,required"`
-	DeliveryAt            int64   `thrift:"deliveryAt,13,required" form:"deliveryAt,required" json:"deliveryAt,required" query:"deliveryAt,required"`
-	AddressID             int64   `thrift:"addressID,14,required" form:"addressID,required" json:"addressID,required" query:"addressID,required"`
-	AddressInfo           string  `thrift:"addressInfo,15,required" form:"addressInfo,required" json:"addressInfo,required" query:"addressInfo,required"`
-	// 优惠券 ID
-	CouponId int64 `thrift:"couponId,16" form:"couponId" json:"couponId" query:"couponId"`
-	// 优惠券名称
-	CouponName string `thrift:"couponName,17" form:"couponName" json:"couponName" query:"couponName"`
-}
-
-func NewOrder() *Order {
-	return &Order{}
-}
-
-func (p *Order) InitDefault() {
-}
-
-func (p *Order) GetID() (v int64) {
-	return p.ID
-}
-
-func (p *Order) GetStatus() (v string) {
-	return p.Status
-}
-
-func (p *Order) GetUID() (v int64) {
-	return p.UID
-}
-
-func (p *Order) GetTotalAmountOfGoods() (v float64) {
-	return p.TotalAmountOfGoods
-}
-
-func (p *Order) GetTotalAmountOfFreight() (v float64) {
-	return p.TotalAmountOfFreight
-}
-
-func (p *Order) GetTotalAmountOfDiscount() (v float64) {
-	return p.TotalAmountOfDiscount
+	if err := oprot.WriteDouble(p.TotalAmountOfGoods); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *Order) GetPaymentAmount() (v float64) {
-	return p.PaymentAmount
+func (p *BaseOrder) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("paymentAmount", thrift.DOUBLE, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteDouble(p.PaymentAmount); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *Order) GetPaymentStatus() (v string) {
-	return p.PaymentStatus
+func (p *BaseOrder) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("paymentStatus", thrift.STRING, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.PaymentStatus); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func (p *Order) GetPaymentAt() (v int64) {
-	return p.PaymentAt
-}
+func (p *BaseOrder) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BaseOrder(%+v)", *p)
 
-func (p *Order) GetPaymentStyle() (v string) {
-	return p.PaymentStyle
 }
 
-func (p *Order) GetOrderedAt() (v int64) {
-	return p.OrderedAt
+type OrderWithGoods struct {
+	Order *Order        `thrift:"order,1,required" form:"order,required" json:"order,required" query:"order,required"`
+	Goods []*OrderGoods `thrift:"goods,2,required" form:"goods,required" json:"goods,required" query:"goods,required"`
 }
 
-func (p *Order) GetDeletedAt() (v int64) {
-	return p.DeletedAt
+func NewOrderWithGoods() *OrderWithGoods {
+	return &OrderWithGoods{}
 }
 
-func (p *Order) GetDeliveryAt() (v int64) {
-	return p.DeliveryAt
+func (p *OrderWithGoods) InitDefault() {
 }
 
-func (p *Order) GetAddressID() (v int64) {
-	return p.AddressID
-}
+var OrderWithGoods_Order_DEFAULT *Order
 
-func (p *Order) GetAddressInfo() (v string) {
-	return p.AddressInfo
+func (p *OrderWithGoods) GetOrder() (v *Order) {
+	if !p.IsSetOrder() {
+		return OrderWithGoods_Order_DEFAULT
+	}
+	return p.Order
 }
 
-func (p *Order) GetCouponId() (v int64) {
-	return p.CouponId
+func (p *OrderWithGoods) GetGoods() (v []*OrderGoods) {
+	return p.Goods
 }
 
-func (p *Order) GetCouponName() (v string) {
-	return p.CouponName
+var fieldIDToName_OrderWithGoods = map[int16]string{
+	1: "order",
+	2: "goods",
 }
 
-var fieldIDToName_Order = map[int16]string{
-	1:  "id",
-	2:  "status",
-	3:  "uid",
-	4:  "totalAmountOfGoods",
-	5:  "totalAmountOfFreight",
-	6:  "totalAmountOfDiscount",
-	7:  "paymentAmount",
-	8:  "paymentStatus",
-	9:  "paymentAt",
-	10: "paymentStyle",
-	11: "orderedAt",
-	12: "deletedAt",
-	13: "deliveryAt",
-	14: "addressID",
-	15: "addressInfo",
-	16: "couponId",
-	17: "couponName",
+func (p *OrderWithGoods) IsSetOrder() bool {
+	return p.Order != nil
 }
 
-func (p *Order) Read(iprot thrift.TProtocol) (err error) {
+func (p *OrderWithGoods) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetID bool = false
-	var issetStatus bool = false
-	var issetUID bool = false
-	var issetTotalAmountOfGoods bool = false
-	var issetTotalAmountOfFreight bool = false
-	var issetTotalAmountOfDiscount bool = false
-	var issetPaymentAmount bool = false
-	var issetPaymentStatus bool = false
-	var issetPaymentAt bool = false
-	var issetPaymentStyle bool = false
-	var issetOrderedAt bool = false
-	var issetDeletedAt bool = false
-	var issetDeliveryAt bool = false
-	var issetAddressID bool = false
-	var issetAddressInfo bool = false
+	var issetOrder bool = false
+	var issetGoods bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -6580,155 +11940,22 @@ func (p *Order) Read(iprot thrift.TProtocol) (err error) {
 			break
 		}
 
-		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField1(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetID = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 2:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetStatus = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 3:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetUID = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 4:
-			if fieldTypeId == thrift.DOUBLE {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetTotalAmountOfGoods = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 5:
-			if fieldTypeId == thrift.DOUBLE {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetTotalAmountOfFreight = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 6:
-			if fieldTypeId == thrift.DOUBLE {
-				if err = p.ReadField6(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetTotalAmountOfDiscount = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 7:
-			if fieldTypeId == thrift.DOUBLE {
-				if err = p.ReadField7(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetPaymentAmount = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 8:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField8(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetPaymentStatus = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 9:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField9(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetPaymentAt = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 10:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField10(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetPaymentStyle = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 11:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField11(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetOrderedAt = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 12:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField12(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetDeletedAt = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 13:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField13(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetDeliveryAt = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 14:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField14(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetAddressID = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 15:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField15(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetAddressInfo = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 16:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField16(iprot); err != nil {
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
+				issetOrder = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 17:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField17(iprot); err != nil {
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
+				issetGoods = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -6745,87 +11972,22 @@ func (p *Order) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetID {
+	if !issetOrder {
 		fieldId = 1
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetStatus {
+	if !issetGoods {
 		fieldId = 2
 		goto RequiredFieldNotSetError
 	}
-
-	if !issetUID {
-		fieldId = 3
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetTotalAmountOfGoods {
-		fieldId = 4
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetTotalAmountOfFreight {
-		fieldId = 5
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetTotalAmountOfDiscount {
-		fieldId = 6
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetPaymentAmount {
-		fieldId = 7
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetPaymentStatus {
-		fieldId = 8
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetPaymentAt {
-		fieldId = 9
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetPaymentStyle {
-		fieldId = 10
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetOrderedAt {
-		fieldId = 11
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetDeletedAt {
-		fieldId = 12
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetDeliveryAt {
-		fieldId = 13
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetAddressID {
-		fieldId = 14
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetAddressInfo {
-		fieldId = 15
-		goto RequiredFieldNotSetError
-	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Order[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderWithGoods[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6834,201 +11996,45 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_Order[fieldId]))
-}
-
-func (p *Order) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.ID = _field
-	return nil
-}
-func (p *Order) ReadField2(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.Status = _field
-	return nil
-}
-func (p *Order) ReadField3(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.UID = _field
-	return nil
-}
-func (p *Order) ReadField4(iprot thrift.TProtocol) error {
-
-	var _field float64
-	if v, err := iprot.ReadDouble(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.TotalAmountOfGoods = _field
-	return nil
-}
-func (p *Order) ReadField5(iprot thrift.TProtocol) error {
-
-	var _field float64
-	if v, err := iprot.ReadDouble(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.TotalAmountOfFreight = _field
-	return nil
-}
-func (p *Order) ReadField6(iprot thrift.TProtocol) error {
-
-	var _field float64
-	if v, err := iprot.ReadDouble(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.TotalAmountOfDiscount = _field
-	return nil
-}
-func (p *Order) ReadField7(iprot thrift.TProtocol) error {
-
-	var _field float64
-	if v, err := iprot.ReadDouble(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.PaymentAmount = _field
-	return nil
-}
-func (p *Order) ReadField8(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.PaymentStatus = _field
-	return nil
-}
-func (p *Order) ReadField9(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.PaymentAt = _field
-	return nil
-}
-func (p *Order) ReadField10(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.PaymentStyle = _field
-	return nil
-}
-func (p *Order) ReadField11(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.OrderedAt = _field
-	return nil
-}
-func (p *Order) ReadField12(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.DeletedAt = _field
-	return nil
-}
-func (p *Order) ReadField13(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.DeliveryAt = _field
-	return nil
-}
-func (p *Order) ReadField14(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.AddressID = _field
-	return nil
-}
-func (p *Order) ReadField15(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.AddressInfo = _field
-	return nil
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_OrderWithGoods[fieldId]))
 }
-func (p *Order) ReadField16(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+func (p *OrderWithGoods) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewOrder()
+	if err := _field.Read(iprot); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.CouponId = _field
+	p.Order = _field
 	return nil
 }
-func (p *Order) ReadField17(iprot thrift.TProtocol) error {
+func (p *OrderWithGoods) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*OrderGoods, 0, size)
+	values := make([]OrderGoods, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.CouponName = _field
+	p.Goods = _field
 	return nil
 }
 
-func (p *Order) Write(oprot thrift.TProtocol) (err error) {
+func (p *OrderWithGoods) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Order"); err != nil {
+	if err = oprot.WriteStructBegin("orderWithGoods"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7040,66 +12046,6 @@ func (p *Order) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
-		if err = p.writeField6(oprot); err != nil {
-			fieldId = 6
-			goto WriteFieldError
-		}
-		if err = p.writeField7(oprot); err != nil {
-			fieldId = 7
-			goto WriteFieldError
-		}
-		if err = p.writeField8(oprot); err != nil {
-			fieldId = 8
-			goto WriteFieldError
-		}
-		if err = p.writeField9(oprot); err != nil {
-			fieldId = 9
-			goto WriteFieldError
-		}
-		if err = p.writeField10(oprot); err != nil {
-			fieldId = 10
-			goto WriteFieldError
-		}
-		if err = p.writeField11(oprot); err != nil {
-			fieldId = 11
-			goto WriteFieldError
-		}
-		if err = p.writeField12(oprot); err != nil {
-			fieldId = 12
-			goto WriteFieldError
-		}
-		if err = p.writeField13(oprot); err != nil {
-			fieldId = 13
-			goto WriteFieldError
-		}
-		if err = p.writeField14(oprot); err != nil {
-			fieldId = 14
-			goto WriteFieldError
-		}
-		if err = p.writeField15(oprot); err != nil {
-			fieldId = 15
-			goto WriteFieldError
-		}
-		if err = p.writeField16(oprot); err != nil {
-			fieldId = 16
-			goto WriteFieldError
-		}
-		if err = p.writeField17(oprot); err != nil {
-			fieldId = 17
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -7118,11 +12064,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *Order) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+func (p *OrderWithGoods) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("order", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.ID); err != nil {
+	if err := p.Order.Write(oprot); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -7135,11 +12081,19 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *Order) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("status", thrift.STRING, 2); err != nil {
+func (p *OrderWithGoods) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("goods", thrift.LIST, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Status); err != nil {
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Goods)); err != nil {
+		return err
+	}
+	for _, v := range p.Goods {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -7152,130 +12106,197 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *Order) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("uid", thrift.I64, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.UID); err != nil {
-		return err
+func (p *OrderWithGoods) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+	return fmt.Sprintf("OrderWithGoods(%+v)", *p)
+
+}
+
+type BaseOrderWithGoods struct {
+	Order *BaseOrder        `thrift:"order,1,required" form:"order,required" json:"order,required" query:"order,required"`
+	Goods []*BaseOrderGoods `thrift:"goods,2,required" form:"goods,required" json:"goods,required" query:"goods,required"`
+}
+
+func NewBaseOrderWithGoods() *BaseOrderWithGoods {
+	return &BaseOrderWithGoods{}
+}
+
+func (p *BaseOrderWithGoods) InitDefault() {
+}
+
+var BaseOrderWithGoods_Order_DEFAULT *BaseOrder
+
+func (p *BaseOrderWithGoods) GetOrder() (v *BaseOrder) {
+	if !p.IsSetOrder() {
+		return BaseOrderWithGoods_Order_DEFAULT
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+	return p.Order
 }
 
-func (p *Order) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("totalAmountOfGoods", thrift.DOUBLE, 4); err != nil {
-		goto WriteFieldBeginError
+func (p *BaseOrderWithGoods) GetGoods() (v []*BaseOrderGoods) {
+	return p.Goods
+}
+
+var fieldIDToName_BaseOrderWithGoods = map[int16]string{
+	1: "order",
+	2: "goods",
+}
+
+func (p *BaseOrderWithGoods) IsSetOrder() bool {
+	return p.Order != nil
+}
+
+func (p *BaseOrderWithGoods) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetOrder bool = false
+	var issetGoods bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err := oprot.WriteDouble(p.TotalAmountOfGoods); err != nil {
-		return err
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetOrder = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetGoods = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
-}
 
-func (p *Order) writeField5(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("totalAmountOfFreight", thrift.DOUBLE, 5); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteDouble(p.TotalAmountOfFreight); err != nil {
-		return err
+	if !issetOrder {
+		fieldId = 1
+		goto RequiredFieldNotSetError
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+
+	if !issetGoods {
+		fieldId = 2
+		goto RequiredFieldNotSetError
 	}
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BaseOrderWithGoods[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_BaseOrderWithGoods[fieldId]))
 }
 
-func (p *Order) writeField6(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("totalAmountOfDiscount", thrift.DOUBLE, 6); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteDouble(p.TotalAmountOfDiscount); err != nil {
+func (p *BaseOrderWithGoods) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewBaseOrder()
+	if err := _field.Read(iprot); err != nil {
 		return err
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
+	p.Order = _field
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
-
-func (p *Order) writeField7(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("paymentAmount", thrift.DOUBLE, 7); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteDouble(p.PaymentAmount); err != nil {
+func (p *BaseOrderWithGoods) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
 		return err
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
-}
+	_field := make([]*BaseOrderGoods, 0, size)
+	values := make([]BaseOrderGoods, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-func (p *Order) writeField8(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("paymentStatus", thrift.STRING, 8); err != nil {
-		goto WriteFieldBeginError
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
 	}
-	if err := oprot.WriteString(p.PaymentStatus); err != nil {
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
+	p.Goods = _field
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
 }
 
-func (p *Order) writeField9(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("paymentAt", thrift.I64, 9); err != nil {
-		goto WriteFieldBeginError
+func (p *BaseOrderWithGoods) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("baseOrderWithGoods"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err := oprot.WriteI64(p.PaymentAt); err != nil {
-		return err
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *Order) writeField10(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("paymentStyle", thrift.STRING, 10); err != nil {
+func (p *BaseOrderWithGoods) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("order", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.PaymentStyle); err != nil {
+	if err := p.Order.Write(oprot); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -7283,33 +12304,24 @@ func (p *Order) writeField10(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *Order) writeField11(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("orderedAt", thrift.I64, 11); err != nil {
+func (p *BaseOrderWithGoods) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("goods", thrift.LIST, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.OrderedAt); err != nil {
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Goods)); err != nil {
 		return err
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
-}
-
-func (p *Order) writeField12(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("deletedAt", thrift.I64, 12); err != nil {
-		goto WriteFieldBeginError
+	for _, v := range p.Goods {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
 	}
-	if err := oprot.WriteI64(p.DeletedAt); err != nil {
+	if err := oprot.WriteListEnd(); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -7317,156 +12329,189 @@ func (p *Order) writeField12(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *Order) writeField13(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("deliveryAt", thrift.I64, 13); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.DeliveryAt); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *BaseOrderWithGoods) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 13 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 13 end error: ", p), err)
+	return fmt.Sprintf("BaseOrderWithGoods(%+v)", *p)
+
 }
 
-func (p *Order) writeField14(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("addressID", thrift.I64, 14); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.AddressID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 14 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 14 end error: ", p), err)
+type OrderGoods struct {
+	// 商家 ID
+	MerchantId int64 `thrift:"merchantId,1,required" form:"merchantId,required" json:"merchantId,required" query:"merchantId,required"`
+	// 商品 ID
+	GoodsId int64 `thrift:"goodsId,2,required" form:"goodsId,required" json:"goodsId,required" query:"goodsId,required"`
+	// 商品名字
+	GoodsName string `thrift:"goodsName,3,required" form:"goodsName,required" json:"goodsName,required" query:"goodsName,required"`
+	// 商品款式 ID
+	StyleId int64 `thrift:"styleId,4,required" form:"styleId,required" json:"styleId,required" query:"styleId,required"`
+	// 款式名称
+	StyleName string `thrift:"styleName,5,required" form:"styleName,required" json:"styleName,required" query:"styleName,required"`
+	// 商品版本号
+	GoodsVersion int64 `thrift:"goodsVersion,6,required" form:"goodsVersion,required" json:"goodsVersion,required" query:"goodsVersion,required"`
+	// 款式头图
+	StyleHeadDrawing string `thrift:"styleHeadDrawing,7,required" form:"styleHeadDrawing,required" json:"styleHeadDrawing,required" query:"styleHeadDrawing,required"`
+	// 原价
+	OriginPrice float64 `thrift:"originPrice,8,required" form:"originPrice,required" json:"originPrice,required" query:"originPrice,required"`
+	// 售卖价
+	SalePrice          float64 `thrift:"salePrice,9,required" form:"salePrice,required" json:"salePrice,required" query:"salePrice,required"`
+	SingleFreightPrice float64 `thrift:"singleFreightPrice,10,required" form:"singleFreightPrice,required" json:"singleFreightPrice,required" query:"singleFreightPrice,required"`
+	// 购买数量
+	PurchaseQuantity int64 `thrift:"purchaseQuantity,11,required" form:"purchaseQuantity,required" json:"purchaseQuantity,required" query:"purchaseQuantity,required"`
+	// 本应总金额
+	TotalAmount float64 `thrift:"totalAmount,12,required" form:"totalAmount,required" json:"totalAmount,required" query:"totalAmount,required"`
+	// 运费总金额
+	FreightAmount float64 `thrift:"freightAmount,13,required" form:"freightAmount,required" json:"freightAmount,required" query:"freightAmount,required"`
+	// 折扣总金额
+	DiscountAmount float64 `thrift:"discountAmount,14,required" form:"discountAmount,required" json:"discountAmount,required" query:"discountAmount,required"`
+	// 支付总金额
+	PaymentAmount float64 `thrift:"paymentAmount,15,required" form:"paymentAmount,required" json:"paymentAmount,required" query:"paymentAmount,required"`
+	// 最终单间
+	SinglePrice float64 `thrift:"singlePrice,16,required" form:"singlePrice,required" json:"singlePrice,required" query:"singlePrice,required"`
+	CouponId    int64   `thrift:"couponId,17" form:"couponId" json:"couponId" query:"couponId"`
+	CouponName  string  `thrift:"couponName,18" form:"couponName" json:"couponName" query:"couponName"`
+	OrderId     int64   `thrift:"orderId,19,required" form:"orderId,required" json:"orderId,required" query:"orderId,required"`
+	// 店铺名称
+	MerchantName string `thrift:"merchantName,20" form:"merchantName" json:"merchantName" query:"merchantName"`
 }
 
-func (p *Order) writeField15(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("addressInfo", thrift.STRING, 15); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.AddressInfo); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 15 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 15 end error: ", p), err)
+func NewOrderGoods() *OrderGoods {
+	return &OrderGoods{}
+}
+
+func (p *OrderGoods) InitDefault() {
+}
+
+func (p *OrderGoods) GetMerchantId() (v int64) {
+	return p.MerchantId
+}
+
+func (p *OrderGoods) GetGoodsId() (v int64) {
+	return p.GoodsId
+}
+
+func (p *OrderGoods) GetGoodsName() (v string) {
+	return p.GoodsName
+}
+
+func (p *OrderGoods) GetStyleId() (v int64) {
+	return p.StyleId
+}
+
+func (p *OrderGoods) GetStyleName() (v string) {
+	return p.StyleName
+}
+
+func (p *OrderGoods) GetGoodsVersion() (v int64) {
+	return p.GoodsVersion
+}
+
+func (p *OrderGoods) GetStyleHeadDrawing() (v string) {
+	return p.StyleHeadDrawing
+}
+
+func (p *OrderGoods) GetOriginPrice() (v float64) {
+	return p.OriginPrice
 }
 
-func (p *Order) writeField16(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("couponId", thrift.I64, 16); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.CouponId); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 16 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 16 end error: ", p), err)
+func (p *OrderGoods) GetSalePrice() (v float64) {
+	return p.SalePrice
 }
 
-func (p *Order) writeField17(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("couponName", thrift.STRING, 17); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.CouponName); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 17 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 17 end error: ", p), err)
+func (p *OrderGoods) GetSingleFreightPrice() (v float64) {
+	return p.SingleFreightPrice
 }
 
-func (p *Order) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("Order(%+v)", *p)
+func (p *OrderGoods) GetPurchaseQuantity() (v int64) {
+	return p.PurchaseQuantity
+}
 
+func (p *OrderGoods) GetTotalAmount() (v float64) {
+	return p.TotalAmount
 }
 
-type BaseOrder struct {
-	ID                 int64   `thrift:"id,1,required" form:"id,required" json:"id,required" query:"id,required"`
-	Status             string  `thrift:"status,2,required" form:"status,required" json:"status,required" query:"status,required"`
-	TotalAmountOfGoods float64 `thrift:"totalAmountOfGoods,3,required" form:"totalAmountOfGoods,required" json:"totalAmountOfGoods,required" query:"totalAmountOfGoods,required"`
-	PaymentAmount      float64 `thrift:"paymentAmount,4,required" form:"paymentAmount,required" json:"paymentAmount,required" query:"paymentAmount,required"`
-	PaymentStatus      string  `thrift:"paymentStatus,5,required" form:"paymentStatus,required" json:"paymentStatus,required" query:"paymentStatus,required"`
+func (p *OrderGoods) GetFreightAmount() (v float64) {
+	return p.FreightAmount
 }
 
-func NewBaseOrder() *BaseOrder {
-	return &BaseOrder{}
+func (p *OrderGoods) GetDiscountAmount() (v float64) {
+	return p.DiscountAmount
 }
 
-func (p *BaseOrder) InitDefault() {
+func (p *OrderGoods) GetPaymentAmount() (v float64) {
+	return p.PaymentAmount
 }
 
-func (p *BaseOrder) GetID() (v int64) {
-	return p.ID
+func (p *OrderGoods) GetSinglePrice() (v float64) {
+	return p.SinglePrice
 }
 
-func (p *BaseOrder) GetStatus() (v string) {
-	return p.Status
+func (p *OrderGoods) GetCouponId() (v int64) {
+	return p.CouponId
 }
 
-func (p *BaseOrder) GetTotalAmountOfGoods() (v float64) {
-	return p.TotalAmountOfGoods
+func (p *OrderGoods) GetCouponName() (v string) {
+	return p.CouponName
 }
 
-func (p *BaseOrder) GetPaymentAmount() (v float64) {
-	return p.PaymentAmount
+func (p *OrderGoods) GetOrderId() (v int64) {
+	return p.OrderId
 }
 
-func (p *BaseOrder) GetPaymentStatus() (v string) {
-	return p.PaymentStatus
+func (p *OrderGoods) GetMerchantName() (v string) {
+	return p.MerchantName
 }
 
-var fieldIDToName_BaseOrder = map[int16]string{
-	1: "id",
-	2: "status",
-	3: "totalAmountOfGoods",
-	4: "paymentAmount",
-	5: "paymentStatus",
+var fieldIDToName_OrderGoods = map[int16]string{
+	1:  "merchantId",
+	2:  "goodsId",
+	3:  "goodsName",
+	4:  "styleId",
+	5:  "styleName",
+	6:  "goodsVersion",
+	7:  "styleHeadDrawing",
+	8:  "originPrice",
+	9:  "salePrice",
+	10: "singleFreightPrice",
+	11: "purchaseQuantity",
+	12: "totalAmount",
+	13: "freightAmount",
+	14: "discountAmount",
+	15: "paymentAmount",
+	16: "singlePrice",
+	17: "couponId",
+	18: "couponName",
+	19: "orderId",
+	20: "merchantName",
 }
 
-func (p *BaseOrder) Read(iprot thrift.TProtocol) (err error) {
+func (p *OrderGoods) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetID bool = false
-	var issetStatus bool = false
-	var issetTotalAmountOfGoods bool = false
+	var issetMerchantId bool = false
+	var issetGoodsId bool = false
+	var issetGoodsName bool = false
+	var issetStyleId bool = false
+	var issetStyleName bool = false
+	var issetGoodsVersion bool = false
+	var issetStyleHeadDrawing bool = false
+	var issetOriginPrice bool = false
+	var issetSalePrice bool = false
+	var issetSingleFreightPrice bool = false
+	var issetPurchaseQuantity bool = false
+	var issetTotalAmount bool = false
+	var issetFreightAmount bool = false
+	var issetDiscountAmount bool = false
 	var issetPaymentAmount bool = false
-	var issetPaymentStatus bool = false
+	var issetSinglePrice bool = false
+	var issetOrderId bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -7487,43 +12532,175 @@ func (p *BaseOrder) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetID = true
+				issetMerchantId = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetGoodsId = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetGoodsName = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetStyleId = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetStyleName = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetGoodsVersion = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetStyleHeadDrawing = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetOriginPrice = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSalePrice = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSingleFreightPrice = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetPurchaseQuantity = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 12:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField12(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetTotalAmount = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 13:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField13(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetFreightAmount = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 14:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField14(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetDiscountAmount = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 15:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField15(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetPaymentAmount = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 16:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField16(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSinglePrice = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 2:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField2(iprot); err != nil {
+		case 17:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField17(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetStatus = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 3:
-			if fieldTypeId == thrift.DOUBLE {
-				if err = p.ReadField3(iprot); err != nil {
+		case 18:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField18(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetTotalAmountOfGoods = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 4:
-			if fieldTypeId == thrift.DOUBLE {
-				if err = p.ReadField4(iprot); err != nil {
+		case 19:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField19(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetPaymentAmount = true
+				issetOrderId = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 5:
+		case 20:
 			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField5(iprot); err != nil {
+				if err = p.ReadField20(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetPaymentStatus = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -7540,37 +12717,97 @@ func (p *BaseOrder) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetID {
+	if !issetMerchantId {
 		fieldId = 1
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetStatus {
+	if !issetGoodsId {
 		fieldId = 2
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetTotalAmountOfGoods {
+	if !issetGoodsName {
 		fieldId = 3
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetPaymentAmount {
+	if !issetStyleId {
 		fieldId = 4
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetPaymentStatus {
+	if !issetStyleName {
 		fieldId = 5
 		goto RequiredFieldNotSetError
 	}
+
+	if !issetGoodsVersion {
+		fieldId = 6
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetStyleHeadDrawing {
+		fieldId = 7
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetOriginPrice {
+		fieldId = 8
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetSalePrice {
+		fieldId = 9
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetSingleFreightPrice {
+		fieldId = 10
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetPurchaseQuantity {
+		fieldId = 11
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetTotalAmount {
+		fieldId = 12
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetFreightAmount {
+		fieldId = 13
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetDiscountAmount {
+		fieldId = 14
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetPaymentAmount {
+		fieldId = 15
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetSinglePrice {
+		fieldId = 16
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetOrderId {
+		fieldId = 19
+		goto RequiredFieldNotSetError
+	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BaseOrder[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderGoods[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7579,10 +12816,10 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_BaseOrder[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_OrderGoods[fieldId]))
 }
 
-func (p *BaseOrder) ReadField1(iprot thrift.TProtocol) error {
+func (p *OrderGoods) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -7590,43 +12827,43 @@ func (p *BaseOrder) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.ID = _field
+	p.MerchantId = _field
 	return nil
 }
-func (p *BaseOrder) ReadField2(iprot thrift.TProtocol) error {
+func (p *OrderGoods) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.Status = _field
+	p.GoodsId = _field
 	return nil
 }
-func (p *BaseOrder) ReadField3(iprot thrift.TProtocol) error {
+func (p *OrderGoods) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field float64
-	if v, err := iprot.ReadDouble(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.TotalAmountOfGoods = _field
+	p.GoodsName = _field
 	return nil
 }
-func (p *BaseOrder) ReadField4(iprot thrift.TProtocol) error {
+func (p *OrderGoods) ReadField4(iprot thrift.TProtocol) error {
 
-	var _field float64
-	if v, err := iprot.ReadDouble(); err != nil {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.PaymentAmount = _field
+	p.StyleId = _field
 	return nil
 }
-func (p *BaseOrder) ReadField5(iprot thrift.TProtocol) error {
+func (p *OrderGoods) ReadField5(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -7634,306 +12871,260 @@ func (p *BaseOrder) ReadField5(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.PaymentStatus = _field
+	p.StyleName = _field
 	return nil
 }
+func (p *OrderGoods) ReadField6(iprot thrift.TProtocol) error {
 
-func (p *BaseOrder) Write(oprot thrift.TProtocol) (err error) {
-
-	var fieldId int16
-	if err = oprot.WriteStructBegin("BaseOrder"); err != nil {
-		goto WriteStructBeginError
-	}
-	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
-			goto WriteFieldError
-		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
-	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
-	}
-	if err = oprot.WriteStructEnd(); err != nil {
-		goto WriteStructEndError
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
+	p.GoodsVersion = _field
 	return nil
-WriteStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
-WriteFieldStopError:
-	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
-WriteStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
+func (p *OrderGoods) ReadField7(iprot thrift.TProtocol) error {
 
-func (p *BaseOrder) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.ID); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
+	p.StyleHeadDrawing = _field
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
+func (p *OrderGoods) ReadField8(iprot thrift.TProtocol) error {
 
-func (p *BaseOrder) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("status", thrift.STRING, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.Status); err != nil {
+	var _field float64
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
+	p.OriginPrice = _field
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
+func (p *OrderGoods) ReadField9(iprot thrift.TProtocol) error {
 
-func (p *BaseOrder) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("totalAmountOfGoods", thrift.DOUBLE, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteDouble(p.TotalAmountOfGoods); err != nil {
+	var _field float64
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
+	p.SalePrice = _field
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
+func (p *OrderGoods) ReadField10(iprot thrift.TProtocol) error {
 
-func (p *BaseOrder) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("paymentAmount", thrift.DOUBLE, 4); err != nil {
-		goto WriteFieldBeginError
+	var _field float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
-	if err := oprot.WriteDouble(p.PaymentAmount); err != nil {
+	p.SingleFreightPrice = _field
+	return nil
+}
+func (p *OrderGoods) ReadField11(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
+	p.PurchaseQuantity = _field
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
+func (p *OrderGoods) ReadField12(iprot thrift.TProtocol) error {
 
-func (p *BaseOrder) writeField5(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("paymentStatus", thrift.STRING, 5); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.PaymentStatus); err != nil {
+	var _field float64
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
+	p.TotalAmount = _field
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
+func (p *OrderGoods) ReadField13(iprot thrift.TProtocol) error {
 
-func (p *BaseOrder) String() string {
-	if p == nil {
-		return "<nil>"
+	var _field float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
-	return fmt.Sprintf("BaseOrder(%+v)", *p)
-
+	p.FreightAmount = _field
+	return nil
 }
+func (p *OrderGoods) ReadField14(iprot thrift.TProtocol) error {
 
-type OrderWithGoods struct {
-	Order *Order        `thrift:"order,1,required" form:"order,required" json:"order,required" query:"order,required"`
-	Goods []*OrderGoods `thrift:"goods,2,required" form:"goods,required" json:"goods,required" query:"goods,required"`
+	var _field float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.DiscountAmount = _field
+	return nil
 }
+func (p *OrderGoods) ReadField15(iprot thrift.TProtocol) error {
 
-func NewOrderWithGoods() *OrderWithGoods {
-	return &OrderWithGoods{}
+	var _field float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.PaymentAmount = _field
+	return nil
 }
+func (p *OrderGoods) ReadField16(iprot thrift.TProtocol) error {
 
-func (p *OrderWithGoods) InitDefault() {
+	var _field float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.SinglePrice = _field
+	return nil
 }
+func (p *OrderGoods) ReadField17(iprot thrift.TProtocol) error {
 
-var OrderWithGoods_Order_DEFAULT *Order
-
-func (p *OrderWithGoods) GetOrder() (v *Order) {
-	if !p.IsSetOrder() {
-		return OrderWithGoods_Order_DEFAULT
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
-	return p.Order
+	p.CouponId = _field
+	return nil
 }
+func (p *OrderGoods) ReadField18(iprot thrift.TProtocol) error {
 
-func (p *OrderWithGoods) GetGoods() (v []*OrderGoods) {
-	return p.Goods
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CouponName = _field
+	return nil
 }
+func (p *OrderGoods) ReadField19(iprot thrift.TProtocol) error {
 
-var fieldIDToName_OrderWithGoods = map[int16]string{
-	1: "order",
-	2: "goods",
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.OrderId = _field
+	return nil
 }
+func (p *OrderGoods) ReadField20(iprot thrift.TProtocol) error {
 
-func (p *OrderWithGoods) IsSetOrder() bool {
-	return p.Order != nil
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.MerchantName = _field
+	return nil
 }
 
-func (p *OrderWithGoods) Read(iprot thrift.TProtocol) (err error) {
+func (p *OrderGoods) Write(oprot thrift.TProtocol) (err error) {
 
-	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetOrder bool = false
-	var issetGoods bool = false
-
-	if _, err = iprot.ReadStructBegin(); err != nil {
-		goto ReadStructBeginError
+	if err = oprot.WriteStructBegin("OrderGoods"); err != nil {
+		goto WriteStructBeginError
 	}
-
-	for {
-		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
-		if err != nil {
-			goto ReadFieldBeginError
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
 		}
-		if fieldTypeId == thrift.STOP {
-			break
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
 		}
-
-		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField1(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetOrder = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 2:
-			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetGoods = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
 		}
-		if err = iprot.ReadFieldEnd(); err != nil {
-			goto ReadFieldEndError
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
 		}
-	}
-	if err = iprot.ReadStructEnd(); err != nil {
-		goto ReadStructEndError
-	}
-
-	if !issetOrder {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetGoods {
-		fieldId = 2
-		goto RequiredFieldNotSetError
-	}
-	return nil
-ReadStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
-ReadFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderWithGoods[fieldId]), err)
-SkipFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-
-ReadFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
-ReadStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_OrderWithGoods[fieldId]))
-}
-
-func (p *OrderWithGoods) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewOrder()
-	if err := _field.Read(iprot); err != nil {
-		return err
-	}
-	p.Order = _field
-	return nil
-}
-func (p *OrderWithGoods) ReadField2(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]*OrderGoods, 0, size)
-	values := make([]OrderGoods, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
 		}
-
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return err
-	}
-	p.Goods = _field
-	return nil
-}
-
-func (p *OrderWithGoods) Write(oprot thrift.TProtocol) (err error) {
-
-	var fieldId int16
-	if err = oprot.WriteStructBegin("orderWithGoods"); err != nil {
-		goto WriteStructBeginError
-	}
-	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
+		if err = p.writeField12(oprot); err != nil {
+			fieldId = 12
 			goto WriteFieldError
 		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
+		if err = p.writeField13(oprot); err != nil {
+			fieldId = 13
+			goto WriteFieldError
+		}
+		if err = p.writeField14(oprot); err != nil {
+			fieldId = 14
+			goto WriteFieldError
+		}
+		if err = p.writeField15(oprot); err != nil {
+			fieldId = 15
+			goto WriteFieldError
+		}
+		if err = p.writeField16(oprot); err != nil {
+			fieldId = 16
+			goto WriteFieldError
+		}
+		if err = p.writeField17(oprot); err != nil {
+			fieldId = 17
+			goto WriteFieldError
+		}
+		if err = p.writeField18(oprot); err != nil {
+			fieldId = 18
+			goto WriteFieldError
+		}
+		if err = p.writeField19(oprot); err != nil {
+			fieldId = 19
+			goto WriteFieldError
+		}
+		if err = p.writeField20(oprot); err != nil {
+			fieldId = 20
 			goto WriteFieldError
 		}
 	}
@@ -7954,11 +13145,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *OrderWithGoods) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("order", thrift.STRUCT, 1); err != nil {
+func (p *OrderGoods) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("merchantId", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.Order.Write(oprot); err != nil {
+	if err := oprot.WriteI64(p.MerchantId); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -7971,19 +13162,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *OrderWithGoods) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("goods", thrift.LIST, 2); err != nil {
+func (p *OrderGoods) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("goodsId", thrift.I64, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Goods)); err != nil {
-		return err
-	}
-	for _, v := range p.Goods {
-		if err := v.Write(oprot); err != nil {
-			return err
-		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
+	if err := oprot.WriteI64(p.GoodsId); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -7996,197 +13179,164 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *OrderWithGoods) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *OrderGoods) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("goodsName", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
 	}
-	return fmt.Sprintf("OrderWithGoods(%+v)", *p)
-
-}
-
-type BaseOrderWithGoods struct {
-	Order *BaseOrder        `thrift:"order,1,required" form:"order,required" json:"order,required" query:"order,required"`
-	Goods []*BaseOrderGoods `thrift:"goods,2,required" form:"goods,required" json:"goods,required" query:"goods,required"`
-}
-
-func NewBaseOrderWithGoods() *BaseOrderWithGoods {
-	return &BaseOrderWithGoods{}
-}
-
-func (p *BaseOrderWithGoods) InitDefault() {
-}
-
-var BaseOrderWithGoods_Order_DEFAULT *BaseOrder
-
-func (p *BaseOrderWithGoods) GetOrder() (v *BaseOrder) {
-	if !p.IsSetOrder() {
-		return BaseOrderWithGoods_Order_DEFAULT
+	if err := oprot.WriteString(p.GoodsName); err != nil {
+		return err
 	}
-	return p.Order
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *BaseOrderWithGoods) GetGoods() (v []*BaseOrderGoods) {
-	return p.Goods
+func (p *OrderGoods) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("styleId", thrift.I64, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.StyleId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-var fieldIDToName_BaseOrderWithGoods = map[int16]string{
-	1: "order",
-	2: "goods",
+func (p *OrderGoods) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("styleName", thrift.STRING, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.StyleName); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func (p *BaseOrderWithGoods) IsSetOrder() bool {
-	return p.Order != nil
+func (p *OrderGoods) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("goodsVersion", thrift.I64, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.GoodsVersion); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
 
-func (p *BaseOrderWithGoods) Read(iprot thrift.TProtocol) (err error) {
-
-	var fieldTypeId thrift.TType
-	var fieldId int16
-	var issetOrder bool = false
-	var issetGoods bool = false
-
-	if _, err = iprot.ReadStructBegin(); err != nil {
-		goto ReadStructBeginError
+func (p *OrderGoods) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("styleHeadDrawing", thrift.STRING, 7); err != nil {
+		goto WriteFieldBeginError
 	}
-
-	for {
-		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
-		if err != nil {
-			goto ReadFieldBeginError
-		}
-		if fieldTypeId == thrift.STOP {
-			break
-		}
-
-		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField1(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetOrder = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 2:
-			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetGoods = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		}
-		if err = iprot.ReadFieldEnd(); err != nil {
-			goto ReadFieldEndError
-		}
+	if err := oprot.WriteString(p.StyleHeadDrawing); err != nil {
+		return err
 	}
-	if err = iprot.ReadStructEnd(); err != nil {
-		goto ReadStructEndError
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
 
-	if !issetOrder {
-		fieldId = 1
-		goto RequiredFieldNotSetError
+func (p *OrderGoods) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("originPrice", thrift.DOUBLE, 8); err != nil {
+		goto WriteFieldBeginError
 	}
-
-	if !issetGoods {
-		fieldId = 2
-		goto RequiredFieldNotSetError
+	if err := oprot.WriteDouble(p.OriginPrice); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
-ReadStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
-ReadFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BaseOrderWithGoods[fieldId]), err)
-SkipFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-
-ReadFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
-ReadStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_BaseOrderWithGoods[fieldId]))
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
 }
 
-func (p *BaseOrderWithGoods) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewBaseOrder()
-	if err := _field.Read(iprot); err != nil {
+func (p *OrderGoods) writeField9(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("salePrice", thrift.DOUBLE, 9); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteDouble(p.SalePrice); err != nil {
 		return err
 	}
-	p.Order = _field
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
 	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
 }
-func (p *BaseOrderWithGoods) ReadField2(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]*BaseOrderGoods, 0, size)
-	values := make([]BaseOrderGoods, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
 
-		_field = append(_field, _elem)
+func (p *OrderGoods) writeField10(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("singleFreightPrice", thrift.DOUBLE, 10); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err := iprot.ReadListEnd(); err != nil {
+	if err := oprot.WriteDouble(p.SingleFreightPrice); err != nil {
 		return err
 	}
-	p.Goods = _field
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
 	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
 }
 
-func (p *BaseOrderWithGoods) Write(oprot thrift.TProtocol) (err error) {
-
-	var fieldId int16
-	if err = oprot.WriteStructBegin("baseOrderWithGoods"); err != nil {
-		goto WriteStructBeginError
-	}
-	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
-			goto WriteFieldError
-		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
+func (p *OrderGoods) writeField11(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("purchaseQuantity", thrift.I64, 11); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
+	if err := oprot.WriteI64(p.PurchaseQuantity); err != nil {
+		return err
 	}
-	if err = oprot.WriteStructEnd(); err != nil {
-		goto WriteStructEndError
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
-WriteStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
-WriteFieldStopError:
-	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
-WriteStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
 }
 
-func (p *BaseOrderWithGoods) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("order", thrift.STRUCT, 1); err != nil {
+func (p *OrderGoods) writeField12(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("totalAmount", thrift.DOUBLE, 12); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.Order.Write(oprot); err != nil {
+	if err := oprot.WriteDouble(p.TotalAmount); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -8194,24 +13344,33 @@ func (p *BaseOrderWithGoods) writeField1(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
 }
 
-func (p *BaseOrderWithGoods) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("goods", thrift.LIST, 2); err != nil {
+func (p *OrderGoods) writeField13(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("freightAmount", thrift.DOUBLE, 13); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Goods)); err != nil {
+	if err := oprot.WriteDouble(p.FreightAmount); err != nil {
 		return err
 	}
-	for _, v := range p.Goods {
-		if err := v.Write(oprot); err != nil {
-			return err
-		}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	if err := oprot.WriteListEnd(); err != nil {
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 end error: ", p), err)
+}
+
+func (p *OrderGoods) writeField14(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("discountAmount", thrift.DOUBLE, 14); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteDouble(p.DiscountAmount); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -8219,182 +13378,178 @@ func (p *BaseOrderWithGoods) writeField2(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 14 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 14 end error: ", p), err)
 }
 
-func (p *BaseOrderWithGoods) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *OrderGoods) writeField15(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("paymentAmount", thrift.DOUBLE, 15); err != nil {
+		goto WriteFieldBeginError
 	}
-	return fmt.Sprintf("BaseOrderWithGoods(%+v)", *p)
-
-}
-
-type OrderGoods struct {
-	// 商家 ID
-	MerchantId int64 `thrift:"merchantId,1,required" form:"merchantId,required" json:"merchantId,required" query:"merchantId,required"`
-	// 商品 ID
-	GoodsId int64 `thrift:"goodsId,2,required" form:"goodsId,required" json:"goodsId,required" query:"goodsId,required"`
-	// 商品名字
-	GoodsName string `thrift:"goodsName,3,required" form:"goodsName,required" json:"goodsName,required" query:"goodsName,required"`
-	// 商品款式 ID
-	StyleId int64 `thrift:"styleId,4,required" form:"styleId,required" json:"styleId,required" query:"styleId,required"`
-	// 款式名称
-	StyleName string `thrift:"styleName,5,required" form:"styleName,required" json:"styleName,required" query:"styleName,required"`
-	// 商品版本号
-	GoodsVersion int64 `thrift:"goodsVersion,6,required" form:"goodsVersion,required" json:"goodsVersion,required" query:"goodsVersion,required"`
-	// 款式头图
-	StyleHeadDrawing string `thrift:"styleHeadDrawing,7,required" form:"styleHeadDrawing,required" json:"styleHeadDrawing,required" query:"styleHeadDrawing,required"`
-	// 原价
-	OriginPrice float64 `thrift:"originPrice,8,required" form:"originPrice,required" json:"originPrice,required" query:"originPrice,required"`
-	// 售卖价
-	SalePrice          float64 `thrift:"salePrice,9,required" form:"salePrice,required" json:"salePrice,required" query:"salePrice,required"`
-	SingleFreightPrice float64 `thrift:"singleFreightPrice,10,required" form:"singleFreightPrice,required" json:"singleFreightPrice,required" query:"singleFreightPrice,required"`
-	// 购买数量
-	PurchaseQuantity int64 `thrift:"purchaseQuantity,11,required" form:"purchaseQuantity,required" json:"purchaseQuantity,required" query:"purchaseQuantity,required"`
-	// 本应总金额
-	TotalAmount float64 `thrift:"totalAmount,12,required" form:"totalAmount,required" json:"totalAmount,required" query:"totalAmount,required"`
-	// 运费总金额
-	FreightAmount float64 `thrift:"freightAmount,13,required" form:"freightAmount,required" json:"freightAmount,required" query:"freightAmount,required"`
-	// 折扣总金额
-	DiscountAmount float64 `thrift:"discountAmount,14,required" form:"discountAmount,required" json:"discountAmount,required" query:"discountAmount,required"`
-	// 支付总金额
-	PaymentAmount float64 `thrift:"paymentAmount,15,required" form:"paymentAmount,required" json:"paymentAmount,required" query:"paymentAmount,required"`
-	// 最终单间
-	SinglePrice float64 `thrift:"singlePrice,16,required" form:"singlePrice,required" json:"singlePrice,required" query:"singlePrice,required"`
-	CouponId    int64   `thrift:"couponId,17" form:"couponId" json:"couponId" query:"couponId"`
-	CouponName  string  `thrift:"couponName,18" form:"couponName" json:"couponName" query:"couponName"`
-	OrderId     int64   `thrift:"orderId,19,required" form:"orderId,required" json:"orderId,required" query:"orderId,required"`
-}
-
-func NewOrderGoods() *OrderGoods {
-	return &OrderGoods{}
-}
-
-func (p *OrderGoods) InitDefault() {
-}
-
-func (p *OrderGoods) GetMerchantId() (v int64) {
-	return p.MerchantId
-}
-
-func (p *OrderGoods) GetGoodsId() (v int64) {
-	return p.GoodsId
-}
-
-func (p *OrderGoods) GetGoodsName() (v string) {
-	return p.GoodsName
-}
-
-func (p *OrderGoods) GetStyleId() (v int64) {
-	return p.StyleId
-}
-
-func (p *OrderGoods) GetStyleName() (v string) {
-	return p.StyleName
+	if err := oprot.WriteDouble(p.PaymentAmount); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 15 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 15 end error: ", p), err)
 }
 
-func (p *OrderGoods) GetGoodsVersion() (v int64) {
-	return p.GoodsVersion
+func (p *OrderGoods) writeField16(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("singlePrice", thrift.DOUBLE, 16); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteDouble(p.SinglePrice); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 16 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 16 end error: ", p), err)
 }
 
-func (p *OrderGoods) GetStyleHeadDrawing() (v string) {
-	return p.StyleHeadDrawing
+func (p *OrderGoods) writeField17(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("couponId", thrift.I64, 17); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.CouponId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 17 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 17 end error: ", p), err)
 }
 
-func (p *OrderGoods) GetOriginPrice() (v float64) {
-	return p.OriginPrice
+func (p *OrderGoods) writeField18(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("couponName", thrift.STRING, 18); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.CouponName); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 18 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 18 end error: ", p), err)
 }
 
-func (p *OrderGoods) GetSalePrice() (v float64) {
-	return p.SalePrice
+func (p *OrderGoods) writeField19(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("orderId", thrift.I64, 19); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.OrderId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 19 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 19 end error: ", p), err)
 }
 
-func (p *OrderGoods) GetSingleFreightPrice() (v float64) {
-	return p.SingleFreightPrice
+func (p *OrderGoods) writeField20(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("merchantName", thrift.STRING, 20); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.MerchantName); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 20 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 20 end error: ", p), err)
 }
 
-func (p *OrderGoods) GetPurchaseQuantity() (v int64) {
-	return p.PurchaseQuantity
+func (p *OrderGoods) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderGoods(%+v)", *p)
+
 }
 
-func (p *OrderGoods) GetTotalAmount() (v float64) {
-	return p.TotalAmount
+type BaseOrderGoods struct {
+	// 商家 ID
+	MerchantID int64 `thrift:"merchantID,1,required" form:"merchantID,required" json:"merchantID,required" query:"merchantID,required"`
+	// 商品 ID
+	GoodsID int64 `thrift:"goodsID,2,required" form:"goodsID,required" json:"goodsID,required" query:"goodsID,required"`
+	// 商品款式 ID
+	StyleID int64 `thrift:"styleID,3,required" form:"styleID,required" json:"styleID,required" query:"styleID,required"`
+	// 购买数量
+	PurchaseQuantity int64 `thrift:"purchaseQuantity,4,required" form:"purchaseQuantity,required" json:"purchaseQuantity,required" query:"purchaseQuantity,required"`
+	// 商品历史号
+	GoodsVersion int64 `thrift:"goodsVersion,5,required" form:"goodsVersion,required" json:"goodsVersion,required" query:"goodsVersion,required"`
 }
 
-func (p *OrderGoods) GetFreightAmount() (v float64) {
-	return p.FreightAmount
+func NewBaseOrderGoods() *BaseOrderGoods {
+	return &BaseOrderGoods{}
 }
 
-func (p *OrderGoods) GetDiscountAmount() (v float64) {
-	return p.DiscountAmount
+func (p *BaseOrderGoods) InitDefault() {
 }
 
-func (p *OrderGoods) GetPaymentAmount() (v float64) {
-	return p.PaymentAmount
+func (p *BaseOrderGoods) GetMerchantID() (v int64) {
+	return p.MerchantID
 }
 
-func (p *OrderGoods) GetSinglePrice() (v float64) {
-	return p.SinglePrice
+func (p *BaseOrderGoods) GetGoodsID() (v int64) {
+	return p.GoodsID
 }
 
-func (p *OrderGoods) GetCouponId() (v int64) {
-	return p.CouponId
+func (p *BaseOrderGoods) GetStyleID() (v int64) {
+	return p.StyleID
 }
 
-func (p *OrderGoods) GetCouponName() (v string) {
-	return p.CouponName
+func (p *BaseOrderGoods) GetPurchaseQuantity() (v int64) {
+	return p.PurchaseQuantity
 }
 
-func (p *OrderGoods) GetOrderId() (v int64) {
-	return p.OrderId
+func (p *BaseOrderGoods) GetGoodsVersion() (v int64) {
+	return p.GoodsVersion
 }
 
-var fieldIDToName_OrderGoods = map[int16]string{
-	1:  "merchantId",
-	2:  "goodsId",
-	3:  "goodsName",
-	4:  "styleId",
-	5:  "styleName",
-	6:  "goodsVersion",
-	7:  "styleHeadDrawing",
-	8:  "originPrice",
-	9:  "salePrice",
-	10: "singleFreightPrice",
-	11: "purchaseQuantity",
-	12: "totalAmount",
-	13: "freightAmount",
-	14: "discountAmount",
-	15: "paymentAmount",
-	16: "singlePrice",
-	17: "couponId",
-	18: "couponName",
-	19: "orderId",
+var fieldIDToName_BaseOrderGoods = map[int16]string{
+	1: "merchantID",
+	2: "goodsID",
+	3: "styleID",
+	4: "purchaseQuantity",
+	5: "goodsVersion",
 }
 
-func (p *OrderGoods) Read(iprot thrift.TProtocol) (err error) {
+func (p *BaseOrderGoods) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetMerchantId bool = false
-	var issetGoodsId bool = false
-	var issetGoodsName bool = false
-	var issetStyleId bool = false
-	var issetStyleName bool = false
-	var issetGoodsVersion bool = false
-	var issetStyleHeadDrawing bool = false
-	var issetOriginPrice bool = false
-	var issetSalePrice bool = false
-	var issetSingleFreightPrice bool = false
+	var issetMerchantID bool = false
+	var issetGoodsID bool = false
+	var issetStyleID bool = false
 	var issetPurchaseQuantity bool = false
-	var issetTotalAmount bool = false
-	var issetFreightAmount bool = false
-	var issetDiscountAmount bool = false
-	var issetPaymentAmount bool = false
-	var issetSinglePrice bool = false
-	var issetOrderId bool = false
+	var issetGoodsVersion bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -8409,173 +13564,49 @@ func (p *OrderGoods) Read(iprot thrift.TProtocol) (err error) {
 			break
 		}
 
-		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField1(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetMerchantId = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 2:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetGoodsId = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 3:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetGoodsName = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 4:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetStyleId = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 5:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetStyleName = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 6:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField6(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetGoodsVersion = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 7:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField7(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetStyleHeadDrawing = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 8:
-			if fieldTypeId == thrift.DOUBLE {
-				if err = p.ReadField8(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetOriginPrice = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 9:
-			if fieldTypeId == thrift.DOUBLE {
-				if err = p.ReadField9(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetSalePrice = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 10:
-			if fieldTypeId == thrift.DOUBLE {
-				if err = p.ReadField10(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetSingleFreightPrice = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 11:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField11(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetPurchaseQuantity = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 12:
-			if fieldTypeId == thrift.DOUBLE {
-				if err = p.ReadField12(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetTotalAmount = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 13:
-			if fieldTypeId == thrift.DOUBLE {
-				if err = p.ReadField13(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetFreightAmount = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 14:
-			if fieldTypeId == thrift.DOUBLE {
-				if err = p.ReadField14(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetDiscountAmount = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 15:
-			if fieldTypeId == thrift.DOUBLE {
-				if err = p.ReadField15(iprot); err != nil {
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetPaymentAmount = true
+				issetMerchantID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 16:
-			if fieldTypeId == thrift.DOUBLE {
-				if err = p.ReadField16(iprot); err != nil {
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetSinglePrice = true
+				issetGoodsID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 17:
+		case 3:
 			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField17(iprot); err != nil {
+				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
+				issetStyleID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 18:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField18(iprot); err != nil {
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
+				issetPurchaseQuantity = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 19:
+		case 5:
 			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField19(iprot); err != nil {
+				if err = p.ReadField5(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetOrderId = true
+				issetGoodsVersion = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -8592,285 +13623,71 @@ func (p *OrderGoods) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetMerchantId {
+	if !issetMerchantID {
 		fieldId = 1
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetGoodsId {
+	if !issetGoodsID {
 		fieldId = 2
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetGoodsName {
-		fieldId = 3
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetStyleId {
-		fieldId = 4
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetStyleName {
-		fieldId = 5
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetGoodsVersion {
-		fieldId = 6
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetStyleHeadDrawing {
-		fieldId = 7
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetOriginPrice {
-		fieldId = 8
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetSalePrice {
-		fieldId = 9
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetSingleFreightPrice {
-		fieldId = 10
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetPurchaseQuantity {
-		fieldId = 11
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetTotalAmount {
-		fieldId = 12
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetFreightAmount {
-		fieldId = 13
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetDiscountAmount {
-		fieldId = 14
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetPaymentAmount {
-		fieldId = 15
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetSinglePrice {
-		fieldId = 16
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetOrderId {
-		fieldId = 19
-		goto RequiredFieldNotSetError
-	}
-	return nil
-ReadStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
-ReadFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderGoods[fieldId]), err)
-SkipFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-
-ReadFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
-ReadStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_OrderGoods[fieldId]))
-}
-
-func (p *OrderGoods) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.MerchantId = _field
-	return nil
-}
-func (p *OrderGoods) ReadField2(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.GoodsId = _field
-	return nil
-}
-func (p *OrderGoods) ReadField3(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.GoodsName = _field
-	return nil
-}
-func (p *OrderGoods) ReadField4(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.StyleId = _field
-	return nil
-}
-func (p *OrderGoods) ReadField5(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.StyleName = _field
-	return nil
-}
-func (p *OrderGoods) ReadField6(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.GoodsVersion = _field
-	return nil
-}
-func (p *OrderGoods) ReadField7(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.StyleHeadDrawing = _field
-	return nil
-}
-func (p *OrderGoods) ReadField8(iprot thrift.TProtocol) error {
-
-	var _field float64
-	if v, err := iprot.ReadDouble(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.OriginPrice = _field
-	return nil
-}
-func (p *OrderGoods) ReadField9(iprot thrift.TProtocol) error {
-
-	var _field float64
-	if v, err := iprot.ReadDouble(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.SalePrice = _field
-	return nil
-}
-func (p *OrderGoods) ReadField10(iprot thrift.TProtocol) error {
-
-	var _field float64
-	if v, err := iprot.ReadDouble(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.SingleFreightPrice = _field
-	return nil
-}
-func (p *OrderGoods) ReadField11(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.PurchaseQuantity = _field
-	return nil
-}
-func (p *OrderGoods) ReadField12(iprot thrift.TProtocol) error {
-
-	var _field float64
-	if v, err := iprot.ReadDouble(); err != nil {
-		return err
-	} else {
-		_field = v
+	if !issetStyleID {
+		fieldId = 3
+		goto RequiredFieldNotSetError
 	}
-	p.TotalAmount = _field
-	return nil
-}
-func (p *OrderGoods) ReadField13(iprot thrift.TProtocol) error {
 
-	var _field float64
-	if v, err := iprot.ReadDouble(); err != nil {
-		return err
-	} else {
-		_field = v
+	if !issetPurchaseQuantity {
+		fieldId = 4
+		goto RequiredFieldNotSetError
 	}
-	p.FreightAmount = _field
-	return nil
-}
-func (p *OrderGoods) ReadField14(iprot thrift.TProtocol) error {
 
-	var _field float64
-	if v, err := iprot.ReadDouble(); err != nil {
-		return err
-	} else {
-		_field = v
+	if !issetGoodsVersion {
+		fieldId = 5
+		goto RequiredFieldNotSetError
 	}
-	p.DiscountAmount = _field
 	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BaseOrderGoods[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_BaseOrderGoods[fieldId]))
 }
-func (p *OrderGoods) ReadField15(iprot thrift.TProtocol) error {
 
-	var _field float64
-	if v, err := iprot.ReadDouble(); err != nil {
+func (p *BaseOrderGoods) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.PaymentAmount = _field
+	p.MerchantID = _field
 	return nil
 }
-func (p *OrderGoods) ReadField16(iprot thrift.TProtocol) error {
+func (p *BaseOrderGoods) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field float64
-	if v, err := iprot.ReadDouble(); err != nil {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.SinglePrice = _field
+	p.GoodsID = _field
 	return nil
 }
-func (p *OrderGoods) ReadField17(iprot thrift.TProtocol) error {
+func (p *BaseOrderGoods) ReadField3(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -8878,21 +13695,21 @@ func (p *OrderGoods) ReadField17(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.CouponId = _field
+	p.StyleID = _field
 	return nil
 }
-func (p *OrderGoods) ReadField18(iprot thrift.TProtocol) error {
+func (p *BaseOrderGoods) ReadField4(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.CouponName = _field
+	p.PurchaseQuantity = _field
 	return nil
 }
-func (p *OrderGoods) ReadField19(iprot thrift.TProtocol) error {
+func (p *BaseOrderGoods) ReadField5(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -8900,14 +13717,14 @@ func (p *OrderGoods) ReadField19(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.OrderId = _field
+	p.GoodsVersion = _field
 	return nil
 }
 
-func (p *OrderGoods) Write(oprot thrift.TProtocol) (err error) {
+func (p *BaseOrderGoods) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("OrderGoods"); err != nil {
+	if err = oprot.WriteStructBegin("BaseOrderGoods"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8931,62 +13748,6 @@ func (p *OrderGoods) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 5
 			goto WriteFieldError
 		}
-		if err = p.writeField6(oprot); err != nil {
-			fieldId = 6
-			goto WriteFieldError
-		}
-		if err = p.writeField7(oprot); err != nil {
-			fieldId = 7
-			goto WriteFieldError
-		}
-		if err = p.writeField8(oprot); err != nil {
-			fieldId = 8
-			goto WriteFieldError
-		}
-		if err = p.writeField9(oprot); err != nil {
-			fieldId = 9
-			goto WriteFieldError
-		}
-		if err = p.writeField10(oprot); err != nil {
-			fieldId = 10
-			goto WriteFieldError
-		}
-		if err = p.writeField11(oprot); err != nil {
-			fieldId = 11
-			goto WriteFieldError
-		}
-		if err = p.writeField12(oprot); err != nil {
-			fieldId = 12
-			goto WriteFieldError
-		}
-		if err = p.writeField13(oprot); err != nil {
-			fieldId = 13
-			goto WriteFieldError
-		}
-		if err = p.writeField14(oprot); err != nil {
-			fieldId = 14
-			goto WriteFieldError
-		}
-		if err = p.writeField15(oprot); err != nil {
-			fieldId = 15
-			goto WriteFieldError
-		}
-		if err = p.writeField16(oprot); err != nil {
-			fieldId = 16
-			goto WriteFieldError
-		}
-		if err = p.writeField17(oprot); err != nil {
-			fieldId = 17
-			goto WriteFieldError
-		}
-		if err = p.writeField18(oprot); err != nil {
-			fieldId = 18
-			goto WriteFieldError
-		}
-		if err = p.writeField19(oprot); err != nil {
-			fieldId = 19
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -9002,133 +13763,14 @@ WriteFieldError:
 WriteFieldStopError:
 	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
 WriteStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
-}
-
-func (p *OrderGoods) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("merchantId", thrift.I64, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.MerchantId); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
-}
-
-func (p *OrderGoods) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("goodsId", thrift.I64, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.GoodsId); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
-}
-
-func (p *OrderGoods) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("goodsName", thrift.STRING, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.GoodsName); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
-
-func (p *OrderGoods) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("styleId", thrift.I64, 4); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.StyleId); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
-}
-
-func (p *OrderGoods) writeField5(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("styleName", thrift.STRING, 5); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.StyleName); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
-}
-
-func (p *OrderGoods) writeField6(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("goodsVersion", thrift.I64, 6); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.GoodsVersion); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
-}
-
-func (p *OrderGoods) writeField7(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("styleHeadDrawing", thrift.STRING, 7); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.StyleHeadDrawing); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *OrderGoods) writeField8(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("originPrice", thrift.DOUBLE, 8); err != nil {
+func (p *BaseOrderGoods) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("merchantID", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteDouble(p.OriginPrice); err != nil {
+	if err := oprot.WriteI64(p.MerchantID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -9136,16 +13778,16 @@ func (p *OrderGoods) writeField8(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *OrderGoods) writeField9(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("salePrice", thrift.DOUBLE, 9); err != nil {
+func (p *BaseOrderGoods) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("goodsID", thrift.I64, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteDouble(p.SalePrice); err != nil {
+	if err := oprot.WriteI64(p.GoodsID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -9153,16 +13795,16 @@ func (p *OrderGoods) writeField9(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *OrderGoods) writeField10(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("singleFreightPrice", thrift.DOUBLE, 10); err != nil {
+func (p *BaseOrderGoods) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("styleID", thrift.I64, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteDouble(p.SingleFreightPrice); err != nil {
+	if err := oprot.WriteI64(p.StyleID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -9170,13 +13812,13 @@ func (p *OrderGoods) writeField10(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *OrderGoods) writeField11(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("purchaseQuantity", thrift.I64, 11); err != nil {
+func (p *BaseOrderGoods) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("purchaseQuantity", thrift.I64, 4); err != nil {
 		goto WriteFieldBeginError
 	}
 	if err := oprot.WriteI64(p.PurchaseQuantity); err != nil {
@@ -9187,16 +13829,16 @@ func (p *OrderGoods) writeField11(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *OrderGoods) writeField12(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("totalAmount", thrift.DOUBLE, 12); err != nil {
+func (p *BaseOrderGoods) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("goodsVersion", thrift.I64, 5); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteDouble(p.TotalAmount); err != nil {
+	if err := oprot.WriteI64(p.GoodsVersion); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -9204,67 +13846,263 @@ func (p *OrderGoods) writeField12(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func (p *OrderGoods) writeField13(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("freightAmount", thrift.DOUBLE, 13); err != nil {
-		goto WriteFieldBeginError
+func (p *BaseOrderGoods) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	if err := oprot.WriteDouble(p.FreightAmount); err != nil {
-		return err
+	return fmt.Sprintf("BaseOrderGoods(%+v)", *p)
+
+}
+
+/*
+ * struct CreditCardInfo 信用卡信息
+ * @Param maskedCreditCardNumber 仅存储信用卡号掩码，如 **** **** **** 1234
+ * @Param creditCardExpirationYear 信用卡到期年
+ * @Param creditCardExpirationMonth 信用卡到期月
+ * @Param creditCardCvv 信用卡
+ */
+type CreditCardInfo struct {
+	MaskedCreditCardNumber    string `thrift:"maskedCreditCardNumber,1,required" form:"maskedCreditCardNumber,required" json:"maskedCreditCardNumber,required" query:"maskedCreditCardNumber,required"`
+	CreditCardExpirationYear  int64  `thrift:"creditCardExpirationYear,2,required" form:"creditCardExpirationYear,required" json:"creditCardExpirationYear,required" query:"creditCardExpirationYear,required"`
+	CreditCardExpirationMonth int64  `thrift:"creditCardExpirationMonth,3,required" form:"creditCardExpirationMonth,required" json:"creditCardExpirationMonth,required" query:"creditCardExpirationMonth,required"`
+	CreditCardCvv             int64  `thrift:"creditCardCvv,4,required" form:"creditCardCvv,required" json:"creditCardCvv,required" query:"creditCardCvv,required"`
+}
+
+func NewCreditCardInfo() *CreditCardInfo {
+	return &CreditCardInfo{}
+}
+
+func (p *CreditCardInfo) InitDefault() {
+}
+
+func (p *CreditCardInfo) GetMaskedCreditCardNumber() (v string) {
+	return p.MaskedCreditCardNumber
+}
+
+func (p *CreditCardInfo) GetCreditCardExpirationYear() (v int64) {
+	return p.CreditCardExpirationYear
+}
+
+func (p *CreditCardInfo) GetCreditCardExpirationMonth() (v int64) {
+	return p.CreditCardExpirationMonth
+}
+
+func (p *CreditCardInfo) GetCreditCardCvv() (v int64) {
+	return p.CreditCardCvv
+}
+
+var fieldIDToName_CreditCardInfo = map[int16]string{
+	1: "maskedCreditCardNumber",
+	2: "creditCardExpirationYear",
+	3: "creditCardExpirationMonth",
+	4: "creditCardCvv",
+}
+
+func (p *CreditCardInfo) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetMaskedCreditCardNumber bool = false
+	var issetCreditCardExpirationYear bool = false
+	var issetCreditCardExpirationMonth bool = false
+	var issetCreditCardCvv bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetMaskedCreditCardNumber = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCreditCardExpirationYear = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCreditCardExpirationMonth = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCreditCardCvv = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetMaskedCreditCardNumber {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetCreditCardExpirationYear {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetCreditCardExpirationMonth {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetCreditCardCvv {
+		fieldId = 4
+		goto RequiredFieldNotSetError
 	}
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 13 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 13 end error: ", p), err)
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreditCardInfo[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_CreditCardInfo[fieldId]))
 }
 
-func (p *OrderGoods) writeField14(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("discountAmount", thrift.DOUBLE, 14); err != nil {
-		goto WriteFieldBeginError
+func (p *CreditCardInfo) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
-	if err := oprot.WriteDouble(p.DiscountAmount); err != nil {
+	p.MaskedCreditCardNumber = _field
+	return nil
+}
+func (p *CreditCardInfo) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+	p.CreditCardExpirationYear = _field
+	return nil
+}
+func (p *CreditCardInfo) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CreditCardExpirationMonth = _field
+	return nil
+}
+func (p *CreditCardInfo) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
+	p.CreditCardCvv = _field
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 14 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 14 end error: ", p), err)
 }
 
-func (p *OrderGoods) writeField15(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("paymentAmount", thrift.DOUBLE, 15); err != nil {
-		goto WriteFieldBeginError
+func (p *CreditCardInfo) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreditCardInfo"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err := oprot.WriteDouble(p.PaymentAmount); err != nil {
-		return err
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 15 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 15 end error: ", p), err)
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *OrderGoods) writeField16(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("singlePrice", thrift.DOUBLE, 16); err != nil {
+func (p *CreditCardInfo) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("maskedCreditCardNumber", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteDouble(p.SinglePrice); err != nil {
+	if err := oprot.WriteString(p.MaskedCreditCardNumber); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -9272,16 +14110,16 @@ func (p *OrderGoods) writeField16(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 16 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 16 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *OrderGoods) writeField17(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("couponId", thrift.I64, 17); err != nil {
+func (p *CreditCardInfo) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("creditCardExpirationYear", thrift.I64, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.CouponId); err != nil {
+	if err := oprot.WriteI64(p.CreditCardExpirationYear); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -9289,16 +14127,16 @@ func (p *OrderGoods) writeField17(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 17 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 17 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *OrderGoods) writeField18(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("couponName", thrift.STRING, 18); err != nil {
+func (p *CreditCardInfo) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("creditCardExpirationMonth", thrift.I64, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.CouponName); err != nil {
+	if err := oprot.WriteI64(p.CreditCardExpirationMonth); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -9306,16 +14144,16 @@ func (p *OrderGoods) writeField18(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 18 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 18 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *OrderGoods) writeField19(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("orderId", thrift.I64, 19); err != nil {
+func (p *CreditCardInfo) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("creditCardCvv", thrift.I64, 4); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.OrderId); err != nil {
+	if err := oprot.WriteI64(p.CreditCardCvv); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -9323,76 +14161,50 @@ func (p *OrderGoods) writeField19(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 19 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 19 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *OrderGoods) String() string {
+func (p *CreditCardInfo) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OrderGoods(%+v)", *p)
-
-}
-
-type BaseOrderGoods struct {
-	// 商家 ID
-	MerchantID int64 `thrift:"merchantID,1,required" form:"merchantID,required" json:"merchantID,required" query:"merchantID,required"`
-	// 商品 ID
-	GoodsID int64 `thrift:"goodsID,2,required" form:"goodsID,required" json:"goodsID,required" query:"goodsID,required"`
-	// 商品款式 ID
-	StyleID int64 `thrift:"styleID,3,required" form:"styleID,required" json:"styleID,required" query:"styleID,required"`
-	// 购买数量
-	PurchaseQuantity int64 `thrift:"purchaseQuantity,4,required" form:"purchaseQuantity,required" json:"purchaseQuantity,required" query:"purchaseQuantity,required"`
-	// 商品历史号
-	GoodsVersion int64 `thrift:"goodsVersion,5,required" form:"goodsVersion,required" json:"goodsVersion,required" query:"goodsVersion,required"`
-}
-
-func NewBaseOrderGoods() *BaseOrderGoods {
-	return &BaseOrderGoods{}
-}
+	return fmt.Sprintf("CreditCardInfo(%+v)", *p)
 
-func (p *BaseOrderGoods) InitDefault() {
 }
 
-func (p *BaseOrderGoods) GetMerchantID() (v int64) {
-	return p.MerchantID
+type PaymentTokenInfo struct {
+	PaymentToken               string `thrift:"paymentToken,1,required" form:"paymentToken,required" json:"paymentToken,required" query:"paymentToken,required"`
+	PaymentTokenExpirationTime int64  `thrift:"paymentTokenExpirationTime,2,required" form:"paymentTokenExpirationTime,required" json:"paymentTokenExpirationTime,required" query:"paymentTokenExpirationTime,required"`
 }
 
-func (p *BaseOrderGoods) GetGoodsID() (v int64) {
-	return p.GoodsID
+func NewPaymentTokenInfo() *PaymentTokenInfo {
+	return &PaymentTokenInfo{}
 }
 
-func (p *BaseOrderGoods) GetStyleID() (v int64) {
-	return p.StyleID
+func (p *PaymentTokenInfo) InitDefault() {
 }
 
-func (p *BaseOrderGoods) GetPurchaseQuantity() (v int64) {
-	return p.PurchaseQuantity
+func (p *PaymentTokenInfo) GetPaymentToken() (v string) {
+	return p.PaymentToken
 }
 
-func (p *BaseOrderGoods) GetGoodsVersion() (v int64) {
-	return p.GoodsVersion
+func (p *PaymentTokenInfo) GetPaymentTokenExpirationTime() (v int64) {
+	return p.PaymentTokenExpirationTime
 }
 
-var fieldIDToName_BaseOrderGoods = map[int16]string{
-	1: "merchantID",
-	2: "goodsID",
-	3: "styleID",
-	4: "purchaseQuantity",
-	5: "goodsVersion",
+var fieldIDToName_PaymentTokenInfo = map[int16]string{
+	1: "paymentToken",
+	2: "paymentTokenExpirationTime",
 }
 
-func (p *BaseOrderGoods) Read(iprot thrift.TProtocol) (err error) {
+func (p *PaymentTokenInfo) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetMerchantID bool = false
-	var issetGoodsID bool = false
-	var issetStyleID bool = false
-	var issetPurchaseQuantity bool = false
-	var issetGoodsVersion bool = false
+	var issetPaymentToken bool = false
+	var issetPaymentTokenExpirationTime bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -9409,11 +14221,11 @@ func (p *BaseOrderGoods) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetMerchantID = true
+				issetPaymentToken = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -9422,34 +14234,7 @@ func (p *BaseOrderGoods) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetGoodsID = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 3:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetStyleID = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 4:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetPurchaseQuantity = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 5:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetGoodsVersion = true
+				issetPaymentTokenExpirationTime = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -9466,37 +14251,22 @@ func (p *BaseOrderGoods) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetMerchantID {
+	if !issetPaymentToken {
 		fieldId = 1
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetGoodsID {
+	if !issetPaymentTokenExpirationTime {
 		fieldId = 2
 		goto RequiredFieldNotSetError
 	}
-
-	if !issetStyleID {
-		fieldId = 3
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetPurchaseQuantity {
-		fieldId = 4
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetGoodsVersion {
-		fieldId = 5
-		goto RequiredFieldNotSetError
-	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BaseOrderGoods[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PaymentTokenInfo[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9505,54 +14275,21 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_BaseOrderGoods[fieldId]))
-}
-
-func (p *BaseOrderGoods) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.MerchantID = _field
-	return nil
-}
-func (p *BaseOrderGoods) ReadField2(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.GoodsID = _field
-	return nil
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_PaymentTokenInfo[fieldId]))
 }
-func (p *BaseOrderGoods) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.StyleID = _field
-	return nil
-}
-func (p *BaseOrderGoods) ReadField4(iprot thrift.TProtocol) error {
+func (p *PaymentTokenInfo) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.PurchaseQuantity = _field
+	p.PaymentToken = _field
 	return nil
 }
-func (p *BaseOrderGoods) ReadField5(iprot thrift.TProtocol) error {
+func (p *PaymentTokenInfo) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -9560,14 +14297,14 @@ func (p *BaseOrderGoods) ReadField5(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.GoodsVersion = _field
+	p.PaymentTokenExpirationTime = _field
 	return nil
 }
 
-func (p *BaseOrderGoods) Write(oprot thrift.TProtocol) (err error) {
+func (p *PaymentTokenInfo) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("BaseOrderGoods"); err != nil {
+	if err = oprot.WriteStructBegin("PaymentTokenInfo"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9579,18 +14316,6 @@ func (p *BaseOrderGoods) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -9609,11 +14334,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *BaseOrderGoods) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("merchantID", thrift.I64, 1); err != nil {
+func (p *PaymentTokenInfo) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("paymentToken", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.MerchantID); err != nil {
+	if err := oprot.WriteString(p.PaymentToken); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -9626,11 +14351,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *BaseOrderGoods) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("goodsID", thrift.I64, 2); err != nil {
+func (p *PaymentTokenInfo) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("paymentTokenExpirationTime", thrift.I64, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.GoodsID); err != nil {
+	if err := oprot.WriteI64(p.PaymentTokenExpirationTime); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -9643,117 +14368,45 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *BaseOrderGoods) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("styleID", thrift.I64, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.StyleID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
-
-func (p *BaseOrderGoods) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("purchaseQuantity", thrift.I64, 4); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.PurchaseQuantity); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
-}
-
-func (p *BaseOrderGoods) writeField5(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("goodsVersion", thrift.I64, 5); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.GoodsVersion); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
-}
-
-func (p *BaseOrderGoods) String() string {
+func (p *PaymentTokenInfo) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("BaseOrderGoods(%+v)", *p)
-
-}
-
-/*
- * struct CreditCardInfo 信用卡信息
- * @Param maskedCreditCardNumber 仅存储信用卡号掩码，如 **** **** **** 1234
- * @Param creditCardExpirationYear 信用卡到期年
- * @Param creditCardExpirationMonth 信用卡到期月
- * @Param creditCardCvv 信用卡
- */
-type CreditCardInfo struct {
-	MaskedCreditCardNumber    string `thrift:"maskedCreditCardNumber,1,required" form:"maskedCreditCardNumber,required" json:"maskedCreditCardNumber,required" query:"maskedCreditCardNumber,required"`
-	CreditCardExpirationYear  int64  `thrift:"creditCardExpirationYear,2,required" form:"creditCardExpirationYear,required" json:"creditCardExpirationYear,required" query:"creditCardExpirationYear,required"`
-	CreditCardExpirationMonth int64  `thrift:"creditCardExpirationMonth,3,required" form:"creditCardExpirationMonth,required" json:"creditCardExpirationMonth,required" query:"creditCardExpirationMonth,required"`
-	CreditCardCvv             int64  `thrift:"creditCardCvv,4,required" form:"creditCardCvv,required" json:"creditCardCvv,required" query:"creditCardCvv,required"`
-}
+	return fmt.Sprintf("PaymentTokenInfo(%+v)", *p)
 
-func NewCreditCardInfo() *CreditCardInfo {
-	return &CreditCardInfo{}
 }
 
-func (p *CreditCardInfo) InitDefault() {
+type RefundResponseInfo struct {
+	RefundID int64 `thrift:"refundID,1,required" form:"refundID,required" json:"refundID,required" query:"refundID,required"`
+	Status   int64 `thrift:"status,2,required" form:"status,required" json:"status,required" query:"status,required"`
 }
 
-func (p *CreditCardInfo) GetMaskedCreditCardNumber() (v string) {
-	return p.MaskedCreditCardNumber
+func NewRefundResponseInfo() *RefundResponseInfo {
+	return &RefundResponseInfo{}
 }
 
-func (p *CreditCardInfo) GetCreditCardExpirationYear() (v int64) {
-	return p.CreditCardExpirationYear
+func (p *RefundResponseInfo) InitDefault() {
 }
 
-func (p *CreditCardInfo) GetCreditCardExpirationMonth() (v int64) {
-	return p.CreditCardExpirationMonth
+func (p *RefundResponseInfo) GetRefundID() (v int64) {
+	return p.RefundID
 }
 
-func (p *CreditCardInfo) GetCreditCardCvv() (v int64) {
-	return p.CreditCardCvv
+func (p *RefundResponseInfo) GetStatus() (v int64) {
+	return p.Status
 }
 
-var fieldIDToName_CreditCardInfo = map[int16]string{
-	1: "maskedCreditCardNumber",
-	2: "creditCardExpirationYear",
-	3: "creditCardExpirationMonth",
-	4: "creditCardCvv",
+var fieldIDToName_RefundResponseInfo = map[int16]string{
+	1: "refundID",
+	2: "status",
 }
 
-func (p *CreditCardInfo) Read(iprot thrift.TProtocol) (err error) {
+func (p *RefundResponseInfo) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetMaskedCreditCardNumber bool = false
-	var issetCreditCardExpirationYear bool = false
-	var issetCreditCardExpirationMonth bool = false
-	var issetCreditCardCvv bool = false
+	var issetRefundID bool = false
+	var issetStatus bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -9770,11 +14423,11 @@ func (p *CreditCardInfo) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetMaskedCreditCardNumber = true
+				issetRefundID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -9783,25 +14436,7 @@ func (p *CreditCardInfo) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetCreditCardExpirationYear = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 3:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetCreditCardExpirationMonth = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 4:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetCreditCardCvv = true
+				issetStatus = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -9818,32 +14453,22 @@ func (p *CreditCardInfo) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetMaskedCreditCardNumber {
+	if !issetRefundID {
 		fieldId = 1
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetCreditCardExpirationYear {
+	if !issetStatus {
 		fieldId = 2
 		goto RequiredFieldNotSetError
 	}
-
-	if !issetCreditCardExpirationMonth {
-		fieldId = 3
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetCreditCardCvv {
-		fieldId = 4
-		goto RequiredFieldNotSetError
-	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreditCardInfo[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RefundResponseInfo[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9852,32 +14477,10 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_CreditCardInfo[fieldId]))
-}
-
-func (p *CreditCardInfo) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.MaskedCreditCardNumber = _field
-	return nil
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_RefundResponseInfo[fieldId]))
 }
-func (p *CreditCardInfo) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.CreditCardExpirationYear = _field
-	return nil
-}
-func (p *CreditCardInfo) ReadField3(iprot thrift.TProtocol) error {
+func (p *RefundResponseInfo) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -9885,10 +14488,10 @@ func (p *CreditCardInfo) ReadField3(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.CreditCardExpirationMonth = _field
+	p.RefundID = _field
 	return nil
 }
-func (p *CreditCardInfo) ReadField4(iprot thrift.TProtocol) error {
+func (p *RefundResponseInfo) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -9896,14 +14499,14 @@ func (p *CreditCardInfo) ReadField4(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.CreditCardCvv = _field
+	p.Status = _field
 	return nil
 }
 
-func (p *CreditCardInfo) Write(oprot thrift.TProtocol) (err error) {
+func (p *RefundResponseInfo) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreditCardInfo"); err != nil {
+	if err = oprot.WriteStructBegin("RefundResponseInfo"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9915,14 +14518,6 @@ func (p *CreditCardInfo) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -9941,45 +14536,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *CreditCardInfo) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("maskedCreditCardNumber", thrift.STRING, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.MaskedCreditCardNumber); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
-}
-
-func (p *CreditCardInfo) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("creditCardExpirationYear", thrift.I64, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.CreditCardExpirationYear); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
-}
-
-func (p *CreditCardInfo) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("creditCardExpirationMonth", thrift.I64, 3); err != nil {
+func (p *RefundResponseInfo) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("refundID", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.CreditCardExpirationMonth); err != nil {
+	if err := oprot.WriteI64(p.RefundID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -9987,16 +14548,16 @@ func (p *CreditCardInfo) writeField3(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *CreditCardInfo) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("creditCardCvv", thrift.I64, 4); err != nil {
+func (p *RefundResponseInfo) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status", thrift.I64, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.CreditCardCvv); err != nil {
+	if err := oprot.WriteI64(p.Status); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -10004,50 +14565,61 @@ func (p *CreditCardInfo) writeField4(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *CreditCardInfo) String() string {
+func (p *RefundResponseInfo) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CreditCardInfo(%+v)", *p)
+	return fmt.Sprintf("RefundResponseInfo(%+v)", *p)
 
 }
 
-type PaymentTokenInfo struct {
-	PaymentToken               string `thrift:"paymentToken,1,required" form:"paymentToken,required" json:"paymentToken,required" query:"paymentToken,required"`
-	PaymentTokenExpirationTime int64  `thrift:"paymentTokenExpirationTime,2,required" form:"paymentTokenExpirationTime,required" json:"paymentTokenExpirationTime,required" query:"paymentTokenExpirationTime,required"`
+/*
+ * struct PaymentStatusInfo 订单的支付单状态
+ * @Param status 支付状态：0-待支付，1-处理中，2-成功支付，3-支付失败
+ */
+type PaymentStatusInfo struct {
+	PaymentID int64   `thrift:"paymentID,1,required" form:"paymentID,required" json:"paymentID,required" query:"paymentID,required"`
+	Status    int64   `thrift:"status,2,required" form:"status,required" json:"status,required" query:"status,required"`
+	Amount    float64 `thrift:"amount,3,required" form:"amount,required" json:"amount,required" query:"amount,required"`
 }
 
-func NewPaymentTokenInfo() *PaymentTokenInfo {
-	return &PaymentTokenInfo{}
+func NewPaymentStatusInfo() *PaymentStatusInfo {
+	return &PaymentStatusInfo{}
 }
 
-func (p *PaymentTokenInfo) InitDefault() {
+func (p *PaymentStatusInfo) InitDefault() {
 }
 
-func (p *PaymentTokenInfo) GetPaymentToken() (v string) {
-	return p.PaymentToken
+func (p *PaymentStatusInfo) GetPaymentID() (v int64) {
+	return p.PaymentID
 }
 
-func (p *PaymentTokenInfo) GetPaymentTokenExpirationTime() (v int64) {
-	return p.PaymentTokenExpirationTime
+func (p *PaymentStatusInfo) GetStatus() (v int64) {
+	return p.Status
 }
 
-var fieldIDToName_PaymentTokenInfo = map[int16]string{
-	1: "paymentToken",
-	2: "paymentTokenExpirationTime",
+func (p *PaymentStatusInfo) GetAmount() (v float64) {
+	return p.Amount
 }
 
-func (p *PaymentTokenInfo) Read(iprot thrift.TProtocol) (err error) {
+var fieldIDToName_PaymentStatusInfo = map[int16]string{
+	1: "paymentID",
+	2: "status",
+	3: "amount",
+}
+
+func (p *PaymentStatusInfo) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetPaymentToken bool = false
-	var issetPaymentTokenExpirationTime bool = false
+	var issetPaymentID bool = false
+	var issetStatus bool = false
+	var issetAmount bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -10064,11 +14636,11 @@ func (p *PaymentTokenInfo) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetPaymentToken = true
+				issetPaymentID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -10077,7 +14649,16 @@ func (p *PaymentTokenInfo) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetPaymentTokenExpirationTime = true
+				issetStatus = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetAmount = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -10094,22 +14675,27 @@ func (p *PaymentTokenInfo) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetPaymentToken {
+	if !issetPaymentID {
 		fieldId = 1
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetPaymentTokenExpirationTime {
+	if !issetStatus {
 		fieldId = 2
 		goto RequiredFieldNotSetError
 	}
+
+	if !issetAmount {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PaymentTokenInfo[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PaymentStatusInfo[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10118,21 +14704,21 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_PaymentTokenInfo[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_PaymentStatusInfo[fieldId]))
 }
 
-func (p *PaymentTokenInfo) ReadField1(iprot thrift.TProtocol) error {
+func (p *PaymentStatusInfo) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.PaymentToken = _field
+	p.PaymentID = _field
 	return nil
 }
-func (p *PaymentTokenInfo) ReadField2(iprot thrift.TProtocol) error {
+func (p *PaymentStatusInfo) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -10140,14 +14726,25 @@ func (p *PaymentTokenInfo) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.PaymentTokenExpirationTime = _field
+	p.Status = _field
 	return nil
 }
+func (p *PaymentStatusInfo) ReadField3(iprot thrift.TProtocol) error {
 
-func (p *PaymentTokenInfo) Write(oprot thrift.TProtocol) (err error) {
+	var _field float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Amount = _field
+	return nil
+}
+
+func (p *PaymentStatusInfo) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("PaymentTokenInfo"); err != nil {
+	if err = oprot.WriteStructBegin("PaymentStatusInfo"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10159,6 +14756,10 @@ func (p *PaymentTokenInfo) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -10177,11 +14778,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PaymentTokenInfo) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("paymentToken", thrift.STRING, 1); err != nil {
+func (p *PaymentStatusInfo) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("paymentID", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.PaymentToken); err != nil {
+	if err := oprot.WriteI64(p.PaymentID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -10194,11 +14795,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *PaymentTokenInfo) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("paymentTokenExpirationTime", thrift.I64, 2); err != nil {
+func (p *PaymentStatusInfo) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status", thrift.I64, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.PaymentTokenExpirationTime); err != nil {
+	if err := oprot.WriteI64(p.Status); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -10211,45 +14812,80 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *PaymentTokenInfo) String() string {
+func (p *PaymentStatusInfo) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("amount", thrift.DOUBLE, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteDouble(p.Amount); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *PaymentStatusInfo) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PaymentTokenInfo(%+v)", *p)
+	return fmt.Sprintf("PaymentStatusInfo(%+v)", *p)
 
 }
 
-type RefundResponseInfo struct {
-	RefundID int64 `thrift:"refundID,1,required" form:"refundID,required" json:"refundID,required" query:"refundID,required"`
-	Status   int64 `thrift:"status,2,required" form:"status,required" json:"status,required" query:"status,required"`
+/*
+ * struct RefundStatusInfo 订单的退款单状态
+ * @Param status 退款状态：0-待处理，1-处理中，2-成功退款，3-退款失败
+ */
+type RefundStatusInfo struct {
+	RefundID     int64   `thrift:"refundID,1,required" form:"refundID,required" json:"refundID,required" query:"refundID,required"`
+	Status       int64   `thrift:"status,2,required" form:"status,required" json:"status,required" query:"status,required"`
+	RefundAmount float64 `thrift:"refundAmount,3,required" form:"refundAmount,required" json:"refundAmount,required" query:"refundAmount,required"`
+	RefundReason string  `thrift:"refundReason,4,required" form:"refundReason,required" json:"refundReason,required" query:"refundReason,required"`
 }
 
-func NewRefundResponseInfo() *RefundResponseInfo {
-	return &RefundResponseInfo{}
+func NewRefundStatusInfo() *RefundStatusInfo {
+	return &RefundStatusInfo{}
 }
 
-func (p *RefundResponseInfo) InitDefault() {
+func (p *RefundStatusInfo) InitDefault() {
 }
 
-func (p *RefundResponseInfo) GetRefundID() (v int64) {
+func (p *RefundStatusInfo) GetRefundID() (v int64) {
 	return p.RefundID
 }
 
-func (p *RefundResponseInfo) GetStatus() (v int64) {
+func (p *RefundStatusInfo) GetStatus() (v int64) {
 	return p.Status
 }
 
-var fieldIDToName_RefundResponseInfo = map[int16]string{
+func (p *RefundStatusInfo) GetRefundAmount() (v float64) {
+	return p.RefundAmount
+}
+
+func (p *RefundStatusInfo) GetRefundReason() (v string) {
+	return p.RefundReason
+}
+
+var fieldIDToName_RefundStatusInfo = map[int16]string{
 	1: "refundID",
 	2: "status",
+	3: "refundAmount",
+	4: "refundReason",
 }
 
-func (p *RefundResponseInfo) Read(iprot thrift.TProtocol) (err error) {
+func (p *RefundStatusInfo) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
 	var issetRefundID bool = false
 	var issetStatus bool = false
+	var issetRefundAmount bool = false
+	var issetRefundReason bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -10283,6 +14919,24 @@ func (p *RefundResponseInfo) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 3:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetRefundAmount = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetRefundReason = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -10305,13 +14959,23 @@ func (p *RefundResponseInfo) Read(iprot thrift.TProtocol) (err error) {
 		fieldId = 2
 		goto RequiredFieldNotSetError
 	}
+
+	if !issetRefundAmount {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetRefundReason {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RefundResponseInfo[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RefundStatusInfo[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10320,10 +14984,10 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_RefundResponseInfo[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_RefundStatusInfo[fieldId]))
 }
 
-func (p *RefundResponseInfo) ReadField1(iprot thrift.TProtocol) error {
+func (p *RefundStatusInfo) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -10334,7 +14998,7 @@ func (p *RefundResponseInfo) ReadField1(iprot thrift.TProtocol) error {
 	p.RefundID = _field
 	return nil
 }
-func (p *RefundResponseInfo) ReadField2(iprot thrift.TProtocol) error {
+func (p *RefundStatusInfo) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -10345,11 +15009,33 @@ func (p *RefundResponseInfo) ReadField2(iprot thrift.TProtocol) error {
 	p.Status = _field
 	return nil
 }
+func (p *RefundStatusInfo) ReadField3(iprot thrift.TProtocol) error {
 
-func (p *RefundResponseInfo) Write(oprot thrift.TProtocol) (err error) {
+	var _field float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.RefundAmount = _field
+	return nil
+}
+func (p *RefundStatusInfo) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.RefundReason = _field
+	return nil
+}
+
+func (p *RefundStatusInfo) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("RefundResponseInfo"); err != nil {
+	if err = oprot.WriteStructBegin("RefundStatusInfo"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10361,6 +15047,14 @@ func (p *RefundResponseInfo) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -10379,7 +15073,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RefundResponseInfo) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *RefundStatusInfo) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("refundID", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -10396,7 +15090,7 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *RefundResponseInfo) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *RefundStatusInfo) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("status", thrift.I64, 2); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -10413,11 +15107,45 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *RefundResponseInfo) String() string {
+func (p *RefundStatusInfo) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("refundAmount", thrift.DOUBLE, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteDouble(p.RefundAmount); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *RefundStatusInfo) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("refundReason", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.RefundReason); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *RefundStatusInfo) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RefundResponseInfo(%+v)", *p)
+	return fmt.Sprintf("RefundStatusInfo(%+v)", *p)
 
 }
 
@@ -10442,6 +15170,8 @@ type CartGoods struct {
 	TotalAmount float64 `thrift:"totalAmount,12,required" form:"totalAmount,required" json:"totalAmount,required" query:"totalAmount,required"`
 	// 折扣总金额
 	DiscountAmount float64 `thrift:"discountAmount,14,required" form:"discountAmount,required" json:"discountAmount,required" query:"discountAmount,required"`
+	// 店铺名称
+	MerchantName string `thrift:"merchantName,15" form:"merchantName" json:"merchantName" query:"merchantName"`
 }
 
 func NewCartGoods() *CartGoods {
@@ -10491,6 +15221,10 @@ func (p *CartGoods) GetDiscountAmount() (v float64) {
 	return p.DiscountAmount
 }
 
+func (p *CartGoods) GetMerchantName() (v string) {
+	return p.MerchantName
+}
+
 var fieldIDToName_CartGoods = map[int16]string{
 	1:  "merchantId",
 	2:  "goodsId",
@@ -10502,6 +15236,7 @@ var fieldIDToName_CartGoods = map[int16]string{
 	11: "purchaseQuantity",
 	12: "totalAmount",
 	14: "discountAmount",
+	15: "merchantName",
 }
 
 func (p *CartGoods) Read(iprot thrift.TProtocol) (err error) {
@@ -10623,6 +15358,14 @@ func (p *CartGoods) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 15:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField15(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -10813,6 +15556,17 @@ func (p *CartGoods) ReadField14(iprot thrift.TProtocol) error {
 	p.DiscountAmount = _field
 	return nil
 }
+func (p *CartGoods) ReadField15(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.MerchantName = _field
+	return nil
+}
 
 func (p *CartGoods) Write(oprot thrift.TProtocol) (err error) {
 
@@ -10861,6 +15615,10 @@ func (p *CartGoods) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 14
 			goto WriteFieldError
 		}
+		if err = p.writeField15(oprot); err != nil {
+			fieldId = 15
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -11049,6 +15807,23 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 14 end error: ", p), err)
 }
 
+func (p *CartGoods) writeField15(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("merchantName", thrift.STRING, 15); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.MerchantName); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 15 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 15 end error: ", p), err)
+}
+
 func (p *CartGoods) String() string {
 	if p == nil {
 		return "<nil>"
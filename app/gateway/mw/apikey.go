@@ -0,0 +1,77 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mw
+
+import (
+	"context"
+	"slices"
+
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/west2-online/DomTok/app/gateway/pack"
+	"github.com/west2-online/DomTok/app/gateway/rpc"
+	"github.com/west2-online/DomTok/kitex_gen/user"
+	metainfoContext "github.com/west2-online/DomTok/pkg/base/context"
+	"github.com/west2-online/DomTok/pkg/constants"
+	"github.com/west2-online/DomTok/pkg/errno"
+	"github.com/west2-online/DomTok/pkg/rbac"
+)
+
+// ApiKeyAuth 在 Auth 的基础上允许商家使用 X-Api-Key 访问商品和优惠券接口,
+// 请求没有携带 API Key 时与 Auth 的行为一致
+func ApiKeyAuth() app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		key := string(c.GetHeader(constants.ApiKeyHeader))
+		if key == "" {
+			ctx, err := withAccessToken(ctx, string(c.GetHeader(constants.AuthHeader)))
+			if err != nil {
+				pack.RespError(c, err)
+				c.Abort()
+				return
+			}
+			ctx = metainfoContext.WithClientIPData(ctx, c.ClientIP())
+			c.Next(ctx)
+			return
+		}
+
+		scope, ok := rbac.ApiKeyScope(c.FullPath())
+		if !ok {
+			pack.RespError(c, errno.AuthNoOperatePermission.WithMessage("api key is not allowed on this route"))
+			c.Abort()
+			return
+		}
+		resp, err := rpc.VerifyApiKeyRPC(ctx, &user.VerifyApiKeyReq{Key: key})
+		if err != nil {
+			pack.RespError(c, err)
+			c.Abort()
+			return
+		}
+		if !slices.Contains(resp.Scopes, scope) {
+			pack.RespError(c, errno.AuthNoOperatePermission.WithMessage("api key lacks scope: "+scope))
+			c.Abort()
+			return
+		}
+
+		// API Key 只拥有 scope 对应的权限, 不携带会话, 也不能用于管理类操作
+		ctx = metainfoContext.WithLoginData(ctx, resp.Uid)
+		ctx = metainfoContext.SetStreamLoginData(ctx, resp.Uid)
+		ctx = metainfoContext.WithPermissionData(ctx, rbac.ApiKeyPermissions(resp.Scopes))
+		ctx = metainfoContext.WithMFAData(ctx, false)
+		ctx = metainfoContext.WithClientIPData(ctx, c.ClientIP())
+		c.Next(ctx)
+	}
+}
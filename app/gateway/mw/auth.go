@@ -30,26 +30,31 @@ import (
 // Auth 负责校验用户身份，会提取 token 并做处理，Next 时会携带 token 类型
 func Auth() app.HandlerFunc {
 	return func(ctx context.Context, c *app.RequestContext) {
-		token := string(c.GetHeader(constants.AuthHeader))
-		_, uid, err := utils.CheckToken(token)
+		ctx, err := withAccessToken(ctx, string(c.GetHeader(constants.AuthHeader)))
 		if err != nil {
 			pack.RespError(c, err)
 			c.Abort()
 			return
 		}
-
-		access, refresh, err := utils.CreateAllToken(uid)
-		if err != nil {
-			pack.RespError(c, err)
-			c.Abort()
-			return
-		}
-
-		// 实现规范化服务透传，不需要中间进行编解码
-		ctx = metainfoContext.WithLoginData(ctx, uid)
-		ctx = metainfoContext.SetStreamLoginData(ctx, uid)
-		c.Header(constants.AccessTokenHeader, access)
-		c.Header(constants.RefreshTokenHeader, refresh)
+		ctx = metainfoContext.WithClientIPData(ctx, c.ClientIP())
 		c.Next(ctx)
 	}
 }
+
+// withAccessToken 解析 access token, 并将其中的登录信息注入 context
+func withAccessToken(ctx context.Context, token string) (context.Context, error) {
+	// refresh token 只能用于换取新的 token, 不能直接访问业务接口
+	claims, err := utils.ParseAccessToken(token)
+	if err != nil {
+		return ctx, err
+	}
+	uid := claims.UserID
+
+	// 实现规范化服务透传，不需要中间进行编解码
+	ctx = metainfoContext.WithLoginData(ctx, uid)
+	ctx = metainfoContext.SetStreamLoginData(ctx, uid)
+	ctx = metainfoContext.WithSessionData(ctx, claims.SessionID)
+	ctx = metainfoContext.WithPermissionData(ctx, claims.Permissions)
+	ctx = metainfoContext.WithMFAData(ctx, claims.MFA)
+	return ctx, nil
+}
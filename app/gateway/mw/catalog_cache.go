@@ -0,0 +1,172 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mw
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/bytedance/sonic"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+
+	"github.com/west2-online/DomTok/app/gateway/pack"
+	"github.com/west2-online/DomTok/app/gateway/service"
+	"github.com/west2-online/DomTok/pkg/constants"
+	"github.com/west2-online/DomTok/pkg/errno"
+	"github.com/west2-online/DomTok/pkg/logger"
+)
+
+var catalogOnce sync.Once
+
+// CatalogCache 缓存对所有用户都相同的商品查询接口, 并支持 ETag 协商缓存
+// 缓存在 spu 变更时通过 kafka 事件失效, 其余变更依赖较短的过期时间
+// 下游服务熔断或者不可用时, 返回最近一次成功的响应作为降级数据
+func CatalogCache() app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		once.Do(func() {
+			GateWayService = service.NewGateWayService()
+		})
+		catalogOnce.Do(func() {
+			GateWayService.WatchSpuEvents(context.Background())
+		})
+		// hz 生成的请求结构在 GET 请求上也会绑定 json 和表单请求体, 而缓存键只包含查询参数,
+		// 带请求体的请求可能得到不同的响应, 既不能读取缓存, 也不能写入缓存和降级数据
+		if hasRequestBody(c) {
+			c.Next(ctx)
+			return
+		}
+
+		version, err := GateWayService.Re.GetCatalogVersion(ctx)
+		if err != nil {
+			logger.Errorf("gateway: catalog cache failed: %v", err)
+			c.Next(ctx)
+			return
+		}
+		key := GateWayService.Re.GetCatalogCacheKey(version, c.FullPath(), normalizedQuery(c))
+
+		cached, err := GateWayService.Re.GetCatalogCache(ctx, key)
+		if err != nil {
+			logger.Errorf("gateway: catalog cache failed: %v", err)
+		}
+		if cached != nil {
+			writeCachedResponse(c, cached)
+			c.Abort()
+			return
+		}
+
+		c.Next(ctx)
+
+		staleKey := GateWayService.Re.GetCatalogStaleKey(c.FullPath(), normalizedQuery(c))
+		body := c.Response.Body()
+		code, ok := responseCode(body)
+		if !ok || code >= errno.InternalServiceErrorCode {
+			serveStaleResponse(ctx, c, staleKey)
+			return
+		}
+		// 只缓存成功的响应
+		if c.Response.StatusCode() != consts.StatusOK || code != errno.SuccessCode {
+			return
+		}
+		sum := sha256.Sum256(body)
+		cached = &service.CachedResponse{
+			ETag:        strconv.Quote(hex.EncodeToString(sum[:16])),
+			ContentType: string(c.Response.Header.ContentType()),
+			Body:        bytes.Clone(body),
+		}
+		logger.LogError(GateWayService.Re.SetCatalogCache(ctx, key, cached, constants.CatalogCacheTTL))
+		logger.LogError(GateWayService.Re.SetCatalogCache(ctx, staleKey, cached, constants.CatalogStaleTTL))
+		writeCachedResponse(c, cached)
+	}
+}
+
+// serveStaleResponse 下游服务熔断或者不可用时, 使用最近一次成功的响应降级, 没有降级数据时保留原来的错误
+func serveStaleResponse(ctx context.Context, c *app.RequestContext, staleKey string) {
+	stale, err := GateWayService.Re.GetCatalogCache(ctx, staleKey)
+	if err != nil {
+		logger.Errorf("gateway: catalog stale cache failed: %v", err)
+		return
+	}
+	if stale == nil {
+		return
+	}
+	c.Header("Warning", constants.CatalogStaleWarning)
+	writeCachedResponse(c, stale)
+}
+
+// writeCachedResponse 客户端携带的 If-None-Match 与 ETag 相同时返回 304
+// hertz 的 Data 会追加响应体, 需要先清空下游接口已经写入的响应, 否则缓存的响应会拼接在后面
+func writeCachedResponse(c *app.RequestContext, cached *service.CachedResponse) {
+	c.Header("ETag", cached.ETag)
+	c.Header("Cache-Control", constants.CatalogCacheControlValue)
+	c.Response.ResetBody()
+	if etagMatch(string(c.GetHeader("If-None-Match")), cached.ETag) {
+		c.Status(consts.StatusNotModified)
+		return
+	}
+	c.Data(consts.StatusOK, cached.ContentType, cached.Body)
+}
+
+// etagMatch If-None-Match 可以包含多个 ETag, 比较时忽略弱校验前缀
+func etagMatch(ifNoneMatch, etag string) bool {
+	for _, tag := range strings.Split(ifNoneMatch, ",") {
+		tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+		if tag == etag || tag == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizedQuery 按照参数名和参数值排序, 并忽略空参数, 使等价的请求得到相同的缓存
+func normalizedQuery(c *app.RequestContext) string {
+	values := make(url.Values)
+	c.QueryArgs().VisitAll(func(key, value []byte) {
+		if len(value) > 0 {
+			values.Add(string(key), string(value))
+		}
+	})
+	for _, v := range values {
+		sort.Strings(v)
+	}
+	return values.Encode()
+}
+
+// hasRequestBody 判断请求是否携带了请求体
+func hasRequestBody(c *app.RequestContext) bool {
+	return len(c.Request.Body()) > 0
+}
+
+// responseCode 解析响应中的错误码, 响应不是统一的格式时返回 false
+func responseCode(body []byte) (int64, bool) {
+	var base pack.Base
+	if err := sonic.Unmarshal(body, &base); err != nil {
+		return 0, false
+	}
+	code, err := strconv.ParseInt(base.Code, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return code, true
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mw
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bytedance/mockey"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/west2-online/DomTok/app/gateway/pack"
+	"github.com/west2-online/DomTok/app/gateway/service"
+	"github.com/west2-online/DomTok/pkg/constants"
+)
+
+func TestCatalogCacheKey(t *testing.T) {
+	Convey("TestCatalogCacheKey", t, func() {
+		Convey("equivalent queries share a key", func() {
+			a := app.NewContext(0)
+			a.Request.SetRequestURI("/api/v1/commodity/spu/search?page_size=10&keyword=phone&empty=")
+			b := app.NewContext(0)
+			b.Request.SetRequestURI("/api/v1/commodity/spu/search?keyword=phone&page_size=10")
+			So(normalizedQuery(a), ShouldEqual, normalizedQuery(b))
+		})
+		Convey("requests with a body bypass the cache", func() {
+			c := app.NewContext(0)
+			c.Request.SetRequestURI("/api/v1/commodity/spu/search")
+			So(hasRequestBody(c), ShouldBeFalse)
+			c.Request.SetBodyString(`{"keyword":"phone"}`)
+			So(hasRequestBody(c), ShouldBeTrue)
+		})
+	})
+}
+
+func TestCatalogCache_Stale(t *testing.T) {
+	mockey.PatchConvey("TestCatalogCache_Stale", t, func() {
+		useTestGateWayService()
+		catalogOnce.Do(func() {})
+
+		stale := &service.CachedResponse{
+			ETag:        `"stale"`,
+			ContentType: "application/json; charset=utf-8",
+			Body:        []byte(`{"code":"10000","message":"Success","data":{"total":1}}`),
+		}
+		hasStale := true
+		var saved []string
+		mockey.Mock((*service.RedisService).GetCatalogVersion).Return(int64(1), nil).Build()
+		mockey.Mock((*service.RedisService).GetCatalogCache).To(
+			func(_ *service.RedisService, _ context.Context, key string) (*service.CachedResponse, error) {
+				if hasStale && strings.HasPrefix(key, constants.RedisCatalogStaleKey) {
+					return stale, nil
+				}
+				return nil, nil
+			}).Build()
+		mockey.Mock((*service.RedisService).SetCatalogCache).To(
+			func(_ *service.RedisService, _ context.Context, key string, _ *service.CachedResponse, _ time.Duration) error {
+				saved = append(saved, key)
+				return nil
+			}).Build()
+
+		// run 依次执行 CatalogCache 和模拟的下游接口
+		run := func(handler app.HandlerFunc) *app.RequestContext {
+			c := app.NewContext(0)
+			c.Request.SetRequestURI("/api/v1/commodity/spu/search?keyword=phone")
+			c.SetHandlers(app.HandlersChain{CatalogCache(), handler})
+			c.Next(context.Background())
+			return c
+		}
+		breakerOpen := func(ctx context.Context, c *app.RequestContext) {
+			pack.RespError(c, kerrors.ErrCircuitBreak)
+		}
+
+		Convey("stale response is served when the breaker is open", func() {
+			c := run(breakerOpen)
+			So(c.Response.StatusCode(), ShouldEqual, consts.StatusOK)
+			So(string(c.Response.Body()), ShouldEqual, string(stale.Body))
+			So(string(c.Response.Header.Peek("Warning")), ShouldEqual, constants.CatalogStaleWarning)
+			So(saved, ShouldBeEmpty)
+		})
+		Convey("original error is kept without stale response", func() {
+			hasStale = false
+			c := run(breakerOpen)
+			So(string(c.Response.Body()), ShouldContainSubstring, kerrors.ErrCircuitBreak.Error())
+			So(string(c.Response.Header.Peek("Warning")), ShouldBeEmpty)
+		})
+		Convey("successful response refreshes the cache and the stale response", func() {
+			c := run(func(ctx context.Context, c *app.RequestContext) {
+				pack.RespData(c, map[string]int{"total": 2})
+			})
+			So(c.Response.StatusCode(), ShouldEqual, consts.StatusOK)
+			So(string(c.Response.Header.Peek("Warning")), ShouldBeEmpty)
+			So(string(c.Response.Body()), ShouldEqual, `{"code":"10000","message":"Success","data":{"total":2}}`)
+			So(saved, ShouldHaveLength, 2)
+		})
+	})
+}
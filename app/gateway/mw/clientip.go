@@ -0,0 +1,41 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mw
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// ClientIPFunc 返回网关获取客户端 IP 的方法, 只有对端地址属于 trustedProxies 时才读取 X-Forwarded-For 和 X-Real-IP,
+// 否则使用连接的对端地址. hertz 默认信任所有来源的这两个请求头, 客户端可以伪造它们绕过按 IP 的登录锁定和限流
+func ClientIPFunc(trustedProxies []string) (app.ClientIP, error) {
+	cidrs := make([]*net.IPNet, 0, len(trustedProxies))
+	for _, proxy := range trustedProxies {
+		_, cidr, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("mw.ClientIPFunc: invalid trusted proxy %q: %w", proxy, err)
+		}
+		cidrs = append(cidrs, cidr)
+	}
+	return app.ClientIPWithOption(app.ClientIPOptions{
+		RemoteIPHeaders: []string{"X-Forwarded-For", "X-Real-IP"},
+		TrustedCIDRs:    cidrs,
+	}), nil
+}
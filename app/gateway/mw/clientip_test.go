@@ -0,0 +1,70 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mw
+
+import (
+	"net"
+	"testing"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/network"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+type remoteAddrConn struct {
+	network.Conn
+	addr net.Addr
+}
+
+func (c *remoteAddrConn) RemoteAddr() net.Addr {
+	return c.addr
+}
+
+func newClientIPContext(remote string, xff string) *app.RequestContext {
+	c := app.NewContext(0)
+	c.SetConn(&remoteAddrConn{addr: &net.TCPAddr{IP: net.ParseIP(remote), Port: 12345}})
+	if xff != "" {
+		c.Request.Header.Set("X-Forwarded-For", xff)
+	}
+	return c
+}
+
+func TestClientIPFunc(t *testing.T) {
+	Convey("TestClientIPFunc", t, func() {
+		Convey("spoofed header is ignored without trusted proxies", func() {
+			clientIP, err := ClientIPFunc(nil)
+			So(err, ShouldBeNil)
+			So(clientIP(newClientIPContext("203.0.113.7", "198.51.100.1")), ShouldEqual, "203.0.113.7")
+		})
+		Convey("spoofed header from an untrusted peer is ignored", func() {
+			clientIP, err := ClientIPFunc([]string{"10.0.0.0/8"})
+			So(err, ShouldBeNil)
+			So(clientIP(newClientIPContext("203.0.113.7", "198.51.100.1")), ShouldEqual, "203.0.113.7")
+		})
+		Convey("header from a trusted proxy is used", func() {
+			clientIP, err := ClientIPFunc([]string{"10.0.0.0/8"})
+			So(err, ShouldBeNil)
+			So(clientIP(newClientIPContext("10.0.0.2", "203.0.113.7")), ShouldEqual, "203.0.113.7")
+			// 客户端自己填入的地址排在代理追加的地址前面, 只取最右侧不受信任的地址
+			So(clientIP(newClientIPContext("10.0.0.2", "198.51.100.1, 203.0.113.7")), ShouldEqual, "203.0.113.7")
+		})
+		Convey("invalid cidr is rejected", func() {
+			_, err := ClientIPFunc([]string{"10.0.0.1"})
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
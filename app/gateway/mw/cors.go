@@ -30,6 +30,6 @@ func CorsMW() app.HandlerFunc {
 		AllowMethods:     []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
 		AllowHeaders:     []string{"*"},
 		MaxAge:           constants.CorsMaxAge,
-		ExposeHeaders:    []string{"Content-Length"},
+		ExposeHeaders:    []string{"Content-Length", "Retry-After", "ETag", constants.IdempotencyReplayedHeader},
 	})
 }
@@ -19,8 +19,11 @@ package mw
 import (
 	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/hertz-contrib/gzip"
+
+	"github.com/west2-online/DomTok/pkg/constants"
 )
 
 func GzipMW() app.HandlerFunc {
-	return gzip.Gzip(gzip.BestSpeed)
+	// websocket 的握手响应不能被压缩
+	return gzip.Gzip(gzip.BestSpeed, gzip.WithExcludedPaths([]string{constants.OrderPushPath}))
 }
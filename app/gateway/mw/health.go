@@ -0,0 +1,36 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mw
+
+import (
+	"context"
+
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/west2-online/DomTok/app/gateway/service"
+)
+
+// Readiness 在检查依赖之前初始化 GateWayService
+// GateWayService 在第一个业务请求时才会创建, redis 的检查项也是在那时注册的
+func Readiness() app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		once.Do(func() {
+			GateWayService = service.NewGateWayService()
+		})
+		c.Next(ctx)
+	}
+}
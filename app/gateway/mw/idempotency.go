@@ -0,0 +1,151 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mw
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+
+	"github.com/bytedance/sonic"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+
+	"github.com/west2-online/DomTok/app/gateway/pack"
+	"github.com/west2-online/DomTok/app/gateway/service"
+	"github.com/west2-online/DomTok/pkg/constants"
+	"github.com/west2-online/DomTok/pkg/errno"
+	"github.com/west2-online/DomTok/pkg/logger"
+)
+
+// IdempotencyMW 对携带 Idempotency-Key 的 POST/PUT/DELETE 请求做幂等处理
+// 同一个客户端使用相同的幂等键重试时, 处理中的请求返回冲突, 已完成的请求直接重放保存的响应
+func IdempotencyMW() app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		idempotencyKey := string(c.GetHeader(constants.IdempotencyKeyHeader))
+		if idempotencyKey == "" || !isStateChangingMethod(c.Method()) {
+			c.Next(ctx)
+			return
+		}
+		if len(idempotencyKey) > constants.IdempotencyKeyMaxLength {
+			pack.RespError(c, errno.NewErrNo(errno.ParamInvalidHeaderCode, "Idempotency-Key is too long"))
+			c.Abort()
+			return
+		}
+		once.Do(func() {
+			GateWayService = service.NewGateWayService()
+		})
+
+		// 幂等键只在同一个客户端内有效, 登录用户按 uid 区分, 否则按 IP 区分
+		dimension, id := clientDimensionIP, c.ClientIP()
+		if uid, ok := tokenUserID(c); ok {
+			dimension, id = clientDimensionUser, strconv.FormatInt(uid, 10)
+		}
+		key := GateWayService.Re.GetIdempotencyKey(dimension, id, idempotencyKey)
+		fingerprint := requestFingerprint(c)
+
+		acquired, err := GateWayService.Re.AcquireIdempotencyKey(ctx, key,
+			&service.IdempotencyRecord{Fingerprint: fingerprint}, constants.IdempotencyProcessingTTL)
+		if err != nil {
+			// redis 不可用时放行, 与限流保持一致
+			logger.Errorf("gateway: idempotency failed: %v", err)
+			c.Next(ctx)
+			return
+		}
+		if !acquired {
+			replayIdempotentResponse(ctx, c, key, fingerprint)
+			c.Abort()
+			return
+		}
+
+		c.Next(ctx)
+
+		// 请求没有确定的结果时删除标记, 让客户端可以重试
+		if !isReplayableResponse(c.Response.StatusCode(), c.Response.Body()) {
+			logger.LogError(GateWayService.Re.ReleaseIdempotencyKey(ctx, key))
+			return
+		}
+		record := &service.IdempotencyRecord{
+			Fingerprint: fingerprint,
+			Completed:   true,
+			StatusCode:  c.Response.StatusCode(),
+			ContentType: string(c.Response.Header.ContentType()),
+			Body:        bytes.Clone(c.Response.Body()),
+		}
+		logger.LogError(GateWayService.Re.SaveIdempotencyRecord(ctx, key, record, constants.IdempotencyResponseTTL))
+	}
+}
+
+func replayIdempotentResponse(ctx context.Context, c *app.RequestContext, key, fingerprint string) {
+	record, err := GateWayService.Re.GetIdempotencyRecord(ctx, key)
+	if err != nil {
+		logger.Errorf("gateway: idempotency failed: %v", err)
+		pack.RespError(c, errno.InternalServiceError.WithError(err))
+		return
+	}
+	switch {
+	case record != nil && record.Fingerprint != fingerprint:
+		pack.RespErrorWithStatus(c, consts.StatusUnprocessableEntity, errno.IdempotencyKeyMismatch)
+	case record == nil || !record.Completed:
+		// 标记在 SETNX 之后刚好过期时也按处理中返回, 客户端稍后重试即可
+		pack.RespErrorWithStatus(c, consts.StatusConflict, errno.IdempotencyConflict)
+	default:
+		c.Header(constants.IdempotencyReplayedHeader, "true")
+		c.Data(record.StatusCode, record.ContentType, record.Body)
+	}
+}
+
+// isReplayableResponse 判断响应是否可以保存下来重放
+// pack.RespError 总是返回 200, 需要根据响应体中的业务 code 判断: 只保存成功和参数错误这类重试也不会改变结果的响应,
+// 超时, 内部错误和下游服务的拒绝都需要允许客户端使用同一个幂等键重试
+func isReplayableResponse(statusCode int, body []byte) bool {
+	if statusCode >= consts.StatusInternalServerError {
+		return false
+	}
+	var base pack.Base
+	if err := sonic.Unmarshal(body, &base); err != nil || base.Code == "" {
+		// 不是 pack 生成的响应, 比如附件, 只能按 http 状态码判断
+		return true
+	}
+	code, err := strconv.ParseInt(base.Code, 10, 64)
+	if err != nil {
+		return false
+	}
+	return code == errno.SuccessCode || (code >= errno.ParamVerifyErrorCode && code < errno.AuthInvalidCode)
+}
+
+// requestFingerprint 使用请求方法, 路径和请求体区分使用相同幂等键的不同请求
+func requestFingerprint(c *app.RequestContext) string {
+	h := sha256.New()
+	h.Write(c.Method())
+	h.Write([]byte{'\n'})
+	h.Write(c.Request.URI().RequestURI())
+	h.Write([]byte{'\n'})
+	h.Write(c.Request.Body())
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func isStateChangingMethod(method []byte) bool {
+	switch string(method) {
+	case consts.MethodPost, consts.MethodPut, consts.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mw
+
+import (
+	"testing"
+
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestIsReplayableResponse(t *testing.T) {
+	Convey("TestIsReplayableResponse", t, func() {
+		Convey("success is replayed", func() {
+			So(isReplayableResponse(consts.StatusOK, []byte(`{"code":"10000","message":"ok","data":{"order_id":1}}`)), ShouldBeTrue)
+		})
+		Convey("param error is replayed", func() {
+			So(isReplayableResponse(consts.StatusOK, []byte(`{"code":"20000","message":"invalid"}`)), ShouldBeTrue)
+		})
+		Convey("error responses written with http 200 are released", func() {
+			// RPC 超时和内部错误
+			So(isReplayableResponse(consts.StatusOK, []byte(`{"code":"50000","message":"rpc timeout"}`)), ShouldBeFalse)
+			// 下游服务拒绝, 比如库存不足
+			So(isReplayableResponse(consts.StatusOK, []byte(`{"code":"50008","message":"insufficient stock"}`)), ShouldBeFalse)
+			So(isReplayableResponse(consts.StatusOK, []byte(`{"code":"30000","message":"auth failed"}`)), ShouldBeFalse)
+		})
+		Convey("5xx is released", func() {
+			So(isReplayableResponse(consts.StatusInternalServerError, []byte(`{"code":"10000","message":"ok"}`)), ShouldBeFalse)
+		})
+		Convey("non-json response falls back to status code", func() {
+			So(isReplayableResponse(consts.StatusOK, []byte("a,b,c")), ShouldBeTrue)
+		})
+	})
+}
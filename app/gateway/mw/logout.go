@@ -25,8 +25,8 @@ import (
 
 	"github.com/west2-online/DomTok/app/gateway/pack"
 	"github.com/west2-online/DomTok/app/gateway/service"
-	"github.com/west2-online/DomTok/pkg/constants"
-	"github.com/west2-online/DomTok/pkg/utils"
+	metainfoContext "github.com/west2-online/DomTok/pkg/base/context"
+	"github.com/west2-online/DomTok/pkg/errno"
 )
 
 var (
@@ -39,20 +39,22 @@ func UserLoginStatus() app.HandlerFunc {
 		once.Do(func() {
 			GateWayService = service.NewGateWayService()
 		})
-		token := string(c.GetHeader(constants.AuthHeader))
-		_, uid, err := utils.CheckToken(token)
+		// 登录信息由前置的 Auth 或 ApiKeyAuth 注入
+		uid, err := metainfoContext.GetLoginData(ctx)
 		if err != nil {
-			pack.RespError(c, err)
+			pack.RespError(c, errno.AuthNoToken)
 			c.Abort()
 			return
 		}
+		// 使用 API Key 访问时没有会话, 只需要检查是否被封禁
+		sessionID, _ := metainfoContext.GetSessionData(ctx)
 
-		// 判断是否是白名单
-		if !GateWayService.Bf.Test(uid) {
-			// 是的话检查是否退出登录
-			if GateWayService.Re.IsUserLogout(ctx, uid) {
+		// 不在封禁名单中的用户只需要检查是否退出登录
+		if !GateWayService.Ban.Test(uid) {
+			if sessionID != "" && GateWayService.Re.IsUserLogout(ctx, sessionID) {
 				pack.RespError(c, errors.New("please login again"))
 				c.Abort()
+				return
 			}
 			c.Next(ctx)
 			return
@@ -66,7 +68,7 @@ func UserLoginStatus() app.HandlerFunc {
 		}
 
 		// 如果不是的话就尝试继续判断是否登录
-		if GateWayService.Re.IsUserLogout(ctx, uid) {
+		if sessionID != "" && GateWayService.Re.IsUserLogout(ctx, sessionID) {
 			pack.RespError(c, errors.New("please login again"))
 			c.Abort()
 			return
@@ -0,0 +1,104 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mw
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/cloudwego/hertz/pkg/app"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/west2-online/DomTok/app/gateway/service"
+	metainfoContext "github.com/west2-online/DomTok/pkg/base/context"
+)
+
+// useTestGateWayService 替换 UserLoginStatus 使用的 GateWayService, 避免连接 redis 和订阅封禁事件
+func useTestGateWayService() *service.GateWayService {
+	once.Do(func() {})
+	GateWayService = &service.GateWayService{
+		Re:  &service.RedisService{},
+		Ban: service.NewBanSet(),
+	}
+	return GateWayService
+}
+
+// runUserLoginStatus 使用给定的会话执行 UserLoginStatus, 返回请求是否被放行
+func runUserLoginStatus(uid int64, sessionID string) bool {
+	ctx := metainfoContext.WithLoginData(context.Background(), uid)
+	if sessionID != "" {
+		ctx = metainfoContext.WithSessionData(ctx, sessionID)
+	}
+	c := app.NewContext(0)
+	UserLoginStatus()(ctx, c)
+	return !c.IsAborted()
+}
+
+func TestUserLoginStatus_Session(t *testing.T) {
+	mockey.PatchConvey("TestUserLoginStatus_Session", t, func() {
+		useTestGateWayService()
+		revoked := map[string]bool{"revoked": true}
+		mockey.Mock((*service.RedisService).IsUserLogout).To(func(_ *service.RedisService, _ context.Context, sessionID string) bool {
+			return revoked[sessionID]
+		}).Build()
+
+		Convey("active session is accepted", func() {
+			So(runUserLoginStatus(100, "active"), ShouldBeTrue)
+		})
+		Convey("revoked session is rejected", func() {
+			So(runUserLoginStatus(100, "revoked"), ShouldBeFalse)
+		})
+		Convey("api key without session skips the session check", func() {
+			So(runUserLoginStatus(100, ""), ShouldBeTrue)
+		})
+	})
+}
+
+func TestUserLoginStatus_Ban(t *testing.T) {
+	mockey.PatchConvey("TestUserLoginStatus_Ban", t, func() {
+		svc := useTestGateWayService()
+		banned := map[int64]bool{1: true}
+		confirmed := make([]int64, 0)
+		mockey.Mock((*service.RedisService).IsUserLogout).Return(false).Build()
+		mockey.Mock((*service.RedisService).IsUserBanned).To(func(_ *service.RedisService, _ context.Context, uid int64) bool {
+			confirmed = append(confirmed, uid)
+			return banned[uid]
+		}).Build()
+
+		Convey("user not in the ban set skips the redis check", func() {
+			So(runUserLoginStatus(2, "active"), ShouldBeTrue)
+			So(confirmed, ShouldBeEmpty)
+		})
+		Convey("banned user is confirmed in redis and rejected", func() {
+			svc.Ban.Add(1)
+			So(runUserLoginStatus(1, "active"), ShouldBeFalse)
+			So(confirmed, ShouldResemble, []int64{1})
+		})
+		Convey("stale entry in the ban set is let through after redis confirmation", func() {
+			svc.Ban.Add(2)
+			So(runUserLoginStatus(2, "active"), ShouldBeTrue)
+			So(confirmed, ShouldResemble, []int64{2})
+		})
+		Convey("lifted user is removed from the ban set", func() {
+			svc.Ban.Add(1)
+			svc.Ban.Remove(1)
+			So(runUserLoginStatus(1, "active"), ShouldBeTrue)
+			So(confirmed, ShouldBeEmpty)
+		})
+	})
+}
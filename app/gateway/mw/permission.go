@@ -0,0 +1,45 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mw
+
+import (
+	"context"
+
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/west2-online/DomTok/app/gateway/pack"
+	"github.com/west2-online/DomTok/pkg/rbac"
+)
+
+// Permission 根据 rbac.GatewayRoutePermissions 校验当前用户是否有权限访问该路由, 需要注册在 Auth 之后
+// 在网关提前拦截可以省去一次无意义的 RPC, 各个服务内部仍然会通过 middleware.Permission 再校验一次
+func Permission() app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		permission, ok := rbac.GatewayRoutePermissions[string(c.Method())+" "+c.FullPath()]
+		if !ok {
+			c.Next(ctx)
+			return
+		}
+
+		if err := rbac.CheckPermission(ctx, permission); err != nil {
+			pack.RespError(c, err)
+			c.Abort()
+			return
+		}
+		c.Next(ctx)
+	}
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mw
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"sync/atomic"
+
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/west2-online/DomTok/app/gateway/pack"
+	"github.com/west2-online/DomTok/app/gateway/service"
+	"github.com/west2-online/DomTok/config"
+	"github.com/west2-online/DomTok/pkg/constants"
+	"github.com/west2-online/DomTok/pkg/logger"
+	"github.com/west2-online/DomTok/pkg/utils"
+)
+
+const (
+	clientDimensionIP     = "ip"
+	clientDimensionUser   = "user"
+	rateLimitDefaultScope = "default"
+)
+
+// bucketRule 是一个令牌桶规则, scope 决定计数的范围, 默认规则的所有路由共用一个令牌桶
+type bucketRule struct {
+	scope string
+	rate  float64
+	burst int
+}
+
+func (r bucketRule) enabled() bool {
+	return r.rate > 0
+}
+
+type routeRule struct {
+	qps  float64
+	ip   bucketRule
+	user bucketRule
+}
+
+// rateLimitRules 是由配置解析出的限流规则, 配置热更新时整体替换
+type rateLimitRules struct {
+	qpsThreshold float64
+	ip           bucketRule
+	user         bucketRule
+	routes       map[string]routeRule
+}
+
+var currentRateLimitRules atomic.Pointer[rateLimitRules]
+
+func init() {
+	config.OnReload(func() {
+		currentRateLimitRules.Store(buildRateLimitRules())
+		loadSentinelRules()
+	})
+}
+
+// RateLimitMW 使用 redis 令牌桶分别限制每个客户端 IP 和每个登录用户的请求频率, 限流在所有网关实例间共享
+func RateLimitMW() app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		once.Do(func() {
+			GateWayService = service.NewGateWayService()
+		})
+		rules := getRateLimitRules()
+		ipRule, userRule := rules.match(routeResource(c))
+
+		// 客户端 IP 由 main 中设置的 ClientIPFunc 解析, 只有来自 server.trusted-proxies 的请求才读取 X-Forwarded-For,
+		// 客户端伪造请求头无法绕过限流. 无法解析对端地址时不按 IP 限流, 避免这些请求共用同一个令牌桶
+		if ip := c.ClientIP(); ipRule.enabled() && ip != "" && !takeToken(ctx, c, clientDimensionIP, ipRule, ip) {
+			return
+		}
+		// 限流发生在鉴权之前, 这里只解析 token 获取 uid, 无效的 token 交给后续的 Auth 处理
+		if userRule.enabled() {
+			if uid, ok := tokenUserID(c); ok && !takeToken(ctx, c, clientDimensionUser, userRule, strconv.FormatInt(uid, 10)) {
+				return
+			}
+		}
+		c.Next(ctx)
+	}
+}
+
+// takeToken 被限流时直接响应 429, redis 不可用时放行, 避免限流组件拖垮整个网关
+func takeToken(ctx context.Context, c *app.RequestContext, dimension string, rule bucketRule, id string) bool {
+	key := GateWayService.Re.GetRateLimitKey(dimension, rule.scope, id)
+	allowed, retryAfter, err := GateWayService.Re.TakeToken(ctx, key, rule.rate, rule.burst)
+	if err != nil {
+		logger.Errorf("gateway: rate limit failed: %v", err)
+		return true
+	}
+	if !allowed {
+		pack.RespTooManyRequests(c, retryAfter)
+		c.Abort()
+		return false
+	}
+	return true
+}
+
+// tokenUserID 在鉴权之前从 access token 中解析 uid, token 无效时返回 false
+func tokenUserID(c *app.RequestContext) (int64, bool) {
+	claims, err := utils.ParseAccessToken(string(c.GetHeader(constants.AuthHeader)))
+	if err != nil {
+		return 0, false
+	}
+	return claims.UserID, true
+}
+
+func getRateLimitRules() *rateLimitRules {
+	if rules := currentRateLimitRules.Load(); rules != nil {
+		return rules
+	}
+	rules := buildRateLimitRules()
+	currentRateLimitRules.Store(rules)
+	return rules
+}
+
+func buildRateLimitRules() *rateLimitRules {
+	rules := &rateLimitRules{
+		qpsThreshold: constants.SentinelThreshold,
+		routes:       make(map[string]routeRule),
+	}
+	cfg := config.GetRateLimit()
+	if cfg == nil {
+		return rules
+	}
+	if cfg.QPS > 0 {
+		rules.qpsThreshold = cfg.QPS
+	}
+	rules.ip = newBucketRule(rateLimitDefaultScope, cfg.IP.Rate, cfg.IP.Burst)
+	rules.user = newBucketRule(rateLimitDefaultScope, cfg.User.Rate, cfg.User.Burst)
+	for _, r := range cfg.Routes {
+		rules.routes[r.Route] = routeRule{
+			qps:  r.QPS,
+			ip:   newBucketRule(r.Route, r.IP.Rate, r.IP.Burst),
+			user: newBucketRule(r.Route, r.User.Rate, r.User.Burst),
+		}
+	}
+	return rules
+}
+
+// newBucketRule burst 未配置时至少允许一秒内的请求量
+func newBucketRule(scope string, rate float64, burst int) bucketRule {
+	if burst <= 0 {
+		burst = int(math.Max(1, math.Ceil(rate)))
+	}
+	return bucketRule{scope: scope, rate: rate, burst: burst}
+}
+
+// match 返回路由适用的令牌桶规则, 路由没有单独配置的令牌桶沿用默认规则
+func (r *rateLimitRules) match(resource string) (bucketRule, bucketRule) {
+	ip, user := r.ip, r.user
+	if route, ok := r.routes[resource]; ok {
+		if route.ip.enabled() {
+			ip = route.ip
+		}
+		if route.user.enabled() {
+			user = route.user
+		}
+	}
+	return ip, user
+}
+
+// qps 返回路由在单个网关实例上的 QPS 阈值
+func (r *rateLimitRules) qps(resource string) float64 {
+	if route, ok := r.routes[resource]; ok && route.qps > 0 {
+		return route.qps
+	}
+	return r.qpsThreshold
+}
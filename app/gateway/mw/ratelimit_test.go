@@ -0,0 +1,215 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mw
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bytedance/mockey"
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/spf13/viper"
+
+	"github.com/west2-online/DomTok/app/gateway/service"
+	"github.com/west2-online/DomTok/config"
+	"github.com/west2-online/DomTok/pkg/constants"
+)
+
+// mockRateLimitConfig 按 yaml 构造 config.GetRateLimit 的返回值, 配置类型没有导出, 只能通过返回值的类型构造
+// content 为空时模拟配置还没有加载
+func mockRateLimitConfig(content string) {
+	typ := reflect.TypeOf(config.GetRateLimit)
+	cfg := reflect.New(typ.Out(0).Elem())
+	if content == "" {
+		cfg = reflect.Zero(typ.Out(0))
+	} else {
+		v := viper.New()
+		v.SetConfigType("yaml")
+		So(v.ReadConfig(strings.NewReader(content)), ShouldBeNil)
+		So(v.Unmarshal(cfg.Interface()), ShouldBeNil)
+	}
+	mockey.Mock(config.GetRateLimit).Return(cfg.Interface()).Build()
+}
+
+const testRateLimitConfig = `
+qps: 200
+ip:
+  rate: 10
+user:
+  rate: 5
+  burst: 20
+routes:
+  - route: POST /api/v1/order/create
+    qps: 50
+    ip:
+      rate: 1
+      burst: 3
+  - route: GET /api/v1/commodity/spu/search
+    user:
+      rate: 0.5
+`
+
+func TestNewBucketRule(t *testing.T) {
+	Convey("TestNewBucketRule", t, func() {
+		testCases := []struct {
+			name          string
+			rate          float64
+			burst         int
+			expectedBurst int
+		}{
+			{"configured burst is kept", 10, 30, 30},
+			{"missing burst allows one second of requests", 10, 0, 10},
+			{"fractional rate rounds burst up", 2.5, 0, 3},
+			{"slow rate still allows one request", 0.5, 0, 1},
+		}
+		for _, tc := range testCases {
+			Convey(tc.name, func() {
+				rule := newBucketRule("scope", tc.rate, tc.burst)
+				So(rule, ShouldResemble, bucketRule{scope: "scope", rate: tc.rate, burst: tc.expectedBurst})
+			})
+		}
+		So(newBucketRule("scope", 0, 0).enabled(), ShouldBeFalse)
+	})
+}
+
+func TestBuildRateLimitRules(t *testing.T) {
+	mockey.PatchConvey("TestBuildRateLimitRules", t, func() {
+		Convey("config not loaded", func() {
+			mockRateLimitConfig("")
+			rules := buildRateLimitRules()
+			So(rules.qpsThreshold, ShouldEqual, constants.SentinelThreshold)
+			So(rules.ip.enabled(), ShouldBeFalse)
+			So(rules.user.enabled(), ShouldBeFalse)
+			So(rules.routes, ShouldBeEmpty)
+		})
+		Convey("rules from config", func() {
+			mockRateLimitConfig(testRateLimitConfig)
+			rules := buildRateLimitRules()
+			So(rules.qpsThreshold, ShouldEqual, 200)
+			So(rules.ip, ShouldResemble, bucketRule{scope: rateLimitDefaultScope, rate: 10, burst: 10})
+			So(rules.user, ShouldResemble, bucketRule{scope: rateLimitDefaultScope, rate: 5, burst: 20})
+			So(rules.routes, ShouldHaveLength, 2)
+			So(rules.routes["POST /api/v1/order/create"].ip,
+				ShouldResemble, bucketRule{scope: "POST /api/v1/order/create", rate: 1, burst: 3})
+		})
+	})
+}
+
+func TestRateLimitRules_Match(t *testing.T) {
+	mockey.PatchConvey("TestRateLimitRules_Match", t, func() {
+		mockRateLimitConfig(testRateLimitConfig)
+		rules := buildRateLimitRules()
+
+		testCases := []struct {
+			name         string
+			resource     string
+			expectedIP   bucketRule
+			expectedUser bucketRule
+			expectedQPS  float64
+		}{
+			{
+				name:         "route without override uses the default rules",
+				resource:     "GET /api/v1/order/list",
+				expectedIP:   bucketRule{scope: rateLimitDefaultScope, rate: 10, burst: 10},
+				expectedUser: bucketRule{scope: rateLimitDefaultScope, rate: 5, burst: 20},
+				expectedQPS:  200,
+			},
+			{
+				name:         "route overrides the ip bucket and qps",
+				resource:     "POST /api/v1/order/create",
+				expectedIP:   bucketRule{scope: "POST /api/v1/order/create", rate: 1, burst: 3},
+				expectedUser: bucketRule{scope: rateLimitDefaultScope, rate: 5, burst: 20},
+				expectedQPS:  50,
+			},
+			{
+				name:         "route overrides the user bucket only",
+				resource:     "GET /api/v1/commodity/spu/search",
+				expectedIP:   bucketRule{scope: rateLimitDefaultScope, rate: 10, burst: 10},
+				expectedUser: bucketRule{scope: "GET /api/v1/commodity/spu/search", rate: 0.5, burst: 1},
+				expectedQPS:  200,
+			},
+			{
+				name:         "unmatched route falls back to the default resource",
+				resource:     constants.SentinelDefaultResource,
+				expectedIP:   bucketRule{scope: rateLimitDefaultScope, rate: 10, burst: 10},
+				expectedUser: bucketRule{scope: rateLimitDefaultScope, rate: 5, burst: 20},
+				expectedQPS:  200,
+			},
+		}
+		for _, tc := range testCases {
+			Convey(tc.name, func() {
+				ip, user := rules.match(tc.resource)
+				So(ip, ShouldResemble, tc.expectedIP)
+				So(user, ShouldResemble, tc.expectedUser)
+				So(rules.qps(tc.resource), ShouldEqual, tc.expectedQPS)
+			})
+		}
+	})
+}
+
+func TestRateLimitMW_IPKey(t *testing.T) {
+	mockey.PatchConvey("TestRateLimitMW_IPKey", t, func() {
+		useTestGateWayService()
+		previous := currentRateLimitRules.Load()
+		currentRateLimitRules.Store(&rateLimitRules{
+			ip:     newBucketRule(rateLimitDefaultScope, 1, 1),
+			routes: make(map[string]routeRule),
+		})
+		defer currentRateLimitRules.Store(previous)
+
+		var keys []string
+		allowed := true
+		mockey.Mock((*service.RedisService).TakeToken).To(
+			func(_ *service.RedisService, _ context.Context, key string, _ float64, _ int) (bool, time.Duration, error) {
+				keys = append(keys, key)
+				return allowed, time.Second, nil
+			}).Build()
+
+		clientIP, err := ClientIPFunc([]string{"10.0.0.0/8"})
+		So(err, ShouldBeNil)
+		run := func(remote, xff string) bool {
+			c := newClientIPContext(remote, xff)
+			c.SetClientIPFunc(clientIP)
+			RateLimitMW()(context.Background(), c)
+			return !c.IsAborted()
+		}
+		ipKey := func(ip string) string {
+			return GateWayService.Re.GetRateLimitKey(clientDimensionIP, rateLimitDefaultScope, ip)
+		}
+
+		Convey("spoofed header from an untrusted peer is keyed on the peer", func() {
+			So(run("203.0.113.7", "198.51.100.1"), ShouldBeTrue)
+			So(keys, ShouldResemble, []string{ipKey("203.0.113.7")})
+		})
+		Convey("request through a trusted proxy is keyed on the client", func() {
+			So(run("10.0.0.2", "198.51.100.1, 203.0.113.7"), ShouldBeTrue)
+			So(keys, ShouldResemble, []string{ipKey("203.0.113.7")})
+		})
+		Convey("limited request is rejected with 429", func() {
+			allowed = false
+			c := newClientIPContext("203.0.113.7", "")
+			c.SetClientIPFunc(clientIP)
+			RateLimitMW()(context.Background(), c)
+			So(c.IsAborted(), ShouldBeTrue)
+			So(c.Response.StatusCode(), ShouldEqual, http.StatusTooManyRequests)
+		})
+	})
+}
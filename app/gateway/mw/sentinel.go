@@ -18,52 +18,84 @@ package mw
 
 import (
 	"context"
+	"sync"
+	"time"
 
 	sentinel "github.com/alibaba/sentinel-golang/api"
 	"github.com/alibaba/sentinel-golang/core/flow"
 	"github.com/cloudwego/hertz/pkg/app"
-	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"github.com/cloudwego/hertz/pkg/route"
 	"github.com/hertz-contrib/opensergo/sentinel/adapter"
 
+	"github.com/west2-online/DomTok/app/gateway/pack"
 	"github.com/west2-online/DomTok/pkg/constants"
-	"github.com/west2-online/DomTok/pkg/errno"
 	"github.com/west2-online/DomTok/pkg/logger"
 )
 
+var (
+	sentinelRoutes []string
+	sentinelMu     sync.Mutex
+)
+
+// SentinelMW 以路由为粒度限流, 避免开销较小的查询和下单等接口共用同一份额度
 func SentinelMW() app.HandlerFunc {
 	initSentinel()
 	return adapter.SentinelServerMiddleware(
 		adapter.WithServerResourceExtractor(func(c context.Context, ctx *app.RequestContext) string {
-			return "api"
+			return routeResource(ctx)
 		}),
 		adapter.WithServerBlockFallback(func(ctx context.Context, c *app.RequestContext) {
-			logger.Errorf("frequent requests have been rejected by the gateway. clientIP: %v\n", c.ClientIP())
-			c.AbortWithStatusJSON(consts.StatusOK, map[string]interface{}{
-				"code":    errno.InternalServiceErrorCode,
-				"message": "服务器当前处于请求高峰，请稍后再试",
-			})
+			logger.Errorf("frequent requests have been rejected by the gateway. route: %v, clientIP: %v\n", routeResource(c), c.ClientIP())
+			pack.RespTooManyRequests(c, constants.SentinelStatIntervalInMs*time.Millisecond)
+			c.Abort()
 		}),
 	)
 }
 
+// RegisterSentinelRoutes 需要在注册完所有路由后调用, 为每个路由加载单独的流控规则
+func RegisterSentinelRoutes(routes route.RoutesInfo) {
+	sentinelMu.Lock()
+	sentinelRoutes = sentinelRoutes[:0]
+	for _, r := range routes {
+		sentinelRoutes = append(sentinelRoutes, r.Method+" "+r.Path)
+	}
+	sentinelMu.Unlock()
+	loadSentinelRules()
+}
+
+// routeResource 使用请求方法和 hertz 完整路由作为资源名, 没有匹配到路由的请求共用默认资源
+func routeResource(c *app.RequestContext) string {
+	if c.FullPath() == "" {
+		return constants.SentinelDefaultResource
+	}
+	return string(c.Method()) + " " + c.FullPath()
+}
+
 func initSentinel() {
 	err := sentinel.InitDefault()
 	if err != nil {
 		logger.Fatalf("Unexpected error: %+v", err)
 	}
+	loadSentinelRules()
+}
+
+// loadSentinelRules 根据配置重新加载所有路由的流控规则, 配置热更新时也会调用
+func loadSentinelRules() {
+	sentinelMu.Lock()
+	defer sentinelMu.Unlock()
 
-	// limit QPS to 100
-	_, err = flow.LoadRules([]*flow.Rule{
-		{
-			Resource:               "api",
-			Threshold:              constants.SentinelThreshold,
+	rules := getRateLimitRules()
+	flowRules := make([]*flow.Rule, 0, len(sentinelRoutes)+1)
+	for _, resource := range append([]string{constants.SentinelDefaultResource}, sentinelRoutes...) {
+		flowRules = append(flowRules, &flow.Rule{
+			Resource:               resource,
+			Threshold:              rules.qps(resource),
 			TokenCalculateStrategy: flow.Direct,
 			ControlBehavior:        flow.Reject,
 			StatIntervalInMs:       constants.SentinelStatIntervalInMs,
-		},
-	})
-	if err != nil {
-		logger.Fatalf("Unexpected error: %+v", err)
-		return
+		})
+	}
+	if _, err := flow.LoadRules(flowRules); err != nil {
+		logger.Errorf("gateway: load sentinel rules failed: %v", err)
 	}
 }
@@ -17,7 +17,10 @@ limitations under the License.
 package pack
 
 import (
+	"fmt"
+	"math"
 	"strconv"
+	"time"
 
 	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/cloudwego/hertz/pkg/protocol/consts"
@@ -69,3 +72,28 @@ func RespList(c *app.RequestContext, items any) {
 	}
 	c.JSON(consts.StatusOK, resp)
 }
+
+// RespAttachment 以附件的形式返回数据, 浏览器会直接下载而不是展示
+func RespAttachment(c *app.RequestContext, filename string, contentType string, data []byte) {
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	c.Data(consts.StatusOK, contentType, data)
+}
+
+// RespErrorWithStatus 和 RespError 相同, 但使用指定的 http 状态码, 用于网关直接拒绝的请求
+func RespErrorWithStatus(c *app.RequestContext, status int, err error) {
+	Errno := errno.ConvertErr(err)
+	c.JSON(status, Base{
+		Code: strconv.FormatInt(Errno.ErrorCode, 10),
+		Msg:  Errno.ErrorMsg,
+	})
+}
+
+// RespTooManyRequests 返回 429, 并通过 Retry-After 告知客户端至少需要等待多少秒后再重试
+func RespTooManyRequests(c *app.RequestContext, retryAfter time.Duration) {
+	seconds := int64(math.Ceil(retryAfter.Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+	c.Header("Retry-After", strconv.FormatInt(seconds, 10))
+	RespErrorWithStatus(c, consts.StatusTooManyRequests, errno.TooManyRequests)
+}
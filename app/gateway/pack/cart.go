@@ -25,6 +25,7 @@ import (
 func BuildCartGoods(goods *kmodel.CartGoods) *model.CartGoods {
 	return &model.CartGoods{
 		MerchantId:       goods.MerchantId,
+		MerchantName:     goods.MerchantName,
 		GoodsId:          goods.GoodsId,
 		GoodsName:        goods.GoodsName,
 		SkuId:            goods.SkuId,
@@ -60,6 +60,7 @@ func BuildSpu(spu *modelKitex.Spu) *model.Spu {
 		SpuID:            spu.SpuID,
 		Name:             spu.Name,
 		CreatorID:        spu.CreatorID,
+		ShopID:           spu.ShopID,
 		CreatedAt:        spu.CreatedAt,
 		UpdatedAt:        spu.UpdatedAt,
 		CategoryID:       spu.CategoryID,
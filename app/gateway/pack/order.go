@@ -0,0 +1,121 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pack
+
+import (
+	"github.com/west2-online/DomTok/app/gateway/model/model"
+	kmodel "github.com/west2-online/DomTok/kitex_gen/model"
+	"github.com/west2-online/DomTok/pkg/base"
+)
+
+// BuildOrderWithGoods 将 RPC 交流实体转换成 http 返回的实体
+func BuildOrderWithGoods(o *kmodel.OrderWithGoods) *model.OrderWithGoods {
+	if o == nil {
+		return nil
+	}
+	return &model.OrderWithGoods{
+		Order: BuildOrder(o.Order),
+		Goods: base.BuildTypeList(o.Goods, BuildOrderGoods),
+	}
+}
+
+func BuildOrder(o *kmodel.Order) *model.Order {
+	if o == nil {
+		return nil
+	}
+	return &model.Order{
+		ID:                    o.Id,
+		Status:                o.Status,
+		UID:                   o.Uid,
+		TotalAmountOfGoods:    o.TotalAmountOfGoods,
+		TotalAmountOfFreight:  o.TotalAmountOfFreight,
+		TotalAmountOfDiscount: o.TotalAmountOfDiscount,
+		PaymentAmount:         o.PaymentAmount,
+		PaymentStatus:         o.PaymentStatus,
+		PaymentAt:             o.PaymentAt,
+		PaymentStyle:          o.PaymentStyle,
+		OrderedAt:             o.OrderedAt,
+		DeletedAt:             o.DeletedAt,
+		DeliveryAt:            o.DeliveryAt,
+		AddressID:             o.AddressID,
+		AddressInfo:           o.AddressInfo,
+		CouponId:              o.CouponId,
+		CouponName:            o.CouponName,
+	}
+}
+
+func BuildOrderGoods(g *kmodel.OrderGoods) *model.OrderGoods {
+	return &model.OrderGoods{
+		MerchantId:         g.MerchantId,
+		GoodsId:            g.GoodsId,
+		GoodsName:          g.GoodsName,
+		StyleId:            g.StyleId,
+		StyleName:          g.StyleName,
+		GoodsVersion:       g.GoodsVersion,
+		StyleHeadDrawing:   g.StyleHeadDrawing,
+		OriginPrice:        g.OriginPrice,
+		SalePrice:          g.SalePrice,
+		SingleFreightPrice: g.SingleFreightPrice,
+		PurchaseQuantity:   g.PurchaseQuantity,
+		TotalAmount:        g.TotalAmount,
+		FreightAmount:      g.FreightAmount,
+		DiscountAmount:     g.DiscountAmount,
+		PaymentAmount:      g.PaymentAmount,
+		SinglePrice:        g.SinglePrice,
+		CouponId:           g.CouponId,
+		CouponName:         g.CouponName,
+		OrderId:            g.OrderId,
+		MerchantName:       g.MerchantName,
+	}
+}
+
+func BuildPaymentStatusInfo(p *kmodel.PaymentStatusInfo) *model.PaymentStatusInfo {
+	if p == nil {
+		return nil
+	}
+	return &model.PaymentStatusInfo{
+		PaymentID: p.PaymentID,
+		Status:    p.Status,
+		Amount:    p.Amount,
+	}
+}
+
+func BuildRefundStatusInfo(r *kmodel.RefundStatusInfo) *model.RefundStatusInfo {
+	if r == nil {
+		return nil
+	}
+	return &model.RefundStatusInfo{
+		RefundID:     r.RefundID,
+		Status:       r.Status,
+		RefundAmount: r.RefundAmount,
+		RefundReason: r.RefundReason,
+	}
+}
+
+func BuildSkuImage(img *kmodel.SkuImage) *model.SkuImage {
+	return &model.SkuImage{
+		ImageID:   img.ImageID,
+		SkuID:     img.SkuID,
+		URL:       img.Url,
+		CreatedAt: img.CreatedAt,
+		DeletedAt: img.DeletedAt,
+	}
+}
+
+func BuildSkuImages(imgs []*kmodel.SkuImage) []*model.SkuImage {
+	return base.BuildTypeList(imgs, BuildSkuImage)
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package pack
 
 import (
+	"github.com/samber/lo"
+
 	"github.com/west2-online/DomTok/app/gateway/model/model"
 	rpcModel "github.com/west2-online/DomTok/kitex_gen/model"
 )
@@ -26,5 +28,175 @@ func BuildUserInfo(u *rpcModel.UserInfo) *model.UserInfo {
 	return &model.UserInfo{
 		UserId: u.UserId,
 		Name:   u.Name,
+		Role:   u.Role,
+		Email:  u.Email,
+		Phone:  u.Phone,
+	}
+}
+
+// BuildAddressInfo 将 RPC 交流实体转换成 http 返回的实体
+func BuildAddressInfo(a *rpcModel.AddressInfo) *model.AddressInfo {
+	if a == nil {
+		return nil
+	}
+	return &model.AddressInfo{
+		AddressID:     a.AddressID,
+		Province:      a.Province,
+		City:          a.City,
+		Detail:        a.Detail,
+		RecipientName: a.RecipientName,
+		Phone:         a.Phone,
+		IsDefault:     a.IsDefault,
+	}
+}
+
+func BuildAddressInfoList(addresses []*rpcModel.AddressInfo) []*model.AddressInfo {
+	return lo.Map(addresses, func(item *rpcModel.AddressInfo, index int) *model.AddressInfo {
+		return BuildAddressInfo(item)
+	})
+}
+
+func BuildSessionInfo(s *rpcModel.SessionInfo) *model.SessionInfo {
+	if s == nil {
+		return nil
+	}
+	return &model.SessionInfo{
+		SessionID: s.SessionID,
+		Device:    s.Device,
+		IP:        s.Ip,
+		CreatedAt: s.CreatedAt,
+		ActiveAt:  s.ActiveAt,
+		Current:   s.Current,
 	}
 }
+
+func BuildSessionInfoList(sessions []*rpcModel.SessionInfo) []*model.SessionInfo {
+	return lo.Map(sessions, func(item *rpcModel.SessionInfo, index int) *model.SessionInfo {
+		return BuildSessionInfo(item)
+	})
+}
+
+func BuildShopInfo(s *rpcModel.ShopInfo) *model.ShopInfo {
+	if s == nil {
+		return nil
+	}
+	return &model.ShopInfo{
+		ShopID:      s.ShopID,
+		Name:        s.Name,
+		Logo:        s.Logo,
+		Description: s.Description,
+		Status:      s.Status,
+		OwnerID:     s.OwnerID,
+		CreatedAt:   s.CreatedAt,
+		UpdatedAt:   s.UpdatedAt,
+	}
+}
+
+func BuildShopStaffInfoList(staff []*rpcModel.ShopStaffInfo) []*model.ShopStaffInfo {
+	return lo.Map(staff, func(item *rpcModel.ShopStaffInfo, index int) *model.ShopStaffInfo {
+		return &model.ShopStaffInfo{
+			ShopID:    item.ShopID,
+			UID:       item.Uid,
+			Role:      item.Role,
+			CreatedAt: item.CreatedAt,
+		}
+	})
+}
+
+func BuildBanInfoList(bans []*rpcModel.BanInfo) []*model.BanInfo {
+	return lo.Map(bans, func(item *rpcModel.BanInfo, index int) *model.BanInfo {
+		return &model.BanInfo{
+			BanID:      item.BanID,
+			UID:        item.Uid,
+			Reason:     item.Reason,
+			OperatorID: item.OperatorID,
+			StartAt:    item.StartAt,
+			EndAt:      item.EndAt,
+			LiftedAt:   item.LiftedAt,
+			LiftedBy:   item.LiftedBy,
+		}
+	})
+}
+
+func BuildApiKeyInfo(k *rpcModel.ApiKeyInfo) *model.ApiKeyInfo {
+	if k == nil {
+		return nil
+	}
+	return &model.ApiKeyInfo{
+		KeyID:     k.KeyID,
+		Name:      k.Name,
+		Prefix:    k.Prefix,
+		Scopes:    k.Scopes,
+		CreatedAt: k.CreatedAt,
+	}
+}
+
+func BuildApiKeyInfoList(keys []*rpcModel.ApiKeyInfo) []*model.ApiKeyInfo {
+	return lo.Map(keys, func(item *rpcModel.ApiKeyInfo, index int) *model.ApiKeyInfo {
+		return BuildApiKeyInfo(item)
+	})
+}
+
+func BuildAuditLogInfoList(logs []*rpcModel.AuditLogInfo) []*model.AuditLogInfo {
+	return lo.Map(logs, func(item *rpcModel.AuditLogInfo, index int) *model.AuditLogInfo {
+		return &model.AuditLogInfo{
+			LogID:     item.LogID,
+			ActorID:   item.ActorID,
+			Action:    item.Action,
+			Target:    item.Target,
+			Before:    item.Before,
+			After:     item.After,
+			ClientIP:  item.ClientIP,
+			TraceID:   item.TraceID,
+			CreatedAt: item.CreatedAt,
+		}
+	})
+}
+
+func BuildWebhookEndpointInfo(e *rpcModel.WebhookEndpointInfo) *model.WebhookEndpointInfo {
+	if e == nil {
+		return nil
+	}
+	return &model.WebhookEndpointInfo{
+		EndpointID:          e.EndpointID,
+		ShopID:              e.ShopID,
+		URL:                 e.Url,
+		EventTypes:          e.EventTypes,
+		Enabled:             e.Enabled,
+		ConsecutiveFailures: e.ConsecutiveFailures,
+		CreatedAt:           e.CreatedAt,
+		UpdatedAt:           e.UpdatedAt,
+	}
+}
+
+func BuildWebhookEndpointInfoList(endpoints []*rpcModel.WebhookEndpointInfo) []*model.WebhookEndpointInfo {
+	return lo.Map(endpoints, func(item *rpcModel.WebhookEndpointInfo, index int) *model.WebhookEndpointInfo {
+		return BuildWebhookEndpointInfo(item)
+	})
+}
+
+func BuildWebhookDeliveryInfo(d *rpcModel.WebhookDeliveryInfo) *model.WebhookDeliveryInfo {
+	if d == nil {
+		return nil
+	}
+	return &model.WebhookDeliveryInfo{
+		DeliveryID:     d.DeliveryID,
+		EndpointID:     d.EndpointID,
+		EventID:        d.EventID,
+		EventType:      d.EventType,
+		Payload:        d.Payload,
+		Status:         d.Status,
+		Attempts:       d.Attempts,
+		NextRetryAt:    d.NextRetryAt,
+		LastStatusCode: d.LastStatusCode,
+		LastError:      d.LastError,
+		CreatedAt:      d.CreatedAt,
+		UpdatedAt:      d.UpdatedAt,
+	}
+}
+
+func BuildWebhookDeliveryInfoList(deliveries []*rpcModel.WebhookDeliveryInfo) []*model.WebhookDeliveryInfo {
+	return lo.Map(deliveries, func(item *rpcModel.WebhookDeliveryInfo, index int) *model.WebhookDeliveryInfo {
+		return BuildWebhookDeliveryInfo(item)
+	})
+}
@@ -27,7 +27,7 @@ import (
 func rootMw() []app.HandlerFunc {
 	// your code...
 	return []app.HandlerFunc{
-		mw.Auth(),
+		mw.ApiKeyAuth(),
 		mw.UserLoginStatus(),
 	}
 }
@@ -64,7 +64,9 @@ func _deletecategoryMw() []app.HandlerFunc {
 
 func _viewcategoryMw() []app.HandlerFunc {
 	// your code...
-	return nil
+	return []app.HandlerFunc{
+		mw.CatalogCache(),
+	}
 }
 
 func _updatecategoryMw() []app.HandlerFunc {
@@ -139,7 +141,9 @@ func _deleteskuMw() []app.HandlerFunc {
 
 func _viewskuimageMw() []app.HandlerFunc {
 	// your code...
-	return nil
+	return []app.HandlerFunc{
+		mw.CatalogCache(),
+	}
 }
 
 func _listskuinfoMw() []app.HandlerFunc {
@@ -149,7 +153,9 @@ func _listskuinfoMw() []app.HandlerFunc {
 
 func _viewskuMw() []app.HandlerFunc {
 	// your code...
-	return nil
+	return []app.HandlerFunc{
+		mw.CatalogCache(),
+	}
 }
 
 func _updateskuMw() []app.HandlerFunc {
@@ -174,12 +180,16 @@ func _deletespuMw() []app.HandlerFunc {
 
 func _viewspuimageMw() []app.HandlerFunc {
 	// your code...
-	return nil
+	return []app.HandlerFunc{
+		mw.CatalogCache(),
+	}
 }
 
 func _viewspuMw() []app.HandlerFunc {
 	// your code...
-	return nil
+	return []app.HandlerFunc{
+		mw.CatalogCache(),
+	}
 }
 
 func _updatespuMw() []app.HandlerFunc {
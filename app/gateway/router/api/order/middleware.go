@@ -76,3 +76,8 @@ func _vieworderMw() []app.HandlerFunc {
 	// your code...
 	return nil
 }
+
+func _vieworderdetailMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
@@ -44,6 +44,7 @@ func Register(r *server.Hertz) {
 				_order.PUT("/change-address", append(_changedeliveraddressMw(), order.ChangeDeliverAddress)...)
 				_order.POST("/create", append(_createorderMw(), order.CreateOrder)...)
 				_order.DELETE("/delete", append(_deleteorderMw(), order.DeleteOrder)...)
+				_order.GET("/detail", append(_vieworderdetailMw(), order.ViewOrderDetail)...)
 				_order.GET("/list", append(_vieworderlistMw(), order.ViewOrderList)...)
 				_order.GET("/view", append(_vieworderMw(), order.ViewOrder)...)
 			}
@@ -64,7 +64,9 @@ func _refundMw() []app.HandlerFunc {
 
 func _refundreviewMw() []app.HandlerFunc {
 	// your code...
-	return nil
+	return []app.HandlerFunc{
+		mw.Permission(),
+	}
 }
 
 func _requestpaymentcheckoutMw() []app.HandlerFunc {
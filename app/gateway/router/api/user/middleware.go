@@ -55,10 +55,43 @@ func _loginMw() []app.HandlerFunc {
 }
 
 func _getaddressMw() []app.HandlerFunc {
-	return nil
+	return []app.HandlerFunc{
+		mw.Auth(),
+		mw.UserLoginStatus(),
+	}
 }
 
 func _addaddressMw() []app.HandlerFunc {
+	return []app.HandlerFunc{
+		mw.Auth(),
+		mw.UserLoginStatus(),
+	}
+}
+
+func _addressMw() []app.HandlerFunc {
+	return []app.HandlerFunc{
+		mw.Auth(),
+		mw.UserLoginStatus(),
+	}
+}
+
+func _listaddressMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _updateaddressMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _deleteaddressMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _setdefaultaddressMw() []app.HandlerFunc {
+	// your code...
 	return nil
 }
 
@@ -66,14 +99,43 @@ func _banuserMw() []app.HandlerFunc {
 	// your code...
 	return []app.HandlerFunc{
 		mw.Auth(),
+		mw.UserLoginStatus(),
+		mw.Permission(),
 	}
 }
 
-func _liftbanduserMw() []app.HandlerFunc {
+func _banMw() []app.HandlerFunc {
 	// your code...
 	return nil
 }
 
+func _listbanhistoryMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		mw.Auth(),
+		mw.UserLoginStatus(),
+		mw.Permission(),
+	}
+}
+
+func _liftbanduserMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		mw.Auth(),
+		mw.UserLoginStatus(),
+		mw.Permission(),
+	}
+}
+
+func _unlockuserMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		mw.Auth(),
+		mw.UserLoginStatus(),
+		mw.Permission(),
+	}
+}
+
 func _logoutMw() []app.HandlerFunc {
 	// your code...
 	return []app.HandlerFunc{
@@ -82,6 +144,289 @@ func _logoutMw() []app.HandlerFunc {
 }
 
 func _setadministratorMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		mw.Auth(),
+		mw.UserLoginStatus(),
+	}
+}
+
+func _refreshtokenMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _sessionMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		mw.Auth(),
+		mw.UserLoginStatus(),
+	}
+}
+
+func _listsessionsMw() []app.HandlerFunc {
 	// your code...
 	return nil
 }
+
+func _revokesessionMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _revokeallsessionsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _createshopMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		mw.Auth(),
+		mw.UserLoginStatus(),
+		mw.Permission(),
+	}
+}
+
+func _shopMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _closeshopMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		mw.Auth(),
+		mw.UserLoginStatus(),
+	}
+}
+
+func _getshopMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _removeshopstaffMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		mw.Auth(),
+		mw.UserLoginStatus(),
+	}
+}
+
+func _addshopstaffMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		mw.Auth(),
+		mw.UserLoginStatus(),
+	}
+}
+
+func _staffMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listshopstaffMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		mw.Auth(),
+		mw.UserLoginStatus(),
+	}
+}
+
+func _updateshopMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		mw.Auth(),
+		mw.UserLoginStatus(),
+	}
+}
+
+func _updateprofileMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		mw.Auth(),
+		mw.UserLoginStatus(),
+	}
+}
+
+func _changepasswordMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		mw.Auth(),
+		mw.UserLoginStatus(),
+	}
+}
+
+func _passwordMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _resetpasswordMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _resetMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _requestpasswordresetMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _exportuserdataMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		mw.Auth(),
+		mw.UserLoginStatus(),
+	}
+}
+
+func _accountMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _closeaccountMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		mw.Auth(),
+		mw.UserLoginStatus(),
+	}
+}
+
+func _totpMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _enrolltotpMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		mw.Auth(),
+		mw.UserLoginStatus(),
+	}
+}
+
+func _confirmtotpMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		mw.Auth(),
+		mw.UserLoginStatus(),
+	}
+}
+
+func _disabletotpMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		mw.Auth(),
+		mw.UserLoginStatus(),
+	}
+}
+
+func _apikeyMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _createapikeyMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		mw.Auth(),
+		mw.UserLoginStatus(),
+		mw.Permission(),
+	}
+}
+
+func _listapikeysMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		mw.Auth(),
+		mw.UserLoginStatus(),
+	}
+}
+
+func _revokeapikeyMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		mw.Auth(),
+		mw.UserLoginStatus(),
+	}
+}
+
+func _auditMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listauditlogsMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		mw.Auth(),
+		mw.UserLoginStatus(),
+		mw.Permission(),
+	}
+}
+
+func _deletewebhookendpointMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		mw.Auth(),
+		mw.UserLoginStatus(),
+	}
+}
+
+func _createwebhookendpointMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		mw.Auth(),
+		mw.UserLoginStatus(),
+	}
+}
+
+func _webhookMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listwebhookendpointsMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		mw.Auth(),
+		mw.UserLoginStatus(),
+	}
+}
+
+func _updatewebhookendpointMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		mw.Auth(),
+		mw.UserLoginStatus(),
+	}
+}
+
+func _deliveryMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listwebhookdeliveriesMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		mw.Auth(),
+		mw.UserLoginStatus(),
+	}
+}
+
+func _redeliverwebhookMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		mw.Auth(),
+		mw.UserLoginStatus(),
+	}
+}
@@ -40,14 +40,63 @@ func Register(r *server.Hertz) {
 			_v1 := _api.Group("/v1", _v1Mw()...)
 			{
 				_user := _v1.Group("/user", _userMw()...)
+				_account := _user.Group("/account", _accountMw()...)
+				_account.POST("/close", append(_closeaccountMw(), user.CloseAccount)...)
 				_user.POST("/address", append(_addaddressMw(), user.AddAddress)...)
+				_address := _user.Group("/address", _addressMw()...)
+				_address.PUT("/default", append(_setdefaultaddressMw(), user.SetDefaultAddress)...)
+				_address.DELETE("/delete", append(_deleteaddressMw(), user.DeleteAddress)...)
+				_address.GET("/list", append(_listaddressMw(), user.ListAddress)...)
+				_address.PUT("/update", append(_updateaddressMw(), user.UpdateAddress)...)
 				_user.POST("/administrator", append(_setadministratorMw(), user.SetAdministrator)...)
+				_user.DELETE("/apikey", append(_revokeapikeyMw(), user.RevokeApiKey)...)
+				_user.POST("/apikey", append(_createapikeyMw(), user.CreateApiKey)...)
+				_apikey := _user.Group("/apikey", _apikeyMw()...)
+				_apikey.GET("/list", append(_listapikeysMw(), user.ListApiKeys)...)
+				_audit := _user.Group("/audit", _auditMw()...)
+				_audit.GET("/list", append(_listauditlogsMw(), user.ListAuditLogs)...)
 				_user.POST("/ban", append(_banuserMw(), user.BanUser)...)
+				_ban := _user.Group("/ban", _banMw()...)
+				_ban.GET("/history", append(_listbanhistoryMw(), user.ListBanHistory)...)
+				_user.GET("/export", append(_exportuserdataMw(), user.ExportUserData)...)
 				_user.POST("/lift", append(_liftbanduserMw(), user.LiftBandUser)...)
 				_user.GET("/location", append(_getaddressMw(), user.GetAddress)...)
 				_user.POST("/login", append(_loginMw(), user.Login)...)
 				_user.POST("/logout", append(_logoutMw(), user.Logout)...)
+				_user.PUT("/password", append(_changepasswordMw(), user.ChangePassword)...)
+				_password := _user.Group("/password", _passwordMw()...)
+				_password.POST("/reset", append(_resetpasswordMw(), user.ResetPassword)...)
+				_reset := _password.Group("/reset", _resetMw()...)
+				_reset.POST("/code", append(_requestpasswordresetMw(), user.RequestPasswordReset)...)
+				_user.PUT("/profile", append(_updateprofileMw(), user.UpdateProfile)...)
+				_user.POST("/refresh", append(_refreshtokenMw(), user.RefreshToken)...)
 				_user.POST("/register", append(_registerMw(), user.Register)...)
+				_user.POST("/shop", append(_createshopMw(), user.CreateShop)...)
+				_shop := _user.Group("/shop", _shopMw()...)
+				_shop.PUT("/close", append(_closeshopMw(), user.CloseShop)...)
+				_shop.GET("/info", append(_getshopMw(), user.GetShop)...)
+				_shop.DELETE("/staff", append(_removeshopstaffMw(), user.RemoveShopStaff)...)
+				_shop.POST("/staff", append(_addshopstaffMw(), user.AddShopStaff)...)
+				_staff := _shop.Group("/staff", _staffMw()...)
+				_staff.GET("/list", append(_listshopstaffMw(), user.ListShopStaff)...)
+				_shop.PUT("/update", append(_updateshopMw(), user.UpdateShop)...)
+				_shop.DELETE("/webhook", append(_deletewebhookendpointMw(), user.DeleteWebhookEndpoint)...)
+				_shop.POST("/webhook", append(_createwebhookendpointMw(), user.CreateWebhookEndpoint)...)
+				_webhook := _shop.Group("/webhook", _webhookMw()...)
+				_webhook.GET("/list", append(_listwebhookendpointsMw(), user.ListWebhookEndpoints)...)
+				_webhook.PUT("/update", append(_updatewebhookendpointMw(), user.UpdateWebhookEndpoint)...)
+				_delivery := _webhook.Group("/delivery", _deliveryMw()...)
+				_delivery.GET("/list", append(_listwebhookdeliveriesMw(), user.ListWebhookDeliveries)...)
+				_delivery.POST("/redeliver", append(_redeliverwebhookMw(), user.RedeliverWebhook)...)
+				_session := _user.Group("/session", _sessionMw()...)
+				_session.GET("/list", append(_listsessionsMw(), user.ListSessions)...)
+				_session.DELETE("/revoke", append(_revokesessionMw(), user.RevokeSession)...)
+				_session.DELETE("/revoke-all", append(_revokeallsessionsMw(), user.RevokeAllSessions)...)
+				_totp := _user.Group("/totp", _totpMw()...)
+				_totp.POST("/confirm", append(_confirmtotpMw(), user.ConfirmTOTP)...)
+				_totp.POST("/disable", append(_disabletotpMw(), user.DisableTOTP)...)
+				_totp.POST("/enroll", append(_enrolltotpMw(), user.EnrollTOTP)...)
+				_user.POST("/unlock", append(_unlockuserMw(), user.UnlockUser)...)
 			}
 		}
 	}
@@ -0,0 +1,39 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package router
+
+import (
+	"github.com/cloudwego/hertz/pkg/app/server"
+
+	"github.com/west2-online/DomTok/app/gateway/handler/docs"
+	"github.com/west2-online/DomTok/app/gateway/handler/probe"
+	"github.com/west2-online/DomTok/app/gateway/handler/push"
+	"github.com/west2-online/DomTok/app/gateway/handler/wellknown"
+	"github.com/west2-online/DomTok/app/gateway/mw"
+	"github.com/west2-online/DomTok/pkg/constants"
+)
+
+// CustomizedRegister 注册不由 IDL 生成的路由
+func CustomizedRegister(r *server.Hertz) {
+	r.GET(constants.JWKSPath, wellknown.JWKS)
+	r.GET(constants.OpenAPIPath, docs.OpenAPI)
+	r.GET(constants.DocsPath, docs.Index)
+	r.GET(constants.HealthzPath, probe.Healthz)
+	r.GET(constants.ReadyzPath, mw.Readiness(), probe.Readyz)
+	// 推送连接需要登录, UserLoginStatus 同时会初始化 mw.GateWayService
+	r.GET(constants.OrderPushPath, mw.Auth(), mw.UserLoginStatus(), push.Entrypoint)
+}
@@ -0,0 +1,203 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rpc
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/bytedance/gopkg/cloud/circuitbreaker"
+	"github.com/cloudwego/kitex/client"
+	"github.com/cloudwego/kitex/pkg/circuitbreak"
+	"github.com/cloudwego/kitex/pkg/rpcinfo"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/west2-online/DomTok/config"
+	"github.com/west2-online/DomTok/pkg/constants"
+	"github.com/west2-online/DomTok/pkg/logger"
+)
+
+// 熔断器状态的指标值
+const (
+	breakerStateClosed int64 = iota
+	breakerStateHalfOpen
+	breakerStateOpen
+)
+
+// breakerEntry 记录已经出现过的熔断 key 和当前生效的规则, 配置热更新时只需要更新发生变化的规则
+type breakerEntry struct {
+	service string
+	cfg     circuitbreak.CBConfig
+}
+
+var (
+	// breakers 每个下游服务使用单独的熔断器, key 为服务名
+	breakers    = make(map[string]*circuitbreak.CBSuite)
+	breakerKeys = make(map[string]*breakerEntry)
+	breakersMu  sync.RWMutex
+)
+
+func init() {
+	config.OnReload(reloadBreakerConfig)
+}
+
+// clientOptions 是网关调用下游服务时的公共选项, 超时的请求也会计入熔断的错误率
+func clientOptions(service string) []client.Option {
+	var cbs *circuitbreak.CBSuite
+	cbs = circuitbreak.NewCBSuite(func(ri rpcinfo.RPCInfo) string {
+		key := breakerKey(ri)
+		registerBreakerKey(cbs, service, key)
+		return key
+	})
+
+	breakersMu.Lock()
+	breakers[service] = cbs
+	breakersMu.Unlock()
+
+	return []client.Option{
+		client.WithRPCTimeout(constants.RPCTimeout),
+		client.WithConnectTimeout(constants.ConnectTimeout),
+		client.WithCircuitBreaker(cbs),
+	}
+}
+
+// breakerKey 以 服务名/方法名 作为熔断的粒度, 与配置中的格式一致
+func breakerKey(ri rpcinfo.RPCInfo) string {
+	if ri == nil {
+		return ""
+	}
+	return ri.To().ServiceName() + "/" + ri.To().Method()
+}
+
+// registerBreakerKey 在第一次调用某个方法时写入它的熔断规则, 否则 kitex 会使用默认规则
+func registerBreakerKey(cbs *circuitbreak.CBSuite, service, key string) {
+	breakersMu.RLock()
+	_, ok := breakerKeys[key]
+	breakersMu.RUnlock()
+	if ok {
+		return
+	}
+
+	breakersMu.Lock()
+	defer breakersMu.Unlock()
+	if _, ok = breakerKeys[key]; ok {
+		return
+	}
+	cfg := breakerConfig(key)
+	cbs.UpdateServiceCBConfig(key, cfg)
+	breakerKeys[key] = &breakerEntry{service: service, cfg: cfg}
+}
+
+// breakerConfig 返回方法的熔断规则, 方法没有单独配置的字段沿用全局配置
+func breakerConfig(key string) circuitbreak.CBConfig {
+	cfg := circuitbreak.GetDefaultCBConfig()
+	bc := config.GetBreaker()
+	if bc == nil {
+		return cfg
+	}
+	if bc.ErrRate > 0 {
+		cfg.ErrRate = bc.ErrRate
+	}
+	if bc.MinSample > 0 {
+		cfg.MinSample = bc.MinSample
+	}
+	for _, m := range bc.Methods {
+		if m.Method != key {
+			continue
+		}
+		cfg.Enable = !m.Disable
+		if m.ErrRate > 0 {
+			cfg.ErrRate = m.ErrRate
+		}
+		if m.MinSample > 0 {
+			cfg.MinSample = m.MinSample
+		}
+	}
+	return cfg
+}
+
+// reloadBreakerConfig 配置热更新时更新所有已经出现过的方法的规则
+// kitex 只在创建熔断器时读取阈值, 所以规则变化后需要移除旧的熔断器
+func reloadBreakerConfig() {
+	breakersMu.Lock()
+	defer breakersMu.Unlock()
+	for key, entry := range breakerKeys {
+		cfg := breakerConfig(key)
+		if cfg.Equals(&entry.cfg) {
+			continue
+		}
+		cbs := breakers[entry.service]
+		cbs.UpdateServiceCBConfig(key, cfg)
+		cbs.ServicePanel().RemoveBreaker(key)
+		entry.cfg = cfg
+		logger.Infof("gateway: circuit breaker of %s updated: %+v", key, cfg)
+	}
+}
+
+// registerBreakerMetrics 通过 opentelemetry 暴露每个方法的熔断器状态和错误率
+func registerBreakerMetrics() {
+	meter := otel.Meter(constants.GatewayServiceName)
+	state, err := meter.Int64ObservableGauge("rpc.client.circuit_breaker.state",
+		metric.WithDescription("circuit breaker state of downstream methods, 0: closed, 1: half-open, 2: open"))
+	if err != nil {
+		logger.Errorf("gateway: register circuit breaker metrics failed: %v", err)
+		return
+	}
+	errRate, err := meter.Float64ObservableGauge("rpc.client.circuit_breaker.error_rate",
+		metric.WithDescription("error rate of downstream methods in the circuit breaker window"))
+	if err != nil {
+		logger.Errorf("gateway: register circuit breaker metrics failed: %v", err)
+		return
+	}
+
+	_, err = meter.RegisterCallback(func(ctx context.Context, o metric.Observer) error {
+		breakersMu.RLock()
+		defer breakersMu.RUnlock()
+		for service, cbs := range breakers {
+			dumper, ok := cbs.ServicePanel().(interface {
+				DumpBreakers() map[string]circuitbreaker.Breaker
+			})
+			if !ok {
+				continue
+			}
+			for key, b := range dumper.DumpBreakers() {
+				_, method, _ := strings.Cut(key, "/")
+				attrs := metric.WithAttributes(attribute.String("service", service), attribute.String("method", method))
+				o.ObserveInt64(state, breakerStateValue(b.State()), attrs)
+				o.ObserveFloat64(errRate, b.Metricer().ErrorRate(), attrs)
+			}
+		}
+		return nil
+	}, state, errRate)
+	if err != nil {
+		logger.Errorf("gateway: register circuit breaker metrics failed: %v", err)
+	}
+}
+
+func breakerStateValue(s circuitbreaker.State) int64 {
+	switch s {
+	case circuitbreaker.Open:
+		return breakerStateOpen
+	case circuitbreaker.HalfOpen:
+		return breakerStateHalfOpen
+	default:
+		return breakerStateClosed
+	}
+}
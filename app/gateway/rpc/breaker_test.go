@@ -0,0 +1,102 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rpc
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/cloudwego/kitex/pkg/circuitbreak"
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/spf13/viper"
+
+	"github.com/west2-online/DomTok/config"
+)
+
+// mockBreakerConfig 按 yaml 构造 config.GetBreaker 的返回值, 配置类型没有导出, 只能通过返回值的类型构造
+func mockBreakerConfig(content string) {
+	typ := reflect.TypeOf(config.GetBreaker)
+	cfg := reflect.New(typ.Out(0).Elem())
+	v := viper.New()
+	v.SetConfigType("yaml")
+	So(v.ReadConfig(strings.NewReader(content)), ShouldBeNil)
+	So(v.Unmarshal(cfg.Interface()), ShouldBeNil)
+	mockey.Mock(config.GetBreaker).Return(cfg.Interface()).Build()
+}
+
+func TestBreakerConfig(t *testing.T) {
+	mockey.PatchConvey("TestBreakerConfig", t, func() {
+		defaultCfg := circuitbreak.GetDefaultCBConfig()
+
+		Convey("config not loaded uses the kitex defaults", func() {
+			mockey.Mock(config.GetBreaker).Return(reflect.Zero(reflect.TypeOf(config.GetBreaker).Out(0)).Interface()).Build()
+			So(breakerConfig("commodity/ViewSpu"), ShouldResemble, defaultCfg)
+		})
+
+		Convey("per method lookup", func() {
+			mockBreakerConfig(`
+err-rate: 0.3
+min-sample: 100
+methods:
+  - method: commodity/ViewSpu
+    err-rate: 0.8
+  - method: commodity/ListSpuInfo
+    min-sample: 20
+  - method: order/CreateOrder
+    disable: true
+`)
+			testCases := []struct {
+				name     string
+				key      string
+				expected circuitbreak.CBConfig
+			}{
+				{
+					name:     "method without override uses the global config",
+					key:      "user/GetAddress",
+					expected: circuitbreak.CBConfig{Enable: true, ErrRate: 0.3, MinSample: 100},
+				},
+				{
+					name:     "method overrides the error rate",
+					key:      "commodity/ViewSpu",
+					expected: circuitbreak.CBConfig{Enable: true, ErrRate: 0.8, MinSample: 100},
+				},
+				{
+					name:     "method overrides the min sample",
+					key:      "commodity/ListSpuInfo",
+					expected: circuitbreak.CBConfig{Enable: true, ErrRate: 0.3, MinSample: 20},
+				},
+				{
+					name:     "method disables the breaker",
+					key:      "order/CreateOrder",
+					expected: circuitbreak.CBConfig{Enable: false, ErrRate: 0.3, MinSample: 100},
+				},
+				{
+					name:     "method name must match the service",
+					key:      "cart/ViewSpu",
+					expected: circuitbreak.CBConfig{Enable: true, ErrRate: 0.3, MinSample: 100},
+				},
+			}
+			for _, tc := range testCases {
+				Convey(tc.name, func() {
+					So(breakerConfig(tc.key), ShouldResemble, tc.expected)
+				})
+			}
+		})
+	})
+}
@@ -22,13 +22,14 @@ import (
 	"github.com/west2-online/DomTok/kitex_gen/cart"
 	"github.com/west2-online/DomTok/kitex_gen/model"
 	"github.com/west2-online/DomTok/pkg/base/client"
+	"github.com/west2-online/DomTok/pkg/constants"
 	"github.com/west2-online/DomTok/pkg/errno"
 	"github.com/west2-online/DomTok/pkg/logger"
 	"github.com/west2-online/DomTok/pkg/utils"
 )
 
 func InitCartRPC() {
-	c, err := client.InitCartRPC()
+	c, err := client.InitCartRPC(clientOptions(constants.CartServiceName)...)
 	if err != nil {
 		logger.Fatalf("api.rpc.cart InitCartRPC failed, err is %v", err)
 	}
@@ -22,13 +22,14 @@ import (
 	"github.com/west2-online/DomTok/kitex_gen/commodity"
 	"github.com/west2-online/DomTok/kitex_gen/model"
 	"github.com/west2-online/DomTok/pkg/base/client"
+	"github.com/west2-online/DomTok/pkg/constants"
 	"github.com/west2-online/DomTok/pkg/errno"
 	"github.com/west2-online/DomTok/pkg/logger"
 	"github.com/west2-online/DomTok/pkg/utils"
 )
 
 func InitCommodityRPC() {
-	c, err := client.InitCommodityRPC()
+	c, err := client.InitCommodityRPC(clientOptions(constants.CommodityServiceName)...)
 	if err != nil {
 		logger.Fatalf("api.rpc.Commodity InitCommodityRPC failed, err  %v", err)
 	}
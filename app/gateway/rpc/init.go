@@ -40,4 +40,5 @@ func Init() {
 	InitCommodityStreamClientRPC()
 	InitCartRPC()
 	InitPaymentRPC()
+	registerBreakerMetrics()
 }
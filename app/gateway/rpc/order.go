@@ -21,13 +21,14 @@ import (
 
 	"github.com/west2-online/DomTok/kitex_gen/order"
 	"github.com/west2-online/DomTok/pkg/base/client"
+	"github.com/west2-online/DomTok/pkg/constants"
 	"github.com/west2-online/DomTok/pkg/errno"
 	"github.com/west2-online/DomTok/pkg/logger"
 	"github.com/west2-online/DomTok/pkg/utils"
 )
 
 func InitOrderRPC() {
-	c, err := client.InitOrderRPC()
+	c, err := client.InitOrderRPC(clientOptions(constants.OrderServiceName)...)
 	if err != nil {
 		logger.Fatalf("api.rpc.order InitOrderRPC failed, err is %v", err)
 	}
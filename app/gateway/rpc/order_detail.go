@@ -0,0 +1,125 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"sync"
+
+	"github.com/samber/lo"
+
+	api "github.com/west2-online/DomTok/app/gateway/model/api/order"
+	"github.com/west2-online/DomTok/app/gateway/pack"
+	"github.com/west2-online/DomTok/kitex_gen/commodity"
+	"github.com/west2-online/DomTok/kitex_gen/model"
+	"github.com/west2-online/DomTok/kitex_gen/order"
+	"github.com/west2-online/DomTok/kitex_gen/payment"
+	"github.com/west2-online/DomTok/kitex_gen/user"
+	"github.com/west2-online/DomTok/pkg/errno"
+	"github.com/west2-online/DomTok/pkg/logger"
+)
+
+// ViewOrderDetailRPC 聚合订单页需要的数据
+// 订单本身先查询, 它同时校验了订单归属; 之后地址, 支付/退款状态和每个款式的图片并发查询,
+// 单个分区失败时只在该分区内返回错误, 不影响整个页面
+func ViewOrderDetailRPC(ctx context.Context, orderID int64) (*api.ViewOrderDetailResp, error) {
+	o, err := ViewOrderRPC(ctx, &order.ViewOrderReq{OrderID: orderID})
+	if err != nil {
+		return nil, err
+	}
+	if o.Data == nil || o.Data.Order == nil {
+		return nil, errno.NewErrNo(errno.ServiceOrderNotFound, "order not found")
+	}
+
+	styleIDs := lo.Uniq(lo.Map(o.Data.Goods, func(g *model.OrderGoods, _ int) int64 {
+		return g.StyleId
+	}))
+
+	resp := &api.ViewOrderDetailResp{
+		Order:     pack.BuildOrderWithGoods(o.Data),
+		Address:   new(api.OrderAddressSection),
+		Payment:   new(api.OrderPaymentSection),
+		SkuImages: make([]*api.OrderSkuImageSection, len(styleIDs)),
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(2 + len(styleIDs))
+	go func() {
+		defer wg.Done()
+		defer recoverSection("address", func(e *api.SectionError) {
+			resp.Address.Error = e
+		})
+		address, err := GetAddressRPC(ctx, &user.GetAddressRequest{AddressId: o.Data.Order.AddressID})
+		if err != nil {
+			resp.Address.Error = buildSectionError(err)
+			return
+		}
+		resp.Address.Address = address.Address
+	}()
+	go func() {
+		defer wg.Done()
+		defer recoverSection("payment", func(e *api.SectionError) {
+			resp.Payment.Error = e
+		})
+		status, err := QueryPaymentStatusRPC(ctx, &payment.PaymentStatusRequest{OrderID: orderID})
+		if err != nil {
+			resp.Payment.Error = buildSectionError(err)
+			return
+		}
+		resp.Payment.Payment = pack.BuildPaymentStatusInfo(status.Payment)
+		resp.Payment.Refund = pack.BuildRefundStatusInfo(status.Refund)
+	}()
+	// 每个 goroutine 只写自己下标的元素, 不需要加锁
+	for i, id := range styleIDs {
+		go func(i int, id int64) {
+			defer wg.Done()
+			defer recoverSection("sku image", func(e *api.SectionError) {
+				resp.SkuImages[i] = &api.OrderSkuImageSection{SkuID: id, Error: e}
+			})
+			section := &api.OrderSkuImageSection{SkuID: id}
+			images, err := ViewSkuImageRPC(ctx, &commodity.ViewSkuImageReq{SkuID: id})
+			if err != nil {
+				section.Error = buildSectionError(err)
+			} else {
+				section.Images = pack.BuildSkuImages(images)
+			}
+			resp.SkuImages[i] = section
+		}(i, id)
+	}
+	wg.Wait()
+
+	return resp, nil
+}
+
+// recoverSection 把分区内的 panic 转换为该分区的错误, 这些 goroutine 不在 hertz 的 recovery 保护范围内,
+// 不处理的话单个分区的异常会导致整个网关退出
+func recoverSection(section string, report func(*api.SectionError)) {
+	if r := recover(); r != nil {
+		logger.Errorf("rpc.ViewOrderDetailRPC: %s section panic: %v\n stack=%s\n", section, r, debug.Stack())
+		report(buildSectionError(errno.InternalServiceError.WithMessage(fmt.Sprintf("%s section panic", section))))
+	}
+}
+
+func buildSectionError(err error) *api.SectionError {
+	e := errno.ConvertErr(err)
+	return &api.SectionError{
+		Code: e.ErrorCode,
+		Msg:  e.ErrorMsg,
+	}
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rpc
+
+import (
+	"sync"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	api "github.com/west2-online/DomTok/app/gateway/model/api/order"
+	"github.com/west2-online/DomTok/pkg/errno"
+)
+
+func TestRecoverSection(t *testing.T) {
+	Convey("TestRecoverSection", t, func() {
+		Convey("panic is reported in its own section", func() {
+			resp := &api.ViewOrderDetailResp{Address: new(api.OrderAddressSection)}
+			var wg sync.WaitGroup
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				defer recoverSection("address", func(e *api.SectionError) {
+					resp.Address.Error = e
+				})
+				var address *api.OrderAddressSection
+				_ = address.Address // nil pointer dereference
+			}()
+			wg.Wait()
+			So(resp.Address.Error, ShouldNotBeNil)
+			So(resp.Address.Error.Code, ShouldEqual, errno.InternalServiceErrorCode)
+		})
+		Convey("nothing is reported without panic", func() {
+			reported := false
+			func() {
+				defer recoverSection("payment", func(*api.SectionError) {
+					reported = true
+				})
+			}()
+			So(reported, ShouldBeFalse)
+		})
+	})
+}
@@ -22,13 +22,14 @@ import (
 	"github.com/west2-online/DomTok/kitex_gen/model"
 	"github.com/west2-online/DomTok/kitex_gen/payment"
 	"github.com/west2-online/DomTok/pkg/base/client"
+	"github.com/west2-online/DomTok/pkg/constants"
 	"github.com/west2-online/DomTok/pkg/errno"
 	"github.com/west2-online/DomTok/pkg/logger"
 	"github.com/west2-online/DomTok/pkg/utils"
 )
 
 func InitPaymentRPC() {
-	c, err := client.InitPaymentRPC()
+	c, err := client.InitPaymentRPC(clientOptions(constants.PaymentServiceName)...)
 	if err != nil {
 		logger.Fatalf("api.rpc.payment InitPayemntRPC failed, err is %v", err)
 	}
@@ -99,3 +100,18 @@ func RequestPaymentCheckoutRPC(ctx context.Context, req *payment.PaymentCheckout
 
 	return nil
 }
+
+// QueryPaymentStatusRPC 查询订单的支付单和退款单, 业务错误保留原始错误码
+func QueryPaymentStatusRPC(ctx context.Context, req *payment.PaymentStatusRequest) (*payment.PaymentStatusResponse, error) {
+	resp, err := paymentClient.QueryPaymentStatus(ctx, req)
+	if err != nil {
+		logger.Errorf("QueryPaymentStatusRPC: RPC call failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+
+	if !utils.IsSuccess(resp.Base) {
+		return nil, errno.NewErrNo(resp.Base.Code, resp.Base.Msg)
+	}
+
+	return resp, nil
+}
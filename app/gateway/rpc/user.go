@@ -21,15 +21,18 @@ import (
 
 	api "github.com/west2-online/DomTok/app/gateway/model/api/user"
 	"github.com/west2-online/DomTok/app/gateway/model/model"
+	"github.com/west2-online/DomTok/app/gateway/pack"
+	kmodel "github.com/west2-online/DomTok/kitex_gen/model"
 	"github.com/west2-online/DomTok/kitex_gen/user"
 	"github.com/west2-online/DomTok/pkg/base/client"
+	"github.com/west2-online/DomTok/pkg/constants"
 	"github.com/west2-online/DomTok/pkg/errno"
 	"github.com/west2-online/DomTok/pkg/logger"
 	"github.com/west2-online/DomTok/pkg/utils"
 )
 
 func InitUserRPC() {
-	c, err := client.InitUserRPC()
+	c, err := client.InitUserRPC(clientOptions(constants.UserServiceName)...)
 	if err != nil {
 		logger.Fatalf("api.rpc.user InitUserRPC failed, err is %v", err)
 	}
@@ -52,14 +55,14 @@ func RegisterRPC(ctx context.Context, req *user.RegisterRequest) (response *api.
 	return response, nil
 }
 
-func LoginRPC(ctx context.Context, req *user.LoginRequest) (response *api.LoginResponse, err error) {
+func LoginRPC(ctx context.Context, req *user.LoginRequest) (response *api.LoginResponse, token *kmodel.TokenInfo, err error) {
 	resp, err := userClient.Login(ctx, req)
 	if err != nil {
 		logger.Errorf("LoginRPC: RPC called failed: %v", err.Error())
-		return nil, errno.InternalServiceError.WithError(err)
+		return nil, nil, errno.InternalServiceError.WithError(err)
 	}
 	if !utils.IsSuccess(resp.Base) {
-		return nil, errno.InternalServiceError.WithMessage(resp.Base.Msg)
+		return nil, nil, errno.NewErrNo(resp.Base.Code, resp.Base.Msg)
 	}
 
 	response = &api.LoginResponse{
@@ -70,7 +73,20 @@ func LoginRPC(ctx context.Context, req *user.LoginRequest) (response *api.LoginR
 		},
 	}
 
-	return response, nil
+	return response, resp.Token, nil
+}
+
+func RefreshTokenRPC(ctx context.Context, req *user.RefreshTokenReq) (*kmodel.TokenInfo, error) {
+	resp, err := userClient.RefreshToken(ctx, req)
+	if err != nil {
+		logger.Errorf("RefreshTokenRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	// 保留业务错误码, 让客户端能够区分 refresh token 被重放和普通的失效
+	if !utils.IsSuccess(resp.Base) {
+		return nil, errno.NewErrNo(resp.Base.Code, resp.Base.Msg)
+	}
+	return resp.Token, nil
 }
 
 func GetAddressRPC(ctx context.Context, req *user.GetAddressRequest) (response *api.GetAddressResponse, err error) {
@@ -84,12 +100,7 @@ func GetAddressRPC(ctx context.Context, req *user.GetAddressRequest) (response *
 	}
 
 	response = &api.GetAddressResponse{
-		Address: &model.AddressInfo{
-			AddressID: resp.Address.AddressID,
-			Province:  resp.Address.Province,
-			City:      resp.Address.City,
-			Detail:    resp.Address.Detail,
-		},
+		Address: pack.BuildAddressInfo(resp.Address),
 	}
 
 	return response, nil
@@ -107,6 +118,58 @@ func AddAddressRPC(ctx context.Context, req *user.AddAddressRequest) (addressID
 	return resp.AddressID, nil
 }
 
+func ListAddressRPC(ctx context.Context, req *user.ListAddressReq) (response *api.ListAddressResponse, err error) {
+	resp, err := userClient.ListAddress(ctx, req)
+	if err != nil {
+		logger.Errorf("ListAddressRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return nil, errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+
+	response = &api.ListAddressResponse{
+		Addresses: pack.BuildAddressInfoList(resp.Addresses),
+	}
+	return response, nil
+}
+
+func UpdateAddressRPC(ctx context.Context, req *user.UpdateAddressReq) error {
+	resp, err := userClient.UpdateAddress(ctx, req)
+	if err != nil {
+		logger.Errorf("UpdateAddressRPC: RPC called failed: %v", err.Error())
+		return errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return nil
+}
+
+func DeleteAddressRPC(ctx context.Context, req *user.DeleteAddressReq) error {
+	resp, err := userClient.DeleteAddress(ctx, req)
+	if err != nil {
+		logger.Errorf("DeleteAddressRPC: RPC called failed: %v", err.Error())
+		return errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return nil
+}
+
+func SetDefaultAddressRPC(ctx context.Context, req *user.SetDefaultAddressReq) error {
+	resp, err := userClient.SetDefaultAddress(ctx, req)
+	if err != nil {
+		logger.Errorf("SetDefaultAddressRPC: RPC called failed: %v", err.Error())
+		return errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return nil
+}
+
 func LogoutUserRPC(ctx context.Context, req *user.LogoutReq) error {
 	resp, err := userClient.Logout(ctx, req)
 	if err != nil {
@@ -119,6 +182,46 @@ func LogoutUserRPC(ctx context.Context, req *user.LogoutReq) error {
 	return nil
 }
 
+func ListSessionsRPC(ctx context.Context, req *user.ListSessionsReq) (response *api.ListSessionsResponse, err error) {
+	resp, err := userClient.ListSessions(ctx, req)
+	if err != nil {
+		logger.Errorf("ListSessionsRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return nil, errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+
+	response = &api.ListSessionsResponse{
+		Sessions: pack.BuildSessionInfoList(resp.Sessions),
+	}
+	return response, nil
+}
+
+func RevokeSessionRPC(ctx context.Context, req *user.RevokeSessionReq) error {
+	resp, err := userClient.RevokeSession(ctx, req)
+	if err != nil {
+		logger.Errorf("RevokeSessionRPC: RPC called failed: %v", err.Error())
+		return errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return nil
+}
+
+func RevokeAllSessionsRPC(ctx context.Context, req *user.RevokeAllSessionsReq) error {
+	resp, err := userClient.RevokeAllSessions(ctx, req)
+	if err != nil {
+		logger.Errorf("RevokeAllSessionsRPC: RPC called failed: %v", err.Error())
+		return errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return nil
+}
+
 func BanUserRPC(ctx context.Context, req *user.BanUserReq) error {
 	resp, err := userClient.BanUser(ctx, req)
 	if err != nil {
@@ -131,6 +234,22 @@ func BanUserRPC(ctx context.Context, req *user.BanUserReq) error {
 	return nil
 }
 
+func ListBanHistoryRPC(ctx context.Context, req *user.ListBanHistoryReq) (response *api.ListBanHistoryResponse, err error) {
+	resp, err := userClient.ListBanHistory(ctx, req)
+	if err != nil {
+		logger.Errorf("ListBanHistoryRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return nil, errno.NewErrNo(resp.Base.Code, resp.Base.Msg)
+	}
+
+	response = &api.ListBanHistoryResponse{
+		Bans: pack.BuildBanInfoList(resp.Bans),
+	}
+	return response, nil
+}
+
 func LiftUserRPC(ctx context.Context, req *user.LiftBanUserReq) error {
 	resp, err := userClient.LiftBandUser(ctx, req)
 	if err != nil {
@@ -143,6 +262,18 @@ func LiftUserRPC(ctx context.Context, req *user.LiftBanUserReq) error {
 	return nil
 }
 
+func UnlockUserRPC(ctx context.Context, req *user.UnlockUserReq) error {
+	resp, err := userClient.UnlockUser(ctx, req)
+	if err != nil {
+		logger.Errorf("UnlockUserRPC: RPC called failed: %v", err.Error())
+		return errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return errno.NewErrNo(resp.Base.Code, resp.Base.Msg)
+	}
+	return nil
+}
+
 func SetAdministrator(ctx context.Context, req *user.SetAdministratorReq) error {
 	resp, err := userClient.SetAdministrator(ctx, req)
 	if err != nil {
@@ -154,3 +285,362 @@ func SetAdministrator(ctx context.Context, req *user.SetAdministratorReq) error
 	}
 	return nil
 }
+
+func CreateShopRPC(ctx context.Context, req *user.CreateShopReq) (shopID int64, err error) {
+	resp, err := userClient.CreateShop(ctx, req)
+	if err != nil {
+		logger.Errorf("CreateShopRPC: RPC called failed: %v", err.Error())
+		return 0, errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return 0, errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return resp.ShopID, nil
+}
+
+func UpdateShopRPC(ctx context.Context, req *user.UpdateShopReq) error {
+	resp, err := userClient.UpdateShop(ctx, req)
+	if err != nil {
+		logger.Errorf("UpdateShopRPC: RPC called failed: %v", err.Error())
+		return errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return nil
+}
+
+func CloseShopRPC(ctx context.Context, req *user.CloseShopReq) error {
+	resp, err := userClient.CloseShop(ctx, req)
+	if err != nil {
+		logger.Errorf("CloseShopRPC: RPC called failed: %v", err.Error())
+		return errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return nil
+}
+
+func GetShopRPC(ctx context.Context, req *user.GetShopReq) (response *api.GetShopResponse, err error) {
+	resp, err := userClient.GetShop(ctx, req)
+	if err != nil {
+		logger.Errorf("GetShopRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return nil, errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+
+	response = &api.GetShopResponse{
+		Shop: pack.BuildShopInfo(resp.Shop),
+	}
+	return response, nil
+}
+
+func AddShopStaffRPC(ctx context.Context, req *user.AddShopStaffReq) error {
+	resp, err := userClient.AddShopStaff(ctx, req)
+	if err != nil {
+		logger.Errorf("AddShopStaffRPC: RPC called failed: %v", err.Error())
+		return errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return nil
+}
+
+func RemoveShopStaffRPC(ctx context.Context, req *user.RemoveShopStaffReq) error {
+	resp, err := userClient.RemoveShopStaff(ctx, req)
+	if err != nil {
+		logger.Errorf("RemoveShopStaffRPC: RPC called failed: %v", err.Error())
+		return errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return nil
+}
+
+func ListShopStaffRPC(ctx context.Context, req *user.ListShopStaffReq) (response *api.ListShopStaffResponse, err error) {
+	resp, err := userClient.ListShopStaff(ctx, req)
+	if err != nil {
+		logger.Errorf("ListShopStaffRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return nil, errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+
+	response = &api.ListShopStaffResponse{
+		Staff: pack.BuildShopStaffInfoList(resp.Staff),
+	}
+	return response, nil
+}
+
+func UpdateProfileRPC(ctx context.Context, req *user.UpdateProfileReq) (response *api.UpdateProfileResponse, err error) {
+	resp, err := userClient.UpdateProfile(ctx, req)
+	if err != nil {
+		logger.Errorf("UpdateProfileRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return nil, errno.NewErrNo(resp.Base.Code, resp.Base.Msg)
+	}
+
+	response = &api.UpdateProfileResponse{
+		User: pack.BuildUserInfo(resp.User),
+	}
+	return response, nil
+}
+
+func ChangePasswordRPC(ctx context.Context, req *user.ChangePasswordReq) error {
+	resp, err := userClient.ChangePassword(ctx, req)
+	if err != nil {
+		logger.Errorf("ChangePasswordRPC: RPC called failed: %v", err.Error())
+		return errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return errno.NewErrNo(resp.Base.Code, resp.Base.Msg)
+	}
+	return nil
+}
+
+func RequestPasswordResetRPC(ctx context.Context, req *user.RequestPasswordResetReq) error {
+	resp, err := userClient.RequestPasswordReset(ctx, req)
+	if err != nil {
+		logger.Errorf("RequestPasswordResetRPC: RPC called failed: %v", err.Error())
+		return errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return errno.NewErrNo(resp.Base.Code, resp.Base.Msg)
+	}
+	return nil
+}
+
+func ResetPasswordRPC(ctx context.Context, req *user.ResetPasswordReq) error {
+	resp, err := userClient.ResetPassword(ctx, req)
+	if err != nil {
+		logger.Errorf("ResetPasswordRPC: RPC called failed: %v", err.Error())
+		return errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return errno.NewErrNo(resp.Base.Code, resp.Base.Msg)
+	}
+	return nil
+}
+
+func ExportUserDataRPC(ctx context.Context, req *user.ExportUserDataReq) (string, error) {
+	resp, err := userClient.ExportUserData(ctx, req)
+	if err != nil {
+		logger.Errorf("ExportUserDataRPC: RPC called failed: %v", err.Error())
+		return "", errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return "", errno.NewErrNo(resp.Base.Code, resp.Base.Msg)
+	}
+	return resp.Data, nil
+}
+
+func CloseAccountRPC(ctx context.Context, req *user.CloseAccountReq) error {
+	resp, err := userClient.CloseAccount(ctx, req)
+	if err != nil {
+		logger.Errorf("CloseAccountRPC: RPC called failed: %v", err.Error())
+		return errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return errno.NewErrNo(resp.Base.Code, resp.Base.Msg)
+	}
+	return nil
+}
+
+func EnrollTOTPRPC(ctx context.Context, req *user.EnrollTOTPReq) (*api.EnrollTOTPResponse, error) {
+	resp, err := userClient.EnrollTOTP(ctx, req)
+	if err != nil {
+		logger.Errorf("EnrollTOTPRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return nil, errno.NewErrNo(resp.Base.Code, resp.Base.Msg)
+	}
+	return &api.EnrollTOTPResponse{
+		Secret:        resp.Secret,
+		URI:           resp.Uri,
+		RecoveryCodes: resp.RecoveryCodes,
+	}, nil
+}
+
+func ConfirmTOTPRPC(ctx context.Context, req *user.ConfirmTOTPReq) error {
+	resp, err := userClient.ConfirmTOTP(ctx, req)
+	if err != nil {
+		logger.Errorf("ConfirmTOTPRPC: RPC called failed: %v", err.Error())
+		return errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return errno.NewErrNo(resp.Base.Code, resp.Base.Msg)
+	}
+	return nil
+}
+
+func DisableTOTPRPC(ctx context.Context, req *user.DisableTOTPReq) error {
+	resp, err := userClient.DisableTOTP(ctx, req)
+	if err != nil {
+		logger.Errorf("DisableTOTPRPC: RPC called failed: %v", err.Error())
+		return errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return errno.NewErrNo(resp.Base.Code, resp.Base.Msg)
+	}
+	return nil
+}
+
+func CreateApiKeyRPC(ctx context.Context, req *user.CreateApiKeyReq) (*api.CreateApiKeyResponse, error) {
+	resp, err := userClient.CreateApiKey(ctx, req)
+	if err != nil {
+		logger.Errorf("CreateApiKeyRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return nil, errno.NewErrNo(resp.Base.Code, resp.Base.Msg)
+	}
+	return &api.CreateApiKeyResponse{
+		Key:  resp.Key,
+		Info: pack.BuildApiKeyInfo(resp.Info),
+	}, nil
+}
+
+func ListApiKeysRPC(ctx context.Context, req *user.ListApiKeysReq) (*api.ListApiKeysResponse, error) {
+	resp, err := userClient.ListApiKeys(ctx, req)
+	if err != nil {
+		logger.Errorf("ListApiKeysRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return nil, errno.NewErrNo(resp.Base.Code, resp.Base.Msg)
+	}
+	return &api.ListApiKeysResponse{
+		Keys: pack.BuildApiKeyInfoList(resp.Keys),
+	}, nil
+}
+
+func RevokeApiKeyRPC(ctx context.Context, req *user.RevokeApiKeyReq) error {
+	resp, err := userClient.RevokeApiKey(ctx, req)
+	if err != nil {
+		logger.Errorf("RevokeApiKeyRPC: RPC called failed: %v", err.Error())
+		return errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return errno.NewErrNo(resp.Base.Code, resp.Base.Msg)
+	}
+	return nil
+}
+
+// VerifyApiKeyRPC 校验 API Key, 返回 key 持有者的 uid, 角色和 key 的 scope
+func VerifyApiKeyRPC(ctx context.Context, req *user.VerifyApiKeyReq) (*user.VerifyApiKeyResp, error) {
+	resp, err := userClient.VerifyApiKey(ctx, req)
+	if err != nil {
+		logger.Errorf("VerifyApiKeyRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return nil, errno.NewErrNo(resp.Base.Code, resp.Base.Msg)
+	}
+	return resp, nil
+}
+
+func ListAuditLogsRPC(ctx context.Context, req *user.ListAuditLogsReq) (*api.ListAuditLogsResponse, error) {
+	resp, err := userClient.ListAuditLogs(ctx, req)
+	if err != nil {
+		logger.Errorf("ListAuditLogsRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return nil, errno.NewErrNo(resp.Base.Code, resp.Base.Msg)
+	}
+	return &api.ListAuditLogsResponse{
+		Logs:  pack.BuildAuditLogInfoList(resp.Logs),
+		Total: resp.Total,
+	}, nil
+}
+
+func CreateWebhookEndpointRPC(ctx context.Context, req *user.CreateWebhookEndpointReq) (*api.CreateWebhookEndpointResponse, error) {
+	resp, err := userClient.CreateWebhookEndpoint(ctx, req)
+	if err != nil {
+		logger.Errorf("CreateWebhookEndpointRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return nil, errno.NewErrNo(resp.Base.Code, resp.Base.Msg)
+	}
+	return &api.CreateWebhookEndpointResponse{
+		Secret:   resp.Secret,
+		Endpoint: pack.BuildWebhookEndpointInfo(resp.Endpoint),
+	}, nil
+}
+
+func ListWebhookEndpointsRPC(ctx context.Context, req *user.ListWebhookEndpointsReq) (*api.ListWebhookEndpointsResponse, error) {
+	resp, err := userClient.ListWebhookEndpoints(ctx, req)
+	if err != nil {
+		logger.Errorf("ListWebhookEndpointsRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return nil, errno.NewErrNo(resp.Base.Code, resp.Base.Msg)
+	}
+	return &api.ListWebhookEndpointsResponse{
+		Endpoints: pack.BuildWebhookEndpointInfoList(resp.Endpoints),
+	}, nil
+}
+
+func UpdateWebhookEndpointRPC(ctx context.Context, req *user.UpdateWebhookEndpointReq) error {
+	resp, err := userClient.UpdateWebhookEndpoint(ctx, req)
+	if err != nil {
+		logger.Errorf("UpdateWebhookEndpointRPC: RPC called failed: %v", err.Error())
+		return errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return errno.NewErrNo(resp.Base.Code, resp.Base.Msg)
+	}
+	return nil
+}
+
+func DeleteWebhookEndpointRPC(ctx context.Context, req *user.DeleteWebhookEndpointReq) error {
+	resp, err := userClient.DeleteWebhookEndpoint(ctx, req)
+	if err != nil {
+		logger.Errorf("DeleteWebhookEndpointRPC: RPC called failed: %v", err.Error())
+		return errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return errno.NewErrNo(resp.Base.Code, resp.Base.Msg)
+	}
+	return nil
+}
+
+func ListWebhookDeliveriesRPC(ctx context.Context, req *user.ListWebhookDeliveriesReq) (*api.ListWebhookDeliveriesResponse, error) {
+	resp, err := userClient.ListWebhookDeliveries(ctx, req)
+	if err != nil {
+		logger.Errorf("ListWebhookDeliveriesRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return nil, errno.NewErrNo(resp.Base.Code, resp.Base.Msg)
+	}
+	return &api.ListWebhookDeliveriesResponse{
+		Deliveries: pack.BuildWebhookDeliveryInfoList(resp.Deliveries),
+		Total:      resp.Total,
+	}, nil
+}
+
+func RedeliverWebhookRPC(ctx context.Context, req *user.RedeliverWebhookReq) (*api.RedeliverWebhookResponse, error) {
+	resp, err := userClient.RedeliverWebhook(ctx, req)
+	if err != nil {
+		logger.Errorf("RedeliverWebhookRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return nil, errno.NewErrNo(resp.Base.Code, resp.Base.Msg)
+	}
+	return &api.RedeliverWebhookResponse{
+		Delivery: pack.BuildWebhookDeliveryInfo(resp.Delivery),
+	}, nil
+}
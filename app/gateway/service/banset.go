@@ -0,0 +1,75 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"sync"
+	"time"
+)
+
+// BanSet 保存被封禁用户的 uid, 作为 UserLoginStatus 的快速判断
+// 名单中存在的用户还会去 redis 中再确认一次, 所以多出来的 uid(比如临时封禁已经到期)只会多一次查询, 不会误拦截
+// 和布隆过滤器不同, 解封时可以直接删除
+type BanSet struct {
+	users map[int64]time.Time // uid -> 加入名单的时间
+	mu    sync.RWMutex
+}
+
+func NewBanSet() *BanSet {
+	return &BanSet{
+		users: make(map[int64]time.Time),
+	}
+}
+
+func (b *BanSet) Add(uid int64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.users[uid] = time.Now()
+}
+
+func (b *BanSet) Remove(uid int64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.users, uid)
+}
+
+// Test 判断用户是否可能被封禁
+func (b *BanSet) Test(uid int64) bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	_, ok := b.users[uid]
+	return ok
+}
+
+// Reset 使用从 redis 中全量加载的名单替换当前名单
+// since 为开始加载的时间, 加载期间通过事件加入的用户不会在全量名单中, 需要保留下来
+func (b *BanSet) Reset(uids []int64, since time.Time) {
+	users := make(map[int64]time.Time, len(uids))
+	now := time.Now()
+	for _, uid := range uids {
+		users[uid] = now
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for uid, addedAt := range b.users {
+		if addedAt.After(since) {
+			users[uid] = addedAt
+		}
+	}
+	b.users = users
+}
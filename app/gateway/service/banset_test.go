@@ -0,0 +1,69 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestBanSet(t *testing.T) {
+	Convey("TestBanSet", t, func() {
+		b := NewBanSet()
+
+		Convey("add and remove", func() {
+			b.Add(1)
+			So(b.Test(1), ShouldBeTrue)
+			So(b.Test(2), ShouldBeFalse)
+			b.Remove(1)
+			So(b.Test(1), ShouldBeFalse)
+		})
+
+		Convey("reset replaces the set but keeps users added during the load", func() {
+			b.Add(1)
+			since := time.Now()
+			b.Add(2) // 全量加载期间通过事件加入
+			b.Reset([]int64{3}, since)
+			So(b.Test(1), ShouldBeFalse)
+			So(b.Test(2), ShouldBeTrue)
+			So(b.Test(3), ShouldBeTrue)
+		})
+	})
+}
+
+func TestGateWayService_WatchBanEvents(t *testing.T) {
+	Convey("TestGateWayService_WatchBanEvents", t, func() {
+		svc := &GateWayService{Ban: NewBanSet()}
+		svc.Ban.Add(2)
+
+		ch := make(chan *redis.Message, 4)
+		ch <- &redis.Message{Payload: `{"uid":1,"banned":true}`}
+		ch <- &redis.Message{Payload: `{"uid":2,"banned":false}`}
+		ch <- &redis.Message{Payload: `not json`}
+		ch <- &redis.Message{Payload: `{"uid":3,"banned":true}`}
+		close(ch)
+		svc.watchBanEvents(ch)
+
+		So(svc.Ban.Test(1), ShouldBeTrue)
+		So(svc.Ban.Test(2), ShouldBeFalse)
+		// 无法解析的事件被跳过, 不影响后续的事件
+		So(svc.Ban.Test(3), ShouldBeTrue)
+	})
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/west2-online/DomTok/pkg/constants"
+	"github.com/west2-online/DomTok/pkg/kafka"
+	"github.com/west2-online/DomTok/pkg/logger"
+	"github.com/west2-online/DomTok/pkg/shutdown"
+)
+
+// CachedResponse 是商品查询接口缓存的响应
+type CachedResponse struct {
+	ETag        string `json:"etag"`
+	ContentType string `json:"content_type"`
+	Body        []byte `json:"body"`
+}
+
+// GetCatalogVersion 版本不存在时为 0
+func (svc *RedisService) GetCatalogVersion(ctx context.Context) (int64, error) {
+	version, err := svc.client.Get(ctx, constants.RedisCatalogVersionKey).Int64()
+	if errors.Is(err, redis.Nil) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("get catalog version failed: %w", err)
+	}
+	return version, nil
+}
+
+// BumpCatalogVersion 递增缓存版本, 旧版本的缓存不再被读取, 等待过期即可
+func (svc *RedisService) BumpCatalogVersion(ctx context.Context) error {
+	if err := svc.client.Incr(ctx, constants.RedisCatalogVersionKey).Err(); err != nil {
+		return fmt.Errorf("bump catalog version failed: %w", err)
+	}
+	return nil
+}
+
+// GetCatalogCache 缓存不存在时返回 nil
+func (svc *RedisService) GetCatalogCache(ctx context.Context, key string) (*CachedResponse, error) {
+	data, err := svc.client.Get(ctx, key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get catalog cache %s failed: %w", key, err)
+	}
+	resp := new(CachedResponse)
+	if err = sonic.Unmarshal(data, resp); err != nil {
+		return nil, fmt.Errorf("unmarshal catalog cache %s failed: %w", key, err)
+	}
+	return resp, nil
+}
+
+func (svc *RedisService) SetCatalogCache(ctx context.Context, key string, resp *CachedResponse, ttl time.Duration) error {
+	data, err := sonic.Marshal(resp)
+	if err != nil {
+		return fmt.Errorf("marshal catalog cache failed: %w", err)
+	}
+	if err = svc.client.Set(ctx, key, data, ttl).Err(); err != nil {
+		return fmt.Errorf("set catalog cache %s failed: %w", key, err)
+	}
+	return nil
+}
+
+// GetCatalogCacheKey query 需要是规范化之后的查询参数, 保证参数顺序不同的请求命中同一个缓存
+func (svc *RedisService) GetCatalogCacheKey(version int64, route, query string) string {
+	return fmt.Sprintf("%s%d:%s", constants.RedisCatalogCacheKey, version, catalogDigest(route, query))
+}
+
+// GetCatalogStaleKey 过期数据与缓存版本无关, 只在下游服务不可用时作为降级数据返回
+func (svc *RedisService) GetCatalogStaleKey(route, query string) string {
+	return constants.RedisCatalogStaleKey + catalogDigest(route, query)
+}
+
+func catalogDigest(route, query string) string {
+	sum := sha256.Sum256([]byte(route + "?" + query))
+	return hex.EncodeToString(sum[:])
+}
+
+// WatchSpuEvents 消费商品服务发布的 spu 创建, 更新和删除事件, 收到事件后使商品缓存失效
+// 所有网关实例使用同一个订阅组, 缓存保存在 redis 中, 任意一个实例处理事件即可
+func (svc *GateWayService) WatchSpuEvents(ctx context.Context) {
+	k := kafka.NewKafkaInstance()
+	shutdown.Register("kafka", func() error { k.Close(); return nil })
+	for _, topic := range []string{constants.KafkaCreateSpuTopic, constants.KafkaUpdateSpuTopic, constants.KafkaDeleteSpuTopic} {
+		ch := k.Consume(ctx, topic, constants.KafkaCatalogCacheConsumerNum, constants.KafkaCatalogCacheGroupId)
+		go svc.invalidateCatalog(ctx, ch)
+	}
+}
+
+func (svc *GateWayService) invalidateCatalog(ctx context.Context, ch <-chan *kafka.Message) {
+	for range ch {
+		if err := svc.Re.BumpCatalogVersion(ctx); err != nil {
+			logger.Errorf("gateway: invalidate catalog cache failed: %v", err)
+		}
+	}
+}
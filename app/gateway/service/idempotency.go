@@ -0,0 +1,90 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/west2-online/DomTok/pkg/constants"
+)
+
+// IdempotencyRecord 是幂等键保存的内容, Completed 为 false 表示请求还在处理中
+type IdempotencyRecord struct {
+	Fingerprint string `json:"fingerprint"`
+	Completed   bool   `json:"completed"`
+	StatusCode  int    `json:"status_code,omitempty"`
+	ContentType string `json:"content_type,omitempty"`
+	Body        []byte `json:"body,omitempty"`
+}
+
+// AcquireIdempotencyKey 只有幂等键不存在时才写入处理中的标记, 返回是否写入成功
+func (svc *RedisService) AcquireIdempotencyKey(ctx context.Context, key string, record *IdempotencyRecord, ttl time.Duration) (bool, error) {
+	data, err := sonic.Marshal(record)
+	if err != nil {
+		return false, fmt.Errorf("marshal idempotency record failed: %w", err)
+	}
+	ok, err := svc.client.SetNX(ctx, key, data, ttl).Result()
+	if err != nil {
+		return false, fmt.Errorf("acquire idempotency key %s failed: %w", key, err)
+	}
+	return ok, nil
+}
+
+// GetIdempotencyRecord 幂等键不存在时返回 nil
+func (svc *RedisService) GetIdempotencyRecord(ctx context.Context, key string) (*IdempotencyRecord, error) {
+	data, err := svc.client.Get(ctx, key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get idempotency record %s failed: %w", key, err)
+	}
+	record := new(IdempotencyRecord)
+	if err = sonic.Unmarshal(data, record); err != nil {
+		return nil, fmt.Errorf("unmarshal idempotency record %s failed: %w", key, err)
+	}
+	return record, nil
+}
+
+func (svc *RedisService) SaveIdempotencyRecord(ctx context.Context, key string, record *IdempotencyRecord, ttl time.Duration) error {
+	data, err := sonic.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("marshal idempotency record failed: %w", err)
+	}
+	if err = svc.client.Set(ctx, key, data, ttl).Err(); err != nil {
+		return fmt.Errorf("save idempotency record %s failed: %w", key, err)
+	}
+	return nil
+}
+
+// ReleaseIdempotencyKey 删除处理中的标记, 让客户端可以使用同一个幂等键重试
+func (svc *RedisService) ReleaseIdempotencyKey(ctx context.Context, key string) error {
+	if err := svc.client.Del(ctx, key).Err(); err != nil {
+		return fmt.Errorf("release idempotency key %s failed: %w", key, err)
+	}
+	return nil
+}
+
+func (svc *RedisService) GetIdempotencyKey(dimension, id, idempotencyKey string) string {
+	return fmt.Sprintf("%s%s:%s:%s", constants.RedisIdempotencyKey, dimension, id, idempotencyKey)
+}
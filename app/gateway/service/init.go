@@ -16,25 +16,87 @@ limitations under the License.
 
 package service
 
-import "context"
+import (
+	"context"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/west2-online/DomTok/pkg/constants"
+	"github.com/west2-online/DomTok/pkg/logger"
+)
 
 type GateWayService struct {
-	Re *RedisService
-	Bf *BF
+	Re   *RedisService
+	Ban  *BanSet
+	Push *PushHub
+}
+
+// BanEvent 是用户服务发布的封禁事件, 字段需要和用户服务保持一致
+type BanEvent struct {
+	Uid    int64 `json:"uid"`
+	Banned bool  `json:"banned"` // true 表示封禁, false 表示解封
 }
 
 func NewGateWayService() *GateWayService {
 	svc := &GateWayService{
-		Re: NewRedisService(),
-		Bf: NewBloomFilter(),
+		Re:   NewRedisService(),
+		Ban:  NewBanSet(),
+		Push: NewPushHub(),
 	}
-	svc.initBloomFilter()
+	svc.initBanSet()
+	svc.initPush()
 	return svc
 }
 
-func (svc *GateWayService) initBloomFilter() {
-	keys := svc.Re.GetAllBanedUser(context.Background())
-	for _, key := range keys {
-		svc.Bf.Add(key)
+// initBanSet 先订阅事件再全量加载, 避免加载期间发生的封禁被漏掉
+// 订阅断线重连期间的事件会丢失, 所以还需要定期全量重建
+func (svc *GateWayService) initBanSet() {
+	ctx := context.Background()
+	pubsub := svc.Re.SubscribeBanEvents(ctx)
+	if _, err := pubsub.Receive(ctx); err != nil {
+		logger.Fatalf("gateway: subscribe ban events failed: %v", err)
+	}
+	go svc.watchBanEvents(pubsub.Channel())
+
+	if err := svc.rebuildBanSet(ctx); err != nil {
+		logger.Fatalf("gateway: load baned users failed: %v", err)
+	}
+	go svc.rebuildBanSetPeriodically(ctx)
+}
+
+func (svc *GateWayService) watchBanEvents(ch <-chan *redis.Message) {
+	for msg := range ch {
+		var event BanEvent
+		if err := sonic.UnmarshalString(msg.Payload, &event); err != nil {
+			logger.Errorf("gateway: unmarshal ban event failed: %v, payload: %s", err, msg.Payload)
+			continue
+		}
+		if event.Banned {
+			svc.Ban.Add(event.Uid)
+		} else {
+			svc.Ban.Remove(event.Uid)
+		}
+	}
+}
+
+func (svc *GateWayService) rebuildBanSet(ctx context.Context) error {
+	since := time.Now()
+	uids, err := svc.Re.GetAllBanedUser(ctx)
+	if err != nil {
+		return err
+	}
+	svc.Ban.Reset(uids, since)
+	return nil
+}
+
+func (svc *GateWayService) rebuildBanSetPeriodically(ctx context.Context) {
+	ticker := time.NewTicker(constants.BanSetRebuildInterval)
+	defer ticker.Stop()
+	for range ticker.C {
+		if err := svc.rebuildBanSet(ctx); err != nil {
+			logger.Errorf("gateway: rebuild ban set failed: %v", err)
+		}
 	}
 }
@@ -0,0 +1,107 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"sync"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/west2-online/DomTok/pkg/constants"
+	"github.com/west2-online/DomTok/pkg/logger"
+	"github.com/west2-online/DomTok/pkg/push"
+)
+
+// PushHub 保存当前网关实例上每个用户的推送连接
+// 每个连接对应一个带缓冲的 channel, 连接写得太慢导致缓冲区满时直接丢弃事件, 不阻塞其他连接
+type PushHub struct {
+	subscribers map[int64]map[chan []byte]struct{}
+	mu          sync.RWMutex
+}
+
+func NewPushHub() *PushHub {
+	return &PushHub{
+		subscribers: make(map[int64]map[chan []byte]struct{}),
+	}
+}
+
+// Subscribe 为用户注册一个推送连接, 返回的 cancel 用于连接断开时注销
+// 用户的连接数达到上限时返回 false
+func (h *PushHub) Subscribe(uid int64) (<-chan []byte, func(), bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	subs := h.subscribers[uid]
+	if len(subs) >= constants.PushMaxConnsPerUser {
+		return nil, nil, false
+	}
+	if subs == nil {
+		subs = make(map[chan []byte]struct{})
+		h.subscribers[uid] = subs
+	}
+	ch := make(chan []byte, constants.PushSubscriberBuffer)
+	subs[ch] = struct{}{}
+
+	cancel := func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		delete(subs, ch)
+		if len(subs) == 0 {
+			delete(h.subscribers, uid)
+		}
+	}
+	return ch, cancel, true
+}
+
+// Dispatch 把事件发送给用户在当前实例上的所有连接
+func (h *PushHub) Dispatch(uid int64, payload []byte) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	for ch := range h.subscribers[uid] {
+		select {
+		case ch <- payload:
+		default:
+			logger.Warnf("gateway: push buffer of user %d is full, event dropped", uid)
+		}
+	}
+}
+
+// SubscribePushEvents 订阅订单服务和支付服务发布的推送事件
+func (svc *RedisService) SubscribePushEvents(ctx context.Context) *redis.PubSub {
+	return svc.client.Subscribe(ctx, constants.RedisPushChannel)
+}
+
+// initPush 订阅推送频道, 每个网关实例都会收到全部事件, 只转发给连接在本实例上的用户
+func (svc *GateWayService) initPush() {
+	ctx := context.Background()
+	pubsub := svc.Re.SubscribePushEvents(ctx)
+	if _, err := pubsub.Receive(ctx); err != nil {
+		logger.Fatalf("gateway: subscribe push events failed: %v", err)
+	}
+	go svc.watchPushEvents(pubsub.Channel())
+}
+
+func (svc *GateWayService) watchPushEvents(ch <-chan *redis.Message) {
+	for msg := range ch {
+		e, err := push.Decode(msg.Payload)
+		if err != nil {
+			logger.Errorf("gateway: decode push event failed: %v, payload: %s", err, msg.Payload)
+			continue
+		}
+		svc.Push.Dispatch(e.Uid, []byte(msg.Payload))
+	}
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/west2-online/DomTok/pkg/constants"
+)
+
+var tokenBucketScript = redis.NewScript(constants.GatewayTokenBucketLuaScript)
+
+// TakeToken 从 key 对应的令牌桶中取出一个令牌, 被拒绝时返回需要等待的时间
+func (svc *RedisService) TakeToken(ctx context.Context, key string, rate float64, burst int) (bool, time.Duration, error) {
+	res, err := tokenBucketScript.Run(ctx, &svc.client, []string{key}, rate, burst).Int64Slice()
+	if err != nil {
+		return false, 0, fmt.Errorf("take token from %s failed: %w", key, err)
+	}
+	if len(res) != 2 { //nolint:mnd
+		return false, 0, fmt.Errorf("take token from %s failed: unexpected result %v", key, res)
+	}
+	return res[0] == 1, time.Duration(res[1]) * time.Millisecond, nil
+}
+
+// GetRateLimitKey dimension 为 ip 或 user, rule 为默认规则或路由
+func (svc *RedisService) GetRateLimitKey(dimension, rule, id string) string {
+	return fmt.Sprintf("%s%s:%s:%s", constants.RedisRateLimitKey, dimension, rule, id)
+}
@@ -24,7 +24,8 @@ import (
 
 	"github.com/west2-online/DomTok/pkg/base/client"
 	"github.com/west2-online/DomTok/pkg/constants"
-	"github.com/west2-online/DomTok/pkg/logger"
+	"github.com/west2-online/DomTok/pkg/health"
+	"github.com/west2-online/DomTok/pkg/shutdown"
 )
 
 type RedisService struct {
@@ -36,6 +37,8 @@ func NewRedisService() *RedisService {
 	if err != nil {
 		panic(err)
 	}
+	health.Register(constants.HealthCheckRedis, health.Redis(cli))
+	shutdown.Register("redis", cli.Close)
 
 	return &RedisService{*cli}
 }
@@ -44,28 +47,37 @@ func (svc *RedisService) IsUserBanned(ctx context.Context, userId int64) bool {
 	return svc.client.Exists(ctx, svc.GetUserBanedKey(userId)).Val() == 1
 }
 
-func (svc *RedisService) IsUserLogout(ctx context.Context, userId int64) bool {
-	return svc.client.Exists(ctx, svc.GetUserLogoutKey(userId)).Val() != 1
+// IsUserLogout 判断 token 所属的会话是否已经退出登录或被吊销
+func (svc *RedisService) IsUserLogout(ctx context.Context, sessionID string) bool {
+	return svc.client.Exists(ctx, svc.GetSessionKey(sessionID)).Val() != 1
 }
 
-func (svc *RedisService) GetAllBanedUser(ctx context.Context) []int64 {
-	keys, err := svc.client.Keys(ctx, constants.RedisUserBanedKey+"*").Result()
-	if err != nil {
-		logger.Fatalf("get all baned user failed: %v", err)
-	}
+// GetAllBanedUser 使用 SCAN 遍历所有的封禁标记, 避免 KEYS 在 key 数量较多时阻塞 redis
+func (svc *RedisService) GetAllBanedUser(ctx context.Context) ([]int64, error) {
 	var res []int64
-	for _, key := range keys {
+	iter := svc.client.Scan(ctx, 0, constants.RedisUserBanedKey+"*", constants.BanSetScanCount).Iterator()
+	for iter.Next(ctx) {
 		var userId int64
-		_, _ = fmt.Sscanf(key, constants.RedisUserBanedKey+"%d", &userId)
+		if _, err := fmt.Sscanf(iter.Val(), constants.RedisUserBanedKey+"%d", &userId); err != nil {
+			continue
+		}
 		res = append(res, userId)
 	}
-	return res
+	if err := iter.Err(); err != nil {
+		return nil, fmt.Errorf("scan baned user failed: %w", err)
+	}
+	return res, nil
+}
+
+// SubscribeBanEvents 订阅用户服务发布的封禁和解封事件
+func (svc *RedisService) SubscribeBanEvents(ctx context.Context) *redis.PubSub {
+	return svc.client.Subscribe(ctx, constants.RedisUserBanChannel)
 }
 
 func (svc *RedisService) GetUserBanedKey(userId int64) string {
 	return fmt.Sprintf(constants.RedisUserBanedKey+"%d", userId)
 }
 
-func (svc *RedisService) GetUserLogoutKey(userId int64) string {
-	return fmt.Sprintf(constants.RedisUserLogoutKey+"%d", userId)
+func (svc *RedisService) GetSessionKey(sessionID string) string {
+	return constants.RedisSessionKey + sessionID
 }
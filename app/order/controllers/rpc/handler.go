@@ -132,3 +132,20 @@ func (h *OrderHandler) GetOrderPaymentAmount(ctx context.Context, req *order.Get
 	r.Amount, err = h.useCase.GetOrderPaymentAmount(ctx, req.GetOrderID())
 	return r, err
 }
+
+func (h *OrderHandler) GetOrderMerchants(ctx context.Context, req *order.GetOrderMerchantsReq) (r *order.GetOrderMerchantsResp, err error) {
+	r = new(order.GetOrderMerchantsResp)
+	r.MerchantIDs, err = h.useCase.GetOrderMerchants(ctx, req.GetOrderID())
+	return r, err
+}
+
+func (h *OrderHandler) ExportUserData(ctx context.Context, req *order.ExportUserDataReq) (r *order.ExportUserDataResp, err error) {
+	r = new(order.ExportUserDataResp)
+	r.Data, err = h.useCase.ExportUserData(ctx, req.GetUid())
+	return r, err
+}
+
+func (h *OrderHandler) EraseUserData(ctx context.Context, req *order.EraseUserDataReq) (r *order.EraseUserDataResp, err error) {
+	r = new(order.EraseUserDataResp)
+	return r, h.useCase.EraseUserData(ctx, req.GetUid())
+}
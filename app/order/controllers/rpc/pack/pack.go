@@ -51,6 +51,7 @@ func BuildOrderGoods(g *model.OrderGoods) *idlmodel.OrderGoods {
 	return &idlmodel.OrderGoods{
 		OrderId:            g.OrderID,
 		MerchantId:         g.MerchantID,
+		MerchantName:       g.MerchantName,
 		GoodsId:            g.GoodsID,
 		GoodsName:          g.GoodsName,
 		StyleId:            g.StyleID,
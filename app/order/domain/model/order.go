@@ -19,45 +19,46 @@ package model
 import "github.com/shopspring/decimal"
 
 type Order struct {
-	Id                    int64
-	Status                int8
-	Uid                   int64
-	TotalAmountOfGoods    decimal.Decimal
-	TotalAmountOfFreight  decimal.Decimal
-	TotalAmountOfDiscount decimal.Decimal
-	PaymentAmount         decimal.Decimal
-	PaymentStatus         int8
-	PaymentAt             int64
-	PaymentStyle          string
-	OrderedAt             int64
-	DeletedAt             int64
-	DeliveryAt            int64
-	AddressID             int64
-	AddressInfo           string
-	CouponId              int64
-	CouponName            string
+	Id                    int64           `json:"id"`
+	Status                int8            `json:"status"`
+	Uid                   int64           `json:"uid"`
+	TotalAmountOfGoods    decimal.Decimal `json:"total_amount_of_goods"`
+	TotalAmountOfFreight  decimal.Decimal `json:"total_amount_of_freight"`
+	TotalAmountOfDiscount decimal.Decimal `json:"total_amount_of_discount"`
+	PaymentAmount         decimal.Decimal `json:"payment_amount"`
+	PaymentStatus         int8            `json:"payment_status"`
+	PaymentAt             int64           `json:"payment_at"`
+	PaymentStyle          string          `json:"payment_style"`
+	OrderedAt             int64           `json:"ordered_at"`
+	DeletedAt             int64           `json:"deleted_at"`
+	DeliveryAt            int64           `json:"delivery_at"`
+	AddressID             int64           `json:"address_id"`
+	AddressInfo           string          `json:"address_info"`
+	CouponId              int64           `json:"coupon_id"`
+	CouponName            string          `json:"coupon_name"`
 }
 
 type OrderGoods struct {
-	OrderID            int64
-	MerchantID         int64
-	GoodsID            int64
-	GoodsName          string
-	StyleID            int64
-	StyleName          string
-	GoodsVersion       int64
-	StyleHeadDrawing   string
-	OriginPrice        decimal.Decimal
-	SalePrice          decimal.Decimal
-	SingleFreightPrice decimal.Decimal
-	PurchaseQuantity   int64
-	TotalAmount        decimal.Decimal
-	FreightAmount      decimal.Decimal
-	DiscountAmount     decimal.Decimal
-	PaymentAmount      decimal.Decimal // 应付金额
-	SinglePrice        decimal.Decimal
-	CouponId           int64
-	CouponName         string
+	OrderID            int64           `json:"order_id"`
+	MerchantID         int64           `json:"merchant_id"`
+	MerchantName       string          `json:"merchant_name"` // 店铺名称, 不落库, 查看订单时从 user 服务获取
+	GoodsID            int64           `json:"goods_id"`
+	GoodsName          string          `json:"goods_name"`
+	StyleID            int64           `json:"style_id"`
+	StyleName          string          `json:"style_name"`
+	GoodsVersion       int64           `json:"goods_version"`
+	StyleHeadDrawing   string          `json:"style_head_drawing"`
+	OriginPrice        decimal.Decimal `json:"origin_price"`
+	SalePrice          decimal.Decimal `json:"sale_price"`
+	SingleFreightPrice decimal.Decimal `json:"single_freight_price"`
+	PurchaseQuantity   int64           `json:"purchase_quantity"`
+	TotalAmount        decimal.Decimal `json:"total_amount"`
+	FreightAmount      decimal.Decimal `json:"freight_amount"`
+	DiscountAmount     decimal.Decimal `json:"discount_amount"`
+	PaymentAmount      decimal.Decimal `json:"payment_amount"` // 应付金额
+	SinglePrice        decimal.Decimal `json:"single_price"`
+	CouponId           int64           `json:"coupon_id"`
+	CouponName         string          `json:"coupon_name"`
 }
 
 type BaseOrderGoods struct {
@@ -86,3 +87,9 @@ func OG2BOG(o *OrderGoods) *BaseOrderGoods {
 		CouponID:         o.CouponId,
 	}
 }
+
+// UserData 是用户在订单服务中持有的全部数据, 用于个人数据导出
+type UserData struct {
+	Orders []*Order      `json:"orders"`
+	Goods  []*OrderGoods `json:"goods"`
+}
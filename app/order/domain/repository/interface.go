@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"github.com/west2-online/DomTok/app/order/domain/model"
+	"github.com/west2-online/DomTok/pkg/push"
 )
 
 // OrderDB 表示订单模块的持久化存储接口
@@ -35,12 +36,17 @@ type OrderDB interface {
 	GetOrderStatus(ctx context.Context, id int64) (int8, int64, error) // GetOrderStatus Return paymentStatus orderedAt error
 
 	UpdateOrderStatus(ctx context.Context, orderID int64, status int32) error
+	CancelUnpaidOrder(ctx context.Context, orderID int64) (bool, error)
 	UpdateOrderAddress(ctx context.Context, orderID int64, addressID int64, addressInfo string) error
 	UpdatePaymentStatus(ctx context.Context, message *model.PaymentResult) error
 
 	DeleteOrder(ctx context.Context, orderID int64) error
 
 	IsOrderExist(ctx context.Context, orderID int64) (bool, int64, error)
+
+	GetOrdersAndGoodsByUserID(ctx context.Context, userID int64) ([]*model.Order, []*model.OrderGoods, error)
+	HasUnfinishedOrder(ctx context.Context, userID int64) (bool, error)
+	AnonymizeUserOrders(ctx context.Context, userID int64) error
 }
 
 type MQ interface {
@@ -56,6 +62,7 @@ type RPC interface {
 	RollbackSkuStock(ctx context.Context, stocks *model.OrderStock) error
 	DescSkuStock(ctx context.Context, stocks *model.OrderStock) error
 	CalcOrderGoodsPrice(ctx context.Context, goods []*model.OrderGoods) ([]*model.OrderGoods, error)
+	GetShopNames(ctx context.Context, shopIDs []int64) (map[int64]string, error)
 }
 
 type Cache interface {
@@ -63,6 +70,7 @@ type Cache interface {
 	GetPaymentStatus(ctx context.Context, orderID int64) (*model.CachePaymentStatus, bool, error)
 	UpdatePaymentStatus(ctx context.Context, s *model.CachePaymentStatus) (exist bool, err error)
 	DeletePaymentStatus(ctx context.Context, orderID int64) error
+	PublishPushEvent(ctx context.Context, e *push.Event) error
 }
 
 type Locker interface {
@@ -24,7 +24,8 @@ import (
 	"github.com/west2-online/DomTok/pkg/logger"
 )
 
-// SkuLockStockRollback 只负责进行回滚，如果订单被完成那应该由 payment 的 msg 或者直接调用 rpc 来对订单进行更改
+// SkuLockStockRollback 在订单超时未支付时回滚锁定的库存, 并把仍处于待支付状态的订单标记为取消, 取消成功时通知用户
+// 订单被支付完成时应该由 payment 的 msg 或者直接调用 rpc 来对订单进行更改
 func (svc *OrderService) SkuLockStockRollback(ctx context.Context, body []byte) (sucRollback bool) {
 	orderStock, err := svc.decodeStocks(body)
 	if err != nil {
@@ -43,6 +44,17 @@ func (svc *OrderService) SkuLockStockRollback(ctx context.Context, body []byte)
 			logger.Error(err.Error())
 			return false
 		}
+		// 库存已经回滚, 订单不能再被支付, 标记为取消并通知用户
+		// 上面读到的支付状态可能已经过期, 只取消仍然待支付的订单, 没有订单被取消时不推送
+		// 这里失败时不能再返回 false, 否则会导致库存被重复回滚
+		cancelled, err := svc.db.CancelUnpaidOrder(ctx, orderStock.OrderID)
+		if err != nil {
+			logger.Error(err.Error())
+			return true
+		}
+		if cancelled {
+			svc.PushOrderStatus(ctx, orderStock.OrderID, constants.PushCancelReasonExpired)
+		}
 	}
 
 	return true
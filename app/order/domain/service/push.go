@@ -0,0 +1,37 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+
+	"github.com/west2-online/DomTok/pkg/logger"
+	"github.com/west2-online/DomTok/pkg/push"
+)
+
+// PushOrderStatus 把订单当前的状态推送给下单的用户, 状态从数据库中重新读取, 和查询订单时看到的一致
+// 推送失败不影响已经完成的操作, 只记录错误日志
+func (svc *OrderService) PushOrderStatus(ctx context.Context, orderID int64, reason string) {
+	order, err := svc.db.GetOrderByID(ctx, orderID)
+	if err != nil {
+		logger.Errorf("OrderService.PushOrderStatus: get order %d failed: %v", orderID, err)
+		return
+	}
+	if err = svc.cache.PublishPushEvent(ctx, push.NewOrderStatusEvent(order.Uid, orderID, order.Status, reason)); err != nil {
+		logger.Errorf("OrderService.PushOrderStatus: push order %d failed: %v", orderID, err)
+	}
+}
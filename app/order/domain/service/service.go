@@ -25,6 +25,7 @@ import (
 	"github.com/west2-online/DomTok/pkg/constants"
 	"github.com/west2-online/DomTok/pkg/errno"
 	"github.com/west2-online/DomTok/pkg/logger"
+	"github.com/west2-online/DomTok/pkg/webhook"
 )
 
 type OrderService struct {
@@ -34,15 +35,16 @@ type OrderService struct {
 	mq     repository.MQ
 	cache  repository.Cache
 	locker repository.Locker
+	hook   webhook.Publisher
 }
 
 func NewOrderService(db repository.OrderDB, sf repository.IDGenerator, rpc repository.RPC,
-	mq repository.MQ, cache repository.Cache, locker repository.Locker,
+	mq repository.MQ, cache repository.Cache, locker repository.Locker, hook webhook.Publisher,
 ) *OrderService {
-	if db == nil || sf == nil || rpc == nil || mq == nil || cache == nil || locker == nil {
+	if db == nil || sf == nil || rpc == nil || mq == nil || cache == nil || locker == nil || hook == nil {
 		logger.Fatalf("failed get new order service, all arguments should not be nil")
 	}
-	svc := &OrderService{db: db, idG: sf, rpc: rpc, mq: mq, cache: cache, locker: locker}
+	svc := &OrderService{db: db, idG: sf, rpc: rpc, mq: mq, cache: cache, locker: locker, hook: hook}
 	svc.init()
 	return svc
 }
@@ -137,6 +139,8 @@ func (svc *OrderService) UpdateOrderAsSuccess(ctx context.Context, expired int64
 		return err
 	}
 
+	svc.publishOrderEvents(ctx, constants.WebhookEventOrderPaid, payRel, goods)
+	svc.PushOrderStatus(ctx, payRel.OrderID, "")
 	return nil
 }
 
@@ -173,6 +177,8 @@ func (svc *OrderService) CancelOrder(ctx context.Context, payRel *model.PaymentR
 		return err
 	}
 
+	svc.publishOrderEvents(ctx, constants.WebhookEventOrderCanceled, payRel, goods)
+	svc.PushOrderStatus(ctx, payRel.OrderID, constants.PushCancelReasonPayment)
 	return nil
 }
 
@@ -0,0 +1,61 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+
+	"github.com/samber/lo"
+
+	"github.com/west2-online/DomTok/app/order/domain/model"
+	"github.com/west2-online/DomTok/pkg/utils"
+	"github.com/west2-online/DomTok/pkg/webhook"
+)
+
+// publishOrderEvents 按店铺拆分订单商品, 给每个店铺发布一条只包含自己商品的 webhook 事件
+func (svc *OrderService) publishOrderEvents(ctx context.Context, eventType string, payRel *model.PaymentResult, goods []*model.OrderGoods) {
+	for shopID, items := range lo.GroupBy(goods, func(g *model.OrderGoods) int64 { return g.MerchantID }) {
+		webhook.Emit(ctx, svc.hook, eventType, shopID, func(eventID string, createdAt int64) any {
+			return &webhook.OrderData{
+				EventID:      eventID,
+				Type:         eventType,
+				OrderID:      payRel.OrderID,
+				ShopID:       shopID,
+				PaymentAt:    payRel.PaymentAt,
+				PaymentStyle: payRel.PaymentStyle,
+				Goods: lo.Map(items, func(g *model.OrderGoods, _ int) *webhook.OrderGoods {
+					return &webhook.OrderGoods{
+						GoodsID:          g.GoodsID,
+						StyleID:          g.StyleID,
+						PurchaseQuantity: g.PurchaseQuantity,
+						PaymentAmount:    utils.DecimalFloat64(&g.PaymentAmount),
+					}
+				}),
+				CreatedAt: createdAt,
+			}
+		})
+	}
+}
+
+// GetOrderMerchantIDs 返回订单中商品所属的店铺
+func (svc *OrderService) GetOrderMerchantIDs(ctx context.Context, orderID int64) ([]int64, error) {
+	goods, err := svc.db.GetOrderGoodsByOrderID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	return lo.Uniq(lo.Map(goods, func(g *model.OrderGoods, _ int) int64 { return g.MerchantID })), nil
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mq
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/west2-online/DomTok/pkg/constants"
+	"github.com/west2-online/DomTok/pkg/errno"
+	"github.com/west2-online/DomTok/pkg/kafka"
+	"github.com/west2-online/DomTok/pkg/webhook"
+)
+
+// WebhookMQ 负责把商家 webhook 事件发布到 kafka, 订单内部的消息仍然使用 rocketmq
+type WebhookMQ struct {
+	client *kafka.Kafka
+}
+
+func NewWebhookMQ(client *kafka.Kafka) *WebhookMQ {
+	return &WebhookMQ{client: client}
+}
+
+// SendWebhookEvent 发布 webhook 事件, 由用户服务按商家的订阅投递
+func (m *WebhookMQ) SendWebhookEvent(ctx context.Context, e *webhook.Event) error {
+	v, err := webhook.Encode(e)
+	if err != nil {
+		return errno.Errorf(errno.InternalServiceErrorCode, "WebhookMQ.SendWebhookEvent failed: %v", err)
+	}
+
+	msg := &kafka.Message{
+		K: []byte(strconv.FormatInt(e.ShopID, 10)), // 同一店铺的事件落在同一分区, 保证顺序
+		V: v,
+	}
+	if errs := m.client.Send(ctx, constants.KafkaWebhookTopic, []*kafka.Message{msg}); len(errs) > 0 {
+		return errno.Errorf(errno.InternalKafkaErrorCode, "WebhookMQ.SendWebhookEvent failed: %v", errors.Join(errs...))
+	}
+	return nil
+}
@@ -100,7 +100,7 @@ func (mq *rocketMq) SubscribeTopic(ctx context.Context, topic string, pullMsgInt
 	return nil
 }
 
-// Shutdown 尝试释放所有资源
+// Shutdown 尝试释放所有资源, 先停止消费者, 消费者处理中的消息可能还需要发送消息
 func (mq *rocketMq) Shutdown() []error {
 	var errs []error
 	for _, con := range mq.consumers {
@@ -155,6 +155,7 @@ func (mq *rocketMq) getConsumer(topic string, pullMsgInterval time.Duration) (ro
 	if err := con.Start(); err != nil {
 		return nil, errno.NewErrNo(errno.InternalRocketmqErrorCode, fmt.Sprintf("start rocketmq consumer failed, err: %v", err))
 	}
+	mq.consumers = append(mq.consumers, con)
 	return con, nil
 }
 
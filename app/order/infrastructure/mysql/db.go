@@ -26,6 +26,7 @@ import (
 
 	"github.com/west2-online/DomTok/app/order/domain/model"
 	"github.com/west2-online/DomTok/app/order/domain/repository"
+	"github.com/west2-online/DomTok/pkg/constants"
 	"github.com/west2-online/DomTok/pkg/errno"
 )
 
@@ -157,6 +158,18 @@ func (db *orderDB) UpdateOrderStatus(ctx context.Context, orderID int64, status
 	return nil
 }
 
+// CancelUnpaidOrder 只在订单仍处于待支付状态时将其取消, 返回是否有订单被取消
+// 调用方读到的支付状态可能已经过期, 条件更新避免把刚刚支付成功的订单覆盖为已取消
+func (db *orderDB) CancelUnpaidOrder(ctx context.Context, orderID int64) (bool, error) {
+	result := db.client.WithContext(ctx).Model(&Order{}).
+		Where("id = ? AND status = ?", orderID, constants.OrderStatusUnpaidCode).
+		Update("status", constants.OrderStatusCancelledCode)
+	if result.Error != nil {
+		return false, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to cancel unpaid order: %v", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
 // UpdateOrderAddress 更新订单地址
 func (db *orderDB) UpdateOrderAddress(ctx context.Context, orderID int64, addressID int64, addressInfo string) error {
 	if err := db.client.WithContext(ctx).Model(&Order{Id: orderID}).
@@ -427,6 +427,50 @@ func TestOrderDB_UpdateOrderStatus(t *testing.T) {
 	})
 }
 
+func TestOrderDB_CancelUnpaidOrder(t *testing.T) {
+	if !initConfig() {
+		return
+	}
+	ctx := context.Background()
+	order := buildTestModelOrder(t)
+	order.Status = constants.OrderStatusUnpaidCode
+	orderGoods := buildTestModelOrderGoods(t, order.Id)
+
+	Convey("TestOrderDB_CancelUnpaidOrder", t, func() {
+		Convey("TestOrderDB_CancelUnpaidOrder_unpaid", func() {
+			err := _db.CreateOrder(ctx, order, orderGoods)
+			So(err, ShouldBeNil)
+
+			cancelled, err := _db.CancelUnpaidOrder(ctx, order.Id)
+			So(err, ShouldBeNil)
+			So(cancelled, ShouldBeTrue)
+
+			updatedOrder, err := _db.GetOrderByID(ctx, order.Id)
+			So(err, ShouldBeNil)
+			So(updatedOrder.Status, ShouldEqual, int8(constants.OrderStatusCancelledCode))
+		})
+
+		Convey("TestOrderDB_CancelUnpaidOrder_paid", func() {
+			err := _db.UpdateOrderStatus(ctx, order.Id, constants.OrderStatusPaidCode)
+			So(err, ShouldBeNil)
+
+			// 订单在读取支付状态之后被支付, 不能再被覆盖为已取消
+			cancelled, err := _db.CancelUnpaidOrder(ctx, order.Id)
+			So(err, ShouldBeNil)
+			So(cancelled, ShouldBeFalse)
+
+			updatedOrder, err := _db.GetOrderByID(ctx, order.Id)
+			So(err, ShouldBeNil)
+			So(updatedOrder.Status, ShouldEqual, int8(constants.OrderStatusPaidCode))
+		})
+
+		Convey("TestOrderDB_CancelUnpaidOrder_cleanup", func() {
+			err := _db.DeleteOrder(ctx, order.Id)
+			So(err, ShouldBeNil)
+		})
+	})
+}
+
 func TestOrderDB_UpdateOrderAddress(t *testing.T) {
 	if !initConfig() {
 		return
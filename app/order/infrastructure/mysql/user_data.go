@@ -0,0 +1,80 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"context"
+
+	"github.com/samber/lo"
+
+	"github.com/west2-online/DomTok/app/order/domain/model"
+	"github.com/west2-online/DomTok/pkg/constants"
+	"github.com/west2-online/DomTok/pkg/errno"
+)
+
+// GetOrdersAndGoodsByUserID 获取用户的全部订单及订单商品, 包括已经被用户删除的订单
+func (db *orderDB) GetOrdersAndGoodsByUserID(ctx context.Context, userID int64) ([]*model.Order, []*model.OrderGoods, error) {
+	var orders []*Order
+	if err := db.client.WithContext(ctx).Unscoped().
+		Where("user_id = ?", userID).
+		Order("ordered_at DESC").
+		Find(&orders).Error; err != nil {
+		return nil, nil, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to get user orders: %v", err)
+	}
+	if len(orders) == 0 {
+		return []*model.Order{}, []*model.OrderGoods{}, nil
+	}
+
+	var goods []*OrderGoods
+	orderIDs := lo.Map(orders, func(item *Order, index int) int64 {
+		return item.Id
+	})
+	if err := db.client.WithContext(ctx).Table(constants.OrderGoodsTableName).
+		Where("order_id IN ?", orderIDs).
+		Find(&goods).Error; err != nil {
+		return nil, nil, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to get user order goods: %v", err)
+	}
+
+	os := lo.Map(orders, func(item *Order, index int) *model.Order {
+		return db.order2Model(item)
+	})
+	gs := lo.Map(goods, func(item *OrderGoods, index int) *model.OrderGoods {
+		return db.goods2Model(item)
+	})
+	return os, gs, nil
+}
+
+// HasUnfinishedOrder 检查用户是否还有待支付或已支付但未完成的订单
+func (db *orderDB) HasUnfinishedOrder(ctx context.Context, userID int64) (bool, error) {
+	var count int64
+	if err := db.client.WithContext(ctx).Model(&Order{}).
+		Where("user_id = ? AND status IN ?", userID, []int{constants.OrderStatusUnpaidCode, constants.OrderStatusPaidCode}).
+		Count(&count).Error; err != nil {
+		return false, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to count unfinished orders: %v", err)
+	}
+	return count > 0, nil
+}
+
+// AnonymizeUserOrders 清除用户订单中的收货信息, 订单本身和金额作为交易记录需要保留
+func (db *orderDB) AnonymizeUserOrders(ctx context.Context, userID int64) error {
+	if err := db.client.WithContext(ctx).Unscoped().Model(&Order{}).
+		Where("user_id = ?", userID).
+		Update("address_info", "").Error; err != nil {
+		return errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to anonymize user orders: %v", err)
+	}
+	return nil
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/west2-online/DomTok/pkg/constants"
 	"github.com/west2-online/DomTok/pkg/errno"
 	"github.com/west2-online/DomTok/pkg/logger"
+	"github.com/west2-online/DomTok/pkg/push"
 )
 
 type orderCache struct {
@@ -99,6 +100,18 @@ func (cache *orderCache) DeletePaymentStatus(ctx context.Context, orderID int64)
 	return nil
 }
 
+// PublishPushEvent 通过 redis 的发布订阅把订单状态变化推送给所有网关实例
+func (cache *orderCache) PublishPushEvent(ctx context.Context, e *push.Event) error {
+	payload, err := push.Encode(e)
+	if err != nil {
+		return errno.NewErrNo(errno.InternalServiceErrorCode, err.Error())
+	}
+	if err = cache.client.Publish(ctx, constants.RedisPushChannel, payload).Err(); err != nil {
+		return errno.NewErrNo(errno.InternalRedisErrorCode, fmt.Sprintf("failed to publish push event: %v", err))
+	}
+	return nil
+}
+
 func (cache *orderCache) loadUpdateLUAScript() {
 	sha1, err := cache.client.ScriptLoad(context.Background(), constants.OrderUpdatePaymentStatusLuaScript).Result()
 	if err != nil {
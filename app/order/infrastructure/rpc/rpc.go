@@ -18,6 +18,7 @@ package rpc
 
 import (
 	"context"
+	"fmt"
 	"math"
 
 	"github.com/samber/lo"
@@ -28,6 +29,7 @@ import (
 	"github.com/west2-online/DomTok/kitex_gen/commodity"
 	"github.com/west2-online/DomTok/kitex_gen/commodity/commodityservice"
 	kmodel "github.com/west2-online/DomTok/kitex_gen/model"
+	"github.com/west2-online/DomTok/kitex_gen/user"
 	"github.com/west2-online/DomTok/kitex_gen/user/userservice"
 	"github.com/west2-online/DomTok/pkg/utils"
 )
@@ -41,8 +43,15 @@ func NewOrderRpcImpl(u userservice.Client, c commodityservice.Client) repository
 	return &orderRpcImpl{u, c}
 }
 
+// GetAddressInfo 获取地址的简略信息, user 服务会校验该地址是否属于当前登录用户
 func (rpc *orderRpcImpl) GetAddressInfo(ctx context.Context, addressId int64) (string, error) {
-	return "", nil
+	resp, err := rpc.user.GetAddress(ctx, &user.GetAddressRequest{AddressId: addressId})
+	if err = utils.ProcessRpcError("user.GetAddress", resp, err); err != nil {
+		return "", err
+	}
+
+	addr := resp.GetAddress()
+	return fmt.Sprintf("%s %s %s %s %s", addr.Province, addr.City, addr.Detail, addr.RecipientName, addr.Phone), nil
 }
 
 func (rpc *orderRpcImpl) QueryGoodsInfo(ctx context.Context, goods []*model.BaseOrderGoods) ([]*model.OrderGoods, error) {
@@ -68,7 +77,7 @@ func (rpc *orderRpcImpl) QueryGoodsInfo(ctx context.Context, goods []*model.Base
 		purchaseCount := goods[index].PurchaseQuantity
 		return &model.OrderGoods{
 			// OrderID:            0, 后续由 service 模块赋值
-			MerchantID:         item.CreatorID,
+			MerchantID:         item.ShopID,
 			GoodsID:            item.SpuID,
 			GoodsName:          item.Name,
 			StyleID:            item.SkuID,
@@ -91,6 +100,22 @@ func (rpc *orderRpcImpl) QueryGoodsInfo(ctx context.Context, goods []*model.Base
 	return orderGoods, nil
 }
 
+// GetShopNames 批量获取店铺名称, 返回 店铺ID -> 店铺名称 的映射
+func (rpc *orderRpcImpl) GetShopNames(ctx context.Context, shopIDs []int64) (map[int64]string, error) {
+	shopIDs = lo.Uniq(shopIDs)
+	if len(shopIDs) == 0 {
+		return map[int64]string{}, nil
+	}
+
+	resp, err := rpc.user.GetShops(ctx, &user.GetShopsReq{ShopIDs: shopIDs})
+	if err = utils.ProcessRpcError("user.GetShops", resp, err); err != nil {
+		return nil, err
+	}
+	return lo.SliceToMap(resp.GetShops(), func(item *kmodel.ShopInfo) (int64, string) {
+		return item.ShopID, item.Name
+	}), nil
+}
+
 // WithholdSkuStock 预扣除商品数量
 func (rpc *orderRpcImpl) WithholdSkuStock(ctx context.Context, stocks *model.OrderStock) error {
 	infos := stockToSkuBuyInfo(stocks)
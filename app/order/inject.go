@@ -17,6 +17,8 @@ limitations under the License.
 package order
 
 import (
+	"errors"
+
 	"github.com/west2-online/DomTok/app/order/controllers/rpc"
 	"github.com/west2-online/DomTok/app/order/domain/service"
 	"github.com/west2-online/DomTok/app/order/infrastructure/locker"
@@ -29,8 +31,12 @@ import (
 	"github.com/west2-online/DomTok/kitex_gen/order"
 	"github.com/west2-online/DomTok/pkg/base/client"
 	"github.com/west2-online/DomTok/pkg/constants"
+	"github.com/west2-online/DomTok/pkg/health"
+	"github.com/west2-online/DomTok/pkg/kafka"
 	"github.com/west2-online/DomTok/pkg/logger"
+	"github.com/west2-online/DomTok/pkg/shutdown"
 	"github.com/west2-online/DomTok/pkg/utils"
+	"github.com/west2-online/DomTok/pkg/webhook"
 )
 
 // InjectOrderHandler 用于依赖注入
@@ -61,8 +67,10 @@ func InjectOrderHandler() order.OrderService {
 	}
 	rpcIns := rpcimpl.NewOrderRpcImpl(*uClient, *cClient)
 
-	// 5. 初始化 mq
+	// 5. 初始化 mq, 商家 webhook 事件通过 kafka 发布给用户服务
 	mqIns := mq.NewRocketmq()
+	kafkaIns := kafka.NewKafkaInstance()
+	webhookMQ := mq.NewWebhookMQ(kafkaIns)
 
 	// 6. 初始化 cache
 	redisClient, err := client.NewRedisClient(constants.RedisDBOrder)
@@ -75,8 +83,20 @@ func InjectOrderHandler() order.OrderService {
 	lock := locker.NewLocker(client.InitRedSync(redisClient))
 
 	// 8. 初始化 service 和 usecase
-	svc := service.NewOrderService(db, sf, rpcIns, mqIns, cacheIns, lock)
+	svc := service.NewOrderService(db, sf, rpcIns, mqIns, cacheIns, lock, webhook.PublisherFunc(webhookMQ.SendWebhookEvent))
 	uc := usecase.NewOrderCase(db, svc, rpcIns)
 
+	// 9. 注册健康检查项, 由 health.Serve 对外暴露
+	health.Register(constants.HealthCheckMySQL, health.MySQL(gormDB))
+	health.Register(constants.HealthCheckRedis, health.Redis(redisClient))
+	health.Register(constants.HealthCheckKafka, client.PingKafka)
+	health.Register(constants.HealthCheckRocketMQ, health.TCP(config.Rocketmq.NameSrvAddr))
+
+	// 10. 退出时逆序执行: 先停止消费并刷新 kafka writer, 再关闭连接池
+	shutdown.Register("mysql", func() error { return client.CloseMySQL(gormDB) })
+	shutdown.Register("redis", redisClient.Close)
+	shutdown.Register("rocketmq", func() error { return errors.Join(mqIns.Shutdown()...) })
+	shutdown.Register("kafka", func() error { kafkaIns.Close(); return nil })
+
 	return rpc.NewOrderHandler(uc)
 }
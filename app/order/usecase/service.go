@@ -18,6 +18,9 @@ package usecase
 
 import (
 	"context"
+	"fmt"
+
+	"github.com/samber/lo"
 
 	"github.com/west2-online/DomTok/app/order/domain/model"
 	basecontext "github.com/west2-online/DomTok/pkg/base/context"
@@ -65,7 +68,14 @@ func (uc *useCase) ViewOrderList(ctx context.Context, page, size int32) ([]*mode
 		return nil, nil, 0, errno.NewErrNo(errno.AuthInvalidCode, "invalid user id")
 	}
 
-	return uc.svc.ViewOrderList(ctx, userID, page, size)
+	orders, goods, total, err := uc.svc.ViewOrderList(ctx, userID, page, size)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	if err = uc.fillMerchantName(ctx, lo.Flatten(goods)); err != nil {
+		return nil, nil, 0, err
+	}
+	return orders, goods, total, nil
 }
 
 // ViewOrder 获取订单详情
@@ -78,10 +88,27 @@ func (uc *useCase) ViewOrder(ctx context.Context, orderID int64) (*model.Order,
 	if err != nil {
 		return nil, nil, err
 	}
+	if err = uc.fillMerchantName(ctx, orderGoods); err != nil {
+		return nil, nil, err
+	}
 
 	return order, orderGoods, nil
 }
 
+// fillMerchantName 填充订单商品的店铺名称, 店铺名称可能会变化, 所以没有在下单时落库
+func (uc *useCase) fillMerchantName(ctx context.Context, goods []*model.OrderGoods) error {
+	names, err := uc.rpc.GetShopNames(ctx, lo.Map(goods, func(item *model.OrderGoods, index int) int64 {
+		return item.MerchantID
+	}))
+	if err != nil {
+		return fmt.Errorf("usecase.fillMerchantName failed: %w", err)
+	}
+	for _, g := range goods {
+		g.MerchantName = names[g.MerchantID]
+	}
+	return nil
+}
+
 // CancelOrder 取消订单
 func (uc *useCase) CancelOrder(ctx context.Context, orderID int64) error {
 	// 1. 检查订单是否存在
@@ -105,7 +132,11 @@ func (uc *useCase) CancelOrder(ctx context.Context, orderID int64) error {
 	}
 
 	// 4. 更新订单状态为已取消
-	return uc.db.UpdateOrderStatus(ctx, orderID, constants.OrderStatusCancelledCode)
+	if err = uc.db.UpdateOrderStatus(ctx, orderID, constants.OrderStatusCancelledCode); err != nil {
+		return err
+	}
+	uc.svc.PushOrderStatus(ctx, orderID, constants.PushCancelReasonUser)
+	return nil
 }
 
 // ChangeDeliverAddress 更改配送地址
@@ -193,3 +224,8 @@ func (uc *useCase) GetOrderPaymentAmount(ctx context.Context, orderID int64) (fl
 	}
 	return utils.DecimalFloat64(&o.PaymentAmount), nil
 }
+
+// GetOrderMerchants 返回订单中商品所属的店铺, 由 payment 服务在发布退款的 webhook 事件时调用
+func (uc *useCase) GetOrderMerchants(ctx context.Context, orderID int64) ([]int64, error) {
+	return uc.svc.GetOrderMerchantIDs(ctx, orderID)
+}
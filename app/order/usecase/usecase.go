@@ -38,6 +38,10 @@ type OrderUseCase interface {
 	OrderPaymentSuccess(ctx context.Context, req *model.PaymentResult) error
 	OrderPaymentCancel(ctx context.Context, req *model.PaymentResult) error
 	GetOrderPaymentAmount(ctx context.Context, orderID int64) (float64, error)
+	GetOrderMerchants(ctx context.Context, orderID int64) ([]int64, error)
+
+	ExportUserData(ctx context.Context, uid int64) (string, error)
+	EraseUserData(ctx context.Context, uid int64) error
 }
 
 // useCase 实现了 OrderUseCase 接口
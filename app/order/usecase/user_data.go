@@ -0,0 +1,54 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package usecase
+
+import (
+	"context"
+
+	"github.com/bytedance/sonic"
+
+	"github.com/west2-online/DomTok/app/order/domain/model"
+	"github.com/west2-online/DomTok/pkg/errno"
+)
+
+// ExportUserData 导出用户的全部订单, 该方法由 user 服务调用
+func (uc *useCase) ExportUserData(ctx context.Context, uid int64) (string, error) {
+	orders, goods, err := uc.db.GetOrdersAndGoodsByUserID(ctx, uid)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := sonic.MarshalString(&model.UserData{Orders: orders, Goods: goods})
+	if err != nil {
+		return "", errno.Errorf(errno.InternalServiceErrorCode, "usecase.ExportUserData marshal failed: %v", err)
+	}
+	return data, nil
+}
+
+// EraseUserData 注销账号时清除订单中的收货信息, 该方法由 user 服务调用
+// 还有未完成的订单时不允许注销, 否则商家无法发货
+func (uc *useCase) EraseUserData(ctx context.Context, uid int64) error {
+	unfinished, err := uc.db.HasUnfinishedOrder(ctx, uid)
+	if err != nil {
+		return err
+	}
+	if unfinished {
+		return errno.NewErrNo(errno.ServiceOrderStatusInvalid, "there are unfinished orders, please complete or cancel them first")
+	}
+
+	return uc.db.AnonymizeUserOrders(ctx, uid)
+}
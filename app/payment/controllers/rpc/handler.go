@@ -97,3 +97,25 @@ func (handler *PaymentHandler) RefundReview(ctx context.Context, request *paymen
 	r.Base = base.BuildBaseResp(err)
 	return
 }
+
+func (handler *PaymentHandler) ExportUserData(ctx context.Context, req *payment.ExportUserDataRequest) (r *payment.ExportUserDataResponse, err error) {
+	r = new(payment.ExportUserDataResponse)
+	r.Data, err = handler.useCase.ExportUserData(ctx, req.GetUid())
+	if err != nil {
+		return
+	}
+	r.Base = base.BuildBaseResp(err)
+	return
+}
+
+func (handler *PaymentHandler) QueryPaymentStatus(ctx context.Context, req *payment.PaymentStatusRequest) (r *payment.PaymentStatusResponse, err error) {
+	r = new(payment.PaymentStatusResponse)
+	p, refund, err := handler.useCase.QueryPaymentStatus(ctx, req.GetOrderID())
+	if err != nil {
+		return
+	}
+	r.Base = base.BuildBaseResp(err)
+	r.Payment = pack.BuildPaymentStatus(p)
+	r.Refund = pack.BuildRefundStatus(refund)
+	return
+}
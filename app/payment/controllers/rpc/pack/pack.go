@@ -17,6 +17,7 @@ limitations under the License.
 package pack
 
 import (
+	paymentModel "github.com/west2-online/DomTok/app/payment/domain/model"
 	"github.com/west2-online/DomTok/kitex_gen/model"
 )
 
@@ -34,3 +35,29 @@ func BuildRefundTokenInfo(refundID int64, status int64) *model.RefundResponseInf
 		Status:   status,
 	}
 }
+
+// BuildPaymentStatus 没有支付单时返回 nil, 对应 idl 中的 optional 字段
+func BuildPaymentStatus(p *paymentModel.PaymentOrder) *model.PaymentStatusInfo {
+	if p == nil {
+		return nil
+	}
+	amount, _ := p.Amount.Float64()
+	return &model.PaymentStatusInfo{
+		PaymentID: p.ID,
+		Status:    p.Status,
+		Amount:    amount,
+	}
+}
+
+func BuildRefundStatus(r *paymentModel.PaymentRefund) *model.RefundStatusInfo {
+	if r == nil {
+		return nil
+	}
+	amount, _ := r.RefundAmount.Float64()
+	return &model.RefundStatusInfo{
+		RefundID:     r.ID,
+		Status:       r.Status,
+		RefundAmount: amount,
+		RefundReason: r.RefundReason,
+	}
+}
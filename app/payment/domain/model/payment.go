@@ -24,38 +24,38 @@ import (
 
 // PaymentOrder 支付订单表（删除了三个时间）
 type PaymentOrder struct {
-	ID                        int64           `gorm:"primaryKey;autoIncrement;comment:支付订单的唯一标识"`
-	OrderID                   int64           `gorm:"not null;comment:商户订单号"`
-	UserID                    int64           `gorm:"not null;comment:用户的唯一标识"`
-	Amount                    decimal.Decimal `gorm:"type:decimal(14,4);not null;comment:订单总金额"`
-	Status                    int64           `gorm:"not null;default:0;comment:支付状态：0-待支付，1-处理中，2-成功支付，3-支付失败"`
-	MaskedCreditCardNumber    string          `gorm:"size:19;comment:信用卡号（仅存储掩码，如 **** **** **** 1234）"`
-	CreditCardExpirationYear  int64           `gorm:"comment:信用卡到期年"`
-	CreditCardExpirationMonth int64           `gorm:"comment:信用卡到期月"`
-	Description               string          `gorm:"size:255;comment:订单描述信息"`
+	ID                        int64           `gorm:"primaryKey;autoIncrement;comment:支付订单的唯一标识" json:"id"`
+	OrderID                   int64           `gorm:"not null;comment:商户订单号" json:"order_id"`
+	UserID                    int64           `gorm:"not null;comment:用户的唯一标识" json:"user_id"`
+	Amount                    decimal.Decimal `gorm:"type:decimal(14,4);not null;comment:订单总金额" json:"amount"`
+	Status                    int64           `gorm:"not null;default:0;comment:支付状态：0-待支付，1-处理中，2-成功支付，3-支付失败" json:"status"`
+	MaskedCreditCardNumber    string          `gorm:"size:19;comment:信用卡号（仅存储掩码，如 **** **** **** 1234）" json:"masked_credit_card_number"`
+	CreditCardExpirationYear  int64           `gorm:"comment:信用卡到期年" json:"credit_card_expiration_year"`
+	CreditCardExpirationMonth int64           `gorm:"comment:信用卡到期月" json:"credit_card_expiration_month"`
+	Description               string          `gorm:"size:255;comment:订单描述信息" json:"description"`
 }
 
 // PaymentRefund 退款表
 type PaymentRefund struct {
-	ID                        int64           `gorm:"primaryKey;autoIncrement;comment:支付退款的唯一标识"`
-	OrderID                   int64           `gorm:"size:64;not null;comment:关联的商户订单号"`
-	UserID                    int64           `gorm:"not null;comment:用户的唯一标识"`
-	RefundAmount              decimal.Decimal `gorm:"type:decimal(15,4);not null;comment:退款金额，单位为元"`
-	RefundReason              string          `gorm:"size:255;comment:退款原因"`
-	Status                    int64           `gorm:"not null;default:0;comment:退款状态：0-待处理，1-处理中，2-成功退款，3-退款失败"`
-	MaskedCreditCardNumber    string          `gorm:"size:19;comment:信用卡号（仅存储掩码，如 **** **** **** 1234）"`
-	CreditCardExpirationYear  int64           `gorm:"comment:信用卡到期年"`
-	CreditCardExpirationMonth int64           `gorm:"comment:信用卡到期月"`
+	ID                        int64           `gorm:"primaryKey;autoIncrement;comment:支付退款的唯一标识" json:"id"`
+	OrderID                   int64           `gorm:"size:64;not null;comment:关联的商户订单号" json:"order_id"`
+	UserID                    int64           `gorm:"not null;comment:用户的唯一标识" json:"user_id"`
+	RefundAmount              decimal.Decimal `gorm:"type:decimal(15,4);not null;comment:退款金额，单位为元" json:"refund_amount"`
+	RefundReason              string          `gorm:"size:255;comment:退款原因" json:"refund_reason"`
+	Status                    int64           `gorm:"not null;default:0;comment:退款状态：0-待处理，1-处理中，2-成功退款，3-退款失败" json:"status"`
+	MaskedCreditCardNumber    string          `gorm:"size:19;comment:信用卡号（仅存储掩码，如 **** **** **** 1234）" json:"masked_credit_card_number"`
+	CreditCardExpirationYear  int64           `gorm:"comment:信用卡到期年" json:"credit_card_expiration_year"`
+	CreditCardExpirationMonth int64           `gorm:"comment:信用卡到期月" json:"credit_card_expiration_month"`
 }
 
 // PaymentLedger 流水信息表
 type PaymentLedger struct {
-	ID              int64           `gorm:"primaryKey;autoIncrement;comment:流水ID"`
-	ReferenceID     int64           `gorm:"not null;comment:关联的支付订单或退款订单ID"`
-	UserID          int64           `gorm:"not null;comment:用户ID"`
-	Amount          decimal.Decimal `gorm:"type:decimal(15,4);not null;comment:交易金额（正数表示收入，负数表示支出）"`
-	TransactionType int64           `gorm:"not null;comment:交易类型：1-支付，2-退款，3-手续费，4-调整"`
-	Status          int64           `gorm:"not null;default:0;comment:交易状态：0-待处理，1-成功，2-失败"`
+	ID              int64           `gorm:"primaryKey;autoIncrement;comment:流水ID" json:"id"`
+	ReferenceID     int64           `gorm:"not null;comment:关联的支付订单或退款订单ID" json:"reference_id"`
+	UserID          int64           `gorm:"not null;comment:用户ID" json:"user_id"`
+	Amount          decimal.Decimal `gorm:"type:decimal(15,4);not null;comment:交易金额（正数表示收入，负数表示支出）" json:"amount"`
+	TransactionType int64           `gorm:"not null;comment:交易类型：1-支付，2-退款，3-手续费，4-调整" json:"transaction_type"`
+	Status          int64           `gorm:"not null;default:0;comment:交易状态：0-待处理，1-成功，2-失败" json:"status"`
 }
 
 func (p *PaymentOrder) ToLedger() *PaymentLedger {
@@ -75,3 +75,10 @@ func (p *PaymentRefund) ToLedger() *PaymentLedger {
 		TransactionType: constants.LedgerTransactionTypeRefundCode,
 	}
 }
+
+// UserData 是用户在支付服务中持有的全部数据, 用于个人数据导出
+type UserData struct {
+	Payments []*PaymentOrder  `json:"payments"`
+	Refunds  []*PaymentRefund `json:"refunds"`
+	Ledgers  []*PaymentLedger `json:"ledgers"`
+}
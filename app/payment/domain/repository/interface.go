@@ -21,6 +21,9 @@ import (
 	"time"
 
 	"github.com/west2-online/DomTok/app/payment/domain/model"
+	"github.com/west2-online/DomTok/pkg/audit"
+	"github.com/west2-online/DomTok/pkg/push"
+	"github.com/west2-online/DomTok/pkg/webhook"
 )
 
 type PaymentDB interface {
@@ -34,6 +37,7 @@ type PaymentDB interface {
 	GetRefundInfoByOrderID(ctx context.Context, orderID int64) (*model.PaymentRefund, error)
 	UpdateRefundStatusByOrderIDAndStatus(ctx context.Context, orderID int64, prevStatus, nextStatus int) error
 	UpdateRefundStatusToSuccessAndCreateLedgerAsTransaction(ctx context.Context, refund *model.PaymentRefund) error
+	GetUserData(ctx context.Context, uid int64) (*model.UserData, error)
 }
 type PaymentRedis interface {
 	SetPaymentToken(ctx context.Context, key string, value interface{}, expiration time.Duration) error
@@ -43,6 +47,7 @@ type PaymentRedis interface {
 	SetRefundToken(ctx context.Context, key string, token string, duration time.Duration) error
 	CheckAndDelPaymentToken(ctx context.Context, key string, value string) (exist bool, err error)
 	GetTTLAndDelPaymentToken(ctx context.Context, key string, value string) (exist bool, ttl time.Duration, err error)
+	PublishPushEvent(ctx context.Context, e *push.Event) error
 	// GetPaymentToken(ctx context.Context, key string) (string, error)
 }
 
@@ -52,5 +57,10 @@ type PaymentRPC interface {
 	OrderPaymentCancel(ctx context.Context, orderID int64, paymentAt int64, paymentStyle string) error
 	OrderPaymentSuccess(ctx context.Context, orderID int64, paymentAt int64, paymentStyle string) error
 	GetOrderPaymentAmount(ctx context.Context, orderID int64) (float64, error)
-	GetUserInfo(ctx context.Context, uid int64) (bool, error)
+	GetOrderMerchantIDs(ctx context.Context, orderID int64) ([]int64, error)
+}
+
+type PaymentMQ interface {
+	SendAuditEvent(ctx context.Context, e *audit.Event) error
+	SendWebhookEvent(ctx context.Context, e *webhook.Event) error
 }
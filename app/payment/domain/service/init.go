@@ -26,9 +26,12 @@ type PaymentService struct {
 	sf    *utils.Snowflake
 	redis repository.PaymentRedis
 	rpc   repository.PaymentRPC
+	mq    repository.PaymentMQ
 }
 
-func NewPaymentService(db repository.PaymentDB, sf *utils.Snowflake, redis repository.PaymentRedis, rpc repository.PaymentRPC) *PaymentService {
+func NewPaymentService(db repository.PaymentDB, sf *utils.Snowflake, redis repository.PaymentRedis, rpc repository.PaymentRPC,
+	mq repository.PaymentMQ,
+) *PaymentService {
 	if db == nil {
 		panic("paymentService`s db should not be nil")
 	}
@@ -41,11 +44,15 @@ func NewPaymentService(db repository.PaymentDB, sf *utils.Snowflake, redis repos
 	if rpc == nil {
 		panic("paymentService`s rpc should not be nil")
 	}
+	if mq == nil {
+		panic("paymentService`s mq should not be nil")
+	}
 	svc := &PaymentService{
 		db:    db,
 		sf:    sf,
 		redis: redis,
 		rpc:   rpc,
+		mq:    mq,
 	}
 	return svc
 }
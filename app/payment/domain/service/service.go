@@ -27,9 +27,14 @@ import (
 	"github.com/shopspring/decimal"
 
 	"github.com/west2-online/DomTok/app/payment/domain/model"
+	"github.com/west2-online/DomTok/pkg/audit"
 	loginData "github.com/west2-online/DomTok/pkg/base/context"
 	paymentStatus "github.com/west2-online/DomTok/pkg/constants"
 	"github.com/west2-online/DomTok/pkg/errno"
+	"github.com/west2-online/DomTok/pkg/logger"
+	"github.com/west2-online/DomTok/pkg/push"
+	"github.com/west2-online/DomTok/pkg/rbac"
+	"github.com/west2-online/DomTok/pkg/webhook"
 )
 
 // CreatePaymentInfo sf可以生成id,详见user/domain/service/service.go
@@ -181,12 +186,9 @@ func (svc *PaymentService) GetRefundStatusMsg(code int8) string {
 	return paymentStatus.GetRefundStatus(code)
 }
 
-func (svc *PaymentService) CheckAdminPermission(ctx context.Context, uid int64) (bool, error) {
-	res, err := svc.rpc.GetUserInfo(ctx, uid)
-	if err != nil {
-		return false, fmt.Errorf("failed to get user info: %w", err)
-	}
-	return res, nil
+// CheckAdminPermission 检查用户是否有审核退款的权限, 权限由网关从 token 中解析后通过 metainfo 透传过来
+func (svc *PaymentService) CheckAdminPermission(ctx context.Context) bool {
+	return rbac.HasPermission(ctx, paymentStatus.PermissionRefundReview)
 }
 
 func (svc *PaymentService) CheckAndDelPaymentToken(ctx context.Context, token string, userID int64, orderID int64) (bool, error) {
@@ -241,3 +243,42 @@ func (svc *PaymentService) CancelOrder(ctx context.Context, orderID int64, payme
 func (svc *PaymentService) ConfirmOrder(ctx context.Context, orderID int64, paymentAt int64, paymentStyle string) error {
 	return svc.rpc.OrderPaymentSuccess(ctx, orderID, paymentAt, paymentStyle)
 }
+
+// RecordRefundReview 发布退款审核的审计事件, before 和 after 分别是审核前后的退款信息
+func (svc *PaymentService) RecordRefundReview(ctx context.Context, before, after *model.PaymentRefund) {
+	audit.Record(ctx, audit.PublisherFunc(svc.mq.SendAuditEvent),
+		paymentStatus.AuditActionRefundReview, audit.Target("refund", before.ID), before, after)
+}
+
+// PublishRefundReview 把退款审核结果推送给申请退款的用户, 并给订单涉及的每个店铺发布 webhook 事件, 失败时只记录日志
+func (svc *PaymentService) PublishRefundReview(ctx context.Context, refund *model.PaymentRefund, passed bool) {
+	e := push.NewRefundResultEvent(refund.UserID, refund.OrderID, refund.ID, int8(refund.Status))
+	if err := svc.redis.PublishPushEvent(ctx, e); err != nil {
+		logger.Errorf("PaymentService.PublishRefundReview: push refund %d failed: %v", refund.ID, err)
+	}
+
+	shopIDs, err := svc.rpc.GetOrderMerchantIDs(ctx, refund.OrderID)
+	if err != nil {
+		logger.Errorf("PaymentService.PublishRefundReview: get merchants of order %d failed: %v", refund.OrderID, err)
+		return
+	}
+	eventType := paymentStatus.WebhookEventRefundRejected
+	if passed {
+		eventType = paymentStatus.WebhookEventRefundApproved
+	}
+	amount, _ := refund.RefundAmount.Float64()
+	for _, shopID := range shopIDs {
+		webhook.Emit(ctx, webhook.PublisherFunc(svc.mq.SendWebhookEvent), eventType, shopID, func(eventID string, createdAt int64) any {
+			return &webhook.RefundData{
+				EventID:      eventID,
+				Type:         eventType,
+				OrderID:      refund.OrderID,
+				ShopID:       shopID,
+				RefundID:     refund.ID,
+				RefundAmount: amount,
+				RefundReason: refund.RefundReason,
+				CreatedAt:    createdAt,
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mq
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/west2-online/DomTok/app/payment/domain/repository"
+	"github.com/west2-online/DomTok/pkg/audit"
+	"github.com/west2-online/DomTok/pkg/constants"
+	"github.com/west2-online/DomTok/pkg/errno"
+	"github.com/west2-online/DomTok/pkg/kafka"
+	"github.com/west2-online/DomTok/pkg/webhook"
+)
+
+type PaymentMQ struct {
+	client *kafka.Kafka
+}
+
+func NewPaymentMQ(client *kafka.Kafka) repository.PaymentMQ {
+	return &PaymentMQ{client: client}
+}
+
+// SendAuditEvent 发布审计事件, 由用户服务消费并持久化
+func (p *PaymentMQ) SendAuditEvent(ctx context.Context, e *audit.Event) error {
+	v, err := audit.Encode(e)
+	if err != nil {
+		return errno.Errorf(errno.InternalServiceErrorCode, "PaymentMQ.SendAuditEvent failed: %v", err)
+	}
+
+	msg := &kafka.Message{
+		K: []byte(e.Target), // 同一对象的事件落在同一分区, 保证顺序
+		V: v,
+	}
+	if errs := p.client.Send(ctx, constants.KafkaAuditTopic, []*kafka.Message{msg}); len(errs) > 0 {
+		return errno.Errorf(errno.InternalKafkaErrorCode, "PaymentMQ.SendAuditEvent failed: %v", errors.Join(errs...))
+	}
+	return nil
+}
+
+// SendWebhookEvent 发布商家 webhook 事件, 由用户服务按商家的订阅投递
+func (p *PaymentMQ) SendWebhookEvent(ctx context.Context, e *webhook.Event) error {
+	v, err := webhook.Encode(e)
+	if err != nil {
+		return errno.Errorf(errno.InternalServiceErrorCode, "PaymentMQ.SendWebhookEvent failed: %v", err)
+	}
+
+	msg := &kafka.Message{
+		K: []byte(strconv.FormatInt(e.ShopID, 10)), // 同一店铺的事件落在同一分区, 保证顺序
+		V: v,
+	}
+	if errs := p.client.Send(ctx, constants.KafkaWebhookTopic, []*kafka.Message{msg}); len(errs) > 0 {
+		return errno.Errorf(errno.InternalKafkaErrorCode, "PaymentMQ.SendWebhookEvent failed: %v", errors.Join(errs...))
+	}
+	return nil
+}
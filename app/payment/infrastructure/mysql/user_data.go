@@ -0,0 +1,85 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"context"
+
+	"github.com/samber/lo"
+
+	"github.com/west2-online/DomTok/app/payment/domain/model"
+	"github.com/west2-online/DomTok/pkg/constants"
+	"github.com/west2-online/DomTok/pkg/errno"
+)
+
+// GetUserData 查询用户的全部支付单, 退款单和流水, 用于个人数据导出
+func (db *paymentDB) GetUserData(ctx context.Context, uid int64) (*model.UserData, error) {
+	var payments []*PaymentOrder
+	if err := db.client.WithContext(ctx).Table(constants.PaymentTableName).
+		Where("user_id = ?", uid).Order("id DESC").Find(&payments).Error; err != nil {
+		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to query user payment orders: %v", err)
+	}
+	var refunds []*PaymentRefund
+	if err := db.client.WithContext(ctx).Table(constants.PaymentRefundTableName).
+		Where("user_id = ?", uid).Order("id DESC").Find(&refunds).Error; err != nil {
+		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to query user refund orders: %v", err)
+	}
+	var ledgers []*PaymentLedger
+	if err := db.client.WithContext(ctx).Table(constants.PaymentLedgerTableName).
+		Where("user_id = ?", uid).Order("id DESC").Find(&ledgers).Error; err != nil {
+		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to query user ledgers: %v", err)
+	}
+
+	return &model.UserData{
+		Payments: lo.Map(payments, func(p *PaymentOrder, _ int) *model.PaymentOrder {
+			return &model.PaymentOrder{
+				ID:                        p.ID,
+				OrderID:                   p.OrderID,
+				UserID:                    p.UserID,
+				Amount:                    p.Amount,
+				Status:                    p.Status,
+				MaskedCreditCardNumber:    p.MaskedCreditCardNumber,
+				CreditCardExpirationYear:  p.CreditCardExpirationYear,
+				CreditCardExpirationMonth: p.CreditCardExpirationMonth,
+				Description:               p.Description,
+			}
+		}),
+		Refunds: lo.Map(refunds, func(r *PaymentRefund, _ int) *model.PaymentRefund {
+			return &model.PaymentRefund{
+				ID:                        r.ID,
+				OrderID:                   r.OrderID,
+				UserID:                    r.UserID,
+				RefundAmount:              r.RefundAmount,
+				RefundReason:              r.RefundReason,
+				Status:                    r.Status,
+				MaskedCreditCardNumber:    r.MaskedCreditCardNumber,
+				CreditCardExpirationYear:  r.CreditCardExpirationYear,
+				CreditCardExpirationMonth: r.CreditCardExpirationMonth,
+			}
+		}),
+		Ledgers: lo.Map(ledgers, func(l *PaymentLedger, _ int) *model.PaymentLedger {
+			return &model.PaymentLedger{
+				ID:              l.ID,
+				ReferenceID:     l.ReferenceID,
+				UserID:          l.UserID,
+				Amount:          l.Amount,
+				TransactionType: l.TransactionType,
+				Status:          l.Status,
+			}
+		}),
+	}, nil
+}
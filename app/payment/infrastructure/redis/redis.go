@@ -23,7 +23,9 @@ import (
 	"github.com/redis/go-redis/v9"
 
 	"github.com/west2-online/DomTok/app/payment/domain/repository"
+	"github.com/west2-online/DomTok/pkg/constants"
 	"github.com/west2-online/DomTok/pkg/errno"
+	"github.com/west2-online/DomTok/pkg/push"
 )
 
 type paymentRedis struct {
@@ -114,3 +116,15 @@ func (p *paymentRedis) GetTTLAndDelPaymentToken(ctx context.Context, key string,
 	}
 	return redisExist == 1, time.Duration(redisTTL) * time.Second, nil
 }
+
+// PublishPushEvent 通过 redis 的发布订阅把退款审核结果推送给所有网关实例
+func (p *paymentRedis) PublishPushEvent(ctx context.Context, e *push.Event) error {
+	payload, err := push.Encode(e)
+	if err != nil {
+		return errno.Errorf(errno.InternalServiceErrorCode, "failed to encode push event: %v", err)
+	}
+	if err = p.client.Publish(ctx, constants.RedisPushChannel, payload).Err(); err != nil {
+		return errno.Errorf(errno.InternalRedisErrorCode, "failed to publish push event: %v", err)
+	}
+	return nil
+}
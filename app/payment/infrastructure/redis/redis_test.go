@@ -25,6 +25,8 @@ import (
 	"github.com/smartystreets/goconvey/convey"
 	"golang.org/x/net/context"
 
+	"github.com/west2-online/DomTok/pkg/constants"
+	"github.com/west2-online/DomTok/pkg/push"
 	"github.com/west2-online/DomTok/pkg/utils"
 )
 
@@ -147,3 +149,27 @@ func TestPaymentRedis_GetTTLAndDelPaymentToken(t *testing.T) {
 		convey.So(exist, convey.ShouldBeFalse)
 	})
 }
+
+func TestPaymentRedis_PublishPushEvent(t *testing.T) {
+	if !utils.EnvironmentEnable() {
+		return
+	}
+	_EnvSetup()
+	ctx := context.Background()
+	mockey.PatchConvey("PublishPushEvent", t, func() {
+		sub := _cli.client.Subscribe(ctx, constants.RedisPushChannel)
+		defer sub.Close()
+		_, err := sub.Receive(ctx)
+		convey.So(err, convey.ShouldBeNil)
+
+		err = _cli.PublishPushEvent(ctx, push.NewRefundResultEvent(1, 2, 3, constants.RefundStatusSuccessCode))
+		convey.So(err, convey.ShouldBeNil)
+		msg, err := sub.ReceiveMessage(ctx)
+		convey.So(err, convey.ShouldBeNil)
+		e, err := push.Decode(msg.Payload)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(e.Type, convey.ShouldEqual, constants.PushEventRefundResult)
+		convey.So(e.Uid, convey.ShouldEqual, 1)
+		convey.So(e.RefundID, convey.ShouldEqual, 3)
+	})
+}
@@ -22,28 +22,16 @@ import (
 	"github.com/west2-online/DomTok/app/payment/domain/repository"
 	orderrpc "github.com/west2-online/DomTok/kitex_gen/order"
 	"github.com/west2-online/DomTok/kitex_gen/order/orderservice"
-	userrpc "github.com/west2-online/DomTok/kitex_gen/user"
-	"github.com/west2-online/DomTok/kitex_gen/user/userservice"
 	"github.com/west2-online/DomTok/pkg/constants"
 	"github.com/west2-online/DomTok/pkg/utils"
 )
 
 type paymentRPC struct {
 	order orderservice.Client
-	user  userservice.Client
 }
 
-func (rpc *paymentRPC) GetUserInfo(ctx context.Context, uid int64) (bool, error) {
-	infoReq := &userrpc.GetUserInfoReq{Uid: uid}
-	resp, err := rpc.user.GetUserInfo(ctx, infoReq)
-	if err = utils.ProcessRpcError("rpc.user.GetUserInfo", resp, err); err != nil {
-		return false, err
-	}
-	return resp.Info.Role == constants.UserAdministrator, nil
-}
-
-func NewPaymentRPC(order orderservice.Client, user userservice.Client) repository.PaymentRPC {
-	return &paymentRPC{order: order, user: user}
+func NewPaymentRPC(order orderservice.Client) repository.PaymentRPC {
+	return &paymentRPC{order: order}
 }
 
 func (rpc *paymentRPC) PaymentIsOrderExist(ctx context.Context, orderID int64) (orderExistInfo bool, err error) {
@@ -104,3 +92,14 @@ func (rpc *paymentRPC) GetOrderPaymentAmount(ctx context.Context, orderID int64)
 	}
 	return resp.GetAmount(), nil
 }
+
+func (rpc *paymentRPC) GetOrderMerchantIDs(ctx context.Context, orderID int64) ([]int64, error) {
+	req := &orderrpc.GetOrderMerchantsReq{
+		OrderID: orderID,
+	}
+	resp, err := rpc.order.GetOrderMerchants(ctx, req)
+	if err = utils.ProcessRpcError("rpc.order.GetOrderMerchants", resp, err); err != nil {
+		return nil, err
+	}
+	return resp.GetMerchantIDs(), nil
+}
@@ -19,6 +19,7 @@ package payment
 import (
 	"github.com/west2-online/DomTok/app/payment/controllers/rpc"
 	"github.com/west2-online/DomTok/app/payment/domain/service"
+	"github.com/west2-online/DomTok/app/payment/infrastructure/mq"
 	"github.com/west2-online/DomTok/app/payment/infrastructure/mysql"
 	"github.com/west2-online/DomTok/app/payment/infrastructure/redis"
 	orderRpcPkg "github.com/west2-online/DomTok/app/payment/infrastructure/rpc"
@@ -26,7 +27,10 @@ import (
 	"github.com/west2-online/DomTok/kitex_gen/payment"
 	"github.com/west2-online/DomTok/pkg/base/client"
 	"github.com/west2-online/DomTok/pkg/constants"
+	"github.com/west2-online/DomTok/pkg/health"
+	"github.com/west2-online/DomTok/pkg/kafka"
 	"github.com/west2-online/DomTok/pkg/logger"
+	"github.com/west2-online/DomTok/pkg/shutdown"
 	"github.com/west2-online/DomTok/pkg/utils"
 )
 
@@ -58,16 +62,23 @@ func InjectPaymentHandler() payment.PaymentService {
 	if err != nil {
 		logger.Fatalf("api.rpc.order InitOrderRPC failed, err is %v", err)
 	}
-	u, err := client.InitUserRPC()
-	if err != nil {
-		logger.Fatalf("api.rpc.user InitUserRPC failed, err is %v", err)
-	}
-	orderRpc := orderRpcPkg.NewPaymentRPC(*c, *u)
+	orderRpc := orderRpcPkg.NewPaymentRPC(*c)
+	kafkaIns := kafka.NewKafkaInstance()
 	// 初始化 Service，并传入 Redis
-	svc := service.NewPaymentService(db, sf, redisRepo, orderRpc)
+	svc := service.NewPaymentService(db, sf, redisRepo, orderRpc, mq.NewPaymentMQ(kafkaIns))
 
 	// 初始化 UseCase，并传入 Redis
 	uc := usecase.NewPaymentCase(db, svc, redisRepo, orderRpc)
 
+	// 注册健康检查项, 由 health.Serve 对外暴露
+	health.Register(constants.HealthCheckMySQL, health.MySQL(gormDB))
+	health.Register(constants.HealthCheckRedis, health.Redis(redisClient))
+	health.Register(constants.HealthCheckKafka, client.PingKafka)
+
+	// 退出时逆序执行: 先停止消费并刷新 kafka writer, 再关闭连接池
+	shutdown.Register("mysql", func() error { return client.CloseMySQL(gormDB) })
+	shutdown.Register("redis", redisClient.Close)
+	shutdown.Register("kafka", func() error { kafkaIns.Close(); return nil })
+
 	return rpc.NewPaymentHandler(uc)
 }
@@ -139,17 +139,12 @@ func (uc *paymentUseCase) RefundReview(ctx context.Context, orderID int64, passe
 	}
 
 	// 2. 用户是否存在
-	uid, err := uc.svc.GetUserID(ctx)
-	if err != nil {
+	if _, err = uc.svc.GetUserID(ctx); err != nil {
 		return err
 	}
 
 	// 3. 用户是否有权限发起退款
-	hasPermission, err := uc.svc.CheckAdminPermission(ctx, uid)
-	if err != nil {
-		return err
-	}
-	if !hasPermission {
+	if !uc.svc.CheckAdminPermission(ctx) {
 		return errno.AuthNoOperatePermission
 	}
 
@@ -161,6 +156,7 @@ func (uc *paymentUseCase) RefundReview(ctx context.Context, orderID int64, passe
 	if refund == nil {
 		return errno.Errorf(errno.ServicePaymentRefundNotExist, "refund does not exist")
 	}
+	before := *refund // 审计时记录审核前的退款信息
 
 	// 5. 更新退款状态为处理中
 	err = uc.db.UpdateRefundStatusByOrderIDAndStatus(ctx, orderID,
@@ -190,6 +186,13 @@ func (uc *paymentUseCase) RefundReview(ctx context.Context, orderID int64, passe
 		}
 	}
 
+	reviewed := before
+	reviewed.Status = paymentStatus.RefundStatusFailedCode
+	if passed {
+		reviewed.Status = paymentStatus.RefundStatusSuccessCode
+	}
+	uc.svc.RecordRefundReview(ctx, &before, &reviewed)
+	uc.svc.PublishRefundReview(ctx, &reviewed, passed)
 	return nil
 }
 
@@ -346,9 +346,11 @@ func TestPaymentUseCase_RefundReview(t *testing.T) {
 	}
 	mockey.Mock((*service.PaymentService).GetOrderStatus).Return(true, false, nil).Build()
 	mockey.Mock((*service.PaymentService).GetUserID).Return(int64(1), nil).Build()
-	mockey.Mock((*service.PaymentService).CheckAdminPermission).Return(true, nil).Build()
+	mockey.Mock((*service.PaymentService).CheckAdminPermission).Return(true).Build()
 	mockey.Mock((*service.PaymentService).Refund).Return(int64(1), "test", nil).Build()
 	mockey.Mock((*service.PaymentService).CancelOrder).Return(nil).Build()
+	mockey.Mock((*service.PaymentService).RecordRefundReview).Return().Build()
+	mockey.Mock((*service.PaymentService).PublishRefundReview).Return().Build()
 	mockey.Mock((*_DB).GetRefundInfoByOrderID).Return(&model.PaymentRefund{}, nil).Build()
 	mockey.Mock((*_DB).UpdateRefundStatusByOrderIDAndStatus).Return(nil).Build()
 	mockey.Mock((*_DB).UpdateRefundStatusToSuccessAndCreateLedgerAsTransaction).Return(nil).Build()
@@ -389,13 +391,8 @@ func TestPaymentUseCase_RefundReview(t *testing.T) {
 			err := uc.RefundReview(bg, orderID, true)
 			convey.So(errno.ConvertErr(err), convey.ShouldEqual, testErr)
 		})
-		mockey.PatchConvey("CheckAdminPermissionError", func() {
-			mockey.Mock((*service.PaymentService).CheckAdminPermission).Return(true, testErr).Build()
-			err := uc.RefundReview(bg, orderID, true)
-			convey.So(errno.ConvertErr(err), convey.ShouldEqual, testErr)
-		})
 		mockey.PatchConvey("CheckAdminPermissionNoPermission", func() {
-			mockey.Mock((*service.PaymentService).CheckAdminPermission).Return(false, nil).Build()
+			mockey.Mock((*service.PaymentService).CheckAdminPermission).Return(false).Build()
 			err := uc.RefundReview(bg, orderID, true)
 			convey.So(errno.ConvertErr(err).ErrorCode, convey.ShouldEqual, errno.AuthNoOperatePermission.ErrorCode)
 		})
@@ -563,3 +560,64 @@ func TestPaymentUseCase_PaymentCheckout(t *testing.T) {
 		})
 	})
 }
+
+func TestPaymentUseCase_QueryPaymentStatus(t *testing.T) {
+	type _DB struct {
+		repository.PaymentDB
+	}
+	uc := &paymentUseCase{
+		db:  &_DB{},
+		svc: new(service.PaymentService),
+	}
+	bg := ctx.Background()
+	orderID := int64(1)
+	testErr := errno.NewErrNo(-1, "")
+
+	// 在 PatchConvey 中 mock, 结束后自动恢复, 避免和其他测试在顶层留下的 mock 冲突
+	mockey.PatchConvey("QueryPaymentStatus", t, func() {
+		mockey.Mock((*service.PaymentService).GetUserID).Return(int64(1), nil).Build()
+		mockey.Mock((*_DB).GetPaymentInfo).Return(&model.PaymentOrder{UserID: 1}, nil).Build()
+		mockey.Mock((*_DB).GetRefundInfoByOrderID).Return(&model.PaymentRefund{UserID: 1}, nil).Build()
+
+		mockey.PatchConvey("success", func() {
+			p, r, err := uc.QueryPaymentStatus(bg, orderID)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(p, convey.ShouldNotBeNil)
+			convey.So(r, convey.ShouldNotBeNil)
+		})
+		mockey.PatchConvey("GetUserIDError", func() {
+			mockey.Mock((*service.PaymentService).GetUserID).Return(int64(0), testErr).Build()
+			_, _, err := uc.QueryPaymentStatus(bg, orderID)
+			convey.So(errno.ConvertErr(err), convey.ShouldEqual, testErr)
+		})
+		mockey.PatchConvey("PaymentNotExist", func() {
+			mockey.Mock((*_DB).GetPaymentInfo).Return(nil, errno.NewErrNo(errno.ServicePaymentOrderNotExist, "")).Build()
+			p, r, err := uc.QueryPaymentStatus(bg, orderID)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(p, convey.ShouldBeNil)
+			convey.So(r, convey.ShouldBeNil)
+		})
+		mockey.PatchConvey("GetPaymentInfoError", func() {
+			mockey.Mock((*_DB).GetPaymentInfo).Return(nil, testErr).Build()
+			_, _, err := uc.QueryPaymentStatus(bg, orderID)
+			convey.So(errno.ConvertErr(err), convey.ShouldEqual, testErr)
+		})
+		mockey.PatchConvey("NotOwner", func() {
+			mockey.Mock((*_DB).GetPaymentInfo).Return(&model.PaymentOrder{UserID: 2}, nil).Build()
+			_, _, err := uc.QueryPaymentStatus(bg, orderID)
+			convey.So(errno.ConvertErr(err).ErrorCode, convey.ShouldEqual, errno.AuthNoOperatePermissionCode)
+		})
+		mockey.PatchConvey("RefundNotExist", func() {
+			mockey.Mock((*_DB).GetRefundInfoByOrderID).Return(nil, errno.NewErrNo(errno.ServicePaymentRefundNotExist, "")).Build()
+			p, r, err := uc.QueryPaymentStatus(bg, orderID)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(p, convey.ShouldNotBeNil)
+			convey.So(r, convey.ShouldBeNil)
+		})
+		mockey.PatchConvey("GetRefundInfoByOrderIDError", func() {
+			mockey.Mock((*_DB).GetRefundInfoByOrderID).Return(nil, testErr).Build()
+			_, _, err := uc.QueryPaymentStatus(bg, orderID)
+			convey.So(errno.ConvertErr(err), convey.ShouldEqual, testErr)
+		})
+	})
+}
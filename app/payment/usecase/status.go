@@ -0,0 +1,50 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package usecase
+
+import (
+	"context"
+
+	"github.com/west2-online/DomTok/app/payment/domain/model"
+	"github.com/west2-online/DomTok/pkg/errno"
+)
+
+// QueryPaymentStatus 查询订单的支付单和退款单, 尚未支付或没有退款时对应的返回值为 nil
+func (uc *paymentUseCase) QueryPaymentStatus(ctx context.Context, orderID int64) (*model.PaymentOrder, *model.PaymentRefund, error) {
+	uid, err := uc.svc.GetUserID(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	p, err := uc.db.GetPaymentInfo(ctx, orderID)
+	if err != nil {
+		if errno.ConvertErr(err).ErrorCode == errno.ServicePaymentOrderNotExist {
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+	if p.UserID != uid {
+		return nil, nil, errno.NewErrNo(errno.AuthNoOperatePermissionCode, "payment order does not belong to the user")
+	}
+	r, err := uc.db.GetRefundInfoByOrderID(ctx, orderID)
+	if err != nil {
+		if errno.ConvertErr(err).ErrorCode == errno.ServicePaymentRefundNotExist {
+			return p, nil, nil
+		}
+		return nil, nil, err
+	}
+	return p, r, nil
+}
@@ -31,6 +31,8 @@ type PaymentUseCase interface {
 	CreateRefund(ctx context.Context, orderID int64) (int64, int64, error)
 	RefundReview(ctx context.Context, orderID int64, passed bool) error
 	PaymentCheckout(ctx context.Context, orderID int64, token string) error
+	ExportUserData(ctx context.Context, uid int64) (string, error)
+	QueryPaymentStatus(ctx context.Context, orderID int64) (*model.PaymentOrder, *model.PaymentRefund, error)
 }
 
 type paymentUseCase struct {
@@ -0,0 +1,39 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package usecase
+
+import (
+	"context"
+
+	"github.com/bytedance/sonic"
+
+	"github.com/west2-online/DomTok/pkg/errno"
+)
+
+// ExportUserData 导出用户的支付数据, 该方法由 user 服务调用
+// 支付记录属于需要依法留存的交易凭证, 注销账号时不会删除, 所以这里没有对应的清除方法
+func (uc *paymentUseCase) ExportUserData(ctx context.Context, uid int64) (string, error) {
+	data, err := uc.db.GetUserData(ctx, uid)
+	if err != nil {
+		return "", err
+	}
+	s, err := sonic.MarshalString(data)
+	if err != nil {
+		return "", errno.Errorf(errno.InternalServiceErrorCode, "usecase.ExportUserData marshal failed: %v", err)
+	}
+	return s, nil
+}
@@ -59,13 +59,31 @@ func (h *UserHandler) Login(ctx context.Context, req *user.LoginRequest) (r *use
 		Password: req.Password,
 	}
 
-	ans, err := h.useCase.Login(ctx, u)
+	session := &model.Session{
+		Device: req.GetDevice(),
+		IP:     req.GetIp(),
+	}
+
+	ans, token, err := h.useCase.Login(ctx, u, session, req.GetTotpCode())
 	if err != nil {
 		r.Base = base.BuildBaseResp(err)
 		return
 	}
 	r.Base = base.BuildBaseResp(nil)
 	r.User = pack.BuildUser(ans)
+	r.Token = pack.BuildToken(token)
+	return
+}
+
+func (h *UserHandler) RefreshToken(ctx context.Context, req *user.RefreshTokenReq) (r *user.RefreshTokenResp, err error) {
+	r = new(user.RefreshTokenResp)
+	token, err := h.useCase.RefreshToken(ctx, req.RefreshToken)
+	if err != nil {
+		r.Base = base.BuildBaseResp(err)
+		return
+	}
+	r.Base = base.BuildBaseResp(nil)
+	r.Token = pack.BuildToken(token)
 	return
 }
 
@@ -84,9 +102,12 @@ func (h *UserHandler) GetAddress(ctx context.Context, req *user.GetAddressReques
 func (h *UserHandler) AddAddress(ctx context.Context, req *user.AddAddressRequest) (r *user.AddAddressResponse, err error) {
 	r = new(user.AddAddressResponse)
 	address := &model.Address{
-		Province: req.Province,
-		City:     req.City,
-		Detail:   req.Detail,
+		Province:      req.Province,
+		City:          req.City,
+		Detail:        req.Detail,
+		RecipientName: req.RecipientName,
+		Phone:         req.Phone,
+		IsDefault:     req.GetIsDefault(),
 	}
 	var addressID int64
 	if addressID, err = h.useCase.AddAddress(ctx, address); err != nil {
@@ -98,9 +119,85 @@ func (h *UserHandler) AddAddress(ctx context.Context, req *user.AddAddressReques
 	return
 }
 
+func (h *UserHandler) ListAddress(ctx context.Context, req *user.ListAddressReq) (r *user.ListAddressResp, err error) {
+	r = new(user.ListAddressResp)
+	addresses, err := h.useCase.ListAddress(ctx)
+	if err != nil {
+		r.Base = base.BuildBaseResp(err)
+		return
+	}
+	r.Base = base.BuildBaseResp(nil)
+	r.Addresses = pack.BuildAddresses(addresses)
+	return
+}
+
+func (h *UserHandler) UpdateAddress(ctx context.Context, req *user.UpdateAddressReq) (r *user.UpdateAddressResp, err error) {
+	r = new(user.UpdateAddressResp)
+	address := &model.Address{
+		AddressID:     req.AddressID,
+		Province:      req.GetProvince(),
+		City:          req.GetCity(),
+		Detail:        req.GetDetail(),
+		RecipientName: req.GetRecipientName(),
+		Phone:         req.GetPhone(),
+	}
+	err = h.useCase.UpdateAddress(ctx, address)
+	if err != nil {
+		r.Base = base.BuildBaseResp(err)
+		return
+	}
+	r.Base = base.BuildBaseResp(nil)
+	return
+}
+
+func (h *UserHandler) DeleteAddress(ctx context.Context, req *user.DeleteAddressReq) (r *user.DeleteAddressResp, err error) {
+	r = new(user.DeleteAddressResp)
+	err = h.useCase.DeleteAddress(ctx, req.AddressID)
+	if err != nil {
+		r.Base = base.BuildBaseResp(err)
+		return
+	}
+	r.Base = base.BuildBaseResp(nil)
+	return
+}
+
+func (h *UserHandler) SetDefaultAddress(ctx context.Context, req *user.SetDefaultAddressReq) (r *user.SetDefaultAddressResp, err error) {
+	r = new(user.SetDefaultAddressResp)
+	err = h.useCase.SetDefaultAddress(ctx, req.AddressID)
+	if err != nil {
+		r.Base = base.BuildBaseResp(err)
+		return
+	}
+	r.Base = base.BuildBaseResp(nil)
+	return
+}
+
 func (h *UserHandler) BanUser(ctx context.Context, req *user.BanUserReq) (r *user.BanUserResp, err error) {
 	r = new(user.BanUserResp)
-	err = h.useCase.BanUser(ctx, req.Uid)
+	err = h.useCase.BanUser(ctx, req.Uid, req.Reason, req.GetDuration())
+	if err != nil {
+		r.Base = base.BuildBaseResp(err)
+		return
+	}
+	r.Base = base.BuildBaseResp(nil)
+	return
+}
+
+func (h *UserHandler) ListBanHistory(ctx context.Context, req *user.ListBanHistoryReq) (r *user.ListBanHistoryResp, err error) {
+	r = new(user.ListBanHistoryResp)
+	bans, err := h.useCase.ListBanHistory(ctx, req.Uid)
+	if err != nil {
+		r.Base = base.BuildBaseResp(err)
+		return
+	}
+	r.Base = base.BuildBaseResp(nil)
+	r.Bans = pack.BuildBans(bans)
+	return
+}
+
+func (h *UserHandler) UnlockUser(ctx context.Context, req *user.UnlockUserReq) (r *user.UnlockUserResp, err error) {
+	r = new(user.UnlockUserResp)
+	err = h.useCase.UnlockUser(ctx, req.Uid)
 	if err != nil {
 		r.Base = base.BuildBaseResp(err)
 		return
@@ -131,6 +228,40 @@ func (h *UserHandler) LiftBandUser(ctx context.Context, req *user.LiftBanUserReq
 	return
 }
 
+func (h *UserHandler) ListSessions(ctx context.Context, req *user.ListSessionsReq) (r *user.ListSessionsResp, err error) {
+	r = new(user.ListSessionsResp)
+	sessions, err := h.useCase.ListSessions(ctx)
+	if err != nil {
+		r.Base = base.BuildBaseResp(err)
+		return
+	}
+	r.Base = base.BuildBaseResp(nil)
+	r.Sessions = pack.BuildSessions(sessions)
+	return
+}
+
+func (h *UserHandler) RevokeSession(ctx context.Context, req *user.RevokeSessionReq) (r *user.RevokeSessionResp, err error) {
+	r = new(user.RevokeSessionResp)
+	err = h.useCase.RevokeSession(ctx, req.SessionID)
+	if err != nil {
+		r.Base = base.BuildBaseResp(err)
+		return
+	}
+	r.Base = base.BuildBaseResp(nil)
+	return
+}
+
+func (h *UserHandler) RevokeAllSessions(ctx context.Context, req *user.RevokeAllSessionsReq) (r *user.RevokeAllSessionsResp, err error) {
+	r = new(user.RevokeAllSessionsResp)
+	err = h.useCase.RevokeAllSessions(ctx)
+	if err != nil {
+		r.Base = base.BuildBaseResp(err)
+		return
+	}
+	r.Base = base.BuildBaseResp(nil)
+	return
+}
+
 func (h *UserHandler) SetAdministrator(ctx context.Context, req *user.SetAdministratorReq) (r *user.SetAdministratorResp, err error) {
 	r = new(user.SetAdministratorResp)
 	err = h.useCase.SetAdministrator(ctx, req.Uid, []byte(req.Password), int(req.Action))
@@ -153,3 +284,382 @@ func (h *UserHandler) GetUserInfo(ctx context.Context, req *user.GetUserInfoReq)
 	r.Info = pack.BuildUser(res)
 	return
 }
+
+func (h *UserHandler) CreateShop(ctx context.Context, req *user.CreateShopReq) (r *user.CreateShopResp, err error) {
+	r = new(user.CreateShopResp)
+	shop := &model.Shop{
+		Name:        req.Name,
+		Logo:        req.GetLogo(),
+		Description: req.GetDescription(),
+	}
+	var shopID int64
+	if shopID, err = h.useCase.CreateShop(ctx, shop); err != nil {
+		r.Base = base.BuildBaseResp(err)
+		return
+	}
+	r.Base = base.BuildBaseResp(nil)
+	r.ShopID = shopID
+	return
+}
+
+func (h *UserHandler) UpdateShop(ctx context.Context, req *user.UpdateShopReq) (r *user.UpdateShopResp, err error) {
+	r = new(user.UpdateShopResp)
+	shop := &model.Shop{
+		ShopID:      req.ShopID,
+		Name:        req.GetName(),
+		Logo:        req.GetLogo(),
+		Description: req.GetDescription(),
+	}
+	err = h.useCase.UpdateShop(ctx, shop)
+	if err != nil {
+		r.Base = base.BuildBaseResp(err)
+		return
+	}
+	r.Base = base.BuildBaseResp(nil)
+	return
+}
+
+func (h *UserHandler) CloseShop(ctx context.Context, req *user.CloseShopReq) (r *user.CloseShopResp, err error) {
+	r = new(user.CloseShopResp)
+	err = h.useCase.CloseShop(ctx, req.ShopID)
+	if err != nil {
+		r.Base = base.BuildBaseResp(err)
+		return
+	}
+	r.Base = base.BuildBaseResp(nil)
+	return
+}
+
+func (h *UserHandler) GetShop(ctx context.Context, req *user.GetShopReq) (r *user.GetShopResp, err error) {
+	r = new(user.GetShopResp)
+	shop, err := h.useCase.GetShop(ctx, req.ShopID)
+	if err != nil {
+		r.Base = base.BuildBaseResp(err)
+		return
+	}
+	r.Base = base.BuildBaseResp(nil)
+	r.Shop = pack.BuildShop(shop)
+	return
+}
+
+func (h *UserHandler) GetShops(ctx context.Context, req *user.GetShopsReq) (r *user.GetShopsResp, err error) {
+	r = new(user.GetShopsResp)
+	shops, err := h.useCase.GetShops(ctx, req.ShopIDs)
+	if err != nil {
+		r.Base = base.BuildBaseResp(err)
+		return
+	}
+	r.Base = base.BuildBaseResp(nil)
+	r.Shops = pack.BuildShops(shops)
+	return
+}
+
+func (h *UserHandler) AddShopStaff(ctx context.Context, req *user.AddShopStaffReq) (r *user.AddShopStaffResp, err error) {
+	r = new(user.AddShopStaffResp)
+	err = h.useCase.AddShopStaff(ctx, req.ShopID, req.Uid)
+	if err != nil {
+		r.Base = base.BuildBaseResp(err)
+		return
+	}
+	r.Base = base.BuildBaseResp(nil)
+	return
+}
+
+func (h *UserHandler) RemoveShopStaff(ctx context.Context, req *user.RemoveShopStaffReq) (r *user.RemoveShopStaffResp, err error) {
+	r = new(user.RemoveShopStaffResp)
+	err = h.useCase.RemoveShopStaff(ctx, req.ShopID, req.Uid)
+	if err != nil {
+		r.Base = base.BuildBaseResp(err)
+		return
+	}
+	r.Base = base.BuildBaseResp(nil)
+	return
+}
+
+func (h *UserHandler) ListShopStaff(ctx context.Context, req *user.ListShopStaffReq) (r *user.ListShopStaffResp, err error) {
+	r = new(user.ListShopStaffResp)
+	staff, err := h.useCase.ListShopStaff(ctx, req.ShopID)
+	if err != nil {
+		r.Base = base.BuildBaseResp(err)
+		return
+	}
+	r.Base = base.BuildBaseResp(nil)
+	r.Staff = pack.BuildShopStaff(staff)
+	return
+}
+
+func (h *UserHandler) IsShopStaff(ctx context.Context, req *user.IsShopStaffReq) (r *user.IsShopStaffResp, err error) {
+	r = new(user.IsShopStaffResp)
+	ok, shop, err := h.useCase.IsShopStaff(ctx, req.ShopID, req.Uid)
+	if err != nil {
+		r.Base = base.BuildBaseResp(err)
+		return
+	}
+	r.Base = base.BuildBaseResp(nil)
+	r.IsStaff = ok
+	r.Shop = pack.BuildShop(shop)
+	return
+}
+
+func (h *UserHandler) UpdateProfile(ctx context.Context, req *user.UpdateProfileReq) (r *user.UpdateProfileResp, err error) {
+	r = new(user.UpdateProfileResp)
+	profile := &model.User{
+		UserName: req.GetName(),
+		Email:    req.GetEmail(),
+		Phone:    req.GetPhone(),
+	}
+	u, err := h.useCase.UpdateProfile(ctx, profile, req.GetPassword())
+	if err != nil {
+		r.Base = base.BuildBaseResp(err)
+		return
+	}
+	r.Base = base.BuildBaseResp(nil)
+	r.User = pack.BuildUser(u)
+	return
+}
+
+func (h *UserHandler) ChangePassword(ctx context.Context, req *user.ChangePasswordReq) (r *user.ChangePasswordResp, err error) {
+	r = new(user.ChangePasswordResp)
+	err = h.useCase.ChangePassword(ctx, req.OldPassword, req.Password)
+	if err != nil {
+		r.Base = base.BuildBaseResp(err)
+		return
+	}
+	r.Base = base.BuildBaseResp(nil)
+	return
+}
+
+func (h *UserHandler) RequestPasswordReset(ctx context.Context, req *user.RequestPasswordResetReq) (r *user.RequestPasswordResetResp, err error) {
+	r = new(user.RequestPasswordResetResp)
+	err = h.useCase.RequestPasswordReset(ctx, req.Name)
+	if err != nil {
+		r.Base = base.BuildBaseResp(err)
+		return
+	}
+	r.Base = base.BuildBaseResp(nil)
+	return
+}
+
+func (h *UserHandler) ResetPassword(ctx context.Context, req *user.ResetPasswordReq) (r *user.ResetPasswordResp, err error) {
+	r = new(user.ResetPasswordResp)
+	err = h.useCase.ResetPassword(ctx, req.Name, req.Code, req.Password)
+	if err != nil {
+		r.Base = base.BuildBaseResp(err)
+		return
+	}
+	r.Base = base.BuildBaseResp(nil)
+	return
+}
+
+func (h *UserHandler) ExportUserData(ctx context.Context, req *user.ExportUserDataReq) (r *user.ExportUserDataResp, err error) {
+	r = new(user.ExportUserDataResp)
+	r.Data, err = h.useCase.ExportUserData(ctx)
+	if err != nil {
+		r.Base = base.BuildBaseResp(err)
+		return
+	}
+	r.Base = base.BuildBaseResp(nil)
+	return
+}
+
+func (h *UserHandler) CloseAccount(ctx context.Context, req *user.CloseAccountReq) (r *user.CloseAccountResp, err error) {
+	r = new(user.CloseAccountResp)
+	err = h.useCase.CloseAccount(ctx, req.Password)
+	if err != nil {
+		r.Base = base.BuildBaseResp(err)
+		return
+	}
+	r.Base = base.BuildBaseResp(nil)
+	return
+}
+
+func (h *UserHandler) EnrollTOTP(ctx context.Context, req *user.EnrollTOTPReq) (r *user.EnrollTOTPResp, err error) {
+	r = new(user.EnrollTOTPResp)
+	enrollment, err := h.useCase.EnrollTOTP(ctx, req.Password)
+	if err != nil {
+		r.Base = base.BuildBaseResp(err)
+		return
+	}
+	r.Base = base.BuildBaseResp(nil)
+	r.Secret = enrollment.Secret
+	r.Uri = enrollment.URI
+	r.RecoveryCodes = enrollment.RecoveryCodes
+	return
+}
+
+func (h *UserHandler) ConfirmTOTP(ctx context.Context, req *user.ConfirmTOTPReq) (r *user.ConfirmTOTPResp, err error) {
+	r = new(user.ConfirmTOTPResp)
+	err = h.useCase.ConfirmTOTP(ctx, req.Code)
+	if err != nil {
+		r.Base = base.BuildBaseResp(err)
+		return
+	}
+	r.Base = base.BuildBaseResp(nil)
+	return
+}
+
+func (h *UserHandler) DisableTOTP(ctx context.Context, req *user.DisableTOTPReq) (r *user.DisableTOTPResp, err error) {
+	r = new(user.DisableTOTPResp)
+	err = h.useCase.DisableTOTP(ctx, req.Password, req.Code)
+	if err != nil {
+		r.Base = base.BuildBaseResp(err)
+		return
+	}
+	r.Base = base.BuildBaseResp(nil)
+	return
+}
+
+func (h *UserHandler) CreateApiKey(ctx context.Context, req *user.CreateApiKeyReq) (r *user.CreateApiKeyResp, err error) {
+	r = new(user.CreateApiKeyResp)
+	key := &model.ApiKey{
+		Name:   req.Name,
+		Scopes: req.Scopes,
+	}
+	plaintext, err := h.useCase.CreateApiKey(ctx, key)
+	if err != nil {
+		r.Base = base.BuildBaseResp(err)
+		return
+	}
+	r.Base = base.BuildBaseResp(nil)
+	r.Key = plaintext
+	r.Info = pack.BuildApiKey(key)
+	return
+}
+
+func (h *UserHandler) ListApiKeys(ctx context.Context, req *user.ListApiKeysReq) (r *user.ListApiKeysResp, err error) {
+	r = new(user.ListApiKeysResp)
+	keys, err := h.useCase.ListApiKeys(ctx)
+	if err != nil {
+		r.Base = base.BuildBaseResp(err)
+		return
+	}
+	r.Base = base.BuildBaseResp(nil)
+	r.Keys = pack.BuildApiKeys(keys)
+	return
+}
+
+func (h *UserHandler) RevokeApiKey(ctx context.Context, req *user.RevokeApiKeyReq) (r *user.RevokeApiKeyResp, err error) {
+	r = new(user.RevokeApiKeyResp)
+	err = h.useCase.RevokeApiKey(ctx, req.KeyID)
+	if err != nil {
+		r.Base = base.BuildBaseResp(err)
+		return
+	}
+	r.Base = base.BuildBaseResp(nil)
+	return
+}
+
+func (h *UserHandler) VerifyApiKey(ctx context.Context, req *user.VerifyApiKeyReq) (r *user.VerifyApiKeyResp, err error) {
+	r = new(user.VerifyApiKeyResp)
+	key, u, err := h.useCase.VerifyApiKey(ctx, req.Key)
+	if err != nil {
+		r.Base = base.BuildBaseResp(err)
+		return
+	}
+	r.Base = base.BuildBaseResp(nil)
+	r.Uid = u.Uid
+	r.Role = int64(u.Role)
+	r.Scopes = key.Scopes
+	return
+}
+
+func (h *UserHandler) ListAuditLogs(ctx context.Context, req *user.ListAuditLogsReq) (r *user.ListAuditLogsResp, err error) {
+	r = new(user.ListAuditLogsResp)
+	logs, total, err := h.useCase.ListAuditLogs(ctx, &model.AuditLogFilter{
+		ActorID:   req.GetActorID(),
+		Action:    req.GetAction(),
+		StartTime: req.GetStartTime(),
+		EndTime:   req.GetEndTime(),
+		PageNum:   req.GetPageNum(),
+		PageSize:  req.GetPageSize(),
+	})
+	if err != nil {
+		r.Base = base.BuildBaseResp(err)
+		return
+	}
+	r.Base = base.BuildBaseResp(nil)
+	r.Logs = pack.BuildAuditLogs(logs)
+	r.Total = total
+	return
+}
+
+func (h *UserHandler) CreateWebhookEndpoint(ctx context.Context, req *user.CreateWebhookEndpointReq) (r *user.CreateWebhookEndpointResp, err error) {
+	r = new(user.CreateWebhookEndpointResp)
+	endpoint := &model.WebhookEndpoint{
+		ShopID:     req.ShopID,
+		URL:        req.Url,
+		EventTypes: req.EventTypes,
+	}
+	secret, err := h.useCase.CreateWebhookEndpoint(ctx, endpoint)
+	if err != nil {
+		r.Base = base.BuildBaseResp(err)
+		return
+	}
+	r.Base = base.BuildBaseResp(nil)
+	r.Secret = secret
+	r.Endpoint = pack.BuildWebhookEndpoint(endpoint)
+	return
+}
+
+func (h *UserHandler) ListWebhookEndpoints(ctx context.Context, req *user.ListWebhookEndpointsReq) (r *user.ListWebhookEndpointsResp, err error) {
+	r = new(user.ListWebhookEndpointsResp)
+	endpoints, err := h.useCase.ListWebhookEndpoints(ctx, req.ShopID)
+	if err != nil {
+		r.Base = base.BuildBaseResp(err)
+		return
+	}
+	r.Base = base.BuildBaseResp(nil)
+	r.Endpoints = pack.BuildWebhookEndpoints(endpoints)
+	return
+}
+
+func (h *UserHandler) UpdateWebhookEndpoint(ctx context.Context, req *user.UpdateWebhookEndpointReq) (r *user.UpdateWebhookEndpointResp, err error) {
+	r = new(user.UpdateWebhookEndpointResp)
+	err = h.useCase.UpdateWebhookEndpoint(ctx, &model.WebhookEndpoint{
+		EndpointID: req.EndpointID,
+		URL:        req.Url,
+		EventTypes: req.EventTypes,
+	}, req.Enabled)
+	if err != nil {
+		r.Base = base.BuildBaseResp(err)
+		return
+	}
+	r.Base = base.BuildBaseResp(nil)
+	return
+}
+
+func (h *UserHandler) DeleteWebhookEndpoint(ctx context.Context, req *user.DeleteWebhookEndpointReq) (r *user.DeleteWebhookEndpointResp, err error) {
+	r = new(user.DeleteWebhookEndpointResp)
+	err = h.useCase.DeleteWebhookEndpoint(ctx, req.EndpointID)
+	if err != nil {
+		r.Base = base.BuildBaseResp(err)
+		return
+	}
+	r.Base = base.BuildBaseResp(nil)
+	return
+}
+
+func (h *UserHandler) ListWebhookDeliveries(ctx context.Context, req *user.ListWebhookDeliveriesReq) (r *user.ListWebhookDeliveriesResp, err error) {
+	r = new(user.ListWebhookDeliveriesResp)
+	deliveries, total, err := h.useCase.ListWebhookDeliveries(ctx, req.EndpointID, req.GetPageNum(), req.GetPageSize())
+	if err != nil {
+		r.Base = base.BuildBaseResp(err)
+		return
+	}
+	r.Base = base.BuildBaseResp(nil)
+	r.Deliveries = pack.BuildWebhookDeliveries(deliveries)
+	r.Total = total
+	return
+}
+
+func (h *UserHandler) RedeliverWebhook(ctx context.Context, req *user.RedeliverWebhookReq) (r *user.RedeliverWebhookResp, err error) {
+	r = new(user.RedeliverWebhookResp)
+	delivery, err := h.useCase.RedeliverWebhook(ctx, req.DeliveryID)
+	if err != nil {
+		r.Base = base.BuildBaseResp(err)
+		return
+	}
+	r.Base = base.BuildBaseResp(nil)
+	r.Delivery = pack.BuildWebhookDelivery(delivery)
+	return
+}
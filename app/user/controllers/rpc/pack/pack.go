@@ -17,8 +17,11 @@ limitations under the License.
 package pack
 
 import (
+	"github.com/samber/lo"
+
 	domainModel "github.com/west2-online/DomTok/app/user/domain/model"
 	"github.com/west2-online/DomTok/kitex_gen/model"
+	"github.com/west2-online/DomTok/pkg/constants"
 )
 
 // BuildUser 将 entities 定义的 User 实体转换成 idl 定义的 RPC 交流实体，类似 dto
@@ -27,6 +30,8 @@ func BuildUser(u *domainModel.User) *model.UserInfo {
 		UserId: u.Uid,
 		Name:   u.UserName,
 		Role:   int64(u.Role),
+		Email:  u.Email,
+		Phone:  u.Phone,
 	}
 }
 
@@ -35,9 +40,169 @@ func BuildAddress(address *domainModel.Address) *model.AddressInfo {
 		return nil
 	}
 	return &model.AddressInfo{
-		AddressID: address.AddressID,
-		Province:  address.Province,
-		City:      address.City,
-		Detail:    address.Detail,
+		AddressID:     address.AddressID,
+		Province:      address.Province,
+		City:          address.City,
+		Detail:        address.Detail,
+		RecipientName: address.RecipientName,
+		Phone:         address.Phone,
+		IsDefault:     address.IsDefault,
+	}
+}
+
+func BuildAddresses(addresses []*domainModel.Address) []*model.AddressInfo {
+	return lo.Map(addresses, func(item *domainModel.Address, index int) *model.AddressInfo {
+		return BuildAddress(item)
+	})
+}
+
+func BuildToken(token *domainModel.Token) *model.TokenInfo {
+	return &model.TokenInfo{
+		AccessToken:  token.AccessToken,
+		RefreshToken: token.RefreshToken,
+	}
+}
+
+func BuildSession(session *domainModel.Session) *model.SessionInfo {
+	return &model.SessionInfo{
+		SessionID: session.SessionID,
+		Device:    session.Device,
+		Ip:        session.IP,
+		CreatedAt: session.CreatedAt,
+		ActiveAt:  session.ActiveAt,
+		Current:   session.Current,
+	}
+}
+
+func BuildSessions(sessions []*domainModel.Session) []*model.SessionInfo {
+	return lo.Map(sessions, func(item *domainModel.Session, index int) *model.SessionInfo {
+		return BuildSession(item)
+	})
+}
+
+func BuildShop(shop *domainModel.Shop) *model.ShopInfo {
+	return &model.ShopInfo{
+		ShopID:      shop.ShopID,
+		Name:        shop.Name,
+		Logo:        shop.Logo,
+		Description: shop.Description,
+		Status:      int32(shop.Status),
+		OwnerID:     shop.OwnerID,
+		CreatedAt:   shop.CreatedAt,
+		UpdatedAt:   shop.UpdatedAt,
 	}
 }
+
+func BuildShops(shops []*domainModel.Shop) []*model.ShopInfo {
+	return lo.Map(shops, func(item *domainModel.Shop, index int) *model.ShopInfo {
+		return BuildShop(item)
+	})
+}
+
+func BuildShopStaff(staff []*domainModel.ShopStaff) []*model.ShopStaffInfo {
+	return lo.Map(staff, func(item *domainModel.ShopStaff, index int) *model.ShopStaffInfo {
+		return &model.ShopStaffInfo{
+			ShopID:    item.ShopID,
+			Uid:       item.Uid,
+			Role:      int32(item.Role),
+			CreatedAt: item.CreatedAt,
+		}
+	})
+}
+
+func BuildBan(ban *domainModel.Ban) *model.BanInfo {
+	return &model.BanInfo{
+		BanID:      ban.BanID,
+		Uid:        ban.Uid,
+		Reason:     ban.Reason,
+		OperatorID: ban.OperatorID,
+		StartAt:    ban.StartAt,
+		EndAt:      ban.EndAt,
+		LiftedAt:   ban.LiftedAt,
+		LiftedBy:   ban.LiftedBy,
+	}
+}
+
+func BuildBans(bans []*domainModel.Ban) []*model.BanInfo {
+	return lo.Map(bans, func(item *domainModel.Ban, index int) *model.BanInfo {
+		return BuildBan(item)
+	})
+}
+
+func BuildApiKey(key *domainModel.ApiKey) *model.ApiKeyInfo {
+	return &model.ApiKeyInfo{
+		KeyID:     key.KeyID,
+		Name:      key.Name,
+		Prefix:    key.Prefix,
+		Scopes:    key.Scopes,
+		CreatedAt: key.CreatedAt,
+	}
+}
+
+func BuildApiKeys(keys []*domainModel.ApiKey) []*model.ApiKeyInfo {
+	return lo.Map(keys, func(item *domainModel.ApiKey, index int) *model.ApiKeyInfo {
+		return BuildApiKey(item)
+	})
+}
+
+func BuildAuditLog(log *domainModel.AuditLog) *model.AuditLogInfo {
+	return &model.AuditLogInfo{
+		LogID:     log.LogID,
+		ActorID:   log.ActorID,
+		Action:    log.Action,
+		Target:    log.Target,
+		Before:    log.Before,
+		After:     log.After,
+		ClientIP:  log.ClientIP,
+		TraceID:   log.TraceID,
+		CreatedAt: log.CreatedAt,
+	}
+}
+
+func BuildAuditLogs(logs []*domainModel.AuditLog) []*model.AuditLogInfo {
+	return lo.Map(logs, func(item *domainModel.AuditLog, index int) *model.AuditLogInfo {
+		return BuildAuditLog(item)
+	})
+}
+
+func BuildWebhookEndpoint(endpoint *domainModel.WebhookEndpoint) *model.WebhookEndpointInfo {
+	return &model.WebhookEndpointInfo{
+		EndpointID:          endpoint.EndpointID,
+		ShopID:              endpoint.ShopID,
+		Url:                 endpoint.URL,
+		EventTypes:          endpoint.EventTypes,
+		Enabled:             endpoint.Status == constants.WebhookEndpointStatusEnabled,
+		ConsecutiveFailures: endpoint.ConsecutiveFailures,
+		CreatedAt:           endpoint.CreatedAt,
+		UpdatedAt:           endpoint.UpdatedAt,
+	}
+}
+
+func BuildWebhookEndpoints(endpoints []*domainModel.WebhookEndpoint) []*model.WebhookEndpointInfo {
+	return lo.Map(endpoints, func(item *domainModel.WebhookEndpoint, index int) *model.WebhookEndpointInfo {
+		return BuildWebhookEndpoint(item)
+	})
+}
+
+func BuildWebhookDelivery(delivery *domainModel.WebhookDelivery) *model.WebhookDeliveryInfo {
+	return &model.WebhookDeliveryInfo{
+		DeliveryID:     delivery.DeliveryID,
+		EndpointID:     delivery.EndpointID,
+		EventID:        delivery.EventID,
+		EventType:      delivery.EventType,
+		Payload:        delivery.Payload,
+		Status:         int32(delivery.Status),
+		Attempts:       delivery.Attempts,
+		NextRetryAt:    delivery.NextRetryAt,
+		LastStatusCode: delivery.LastStatusCode,
+		LastError:      delivery.LastError,
+		CreatedAt:      delivery.CreatedAt,
+		UpdatedAt:      delivery.UpdatedAt,
+	}
+}
+
+func BuildWebhookDeliveries(deliveries []*domainModel.WebhookDelivery) []*model.WebhookDeliveryInfo {
+	return lo.Map(deliveries, func(item *domainModel.WebhookDelivery, index int) *model.WebhookDeliveryInfo {
+		return BuildWebhookDelivery(item)
+	})
+}
@@ -17,8 +17,12 @@ limitations under the License.
 package model
 
 type Address struct {
-	AddressID int64
-	Province  string
-	City      string
-	Detail    string
+	AddressID     int64  `json:"address_id"`
+	UserID        int64  `json:"uid"`
+	Province      string `json:"province"`
+	City          string `json:"city"`
+	Detail        string `json:"detail"`
+	RecipientName string `json:"recipient_name"`
+	Phone         string `json:"phone"`
+	IsDefault     bool   `json:"is_default"`
 }
@@ -0,0 +1,28 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package model
+
+// ApiKey 是商家用于程序化调用接口的凭证, 数据库中只保存 key 的摘要, 完整的 key 只会在创建时返回一次
+type ApiKey struct {
+	KeyID     int64    `json:"key_id"`
+	Uid       int64    `json:"uid"`
+	Name      string   `json:"name"`
+	Prefix    string   `json:"prefix"` // key 的前几位, 用于在列表中区分不同的 key
+	Digest    string   `json:"-"`
+	Scopes    []string `json:"scopes"`
+	CreatedAt int64    `json:"created_at"`
+}
@@ -0,0 +1,41 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package model
+
+// AuditLog 是持久化后的审计事件, 只会追加, 不会被修改或删除
+type AuditLog struct {
+	LogID     int64
+	EventID   string
+	ActorID   int64
+	Action    string
+	Target    string
+	Before    string
+	After     string
+	ClientIP  string
+	TraceID   string
+	CreatedAt int64 // 毫秒时间戳
+}
+
+// AuditLogFilter 是查询审计日志的条件, 零值表示不限制
+type AuditLogFilter struct {
+	ActorID   int64
+	Action    string
+	StartTime int64 // 毫秒时间戳, 包含
+	EndTime   int64 // 毫秒时间戳, 不包含
+	PageNum   int64
+	PageSize  int64
+}
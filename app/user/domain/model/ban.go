@@ -0,0 +1,35 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package model
+
+// Ban 表示一条封禁记录, 解封后记录会保留下来作为封禁历史
+type Ban struct {
+	BanID      int64  `json:"ban_id"`
+	Uid        int64  `json:"uid"`
+	Reason     string `json:"reason"`
+	OperatorID int64  `json:"operator_id"`
+	StartAt    int64  `json:"start_at"`
+	EndAt      int64  `json:"end_at"`    // 封禁结束时间, 0 表示永久封禁
+	LiftedAt   int64  `json:"lifted_at"` // 手动解封的时间, 0 表示没有被手动解封
+	LiftedBy   int64  `json:"lifted_by"`
+}
+
+// BanEvent 是封禁状态变更时发布给网关的事件, 字段需要和网关保持一致
+type BanEvent struct {
+	Uid    int64 `json:"uid"`
+	Banned bool  `json:"banned"` // true 表示封禁, false 表示解封
+}
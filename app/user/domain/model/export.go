@@ -0,0 +1,44 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package model
+
+import "encoding/json"
+
+// UserDataExport 是个人数据导出的整体结构
+// user 服务自身的数据直接序列化, 其他服务导出的数据本身就是 JSON, 以 RawMessage 原样嵌入
+type UserDataExport struct {
+	ExportedAt int64           `json:"exported_at"`
+	Profile    *Profile        `json:"profile"`
+	Addresses  []*Address      `json:"addresses"`
+	Shops      []*ShopStaff    `json:"shops"`
+	Bans       []*Ban          `json:"bans"`
+	Sessions   []*Session      `json:"sessions"`
+	ApiKeys    []*ApiKey       `json:"api_keys"`
+	Cart       json.RawMessage `json:"cart"`
+	Orders     json.RawMessage `json:"orders"`
+	Payments   json.RawMessage `json:"payments"`
+	Coupons    json.RawMessage `json:"coupons"`
+}
+
+// Profile 是导出时使用的用户资料, 不包含密码摘要
+type Profile struct {
+	Uid   int64  `json:"uid"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+	Phone string `json:"phone"`
+	Role  int    `json:"role"`
+}
@@ -0,0 +1,28 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package model
+
+// Session 表示用户在某个设备上的一次登录, 同一会话内签发的 token 共享同一个 SessionID
+type Session struct {
+	SessionID string `json:"session_id"`
+	Uid       int64  `json:"uid"`
+	Device    string `json:"device"`
+	IP        string `json:"ip"`
+	CreatedAt int64  `json:"created_at"`
+	ActiveAt  int64  `json:"active_at"`
+	Current   bool   `json:"-"` // 是否为发起本次请求的会话, 不会持久化
+}
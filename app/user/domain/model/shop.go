@@ -0,0 +1,37 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package model
+
+// Shop 表示一个店铺, 商品归属于店铺而不是某个具体的用户
+type Shop struct {
+	ShopID      int64
+	Name        string
+	Logo        string
+	Description string
+	Status      int
+	OwnerID     int64
+	CreatedAt   int64
+	UpdatedAt   int64
+}
+
+// ShopStaff 表示店铺的一名成员, 店主本身也是店铺成员
+type ShopStaff struct {
+	ShopID    int64 `json:"shop_id"`
+	Uid       int64 `json:"uid"`
+	Role      int   `json:"role"`
+	CreatedAt int64 `json:"created_at"`
+}
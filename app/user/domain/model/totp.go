@@ -0,0 +1,32 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package model
+
+// TOTP 表示用户的两步验证配置, Enabled 为 false 时说明用户还没有完成绑定确认
+type TOTP struct {
+	Uid          int64
+	Secret       string
+	Enabled      bool
+	LastUsedStep int64 // 最近一次校验通过的时间步, 同一个时间步的验证码不能重复使用
+}
+
+// TOTPEnrollment 是绑定两步验证时返回给用户的信息, 恢复码只会在这时以明文出现一次
+type TOTPEnrollment struct {
+	Secret        string
+	URI           string
+	RecoveryCodes []string
+}
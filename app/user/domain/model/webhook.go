@@ -0,0 +1,55 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package model
+
+// WebhookEndpoint 是店铺注册的 webhook 地址, Secret 用于对投递的请求签名, 只会在创建时返回一次
+type WebhookEndpoint struct {
+	EndpointID          int64
+	ShopID              int64
+	URL                 string
+	Secret              string
+	EventTypes          []string
+	Status              int
+	ConsecutiveFailures int64
+	CreatedAt           int64
+	UpdatedAt           int64
+}
+
+// WebhookDelivery 是一个事件对一个端点的投递, 同时作为投递日志和重试队列
+type WebhookDelivery struct {
+	DeliveryID     int64
+	EndpointID     int64
+	EventID        string
+	EventType      string
+	Payload        string
+	Status         int
+	Attempts       int64
+	NextRetryAt    int64 // 毫秒时间戳, 同时用于领取投递时的乐观锁
+	LastStatusCode int64
+	LastError      string
+	CreatedAt      int64
+	UpdatedAt      int64
+}
+
+// WebhookRequest 是一次投递需要的全部信息
+type WebhookRequest struct {
+	URL        string
+	Secret     string
+	DeliveryID int64
+	EventType  string
+	Payload    string
+}
@@ -18,6 +18,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/west2-online/DomTok/app/user/domain/model"
 )
@@ -27,21 +28,111 @@ import (
 // UserDB 表示持久化存储接口 (或者也可以叫做 DBPort)
 type UserDB interface {
 	IsUserExist(ctx context.Context, username string) (bool, error)
+	IsEmailExist(ctx context.Context, email string) (bool, error)
+	IsPhoneExist(ctx context.Context, phone string) (bool, error)
 	CreateUser(ctx context.Context, user *model.User) (int64, error)
 	GetUserInfo(ctx context.Context, username string) (*model.User, error)
 	GetAddressInfo(ctx context.Context, addressID int64) (*model.Address, error)
 	CreateAddress(ctx context.Context, address *model.Address) (int64, error)
+	ListAddresses(ctx context.Context, uid int64) ([]*model.Address, error)
+	UpdateAddress(ctx context.Context, address *model.Address) error
+	DeleteAddress(ctx context.Context, addressID int64) error
+	SetDefaultAddress(ctx context.Context, uid int64, addressID int64) error
 	GetUserById(ctx context.Context, id int64) (*model.User, error)
 	UpdateUser(ctx context.Context, user *model.User) error
+	UpdateUserRole(ctx context.Context, uid int64, role int) error
+	CreateShop(ctx context.Context, shop *model.Shop) (int64, error)
+	GetShopByID(ctx context.Context, shopID int64) (*model.Shop, error)
+	GetShopsByIDs(ctx context.Context, shopIDs []int64) ([]*model.Shop, error)
+	UpdateShop(ctx context.Context, shop *model.Shop) error
+	UpdateShopStatus(ctx context.Context, shopID int64, status int) error
+	IsShopStaff(ctx context.Context, shopID int64, uid int64) (bool, error)
+	AddShopStaff(ctx context.Context, staff *model.ShopStaff) error
+	RemoveShopStaff(ctx context.Context, shopID int64, uid int64) error
+	ListShopStaff(ctx context.Context, shopID int64) ([]*model.ShopStaff, error)
+	ListUserShops(ctx context.Context, uid int64) ([]*model.ShopStaff, error)
+	CreateBan(ctx context.Context, ban *model.Ban) (int64, error)
+	GetActiveBan(ctx context.Context, uid int64) (*model.Ban, error)
+	ListActiveBans(ctx context.Context) ([]*model.Ban, error)
+	ListBans(ctx context.Context, uid int64) ([]*model.Ban, error)
+	LiftBan(ctx context.Context, banID int64, operatorID int64) error
+	AnonymizeUser(ctx context.Context, uid int64) error
+	GetTOTP(ctx context.Context, uid int64) (*model.TOTP, error)
+	SaveTOTP(ctx context.Context, totp *model.TOTP, recoveryCodes []string) error
+	EnableTOTP(ctx context.Context, uid int64) error
+	DeleteTOTP(ctx context.Context, uid int64) error
+	UseTOTPStep(ctx context.Context, uid int64, step int64) (bool, error)
+	UseRecoveryCode(ctx context.Context, uid int64, digest string) (bool, error)
+	CreateApiKey(ctx context.Context, key *model.ApiKey) (int64, error)
+	CountApiKeys(ctx context.Context, uid int64) (int64, error)
+	ListApiKeys(ctx context.Context, uid int64) ([]*model.ApiKey, error)
+	GetApiKeyByDigest(ctx context.Context, digest string) (*model.ApiKey, error)
+	DeleteApiKey(ctx context.Context, uid int64, keyID int64) (bool, error)
+	CreateAuditLog(ctx context.Context, log *model.AuditLog) error
+	ListAuditLogs(ctx context.Context, filter *model.AuditLogFilter) ([]*model.AuditLog, int64, error)
+	CreateWebhookEndpoint(ctx context.Context, endpoint *model.WebhookEndpoint) (int64, error)
+	CountWebhookEndpoints(ctx context.Context, shopID int64) (int64, error)
+	GetWebhookEndpoint(ctx context.Context, endpointID int64) (*model.WebhookEndpoint, error)
+	ListWebhookEndpoints(ctx context.Context, shopID int64) ([]*model.WebhookEndpoint, error)
+	UpdateWebhookEndpoint(ctx context.Context, endpoint *model.WebhookEndpoint) error
+	DeleteWebhookEndpoint(ctx context.Context, endpointID int64) error
+	IncrWebhookEndpointFailures(ctx context.Context, endpointID int64) (int64, error)
+	ResetWebhookEndpointFailures(ctx context.Context, endpointID int64) error
+	DisableWebhookEndpoint(ctx context.Context, endpointID int64) error
+	CreateWebhookDelivery(ctx context.Context, delivery *model.WebhookDelivery) (bool, error)
+	GetWebhookDelivery(ctx context.Context, deliveryID int64) (*model.WebhookDelivery, error)
+	ListWebhookDeliveries(ctx context.Context, endpointID int64, pageNum, pageSize int64) ([]*model.WebhookDelivery, int64, error)
+	ListDueWebhookDeliveries(ctx context.Context, now int64, limit int) ([]*model.WebhookDelivery, error)
+	ClaimWebhookDelivery(ctx context.Context, delivery *model.WebhookDelivery, leaseUntil int64) (bool, error)
+	UpdateWebhookDelivery(ctx context.Context, delivery *model.WebhookDelivery) error
 }
 
 type UserCache interface {
 	IsExist(ctx context.Context, key string) bool
-	SetUserLogOut(ctx context.Context, key string, token string) error
-	DeleteUserLogOut(ctx context.Context, key string) error
-	SetUserBaned(ctx context.Context, key string) error
+	SetUserBaned(ctx context.Context, key string, expiration time.Duration) error
 	DeleteUserBaned(ctx context.Context, key string) error
-	UserLogOutKey(uid int64) string
+	PublishBanEvent(ctx context.Context, event *model.BanEvent) error
 	UserBanedKey(uid int64) string
-	GetToken(ctx context.Context, key string) (string, error)
+	SetSession(ctx context.Context, session *model.Session, tokenID string) error
+	RotateSessionToken(ctx context.Context, uid int64, sessionID string, presented string, next string) (int, error)
+	GetSession(ctx context.Context, sessionID string) (*model.Session, error)
+	ListUserSessions(ctx context.Context, uid int64) ([]*model.Session, error)
+	DeleteSession(ctx context.Context, uid int64, sessionID string) error
+	DeleteUserSessions(ctx context.Context, uid int64) error
+	SessionKey(sessionID string) string
+	UserSessionKey(uid int64) string
+	SetPasswordResetCode(ctx context.Context, uid int64, code string) error
+	GetPasswordResetCodeTTL(ctx context.Context, uid int64) (time.Duration, error)
+	VerifyPasswordResetCode(ctx context.Context, uid int64, code string) (int, error)
+	PasswordResetKey(uid int64) string
+	RecordLoginFailure(ctx context.Context, key string) (int64, error)
+	ClearLoginFailures(ctx context.Context, keys ...string) error
+	LockLogin(ctx context.Context, key string, duration time.Duration) error
+	GetLoginLockTTL(ctx context.Context, key string) (time.Duration, error)
+	LoginFailureUserKey(username string) string
+	LoginFailureIPKey(ip string) string
+	LoginLockUserKey(username string) string
+	LoginLockIPKey(ip string) string
+}
+
+// RPC 表示 user 服务对其他服务的依赖, 目前用于导出个人数据和注销账号时清理各服务中的数据
+type RPC interface {
+	ExportCartData(ctx context.Context, uid int64) (string, error)
+	ExportOrderData(ctx context.Context, uid int64) (string, error)
+	ExportPaymentData(ctx context.Context, uid int64) (string, error)
+	ExportCouponData(ctx context.Context, uid int64) (string, error)
+	EraseCartData(ctx context.Context, uid int64) error
+	EraseOrderData(ctx context.Context, uid int64) error
+	EraseCouponData(ctx context.Context, uid int64) error
+}
+
+// WebhookSender 负责把 webhook 事件以签名后的 HTTP POST 投递给商家,
+// 没有收到响应时 statusCode 为 0, 商家返回非 2xx 时也会返回 error
+type WebhookSender interface {
+	Send(ctx context.Context, req *model.WebhookRequest) (statusCode int, err error)
+}
+
+// Notifier 负责把通知投递给用户, 具体的投递渠道(邮件, 短信, 日志等)由 infrastructure 实现
+type Notifier interface {
+	Notify(ctx context.Context, n *model.Notification) error
 }
@@ -0,0 +1,76 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/west2-online/DomTok/app/user/domain/model"
+)
+
+// BuildUserDataExport 收集 user 服务自身持有的用户数据, 其他服务的数据由 use case 通过 rpc 补全
+func (svc *UserService) BuildUserDataExport(ctx context.Context, u *model.User) (*model.UserDataExport, error) {
+	addresses, err := svc.db.ListAddresses(ctx, u.Uid)
+	if err != nil {
+		return nil, fmt.Errorf("domain.svc.BuildUserDataExport failed: %w", err)
+	}
+	shops, err := svc.db.ListUserShops(ctx, u.Uid)
+	if err != nil {
+		return nil, fmt.Errorf("domain.svc.BuildUserDataExport failed: %w", err)
+	}
+	bans, err := svc.db.ListBans(ctx, u.Uid)
+	if err != nil {
+		return nil, fmt.Errorf("domain.svc.BuildUserDataExport failed: %w", err)
+	}
+	sessions, err := svc.cache.ListUserSessions(ctx, u.Uid)
+	if err != nil {
+		return nil, fmt.Errorf("domain.svc.BuildUserDataExport failed: %w", err)
+	}
+	apiKeys, err := svc.db.ListApiKeys(ctx, u.Uid)
+	if err != nil {
+		return nil, fmt.Errorf("domain.svc.BuildUserDataExport failed: %w", err)
+	}
+
+	return &model.UserDataExport{
+		ExportedAt: time.Now().Unix(),
+		Profile: &model.Profile{
+			Uid:   u.Uid,
+			Name:  u.UserName,
+			Email: u.Email,
+			Phone: u.Phone,
+			Role:  u.Role,
+		},
+		Addresses: addresses,
+		Shops:     shops,
+		Bans:      bans,
+		Sessions:  sessions,
+		ApiKeys:   apiKeys,
+	}, nil
+}
+
+// CloseAccount 匿名化 user 服务中的个人信息并吊销所有会话, 调用前需要确保其他服务中的数据已经清理完毕
+func (svc *UserService) CloseAccount(ctx context.Context, uid int64) error {
+	if err := svc.db.AnonymizeUser(ctx, uid); err != nil {
+		return fmt.Errorf("domain.svc.CloseAccount failed: %w", err)
+	}
+	if err := svc.cache.DeleteUserSessions(ctx, uid); err != nil {
+		return fmt.Errorf("domain.svc.CloseAccount failed: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/samber/lo"
+
+	"github.com/west2-online/DomTok/app/user/domain/model"
+	"github.com/west2-online/DomTok/pkg/constants"
+	"github.com/west2-online/DomTok/pkg/errno"
+	"github.com/west2-online/DomTok/pkg/rbac"
+)
+
+// CreateApiKey 为用户生成一个新的 API Key 并保存摘要, 返回的明文 key 只会出现这一次
+func (svc *UserService) CreateApiKey(ctx context.Context, key *model.ApiKey) (string, error) {
+	count, err := svc.db.CountApiKeys(ctx, key.Uid)
+	if err != nil {
+		return "", fmt.Errorf("domain.svc.CreateApiKey failed: %w", err)
+	}
+	if count >= constants.ApiKeyMaximumPerUser {
+		return "", errno.Errorf(errno.ParamVerifyErrorCode, "at most %d api keys are allowed", constants.ApiKeyMaximumPerUser)
+	}
+
+	buf := make([]byte, constants.ApiKeySize)
+	if _, err = rand.Read(buf); err != nil {
+		return "", errno.Errorf(errno.InternalServiceErrorCode, "generate api key failed: %v", err)
+	}
+	plaintext := constants.ApiKeyPrefix + strings.ToLower(base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(buf))
+
+	key.Scopes = lo.Uniq(key.Scopes)
+	key.Prefix = plaintext[:constants.ApiKeyDisplayLength]
+	key.Digest = hashApiKey(plaintext)
+	if _, err = svc.db.CreateApiKey(ctx, key); err != nil {
+		return "", fmt.Errorf("domain.svc.CreateApiKey failed: %w", err)
+	}
+	return plaintext, nil
+}
+
+// CheckApiKey 校验 API Key 并返回 key 的信息和持有者
+// 持有者失去管理商品的权限后(例如角色被调整), 或者被封禁、注销后, 名下的 key 会随之失效
+func (svc *UserService) CheckApiKey(ctx context.Context, plaintext string) (*model.ApiKey, *model.User, error) {
+	if !strings.HasPrefix(plaintext, constants.ApiKeyPrefix) {
+		return nil, nil, errno.NewErrNo(errno.AuthInvalidCode, "invalid api key")
+	}
+	key, err := svc.db.GetApiKeyByDigest(ctx, hashApiKey(plaintext))
+	if err != nil {
+		return nil, nil, fmt.Errorf("domain.svc.CheckApiKey failed: %w", err)
+	}
+	if key == nil {
+		return nil, nil, errno.NewErrNo(errno.AuthInvalidCode, "invalid api key")
+	}
+
+	u, err := svc.db.GetUserById(ctx, key.Uid)
+	if err != nil {
+		return nil, nil, fmt.Errorf("domain.svc.CheckApiKey failed: %w", err)
+	}
+	if !slices.Contains(rbac.Permissions(u.Role), constants.PermissionCommodityManage) {
+		return nil, nil, errno.AuthNoOperatePermission.WithMessage("api key owner is not allowed to manage commodity")
+	}
+	if strings.HasPrefix(u.UserName, constants.ClosedAccountNamePrefix) {
+		return nil, nil, errno.NewErrNo(errno.AuthInvalidCode, "api key owner account was closed")
+	}
+	baned, err := svc.IsBaned(ctx, u.Uid)
+	if err != nil {
+		return nil, nil, fmt.Errorf("domain.svc.CheckApiKey failed: %w", err)
+	}
+	if baned {
+		return nil, nil, errno.AuthNoOperatePermission.WithMessage("api key owner was baned")
+	}
+	return key, u, nil
+}
+
+// RevokeApiKey 吊销用户持有的 API Key, 吊销后立即失效
+func (svc *UserService) RevokeApiKey(ctx context.Context, uid int64, keyID int64) error {
+	ok, err := svc.db.DeleteApiKey(ctx, uid, keyID)
+	if err != nil {
+		return fmt.Errorf("domain.svc.RevokeApiKey failed: %w", err)
+	}
+	if !ok {
+		return errno.NewErrNo(errno.ErrRecordNotFound, "api key not found")
+	}
+	return nil
+}
+
+// hashApiKey 计算 API Key 的摘要, key 本身有足够的随机性, 使用 sha256 即可, 同时也便于按摘要查找
+func hashApiKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
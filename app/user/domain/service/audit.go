@@ -0,0 +1,80 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/west2-online/DomTok/app/user/domain/model"
+	"github.com/west2-online/DomTok/pkg/audit"
+	"github.com/west2-online/DomTok/pkg/constants"
+	"github.com/west2-online/DomTok/pkg/logger"
+)
+
+// recordAudit 发布一条审计事件, 用户服务自身的管理类操作也和其他服务一样经过 kafka 持久化
+func (svc *UserService) recordAudit(ctx context.Context, action string, uid int64, before, after any) {
+	audit.Record(ctx, svc.audit, action, audit.Target("user", uid), before, after)
+}
+
+// SaveAuditEvents 持续将各服务发布的审计事件写入数据库, 直到 events 被关闭或 ctx 被取消
+func (svc *UserService) SaveAuditEvents(ctx context.Context, events <-chan *audit.Event) {
+	for {
+		var e *audit.Event
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			e = event
+		}
+
+		err := svc.db.CreateAuditLog(ctx, &model.AuditLog{
+			EventID:   e.EventID,
+			ActorID:   e.ActorID,
+			Action:    e.Action,
+			Target:    e.Target,
+			Before:    e.Before,
+			After:     e.After,
+			ClientIP:  e.ClientIP,
+			TraceID:   e.TraceID,
+			CreatedAt: e.CreatedAt,
+		})
+		if err != nil {
+			logger.Errorf("UserService.SaveAuditEvents: save event %s failed: %v", e.EventID, err)
+		}
+	}
+}
+
+// ListAuditLogs 查询审计日志, 没有指定分页时使用默认的每页数量
+func (svc *UserService) ListAuditLogs(ctx context.Context, filter *model.AuditLogFilter) ([]*model.AuditLog, int64, error) {
+	if filter.PageNum <= 0 {
+		filter.PageNum = 1
+	}
+	if filter.PageSize <= 0 {
+		filter.PageSize = constants.AuditLogDefaultPageSize
+	}
+	filter.PageSize = min(filter.PageSize, constants.AuditLogMaxPageSize)
+
+	logs, total, err := svc.db.ListAuditLogs(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("domain.svc.ListAuditLogs failed: %w", err)
+	}
+	return logs, total, nil
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/west2-online/DomTok/app/user/domain/model"
+	"github.com/west2-online/DomTok/pkg/constants"
+	"github.com/west2-online/DomTok/pkg/logger"
+)
+
+// ListBanHistory 获取用户所有的封禁记录, 包括已经到期和已经解封的
+func (svc *UserService) ListBanHistory(ctx context.Context, uid int64) ([]*model.Ban, error) {
+	if _, err := svc.db.GetUserById(ctx, uid); err != nil {
+		return nil, fmt.Errorf("domain.svc.ListBanHistory failed: %w", err)
+	}
+
+	bans, err := svc.db.ListBans(ctx, uid)
+	if err != nil {
+		return nil, fmt.Errorf("domain.svc.ListBanHistory failed: %w", err)
+	}
+	return bans, nil
+}
+
+// RebuildBanCache 根据 mysql 中生效的封禁记录重建 redis 中的封禁标记
+// 服务启动时调用, 避免 redis 数据丢失后被封禁的用户可以正常使用
+func (svc *UserService) RebuildBanCache(ctx context.Context) error {
+	bans, err := svc.db.ListActiveBans(ctx)
+	if err != nil {
+		return fmt.Errorf("domain.svc.RebuildBanCache failed: %w", err)
+	}
+
+	now := time.Now()
+	for _, ban := range bans {
+		expiration := time.Duration(constants.NeverExpire)
+		if ban.EndAt != 0 {
+			expiration = time.Unix(ban.EndAt, 0).Sub(now)
+			if expiration <= 0 {
+				continue
+			}
+		}
+		if err = svc.cache.SetUserBaned(ctx, svc.cache.UserBanedKey(ban.Uid), expiration); err != nil {
+			return fmt.Errorf("domain.svc.RebuildBanCache failed: %w", err)
+		}
+	}
+	return nil
+}
+
+// publishBanEvent 通知网关封禁状态发生了变化
+// 封禁已经落库并写入 redis, 发布失败时网关会在下一次全量重建时同步, 所以这里只记录日志
+func (svc *UserService) publishBanEvent(ctx context.Context, uid int64, banned bool) {
+	if err := svc.cache.PublishBanEvent(ctx, &model.BanEvent{Uid: uid, Banned: banned}); err != nil {
+		logger.Errorf("domain.svc.publishBanEvent failed: %v", err)
+	}
+}
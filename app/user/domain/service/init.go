@@ -20,6 +20,7 @@ import (
 	"regexp"
 
 	"github.com/west2-online/DomTok/app/user/domain/repository"
+	"github.com/west2-online/DomTok/pkg/audit"
 	"github.com/west2-online/DomTok/pkg/utils"
 )
 
@@ -27,11 +28,18 @@ type UserService struct {
 	db      repository.UserDB
 	sf      *utils.Snowflake
 	emailRe *regexp.Regexp
+	phoneRe *regexp.Regexp
 	cache   repository.UserCache
+	nf      repository.Notifier
+	audit   audit.Publisher
+	sender  repository.WebhookSender
+	wake    chan struct{} // 有新的投递时唤醒投递循环, 不必等到下一次扫描
 }
 
 // NewUserService 返回一个 NewUserService 实例
-func NewUserService(db repository.UserDB, sf *utils.Snowflake, redis repository.UserCache) *UserService {
+func NewUserService(db repository.UserDB, sf *utils.Snowflake, redis repository.UserCache, nf repository.Notifier,
+	auditor audit.Publisher, sender repository.WebhookSender,
+) *UserService {
 	if db == nil {
 		panic("userService`s db should not be nil")
 	}
@@ -40,9 +48,13 @@ func NewUserService(db repository.UserDB, sf *utils.Snowflake, redis repository.
 	}
 
 	svc := &UserService{
-		db:    db,
-		sf:    sf,
-		cache: redis,
+		db:     db,
+		sf:     sf,
+		cache:  redis,
+		nf:     nf,
+		audit:  auditor,
+		sender: sender,
+		wake:   make(chan struct{}, 1),
 	}
 	svc.init()
 
@@ -51,9 +63,14 @@ func NewUserService(db repository.UserDB, sf *utils.Snowflake, redis repository.
 
 func (svc *UserService) init() {
 	svc.initEmailRe()
+	svc.initPhoneRe()
 }
 
 // 理论上来说这也是需要外部注入以及常量管理规则的, 但我懒得搞了, 就放在这吧, 有心思的可以优化一下
 func (svc *UserService) initEmailRe() {
 	svc.emailRe = regexp.MustCompile(`^[A-Za-z0-9\x{4e00}-\x{9fa5}]+@[a-zA-Z0-9_-]+(\.[a-zA-Z0-9_-]+)+$`)
 }
+
+func (svc *UserService) initPhoneRe() {
+	svc.phoneRe = regexp.MustCompile(`^1[3-9]\d{9}$`)
+}
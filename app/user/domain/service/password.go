@@ -0,0 +1,93 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+
+	"github.com/west2-online/DomTok/app/user/domain/model"
+	"github.com/west2-online/DomTok/pkg/constants"
+	"github.com/west2-online/DomTok/pkg/errno"
+)
+
+// UpdatePassword 加密新密码并保存
+func (svc *UserService) UpdatePassword(ctx context.Context, uid int64, password string) error {
+	digest, err := svc.EncryptPassword(password)
+	if err != nil {
+		return err
+	}
+	if err = svc.db.UpdateUser(ctx, &model.User{Uid: uid, Password: digest}); err != nil {
+		return fmt.Errorf("domain.svc.UpdatePassword failed: %w", err)
+	}
+	return nil
+}
+
+// SendPasswordResetCode 生成重置密码的验证码并通过 Notifier 发送给用户
+// 验证码发送后的一段时间内不允许重复发送, 避免被用来骚扰用户
+func (svc *UserService) SendPasswordResetCode(ctx context.Context, user *model.User) error {
+	ttl, err := svc.cache.GetPasswordResetCodeTTL(ctx, user.Uid)
+	if err != nil {
+		return fmt.Errorf("domain.svc.SendPasswordResetCode failed: %w", err)
+	}
+	if ttl > constants.PasswordResetCodeExpireTime-constants.PasswordResetResendInterval {
+		return errno.NewErrNo(errno.RepeatedOperation, "reset code has been sent, please try again later")
+	}
+
+	code, err := generateResetCode()
+	if err != nil {
+		return err
+	}
+	if err = svc.cache.SetPasswordResetCode(ctx, user.Uid, code); err != nil {
+		return fmt.Errorf("domain.svc.SendPasswordResetCode failed: %w", err)
+	}
+
+	err = svc.nf.Notify(ctx, &model.Notification{
+		Uid:     user.Uid,
+		Email:   user.Email,
+		Subject: "DomTok password reset",
+		Content: fmt.Sprintf("your password reset code is %s, it will expire in %v", code, constants.PasswordResetCodeExpireTime),
+	})
+	if err != nil {
+		return fmt.Errorf("domain.svc.SendPasswordResetCode failed: %w", err)
+	}
+	return nil
+}
+
+// CheckPasswordResetCode 校验重置密码的验证码, 验证码校验通过后即失效
+func (svc *UserService) CheckPasswordResetCode(ctx context.Context, uid int64, code string) error {
+	res, err := svc.cache.VerifyPasswordResetCode(ctx, uid, code)
+	if err != nil {
+		return fmt.Errorf("domain.svc.CheckPasswordResetCode failed: %w", err)
+	}
+	if res != constants.PasswordResetCodeValid {
+		return errno.NewErrNo(errno.ServiceWrongResetCode, "wrong or expired reset code")
+	}
+	return nil
+}
+
+// generateResetCode 生成固定长度的数字验证码
+func generateResetCode() (string, error) {
+	upper := new(big.Int).Exp(big.NewInt(10), big.NewInt(constants.PasswordResetCodeLength), nil)
+	n, err := rand.Int(rand.Reader, upper)
+	if err != nil {
+		return "", errno.Errorf(errno.InternalServiceErrorCode, "generate reset code failed: %v", err)
+	}
+	return fmt.Sprintf("%0*d", constants.PasswordResetCodeLength, n), nil
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/west2-online/DomTok/app/user/domain/model"
+	"github.com/west2-online/DomTok/pkg/errno"
+)
+
+// CheckProfileUnique 检查新的用户名, 邮箱和手机号是否已经被其他用户使用, 空字段表示不修改, 不做检查
+func (svc *UserService) CheckProfileUnique(ctx context.Context, profile *model.User) error {
+	if profile.UserName != "" {
+		exist, err := svc.db.IsUserExist(ctx, profile.UserName)
+		if err != nil {
+			return fmt.Errorf("domain.svc.CheckProfileUnique failed: %w", err)
+		}
+		if exist {
+			return errno.NewErrNo(errno.ServiceUserExist, "username already in use")
+		}
+	}
+	if profile.Email != "" {
+		exist, err := svc.db.IsEmailExist(ctx, profile.Email)
+		if err != nil {
+			return fmt.Errorf("domain.svc.CheckProfileUnique failed: %w", err)
+		}
+		if exist {
+			return errno.NewErrNo(errno.ServiceEmailExist, "email already in use")
+		}
+	}
+	if profile.Phone != "" {
+		exist, err := svc.db.IsPhoneExist(ctx, profile.Phone)
+		if err != nil {
+			return fmt.Errorf("domain.svc.CheckProfileUnique failed: %w", err)
+		}
+		if exist {
+			return errno.NewErrNo(errno.ServicePhoneExist, "phone already in use")
+		}
+	}
+	return nil
+}
+
+// UpdateProfile 保存用户资料, 只会更新非空的字段
+func (svc *UserService) UpdateProfile(ctx context.Context, profile *model.User) error {
+	if err := svc.CheckProfileUnique(ctx, profile); err != nil {
+		return err
+	}
+	if err := svc.db.UpdateUser(ctx, profile); err != nil {
+		return fmt.Errorf("domain.svc.UpdateProfile failed: %w", err)
+	}
+	return nil
+}
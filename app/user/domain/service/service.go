@@ -19,6 +19,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"golang.org/x/crypto/bcrypt"
 
@@ -27,7 +28,6 @@ import (
 	metadata "github.com/west2-online/DomTok/pkg/base/context"
 	"github.com/west2-online/DomTok/pkg/constants"
 	"github.com/west2-online/DomTok/pkg/errno"
-	"github.com/west2-online/DomTok/pkg/utils"
 )
 
 func (svc *UserService) EncryptPassword(pwd string) (string, error) {
@@ -62,6 +62,15 @@ func (svc *UserService) GetAddress(ctx context.Context, addressID int64) (addres
 }
 
 func (svc *UserService) AddAddress(ctx context.Context, address *model.Address) (addressID int64, err error) {
+	// 用户的第一个地址自动成为默认地址
+	addresses, err := svc.db.ListAddresses(ctx, address.UserID)
+	if err != nil {
+		return 0, fmt.Errorf("domain.svc.AddAddress failed: %w", err)
+	}
+	if len(addresses) == 0 {
+		address.IsDefault = true
+	}
+
 	addressID, err = svc.db.CreateAddress(ctx, address)
 	if err != nil {
 		return 0, fmt.Errorf("domain.svc.AddAddress failed: %w", err)
@@ -69,36 +78,53 @@ func (svc *UserService) AddAddress(ctx context.Context, address *model.Address)
 	return addressID, nil
 }
 
-func (svc *UserService) UserLogin(ctx context.Context, uid int64) error {
-	key := svc.cache.UserLogOutKey(uid)
-	var token string
-	var err error
-	exist := svc.cache.IsExist(ctx, key)
-	if exist {
-		oldToken, err := svc.cache.GetToken(ctx, key)
-		if err != nil {
-			return fmt.Errorf("domain.svc.UserLogOut failed: %w", err)
-		}
-		_, _, err = utils.CheckToken(oldToken)
-		if err != nil {
-			return fmt.Errorf("domain.svc.UserLogOut failed: %w", err)
-		}
+func (svc *UserService) ListAddresses(ctx context.Context, uid int64) ([]*model.Address, error) {
+	addresses, err := svc.db.ListAddresses(ctx, uid)
+	if err != nil {
+		return nil, fmt.Errorf("domain.svc.ListAddresses failed: %w", err)
+	}
+	return addresses, nil
+}
+
+func (svc *UserService) UpdateAddress(ctx context.Context, address *model.Address) error {
+	if err := svc.db.UpdateAddress(ctx, address); err != nil {
+		return fmt.Errorf("domain.svc.UpdateAddress failed: %w", err)
+	}
+	return nil
+}
+
+// DeleteAddress 删除地址, 如果删除的是默认地址, 会把剩余地址中最新的一个设为默认地址
+func (svc *UserService) DeleteAddress(ctx context.Context, address *model.Address) error {
+	if err := svc.db.DeleteAddress(ctx, address.AddressID); err != nil {
+		return fmt.Errorf("domain.svc.DeleteAddress failed: %w", err)
+	}
+	if !address.IsDefault {
 		return nil
-	} else {
-		token, err = utils.CreateToken(constants.TypeUserLoginToken, uid)
-		if err != nil {
-			return errno.NewErrNo(errno.InternalServiceErrorCode, fmt.Sprintf("create token failed, err: %v", err))
-		}
 	}
 
-	err = svc.cache.SetUserLogOut(ctx, key, token)
+	remains, err := svc.db.ListAddresses(ctx, address.UserID)
 	if err != nil {
-		return errno.Errorf(errno.InternalRedisErrorCode, "domain.svc.UserLogin failed: %v", err)
+		return fmt.Errorf("domain.svc.DeleteAddress failed: %w", err)
+	}
+	if len(remains) == 0 {
+		return nil
+	}
+	if err = svc.db.SetDefaultAddress(ctx, address.UserID, remains[0].AddressID); err != nil {
+		return fmt.Errorf("domain.svc.DeleteAddress failed: %w", err)
+	}
+	return nil
+}
+
+func (svc *UserService) SetDefaultAddress(ctx context.Context, uid int64, addressID int64) error {
+	if err := svc.db.SetDefaultAddress(ctx, uid, addressID); err != nil {
+		return fmt.Errorf("domain.svc.SetDefaultAddress failed: %w", err)
 	}
 	return nil
 }
 
-func (svc *UserService) UserBaned(ctx context.Context, uid int64) error {
+// UserBaned 封禁用户, duration 为 0 时表示永久封禁
+// 封禁记录以 mysql 为准, redis 中的 key 只是给网关和登录使用的缓存, 临时封禁到期后会自动过期
+func (svc *UserService) UserBaned(ctx context.Context, uid int64, reason string, duration time.Duration) error {
 	u, err := svc.db.GetUserById(ctx, uid)
 	if err != nil {
 		return fmt.Errorf("domain.svc.UserBaned failed: %w", err)
@@ -113,32 +139,42 @@ func (svc *UserService) UserBaned(ctx context.Context, uid int64) error {
 		return errno.NewErrNo(errno.ParamVerifyErrorCode, "can not do this at self")
 	}
 
-	myInfo, err := svc.db.GetUserById(ctx, me)
+	// 调用方是否拥有封禁权限已经由 middleware.Permission 校验过了, 这里只需要保护超级管理员
+	if u.Role == constants.RoleSuperAdmin {
+		return errno.NewErrNo(errno.AuthNoOperatePermissionCode, "domain.svc.UserBaned failed: role is administrator")
+	}
+
+	active, err := svc.db.GetActiveBan(ctx, uid)
 	if err != nil {
 		return fmt.Errorf("domain.svc.UserBaned failed: %w", err)
 	}
-
-	if myInfo.Role != constants.UserAdministrator {
-		return errno.NewErrNo(errno.AuthNoOperatePermissionCode, "permission denied")
+	if active != nil {
+		return errno.NewErrNo(errno.RepeatedOperation, "domain.svc.UserBaned failed, already banned user")
 	}
 
-	if u.Role == constants.UserAdministrator {
-		return errno.NewErrNo(errno.AuthNoOperatePermissionCode, "domain.svc.UserBaned failed: role is administrator")
+	now := time.Now()
+	ban := &model.Ban{
+		Uid:        uid,
+		Reason:     reason,
+		OperatorID: me,
+		StartAt:    now.Unix(),
+	}
+	if duration > 0 {
+		ban.EndAt = now.Add(duration).Unix()
+	}
+	if _, err = svc.db.CreateBan(ctx, ban); err != nil {
+		return fmt.Errorf("domain.svc.UserBaned failed: %w", err)
 	}
 
-	key := svc.cache.UserBanedKey(uid)
-	exist := svc.cache.IsExist(ctx, key)
-	if !exist {
-		err = svc.cache.SetUserBaned(ctx, key)
-		if err != nil {
-			return fmt.Errorf("domain.svc.UserBaned failed: %w", err)
-		}
-		return nil
-	} else {
-		return errno.NewErrNo(errno.RepeatedOperation, "domain.svc.UserBaned failed, already banned user")
+	if err = svc.cache.SetUserBaned(ctx, svc.cache.UserBanedKey(uid), duration); err != nil {
+		return fmt.Errorf("domain.svc.UserBaned failed: %w", err)
 	}
+	svc.publishBanEvent(ctx, uid, true)
+	svc.recordAudit(ctx, constants.AuditActionBanUser, uid, nil, ban)
+	return nil
 }
 
+// LiftUserBaned 手动解封用户, 封禁记录会保留并标记解封的时间和操作人
 func (svc *UserService) LiftUserBaned(ctx context.Context, uid int64) error {
 	_, err := svc.db.GetUserById(ctx, uid)
 	if err != nil {
@@ -150,44 +186,45 @@ func (svc *UserService) LiftUserBaned(ctx context.Context, uid int64) error {
 		return fmt.Errorf("domain.svc.LiftUserBaned failed: %w", err)
 	}
 
-	myInfo, err := svc.db.GetUserById(ctx, me)
+	active, err := svc.db.GetActiveBan(ctx, uid)
 	if err != nil {
 		return fmt.Errorf("domain.svc.LiftUserBaned failed: %w", err)
 	}
-
-	if myInfo.Role != constants.UserAdministrator {
-		return errno.NewErrNo(errno.AuthNoOperatePermissionCode, "permission denied")
+	if active == nil {
+		return errno.NewErrNo(errno.RepeatedOperation, "domain.svc.LiftUserBaned failed, already normal user")
 	}
 
-	key := svc.cache.UserBanedKey(uid)
-	exist := svc.cache.IsExist(ctx, key)
-	if exist {
-		err = svc.cache.DeleteUserBaned(ctx, key)
-		if err != nil {
-			return fmt.Errorf("domain.svc.LiftUserBaned failed: %w", err)
-		}
-		return nil
-	} else {
-		return errno.NewErrNo(errno.RepeatedOperation, "domain.svc.LiftUserBaned failed, already normal user")
+	if err = svc.db.LiftBan(ctx, active.BanID, me); err != nil {
+		return fmt.Errorf("domain.svc.LiftUserBaned failed: %w", err)
+	}
+	if err = svc.cache.DeleteUserBaned(ctx, svc.cache.UserBanedKey(uid)); err != nil {
+		return fmt.Errorf("domain.svc.LiftUserBaned failed: %w", err)
 	}
+	svc.publishBanEvent(ctx, uid, false)
+	lifted := *active
+	lifted.LiftedAt, lifted.LiftedBy = time.Now().Unix(), me
+	svc.recordAudit(ctx, constants.AuditActionLiftBan, uid, active, &lifted)
+	return nil
 }
 
+// Logout 退出当前会话, 用户在其他设备上的登录不受影响
 func (svc *UserService) Logout(ctx context.Context) error {
 	uid, err := metadata.GetLoginData(ctx)
 	if err != nil {
 		return fmt.Errorf("domain.svc.Logout failed: %w", err)
 	}
-	key := svc.cache.UserLogOutKey(uid)
-	exist := svc.cache.IsExist(ctx, key)
-	if exist {
-		err = svc.cache.DeleteUserLogOut(ctx, key)
-		if err != nil {
-			return fmt.Errorf("domain.svc.Logout failed: %w", err)
-		}
-		return nil
-	} else {
+	sessionID, err := metadata.GetSessionData(ctx)
+	if err != nil {
+		return fmt.Errorf("domain.svc.Logout failed: %w", err)
+	}
+
+	if !svc.cache.IsExist(ctx, svc.cache.SessionKey(sessionID)) {
 		return errno.NewErrNo(errno.RepeatedOperation, "domain.svc.Logout failed, already logout")
 	}
+	if err = svc.cache.DeleteSession(ctx, uid, sessionID); err != nil {
+		return fmt.Errorf("domain.svc.Logout failed: %w", err)
+	}
+	return nil
 }
 
 func (svc *UserService) SetAdministrator(ctx context.Context, uid int64, password []byte, action int) error {
@@ -196,7 +233,7 @@ func (svc *UserService) SetAdministrator(ctx context.Context, uid int64, passwor
 		return errno.NewErrNo(errno.AuthNoOperatePermissionCode, "wrong secret")
 	}
 
-	_, err = svc.db.GetUserById(ctx, uid)
+	u, err := svc.db.GetUserById(ctx, uid)
 	if err != nil {
 		return errno.Errorf(errno.InternalServiceErrorCode, "domain.svc.SetAdministrator failed: %v", err)
 	}
@@ -205,13 +242,11 @@ func (svc *UserService) SetAdministrator(ctx context.Context, uid int64, passwor
 		return errno.NewErrNo(errno.ParamVerifyErrorCode, "action type error")
 	}
 
-	err = svc.db.UpdateUser(ctx, &model.User{
-		Uid:  uid,
-		Role: action,
-	})
+	err = svc.db.UpdateUserRole(ctx, uid, action)
 	if err != nil {
 		return errno.NewErrNo(errno.InternalServiceErrorCode, "domain.svc.SetAdministrator failed")
 	}
+	svc.recordAudit(ctx, constants.AuditActionSetAdministrator, uid, map[string]int{"role": u.Role}, map[string]int{"role": action})
 	return nil
 }
 
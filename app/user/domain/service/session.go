@@ -0,0 +1,63 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/west2-online/DomTok/app/user/domain/model"
+	"github.com/west2-online/DomTok/pkg/errno"
+)
+
+// ListSessions 列出用户所有的登录会话, 按最近活跃时间倒序排列
+func (svc *UserService) ListSessions(ctx context.Context, uid int64) ([]*model.Session, error) {
+	sessions, err := svc.cache.ListUserSessions(ctx, uid)
+	if err != nil {
+		return nil, fmt.Errorf("domain.svc.ListSessions failed: %w", err)
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].ActiveAt > sessions[j].ActiveAt
+	})
+	return sessions, nil
+}
+
+// RevokeSession 吊销用户的某个会话, 只能吊销属于自己的会话
+func (svc *UserService) RevokeSession(ctx context.Context, uid int64, sessionID string) error {
+	session, err := svc.cache.GetSession(ctx, sessionID)
+	if err != nil {
+		return fmt.Errorf("domain.svc.RevokeSession failed: %w", err)
+	}
+	// 不存在和不属于自己的会话返回同样的错误, 避免泄露其他用户的会话是否存在
+	if session == nil || session.Uid != uid {
+		return errno.NewErrNo(errno.ParamVerifyErrorCode, "session not found")
+	}
+
+	if err = svc.cache.DeleteSession(ctx, uid, sessionID); err != nil {
+		return fmt.Errorf("domain.svc.RevokeSession failed: %w", err)
+	}
+	return nil
+}
+
+// RevokeAllSessions 吊销用户所有的会话, 即在所有设备上退出登录
+func (svc *UserService) RevokeAllSessions(ctx context.Context, uid int64) error {
+	if err := svc.cache.DeleteUserSessions(ctx, uid); err != nil {
+		return fmt.Errorf("domain.svc.RevokeAllSessions failed: %w", err)
+	}
+	return nil
+}
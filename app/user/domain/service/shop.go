@@ -0,0 +1,131 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/west2-online/DomTok/app/user/domain/model"
+	"github.com/west2-online/DomTok/pkg/constants"
+	"github.com/west2-online/DomTok/pkg/errno"
+)
+
+func (svc *UserService) CreateShop(ctx context.Context, shop *model.Shop) (int64, error) {
+	shopID, err := svc.db.CreateShop(ctx, shop)
+	if err != nil {
+		return 0, fmt.Errorf("domain.svc.CreateShop failed: %w", err)
+	}
+	return shopID, nil
+}
+
+func (svc *UserService) GetShop(ctx context.Context, shopID int64) (*model.Shop, error) {
+	shop, err := svc.db.GetShopByID(ctx, shopID)
+	if err != nil {
+		return nil, fmt.Errorf("domain.svc.GetShop failed: %w", err)
+	}
+	return shop, nil
+}
+
+func (svc *UserService) GetShops(ctx context.Context, shopIDs []int64) ([]*model.Shop, error) {
+	if len(shopIDs) == 0 {
+		return nil, nil
+	}
+	shops, err := svc.db.GetShopsByIDs(ctx, shopIDs)
+	if err != nil {
+		return nil, fmt.Errorf("domain.svc.GetShops failed: %w", err)
+	}
+	return shops, nil
+}
+
+func (svc *UserService) UpdateShop(ctx context.Context, shop *model.Shop) error {
+	if err := svc.db.UpdateShop(ctx, shop); err != nil {
+		return fmt.Errorf("domain.svc.UpdateShop failed: %w", err)
+	}
+	return nil
+}
+
+// CloseShop 关闭店铺, 关闭后店铺的成员不能再发布新的商品
+func (svc *UserService) CloseShop(ctx context.Context, shop *model.Shop) error {
+	if shop.Status == constants.ShopStatusClosed {
+		return errno.NewErrNo(errno.RepeatedOperation, "domain.svc.CloseShop failed, shop already closed")
+	}
+	if err := svc.db.UpdateShopStatus(ctx, shop.ShopID, constants.ShopStatusClosed); err != nil {
+		return fmt.Errorf("domain.svc.CloseShop failed: %w", err)
+	}
+	return nil
+}
+
+func (svc *UserService) IsShopStaff(ctx context.Context, shopID int64, uid int64) (bool, error) {
+	ok, err := svc.db.IsShopStaff(ctx, shopID, uid)
+	if err != nil {
+		return false, fmt.Errorf("domain.svc.IsShopStaff failed: %w", err)
+	}
+	return ok, nil
+}
+
+func (svc *UserService) AddShopStaff(ctx context.Context, shopID int64, uid int64) error {
+	if _, err := svc.db.GetUserById(ctx, uid); err != nil {
+		return fmt.Errorf("domain.svc.AddShopStaff failed: %w", err)
+	}
+
+	exist, err := svc.db.IsShopStaff(ctx, shopID, uid)
+	if err != nil {
+		return fmt.Errorf("domain.svc.AddShopStaff failed: %w", err)
+	}
+	if exist {
+		return errno.NewErrNo(errno.RepeatedOperation, "domain.svc.AddShopStaff failed, already shop staff")
+	}
+
+	staff := &model.ShopStaff{
+		ShopID: shopID,
+		Uid:    uid,
+		Role:   constants.ShopStaffRoleStaff,
+	}
+	if err = svc.db.AddShopStaff(ctx, staff); err != nil {
+		return fmt.Errorf("domain.svc.AddShopStaff failed: %w", err)
+	}
+	return nil
+}
+
+// RemoveShopStaff 移除店铺成员, 店主不能被移除
+func (svc *UserService) RemoveShopStaff(ctx context.Context, shop *model.Shop, uid int64) error {
+	if shop.OwnerID == uid {
+		return errno.NewErrNo(errno.ParamVerifyErrorCode, "can not remove shop owner")
+	}
+
+	exist, err := svc.db.IsShopStaff(ctx, shop.ShopID, uid)
+	if err != nil {
+		return fmt.Errorf("domain.svc.RemoveShopStaff failed: %w", err)
+	}
+	if !exist {
+		return errno.NewErrNo(errno.ServiceNotShopStaff, "user is not shop staff")
+	}
+
+	if err = svc.db.RemoveShopStaff(ctx, shop.ShopID, uid); err != nil {
+		return fmt.Errorf("domain.svc.RemoveShopStaff failed: %w", err)
+	}
+	return nil
+}
+
+func (svc *UserService) ListShopStaff(ctx context.Context, shopID int64) ([]*model.ShopStaff, error) {
+	staff, err := svc.db.ListShopStaff(ctx, shopID)
+	if err != nil {
+		return nil, fmt.Errorf("domain.svc.ListShopStaff failed: %w", err)
+	}
+	return staff, nil
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/west2-online/DomTok/app/user/domain/model"
+	"github.com/west2-online/DomTok/pkg/constants"
+	"github.com/west2-online/DomTok/pkg/errno"
+	"github.com/west2-online/DomTok/pkg/utils"
+)
+
+// IssueToken 在登录时调用, 为用户创建一个新的会话并签发一对 token
+// mfa 表示本次登录是否通过了两步验证, 会写入 token 并在整个会话中保持不变
+func (svc *UserService) IssueToken(ctx context.Context, session *model.Session, role int, mfa bool) (*model.Token, error) {
+	now := time.Now().Unix()
+	session.SessionID = uuid.NewString()
+	session.CreatedAt = now
+	session.ActiveAt = now
+	tokenID := uuid.NewString()
+
+	if err := svc.cache.SetSession(ctx, session, tokenID); err != nil {
+		return nil, fmt.Errorf("domain.svc.IssueToken failed: %w", err)
+	}
+	return svc.signToken(session.Uid, role, session.SessionID, tokenID, mfa)
+}
+
+// RefreshToken 使用 refresh token 换取新的一对 token, 旧的 refresh token 会被轮换掉
+// 如果出示的是已经被轮换掉的 refresh token, 说明 token 可能已经泄露, 会吊销整个会话
+func (svc *UserService) RefreshToken(ctx context.Context, refreshToken string) (uid int64, token *model.Token, err error) {
+	claims, err := utils.ParseRefreshToken(refreshToken)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	tokenID := uuid.NewString()
+	res, err := svc.cache.RotateSessionToken(ctx, claims.UserID, claims.SessionID, claims.Id, tokenID)
+	if err != nil {
+		return 0, nil, fmt.Errorf("domain.svc.RefreshToken failed: %w", err)
+	}
+
+	switch res {
+	case constants.SessionTokenRotated:
+	case constants.SessionTokenReused:
+		return 0, nil, errno.NewErrNo(errno.AuthRefreshTokenReusedCode, "refresh token reused, please login again")
+	default:
+		return 0, nil, errno.NewErrNo(errno.AuthInvalidCode, "session revoked, please login again")
+	}
+
+	// 每次刷新都重新读取用户的角色, 让角色的变更在下一次刷新后生效
+	u, err := svc.db.GetUserById(ctx, claims.UserID)
+	if err != nil {
+		return 0, nil, fmt.Errorf("domain.svc.RefreshToken failed: %w", err)
+	}
+
+	token, err = svc.signToken(claims.UserID, u.Role, claims.SessionID, tokenID, claims.MFA)
+	if err != nil {
+		return 0, nil, err
+	}
+	return claims.UserID, token, nil
+}
+
+func (svc *UserService) signToken(uid int64, role int, sessionID string, tokenID string, mfa bool) (*model.Token, error) {
+	accessToken, err := utils.CreateAccessToken(uid, role, sessionID, mfa)
+	if err != nil {
+		return nil, errno.Errorf(errno.InternalServiceErrorCode, "create access token failed, err: %v", err)
+	}
+	refreshToken, err := utils.CreateRefreshToken(uid, sessionID, tokenID, mfa)
+	if err != nil {
+		return nil, errno.Errorf(errno.InternalServiceErrorCode, "create refresh token failed, err: %v", err)
+	}
+	return &model.Token{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}, nil
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/west2-online/DomTok/app/user/domain/model"
+	"github.com/west2-online/DomTok/app/user/domain/repository"
+	"github.com/west2-online/DomTok/app/user/usecase/mocks"
+	"github.com/west2-online/DomTok/pkg/constants"
+	"github.com/west2-online/DomTok/pkg/errno"
+	"github.com/west2-online/DomTok/pkg/utils"
+)
+
+// sessionCache 在内存中模拟会话的存储和轮换, 语义和 UserRotateSessionTokenLuaScript 保持一致
+type sessionCache struct {
+	repository.UserCache
+	mu       sync.Mutex
+	sessions map[string]*model.Session
+	current  map[string]string // sessionID -> 当前有效的 refresh token 的 jti
+}
+
+func newSessionCache() *sessionCache {
+	return &sessionCache{
+		sessions: make(map[string]*model.Session),
+		current:  make(map[string]string),
+	}
+}
+
+func (c *sessionCache) SetSession(_ context.Context, session *model.Session, tokenID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.sessions[session.SessionID] = session
+	c.current[session.SessionID] = tokenID
+	return nil
+}
+
+func (c *sessionCache) RotateSessionToken(_ context.Context, _ int64, sessionID string, presented string, next string) (int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	current, ok := c.current[sessionID]
+	if !ok {
+		return constants.SessionTokenNotExist, nil
+	}
+	if current != presented {
+		delete(c.sessions, sessionID)
+		delete(c.current, sessionID)
+		return constants.SessionTokenReused, nil
+	}
+	c.current[sessionID] = next
+	return constants.SessionTokenRotated, nil
+}
+
+func (c *sessionCache) GetSession(_ context.Context, sessionID string) (*model.Session, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.sessions[sessionID], nil
+}
+
+func (c *sessionCache) DeleteSession(_ context.Context, _ int64, sessionID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.sessions, sessionID)
+	delete(c.current, sessionID)
+	return nil
+}
+
+// mockTokenCodec 把 token 的签发和解析替换为明文编码, 测试不依赖 jwt 密钥的配置
+func mockTokenCodec() {
+	mockey.Mock(utils.CreateAccessToken).To(func(uid int64, role int, sessionID string, mfa bool) (string, error) {
+		return fmt.Sprintf("access:%d:%s", uid, sessionID), nil
+	}).Build()
+	mockey.Mock(utils.CreateRefreshToken).To(func(uid int64, sessionID string, tokenID string, mfa bool) (string, error) {
+		return fmt.Sprintf("%d:%s:%s", uid, sessionID, tokenID), nil
+	}).Build()
+	mockey.Mock(utils.ParseRefreshToken).To(func(token string) (*utils.Claims, error) {
+		parts := strings.Split(token, ":")
+		if len(parts) != 3 {
+			return nil, errno.AuthInvalid
+		}
+		claims := &utils.Claims{Type: constants.TypeRefreshToken, SessionID: parts[1]}
+		claims.Id = parts[2]
+		_, err := fmt.Sscanf(parts[0], "%d", &claims.UserID)
+		return claims, err
+	}).Build()
+}
+
+func TestUserService_RefreshToken(t *testing.T) {
+	mockey.PatchConvey("TestUserService_RefreshToken", t, func() {
+		mockTokenCodec()
+		mockDB := new(mocks.UserDB)
+		mockDB.On("GetUserById", mock.Anything, int64(100)).Return(&model.User{Uid: 100, Role: constants.RoleCustomer}, nil)
+		c := newSessionCache()
+		svc := &UserService{db: mockDB, cache: c}
+		ctx := context.Background()
+
+		session := &model.Session{Uid: 100, Device: "phone", IP: "127.0.0.1"}
+		issued, err := svc.IssueToken(ctx, session, constants.RoleCustomer, false)
+		convey.So(err, convey.ShouldBeNil)
+
+		convey.Convey("rotated token is accepted", func() {
+			uid, rotated, err := svc.RefreshToken(ctx, issued.RefreshToken)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(uid, convey.ShouldEqual, int64(100))
+			convey.So(rotated.RefreshToken, convey.ShouldNotEqual, issued.RefreshToken)
+
+			// 轮换后的 token 可以继续用于下一次刷新
+			_, _, err = svc.RefreshToken(ctx, rotated.RefreshToken)
+			convey.So(err, convey.ShouldBeNil)
+		})
+
+		convey.Convey("replayed token kills the session", func() {
+			_, rotated, err := svc.RefreshToken(ctx, issued.RefreshToken)
+			convey.So(err, convey.ShouldBeNil)
+
+			_, _, err = svc.RefreshToken(ctx, issued.RefreshToken)
+			convey.So(errno.ConvertErr(err).ErrorCode, convey.ShouldEqual, int64(errno.AuthRefreshTokenReusedCode))
+			got, _ := c.GetSession(ctx, session.SessionID)
+			convey.So(got, convey.ShouldBeNil)
+
+			// 会话被吊销后, 轮换出来的新 token 也随之失效
+			_, _, err = svc.RefreshToken(ctx, rotated.RefreshToken)
+			convey.So(errno.ConvertErr(err).ErrorCode, convey.ShouldEqual, int64(errno.AuthInvalidCode))
+		})
+
+		convey.Convey("revoked session is rejected", func() {
+			convey.So(svc.RevokeSession(ctx, 100, session.SessionID), convey.ShouldBeNil)
+			_, _, err := svc.RefreshToken(ctx, issued.RefreshToken)
+			convey.So(errno.ConvertErr(err).ErrorCode, convey.ShouldEqual, int64(errno.AuthInvalidCode))
+		})
+	})
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/west2-online/DomTok/app/user/domain/model"
+	"github.com/west2-online/DomTok/pkg/constants"
+	"github.com/west2-online/DomTok/pkg/errno"
+	"github.com/west2-online/DomTok/pkg/utils"
+)
+
+// EnrollTOTP 为用户生成新的两步验证密钥和恢复码, 用户使用动态验证码确认之后才会生效
+// 还没有确认的绑定可以重复发起, 已经开启两步验证的用户需要先关闭才能重新绑定
+func (svc *UserService) EnrollTOTP(ctx context.Context, user *model.User) (*model.TOTPEnrollment, error) {
+	totp, err := svc.db.GetTOTP(ctx, user.Uid)
+	if err != nil {
+		return nil, fmt.Errorf("domain.svc.EnrollTOTP failed: %w", err)
+	}
+	if totp != nil && totp.Enabled {
+		return nil, errno.NewErrNo(errno.RepeatedOperation, "two-factor authentication already enabled")
+	}
+
+	secret, err := utils.GenerateTOTPSecret()
+	if err != nil {
+		return nil, errno.Errorf(errno.InternalServiceErrorCode, "domain.svc.EnrollTOTP failed: %v", err)
+	}
+	codes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	digests := make([]string, len(codes))
+	for i, code := range codes {
+		digests[i] = hashRecoveryCode(code)
+	}
+
+	if err = svc.db.SaveTOTP(ctx, &model.TOTP{Uid: user.Uid, Secret: secret}, digests); err != nil {
+		return nil, fmt.Errorf("domain.svc.EnrollTOTP failed: %w", err)
+	}
+	return &model.TOTPEnrollment{
+		Secret:        secret,
+		URI:           utils.TOTPURI(user.UserName, secret),
+		RecoveryCodes: codes,
+	}, nil
+}
+
+// ConfirmTOTP 使用验证器生成的动态验证码确认绑定, 确认之后登录时就需要进行两步验证
+func (svc *UserService) ConfirmTOTP(ctx context.Context, uid int64, code string) error {
+	totp, err := svc.db.GetTOTP(ctx, uid)
+	if err != nil {
+		return fmt.Errorf("domain.svc.ConfirmTOTP failed: %w", err)
+	}
+	if totp == nil {
+		return errno.NewErrNo(errno.ServiceTOTPNotEnrolled, "two-factor authentication not enrolled")
+	}
+	if totp.Enabled {
+		return errno.NewErrNo(errno.RepeatedOperation, "two-factor authentication already enabled")
+	}
+	if err = svc.checkTOTPCode(ctx, totp, code); err != nil {
+		return err
+	}
+	if err = svc.db.EnableTOTP(ctx, uid); err != nil {
+		return fmt.Errorf("domain.svc.ConfirmTOTP failed: %w", err)
+	}
+	return nil
+}
+
+// IsTOTPEnabled 判断用户是否已经开启了两步验证
+func (svc *UserService) IsTOTPEnabled(ctx context.Context, uid int64) (bool, error) {
+	totp, err := svc.db.GetTOTP(ctx, uid)
+	if err != nil {
+		return false, fmt.Errorf("domain.svc.IsTOTPEnabled failed: %w", err)
+	}
+	return totp != nil && totp.Enabled, nil
+}
+
+// CheckSecondFactor 校验两步验证的动态验证码或恢复码, 两者都只能使用一次
+func (svc *UserService) CheckSecondFactor(ctx context.Context, uid int64, code string) error {
+	totp, err := svc.db.GetTOTP(ctx, uid)
+	if err != nil {
+		return fmt.Errorf("domain.svc.CheckSecondFactor failed: %w", err)
+	}
+	if totp == nil || !totp.Enabled {
+		return errno.NewErrNo(errno.ServiceTOTPNotEnrolled, "two-factor authentication not enabled")
+	}
+
+	// 动态验证码是纯数字, 和恢复码的长度不同, 可以直接按长度区分
+	if len(code) == constants.TOTPDigits {
+		return svc.checkTOTPCode(ctx, totp, code)
+	}
+	ok, err := svc.db.UseRecoveryCode(ctx, uid, hashRecoveryCode(code))
+	if err != nil {
+		return fmt.Errorf("domain.svc.CheckSecondFactor failed: %w", err)
+	}
+	if !ok {
+		return errno.NewErrNo(errno.ServiceWrongTOTPCode, "wrong two-factor code")
+	}
+	return nil
+}
+
+// DisableTOTP 关闭两步验证, 密钥和恢复码会被一并删除
+func (svc *UserService) DisableTOTP(ctx context.Context, uid int64) error {
+	if err := svc.db.DeleteTOTP(ctx, uid); err != nil {
+		return fmt.Errorf("domain.svc.DisableTOTP failed: %w", err)
+	}
+	return nil
+}
+
+// checkTOTPCode 校验动态验证码, 并记录所在的时间步防止同一个验证码被重放
+func (svc *UserService) checkTOTPCode(ctx context.Context, totp *model.TOTP, code string) error {
+	step, ok, err := utils.ValidateTOTP(totp.Secret, code, time.Now())
+	if err != nil {
+		return errno.Errorf(errno.InternalServiceErrorCode, "domain.svc.checkTOTPCode failed: %v", err)
+	}
+	if !ok {
+		return errno.NewErrNo(errno.ServiceWrongTOTPCode, "wrong two-factor code")
+	}
+
+	used, err := svc.db.UseTOTPStep(ctx, totp.Uid, step)
+	if err != nil {
+		return fmt.Errorf("domain.svc.checkTOTPCode failed: %w", err)
+	}
+	if !used {
+		return errno.NewErrNo(errno.ServiceWrongTOTPCode, "two-factor code already used")
+	}
+	return nil
+}
+
+// generateRecoveryCodes 生成一组随机的恢复码, 使用小写的 base32 编码方便用户抄写
+func generateRecoveryCodes() ([]string, error) {
+	codes := make([]string, constants.TOTPRecoveryCodeCount)
+	buf := make([]byte, constants.TOTPRecoveryCodeSize)
+	for i := range codes {
+		if _, err := rand.Read(buf); err != nil {
+			return nil, errno.Errorf(errno.InternalServiceErrorCode, "generate recovery code failed: %v", err)
+		}
+		codes[i] = strings.ToLower(base32.StdEncoding.EncodeToString(buf))
+	}
+	return codes, nil
+}
+
+// hashRecoveryCode 计算恢复码的摘要, 恢复码本身有足够的随机性, 不需要使用 bcrypt 这样的慢哈希
+func hashRecoveryCode(code string) string {
+	sum := sha256.Sum256([]byte(strings.ToLower(strings.TrimSpace(code))))
+	return hex.EncodeToString(sum[:])
+}
@@ -17,8 +17,16 @@ limitations under the License.
 package service
 
 import (
+	"net/url"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/west2-online/DomTok/app/user/domain/model"
 	"github.com/west2-online/DomTok/pkg/constants"
 	"github.com/west2-online/DomTok/pkg/errno"
+	"github.com/west2-online/DomTok/pkg/rbac"
+	"github.com/west2-online/DomTok/pkg/webhook"
 )
 
 type UserVerifyOps func() error
@@ -54,15 +62,126 @@ func (svc *UserService) VerifyPassword(pw string) UserVerifyOps {
 	}
 }
 
+// VerifyPhone 返回一个校验手机号格式的函数, 不应单独使用, 应结合 Verify
+func (svc *UserService) VerifyPhone(phone string) UserVerifyOps {
+	return func() error {
+		if !svc.phoneRe.MatchString(phone) {
+			return errno.NewErrNo(errno.ParamVerifyErrorCode, "wrong phone format")
+		}
+		return nil
+	}
+}
+
+// VerifyAddress 返回一个校验地址信息完整性的函数, 不应单独使用, 应结合 Verify
+func (svc *UserService) VerifyAddress(address *model.Address) UserVerifyOps {
+	return func() error {
+		if address.Province == "" || address.City == "" || address.Detail == "" {
+			return errno.NewErrNo(errno.ParamVerifyErrorCode, "address should not be empty")
+		}
+		if address.RecipientName == "" {
+			return errno.NewErrNo(errno.ParamVerifyErrorCode, "recipient name should not be empty")
+		}
+		return svc.VerifyPhone(address.Phone)()
+	}
+}
+
 func (svc *UserService) VerifyAction(action int) UserVerifyOps {
 	return func() error {
-		switch action {
-		case constants.UserAdministrator:
-			return nil
-		case constants.NormalUser:
-			return nil
-		default:
+		if !rbac.IsValidRole(action) {
 			return errno.NewErrNo(errno.ParamVerifyErrorCode, "invalid action")
 		}
+		return nil
+	}
+}
+
+// VerifyShop 返回一个校验店铺信息的函数, 不应单独使用, 应结合 Verify
+func (svc *UserService) VerifyShop(shop *model.Shop) UserVerifyOps {
+	return func() error {
+		if shop.Name == "" {
+			return errno.NewErrNo(errno.ParamVerifyErrorCode, "shop name should not be empty")
+		}
+		return svc.VerifyShopName(shop.Name)()
+	}
+}
+
+// VerifyShopName 返回一个校验店铺名称长度的函数, 不应单独使用, 应结合 Verify
+func (svc *UserService) VerifyShopName(name string) UserVerifyOps {
+	return func() error {
+		if utf8.RuneCountInString(name) > constants.ShopMaximumNameLength {
+			return errno.NewErrNo(errno.ParamVerifyErrorCode, "shop name should be less than 30 characters")
+		}
+		return nil
+	}
+}
+
+// VerifyBan 返回一个校验封禁原因和时长的函数, 不应单独使用, 应结合 Verify
+func (svc *UserService) VerifyBan(reason string, duration int64) UserVerifyOps {
+	return func() error {
+		if reason == "" {
+			return errno.NewErrNo(errno.ParamVerifyErrorCode, "ban reason should not be empty")
+		}
+		if utf8.RuneCountInString(reason) > constants.UserBanMaximumReasonLength {
+			return errno.NewErrNo(errno.ParamVerifyErrorCode, "ban reason should be less than 255 characters")
+		}
+		if duration < 0 {
+			return errno.NewErrNo(errno.ParamVerifyErrorCode, "ban duration should not be negative")
+		}
+		return nil
+	}
+}
+
+// VerifyUserName 返回一个校验用户名长度和保留前缀的函数, 不应单独使用, 应结合 Verify
+func (svc *UserService) VerifyUserName(name string) UserVerifyOps {
+	return func() error {
+		if name == "" || utf8.RuneCountInString(name) > constants.UserMaximumNameLength {
+			return errno.NewErrNo(errno.ParamVerifyErrorCode, "username should not be empty and less than 30 characters")
+		}
+		if strings.HasPrefix(name, constants.ClosedAccountNamePrefix) {
+			return errno.NewErrNo(errno.ParamVerifyErrorCode, "username is reserved")
+		}
+		return nil
+	}
+}
+
+// VerifyApiKey 返回一个校验 API Key 名称和 scope 的函数, 不应单独使用, 应结合 Verify
+func (svc *UserService) VerifyApiKey(key *model.ApiKey) UserVerifyOps {
+	return func() error {
+		if key.Name == "" || utf8.RuneCountInString(key.Name) > constants.ApiKeyMaximumNameLength {
+			return errno.NewErrNo(errno.ParamVerifyErrorCode, "api key name should not be empty and less than 30 characters")
+		}
+		if len(key.Scopes) == 0 {
+			return errno.NewErrNo(errno.ParamVerifyErrorCode, "api key scopes should not be empty")
+		}
+		for _, scope := range key.Scopes {
+			if !rbac.IsValidApiKeyScope(scope) {
+				return errno.Errorf(errno.ParamVerifyErrorCode, "invalid api key scope: %s", scope)
+			}
+		}
+		return nil
+	}
+}
+
+// VerifyWebhookEndpoint 返回一个校验 webhook 地址和订阅事件的函数, 不应单独使用, 应结合 Verify
+func (svc *UserService) VerifyWebhookEndpoint(endpoint *model.WebhookEndpoint) UserVerifyOps {
+	return func() error {
+		if len(endpoint.URL) > constants.WebhookURLMaxLength {
+			return errno.Errorf(errno.ParamVerifyErrorCode, "webhook url should be less than %d characters", constants.WebhookURLMaxLength)
+		}
+		u, err := url.Parse(endpoint.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return errno.NewErrNo(errno.ParamVerifyErrorCode, "webhook url should be an absolute http or https url")
+		}
+		if err = webhook.CheckHost(u.Hostname()); err != nil {
+			return errno.NewErrNo(errno.ParamVerifyErrorCode, "webhook url should not point to loopback, private or link-local addresses")
+		}
+		if len(endpoint.EventTypes) == 0 {
+			return errno.NewErrNo(errno.ParamVerifyErrorCode, "webhook should subscribe at least one event type")
+		}
+		for _, t := range endpoint.EventTypes {
+			if !slices.Contains(constants.WebhookEventTypes, t) {
+				return errno.Errorf(errno.ParamVerifyErrorCode, "invalid webhook event type: %s", t)
+			}
+		}
+		return nil
 	}
 }
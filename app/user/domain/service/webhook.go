@@ -0,0 +1,269 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/samber/lo"
+
+	"github.com/west2-online/DomTok/app/user/domain/model"
+	"github.com/west2-online/DomTok/pkg/constants"
+	"github.com/west2-online/DomTok/pkg/errno"
+	"github.com/west2-online/DomTok/pkg/logger"
+	"github.com/west2-online/DomTok/pkg/webhook"
+)
+
+// CreateWebhookEndpoint 为店铺注册一个 webhook 端点, 返回的签名密钥只会出现这一次
+func (svc *UserService) CreateWebhookEndpoint(ctx context.Context, endpoint *model.WebhookEndpoint) (string, error) {
+	count, err := svc.db.CountWebhookEndpoints(ctx, endpoint.ShopID)
+	if err != nil {
+		return "", fmt.Errorf("domain.svc.CreateWebhookEndpoint failed: %w", err)
+	}
+	if count >= constants.WebhookMaxEndpointsPerShop {
+		return "", errno.Errorf(errno.ParamVerifyErrorCode, "at most %d webhook endpoints are allowed", constants.WebhookMaxEndpointsPerShop)
+	}
+
+	buf := make([]byte, constants.WebhookSecretLength)
+	if _, err = rand.Read(buf); err != nil {
+		return "", errno.Errorf(errno.InternalServiceErrorCode, "generate webhook secret failed: %v", err)
+	}
+	endpoint.Secret = hex.EncodeToString(buf)
+	endpoint.EventTypes = lo.Uniq(endpoint.EventTypes)
+	endpoint.Status = constants.WebhookEndpointStatusEnabled
+	if _, err = svc.db.CreateWebhookEndpoint(ctx, endpoint); err != nil {
+		return "", fmt.Errorf("domain.svc.CreateWebhookEndpoint failed: %w", err)
+	}
+	return endpoint.Secret, nil
+}
+
+// UpdateWebhookEndpoint 更新端点的地址, 订阅和启用状态, 重新启用的端点会清空连续失败次数
+func (svc *UserService) UpdateWebhookEndpoint(ctx context.Context, endpoint *model.WebhookEndpoint, enabled bool) error {
+	if enabled {
+		if endpoint.Status != constants.WebhookEndpointStatusEnabled {
+			endpoint.ConsecutiveFailures = 0
+		}
+		endpoint.Status = constants.WebhookEndpointStatusEnabled
+	} else {
+		endpoint.Status = constants.WebhookEndpointStatusDisabled
+	}
+	endpoint.EventTypes = lo.Uniq(endpoint.EventTypes)
+	if err := svc.db.UpdateWebhookEndpoint(ctx, endpoint); err != nil {
+		return fmt.Errorf("domain.svc.UpdateWebhookEndpoint failed: %w", err)
+	}
+	return nil
+}
+
+// ListWebhookDeliveries 查询端点的投递日志, 没有指定分页时使用默认的每页数量
+func (svc *UserService) ListWebhookDeliveries(ctx context.Context, endpointID int64, pageNum, pageSize int64,
+) ([]*model.WebhookDelivery, int64, error) {
+	if pageNum <= 0 {
+		pageNum = 1
+	}
+	if pageSize <= 0 {
+		pageSize = constants.WebhookDeliveryDefaultPageSize
+	}
+	pageSize = min(pageSize, constants.WebhookDeliveryMaxPageSize)
+
+	deliveries, total, err := svc.db.ListWebhookDeliveries(ctx, endpointID, pageNum, pageSize)
+	if err != nil {
+		return nil, 0, fmt.Errorf("domain.svc.ListWebhookDeliveries failed: %w", err)
+	}
+	return deliveries, total, nil
+}
+
+// DispatchWebhookEvents 持续为订阅了事件的端点创建投递记录, 直到 events 被关闭或 ctx 被取消
+// 投递记录落库后才会真正发出请求, 服务重启或者投递失败都不会丢失事件
+func (svc *UserService) DispatchWebhookEvents(ctx context.Context, events <-chan *webhook.Event) {
+	for {
+		var e *webhook.Event
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			e = event
+		}
+
+		endpoints, err := svc.db.ListWebhookEndpoints(ctx, e.ShopID)
+		if err != nil {
+			logger.Errorf("UserService.DispatchWebhookEvents: list endpoints of shop %d failed: %v", e.ShopID, err)
+			continue
+		}
+		for _, endpoint := range endpoints {
+			if endpoint.Status != constants.WebhookEndpointStatusEnabled || !slices.Contains(endpoint.EventTypes, e.Type) {
+				continue
+			}
+			_, err = svc.db.CreateWebhookDelivery(ctx, &model.WebhookDelivery{
+				EndpointID:  endpoint.EndpointID,
+				EventID:     e.EventID,
+				EventType:   e.Type,
+				Payload:     e.Data,
+				Status:      constants.WebhookDeliveryStatusPending,
+				NextRetryAt: time.Now().UnixMilli(),
+			})
+			if err != nil {
+				logger.Errorf("UserService.DispatchWebhookEvents: create delivery of event %s failed: %v", e.EventID, err)
+			}
+		}
+		svc.wakeWebhookDeliveries()
+	}
+}
+
+// RunWebhookDeliveries 定期扫描到期的投递并发出请求, 直到 ctx 被取消
+// 多个实例可以同时运行, 每条投递只会被领取到它的实例投递
+func (svc *UserService) RunWebhookDeliveries(ctx context.Context) {
+	ticker := time.NewTicker(constants.WebhookRetryScanInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-svc.wake:
+		}
+
+		deliveries, err := svc.db.ListDueWebhookDeliveries(ctx, time.Now().UnixMilli(), constants.WebhookRetryBatchSize)
+		if err != nil {
+			logger.Errorf("UserService.RunWebhookDeliveries: list due deliveries failed: %v", err)
+			continue
+		}
+
+		var wg sync.WaitGroup
+		for _, d := range deliveries {
+			ok, err := svc.db.ClaimWebhookDelivery(ctx, d, time.Now().Add(constants.WebhookDeliveryLease).UnixMilli())
+			if err != nil {
+				logger.Errorf("UserService.RunWebhookDeliveries: claim delivery %d failed: %v", d.DeliveryID, err)
+				continue
+			}
+			if !ok {
+				continue // 已经被其他实例领取
+			}
+			wg.Add(1)
+			go func(d *model.WebhookDelivery) {
+				defer wg.Done()
+				if err := svc.deliverWebhook(ctx, d); err != nil {
+					logger.Errorf("UserService.RunWebhookDeliveries: deliver %d failed: %v", d.DeliveryID, err)
+				}
+			}(d)
+		}
+		wg.Wait()
+	}
+}
+
+// RedeliverWebhook 立即重新投递一次, 投递的次数会被清零, 失败后按正常的间隔继续重试
+func (svc *UserService) RedeliverWebhook(ctx context.Context, endpoint *model.WebhookEndpoint, delivery *model.WebhookDelivery,
+) (*model.WebhookDelivery, error) {
+	if endpoint.Status != constants.WebhookEndpointStatusEnabled {
+		return nil, errno.NewErrNo(errno.ServiceWebhookEndpointDisabled, "webhook endpoint is disabled")
+	}
+
+	// 先把投递改回等待状态并持有租约, 避免投递期间被扫描到重复投递
+	delivery.Status = constants.WebhookDeliveryStatusPending
+	delivery.Attempts = 0
+	delivery.NextRetryAt = time.Now().Add(constants.WebhookDeliveryLease).UnixMilli()
+	if err := svc.db.UpdateWebhookDelivery(ctx, delivery); err != nil {
+		return nil, fmt.Errorf("domain.svc.RedeliverWebhook failed: %w", err)
+	}
+	if err := svc.deliverWebhook(ctx, delivery); err != nil {
+		return nil, fmt.Errorf("domain.svc.RedeliverWebhook failed: %w", err)
+	}
+	return delivery, nil
+}
+
+// deliverWebhook 投递一次并记录结果, 返回的 error 只表示结果没能记录下来, 投递本身失败时返回 nil
+// 结果没能记录时投递仍然是等待状态, 租约过期后会被再次领取
+func (svc *UserService) deliverWebhook(ctx context.Context, delivery *model.WebhookDelivery) error {
+	endpoint, err := svc.db.GetWebhookEndpoint(ctx, delivery.EndpointID)
+	if err != nil && errno.ConvertErr(err).ErrorCode != errno.ServiceWebhookEndpointNotExist {
+		return err
+	}
+	if endpoint == nil || endpoint.Status != constants.WebhookEndpointStatusEnabled {
+		delivery.Status = constants.WebhookDeliveryStatusFailed
+		delivery.LastError = "webhook endpoint is disabled or deleted"
+		return svc.db.UpdateWebhookDelivery(ctx, delivery)
+	}
+
+	code, sendErr := svc.sender.Send(ctx, &model.WebhookRequest{
+		URL:        endpoint.URL,
+		Secret:     endpoint.Secret,
+		DeliveryID: delivery.DeliveryID,
+		EventType:  delivery.EventType,
+		Payload:    delivery.Payload,
+	})
+	delivery.Attempts++
+	delivery.LastStatusCode = int64(code)
+	delivery.LastError = ""
+
+	if sendErr == nil {
+		delivery.Status = constants.WebhookDeliveryStatusSucceeded
+		if err = svc.db.UpdateWebhookDelivery(ctx, delivery); err != nil {
+			return err
+		}
+		return svc.db.ResetWebhookEndpointFailures(ctx, endpoint.EndpointID)
+	}
+
+	delivery.LastError = sendErr.Error()
+	if len(delivery.LastError) > constants.WebhookResponseErrorMaxSize {
+		delivery.LastError = delivery.LastError[:constants.WebhookResponseErrorMaxSize]
+	}
+	if delivery.Attempts >= constants.WebhookMaxAttempts {
+		delivery.Status = constants.WebhookDeliveryStatusFailed
+	} else {
+		delivery.Status = constants.WebhookDeliveryStatusPending
+		delivery.NextRetryAt = time.Now().Add(webhookRetryInterval(delivery.Attempts)).UnixMilli()
+	}
+	if err = svc.db.UpdateWebhookDelivery(ctx, delivery); err != nil {
+		return err
+	}
+
+	failures, err := svc.db.IncrWebhookEndpointFailures(ctx, endpoint.EndpointID)
+	if err != nil {
+		return err
+	}
+	if failures >= constants.WebhookDisableThreshold {
+		logger.Warnf("UserService.deliverWebhook: endpoint %d failed %d times in a row, disabled", endpoint.EndpointID, failures)
+		return svc.db.DisableWebhookEndpoint(ctx, endpoint.EndpointID)
+	}
+	return nil
+}
+
+// wakeWebhookDeliveries 唤醒投递循环, 已经有待处理的唤醒时直接返回
+func (svc *UserService) wakeWebhookDeliveries() {
+	select {
+	case svc.wake <- struct{}{}:
+	default:
+	}
+}
+
+// webhookRetryInterval 计算第 attempts 次投递失败后到下一次重试的间隔, 从基础间隔开始每次翻倍, 不超过上限
+func webhookRetryInterval(attempts int64) time.Duration {
+	interval := constants.WebhookRetryBaseInterval
+	for i := int64(1); i < attempts && interval < constants.WebhookRetryMaxInterval; i++ {
+		interval *= 2
+	}
+	return min(interval, constants.WebhookRetryMaxInterval)
+}
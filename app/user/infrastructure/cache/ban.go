@@ -18,13 +18,17 @@ package cache
 
 import (
 	"context"
+	"time"
 
+	"github.com/west2-online/DomTok/app/user/domain/model"
 	"github.com/west2-online/DomTok/pkg/constants"
 	"github.com/west2-online/DomTok/pkg/errno"
+	"github.com/west2-online/DomTok/pkg/utils"
 )
 
-func (c *userCache) SetUserBaned(ctx context.Context, key string) error {
-	err := c.client.Set(ctx, key, constants.UserBanned, constants.NeverExpire).Err()
+// SetUserBaned 标记用户被封禁, 临时封禁到期后 key 会自动过期, expiration 为 constants.NeverExpire 时表示永久封禁
+func (c *userCache) SetUserBaned(ctx context.Context, key string, expiration time.Duration) error {
+	err := c.client.Set(ctx, key, constants.UserBanned, expiration).Err()
 	if err != nil {
 		return errno.NewErrNo(errno.InternalRedisErrorCode, err.Error())
 	}
@@ -38,3 +42,15 @@ func (c *userCache) DeleteUserBaned(ctx context.Context, key string) error {
 	}
 	return nil
 }
+
+// PublishBanEvent 通过 redis 的发布订阅通知所有网关实例更新封禁名单
+func (c *userCache) PublishBanEvent(ctx context.Context, event *model.BanEvent) error {
+	payload, err := utils.JSONEncode(event)
+	if err != nil {
+		return errno.Errorf(errno.InternalServiceErrorCode, "userCache.PublishBanEvent marshal failed, %v", err)
+	}
+	if err = c.client.Publish(ctx, constants.RedisUserBanChannel, payload).Err(); err != nil {
+		return errno.Errorf(errno.InternalRedisErrorCode, "userCache.PublishBanEvent failed, %v", err)
+	}
+	return nil
+}
@@ -18,14 +18,40 @@ package cache
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/west2-online/DomTok/pkg/constants"
 )
 
-func (c *userCache) UserLogOutKey(uid int64) string {
-	return fmt.Sprintf(constants.RedisUserLogoutKey+"%d", uid)
-}
-
 func (c *userCache) UserBanedKey(uid int64) string {
 	return fmt.Sprintf(constants.RedisUserBanedKey+"%d", uid)
 }
+
+func (c *userCache) SessionKey(sessionID string) string {
+	return constants.RedisSessionKey + sessionID
+}
+
+func (c *userCache) UserSessionKey(uid int64) string {
+	return fmt.Sprintf(constants.RedisUserSessionKey+"%d", uid)
+}
+
+func (c *userCache) PasswordResetKey(uid int64) string {
+	return fmt.Sprintf(constants.RedisPasswordResetKey+"%d", uid)
+}
+
+// LoginFailureUserKey 用户名在数据库中按不区分大小写的方式匹配, 统一转换为小写, 避免变换大小写绕过锁定
+func (c *userCache) LoginFailureUserKey(username string) string {
+	return constants.RedisLoginFailureKey + "user:" + strings.ToLower(username)
+}
+
+func (c *userCache) LoginFailureIPKey(ip string) string {
+	return constants.RedisLoginFailureKey + "ip:" + ip
+}
+
+func (c *userCache) LoginLockUserKey(username string) string {
+	return constants.RedisLoginLockKey + "user:" + strings.ToLower(username)
+}
+
+func (c *userCache) LoginLockIPKey(ip string) string {
+	return constants.RedisLoginLockKey + "ip:" + ip
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/west2-online/DomTok/pkg/constants"
+	"github.com/west2-online/DomTok/pkg/errno"
+)
+
+// RecordLoginFailure 记录一次登录失败, 返回滑动窗口内的失败次数
+// 使用 zset 按失败时间排序, 每次写入时顺便清理掉窗口之外的记录
+func (c *userCache) RecordLoginFailure(ctx context.Context, key string) (int64, error) {
+	now := time.Now()
+	windowStart := now.Add(-constants.LoginFailureWindow).UnixNano()
+
+	pipe := c.client.TxPipeline()
+	pipe.ZRemRangeByScore(ctx, key, "-inf", strconv.FormatInt(windowStart, 10))
+	pipe.ZAdd(ctx, key, redis.Z{Score: float64(now.UnixNano()), Member: now.UnixNano()})
+	count := pipe.ZCard(ctx, key)
+	pipe.Expire(ctx, key, constants.LoginFailureWindow)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, errno.Errorf(errno.InternalRedisErrorCode, "userCache.RecordLoginFailure failed, %v", err)
+	}
+	return count.Val(), nil
+}
+
+// ClearLoginFailures 清除失败记录以及对应的锁定
+func (c *userCache) ClearLoginFailures(ctx context.Context, keys ...string) error {
+	if err := c.client.Del(ctx, keys...).Err(); err != nil {
+		return errno.Errorf(errno.InternalRedisErrorCode, "userCache.ClearLoginFailures failed, %v", err)
+	}
+	return nil
+}
+
+// LockLogin 在 duration 内禁止登录, 已有更长的锁定时不会缩短
+func (c *userCache) LockLogin(ctx context.Context, key string, duration time.Duration) error {
+	ttl, err := c.GetLoginLockTTL(ctx, key)
+	if err != nil {
+		return err
+	}
+	if ttl >= duration {
+		return nil
+	}
+	if err = c.client.Set(ctx, key, time.Now().Add(duration).Unix(), duration).Err(); err != nil {
+		return errno.Errorf(errno.InternalRedisErrorCode, "userCache.LockLogin failed, %v", err)
+	}
+	return nil
+}
+
+// GetLoginLockTTL 获取剩余的锁定时长, 没有被锁定时返回 0
+func (c *userCache) GetLoginLockTTL(ctx context.Context, key string) (time.Duration, error) {
+	ttl, err := c.client.TTL(ctx, key).Result()
+	if err != nil {
+		return 0, errno.Errorf(errno.InternalRedisErrorCode, "userCache.GetLoginLockTTL failed, %v", err)
+	}
+	if ttl < 0 {
+		return 0, nil
+	}
+	return ttl, nil
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/west2-online/DomTok/pkg/constants"
+	"github.com/west2-online/DomTok/pkg/errno"
+)
+
+var verifyPasswordResetCodeScript = redis.NewScript(constants.UserVerifyPasswordResetCodeLuaScript)
+
+// SetPasswordResetCode 保存重置密码的验证码, 会覆盖之前未使用的验证码并重置错误次数
+func (c *userCache) SetPasswordResetCode(ctx context.Context, uid int64, code string) error {
+	key := c.PasswordResetKey(uid)
+
+	pipe := c.client.TxPipeline()
+	pipe.Del(ctx, key)
+	pipe.HSet(ctx, key, "code", code, "attempts", 0)
+	pipe.Expire(ctx, key, constants.PasswordResetCodeExpireTime)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return errno.Errorf(errno.InternalRedisErrorCode, "userCache.SetPasswordResetCode failed, %v", err)
+	}
+	return nil
+}
+
+// GetPasswordResetCodeTTL 获取验证码的剩余有效期, 验证码不存在时返回 0
+func (c *userCache) GetPasswordResetCodeTTL(ctx context.Context, uid int64) (time.Duration, error) {
+	ttl, err := c.client.TTL(ctx, c.PasswordResetKey(uid)).Result()
+	if err != nil {
+		return 0, errno.Errorf(errno.InternalRedisErrorCode, "userCache.GetPasswordResetCodeTTL failed, %v", err)
+	}
+	// key 不存在时 TTL 返回 -2, 没有过期时间时返回 -1
+	if ttl < 0 {
+		return 0, nil
+	}
+	return ttl, nil
+}
+
+// VerifyPasswordResetCode 校验验证码, 返回值参考 constants.PasswordResetCodeXXX
+func (c *userCache) VerifyPasswordResetCode(ctx context.Context, uid int64, code string) (int, error) {
+	keys := []string{c.PasswordResetKey(uid)}
+	res, err := verifyPasswordResetCodeScript.Run(ctx, c.client, keys, code, constants.PasswordResetMaxAttempts).Int()
+	if err != nil {
+		return constants.PasswordResetCodeNotExist,
+			errno.Errorf(errno.InternalRedisErrorCode, "userCache.VerifyPasswordResetCode failed, %v", err)
+	}
+	return res, nil
+}
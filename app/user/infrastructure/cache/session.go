@@ -0,0 +1,155 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/west2-online/DomTok/app/user/domain/model"
+	"github.com/west2-online/DomTok/pkg/constants"
+	"github.com/west2-online/DomTok/pkg/errno"
+)
+
+var rotateSessionTokenScript = redis.NewScript(constants.UserRotateSessionTokenLuaScript)
+
+// SetSession 创建一个新的会话, 并记录到用户名下以便列出和统一吊销
+func (c *userCache) SetSession(ctx context.Context, session *model.Session, tokenID string) error {
+	sessionKey := c.SessionKey(session.SessionID)
+	userKey := c.UserSessionKey(session.Uid)
+
+	pipe := c.client.TxPipeline()
+	pipe.HSet(ctx, sessionKey,
+		"uid", session.Uid,
+		"current", tokenID,
+		"device", session.Device,
+		"ip", session.IP,
+		"created_at", session.CreatedAt,
+		"active_at", session.ActiveAt,
+	)
+	pipe.Expire(ctx, sessionKey, constants.RefreshTokenTTL)
+	pipe.SAdd(ctx, userKey, session.SessionID)
+	pipe.Expire(ctx, userKey, constants.RefreshTokenTTL)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return errno.Errorf(errno.InternalRedisErrorCode, "userCache.SetSession failed, %v", err)
+	}
+	return nil
+}
+
+// RotateSessionToken 把会话中当前有效的 token 从 presented 轮换为 next, 返回值参考 constants.SessionTokenXXX
+func (c *userCache) RotateSessionToken(ctx context.Context, uid int64, sessionID string, presented string, next string) (int, error) {
+	keys := []string{c.SessionKey(sessionID), c.UserSessionKey(uid)}
+	res, err := rotateSessionTokenScript.Run(ctx, c.client, keys,
+		presented, next, int64(constants.RefreshTokenTTL.Seconds()), time.Now().Unix(), sessionID).Int()
+	if err != nil {
+		return constants.SessionTokenNotExist, errno.Errorf(errno.InternalRedisErrorCode, "userCache.RotateSessionToken failed, %v", err)
+	}
+	return res, nil
+}
+
+// GetSession 获取会话信息, 会话不存在时返回 nil
+func (c *userCache) GetSession(ctx context.Context, sessionID string) (*model.Session, error) {
+	values, err := c.client.HGetAll(ctx, c.SessionKey(sessionID)).Result()
+	if err != nil {
+		return nil, errno.Errorf(errno.InternalRedisErrorCode, "userCache.GetSession failed, %v", err)
+	}
+	if len(values) == 0 {
+		return nil, nil
+	}
+	return buildSession(sessionID, values), nil
+}
+
+// ListUserSessions 列出用户名下所有仍然有效的会话, 已经过期的会话会顺便从用户的会话集合中移除
+func (c *userCache) ListUserSessions(ctx context.Context, uid int64) ([]*model.Session, error) {
+	userKey := c.UserSessionKey(uid)
+	sessionIDs, err := c.client.SMembers(ctx, userKey).Result()
+	if err != nil {
+		return nil, errno.Errorf(errno.InternalRedisErrorCode, "userCache.ListUserSessions failed, %v", err)
+	}
+
+	pipe := c.client.Pipeline()
+	cmds := make([]*redis.MapStringStringCmd, len(sessionIDs))
+	for i, sessionID := range sessionIDs {
+		cmds[i] = pipe.HGetAll(ctx, c.SessionKey(sessionID))
+	}
+	if _, err = pipe.Exec(ctx); err != nil {
+		return nil, errno.Errorf(errno.InternalRedisErrorCode, "userCache.ListUserSessions failed, %v", err)
+	}
+
+	sessions := make([]*model.Session, 0, len(sessionIDs))
+	expired := make([]interface{}, 0)
+	for i, cmd := range cmds {
+		if len(cmd.Val()) == 0 {
+			expired = append(expired, sessionIDs[i])
+			continue
+		}
+		sessions = append(sessions, buildSession(sessionIDs[i], cmd.Val()))
+	}
+	if len(expired) != 0 {
+		if err = c.client.SRem(ctx, userKey, expired...).Err(); err != nil {
+			return nil, errno.Errorf(errno.InternalRedisErrorCode, "userCache.ListUserSessions failed, %v", err)
+		}
+	}
+	return sessions, nil
+}
+
+// DeleteSession 删除用户的某个会话
+func (c *userCache) DeleteSession(ctx context.Context, uid int64, sessionID string) error {
+	pipe := c.client.TxPipeline()
+	pipe.Del(ctx, c.SessionKey(sessionID))
+	pipe.SRem(ctx, c.UserSessionKey(uid), sessionID)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return errno.Errorf(errno.InternalRedisErrorCode, "userCache.DeleteSession failed, %v", err)
+	}
+	return nil
+}
+
+// DeleteUserSessions 删除用户名下所有的会话
+func (c *userCache) DeleteUserSessions(ctx context.Context, uid int64) error {
+	userKey := c.UserSessionKey(uid)
+	sessionIDs, err := c.client.SMembers(ctx, userKey).Result()
+	if err != nil {
+		return errno.Errorf(errno.InternalRedisErrorCode, "userCache.DeleteUserSessions failed, %v", err)
+	}
+
+	keys := make([]string, 0, len(sessionIDs)+1)
+	for _, sessionID := range sessionIDs {
+		keys = append(keys, c.SessionKey(sessionID))
+	}
+	keys = append(keys, userKey)
+	if err = c.client.Del(ctx, keys...).Err(); err != nil {
+		return errno.Errorf(errno.InternalRedisErrorCode, "userCache.DeleteUserSessions failed, %v", err)
+	}
+	return nil
+}
+
+func buildSession(sessionID string, values map[string]string) *model.Session {
+	uid, _ := strconv.ParseInt(values["uid"], 10, 64)
+	createdAt, _ := strconv.ParseInt(values["created_at"], 10, 64)
+	activeAt, _ := strconv.ParseInt(values["active_at"], 10, 64)
+	return &model.Session{
+		SessionID: sessionID,
+		Uid:       uid,
+		Device:    values["device"],
+		IP:        values["ip"],
+		CreatedAt: createdAt,
+		ActiveAt:  activeAt,
+	}
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mq
+
+import (
+	"context"
+	"errors"
+
+	"github.com/west2-online/DomTok/pkg/audit"
+	"github.com/west2-online/DomTok/pkg/constants"
+	"github.com/west2-online/DomTok/pkg/errno"
+	"github.com/west2-online/DomTok/pkg/kafka"
+	"github.com/west2-online/DomTok/pkg/logger"
+	"github.com/west2-online/DomTok/pkg/webhook"
+)
+
+type UserMQ struct {
+	client *kafka.Kafka
+}
+
+func NewUserMQ(client *kafka.Kafka) *UserMQ {
+	return &UserMQ{client: client}
+}
+
+// SendAuditEvent 发布审计事件, 用户服务自身的审计事件也经过 kafka, 和其他服务保持一致
+func (m *UserMQ) SendAuditEvent(ctx context.Context, e *audit.Event) error {
+	v, err := audit.Encode(e)
+	if err != nil {
+		return errno.Errorf(errno.InternalServiceErrorCode, "UserMQ.SendAuditEvent failed: %v", err)
+	}
+
+	msg := &kafka.Message{
+		K: []byte(e.Target), // 同一对象的事件落在同一分区, 保证顺序
+		V: v,
+	}
+	if errs := m.client.Send(ctx, constants.KafkaAuditTopic, []*kafka.Message{msg}); len(errs) > 0 {
+		return errno.Errorf(errno.InternalKafkaErrorCode, "UserMQ.SendAuditEvent failed: %v", errors.Join(errs...))
+	}
+	return nil
+}
+
+// ConsumeAuditEvents 消费所有服务发布的审计事件, 无法解析的消息会被跳过
+func (m *UserMQ) ConsumeAuditEvents(ctx context.Context) <-chan *audit.Event {
+	msgCh := m.client.Consume(ctx,
+		constants.KafkaAuditTopic,
+		constants.KafkaAuditConsumerNum,
+		constants.KafkaAuditGroupId,
+		constants.DefaultConsumerChanCap)
+
+	ch := make(chan *audit.Event, constants.DefaultConsumerChanCap)
+	go func() {
+		defer close(ch)
+		for msg := range msgCh {
+			e, err := audit.Decode(msg.V)
+			if err != nil {
+				logger.Errorf("UserMQ.ConsumeAuditEvents: %v", err)
+				continue
+			}
+			ch <- e
+		}
+	}()
+	return ch
+}
+
+// ConsumeWebhookEvents 消费订单和支付服务发布的 webhook 事件, 无法解析的消息会被跳过
+func (m *UserMQ) ConsumeWebhookEvents(ctx context.Context) <-chan *webhook.Event {
+	msgCh := m.client.Consume(ctx,
+		constants.KafkaWebhookTopic,
+		constants.KafkaWebhookConsumerNum,
+		constants.KafkaWebhookGroupId,
+		constants.DefaultConsumerChanCap)
+
+	ch := make(chan *webhook.Event, constants.DefaultConsumerChanCap)
+	go func() {
+		defer close(ch)
+		for msg := range msgCh {
+			e, err := webhook.Decode(msg.V)
+			if err != nil {
+				logger.Errorf("UserMQ.ConsumeWebhookEvents: %v", err)
+				continue
+			}
+			ch <- e
+		}
+	}()
+	return ch
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/west2-online/DomTok/pkg/constants"
+	"github.com/west2-online/DomTok/pkg/errno"
+)
+
+// AnonymizeUser 注销账号时在同一个事务中清除 user 服务持有的个人信息
+// 用户行本身需要保留, 因为订单, 封禁记录和店铺都通过 uid 引用了它
+func (db *userDB) AnonymizeUser(ctx context.Context, uid int64) error {
+	err := db.client.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 密码摘要置空后 bcrypt 校验必然失败, 账号无法再被登录
+		if err := tx.Table(User{}.TableName()).Where("id = ?", uid).Updates(map[string]interface{}{
+			"username": fmt.Sprintf("%s%d", constants.ClosedAccountNamePrefix, uid),
+			"email":    fmt.Sprintf("%s%d%s", constants.ClosedAccountNamePrefix, uid, constants.ClosedAccountEmailSuffix),
+			"phone":    nil,
+			"password": "",
+		}).Error; err != nil {
+			return err
+		}
+		// 地址包含收件人姓名和电话, 需要物理删除而不是软删除
+		if err := tx.Unscoped().Table(Address{}.TableName()).Where("user_id = ?", uid).Delete(&Address{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Table(UserTOTP{}.TableName()).Where("user_id = ?", uid).Delete(&UserTOTP{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Table(UserRecoveryCode{}.TableName()).Where("user_id = ?", uid).Delete(&UserRecoveryCode{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Table(MerchantApiKey{}.TableName()).Where("user_id = ?", uid).Delete(&MerchantApiKey{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Table(ShopStaff{}.TableName()).
+			Where("user_id = ? AND role = ?", uid, constants.ShopStaffRoleStaff).Delete(&ShopStaff{}).Error; err != nil {
+			return err
+		}
+		return tx.Table(Shop{}.TableName()).Where("owner_id = ?", uid).Update("status", constants.ShopStatusClosed).Error
+	})
+	if err != nil {
+		return errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to anonymize user: %v", err)
+	}
+	return nil
+}
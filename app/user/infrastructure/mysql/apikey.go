@@ -0,0 +1,104 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+
+	"github.com/west2-online/DomTok/app/user/domain/model"
+	"github.com/west2-online/DomTok/pkg/errno"
+)
+
+const apiKeyScopeSeparator = ","
+
+func (db *userDB) CreateApiKey(ctx context.Context, key *model.ApiKey) (int64, error) {
+	k := MerchantApiKey{
+		UserID:  key.Uid,
+		Name:    key.Name,
+		Prefix:  key.Prefix,
+		KeyHash: key.Digest,
+		Scopes:  strings.Join(key.Scopes, apiKeyScopeSeparator),
+	}
+	if err := db.client.WithContext(ctx).Table(MerchantApiKey{}.TableName()).Create(&k).Error; err != nil {
+		return -1, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to create api key: %v", err)
+	}
+	key.KeyID = k.ID
+	key.CreatedAt = k.CreatedAt.Unix()
+	return k.ID, nil
+}
+
+func (db *userDB) CountApiKeys(ctx context.Context, uid int64) (int64, error) {
+	var count int64
+	err := db.client.WithContext(ctx).Table(MerchantApiKey{}.TableName()).Where("user_id = ?", uid).Count(&count).Error
+	if err != nil {
+		return 0, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to count api keys: %v", err)
+	}
+	return count, nil
+}
+
+// ListApiKeys 列出用户持有的 API Key, 最新创建的排在最前面
+func (db *userDB) ListApiKeys(ctx context.Context, uid int64) ([]*model.ApiKey, error) {
+	var keys []*MerchantApiKey
+	err := db.client.WithContext(ctx).Table(MerchantApiKey{}.TableName()).
+		Where("user_id = ?", uid).Order("id DESC").Find(&keys).Error
+	if err != nil {
+		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to list api keys: %v", err)
+	}
+	return lo.Map(keys, func(item *MerchantApiKey, index int) *model.ApiKey {
+		return buildApiKey(item)
+	}), nil
+}
+
+// GetApiKeyByDigest 根据摘要查找 API Key, 不存在(包括已经被吊销)时返回 nil
+func (db *userDB) GetApiKeyByDigest(ctx context.Context, digest string) (*model.ApiKey, error) {
+	var key MerchantApiKey
+	err := db.client.WithContext(ctx).Table(MerchantApiKey{}.TableName()).Where("key_hash = ?", digest).First(&key).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to query api key: %v", err)
+	}
+	return buildApiKey(&key), nil
+}
+
+// DeleteApiKey 删除用户持有的 API Key, key 不存在或者不属于该用户时返回 false
+func (db *userDB) DeleteApiKey(ctx context.Context, uid int64, keyID int64) (bool, error) {
+	res := db.client.WithContext(ctx).Table(MerchantApiKey{}.TableName()).
+		Where("id = ? AND user_id = ?", keyID, uid).Delete(&MerchantApiKey{})
+	if res.Error != nil {
+		return false, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to delete api key: %v", res.Error)
+	}
+	return res.RowsAffected > 0, nil
+}
+
+func buildApiKey(key *MerchantApiKey) *model.ApiKey {
+	return &model.ApiKey{
+		KeyID:     key.ID,
+		Uid:       key.UserID,
+		Name:      key.Name,
+		Prefix:    key.Prefix,
+		Digest:    key.KeyHash,
+		Scopes:    strings.Split(key.Scopes, apiKeyScopeSeparator),
+		CreatedAt: key.CreatedAt.Unix(),
+	}
+}
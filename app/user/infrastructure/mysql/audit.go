@@ -0,0 +1,94 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"context"
+	"time"
+
+	"github.com/samber/lo"
+	"gorm.io/gorm/clause"
+
+	"github.com/west2-online/DomTok/app/user/domain/model"
+	"github.com/west2-online/DomTok/pkg/errno"
+)
+
+// CreateAuditLog 追加一条审计日志, 重复消费到同一个事件时忽略
+func (db *userDB) CreateAuditLog(ctx context.Context, log *model.AuditLog) error {
+	l := AuditLog{
+		EventID:   log.EventID,
+		ActorID:   log.ActorID,
+		Action:    log.Action,
+		Target:    log.Target,
+		Before:    log.Before,
+		After:     log.After,
+		ClientIP:  log.ClientIP,
+		TraceID:   log.TraceID,
+		CreatedAt: time.UnixMilli(log.CreatedAt),
+	}
+	err := db.client.WithContext(ctx).Table(AuditLog{}.TableName()).
+		Clauses(clause.OnConflict{DoNothing: true}).Create(&l).Error
+	if err != nil {
+		return errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to create audit log: %v", err)
+	}
+	log.LogID = l.ID
+	return nil
+}
+
+// ListAuditLogs 按条件分页查询审计日志, 最新的排在最前面
+func (db *userDB) ListAuditLogs(ctx context.Context, filter *model.AuditLogFilter) ([]*model.AuditLog, int64, error) {
+	query := db.client.WithContext(ctx).Table(AuditLog{}.TableName())
+	if filter.ActorID != 0 {
+		query = query.Where("actor_id = ?", filter.ActorID)
+	}
+	if filter.Action != "" {
+		query = query.Where("action = ?", filter.Action)
+	}
+	if filter.StartTime != 0 {
+		query = query.Where("created_at >= ?", time.UnixMilli(filter.StartTime))
+	}
+	if filter.EndTime != 0 {
+		query = query.Where("created_at < ?", time.UnixMilli(filter.EndTime))
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to count audit logs: %v", err)
+	}
+
+	var logs []*AuditLog
+	err := query.Order("created_at DESC, id DESC").
+		Offset(int((filter.PageNum - 1) * filter.PageSize)).Limit(int(filter.PageSize)).
+		Find(&logs).Error
+	if err != nil {
+		return nil, 0, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to list audit logs: %v", err)
+	}
+	return lo.Map(logs, func(item *AuditLog, index int) *model.AuditLog {
+		return &model.AuditLog{
+			LogID:     item.ID,
+			EventID:   item.EventID,
+			ActorID:   item.ActorID,
+			Action:    item.Action,
+			Target:    item.Target,
+			Before:    item.Before,
+			After:     item.After,
+			ClientIP:  item.ClientIP,
+			TraceID:   item.TraceID,
+			CreatedAt: item.CreatedAt.UnixMilli(),
+		}
+	}), total, nil
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+
+	"github.com/west2-online/DomTok/app/user/domain/model"
+	"github.com/west2-online/DomTok/pkg/errno"
+)
+
+func (db *userDB) CreateBan(ctx context.Context, ban *model.Ban) (int64, error) {
+	b := UserBan{
+		UserID:     ban.Uid,
+		Reason:     ban.Reason,
+		OperatorID: ban.OperatorID,
+		StartAt:    time.Unix(ban.StartAt, 0),
+	}
+	if ban.EndAt != 0 {
+		b.EndAt = lo.ToPtr(time.Unix(ban.EndAt, 0))
+	}
+
+	if err := db.client.WithContext(ctx).Table(UserBan{}.TableName()).Create(&b).Error; err != nil {
+		return -1, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to create ban: %v", err)
+	}
+	return b.ID, nil
+}
+
+// GetActiveBan 获取用户当前生效中的封禁, 没有时返回 nil
+func (db *userDB) GetActiveBan(ctx context.Context, uid int64) (*model.Ban, error) {
+	var ban UserBan
+	err := db.activeBans(ctx).Where("user_id = ?", uid).Order("id DESC").First(&ban).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to query active ban: %v", err)
+	}
+	return buildBan(&ban), nil
+}
+
+func (db *userDB) ListActiveBans(ctx context.Context) ([]*model.Ban, error) {
+	var bans []*UserBan
+	if err := db.activeBans(ctx).Find(&bans).Error; err != nil {
+		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to query active bans: %v", err)
+	}
+	return lo.Map(bans, func(item *UserBan, index int) *model.Ban {
+		return buildBan(item)
+	}), nil
+}
+
+// ListBans 获取用户的封禁历史, 最近的封禁排在最前面
+func (db *userDB) ListBans(ctx context.Context, uid int64) ([]*model.Ban, error) {
+	var bans []*UserBan
+	err := db.client.WithContext(ctx).Table(UserBan{}.TableName()).
+		Where("user_id = ?", uid).Order("id DESC").Find(&bans).Error
+	if err != nil {
+		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to query bans: %v", err)
+	}
+	return lo.Map(bans, func(item *UserBan, index int) *model.Ban {
+		return buildBan(item)
+	}), nil
+}
+
+func (db *userDB) LiftBan(ctx context.Context, banID int64, operatorID int64) error {
+	err := db.client.WithContext(ctx).Table(UserBan{}.TableName()).
+		Where("id = ?", banID).
+		Updates(map[string]any{"lifted_at": time.Now(), "lifted_by": operatorID}).Error
+	if err != nil {
+		return errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to lift ban: %v", err)
+	}
+	return nil
+}
+
+// activeBans 生效中的封禁: 没有被手动解封, 并且是永久封禁或者还没有到期
+func (db *userDB) activeBans(ctx context.Context) *gorm.DB {
+	return db.client.WithContext(ctx).Table(UserBan{}.TableName()).
+		Where("lifted_at IS NULL").
+		Where("end_at IS NULL OR end_at > ?", time.Now())
+}
+
+func buildBan(ban *UserBan) *model.Ban {
+	b := &model.Ban{
+		BanID:      ban.ID,
+		Uid:        ban.UserID,
+		Reason:     ban.Reason,
+		OperatorID: ban.OperatorID,
+		StartAt:    ban.StartAt.Unix(),
+		LiftedBy:   ban.LiftedBy,
+	}
+	if ban.EndAt != nil {
+		b.EndAt = ban.EndAt.Unix()
+	}
+	if ban.LiftedAt != nil {
+		b.LiftedAt = ban.LiftedAt.Unix()
+	}
+	return b
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 
+	"github.com/samber/lo"
 	"gorm.io/gorm"
 
 	"github.com/west2-online/DomTok/app/user/domain/model"
@@ -43,6 +44,9 @@ func (db *userDB) GetUserById(ctx context.Context, id int64) (*model.User, error
 	return &model.User{
 		Uid:      id,
 		UserName: ret.Username,
+		Password: ret.Password,
+		Email:    ret.Email,
+		Phone:    lo.FromPtr(ret.Phone),
 		Role:     ret.Role,
 	}, nil
 }
@@ -60,6 +64,9 @@ func (db *userDB) CreateUser(ctx context.Context, u *model.User) (int64, error)
 	}
 
 	if err := db.client.WithContext(ctx).Table(User{}.TableName()).Create(&user).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return -1, errno.Errorf(errno.ServiceUserExist, "mysql: username or email already in use")
+		}
 		return -1, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to create user: %v", err)
 	}
 
@@ -81,6 +88,24 @@ func (db *userDB) IsUserExist(ctx context.Context, username string) (bool, error
 	return true, nil
 }
 
+func (db *userDB) IsEmailExist(ctx context.Context, email string) (bool, error) {
+	var count int64
+	err := db.client.WithContext(ctx).Table(User{}.TableName()).Where("email = ?", email).Count(&count).Error
+	if err != nil {
+		return false, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to query user: %v", err)
+	}
+	return count > 0, nil
+}
+
+func (db *userDB) IsPhoneExist(ctx context.Context, phone string) (bool, error) {
+	var count int64
+	err := db.client.WithContext(ctx).Table(User{}.TableName()).Where("phone = ?", phone).Count(&count).Error
+	if err != nil {
+		return false, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to query user: %v", err)
+	}
+	return count > 0, nil
+}
+
 func (db *userDB) GetUserInfo(ctx context.Context, username string) (*model.User, error) {
 	var user User
 	err := db.client.WithContext(ctx).Where("username = ?", username).First(&user).Error
@@ -96,7 +121,7 @@ func (db *userDB) GetUserInfo(ctx context.Context, username string) (*model.User
 		UserName: user.Username,
 		Password: user.Password,
 		Email:    user.Email,
-		Phone:    user.Phone,
+		Phone:    lo.FromPtr(user.Phone),
 		Role:     user.Role,
 	}
 	return resp, nil
@@ -112,42 +137,138 @@ func (db *userDB) GetAddressInfo(ctx context.Context, addressID int64) (*model.A
 		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to query address: %v", err)
 	}
 
-	resp := &model.Address{
-		AddressID: address.ID,
-		Province:  address.Province,
-		City:      address.City,
-		Detail:    address.Detail,
-	}
-
-	return resp, nil
+	return buildAddress(&address), nil
 }
 
 func (db *userDB) CreateAddress(ctx context.Context, address *model.Address) (int64, error) {
 	addr := Address{
-		Province: address.Province,
-		City:     address.City,
-		Detail:   address.Detail,
+		UserID:        address.UserID,
+		Province:      address.Province,
+		City:          address.City,
+		Detail:        address.Detail,
+		RecipientName: address.RecipientName,
+		Phone:         address.Phone,
 	}
 
-	if err := db.client.WithContext(ctx).Table(Address{}.TableName()).Create(&addr).Error; err != nil {
+	// 新地址如果被指定为默认地址, 需要在同一个事务中清除该用户原来的默认地址
+	err := db.client.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Table(Address{}.TableName()).Create(&addr).Error; err != nil {
+			return err
+		}
+		if !address.IsDefault {
+			return nil
+		}
+		return setDefaultAddress(tx, address.UserID, addr.ID)
+	})
+	if err != nil {
 		return -1, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to create address: %v", err)
 	}
 
 	return addr.ID, nil
 }
 
+func (db *userDB) ListAddresses(ctx context.Context, uid int64) ([]*model.Address, error) {
+	var addresses []*Address
+	err := db.client.WithContext(ctx).Table(Address{}.TableName()).
+		Where("user_id = ?", uid).
+		Order("is_default DESC, id DESC").
+		Find(&addresses).Error
+	if err != nil {
+		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to list address: %v", err)
+	}
+
+	return lo.Map(addresses, func(item *Address, index int) *model.Address {
+		return buildAddress(item)
+	}), nil
+}
+
+func (db *userDB) UpdateAddress(ctx context.Context, address *model.Address) error {
+	// 使用 struct 更新时 gorm 只会更新非零值字段, 所以未传入的字段不会被覆盖
+	addr := Address{
+		Province:      address.Province,
+		City:          address.City,
+		Detail:        address.Detail,
+		RecipientName: address.RecipientName,
+		Phone:         address.Phone,
+	}
+	err := db.client.WithContext(ctx).Table(Address{}.TableName()).
+		Where("id = ?", address.AddressID).Updates(&addr).Error
+	if err != nil {
+		return errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to update address: %v", err)
+	}
+	return nil
+}
+
+func (db *userDB) DeleteAddress(ctx context.Context, addressID int64) error {
+	err := db.client.WithContext(ctx).Table(Address{}.TableName()).
+		Where("id = ?", addressID).Delete(&Address{}).Error
+	if err != nil {
+		return errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to delete address: %v", err)
+	}
+	return nil
+}
+
+func (db *userDB) SetDefaultAddress(ctx context.Context, uid int64, addressID int64) error {
+	err := db.client.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return setDefaultAddress(tx, uid, addressID)
+	})
+	if err != nil {
+		return errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to set default address: %v", err)
+	}
+	return nil
+}
+
+// setDefaultAddress 需要在事务中调用, 保证同一用户至多只有一个默认地址
+func setDefaultAddress(tx *gorm.DB, uid int64, addressID int64) error {
+	if err := tx.Table(Address{}.TableName()).
+		Where("user_id = ? AND is_default = ?", uid, true).
+		Update("is_default", false).Error; err != nil {
+		return err
+	}
+	return tx.Table(Address{}.TableName()).
+		Where("id = ? AND user_id = ?", addressID, uid).
+		Update("is_default", true).Error
+}
+
+func buildAddress(address *Address) *model.Address {
+	return &model.Address{
+		AddressID:     address.ID,
+		UserID:        address.UserID,
+		Province:      address.Province,
+		City:          address.City,
+		Detail:        address.Detail,
+		RecipientName: address.RecipientName,
+		Phone:         address.Phone,
+		IsDefault:     address.IsDefault,
+	}
+}
+
 func (db *userDB) UpdateUser(ctx context.Context, user *model.User) error {
 	u := User{
 		Username: user.UserName,
 		Password: user.Password,
 		Email:    user.Email,
-		Phone:    user.Phone,
+		Phone:    lo.EmptyableToPtr(user.Phone),
 		Role:     user.Role,
 	}
 	err := db.client.WithContext(ctx).Table(u.TableName()).
 		Where("id = ?", user.Uid).Updates(&u).Error
 	if err != nil {
+		// 用户名, 邮箱和手机号都有唯一索引, 并发修改时可能会绕过业务层的检查
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return errno.Errorf(errno.ServiceUserExist, "mysql: username, email or phone already in use")
+		}
 		return errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to update user: %v", err)
 	}
 	return nil
 }
+
+// UpdateUserRole 单独更新角色, Updates 会跳过零值, 无法把角色改回 RoleCustomer
+func (db *userDB) UpdateUserRole(ctx context.Context, uid int64, role int) error {
+	err := db.client.WithContext(ctx).Table(User{}.TableName()).
+		Where("id = ?", uid).Update("role", role).Error
+	if err != nil {
+		return errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to update user role: %v", err)
+	}
+	return nil
+}
@@ -16,7 +16,13 @@ limitations under the License.
 
 package mysql
 
-import "github.com/west2-online/DomTok/pkg/constants"
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/west2-online/DomTok/pkg/constants"
+)
 
 // User 是 mysql 【独有】的，和 db 中的表数据一一对应，和 entities 层的 User 的作用域不一样
 type User struct {
@@ -25,7 +31,7 @@ type User struct {
 	Username string
 	Password string
 	Email    string
-	Phone    string
+	Phone    *string // 手机号有唯一索引, 没有绑定手机号时需要存 NULL 而不是空字符串
 	Role     int
 }
 
@@ -34,13 +40,151 @@ func (User) TableName() string {
 }
 
 type Address struct {
-	ID       int64 `gorm:"primaryKey;autoIncrement"`
-	UserID   int64
-	Province string
-	City     string
-	Detail   string
+	ID            int64 `gorm:"primaryKey;autoIncrement"`
+	UserID        int64
+	Province      string
+	City          string
+	Detail        string
+	RecipientName string
+	Phone         string
+	IsDefault     bool
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	DeletedAt     gorm.DeletedAt `gorm:"index"`
 }
 
 func (Address) TableName() string {
 	return constants.AddressTableName
 }
+
+type Shop struct {
+	ID          int64 `gorm:"primaryKey;autoIncrement"`
+	Name        string
+	Logo        string
+	Description string
+	Status      int
+	OwnerID     int64
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func (Shop) TableName() string {
+	return constants.ShopTableName
+}
+
+type ShopStaff struct {
+	ID        int64 `gorm:"primaryKey;autoIncrement"`
+	ShopID    int64
+	UserID    int64
+	Role      int
+	CreatedAt time.Time
+}
+
+func (ShopStaff) TableName() string {
+	return constants.ShopStaffTableName
+}
+
+type UserBan struct {
+	ID         int64 `gorm:"primaryKey;autoIncrement"`
+	UserID     int64
+	Reason     string
+	OperatorID int64
+	StartAt    time.Time
+	EndAt      *time.Time // NULL 表示永久封禁
+	LiftedAt   *time.Time // NULL 表示没有被手动解封
+	LiftedBy   int64
+}
+
+func (UserBan) TableName() string {
+	return constants.UserBanTableName
+}
+
+type UserTOTP struct {
+	UserID       int64 `gorm:"primaryKey"`
+	Secret       string
+	Enabled      bool
+	LastUsedStep int64
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+func (UserTOTP) TableName() string {
+	return constants.UserTOTPTableName
+}
+
+type UserRecoveryCode struct {
+	ID       int64 `gorm:"primaryKey;autoIncrement"`
+	UserID   int64
+	CodeHash string
+	UsedAt   *time.Time // NULL 表示还没有被使用
+}
+
+func (UserRecoveryCode) TableName() string {
+	return constants.UserRecoveryCodeTableName
+}
+
+type MerchantApiKey struct {
+	ID        int64 `gorm:"primaryKey;autoIncrement"`
+	UserID    int64
+	Name      string
+	Prefix    string
+	KeyHash   string
+	Scopes    string // 多个 scope 使用逗号分隔
+	CreatedAt time.Time
+}
+
+func (MerchantApiKey) TableName() string {
+	return constants.MerchantApiKeyTableName
+}
+
+type AuditLog struct {
+	ID        int64 `gorm:"primaryKey;autoIncrement"`
+	EventID   string
+	ActorID   int64
+	Action    string
+	Target    string
+	Before    string
+	After     string
+	ClientIP  string
+	TraceID   string
+	CreatedAt time.Time
+}
+
+func (AuditLog) TableName() string {
+	return constants.AuditLogTableName
+}
+
+type WebhookEndpoint struct {
+	ID                  int64 `gorm:"primaryKey;autoIncrement"`
+	ShopID              int64
+	URL                 string `gorm:"column:url"`
+	Secret              string
+	EventTypes          string // 多个事件类型使用逗号分隔
+	Status              int
+	ConsecutiveFailures int64
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
+
+func (WebhookEndpoint) TableName() string {
+	return constants.WebhookEndpointTableName
+}
+
+type WebhookDelivery struct {
+	ID             int64 `gorm:"primaryKey;autoIncrement"`
+	EndpointID     int64
+	EventID        string
+	EventType      string
+	Payload        string
+	Status         int
+	Attempts       int64
+	NextRetryAt    int64 // 毫秒时间戳
+	LastStatusCode int64
+	LastError      string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func (WebhookDelivery) TableName() string {
+	return constants.WebhookDeliveryTableName
+}
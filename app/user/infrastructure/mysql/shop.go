@@ -0,0 +1,190 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+
+	"github.com/west2-online/DomTok/app/user/domain/model"
+	"github.com/west2-online/DomTok/pkg/constants"
+	"github.com/west2-online/DomTok/pkg/errno"
+)
+
+func (db *userDB) CreateShop(ctx context.Context, shop *model.Shop) (int64, error) {
+	s := Shop{
+		Name:        shop.Name,
+		Logo:        shop.Logo,
+		Description: shop.Description,
+		Status:      constants.ShopStatusOpen,
+		OwnerID:     shop.OwnerID,
+	}
+
+	// 店主同时作为店铺的第一个成员, 需要和店铺在同一个事务中创建
+	err := db.client.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Table(Shop{}.TableName()).Create(&s).Error; err != nil {
+			return err
+		}
+		owner := ShopStaff{
+			ShopID: s.ID,
+			UserID: shop.OwnerID,
+			Role:   constants.ShopStaffRoleOwner,
+		}
+		return tx.Table(ShopStaff{}.TableName()).Create(&owner).Error
+	})
+	if err != nil {
+		return -1, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to create shop: %v", err)
+	}
+
+	return s.ID, nil
+}
+
+func (db *userDB) GetShopByID(ctx context.Context, shopID int64) (*model.Shop, error) {
+	var shop Shop
+	err := db.client.WithContext(ctx).Table(Shop{}.TableName()).Where("id = ?", shopID).First(&shop).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errno.Errorf(errno.ServiceShopNotExist, "mysql: shop not exist")
+		}
+		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to query shop: %v", err)
+	}
+
+	return buildShop(&shop), nil
+}
+
+func (db *userDB) GetShopsByIDs(ctx context.Context, shopIDs []int64) ([]*model.Shop, error) {
+	var shops []*Shop
+	err := db.client.WithContext(ctx).Table(Shop{}.TableName()).Where("id IN ?", shopIDs).Find(&shops).Error
+	if err != nil {
+		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to query shops: %v", err)
+	}
+
+	return lo.Map(shops, func(item *Shop, index int) *model.Shop {
+		return buildShop(item)
+	}), nil
+}
+
+func (db *userDB) UpdateShop(ctx context.Context, shop *model.Shop) error {
+	// 使用 struct 更新时 gorm 只会更新非零值字段, 所以未传入的字段不会被覆盖
+	s := Shop{
+		Name:        shop.Name,
+		Logo:        shop.Logo,
+		Description: shop.Description,
+	}
+	err := db.client.WithContext(ctx).Table(Shop{}.TableName()).
+		Where("id = ?", shop.ShopID).Updates(&s).Error
+	if err != nil {
+		return errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to update shop: %v", err)
+	}
+	return nil
+}
+
+func (db *userDB) UpdateShopStatus(ctx context.Context, shopID int64, status int) error {
+	err := db.client.WithContext(ctx).Table(Shop{}.TableName()).
+		Where("id = ?", shopID).Update("status", status).Error
+	if err != nil {
+		return errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to update shop status: %v", err)
+	}
+	return nil
+}
+
+func (db *userDB) IsShopStaff(ctx context.Context, shopID int64, uid int64) (bool, error) {
+	var count int64
+	err := db.client.WithContext(ctx).Table(ShopStaff{}.TableName()).
+		Where("shop_id = ? AND user_id = ?", shopID, uid).Count(&count).Error
+	if err != nil {
+		return false, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to query shop staff: %v", err)
+	}
+	return count > 0, nil
+}
+
+func (db *userDB) AddShopStaff(ctx context.Context, staff *model.ShopStaff) error {
+	s := ShopStaff{
+		ShopID: staff.ShopID,
+		UserID: staff.Uid,
+		Role:   staff.Role,
+	}
+	if err := db.client.WithContext(ctx).Table(ShopStaff{}.TableName()).Create(&s).Error; err != nil {
+		return errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to add shop staff: %v", err)
+	}
+	return nil
+}
+
+func (db *userDB) RemoveShopStaff(ctx context.Context, shopID int64, uid int64) error {
+	err := db.client.WithContext(ctx).Table(ShopStaff{}.TableName()).
+		Where("shop_id = ? AND user_id = ?", shopID, uid).Delete(&ShopStaff{}).Error
+	if err != nil {
+		return errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to remove shop staff: %v", err)
+	}
+	return nil
+}
+
+func (db *userDB) ListShopStaff(ctx context.Context, shopID int64) ([]*model.ShopStaff, error) {
+	var staff []*ShopStaff
+	err := db.client.WithContext(ctx).Table(ShopStaff{}.TableName()).
+		Where("shop_id = ?", shopID).
+		Order("role DESC, id ASC").
+		Find(&staff).Error
+	if err != nil {
+		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to list shop staff: %v", err)
+	}
+
+	return lo.Map(staff, func(item *ShopStaff, index int) *model.ShopStaff {
+		return &model.ShopStaff{
+			ShopID:    item.ShopID,
+			Uid:       item.UserID,
+			Role:      item.Role,
+			CreatedAt: item.CreatedAt.Unix(),
+		}
+	}), nil
+}
+
+func (db *userDB) ListUserShops(ctx context.Context, uid int64) ([]*model.ShopStaff, error) {
+	var staff []*ShopStaff
+	err := db.client.WithContext(ctx).Table(ShopStaff{}.TableName()).
+		Where("user_id = ?", uid).
+		Order("id ASC").
+		Find(&staff).Error
+	if err != nil {
+		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to list user shops: %v", err)
+	}
+
+	return lo.Map(staff, func(item *ShopStaff, index int) *model.ShopStaff {
+		return &model.ShopStaff{
+			ShopID:    item.ShopID,
+			Uid:       item.UserID,
+			Role:      item.Role,
+			CreatedAt: item.CreatedAt.Unix(),
+		}
+	}), nil
+}
+
+func buildShop(shop *Shop) *model.Shop {
+	return &model.Shop{
+		ShopID:      shop.ID,
+		Name:        shop.Name,
+		Logo:        shop.Logo,
+		Description: shop.Description,
+		Status:      shop.Status,
+		OwnerID:     shop.OwnerID,
+		CreatedAt:   shop.CreatedAt.Unix(),
+		UpdatedAt:   shop.UpdatedAt.Unix(),
+	}
+}
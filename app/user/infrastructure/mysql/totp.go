@@ -0,0 +1,117 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+
+	"github.com/west2-online/DomTok/app/user/domain/model"
+	"github.com/west2-online/DomTok/pkg/errno"
+)
+
+// GetTOTP 获取用户的两步验证配置, 没有时返回 nil
+func (db *userDB) GetTOTP(ctx context.Context, uid int64) (*model.TOTP, error) {
+	var totp UserTOTP
+	err := db.client.WithContext(ctx).Table(UserTOTP{}.TableName()).Where("user_id = ?", uid).First(&totp).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to query totp: %v", err)
+	}
+	return &model.TOTP{
+		Uid:          totp.UserID,
+		Secret:       totp.Secret,
+		Enabled:      totp.Enabled,
+		LastUsedStep: totp.LastUsedStep,
+	}, nil
+}
+
+// SaveTOTP 保存一份新的两步验证配置和恢复码摘要, 用户原有的配置和恢复码会被一并替换
+func (db *userDB) SaveTOTP(ctx context.Context, totp *model.TOTP, recoveryCodes []string) error {
+	err := db.client.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Table(UserTOTP{}.TableName()).Where("user_id = ?", totp.Uid).Delete(&UserTOTP{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Table(UserRecoveryCode{}.TableName()).Where("user_id = ?", totp.Uid).Delete(&UserRecoveryCode{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Table(UserTOTP{}.TableName()).Create(&UserTOTP{
+			UserID:  totp.Uid,
+			Secret:  totp.Secret,
+			Enabled: totp.Enabled,
+		}).Error; err != nil {
+			return err
+		}
+		codes := lo.Map(recoveryCodes, func(item string, index int) *UserRecoveryCode {
+			return &UserRecoveryCode{UserID: totp.Uid, CodeHash: item}
+		})
+		return tx.Table(UserRecoveryCode{}.TableName()).Create(&codes).Error
+	})
+	if err != nil {
+		return errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to save totp: %v", err)
+	}
+	return nil
+}
+
+func (db *userDB) EnableTOTP(ctx context.Context, uid int64) error {
+	err := db.client.WithContext(ctx).Table(UserTOTP{}.TableName()).Where("user_id = ?", uid).Update("enabled", true).Error
+	if err != nil {
+		return errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to enable totp: %v", err)
+	}
+	return nil
+}
+
+// DeleteTOTP 删除用户的两步验证配置和全部恢复码
+func (db *userDB) DeleteTOTP(ctx context.Context, uid int64) error {
+	err := db.client.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Table(UserTOTP{}.TableName()).Where("user_id = ?", uid).Delete(&UserTOTP{}).Error; err != nil {
+			return err
+		}
+		return tx.Table(UserRecoveryCode{}.TableName()).Where("user_id = ?", uid).Delete(&UserRecoveryCode{}).Error
+	})
+	if err != nil {
+		return errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to delete totp: %v", err)
+	}
+	return nil
+}
+
+// UseTOTPStep 记录一次校验通过的时间步, 只有比上一次更新的时间步才能记录成功
+// 通过条件更新实现, 并发提交同一个验证码时只有一个请求会返回 true
+func (db *userDB) UseTOTPStep(ctx context.Context, uid int64, step int64) (bool, error) {
+	res := db.client.WithContext(ctx).Table(UserTOTP{}.TableName()).
+		Where("user_id = ? AND last_used_step < ?", uid, step).Update("last_used_step", step)
+	if res.Error != nil {
+		return false, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to use totp step: %v", res.Error)
+	}
+	return res.RowsAffected > 0, nil
+}
+
+// UseRecoveryCode 把摘要为 digest 的恢复码标记为已使用, 恢复码不存在或已经被使用时返回 false
+func (db *userDB) UseRecoveryCode(ctx context.Context, uid int64, digest string) (bool, error) {
+	res := db.client.WithContext(ctx).Table(UserRecoveryCode{}.TableName()).
+		Where("user_id = ? AND code_hash = ? AND used_at IS NULL", uid, digest).Update("used_at", time.Now())
+	if res.Error != nil {
+		return false, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to use recovery code: %v", res.Error)
+	}
+	return res.RowsAffected > 0, nil
+}
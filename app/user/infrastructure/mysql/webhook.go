@@ -0,0 +1,286 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/west2-online/DomTok/app/user/domain/model"
+	"github.com/west2-online/DomTok/pkg/constants"
+	"github.com/west2-online/DomTok/pkg/errno"
+)
+
+const webhookEventTypeSeparator = ","
+
+func (db *userDB) CreateWebhookEndpoint(ctx context.Context, endpoint *model.WebhookEndpoint) (int64, error) {
+	e := WebhookEndpoint{
+		ShopID:     endpoint.ShopID,
+		URL:        endpoint.URL,
+		Secret:     endpoint.Secret,
+		EventTypes: strings.Join(endpoint.EventTypes, webhookEventTypeSeparator),
+		Status:     endpoint.Status,
+	}
+	if err := db.client.WithContext(ctx).Table(WebhookEndpoint{}.TableName()).Create(&e).Error; err != nil {
+		return -1, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to create webhook endpoint: %v", err)
+	}
+	endpoint.EndpointID = e.ID
+	endpoint.CreatedAt = e.CreatedAt.UnixMilli()
+	endpoint.UpdatedAt = e.UpdatedAt.UnixMilli()
+	return e.ID, nil
+}
+
+func (db *userDB) CountWebhookEndpoints(ctx context.Context, shopID int64) (int64, error) {
+	var count int64
+	err := db.client.WithContext(ctx).Table(WebhookEndpoint{}.TableName()).Where("shop_id = ?", shopID).Count(&count).Error
+	if err != nil {
+		return 0, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to count webhook endpoints: %v", err)
+	}
+	return count, nil
+}
+
+func (db *userDB) GetWebhookEndpoint(ctx context.Context, endpointID int64) (*model.WebhookEndpoint, error) {
+	var e WebhookEndpoint
+	err := db.client.WithContext(ctx).Table(WebhookEndpoint{}.TableName()).Where("id = ?", endpointID).First(&e).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errno.Errorf(errno.ServiceWebhookEndpointNotExist, "mysql: webhook endpoint not exist")
+		}
+		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to query webhook endpoint: %v", err)
+	}
+	return buildWebhookEndpoint(&e), nil
+}
+
+// ListWebhookEndpoints 列出店铺的全部 webhook 端点, 最先创建的排在最前面
+func (db *userDB) ListWebhookEndpoints(ctx context.Context, shopID int64) ([]*model.WebhookEndpoint, error) {
+	var endpoints []*WebhookEndpoint
+	err := db.client.WithContext(ctx).Table(WebhookEndpoint{}.TableName()).
+		Where("shop_id = ?", shopID).Order("id ASC").Find(&endpoints).Error
+	if err != nil {
+		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to list webhook endpoints: %v", err)
+	}
+	return lo.Map(endpoints, func(item *WebhookEndpoint, index int) *model.WebhookEndpoint {
+		return buildWebhookEndpoint(item)
+	}), nil
+}
+
+// UpdateWebhookEndpoint 更新端点的地址, 订阅和状态, status 和失败次数可能为零值, 所以需要显式指定更新的列
+func (db *userDB) UpdateWebhookEndpoint(ctx context.Context, endpoint *model.WebhookEndpoint) error {
+	e := WebhookEndpoint{
+		URL:                 endpoint.URL,
+		EventTypes:          strings.Join(endpoint.EventTypes, webhookEventTypeSeparator),
+		Status:              endpoint.Status,
+		ConsecutiveFailures: endpoint.ConsecutiveFailures,
+	}
+	err := db.client.WithContext(ctx).Table(WebhookEndpoint{}.TableName()).Where("id = ?", endpoint.EndpointID).
+		Select("url", "event_types", "status", "consecutive_failures").Updates(&e).Error
+	if err != nil {
+		return errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to update webhook endpoint: %v", err)
+	}
+	return nil
+}
+
+// DeleteWebhookEndpoint 删除端点以及它的投递记录
+func (db *userDB) DeleteWebhookEndpoint(ctx context.Context, endpointID int64) error {
+	err := db.client.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Table(WebhookDelivery{}.TableName()).Where("endpoint_id = ?", endpointID).Delete(&WebhookDelivery{}).Error; err != nil {
+			return err
+		}
+		return tx.Table(WebhookEndpoint{}.TableName()).Where("id = ?", endpointID).Delete(&WebhookEndpoint{}).Error
+	})
+	if err != nil {
+		return errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to delete webhook endpoint: %v", err)
+	}
+	return nil
+}
+
+// IncrWebhookEndpointFailures 将端点的连续失败次数加一, 返回增加后的次数
+func (db *userDB) IncrWebhookEndpointFailures(ctx context.Context, endpointID int64) (int64, error) {
+	var failures int64
+	err := db.client.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Table(WebhookEndpoint{}.TableName()).Where("id = ?", endpointID).
+			Update("consecutive_failures", gorm.Expr("consecutive_failures + 1")).Error; err != nil {
+			return err
+		}
+		return tx.Table(WebhookEndpoint{}.TableName()).Where("id = ?", endpointID).
+			Pluck("consecutive_failures", &failures).Error
+	})
+	if err != nil {
+		return 0, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to increase webhook endpoint failures: %v", err)
+	}
+	return failures, nil
+}
+
+func (db *userDB) ResetWebhookEndpointFailures(ctx context.Context, endpointID int64) error {
+	err := db.client.WithContext(ctx).Table(WebhookEndpoint{}.TableName()).
+		Where("id = ? AND consecutive_failures <> 0", endpointID).Update("consecutive_failures", 0).Error
+	if err != nil {
+		return errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to reset webhook endpoint failures: %v", err)
+	}
+	return nil
+}
+
+func (db *userDB) DisableWebhookEndpoint(ctx context.Context, endpointID int64) error {
+	err := db.client.WithContext(ctx).Table(WebhookEndpoint{}.TableName()).
+		Where("id = ?", endpointID).Update("status", constants.WebhookEndpointStatusDisabled).Error
+	if err != nil {
+		return errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to disable webhook endpoint: %v", err)
+	}
+	return nil
+}
+
+// CreateWebhookDelivery 创建一条投递记录, 重复消费到同一个事件时忽略并返回 false
+func (db *userDB) CreateWebhookDelivery(ctx context.Context, delivery *model.WebhookDelivery) (bool, error) {
+	d := WebhookDelivery{
+		EndpointID:  delivery.EndpointID,
+		EventID:     delivery.EventID,
+		EventType:   delivery.EventType,
+		Payload:     delivery.Payload,
+		Status:      delivery.Status,
+		NextRetryAt: delivery.NextRetryAt,
+	}
+	res := db.client.WithContext(ctx).Table(WebhookDelivery{}.TableName()).
+		Clauses(clause.OnConflict{DoNothing: true}).Create(&d)
+	if res.Error != nil {
+		return false, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to create webhook delivery: %v", res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return false, nil
+	}
+	delivery.DeliveryID = d.ID
+	delivery.CreatedAt = d.CreatedAt.UnixMilli()
+	delivery.UpdatedAt = d.UpdatedAt.UnixMilli()
+	return true, nil
+}
+
+func (db *userDB) GetWebhookDelivery(ctx context.Context, deliveryID int64) (*model.WebhookDelivery, error) {
+	var d WebhookDelivery
+	err := db.client.WithContext(ctx).Table(WebhookDelivery{}.TableName()).Where("id = ?", deliveryID).First(&d).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errno.Errorf(errno.ServiceWebhookDeliveryNotExist, "mysql: webhook delivery not exist")
+		}
+		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to query webhook delivery: %v", err)
+	}
+	return buildWebhookDelivery(&d), nil
+}
+
+// ListWebhookDeliveries 分页查询端点的投递日志, 最新的排在最前面
+func (db *userDB) ListWebhookDeliveries(ctx context.Context, endpointID int64, pageNum, pageSize int64) ([]*model.WebhookDelivery, int64, error) {
+	query := db.client.WithContext(ctx).Table(WebhookDelivery{}.TableName()).Where("endpoint_id = ?", endpointID)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to count webhook deliveries: %v", err)
+	}
+
+	var deliveries []*WebhookDelivery
+	err := query.Order("id DESC").Offset(int((pageNum - 1) * pageSize)).Limit(int(pageSize)).Find(&deliveries).Error
+	if err != nil {
+		return nil, 0, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to list webhook deliveries: %v", err)
+	}
+	return lo.Map(deliveries, func(item *WebhookDelivery, index int) *model.WebhookDelivery {
+		return buildWebhookDelivery(item)
+	}), total, nil
+}
+
+// ListDueWebhookDeliveries 查询已经到达投递时间的投递记录, 最早到期的排在最前面
+func (db *userDB) ListDueWebhookDeliveries(ctx context.Context, now int64, limit int) ([]*model.WebhookDelivery, error) {
+	var deliveries []*WebhookDelivery
+	err := db.client.WithContext(ctx).Table(WebhookDelivery{}.TableName()).
+		Where("status = ? AND next_retry_at <= ?", constants.WebhookDeliveryStatusPending, now).
+		Order("next_retry_at ASC").Limit(limit).Find(&deliveries).Error
+	if err != nil {
+		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to list due webhook deliveries: %v", err)
+	}
+	return lo.Map(deliveries, func(item *WebhookDelivery, index int) *model.WebhookDelivery {
+		return buildWebhookDelivery(item)
+	}), nil
+}
+
+// ClaimWebhookDelivery 领取一条投递, 把 next_retry_at 推迟到 leaseUntil,
+// 只有 next_retry_at 仍然是查询到的值时才能领取成功, 以此避免多个实例重复投递
+func (db *userDB) ClaimWebhookDelivery(ctx context.Context, delivery *model.WebhookDelivery, leaseUntil int64) (bool, error) {
+	res := db.client.WithContext(ctx).Table(WebhookDelivery{}.TableName()).
+		Where("id = ? AND status = ? AND next_retry_at = ?",
+			delivery.DeliveryID, constants.WebhookDeliveryStatusPending, delivery.NextRetryAt).
+		Update("next_retry_at", leaseUntil)
+	if res.Error != nil {
+		return false, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to claim webhook delivery: %v", res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return false, nil
+	}
+	delivery.NextRetryAt = leaseUntil
+	return true, nil
+}
+
+// UpdateWebhookDelivery 记录一次投递的结果
+func (db *userDB) UpdateWebhookDelivery(ctx context.Context, delivery *model.WebhookDelivery) error {
+	d := WebhookDelivery{
+		Status:         delivery.Status,
+		Attempts:       delivery.Attempts,
+		NextRetryAt:    delivery.NextRetryAt,
+		LastStatusCode: delivery.LastStatusCode,
+		LastError:      delivery.LastError,
+		UpdatedAt:      time.Now(),
+	}
+	err := db.client.WithContext(ctx).Table(WebhookDelivery{}.TableName()).Where("id = ?", delivery.DeliveryID).
+		Select("status", "attempts", "next_retry_at", "last_status_code", "last_error", "updated_at").Updates(&d).Error
+	if err != nil {
+		return errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to update webhook delivery: %v", err)
+	}
+	delivery.UpdatedAt = d.UpdatedAt.UnixMilli()
+	return nil
+}
+
+func buildWebhookEndpoint(e *WebhookEndpoint) *model.WebhookEndpoint {
+	return &model.WebhookEndpoint{
+		EndpointID:          e.ID,
+		ShopID:              e.ShopID,
+		URL:                 e.URL,
+		Secret:              e.Secret,
+		EventTypes:          strings.Split(e.EventTypes, webhookEventTypeSeparator),
+		Status:              e.Status,
+		ConsecutiveFailures: e.ConsecutiveFailures,
+		CreatedAt:           e.CreatedAt.UnixMilli(),
+		UpdatedAt:           e.UpdatedAt.UnixMilli(),
+	}
+}
+
+func buildWebhookDelivery(d *WebhookDelivery) *model.WebhookDelivery {
+	return &model.WebhookDelivery{
+		DeliveryID:     d.ID,
+		EndpointID:     d.EndpointID,
+		EventID:        d.EventID,
+		EventType:      d.EventType,
+		Payload:        d.Payload,
+		Status:         d.Status,
+		Attempts:       d.Attempts,
+		NextRetryAt:    d.NextRetryAt,
+		LastStatusCode: d.LastStatusCode,
+		LastError:      d.LastError,
+		CreatedAt:      d.CreatedAt.UnixMilli(),
+		UpdatedAt:      d.UpdatedAt.UnixMilli(),
+	}
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/west2-online/DomTok/app/user/domain/model"
+	"github.com/west2-online/DomTok/app/user/domain/repository"
+	"github.com/west2-online/DomTok/pkg/errno"
+)
+
+// fileNotifier 把通知逐行追加写入到文件中, 方便本地调试时查看验证码
+type fileNotifier struct {
+	path string
+	mu   sync.Mutex
+}
+
+func NewFileNotifier(path string) repository.Notifier {
+	return &fileNotifier{path: path}
+}
+
+func (n *fileNotifier) Notify(ctx context.Context, notification *model.Notification) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	f, err := os.OpenFile(n.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return errno.Errorf(errno.InternalServiceErrorCode, "fileNotifier: failed to open file: %v", err)
+	}
+	defer f.Close()
+
+	_, err = fmt.Fprintf(f, "%s\tuid=%d\temail=%s\tsubject=%s\tcontent=%s\n",
+		time.Now().Format(time.RFC3339), notification.Uid, notification.Email, notification.Subject, notification.Content)
+	if err != nil {
+		return errno.Errorf(errno.InternalServiceErrorCode, "fileNotifier: failed to write file: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+
+	"github.com/west2-online/DomTok/app/user/domain/model"
+	"github.com/west2-online/DomTok/app/user/domain/repository"
+	"github.com/west2-online/DomTok/pkg/logger"
+)
+
+// logNotifier 把通知直接打印到日志中, 仅用于本地调试
+type logNotifier struct{}
+
+func NewLogNotifier() repository.Notifier {
+	return &logNotifier{}
+}
+
+func (n *logNotifier) Notify(ctx context.Context, notification *model.Notification) error {
+	logger.Infof("notifier: uid: %d, email: %s, subject: %s, content: %s",
+		notification.Uid, notification.Email, notification.Subject, notification.Content)
+	return nil
+}
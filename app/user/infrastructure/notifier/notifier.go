@@ -0,0 +1,42 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"github.com/west2-online/DomTok/app/user/domain/repository"
+	"github.com/west2-online/DomTok/config"
+	"github.com/west2-online/DomTok/pkg/constants"
+	"github.com/west2-online/DomTok/pkg/logger"
+)
+
+// NewNotifier 根据配置选择通知的投递方式, 未配置时默认输出到日志
+// 目前只有本地调试用的实现, 接入邮件或短信时新增一个 repository.Notifier 的实现即可
+func NewNotifier() repository.Notifier {
+	if config.Notifier == nil {
+		return NewLogNotifier()
+	}
+
+	switch config.Notifier.Type {
+	case constants.NotifierTypeFile:
+		return NewFileNotifier(config.Notifier.Path)
+	case constants.NotifierTypeLog, "":
+		return NewLogNotifier()
+	default:
+		logger.Warnf("notifier: unknown notifier type %s, fallback to log", config.Notifier.Type)
+		return NewLogNotifier()
+	}
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rpc
+
+import (
+	"context"
+
+	"github.com/west2-online/DomTok/app/user/domain/repository"
+	"github.com/west2-online/DomTok/kitex_gen/cart"
+	"github.com/west2-online/DomTok/kitex_gen/cart/cartservice"
+	"github.com/west2-online/DomTok/kitex_gen/commodity"
+	"github.com/west2-online/DomTok/kitex_gen/commodity/commodityservice"
+	"github.com/west2-online/DomTok/kitex_gen/order"
+	"github.com/west2-online/DomTok/kitex_gen/order/orderservice"
+	"github.com/west2-online/DomTok/kitex_gen/payment"
+	"github.com/west2-online/DomTok/kitex_gen/payment/paymentservice"
+	"github.com/west2-online/DomTok/pkg/utils"
+)
+
+type userRpcImpl struct {
+	cart      cartservice.Client
+	order     orderservice.Client
+	payment   paymentservice.Client
+	commodity commodityservice.Client
+}
+
+func NewUserRpcImpl(cart cartservice.Client, order orderservice.Client,
+	payment paymentservice.Client, commodity commodityservice.Client,
+) repository.RPC {
+	return &userRpcImpl{
+		cart:      cart,
+		order:     order,
+		payment:   payment,
+		commodity: commodity,
+	}
+}
+
+func (rpc *userRpcImpl) ExportCartData(ctx context.Context, uid int64) (string, error) {
+	resp, err := rpc.cart.ExportUserData(ctx, &cart.ExportUserDataRequest{Uid: uid})
+	if err = utils.ProcessRpcError("cart.ExportUserData", resp, err); err != nil {
+		return "", err
+	}
+	return resp.Data, nil
+}
+
+func (rpc *userRpcImpl) ExportOrderData(ctx context.Context, uid int64) (string, error) {
+	resp, err := rpc.order.ExportUserData(ctx, &order.ExportUserDataReq{Uid: uid})
+	if err = utils.ProcessRpcError("order.ExportUserData", resp, err); err != nil {
+		return "", err
+	}
+	return resp.Data, nil
+}
+
+func (rpc *userRpcImpl) ExportPaymentData(ctx context.Context, uid int64) (string, error) {
+	resp, err := rpc.payment.ExportUserData(ctx, &payment.ExportUserDataRequest{Uid: uid})
+	if err = utils.ProcessRpcError("payment.ExportUserData", resp, err); err != nil {
+		return "", err
+	}
+	return resp.Data, nil
+}
+
+func (rpc *userRpcImpl) ExportCouponData(ctx context.Context, uid int64) (string, error) {
+	resp, err := rpc.commodity.ExportUserCoupon(ctx, &commodity.ExportUserCouponReq{Uid: uid})
+	if err = utils.ProcessRpcError("commodity.ExportUserCoupon", resp, err); err != nil {
+		return "", err
+	}
+	return resp.Data, nil
+}
+
+func (rpc *userRpcImpl) EraseCartData(ctx context.Context, uid int64) error {
+	resp, err := rpc.cart.EraseUserData(ctx, &cart.EraseUserDataRequest{Uid: uid})
+	return utils.ProcessRpcError("cart.EraseUserData", resp, err)
+}
+
+func (rpc *userRpcImpl) EraseOrderData(ctx context.Context, uid int64) error {
+	resp, err := rpc.order.EraseUserData(ctx, &order.EraseUserDataReq{Uid: uid})
+	return utils.ProcessRpcError("order.EraseUserData", resp, err)
+}
+
+func (rpc *userRpcImpl) EraseCouponData(ctx context.Context, uid int64) error {
+	resp, err := rpc.commodity.EraseUserCoupon(ctx, &commodity.EraseUserCouponReq{Uid: uid})
+	return utils.ProcessRpcError("commodity.EraseUserCoupon", resp, err)
+}
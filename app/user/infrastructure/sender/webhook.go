@@ -0,0 +1,93 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sender
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/west2-online/DomTok/app/user/domain/model"
+	"github.com/west2-online/DomTok/app/user/domain/repository"
+	"github.com/west2-online/DomTok/pkg/constants"
+	"github.com/west2-online/DomTok/pkg/errno"
+	"github.com/west2-online/DomTok/pkg/webhook"
+)
+
+// webhookSender 使用 HTTP POST 投递 webhook, 请求体即事件内容, 签名和事件信息放在请求头中
+type webhookSender struct {
+	client *http.Client
+}
+
+func NewWebhookSender() repository.WebhookSender {
+	return newWebhookSender(webhook.DialControl)
+}
+
+// newWebhookSender 的 control 会在每次建立连接前检查实际连接的地址, 为 nil 时不检查, 仅用于测试
+func newWebhookSender(control func(network, address string, c syscall.RawConn) error) *webhookSender {
+	transport := http.DefaultTransport.(*http.Transport).Clone() //nolint:forcetypeassert
+	// 不使用环境变量中的代理, 否则连接的是代理的地址, 无法检查商家的地址
+	transport.Proxy = nil
+	transport.DialContext = (&net.Dialer{
+		Timeout: constants.WebhookRequestTimeout,
+		Control: control,
+	}).DialContext
+	return &webhookSender{
+		client: &http.Client{
+			Transport: transport,
+			Timeout:   constants.WebhookRequestTimeout,
+			// 不跟随重定向, 避免商家的地址把请求转发到其他地方
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+func (s *webhookSender) Send(ctx context.Context, req *model.WebhookRequest) (int, error) {
+	body := []byte(req.Payload)
+	timestamp := time.Now().Unix()
+
+	r, err := http.NewRequestWithContext(ctx, http.MethodPost, req.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, errno.Errorf(errno.InternalServiceErrorCode, "webhookSender: failed to build request: %v", err)
+	}
+	r.Header.Set("Content-Type", "application/json")
+	r.Header.Set(constants.WebhookEventHeader, req.EventType)
+	r.Header.Set(constants.WebhookDeliveryHeader, strconv.FormatInt(req.DeliveryID, 10))
+	r.Header.Set(constants.WebhookTimestampHeader, strconv.FormatInt(timestamp, 10))
+	r.Header.Set(constants.WebhookSignatureHeader, webhook.Sign(req.Secret, timestamp, body))
+
+	resp, err := s.client.Do(r)
+	if err != nil {
+		return 0, errno.Errorf(errno.InternalNetworkErrorCode, "webhookSender: failed to send request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		// 只保留响应的开头部分, 方便商家在投递日志中排查问题
+		v, _ := io.ReadAll(io.LimitReader(resp.Body, constants.WebhookResponseErrorMaxSize))
+		return resp.StatusCode, errno.Errorf(errno.InternalServiceErrorCode,
+			"webhookSender: unexpected status code %d: %s", resp.StatusCode, string(v))
+	}
+	return resp.StatusCode, nil
+}
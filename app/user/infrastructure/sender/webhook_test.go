@@ -0,0 +1,100 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sender
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/west2-online/DomTok/app/user/domain/model"
+	"github.com/west2-online/DomTok/pkg/constants"
+	"github.com/west2-online/DomTok/pkg/webhook"
+)
+
+func TestWebhookSender_Send(t *testing.T) {
+	const secret = "test-secret"
+	payload := `{"event_id":"e1","type":"order.paid","order_id":1}`
+
+	Convey("TestWebhookSender_Send", t, func() {
+		var (
+			header http.Header
+			body   []byte
+			status = http.StatusOK
+		)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			header = r.Header.Clone()
+			body, _ = io.ReadAll(r.Body)
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte("receiver says no"))
+		}))
+		defer server.Close()
+
+		// 测试服务监听在回环地址上, 这里关闭拨号时的地址检查
+		s := newWebhookSender(nil)
+		req := &model.WebhookRequest{
+			URL:        server.URL,
+			Secret:     secret,
+			DeliveryID: 42,
+			EventType:  constants.WebhookEventOrderPaid,
+			Payload:    payload,
+		}
+
+		Convey("signed delivery", func() {
+			code, err := s.Send(context.Background(), req)
+			So(err, ShouldBeNil)
+			So(code, ShouldEqual, http.StatusOK)
+			So(string(body), ShouldEqual, payload)
+			So(header.Get(constants.WebhookEventHeader), ShouldEqual, constants.WebhookEventOrderPaid)
+			So(header.Get(constants.WebhookDeliveryHeader), ShouldEqual, "42")
+
+			ts, err := strconv.ParseInt(header.Get(constants.WebhookTimestampHeader), 10, 64)
+			So(err, ShouldBeNil)
+			signature := header.Get(constants.WebhookSignatureHeader)
+			So(webhook.Verify(secret, ts, body, signature), ShouldBeTrue)
+			So(webhook.Verify("other-secret", ts, body, signature), ShouldBeFalse)
+			So(webhook.Verify(secret, ts+1, body, signature), ShouldBeFalse)
+		})
+
+		Convey("non-2xx response", func() {
+			status = http.StatusInternalServerError
+			code, err := s.Send(context.Background(), req)
+			So(err, ShouldNotBeNil)
+			So(code, ShouldEqual, http.StatusInternalServerError)
+			So(err.Error(), ShouldContainSubstring, "receiver says no")
+		})
+
+		Convey("loopback endpoint", func() {
+			code, err := NewWebhookSender().Send(context.Background(), req)
+			So(err, ShouldNotBeNil)
+			So(code, ShouldEqual, 0)
+			So(body, ShouldBeNil)
+		})
+
+		Convey("unreachable endpoint", func() {
+			server.Close()
+			code, err := s.Send(context.Background(), req)
+			So(err, ShouldNotBeNil)
+			So(code, ShouldEqual, 0)
+		})
+	})
+}
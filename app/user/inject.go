@@ -17,15 +17,26 @@ limitations under the License.
 package user
 
 import (
+	"context"
+	"sync"
+
 	"github.com/west2-online/DomTok/app/user/controllers/rpc"
 	"github.com/west2-online/DomTok/app/user/domain/service"
 	"github.com/west2-online/DomTok/app/user/infrastructure/cache"
+	"github.com/west2-online/DomTok/app/user/infrastructure/mq"
 	"github.com/west2-online/DomTok/app/user/infrastructure/mysql"
+	"github.com/west2-online/DomTok/app/user/infrastructure/notifier"
+	userrpc "github.com/west2-online/DomTok/app/user/infrastructure/rpc"
+	"github.com/west2-online/DomTok/app/user/infrastructure/sender"
 	"github.com/west2-online/DomTok/app/user/usecase"
 	"github.com/west2-online/DomTok/config"
 	"github.com/west2-online/DomTok/kitex_gen/user"
+	"github.com/west2-online/DomTok/pkg/audit"
 	"github.com/west2-online/DomTok/pkg/base/client"
 	"github.com/west2-online/DomTok/pkg/constants"
+	"github.com/west2-online/DomTok/pkg/health"
+	"github.com/west2-online/DomTok/pkg/kafka"
+	"github.com/west2-online/DomTok/pkg/shutdown"
 	"github.com/west2-online/DomTok/pkg/utils"
 )
 
@@ -46,10 +57,68 @@ func InjectUserHandler() user.UserService {
 		panic(err)
 	}
 
+	cartClient, err := client.InitCartRPC()
+	if err != nil {
+		panic(err)
+	}
+	orderClient, err := client.InitOrderRPC()
+	if err != nil {
+		panic(err)
+	}
+	paymentClient, err := client.InitPaymentRPC()
+	if err != nil {
+		panic(err)
+	}
+	commodityClient, err := client.InitCommodityRPC()
+	if err != nil {
+		panic(err)
+	}
+
 	db := mysql.NewUserDB(gormDB)
 	redisCache := cache.NewUserCache(re)
-	svc := service.NewUserService(db, sf, redisCache)
-	uc := usecase.NewUserCase(db, svc, redisCache)
+	kafkaIns := kafka.NewKafkaInstance()
+	userMQ := mq.NewUserMQ(kafkaIns)
+	svc := service.NewUserService(db, sf, redisCache, notifier.NewNotifier(),
+		audit.PublisherFunc(userMQ.SendAuditEvent), sender.NewWebhookSender())
+	rpcImpl := userrpc.NewUserRpcImpl(*cartClient, *orderClient, *paymentClient, *commodityClient)
+	uc := usecase.NewUserCase(db, svc, redisCache, rpcImpl)
+
+	// redis 中的封禁标记可能因为重启或清库丢失, 启动时以 mysql 为准重建
+	if err = svc.RebuildBanCache(context.Background()); err != nil {
+		panic(err)
+	}
+	// 后台任务使用可以取消的 ctx, 退出时先取消并等待它们结束, 再关闭它们依赖的连接池
+	workerCtx, cancelWorkers := context.WithCancel(context.Background())
+	var workers sync.WaitGroup
+	runWorker := func(fn func(ctx context.Context)) {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			fn(workerCtx)
+		}()
+	}
+	// 用户服务负责持久化所有服务发布的审计事件
+	auditEvents := userMQ.ConsumeAuditEvents(workerCtx)
+	runWorker(func(ctx context.Context) { svc.SaveAuditEvents(ctx, auditEvents) })
+	// 订单和支付服务发布的 webhook 事件由用户服务按店铺的订阅投递
+	webhookEvents := userMQ.ConsumeWebhookEvents(workerCtx)
+	runWorker(func(ctx context.Context) { svc.DispatchWebhookEvents(ctx, webhookEvents) })
+	runWorker(svc.RunWebhookDeliveries)
+
+	// 注册健康检查项, 由 health.Serve 对外暴露
+	health.Register(constants.HealthCheckMySQL, health.MySQL(gormDB))
+	health.Register(constants.HealthCheckRedis, health.Redis(re))
+	health.Register(constants.HealthCheckKafka, client.PingKafka)
+
+	// 退出时逆序执行: 先停止后台任务, 再停止消费并刷新 kafka writer, 最后关闭连接池
+	shutdown.Register("mysql", func() error { return client.CloseMySQL(gormDB) })
+	shutdown.Register("redis", re.Close)
+	shutdown.Register("kafka", func() error { kafkaIns.Close(); return nil })
+	shutdown.Register("workers", func() error {
+		cancelWorkers()
+		workers.Wait()
+		return nil
+	})
 
 	return rpc.NewUserHandler(uc)
 }
@@ -0,0 +1,99 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	metadata "github.com/west2-online/DomTok/pkg/base/context"
+	"github.com/west2-online/DomTok/pkg/errno"
+	"github.com/west2-online/DomTok/pkg/utils"
+)
+
+// ExportUserData 导出当前登录用户在各个服务中持有的全部数据, 返回 JSON
+func (uc *useCase) ExportUserData(ctx context.Context) (string, error) {
+	uid, err := metadata.GetLoginData(ctx)
+	if err != nil {
+		return "", fmt.Errorf("get login data failed: %w", err)
+	}
+	u, err := uc.db.GetUserById(ctx, uid)
+	if err != nil {
+		return "", fmt.Errorf("get user info failed: %w", err)
+	}
+	export, err := uc.svc.BuildUserDataExport(ctx, u)
+	if err != nil {
+		return "", err
+	}
+
+	cart, err := uc.rpc.ExportCartData(ctx, uid)
+	if err != nil {
+		return "", fmt.Errorf("export cart data failed: %w", err)
+	}
+	orders, err := uc.rpc.ExportOrderData(ctx, uid)
+	if err != nil {
+		return "", fmt.Errorf("export order data failed: %w", err)
+	}
+	payments, err := uc.rpc.ExportPaymentData(ctx, uid)
+	if err != nil {
+		return "", fmt.Errorf("export payment data failed: %w", err)
+	}
+	coupons, err := uc.rpc.ExportCouponData(ctx, uid)
+	if err != nil {
+		return "", fmt.Errorf("export coupon data failed: %w", err)
+	}
+	export.Cart = json.RawMessage(cart)
+	export.Orders = json.RawMessage(orders)
+	export.Payments = json.RawMessage(payments)
+	export.Coupons = json.RawMessage(coupons)
+
+	data, err := utils.JSONEncode(export)
+	if err != nil {
+		return "", errno.Errorf(errno.InternalServiceErrorCode, "encode user data export failed: %v", err)
+	}
+	return data, nil
+}
+
+// CloseAccount 注销当前登录用户的账号, 需要重新确认密码
+// 先清理其他服务中的数据, 最后才匿名化 user 服务自身的数据. 任何一步失败都直接返回, 此时账号仍然可以登录,
+// 用户可以再次发起注销, 各服务的清理操作都是幂等的. 订单服务会在存在未完成订单时拒绝, 所以放在最前面
+// 支付记录属于需要依法留存的交易凭证, 不做清理
+func (uc *useCase) CloseAccount(ctx context.Context, password string) error {
+	uid, err := metadata.GetLoginData(ctx)
+	if err != nil {
+		return fmt.Errorf("get login data failed: %w", err)
+	}
+	u, err := uc.db.GetUserById(ctx, uid)
+	if err != nil {
+		return fmt.Errorf("get user info failed: %w", err)
+	}
+	if err = uc.svc.CheckPassword(u.Password, password); err != nil {
+		return err
+	}
+
+	if err = uc.rpc.EraseOrderData(ctx, uid); err != nil {
+		return fmt.Errorf("erase order data failed: %w", err)
+	}
+	if err = uc.rpc.EraseCartData(ctx, uid); err != nil {
+		return fmt.Errorf("erase cart data failed: %w", err)
+	}
+	if err = uc.rpc.EraseCouponData(ctx, uid); err != nil {
+		return fmt.Errorf("erase coupon data failed: %w", err)
+	}
+	return uc.svc.CloseAccount(ctx, uid)
+}
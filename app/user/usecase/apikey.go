@@ -0,0 +1,59 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package usecase
+
+import (
+	"context"
+
+	"github.com/west2-online/DomTok/app/user/domain/model"
+	metadata "github.com/west2-online/DomTok/pkg/base/context"
+)
+
+// CreateApiKey 为当前登录的商家创建 API Key, 是否拥有管理商品的权限已经由 middleware.Permission 校验过了
+// 创建成功后 key 会被补全 id 和前缀等信息, 返回值是只会出现这一次的明文 key
+func (uc *useCase) CreateApiKey(ctx context.Context, key *model.ApiKey) (string, error) {
+	uid, err := metadata.GetLoginData(ctx)
+	if err != nil {
+		return "", err
+	}
+	if err = uc.svc.Verify(uc.svc.VerifyApiKey(key)); err != nil {
+		return "", err
+	}
+	key.Uid = uid
+	return uc.svc.CreateApiKey(ctx, key)
+}
+
+func (uc *useCase) ListApiKeys(ctx context.Context) ([]*model.ApiKey, error) {
+	uid, err := metadata.GetLoginData(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return uc.db.ListApiKeys(ctx, uid)
+}
+
+func (uc *useCase) RevokeApiKey(ctx context.Context, keyID int64) error {
+	uid, err := metadata.GetLoginData(ctx)
+	if err != nil {
+		return err
+	}
+	return uc.svc.RevokeApiKey(ctx, uid, keyID)
+}
+
+// VerifyApiKey 由网关在收到携带 API Key 的请求时调用, 此时请求还没有任何登录信息
+func (uc *useCase) VerifyApiKey(ctx context.Context, key string) (*model.ApiKey, *model.User, error) {
+	return uc.svc.CheckApiKey(ctx, key)
+}
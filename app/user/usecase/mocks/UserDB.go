@@ -31,8 +31,40 @@ type UserDB struct {
 	mock.Mock
 }
 
+// UpdateUser provides a mock function with given fields: ctx, user
 func (_m *UserDB) UpdateUser(ctx context.Context, user *model.User) error {
-	return nil
+	ret := _m.Called(ctx, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.User) error); ok {
+		r0 = rf(ctx, user)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateUserRole provides a mock function with given fields: ctx, uid, role
+func (_m *UserDB) UpdateUserRole(ctx context.Context, uid int64, role int) error {
+	ret := _m.Called(ctx, uid, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateUserRole")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int) error); ok {
+		r0 = rf(ctx, uid, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
 }
 
 // CreateAddress provides a mock function with given fields: ctx, address
@@ -179,8 +211,1302 @@ func (_m *UserDB) IsUserExist(ctx context.Context, username string) (bool, error
 	return r0, r1
 }
 
+// GetUserById provides a mock function with given fields: ctx, id
 func (_m *UserDB) GetUserById(ctx context.Context, id int64) (*model.User, error) {
-	return nil, nil
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserById")
+	}
+
+	var r0 *model.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*model.User, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *model.User); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAddresses provides a mock function with given fields: ctx, uid
+func (_m *UserDB) ListAddresses(ctx context.Context, uid int64) ([]*model.Address, error) {
+	ret := _m.Called(ctx, uid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAddresses")
+	}
+
+	var r0 []*model.Address
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]*model.Address, error)); ok {
+		return rf(ctx, uid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []*model.Address); ok {
+		r0 = rf(ctx, uid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Address)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, uid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateAddress provides a mock function with given fields: ctx, address
+func (_m *UserDB) UpdateAddress(ctx context.Context, address *model.Address) error {
+	ret := _m.Called(ctx, address)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAddress")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Address) error); ok {
+		r0 = rf(ctx, address)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteAddress provides a mock function with given fields: ctx, addressID
+func (_m *UserDB) DeleteAddress(ctx context.Context, addressID int64) error {
+	ret := _m.Called(ctx, addressID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAddress")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, addressID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetDefaultAddress provides a mock function with given fields: ctx, uid, addressID
+func (_m *UserDB) SetDefaultAddress(ctx context.Context, uid int64, addressID int64) error {
+	ret := _m.Called(ctx, uid, addressID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetDefaultAddress")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(ctx, uid, addressID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AddShopStaff provides a mock function with given fields: ctx, staff
+func (_m *UserDB) AddShopStaff(ctx context.Context, staff *model.ShopStaff) error {
+	ret := _m.Called(ctx, staff)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddShopStaff")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ShopStaff) error); ok {
+		r0 = rf(ctx, staff)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateShop provides a mock function with given fields: ctx, shop
+func (_m *UserDB) CreateShop(ctx context.Context, shop *model.Shop) (int64, error) {
+	ret := _m.Called(ctx, shop)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateShop")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Shop) (int64, error)); ok {
+		return rf(ctx, shop)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Shop) int64); ok {
+		r0 = rf(ctx, shop)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.Shop) error); ok {
+		r1 = rf(ctx, shop)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetShopByID provides a mock function with given fields: ctx, shopID
+func (_m *UserDB) GetShopByID(ctx context.Context, shopID int64) (*model.Shop, error) {
+	ret := _m.Called(ctx, shopID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetShopByID")
+	}
+
+	var r0 *model.Shop
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*model.Shop, error)); ok {
+		return rf(ctx, shopID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *model.Shop); ok {
+		r0 = rf(ctx, shopID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Shop)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, shopID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetShopsByIDs provides a mock function with given fields: ctx, shopIDs
+func (_m *UserDB) GetShopsByIDs(ctx context.Context, shopIDs []int64) ([]*model.Shop, error) {
+	ret := _m.Called(ctx, shopIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetShopsByIDs")
+	}
+
+	var r0 []*model.Shop
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) ([]*model.Shop, error)); ok {
+		return rf(ctx, shopIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) []*model.Shop); ok {
+		r0 = rf(ctx, shopIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Shop)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int64) error); ok {
+		r1 = rf(ctx, shopIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IsShopStaff provides a mock function with given fields: ctx, shopID, uid
+func (_m *UserDB) IsShopStaff(ctx context.Context, shopID int64, uid int64) (bool, error) {
+	ret := _m.Called(ctx, shopID, uid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsShopStaff")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) (bool, error)); ok {
+		return rf(ctx, shopID, uid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) bool); ok {
+		r0 = rf(ctx, shopID, uid)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, shopID, uid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListShopStaff provides a mock function with given fields: ctx, shopID
+func (_m *UserDB) ListShopStaff(ctx context.Context, shopID int64) ([]*model.ShopStaff, error) {
+	ret := _m.Called(ctx, shopID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListShopStaff")
+	}
+
+	var r0 []*model.ShopStaff
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]*model.ShopStaff, error)); ok {
+		return rf(ctx, shopID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []*model.ShopStaff); ok {
+		r0 = rf(ctx, shopID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.ShopStaff)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, shopID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveShopStaff provides a mock function with given fields: ctx, shopID, uid
+func (_m *UserDB) RemoveShopStaff(ctx context.Context, shopID int64, uid int64) error {
+	ret := _m.Called(ctx, shopID, uid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveShopStaff")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(ctx, shopID, uid)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateShop provides a mock function with given fields: ctx, shop
+func (_m *UserDB) UpdateShop(ctx context.Context, shop *model.Shop) error {
+	ret := _m.Called(ctx, shop)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateShop")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Shop) error); ok {
+		r0 = rf(ctx, shop)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateShopStatus provides a mock function with given fields: ctx, shopID, status
+func (_m *UserDB) UpdateShopStatus(ctx context.Context, shopID int64, status int) error {
+	ret := _m.Called(ctx, shopID, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateShopStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int) error); ok {
+		r0 = rf(ctx, shopID, status)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateBan provides a mock function with given fields: ctx, ban
+func (_m *UserDB) CreateBan(ctx context.Context, ban *model.Ban) (int64, error) {
+	ret := _m.Called(ctx, ban)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateBan")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Ban) (int64, error)); ok {
+		return rf(ctx, ban)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Ban) int64); ok {
+		r0 = rf(ctx, ban)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.Ban) error); ok {
+		r1 = rf(ctx, ban)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetActiveBan provides a mock function with given fields: ctx, uid
+func (_m *UserDB) GetActiveBan(ctx context.Context, uid int64) (*model.Ban, error) {
+	ret := _m.Called(ctx, uid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActiveBan")
+	}
+
+	var r0 *model.Ban
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*model.Ban, error)); ok {
+		return rf(ctx, uid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *model.Ban); ok {
+		r0 = rf(ctx, uid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Ban)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, uid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LiftBan provides a mock function with given fields: ctx, banID, operatorID
+func (_m *UserDB) LiftBan(ctx context.Context, banID int64, operatorID int64) error {
+	ret := _m.Called(ctx, banID, operatorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LiftBan")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(ctx, banID, operatorID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListActiveBans provides a mock function with given fields: ctx
+func (_m *UserDB) ListActiveBans(ctx context.Context) ([]*model.Ban, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListActiveBans")
+	}
+
+	var r0 []*model.Ban
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*model.Ban, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.Ban); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Ban)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListBans provides a mock function with given fields: ctx, uid
+func (_m *UserDB) ListBans(ctx context.Context, uid int64) ([]*model.Ban, error) {
+	ret := _m.Called(ctx, uid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBans")
+	}
+
+	var r0 []*model.Ban
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]*model.Ban, error)); ok {
+		return rf(ctx, uid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []*model.Ban); ok {
+		r0 = rf(ctx, uid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Ban)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, uid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IsEmailExist provides a mock function with given fields: ctx, email
+func (_m *UserDB) IsEmailExist(ctx context.Context, email string) (bool, error) {
+	ret := _m.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsEmailExist")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return rf(ctx, email)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = rf(ctx, email)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IsPhoneExist provides a mock function with given fields: ctx, phone
+func (_m *UserDB) IsPhoneExist(ctx context.Context, phone string) (bool, error) {
+	ret := _m.Called(ctx, phone)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsPhoneExist")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return rf(ctx, phone)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = rf(ctx, phone)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, phone)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AnonymizeUser provides a mock function with given fields: ctx, uid
+func (_m *UserDB) AnonymizeUser(ctx context.Context, uid int64) error {
+	ret := _m.Called(ctx, uid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AnonymizeUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, uid)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListUserShops provides a mock function with given fields: ctx, uid
+func (_m *UserDB) ListUserShops(ctx context.Context, uid int64) ([]*model.ShopStaff, error) {
+	ret := _m.Called(ctx, uid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUserShops")
+	}
+
+	var r0 []*model.ShopStaff
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]*model.ShopStaff, error)); ok {
+		return rf(ctx, uid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []*model.ShopStaff); ok {
+		r0 = rf(ctx, uid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.ShopStaff)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, uid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteTOTP provides a mock function with given fields: ctx, uid
+func (_m *UserDB) DeleteTOTP(ctx context.Context, uid int64) error {
+	ret := _m.Called(ctx, uid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTOTP")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, uid)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EnableTOTP provides a mock function with given fields: ctx, uid
+func (_m *UserDB) EnableTOTP(ctx context.Context, uid int64) error {
+	ret := _m.Called(ctx, uid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnableTOTP")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, uid)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetTOTP provides a mock function with given fields: ctx, uid
+func (_m *UserDB) GetTOTP(ctx context.Context, uid int64) (*model.TOTP, error) {
+	ret := _m.Called(ctx, uid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTOTP")
+	}
+
+	var r0 *model.TOTP
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*model.TOTP, error)); ok {
+		return rf(ctx, uid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *model.TOTP); ok {
+		r0 = rf(ctx, uid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.TOTP)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, uid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SaveTOTP provides a mock function with given fields: ctx, totp, recoveryCodes
+func (_m *UserDB) SaveTOTP(ctx context.Context, totp *model.TOTP, recoveryCodes []string) error {
+	ret := _m.Called(ctx, totp, recoveryCodes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveTOTP")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.TOTP, []string) error); ok {
+		r0 = rf(ctx, totp, recoveryCodes)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UseRecoveryCode provides a mock function with given fields: ctx, uid, digest
+func (_m *UserDB) UseRecoveryCode(ctx context.Context, uid int64, digest string) (bool, error) {
+	ret := _m.Called(ctx, uid, digest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UseRecoveryCode")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) (bool, error)); ok {
+		return rf(ctx, uid, digest)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) bool); ok {
+		r0 = rf(ctx, uid, digest)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string) error); ok {
+		r1 = rf(ctx, uid, digest)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UseTOTPStep provides a mock function with given fields: ctx, uid, step
+func (_m *UserDB) UseTOTPStep(ctx context.Context, uid int64, step int64) (bool, error) {
+	ret := _m.Called(ctx, uid, step)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UseTOTPStep")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) (bool, error)); ok {
+		return rf(ctx, uid, step)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) bool); ok {
+		r0 = rf(ctx, uid, step)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, uid, step)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountApiKeys provides a mock function with given fields: ctx, uid
+func (_m *UserDB) CountApiKeys(ctx context.Context, uid int64) (int64, error) {
+	ret := _m.Called(ctx, uid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountApiKeys")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (int64, error)); ok {
+		return rf(ctx, uid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) int64); ok {
+		r0 = rf(ctx, uid)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, uid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateApiKey provides a mock function with given fields: ctx, key
+func (_m *UserDB) CreateApiKey(ctx context.Context, key *model.ApiKey) (int64, error) {
+	ret := _m.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateApiKey")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ApiKey) (int64, error)); ok {
+		return rf(ctx, key)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ApiKey) int64); ok {
+		r0 = rf(ctx, key)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.ApiKey) error); ok {
+		r1 = rf(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteApiKey provides a mock function with given fields: ctx, uid, keyID
+func (_m *UserDB) DeleteApiKey(ctx context.Context, uid int64, keyID int64) (bool, error) {
+	ret := _m.Called(ctx, uid, keyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteApiKey")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) (bool, error)); ok {
+		return rf(ctx, uid, keyID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) bool); ok {
+		r0 = rf(ctx, uid, keyID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, uid, keyID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetApiKeyByDigest provides a mock function with given fields: ctx, digest
+func (_m *UserDB) GetApiKeyByDigest(ctx context.Context, digest string) (*model.ApiKey, error) {
+	ret := _m.Called(ctx, digest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetApiKeyByDigest")
+	}
+
+	var r0 *model.ApiKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.ApiKey, error)); ok {
+		return rf(ctx, digest)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.ApiKey); ok {
+		r0 = rf(ctx, digest)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ApiKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, digest)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListApiKeys provides a mock function with given fields: ctx, uid
+func (_m *UserDB) ListApiKeys(ctx context.Context, uid int64) ([]*model.ApiKey, error) {
+	ret := _m.Called(ctx, uid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListApiKeys")
+	}
+
+	var r0 []*model.ApiKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]*model.ApiKey, error)); ok {
+		return rf(ctx, uid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []*model.ApiKey); ok {
+		r0 = rf(ctx, uid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.ApiKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, uid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateAuditLog provides a mock function with given fields: ctx, log
+func (_m *UserDB) CreateAuditLog(ctx context.Context, log *model.AuditLog) error {
+	ret := _m.Called(ctx, log)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAuditLog")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.AuditLog) error); ok {
+		r0 = rf(ctx, log)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListAuditLogs provides a mock function with given fields: ctx, filter
+func (_m *UserDB) ListAuditLogs(ctx context.Context, filter *model.AuditLogFilter) ([]*model.AuditLog, int64, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAuditLogs")
+	}
+
+	var r0 []*model.AuditLog
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.AuditLogFilter) ([]*model.AuditLog, int64, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.AuditLogFilter) []*model.AuditLog); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.AuditLog)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.AuditLogFilter) int64); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *model.AuditLogFilter) error); ok {
+		r2 = rf(ctx, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// CreateWebhookEndpoint provides a mock function with given fields: ctx, endpoint
+func (_m *UserDB) CreateWebhookEndpoint(ctx context.Context, endpoint *model.WebhookEndpoint) (int64, error) {
+	ret := _m.Called(ctx, endpoint)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWebhookEndpoint")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.WebhookEndpoint) (int64, error)); ok {
+		return rf(ctx, endpoint)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.WebhookEndpoint) int64); ok {
+		r0 = rf(ctx, endpoint)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.WebhookEndpoint) error); ok {
+		r1 = rf(ctx, endpoint)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountWebhookEndpoints provides a mock function with given fields: ctx, shopID
+func (_m *UserDB) CountWebhookEndpoints(ctx context.Context, shopID int64) (int64, error) {
+	ret := _m.Called(ctx, shopID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountWebhookEndpoints")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (int64, error)); ok {
+		return rf(ctx, shopID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) int64); ok {
+		r0 = rf(ctx, shopID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, shopID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWebhookEndpoint provides a mock function with given fields: ctx, endpointID
+func (_m *UserDB) GetWebhookEndpoint(ctx context.Context, endpointID int64) (*model.WebhookEndpoint, error) {
+	ret := _m.Called(ctx, endpointID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWebhookEndpoint")
+	}
+
+	var r0 *model.WebhookEndpoint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*model.WebhookEndpoint, error)); ok {
+		return rf(ctx, endpointID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *model.WebhookEndpoint); ok {
+		r0 = rf(ctx, endpointID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.WebhookEndpoint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, endpointID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListWebhookEndpoints provides a mock function with given fields: ctx, shopID
+func (_m *UserDB) ListWebhookEndpoints(ctx context.Context, shopID int64) ([]*model.WebhookEndpoint, error) {
+	ret := _m.Called(ctx, shopID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWebhookEndpoints")
+	}
+
+	var r0 []*model.WebhookEndpoint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]*model.WebhookEndpoint, error)); ok {
+		return rf(ctx, shopID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []*model.WebhookEndpoint); ok {
+		r0 = rf(ctx, shopID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.WebhookEndpoint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, shopID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateWebhookEndpoint provides a mock function with given fields: ctx, endpoint
+func (_m *UserDB) UpdateWebhookEndpoint(ctx context.Context, endpoint *model.WebhookEndpoint) error {
+	ret := _m.Called(ctx, endpoint)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateWebhookEndpoint")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.WebhookEndpoint) error); ok {
+		r0 = rf(ctx, endpoint)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteWebhookEndpoint provides a mock function with given fields: ctx, endpointID
+func (_m *UserDB) DeleteWebhookEndpoint(ctx context.Context, endpointID int64) error {
+	ret := _m.Called(ctx, endpointID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWebhookEndpoint")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, endpointID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IncrWebhookEndpointFailures provides a mock function with given fields: ctx, endpointID
+func (_m *UserDB) IncrWebhookEndpointFailures(ctx context.Context, endpointID int64) (int64, error) {
+	ret := _m.Called(ctx, endpointID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IncrWebhookEndpointFailures")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (int64, error)); ok {
+		return rf(ctx, endpointID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) int64); ok {
+		r0 = rf(ctx, endpointID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, endpointID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResetWebhookEndpointFailures provides a mock function with given fields: ctx, endpointID
+func (_m *UserDB) ResetWebhookEndpointFailures(ctx context.Context, endpointID int64) error {
+	ret := _m.Called(ctx, endpointID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetWebhookEndpointFailures")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, endpointID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DisableWebhookEndpoint provides a mock function with given fields: ctx, endpointID
+func (_m *UserDB) DisableWebhookEndpoint(ctx context.Context, endpointID int64) error {
+	ret := _m.Called(ctx, endpointID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DisableWebhookEndpoint")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, endpointID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateWebhookDelivery provides a mock function with given fields: ctx, delivery
+func (_m *UserDB) CreateWebhookDelivery(ctx context.Context, delivery *model.WebhookDelivery) (bool, error) {
+	ret := _m.Called(ctx, delivery)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWebhookDelivery")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.WebhookDelivery) (bool, error)); ok {
+		return rf(ctx, delivery)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.WebhookDelivery) bool); ok {
+		r0 = rf(ctx, delivery)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.WebhookDelivery) error); ok {
+		r1 = rf(ctx, delivery)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWebhookDelivery provides a mock function with given fields: ctx, deliveryID
+func (_m *UserDB) GetWebhookDelivery(ctx context.Context, deliveryID int64) (*model.WebhookDelivery, error) {
+	ret := _m.Called(ctx, deliveryID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWebhookDelivery")
+	}
+
+	var r0 *model.WebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*model.WebhookDelivery, error)); ok {
+		return rf(ctx, deliveryID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *model.WebhookDelivery); ok {
+		r0 = rf(ctx, deliveryID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.WebhookDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, deliveryID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListWebhookDeliveries provides a mock function with given fields: ctx, endpointID, pageNum, pageSize
+func (_m *UserDB) ListWebhookDeliveries(ctx context.Context, endpointID int64, pageNum int64, pageSize int64) ([]*model.WebhookDelivery, int64, error) {
+	ret := _m.Called(ctx, endpointID, pageNum, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWebhookDeliveries")
+	}
+
+	var r0 []*model.WebhookDelivery
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64) ([]*model.WebhookDelivery, int64, error)); ok {
+		return rf(ctx, endpointID, pageNum, pageSize)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64) []*model.WebhookDelivery); ok {
+		r0 = rf(ctx, endpointID, pageNum, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.WebhookDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, int64) int64); ok {
+		r1 = rf(ctx, endpointID, pageNum, pageSize)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int64, int64, int64) error); ok {
+		r2 = rf(ctx, endpointID, pageNum, pageSize)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ListDueWebhookDeliveries provides a mock function with given fields: ctx, now, limit
+func (_m *UserDB) ListDueWebhookDeliveries(ctx context.Context, now int64, limit int) ([]*model.WebhookDelivery, error) {
+	ret := _m.Called(ctx, now, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDueWebhookDeliveries")
+	}
+
+	var r0 []*model.WebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int) ([]*model.WebhookDelivery, error)); ok {
+		return rf(ctx, now, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int) []*model.WebhookDelivery); ok {
+		r0 = rf(ctx, now, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.WebhookDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int) error); ok {
+		r1 = rf(ctx, now, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClaimWebhookDelivery provides a mock function with given fields: ctx, delivery, leaseUntil
+func (_m *UserDB) ClaimWebhookDelivery(ctx context.Context, delivery *model.WebhookDelivery, leaseUntil int64) (bool, error) {
+	ret := _m.Called(ctx, delivery, leaseUntil)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimWebhookDelivery")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.WebhookDelivery, int64) (bool, error)); ok {
+		return rf(ctx, delivery, leaseUntil)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.WebhookDelivery, int64) bool); ok {
+		r0 = rf(ctx, delivery, leaseUntil)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.WebhookDelivery, int64) error); ok {
+		r1 = rf(ctx, delivery, leaseUntil)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateWebhookDelivery provides a mock function with given fields: ctx, delivery
+func (_m *UserDB) UpdateWebhookDelivery(ctx context.Context, delivery *model.WebhookDelivery) error {
+	ret := _m.Called(ctx, delivery)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateWebhookDelivery")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.WebhookDelivery) error); ok {
+		r0 = rf(ctx, delivery)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
 }
 
 // NewUserDB creates a new instance of UserDB. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
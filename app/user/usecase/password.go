@@ -0,0 +1,83 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	metadata "github.com/west2-online/DomTok/pkg/base/context"
+	"github.com/west2-online/DomTok/pkg/errno"
+	"github.com/west2-online/DomTok/pkg/logger"
+)
+
+// ChangePassword 修改当前登录用户的密码, 需要先校验旧密码
+func (uc *useCase) ChangePassword(ctx context.Context, oldPassword string, newPassword string) error {
+	if err := uc.svc.Verify(uc.svc.VerifyPassword(newPassword)); err != nil {
+		return err
+	}
+
+	uid, err := metadata.GetLoginData(ctx)
+	if err != nil {
+		return fmt.Errorf("get login data failed: %w", err)
+	}
+	u, err := uc.db.GetUserById(ctx, uid)
+	if err != nil {
+		return fmt.Errorf("get user info failed: %w", err)
+	}
+	if err = uc.svc.CheckPassword(u.Password, oldPassword); err != nil {
+		return err
+	}
+	return uc.svc.UpdatePassword(ctx, uid, newPassword)
+}
+
+// RequestPasswordReset 向用户发送重置密码的验证码
+// 用户不存在时同样返回成功, 避免通过这个接口探测用户名是否已经注册
+func (uc *useCase) RequestPasswordReset(ctx context.Context, name string) error {
+	u, err := uc.db.GetUserInfo(ctx, name)
+	if err != nil {
+		if errno.ConvertErr(err).ErrorCode == errno.ServiceUserNotExist {
+			logger.Infof("usecase.RequestPasswordReset: user %s not exist, skip sending reset code", name)
+			return nil
+		}
+		return fmt.Errorf("get user info failed: %w", err)
+	}
+	return uc.svc.SendPasswordResetCode(ctx, u)
+}
+
+// ResetPassword 使用验证码重置密码, 重置成功后吊销用户所有的会话, 所有设备都需要重新登录
+func (uc *useCase) ResetPassword(ctx context.Context, name string, code string, newPassword string) error {
+	if err := uc.svc.Verify(uc.svc.VerifyPassword(newPassword)); err != nil {
+		return err
+	}
+
+	u, err := uc.db.GetUserInfo(ctx, name)
+	if err != nil {
+		// 用户不存在时和验证码错误返回同样的错误
+		if errno.ConvertErr(err).ErrorCode == errno.ServiceUserNotExist {
+			return errno.NewErrNo(errno.ServiceWrongResetCode, "wrong or expired reset code")
+		}
+		return fmt.Errorf("get user info failed: %w", err)
+	}
+	if err = uc.svc.CheckPasswordResetCode(ctx, u.Uid, code); err != nil {
+		return err
+	}
+	if err = uc.svc.UpdatePassword(ctx, u.Uid, newPassword); err != nil {
+		return err
+	}
+	return uc.svc.RevokeAllSessions(ctx, u.Uid)
+}
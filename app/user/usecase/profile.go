@@ -0,0 +1,72 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/west2-online/DomTok/app/user/domain/model"
+	"github.com/west2-online/DomTok/app/user/domain/service"
+	metadata "github.com/west2-online/DomTok/pkg/base/context"
+)
+
+// UpdateProfile 修改当前用户的用户名, 邮箱或手机号, 返回修改后的用户信息
+// 邮箱和手机号会用于找回密码, 属于敏感信息, 修改时需要重新确认密码
+func (uc *useCase) UpdateProfile(ctx context.Context, profile *model.User, password string) (*model.User, error) {
+	uid, err := metadata.GetLoginData(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get login data failed: %w", err)
+	}
+	u, err := uc.db.GetUserById(ctx, uid)
+	if err != nil {
+		return nil, fmt.Errorf("get user info failed: %w", err)
+	}
+
+	// 只保留真正发生变化的字段, 和原来相同的字段不需要再做唯一性检查
+	changes := &model.User{Uid: uid}
+	var opts []service.UserVerifyOps
+	if profile.UserName != "" && profile.UserName != u.UserName {
+		changes.UserName = profile.UserName
+		opts = append(opts, uc.svc.VerifyUserName(profile.UserName))
+	}
+	if profile.Email != "" && profile.Email != u.Email {
+		changes.Email = profile.Email
+		opts = append(opts, uc.svc.VerifyEmail(profile.Email))
+	}
+	if profile.Phone != "" && profile.Phone != u.Phone {
+		changes.Phone = profile.Phone
+		opts = append(opts, uc.svc.VerifyPhone(profile.Phone))
+	}
+	if len(opts) == 0 {
+		return u, nil
+	}
+	if err = uc.svc.Verify(opts...); err != nil {
+		return nil, err
+	}
+
+	if changes.Email != "" || changes.Phone != "" {
+		if err = uc.svc.CheckPassword(u.Password, password); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = uc.svc.UpdateProfile(ctx, changes); err != nil {
+		return nil, err
+	}
+	return uc.db.GetUserById(ctx, uid)
+}
@@ -19,37 +19,91 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/west2-online/DomTok/app/user/domain/model"
+	metadata "github.com/west2-online/DomTok/pkg/base/context"
 	"github.com/west2-online/DomTok/pkg/errno"
 )
 
-// Login 用户登录
-func (uc *useCase) Login(ctx context.Context, user *model.User) (*model.User, error) {
+// Login 用户登录, 每次登录都会创建一个新的会话
+// 用户不存在, 密码错误和两步验证失败都会计入登录失败次数, 失败次数过多时会暂时锁定用户名或 IP
+// 开启了两步验证的用户还需要提供 totpCode, 通过两步验证签发的 token 才能使用管理类的权限
+func (uc *useCase) Login(ctx context.Context, user *model.User, session *model.Session, totpCode string) (*model.User, *model.Token, error) {
+	if err := uc.svc.CheckLoginLocked(ctx, user.UserName, session.IP); err != nil {
+		return nil, nil, err
+	}
+
 	u, err := uc.db.GetUserInfo(ctx, user.UserName)
 	if err != nil {
-		return nil, fmt.Errorf("get user info failed: %w", err)
+		if errno.ConvertErr(err).ErrorCode == errno.ServiceUserNotExist {
+			if recordErr := uc.svc.RecordLoginFailure(ctx, user.UserName, session.IP); recordErr != nil {
+				return nil, nil, recordErr
+			}
+		}
+		return nil, nil, fmt.Errorf("get user info failed: %w", err)
 	}
 	exist, err := uc.svc.IsBaned(ctx, u.Uid)
 	if err != nil {
-		return nil, fmt.Errorf("check ban failed: %w", err)
+		return nil, nil, fmt.Errorf("check ban failed: %w", err)
 	}
 	if exist {
-		return nil, errno.NewErrNo(errno.AuthNoOperatePermissionCode, "user was baned")
+		return nil, nil, errno.NewErrNo(errno.AuthNoOperatePermissionCode, "user was baned")
 	}
 	if err = uc.svc.CheckPassword(u.Password, user.Password); err != nil {
-		return nil, err
+		if recordErr := uc.svc.RecordLoginFailure(ctx, user.UserName, session.IP); recordErr != nil {
+			return nil, nil, recordErr
+		}
+		return nil, nil, err
+	}
+	mfa, err := uc.svc.IsTOTPEnabled(ctx, u.Uid)
+	if err != nil {
+		return nil, nil, err
+	}
+	if mfa {
+		if totpCode == "" {
+			return nil, nil, errno.NewErrNo(errno.ServiceTOTPRequired, "two-factor code required")
+		}
+		if err = uc.svc.CheckSecondFactor(ctx, u.Uid, totpCode); err != nil {
+			if recordErr := uc.svc.RecordLoginFailure(ctx, user.UserName, session.IP); recordErr != nil {
+				return nil, nil, recordErr
+			}
+			return nil, nil, err
+		}
+	}
+	if err = uc.svc.ClearLoginFailures(ctx, u.UserName); err != nil {
+		return nil, nil, err
 	}
 
-	if err = uc.svc.UserLogin(ctx, u.Uid); err != nil {
+	session.Uid = u.Uid
+	token, err := uc.svc.IssueToken(ctx, session, u.Role, mfa)
+	if err != nil {
+		return nil, nil, err
+	}
+	return u, token, nil
+}
+
+// RefreshToken 使用 refresh token 换取新的一对 token
+func (uc *useCase) RefreshToken(ctx context.Context, refreshToken string) (*model.Token, error) {
+	uid, token, err := uc.svc.RefreshToken(ctx, refreshToken)
+	if err != nil {
 		return nil, err
 	}
-	return u, nil
+
+	// 被封禁的用户不能再续期
+	baned, err := uc.svc.IsBaned(ctx, uid)
+	if err != nil {
+		return nil, fmt.Errorf("check ban failed: %w", err)
+	}
+	if baned {
+		return nil, errno.NewErrNo(errno.AuthNoOperatePermissionCode, "user was baned")
+	}
+	return token, nil
 }
 
 func (uc *useCase) RegisterUser(ctx context.Context, u *model.User) (uid int64, err error) {
 	// 这里进行了简单的密码和邮箱格式的校验, 如果后续还需要对别的参数进行校验可以再加
-	if err = uc.svc.Verify(uc.svc.VerifyEmail(u.Email), uc.svc.VerifyPassword(u.Password)); err != nil {
+	if err = uc.svc.Verify(uc.svc.VerifyUserName(u.UserName), uc.svc.VerifyEmail(u.Email), uc.svc.VerifyPassword(u.Password)); err != nil {
 		return
 	}
 	// 判断是否已经注册过
@@ -63,6 +117,14 @@ func (uc *useCase) RegisterUser(ctx context.Context, u *model.User) (uid int64,
 	if exist {
 		return 0, errno.NewErrNo(errno.ServiceUserExist, "user already exist")
 	}
+	// 邮箱有唯一索引, 同时也会用于找回密码, 不能和其他用户重复
+	exist, err = uc.db.IsEmailExist(ctx, u.Email)
+	if err != nil {
+		return 0, fmt.Errorf("check email exist failed: %w", err)
+	}
+	if exist {
+		return 0, errno.NewErrNo(errno.ServiceEmailExist, "email already in use")
+	}
 
 	if u.Password, err = uc.svc.EncryptPassword(u.Password); err != nil {
 		return 0, err
@@ -78,29 +140,157 @@ func (uc *useCase) RegisterUser(ctx context.Context, u *model.User) (uid int64,
 }
 
 func (uc *useCase) GetAddress(ctx context.Context, addressID int64) (*model.Address, error) {
-	return uc.svc.GetAddress(ctx, addressID)
+	return uc.getOwnedAddress(ctx, addressID)
 }
 
 func (uc *useCase) AddAddress(ctx context.Context, address *model.Address) (addressID int64, err error) {
+	if err = uc.svc.Verify(uc.svc.VerifyAddress(address)); err != nil {
+		return 0, err
+	}
+
+	if address.UserID, err = metadata.GetLoginData(ctx); err != nil {
+		return 0, fmt.Errorf("get login data failed: %w", err)
+	}
 	return uc.svc.AddAddress(ctx, address)
 }
 
-func (uc *useCase) BanUser(ctx context.Context, uid int64) error {
-	return uc.svc.UserBaned(ctx, uid)
+func (uc *useCase) ListAddress(ctx context.Context) ([]*model.Address, error) {
+	uid, err := metadata.GetLoginData(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get login data failed: %w", err)
+	}
+	return uc.svc.ListAddresses(ctx, uid)
+}
+
+func (uc *useCase) UpdateAddress(ctx context.Context, address *model.Address) error {
+	if address.Phone != "" {
+		if err := uc.svc.Verify(uc.svc.VerifyPhone(address.Phone)); err != nil {
+			return err
+		}
+	}
+
+	if _, err := uc.getOwnedAddress(ctx, address.AddressID); err != nil {
+		return err
+	}
+	return uc.svc.UpdateAddress(ctx, address)
+}
+
+func (uc *useCase) DeleteAddress(ctx context.Context, addressID int64) error {
+	address, err := uc.getOwnedAddress(ctx, addressID)
+	if err != nil {
+		return err
+	}
+	return uc.svc.DeleteAddress(ctx, address)
+}
+
+func (uc *useCase) SetDefaultAddress(ctx context.Context, addressID int64) error {
+	address, err := uc.getOwnedAddress(ctx, addressID)
+	if err != nil {
+		return err
+	}
+	if address.IsDefault {
+		return nil
+	}
+	return uc.svc.SetDefaultAddress(ctx, address.UserID, addressID)
+}
+
+// getOwnedAddress 获取地址并校验该地址是否属于当前登录的用户
+// 订单服务等其他服务也会通过 GetAddress 获取地址, 所以这里的校验可以防止越权读取他人的地址
+func (uc *useCase) getOwnedAddress(ctx context.Context, addressID int64) (*model.Address, error) {
+	uid, err := metadata.GetLoginData(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get login data failed: %w", err)
+	}
+
+	address, err := uc.svc.GetAddress(ctx, addressID)
+	if err != nil {
+		return nil, err
+	}
+	if address.UserID != uid {
+		return nil, errno.NewErrNo(errno.AuthNoOperatePermissionCode, "address does not belong to current user")
+	}
+	return address, nil
+}
+
+// BanUser 封禁用户, duration 单位为秒, 为 0 时表示永久封禁
+func (uc *useCase) BanUser(ctx context.Context, uid int64, reason string, duration int64) error {
+	if err := uc.svc.Verify(uc.svc.VerifyBan(reason, duration)); err != nil {
+		return err
+	}
+	return uc.svc.UserBaned(ctx, uid, reason, time.Duration(duration)*time.Second)
+}
+
+func (uc *useCase) ListBanHistory(ctx context.Context, uid int64) ([]*model.Ban, error) {
+	return uc.svc.ListBanHistory(ctx, uid)
 }
 
 func (us *useCase) LiftUser(ctx context.Context, uid int64) error {
 	return us.svc.LiftUserBaned(ctx, uid)
 }
 
+// UnlockUser 管理员手动解除用户因登录失败次数过多导致的锁定
+func (uc *useCase) UnlockUser(ctx context.Context, uid int64) error {
+	u, err := uc.db.GetUserById(ctx, uid)
+	if err != nil {
+		return fmt.Errorf("get user info failed: %w", err)
+	}
+	return uc.svc.ClearLoginFailures(ctx, u.UserName)
+}
+
 func (us *useCase) LogoutUser(ctx context.Context) error {
 	return us.svc.Logout(ctx)
 }
 
+// ListSessions 列出当前用户所有的登录会话, 并标记出发起本次请求的会话
+func (uc *useCase) ListSessions(ctx context.Context) ([]*model.Session, error) {
+	uid, err := metadata.GetLoginData(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sessions, err := uc.svc.ListSessions(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	current, _ := metadata.GetSessionData(ctx)
+	for _, session := range sessions {
+		session.Current = session.SessionID == current
+	}
+	return sessions, nil
+}
+
+func (uc *useCase) RevokeSession(ctx context.Context, sessionID string) error {
+	uid, err := metadata.GetLoginData(ctx)
+	if err != nil {
+		return err
+	}
+	return uc.svc.RevokeSession(ctx, uid, sessionID)
+}
+
+func (uc *useCase) RevokeAllSessions(ctx context.Context) error {
+	uid, err := metadata.GetLoginData(ctx)
+	if err != nil {
+		return err
+	}
+	return uc.svc.RevokeAllSessions(ctx, uid)
+}
+
+// SetAdministrator 设置用户的角色, 除了管理员密钥之外, 调用者还必须通过两步验证登录
 func (us *useCase) SetAdministrator(ctx context.Context, uid int64, password []byte, action int) error {
+	if !metadata.GetMFAData(ctx) {
+		return errno.AuthMFARequired
+	}
 	return us.svc.SetAdministrator(ctx, uid, password, action)
 }
 
 func (us *useCase) GetUserInfo(ctx context.Context, uid int64) (*model.User, error) {
 	return us.db.GetUserById(ctx, uid)
 }
+
+// ListAuditLogs 查询审计日志, 只有超级管理员可以调用, 已经由 middleware.Permission 校验过了
+func (uc *useCase) ListAuditLogs(ctx context.Context, filter *model.AuditLogFilter) ([]*model.AuditLog, int64, error) {
+	if filter.StartTime != 0 && filter.EndTime != 0 && filter.StartTime >= filter.EndTime {
+		return nil, 0, errno.NewErrNo(errno.ParamVerifyErrorCode, "start time should be earlier than end time")
+	}
+	return uc.svc.ListAuditLogs(ctx, filter)
+}
@@ -18,20 +18,39 @@ package usecase_test
 
 import (
 	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/bytedance/mockey"
 	"github.com/redis/go-redis/v9"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"golang.org/x/crypto/bcrypt"
 
 	"github.com/west2-online/DomTok/app/user/domain/model"
+	"github.com/west2-online/DomTok/app/user/domain/repository"
 	"github.com/west2-online/DomTok/app/user/domain/service"
 	"github.com/west2-online/DomTok/app/user/infrastructure/cache"
+	"github.com/west2-online/DomTok/app/user/infrastructure/notifier"
+	"github.com/west2-online/DomTok/app/user/infrastructure/sender"
 	"github.com/west2-online/DomTok/app/user/usecase"
 	"github.com/west2-online/DomTok/app/user/usecase/mocks"
 	"github.com/west2-online/DomTok/config"
+	"github.com/west2-online/DomTok/pkg/audit"
+	metadata "github.com/west2-online/DomTok/pkg/base/context"
 	"github.com/west2-online/DomTok/pkg/constants"
+	"github.com/west2-online/DomTok/pkg/errno"
 	"github.com/west2-online/DomTok/pkg/utils"
+	"github.com/west2-online/DomTok/pkg/webhook"
 )
 
 func TestUseCase_RegisterUser(t *testing.T) {
@@ -39,8 +58,8 @@ func TestUseCase_RegisterUser(t *testing.T) {
 	mockCache := new(redis.Client)
 	c := cache.NewUserCache(mockCache)
 	mockSf, _ := utils.NewSnowflake(config.GetDataCenterID(), constants.WorkerOfUserService)
-	mockService := service.NewUserService(mockDB, mockSf, c)
-	uc := usecase.NewUserCase(mockDB, mockService, c)
+	mockService := service.NewUserService(mockDB, mockSf, c, notifier.NewLogNotifier(), audit.NewLogPublisher(), sender.NewWebhookSender())
+	uc := usecase.NewUserCase(mockDB, mockService, c, nil)
 
 	user := &model.User{
 		UserName: "testuser",
@@ -49,6 +68,7 @@ func TestUseCase_RegisterUser(t *testing.T) {
 	}
 
 	mockDB.On("IsUserExist", mock.Anything, user.UserName).Return(false, nil)
+	mockDB.On("IsEmailExist", mock.Anything, user.Email).Return(false, nil)
 	mockDB.On("CreateUser", mock.Anything, mock.Anything).Return(int64(1), nil)
 
 	uid, err := uc.RegisterUser(context.Background(), user)
@@ -57,3 +77,663 @@ func TestUseCase_RegisterUser(t *testing.T) {
 
 	mockDB.AssertExpectations(t)
 }
+
+func TestUseCase_GetAddress(t *testing.T) {
+	mockDB := new(mocks.UserDB)
+	c := cache.NewUserCache(new(redis.Client))
+	mockSf, _ := utils.NewSnowflake(config.GetDataCenterID(), constants.WorkerOfUserService)
+	svc := service.NewUserService(mockDB, mockSf, c, notifier.NewLogNotifier(), audit.NewLogPublisher(), sender.NewWebhookSender())
+	uc := usecase.NewUserCase(mockDB, svc, c, nil)
+
+	address := &model.Address{
+		AddressID: 1,
+		UserID:    100,
+		Province:  "福建省",
+		City:      "福州市",
+		Detail:    "福州大学",
+	}
+	mockDB.On("GetAddressInfo", mock.Anything, address.AddressID).Return(address, nil)
+
+	// 地址的所有者可以正常获取
+	ctx := metadata.WithLoginData(context.Background(), address.UserID)
+	got, err := uc.GetAddress(ctx, address.AddressID)
+	assert.NoError(t, err)
+	assert.Equal(t, address, got)
+
+	// 其他用户不能读取不属于自己的地址
+	ctx = metadata.WithLoginData(context.Background(), 200)
+	_, err = uc.GetAddress(ctx, address.AddressID)
+	assert.Error(t, err)
+	assert.Equal(t, int64(errno.AuthNoOperatePermissionCode), errno.ConvertErr(err).ErrorCode)
+}
+
+func TestUseCase_CloseShop(t *testing.T) {
+	mockDB := new(mocks.UserDB)
+	c := cache.NewUserCache(new(redis.Client))
+	mockSf, _ := utils.NewSnowflake(config.GetDataCenterID(), constants.WorkerOfUserService)
+	svc := service.NewUserService(mockDB, mockSf, c, notifier.NewLogNotifier(), audit.NewLogPublisher(), sender.NewWebhookSender())
+	uc := usecase.NewUserCase(mockDB, svc, c, nil)
+
+	shop := &model.Shop{
+		ShopID:  1,
+		Name:    "west2",
+		Status:  constants.ShopStatusOpen,
+		OwnerID: 100,
+	}
+	mockDB.On("GetShopByID", mock.Anything, shop.ShopID).Return(shop, nil)
+	mockDB.On("UpdateShopStatus", mock.Anything, shop.ShopID, constants.ShopStatusClosed).Return(nil)
+
+	// 店员和其他用户都不能关闭店铺
+	ctx := metadata.WithLoginData(context.Background(), 200)
+	err := uc.CloseShop(ctx, shop.ShopID)
+	assert.Error(t, err)
+	assert.Equal(t, int64(errno.AuthNoOperatePermissionCode), errno.ConvertErr(err).ErrorCode)
+
+	// 店主可以正常关闭
+	ctx = metadata.WithLoginData(context.Background(), shop.OwnerID)
+	assert.NoError(t, uc.CloseShop(ctx, shop.ShopID))
+	mockDB.AssertCalled(t, "UpdateShopStatus", mock.Anything, shop.ShopID, constants.ShopStatusClosed)
+}
+
+func TestUseCase_ChangePassword(t *testing.T) {
+	mockDB := new(mocks.UserDB)
+	c := cache.NewUserCache(new(redis.Client))
+	mockSf, _ := utils.NewSnowflake(config.GetDataCenterID(), constants.WorkerOfUserService)
+	svc := service.NewUserService(mockDB, mockSf, c, notifier.NewLogNotifier(), audit.NewLogPublisher(), sender.NewWebhookSender())
+	uc := usecase.NewUserCase(mockDB, svc, c, nil)
+
+	digest, err := svc.EncryptPassword("old-password")
+	assert.NoError(t, err)
+	user := &model.User{
+		Uid:      100,
+		UserName: "testuser",
+		Password: digest,
+	}
+	mockDB.On("GetUserById", mock.Anything, user.Uid).Return(user, nil)
+	mockDB.On("UpdateUser", mock.Anything, mock.Anything).Return(nil)
+	ctx := metadata.WithLoginData(context.Background(), user.Uid)
+
+	// 旧密码错误时不能修改
+	err = uc.ChangePassword(ctx, "wrong-password", "new-password")
+	assert.Error(t, err)
+	assert.Equal(t, int64(errno.ServiceWrongPassword), errno.ConvertErr(err).ErrorCode)
+	mockDB.AssertNotCalled(t, "UpdateUser", mock.Anything, mock.Anything)
+
+	// 新密码不符合要求时不能修改
+	assert.Error(t, uc.ChangePassword(ctx, "old-password", "new"))
+
+	// 旧密码正确时保存加密后的新密码
+	assert.NoError(t, uc.ChangePassword(ctx, "old-password", "new-password"))
+	mockDB.AssertCalled(t, "UpdateUser", mock.Anything, mock.MatchedBy(func(u *model.User) bool {
+		return u.Uid == user.Uid && svc.CheckPassword(u.Password, "new-password") == nil
+	}))
+}
+
+func TestUseCase_PasswordResetUnknownUser(t *testing.T) {
+	mockDB := new(mocks.UserDB)
+	c := cache.NewUserCache(new(redis.Client))
+	mockSf, _ := utils.NewSnowflake(config.GetDataCenterID(), constants.WorkerOfUserService)
+	svc := service.NewUserService(mockDB, mockSf, c, notifier.NewLogNotifier(), audit.NewLogPublisher(), sender.NewWebhookSender())
+	uc := usecase.NewUserCase(mockDB, svc, c, nil)
+
+	mockDB.On("GetUserInfo", mock.Anything, "nobody").Return(nil, errno.Errorf(errno.ServiceUserNotExist, "mysql: user not exist"))
+
+	// 不存在的用户名和存在的用户名表现一致, 不能用来探测用户是否已经注册
+	assert.NoError(t, uc.RequestPasswordReset(context.Background(), "nobody"))
+	err := uc.ResetPassword(context.Background(), "nobody", "123456", "new-password")
+	assert.Equal(t, int64(errno.ServiceWrongResetCode), errno.ConvertErr(err).ErrorCode)
+}
+
+// lockedCache 模拟用户名已经因为登录失败次数过多被锁定的缓存
+type lockedCache struct {
+	repository.UserCache
+	locked string
+}
+
+func (c *lockedCache) GetLoginLockTTL(ctx context.Context, key string) (time.Duration, error) {
+	if key != c.locked {
+		return 0, nil
+	}
+	return time.Minute, nil
+}
+
+func TestUseCase_LoginLocked(t *testing.T) {
+	mockDB := new(mocks.UserDB)
+	base := cache.NewUserCache(new(redis.Client))
+	c := &lockedCache{UserCache: base, locked: base.LoginLockUserKey("testuser")}
+	mockSf, _ := utils.NewSnowflake(config.GetDataCenterID(), constants.WorkerOfUserService)
+	svc := service.NewUserService(mockDB, mockSf, c, notifier.NewLogNotifier(), audit.NewLogPublisher(), sender.NewWebhookSender())
+	uc := usecase.NewUserCase(mockDB, svc, c, nil)
+
+	// 锁定期间即使密码正确也不能登录, 并且不会再去查询数据库
+	_, _, err := uc.Login(context.Background(), &model.User{UserName: "testuser", Password: "password"}, &model.Session{IP: "127.0.0.1"}, "")
+	assert.Error(t, err)
+	assert.Equal(t, int64(errno.ServiceLoginLocked), errno.ConvertErr(err).ErrorCode)
+	mockDB.AssertNotCalled(t, "GetUserInfo", mock.Anything, mock.Anything)
+
+	// 改变用户名的大小写不能绕过锁定
+	_, _, err = uc.Login(context.Background(), &model.User{UserName: "TestUser", Password: "password"}, &model.Session{IP: "127.0.0.1"}, "")
+	assert.Equal(t, int64(errno.ServiceLoginLocked), errno.ConvertErr(err).ErrorCode)
+	mockDB.AssertNotCalled(t, "GetUserInfo", mock.Anything, mock.Anything)
+}
+
+func TestUseCase_BanUser(t *testing.T) {
+	mockDB := new(mocks.UserDB)
+	c := cache.NewUserCache(new(redis.Client))
+	mockSf, _ := utils.NewSnowflake(config.GetDataCenterID(), constants.WorkerOfUserService)
+	svc := service.NewUserService(mockDB, mockSf, c, notifier.NewLogNotifier(), audit.NewLogPublisher(), sender.NewWebhookSender())
+	uc := usecase.NewUserCase(mockDB, svc, c, nil)
+
+	target := &model.User{Uid: 100, UserName: "testuser", Role: constants.RoleCustomer}
+	mockDB.On("GetUserById", mock.Anything, target.Uid).Return(target, nil)
+	mockDB.On("GetActiveBan", mock.Anything, target.Uid).Return(&model.Ban{BanID: 1, Uid: target.Uid}, nil)
+	ctx := metadata.WithLoginData(context.Background(), 1)
+
+	// 封禁原因不能为空, 时长不能为负数
+	err := uc.BanUser(ctx, target.Uid, "", 0)
+	assert.Equal(t, int64(errno.ParamVerifyErrorCode), errno.ConvertErr(err).ErrorCode)
+	err = uc.BanUser(ctx, target.Uid, "spam", -1)
+	assert.Equal(t, int64(errno.ParamVerifyErrorCode), errno.ConvertErr(err).ErrorCode)
+
+	// 已经有生效中的封禁时不能重复封禁
+	err = uc.BanUser(ctx, target.Uid, "spam", 3600)
+	assert.Equal(t, int64(errno.RepeatedOperation), errno.ConvertErr(err).ErrorCode)
+	mockDB.AssertNotCalled(t, "CreateBan", mock.Anything, mock.Anything)
+}
+
+func TestUseCase_UpdateProfile(t *testing.T) {
+	mockDB := new(mocks.UserDB)
+	c := cache.NewUserCache(new(redis.Client))
+	mockSf, _ := utils.NewSnowflake(config.GetDataCenterID(), constants.WorkerOfUserService)
+	svc := service.NewUserService(mockDB, mockSf, c, notifier.NewLogNotifier(), audit.NewLogPublisher(), sender.NewWebhookSender())
+	uc := usecase.NewUserCase(mockDB, svc, c, nil)
+
+	digest, err := svc.EncryptPassword("password")
+	assert.NoError(t, err)
+	user := &model.User{
+		Uid:      100,
+		UserName: "testuser",
+		Password: digest,
+		Email:    "test@example.com",
+	}
+	mockDB.On("GetUserById", mock.Anything, user.Uid).Return(user, nil)
+	mockDB.On("IsEmailExist", mock.Anything, "taken@example.com").Return(true, nil)
+	mockDB.On("IsEmailExist", mock.Anything, "new@example.com").Return(false, nil)
+	mockDB.On("UpdateUser", mock.Anything, mock.Anything).Return(nil)
+	ctx := metadata.WithLoginData(context.Background(), user.Uid)
+
+	// 格式错误的邮箱不能保存
+	_, err = uc.UpdateProfile(ctx, &model.User{Email: "wrong-email"}, "password")
+	assert.Equal(t, int64(errno.ParamVerifyErrorCode), errno.ConvertErr(err).ErrorCode)
+
+	// 修改邮箱需要重新确认密码
+	_, err = uc.UpdateProfile(ctx, &model.User{Email: "new@example.com"}, "wrong-password")
+	assert.Equal(t, int64(errno.ServiceWrongPassword), errno.ConvertErr(err).ErrorCode)
+
+	// 已经被其他用户使用的邮箱不能保存
+	_, err = uc.UpdateProfile(ctx, &model.User{Email: "taken@example.com"}, "password")
+	assert.Equal(t, int64(errno.ServiceEmailExist), errno.ConvertErr(err).ErrorCode)
+	mockDB.AssertNotCalled(t, "UpdateUser", mock.Anything, mock.Anything)
+
+	// 只更新发生变化的字段
+	_, err = uc.UpdateProfile(ctx, &model.User{UserName: user.UserName, Email: "new@example.com"}, "password")
+	assert.NoError(t, err)
+	mockDB.AssertCalled(t, "UpdateUser", mock.Anything, &model.User{Uid: user.Uid, Email: "new@example.com"})
+}
+
+// sessionCache 模拟会话可以被正常清除的缓存
+type sessionCache struct {
+	repository.UserCache
+}
+
+func (c *sessionCache) DeleteUserSessions(ctx context.Context, uid int64) error {
+	return nil
+}
+
+func TestUseCase_CloseAccount(t *testing.T) {
+	mockDB := new(mocks.UserDB)
+	mockRPC := new(mocks.RPC)
+	c := &sessionCache{UserCache: cache.NewUserCache(new(redis.Client))}
+	mockSf, _ := utils.NewSnowflake(config.GetDataCenterID(), constants.WorkerOfUserService)
+	svc := service.NewUserService(mockDB, mockSf, c, notifier.NewLogNotifier(), audit.NewLogPublisher(), sender.NewWebhookSender())
+	uc := usecase.NewUserCase(mockDB, svc, c, mockRPC)
+
+	digest, err := svc.EncryptPassword("password")
+	assert.NoError(t, err)
+	user := &model.User{Uid: 100, UserName: "testuser", Password: digest}
+	mockDB.On("GetUserById", mock.Anything, user.Uid).Return(user, nil)
+	ctx := metadata.WithLoginData(context.Background(), user.Uid)
+
+	// 密码错误时不会清理任何数据
+	err = uc.CloseAccount(ctx, "wrong-password")
+	assert.Equal(t, int64(errno.ServiceWrongPassword), errno.ConvertErr(err).ErrorCode)
+	mockRPC.AssertNotCalled(t, "EraseOrderData", mock.Anything, mock.Anything)
+
+	// 订单服务拒绝时账号保持原样, 用户可以处理完订单后重试
+	mockRPC.On("EraseOrderData", mock.Anything, user.Uid).
+		Return(errno.NewErrNo(errno.ServiceOrderStatusInvalid, "there are unfinished orders")).Once()
+	err = uc.CloseAccount(ctx, "password")
+	assert.Equal(t, int64(errno.ServiceOrderStatusInvalid), errno.ConvertErr(err).ErrorCode)
+	mockRPC.AssertNotCalled(t, "EraseCartData", mock.Anything, mock.Anything)
+	mockDB.AssertNotCalled(t, "AnonymizeUser", mock.Anything, mock.Anything)
+
+	mockRPC.On("EraseOrderData", mock.Anything, user.Uid).Return(nil)
+	mockRPC.On("EraseCartData", mock.Anything, user.Uid).Return(nil)
+	mockRPC.On("EraseCouponData", mock.Anything, user.Uid).Return(nil)
+	mockDB.On("AnonymizeUser", mock.Anything, user.Uid).Return(nil)
+	err = uc.CloseAccount(ctx, "password")
+	assert.NoError(t, err)
+	mockDB.AssertCalled(t, "AnonymizeUser", mock.Anything, user.Uid)
+}
+
+func TestUseCase_TOTP(t *testing.T) {
+	mockDB := new(mocks.UserDB)
+	c := cache.NewUserCache(new(redis.Client))
+	mockSf, _ := utils.NewSnowflake(config.GetDataCenterID(), constants.WorkerOfUserService)
+	svc := service.NewUserService(mockDB, mockSf, c, notifier.NewLogNotifier(), audit.NewLogPublisher(), sender.NewWebhookSender())
+	uc := usecase.NewUserCase(mockDB, svc, c, nil)
+
+	digest, err := svc.EncryptPassword("password")
+	assert.NoError(t, err)
+	user := &model.User{Uid: 100, UserName: "admin", Password: digest, Role: constants.RoleOperator}
+	mockDB.On("GetUserById", mock.Anything, user.Uid).Return(user, nil)
+	mockDB.On("GetTOTP", mock.Anything, user.Uid).Return(nil, nil).Once()
+	mockDB.On("SaveTOTP", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	ctx := metadata.WithLoginData(context.Background(), user.Uid)
+
+	// 绑定前需要校验密码
+	_, err = uc.EnrollTOTP(ctx, "wrong-password")
+	assert.Equal(t, int64(errno.ServiceWrongPassword), errno.ConvertErr(err).ErrorCode)
+
+	enrollment, err := uc.EnrollTOTP(ctx, "password")
+	assert.NoError(t, err)
+	assert.Contains(t, enrollment.URI, "secret="+enrollment.Secret)
+	assert.Len(t, enrollment.RecoveryCodes, constants.TOTPRecoveryCodeCount)
+	// 数据库中只保存恢复码的摘要
+	mockDB.AssertCalled(t, "SaveTOTP", mock.Anything, mock.Anything, mock.MatchedBy(func(digests []string) bool {
+		return len(digests) == constants.TOTPRecoveryCodeCount && digests[0] != enrollment.RecoveryCodes[0]
+	}))
+
+	totp := &model.TOTP{Uid: user.Uid, Secret: enrollment.Secret}
+	mockDB.On("GetTOTP", mock.Anything, user.Uid).Return(totp, nil)
+	mockDB.On("EnableTOTP", mock.Anything, user.Uid).Return(nil)
+	code, err := utils.TOTPCode(enrollment.Secret, utils.TOTPStep(time.Now()))
+	assert.NoError(t, err)
+
+	// 位数不对的验证码不能确认绑定
+	err = uc.ConfirmTOTP(ctx, code[1:])
+	assert.Equal(t, int64(errno.ServiceWrongTOTPCode), errno.ConvertErr(err).ErrorCode)
+
+	// 同一个时间步的验证码已经被使用过时视为重放
+	mockDB.On("UseTOTPStep", mock.Anything, user.Uid, mock.Anything).Return(false, nil).Once()
+	err = uc.ConfirmTOTP(ctx, code)
+	assert.Equal(t, int64(errno.ServiceWrongTOTPCode), errno.ConvertErr(err).ErrorCode)
+	mockDB.AssertNotCalled(t, "EnableTOTP", mock.Anything, mock.Anything)
+
+	mockDB.On("UseTOTPStep", mock.Anything, user.Uid, mock.Anything).Return(true, nil)
+	assert.NoError(t, uc.ConfirmTOTP(ctx, code))
+	mockDB.AssertCalled(t, "EnableTOTP", mock.Anything, user.Uid)
+
+	// 已经开启两步验证时不能重新绑定, 关闭时可以使用恢复码代替动态验证码
+	totp.Enabled = true
+	_, err = uc.EnrollTOTP(ctx, "password")
+	assert.Equal(t, int64(errno.RepeatedOperation), errno.ConvertErr(err).ErrorCode)
+
+	mockDB.On("UseRecoveryCode", mock.Anything, user.Uid, mock.Anything).Return(true, nil)
+	mockDB.On("DeleteTOTP", mock.Anything, user.Uid).Return(nil)
+	assert.NoError(t, uc.DisableTOTP(ctx, "password", enrollment.RecoveryCodes[0]))
+	mockDB.AssertCalled(t, "DeleteTOTP", mock.Anything, user.Uid)
+}
+
+func TestUseCase_SetAdministratorRequiresMFA(t *testing.T) {
+	mockDB := new(mocks.UserDB)
+	c := cache.NewUserCache(new(redis.Client))
+	mockSf, _ := utils.NewSnowflake(config.GetDataCenterID(), constants.WorkerOfUserService)
+	svc := service.NewUserService(mockDB, mockSf, c, notifier.NewLogNotifier(), audit.NewLogPublisher(), sender.NewWebhookSender())
+	uc := usecase.NewUserCase(mockDB, svc, c, nil)
+
+	// 没有通过两步验证登录时, 即使知道管理员密钥也不能设置角色
+	ctx := metadata.WithMFAData(metadata.WithLoginData(context.Background(), 1), false)
+	err := uc.SetAdministrator(ctx, 100, []byte("secret"), constants.RoleOperator)
+	assert.Equal(t, int64(errno.AuthMFARequiredCode), errno.ConvertErr(err).ErrorCode)
+	mockDB.AssertNotCalled(t, "UpdateUser", mock.Anything, mock.Anything)
+}
+
+func TestUseCase_SetAdministratorDemote(t *testing.T) {
+	mockDB := new(mocks.UserDB)
+	c := cache.NewUserCache(new(redis.Client))
+	mockSf, _ := utils.NewSnowflake(config.GetDataCenterID(), constants.WorkerOfUserService)
+	svc := service.NewUserService(mockDB, mockSf, c, notifier.NewLogNotifier(), audit.NewLogPublisher(), sender.NewWebhookSender())
+	uc := usecase.NewUserCase(mockDB, svc, c, nil)
+	setAdministratorSecret(t, "secret")
+
+	// RoleCustomer 是零值, 降级时也必须写入数据库
+	mockDB.On("GetUserById", mock.Anything, int64(100)).Return(&model.User{Uid: 100, Role: constants.RoleOperator}, nil)
+	mockDB.On("UpdateUserRole", mock.Anything, int64(100), constants.RoleCustomer).Return(nil)
+	ctx := metadata.WithMFAData(metadata.WithLoginData(context.Background(), 1), true)
+	assert.NoError(t, uc.SetAdministrator(ctx, 100, []byte("secret"), constants.RoleCustomer))
+	mockDB.AssertCalled(t, "UpdateUserRole", mock.Anything, int64(100), constants.RoleCustomer)
+	mockDB.AssertNotCalled(t, "UpdateUser", mock.Anything, mock.Anything)
+}
+
+// setAdministratorSecret 设置管理员密钥, 配置类型没有导出, 只能通过反射构造
+func setAdministratorSecret(t *testing.T, secret string) {
+	t.Helper()
+	hash, err := bcrypt.GenerateFromPassword([]byte(secret), bcrypt.MinCost)
+	assert.NoError(t, err)
+	v := reflect.ValueOf(&config.Administrator).Elem()
+	old := v.Interface()
+	admin := reflect.New(v.Type().Elem())
+	admin.Elem().FieldByName("Secret").SetString(string(hash))
+	v.Set(admin)
+	t.Cleanup(func() {
+		v.Set(reflect.ValueOf(old))
+	})
+}
+
+// banedCache 模拟 baned 对应的用户已经被封禁的缓存
+type banedCache struct {
+	repository.UserCache
+	baned string
+}
+
+func (c *banedCache) IsExist(ctx context.Context, key string) bool {
+	return key == c.baned
+}
+
+func TestUseCase_ApiKey(t *testing.T) {
+	mockDB := new(mocks.UserDB)
+	base := cache.NewUserCache(new(redis.Client))
+	c := &banedCache{UserCache: base}
+	mockSf, _ := utils.NewSnowflake(config.GetDataCenterID(), constants.WorkerOfUserService)
+	svc := service.NewUserService(mockDB, mockSf, c, notifier.NewLogNotifier(), audit.NewLogPublisher(), sender.NewWebhookSender())
+	uc := usecase.NewUserCase(mockDB, svc, c, nil)
+
+	merchant := &model.User{Uid: 100, UserName: "merchant", Role: constants.RoleMerchant}
+	ctx := metadata.WithLoginData(context.Background(), merchant.Uid)
+
+	// 只能选择已经定义的 scope
+	_, err := uc.CreateApiKey(ctx, &model.ApiKey{Name: "erp", Scopes: []string{"order"}})
+	assert.Equal(t, int64(errno.ParamVerifyErrorCode), errno.ConvertErr(err).ErrorCode)
+
+	// 数据库中只保存摘要, 明文 key 只在创建时返回
+	var saved *model.ApiKey
+	mockDB.On("CountApiKeys", mock.Anything, merchant.Uid).Return(int64(0), nil)
+	mockDB.On("CreateApiKey", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		saved = args.Get(1).(*model.ApiKey)
+	}).Return(int64(1), nil)
+	key := &model.ApiKey{Name: "erp", Scopes: []string{constants.ApiKeyScopeCommodity, constants.ApiKeyScopeCommodity}}
+	plaintext, err := uc.CreateApiKey(ctx, key)
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(plaintext, key.Prefix))
+	assert.Equal(t, []string{constants.ApiKeyScopeCommodity}, saved.Scopes)
+	assert.Equal(t, merchant.Uid, saved.Uid)
+	assert.NotEqual(t, plaintext, saved.Digest)
+
+	mockDB.On("GetApiKeyByDigest", mock.Anything, saved.Digest).Return(saved, nil)
+	mockDB.On("GetApiKeyByDigest", mock.Anything, mock.Anything).Return(nil, nil)
+	mockDB.On("GetUserById", mock.Anything, merchant.Uid).Return(merchant, nil).Once()
+	got, u, err := uc.VerifyApiKey(context.Background(), plaintext)
+	assert.NoError(t, err)
+	assert.Equal(t, merchant.Uid, u.Uid)
+	assert.Equal(t, saved.Scopes, got.Scopes)
+
+	// 持有者被封禁或注销后, 名下的 key 不能再通过校验
+	c.baned = base.UserBanedKey(merchant.Uid)
+	mockDB.On("GetUserById", mock.Anything, merchant.Uid).Return(merchant, nil).Once()
+	_, _, err = uc.VerifyApiKey(context.Background(), plaintext)
+	assert.Equal(t, int64(errno.AuthNoOperatePermissionCode), errno.ConvertErr(err).ErrorCode)
+	c.baned = ""
+	closed := &model.User{Uid: merchant.Uid, UserName: constants.ClosedAccountNamePrefix + "100", Role: constants.RoleMerchant}
+	mockDB.On("GetUserById", mock.Anything, merchant.Uid).Return(closed, nil).Once()
+	_, _, err = uc.VerifyApiKey(context.Background(), plaintext)
+	assert.Equal(t, int64(errno.AuthInvalidCode), errno.ConvertErr(err).ErrorCode)
+
+	// 不存在的 key 和失去商家身份的用户持有的 key 都不能通过校验
+	_, _, err = uc.VerifyApiKey(context.Background(), constants.ApiKeyPrefix+"unknown")
+	assert.Equal(t, int64(errno.AuthInvalidCode), errno.ConvertErr(err).ErrorCode)
+	mockDB.On("GetUserById", mock.Anything, merchant.Uid).Return(&model.User{Uid: merchant.Uid, Role: constants.RoleCustomer}, nil)
+	_, _, err = uc.VerifyApiKey(context.Background(), plaintext)
+	assert.Equal(t, int64(errno.AuthNoOperatePermissionCode), errno.ConvertErr(err).ErrorCode)
+}
+
+func TestUseCase_AuditLog(t *testing.T) {
+	mockDB := new(mocks.UserDB)
+	c := cache.NewUserCache(new(redis.Client))
+	mockSf, _ := utils.NewSnowflake(config.GetDataCenterID(), constants.WorkerOfUserService)
+	svc := service.NewUserService(mockDB, mockSf, c, notifier.NewLogNotifier(), audit.NewLogPublisher(), sender.NewWebhookSender())
+	uc := usecase.NewUserCase(mockDB, svc, c, nil)
+
+	// 事件中的操作人, 客户端 IP 来自网关透传的 context
+	ctx := metadata.WithClientIPData(metadata.WithLoginData(context.Background(), 1), "10.0.0.1")
+	e, err := audit.NewEvent(ctx, constants.AuditActionBanUser, audit.Target("user", 100), nil, &model.Ban{Uid: 100})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), e.ActorID)
+	assert.Equal(t, "user:100", e.Target)
+	assert.Equal(t, "10.0.0.1", e.ClientIP)
+	assert.Empty(t, e.Before)
+	assert.Contains(t, e.After, `"uid":100`)
+
+	// 事件经过编解码后原样写入数据库
+	v, err := audit.Encode(e)
+	assert.Nil(t, err)
+	decoded, err := audit.Decode(v)
+	assert.Nil(t, err)
+	_, err = audit.Decode([]byte("not json"))
+	assert.NotNil(t, err)
+	events := make(chan *audit.Event, 1)
+	events <- decoded
+	close(events)
+	mockDB.On("CreateAuditLog", mock.Anything, mock.MatchedBy(func(log *model.AuditLog) bool {
+		return log.EventID == e.EventID && log.ActorID == 1 && log.Action == constants.AuditActionBanUser
+	})).Return(nil).Once()
+	svc.SaveAuditEvents(context.Background(), events)
+	mockDB.AssertNumberOfCalls(t, "CreateAuditLog", 1)
+
+	// 查询时补全分页参数, 并限制每页数量
+	mockDB.On("ListAuditLogs", mock.Anything, mock.MatchedBy(func(f *model.AuditLogFilter) bool {
+		return f.PageNum == 1 && f.PageSize == constants.AuditLogMaxPageSize && f.Action == constants.AuditActionBanUser
+	})).Return([]*model.AuditLog{{LogID: 1}}, int64(1), nil)
+	logs, total, err := uc.ListAuditLogs(ctx, &model.AuditLogFilter{Action: constants.AuditActionBanUser, PageSize: 1000})
+	assert.Nil(t, err)
+	assert.Len(t, logs, 1)
+	assert.Equal(t, int64(1), total)
+
+	_, _, err = uc.ListAuditLogs(ctx, &model.AuditLogFilter{StartTime: 2000, EndTime: 1000})
+	assert.Equal(t, int64(errno.ParamVerifyErrorCode), errno.ConvertErr(err).ErrorCode)
+}
+
+func TestUseCase_Webhook(t *testing.T) {
+	mockDB := new(mocks.UserDB)
+	c := cache.NewUserCache(new(redis.Client))
+	mockSf, _ := utils.NewSnowflake(config.GetDataCenterID(), constants.WorkerOfUserService)
+	svc := service.NewUserService(mockDB, mockSf, c, notifier.NewLogNotifier(), audit.NewLogPublisher(), &plainWebhookSender{})
+	uc := usecase.NewUserCase(mockDB, svc, c, nil)
+
+	// 商家的接收端, 第一次返回 500, 之后返回 200
+	var received int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received++
+		if received == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	shop := &model.Shop{ShopID: 1, Status: constants.ShopStatusOpen, OwnerID: 100}
+	endpoint := &model.WebhookEndpoint{
+		EndpointID: 10,
+		ShopID:     shop.ShopID,
+		URL:        server.URL,
+		Secret:     "secret",
+		EventTypes: []string{constants.WebhookEventOrderPaid},
+		Status:     constants.WebhookEndpointStatusEnabled,
+	}
+	delivery := &model.WebhookDelivery{
+		DeliveryID: 1000,
+		EndpointID: endpoint.EndpointID,
+		EventID:    "event",
+		EventType:  constants.WebhookEventOrderPaid,
+		Payload:    `{"order_id":1}`,
+		Status:     constants.WebhookDeliveryStatusFailed,
+		Attempts:   constants.WebhookMaxAttempts,
+	}
+	mockDB.On("GetShopByID", mock.Anything, shop.ShopID).Return(shop, nil)
+	mockDB.On("GetWebhookEndpoint", mock.Anything, endpoint.EndpointID).Return(endpoint, nil)
+	mockDB.On("GetWebhookDelivery", mock.Anything, delivery.DeliveryID).Return(delivery, nil)
+	mockDB.On("UpdateWebhookDelivery", mock.Anything, mock.Anything).Return(nil)
+	ctx := metadata.WithLoginData(context.Background(), shop.OwnerID)
+
+	// 地址和事件类型不合法, 或者店铺不属于当前用户时不能注册
+	_, err := uc.CreateWebhookEndpoint(ctx, &model.WebhookEndpoint{ShopID: shop.ShopID, URL: "ftp://example.com", EventTypes: endpoint.EventTypes})
+	assert.Equal(t, int64(errno.ParamVerifyErrorCode), errno.ConvertErr(err).ErrorCode)
+	_, err = uc.CreateWebhookEndpoint(ctx, &model.WebhookEndpoint{ShopID: shop.ShopID, URL: "https://example.com", EventTypes: []string{"order.unknown"}})
+	assert.Equal(t, int64(errno.ParamVerifyErrorCode), errno.ConvertErr(err).ErrorCode)
+	for _, u := range []string{server.URL, "http://localhost:8080", "http://10.0.0.1", "http://169.254.169.254/latest/meta-data", "http://[::1]:2379"} {
+		_, err = uc.CreateWebhookEndpoint(ctx, &model.WebhookEndpoint{ShopID: shop.ShopID, URL: u, EventTypes: endpoint.EventTypes})
+		assert.Equal(t, int64(errno.ParamVerifyErrorCode), errno.ConvertErr(err).ErrorCode, u)
+	}
+	_, err = uc.RedeliverWebhook(metadata.WithLoginData(context.Background(), 200), delivery.DeliveryID)
+	assert.Equal(t, int64(errno.AuthNoOperatePermissionCode), errno.ConvertErr(err).ErrorCode)
+
+	// 重新投递失败后按间隔重试, 端点连续失败达到阈值时被停用
+	mockDB.On("IncrWebhookEndpointFailures", mock.Anything, endpoint.EndpointID).Return(int64(constants.WebhookDisableThreshold), nil).Once()
+	mockDB.On("DisableWebhookEndpoint", mock.Anything, endpoint.EndpointID).Return(nil).Once()
+	d, err := uc.RedeliverWebhook(ctx, delivery.DeliveryID)
+	assert.NoError(t, err)
+	assert.Equal(t, constants.WebhookDeliveryStatusPending, d.Status)
+	assert.Equal(t, int64(1), d.Attempts)
+	assert.Equal(t, int64(http.StatusInternalServerError), d.LastStatusCode)
+	assert.Greater(t, d.NextRetryAt, time.Now().Add(constants.WebhookRetryBaseInterval/2).UnixMilli())
+	mockDB.AssertCalled(t, "DisableWebhookEndpoint", mock.Anything, endpoint.EndpointID)
+
+	// 投递成功后清空端点的连续失败次数
+	mockDB.On("ResetWebhookEndpointFailures", mock.Anything, endpoint.EndpointID).Return(nil).Once()
+	d, err = uc.RedeliverWebhook(ctx, delivery.DeliveryID)
+	assert.NoError(t, err)
+	assert.Equal(t, constants.WebhookDeliveryStatusSucceeded, d.Status)
+	assert.Empty(t, d.LastError)
+	assert.Equal(t, 2, received)
+
+	// 已经停用的端点不能重新投递
+	endpoint.Status = constants.WebhookEndpointStatusDisabled
+	_, err = uc.RedeliverWebhook(ctx, delivery.DeliveryID)
+	assert.Equal(t, int64(errno.ServiceWebhookEndpointDisabled), errno.ConvertErr(err).ErrorCode)
+
+	// 只为启用且订阅了该事件的端点创建投递
+	mockDB.On("ListWebhookEndpoints", mock.Anything, shop.ShopID).Return([]*model.WebhookEndpoint{
+		{EndpointID: 1, EventTypes: []string{constants.WebhookEventOrderPaid}, Status: constants.WebhookEndpointStatusEnabled},
+		{EndpointID: 2, EventTypes: []string{constants.WebhookEventOrderCanceled}, Status: constants.WebhookEndpointStatusEnabled},
+		{EndpointID: 3, EventTypes: []string{constants.WebhookEventOrderPaid}, Status: constants.WebhookEndpointStatusDisabled},
+	}, nil)
+	mockDB.On("CreateWebhookDelivery", mock.Anything, mock.MatchedBy(func(d *model.WebhookDelivery) bool {
+		return d.EndpointID == 1 && d.EventID == "paid" && d.Status == constants.WebhookDeliveryStatusPending
+	})).Return(true, nil).Once()
+	events := make(chan *webhook.Event, 1)
+	events <- &webhook.Event{EventID: "paid", Type: constants.WebhookEventOrderPaid, ShopID: shop.ShopID, Data: "{}"}
+	close(events)
+	svc.DispatchWebhookEvents(context.Background(), events)
+	mockDB.AssertNumberOfCalls(t, "CreateWebhookDelivery", 1)
+}
+
+// plainWebhookSender 直接投递到本地的测试服务, 不做拨号时的地址检查
+type plainWebhookSender struct{}
+
+func (s *plainWebhookSender) Send(ctx context.Context, req *model.WebhookRequest) (int, error) {
+	r, err := http.NewRequestWithContext(ctx, http.MethodPost, req.URL, strings.NewReader(req.Payload))
+	if err != nil {
+		return 0, err
+	}
+	resp, err := http.DefaultClient.Do(r)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return resp.StatusCode, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// rotatingCache 在内存中模拟会话的轮换, 语义和 UserRotateSessionTokenLuaScript 保持一致
+type rotatingCache struct {
+	repository.UserCache
+	current map[string]string // sessionID -> 当前有效的 refresh token 的 jti
+	baned   string
+}
+
+func (c *rotatingCache) SetSession(ctx context.Context, session *model.Session, tokenID string) error {
+	c.current[session.SessionID] = tokenID
+	return nil
+}
+
+func (c *rotatingCache) RotateSessionToken(ctx context.Context, uid int64, sessionID string, presented string, next string) (int, error) {
+	current, ok := c.current[sessionID]
+	if !ok {
+		return constants.SessionTokenNotExist, nil
+	}
+	if current != presented {
+		delete(c.current, sessionID)
+		return constants.SessionTokenReused, nil
+	}
+	c.current[sessionID] = next
+	return constants.SessionTokenRotated, nil
+}
+
+func (c *rotatingCache) IsExist(ctx context.Context, key string) bool {
+	return key == c.baned
+}
+
+// mockJWTSecret 生成一个 Ed25519 签名密钥作为 server.private-key, 配置类型没有导出, 只能通过反射构造
+func mockJWTSecret(t *testing.T) {
+	t.Helper()
+	_, private, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(private)
+	assert.NoError(t, err)
+	srv := reflect.New(reflect.TypeOf(config.GetServer).Out(0).Elem())
+	srv.Elem().FieldByName("Secret").SetString(string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})))
+	m := mockey.Mock(config.GetServer).Return(srv.Interface()).Build()
+	t.Cleanup(func() { m.UnPatch() })
+}
+
+func TestUseCase_RefreshToken(t *testing.T) {
+	mockJWTSecret(t)
+	mockDB := new(mocks.UserDB)
+	base := cache.NewUserCache(new(redis.Client))
+	c := &rotatingCache{UserCache: base, current: make(map[string]string)}
+	mockSf, _ := utils.NewSnowflake(config.GetDataCenterID(), constants.WorkerOfUserService)
+	svc := service.NewUserService(mockDB, mockSf, c, notifier.NewLogNotifier(), audit.NewLogPublisher(), sender.NewWebhookSender())
+	uc := usecase.NewUserCase(mockDB, svc, c, nil)
+
+	// 刷新时重新读取角色, 角色的变更在下一次刷新后生效
+	mockDB.On("GetUserById", mock.Anything, int64(100)).Return(&model.User{Uid: 100, Role: constants.RoleMerchant}, nil)
+	issued, err := svc.IssueToken(context.Background(), &model.Session{Uid: 100}, constants.RoleCustomer, false)
+	assert.NoError(t, err)
+
+	// access token 不能用于刷新
+	_, err = uc.RefreshToken(context.Background(), issued.AccessToken)
+	assert.Equal(t, int64(errno.AuthInvalidCode), errno.ConvertErr(err).ErrorCode)
+
+	// 使用 refresh token 换取新的一对 token, refresh token 同时被轮换
+	rotated, err := uc.RefreshToken(context.Background(), issued.RefreshToken)
+	assert.NoError(t, err)
+	assert.NotEqual(t, issued.RefreshToken, rotated.RefreshToken)
+	claims, err := utils.ParseAccessToken(rotated.AccessToken)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100), claims.UserID)
+	assert.Equal(t, constants.RoleMerchant, claims.Role)
+
+	// 再次出示已经被轮换掉的 refresh token, 整个会话被吊销, 轮换出来的 token 也随之失效
+	_, err = uc.RefreshToken(context.Background(), issued.RefreshToken)
+	assert.Equal(t, int64(errno.AuthRefreshTokenReusedCode), errno.ConvertErr(err).ErrorCode)
+	_, err = uc.RefreshToken(context.Background(), rotated.RefreshToken)
+	assert.Equal(t, int64(errno.AuthInvalidCode), errno.ConvertErr(err).ErrorCode)
+
+	// 被封禁的用户不能再续期
+	issued, err = svc.IssueToken(context.Background(), &model.Session{Uid: 100}, constants.RoleCustomer, false)
+	assert.NoError(t, err)
+	c.baned = base.UserBanedKey(100)
+	_, err = uc.RefreshToken(context.Background(), issued.RefreshToken)
+	assert.Equal(t, int64(errno.AuthNoOperatePermissionCode), errno.ConvertErr(err).ErrorCode)
+}
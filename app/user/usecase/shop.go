@@ -0,0 +1,145 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/samber/lo"
+
+	"github.com/west2-online/DomTok/app/user/domain/model"
+	metadata "github.com/west2-online/DomTok/pkg/base/context"
+	"github.com/west2-online/DomTok/pkg/constants"
+	"github.com/west2-online/DomTok/pkg/errno"
+)
+
+// CreateShop 开设店铺, 调用者会成为店主. 是否拥有开店权限已经由 middleware.Permission 校验过了
+func (uc *useCase) CreateShop(ctx context.Context, shop *model.Shop) (shopID int64, err error) {
+	if err = uc.svc.Verify(uc.svc.VerifyShop(shop)); err != nil {
+		return 0, err
+	}
+
+	if shop.OwnerID, err = metadata.GetLoginData(ctx); err != nil {
+		return 0, fmt.Errorf("get login data failed: %w", err)
+	}
+	return uc.svc.CreateShop(ctx, shop)
+}
+
+func (uc *useCase) UpdateShop(ctx context.Context, shop *model.Shop) error {
+	if err := uc.svc.Verify(uc.svc.VerifyShopName(shop.Name)); err != nil {
+		return err
+	}
+
+	s, err := uc.getOwnedShop(ctx, shop.ShopID)
+	if err != nil {
+		return err
+	}
+	if s.Status == constants.ShopStatusClosed {
+		return errno.NewErrNo(errno.ServiceShopClosed, "shop is closed")
+	}
+	return uc.svc.UpdateShop(ctx, shop)
+}
+
+func (uc *useCase) CloseShop(ctx context.Context, shopID int64) error {
+	shop, err := uc.getOwnedShop(ctx, shopID)
+	if err != nil {
+		return err
+	}
+	return uc.svc.CloseShop(ctx, shop)
+}
+
+func (uc *useCase) GetShop(ctx context.Context, shopID int64) (*model.Shop, error) {
+	return uc.svc.GetShop(ctx, shopID)
+}
+
+func (uc *useCase) GetShops(ctx context.Context, shopIDs []int64) ([]*model.Shop, error) {
+	shopIDs = lo.Uniq(shopIDs)
+	if len(shopIDs) > constants.ShopMaximumBatchSize {
+		return nil, errno.NewErrNo(errno.ParamVerifyErrorCode, "too many shops in one request")
+	}
+	return uc.svc.GetShops(ctx, shopIDs)
+}
+
+func (uc *useCase) AddShopStaff(ctx context.Context, shopID int64, uid int64) error {
+	shop, err := uc.getOwnedShop(ctx, shopID)
+	if err != nil {
+		return err
+	}
+	if shop.Status == constants.ShopStatusClosed {
+		return errno.NewErrNo(errno.ServiceShopClosed, "shop is closed")
+	}
+	return uc.svc.AddShopStaff(ctx, shopID, uid)
+}
+
+func (uc *useCase) RemoveShopStaff(ctx context.Context, shopID int64, uid int64) error {
+	shop, err := uc.getOwnedShop(ctx, shopID)
+	if err != nil {
+		return err
+	}
+	return uc.svc.RemoveShopStaff(ctx, shop, uid)
+}
+
+// ListShopStaff 列出店铺的所有成员, 只有店铺的成员才能查看
+func (uc *useCase) ListShopStaff(ctx context.Context, shopID int64) ([]*model.ShopStaff, error) {
+	uid, err := metadata.GetLoginData(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get login data failed: %w", err)
+	}
+
+	if _, err = uc.svc.GetShop(ctx, shopID); err != nil {
+		return nil, err
+	}
+	ok, err := uc.svc.IsShopStaff(ctx, shopID, uid)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errno.NewErrNo(errno.ServiceNotShopStaff, "current user is not shop staff")
+	}
+	return uc.svc.ListShopStaff(ctx, shopID)
+}
+
+// IsShopStaff 供其他服务校验用户是否为店铺成员, 同时返回店铺信息以便调用方判断店铺状态
+func (uc *useCase) IsShopStaff(ctx context.Context, shopID int64, uid int64) (bool, *model.Shop, error) {
+	shop, err := uc.svc.GetShop(ctx, shopID)
+	if err != nil {
+		return false, nil, err
+	}
+	ok, err := uc.svc.IsShopStaff(ctx, shopID, uid)
+	if err != nil {
+		return false, nil, err
+	}
+	return ok, shop, nil
+}
+
+// getOwnedShop 获取店铺并校验当前登录的用户是否为店主
+func (uc *useCase) getOwnedShop(ctx context.Context, shopID int64) (*model.Shop, error) {
+	uid, err := metadata.GetLoginData(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get login data failed: %w", err)
+	}
+
+	shop, err := uc.svc.GetShop(ctx, shopID)
+	if err != nil {
+		return nil, err
+	}
+	if shop.OwnerID != uid {
+		return nil, errno.NewErrNo(errno.AuthNoOperatePermissionCode, "shop does not belong to current user")
+	}
+	return shop, nil
+}
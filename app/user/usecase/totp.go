@@ -0,0 +1,70 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/west2-online/DomTok/app/user/domain/model"
+	metadata "github.com/west2-online/DomTok/pkg/base/context"
+)
+
+// EnrollTOTP 为当前登录用户发起两步验证的绑定, 需要先校验密码, 防止登录状态被盗用后攻击者绑定自己的验证器
+func (uc *useCase) EnrollTOTP(ctx context.Context, password string) (*model.TOTPEnrollment, error) {
+	uid, err := metadata.GetLoginData(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get login data failed: %w", err)
+	}
+	u, err := uc.db.GetUserById(ctx, uid)
+	if err != nil {
+		return nil, fmt.Errorf("get user info failed: %w", err)
+	}
+	if err = uc.svc.CheckPassword(u.Password, password); err != nil {
+		return nil, err
+	}
+	return uc.svc.EnrollTOTP(ctx, u)
+}
+
+// ConfirmTOTP 使用验证器生成的动态验证码确认绑定
+// 当前会话不会因此获得两步验证的状态, 需要重新登录才能拿到可以使用管理类权限的 token
+func (uc *useCase) ConfirmTOTP(ctx context.Context, code string) error {
+	uid, err := metadata.GetLoginData(ctx)
+	if err != nil {
+		return fmt.Errorf("get login data failed: %w", err)
+	}
+	return uc.svc.ConfirmTOTP(ctx, uid, code)
+}
+
+// DisableTOTP 关闭两步验证, 需要同时提供密码和动态验证码(或恢复码)
+func (uc *useCase) DisableTOTP(ctx context.Context, password string, code string) error {
+	uid, err := metadata.GetLoginData(ctx)
+	if err != nil {
+		return fmt.Errorf("get login data failed: %w", err)
+	}
+	u, err := uc.db.GetUserById(ctx, uid)
+	if err != nil {
+		return fmt.Errorf("get user info failed: %w", err)
+	}
+	if err = uc.svc.CheckPassword(u.Password, password); err != nil {
+		return err
+	}
+	if err = uc.svc.CheckSecondFactor(ctx, uid, code); err != nil {
+		return err
+	}
+	return uc.svc.DisableTOTP(ctx, uid)
+}
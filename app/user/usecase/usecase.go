@@ -27,14 +27,53 @@ import (
 // UserUseCase 接口应该不应该定义在 domain 中，这属于 use case 层
 type UserUseCase interface {
 	RegisterUser(ctx context.Context, user *model.User) (uid int64, err error)
-	Login(ctx context.Context, user *model.User) (*model.User, error)
+	Login(ctx context.Context, user *model.User, session *model.Session, totpCode string) (*model.User, *model.Token, error)
+	RefreshToken(ctx context.Context, refreshToken string) (*model.Token, error)
 	GetAddress(ctx context.Context, addressID int64) (*model.Address, error)
 	AddAddress(ctx context.Context, address *model.Address) (addressID int64, err error)
-	BanUser(ctx context.Context, uid int64) error
+	ListAddress(ctx context.Context) ([]*model.Address, error)
+	UpdateAddress(ctx context.Context, address *model.Address) error
+	DeleteAddress(ctx context.Context, addressID int64) error
+	SetDefaultAddress(ctx context.Context, addressID int64) error
+	BanUser(ctx context.Context, uid int64, reason string, duration int64) error
+	ListBanHistory(ctx context.Context, uid int64) ([]*model.Ban, error)
 	LiftUser(ctx context.Context, uid int64) error
+	UnlockUser(ctx context.Context, uid int64) error
 	LogoutUser(ctx context.Context) error
+	ListSessions(ctx context.Context) ([]*model.Session, error)
+	RevokeSession(ctx context.Context, sessionID string) error
+	RevokeAllSessions(ctx context.Context) error
 	SetAdministrator(ctx context.Context, uid int64, password []byte, action int) error
 	GetUserInfo(ctx context.Context, uid int64) (*model.User, error)
+	CreateShop(ctx context.Context, shop *model.Shop) (shopID int64, err error)
+	UpdateShop(ctx context.Context, shop *model.Shop) error
+	CloseShop(ctx context.Context, shopID int64) error
+	GetShop(ctx context.Context, shopID int64) (*model.Shop, error)
+	GetShops(ctx context.Context, shopIDs []int64) ([]*model.Shop, error)
+	AddShopStaff(ctx context.Context, shopID int64, uid int64) error
+	RemoveShopStaff(ctx context.Context, shopID int64, uid int64) error
+	ListShopStaff(ctx context.Context, shopID int64) ([]*model.ShopStaff, error)
+	IsShopStaff(ctx context.Context, shopID int64, uid int64) (bool, *model.Shop, error)
+	UpdateProfile(ctx context.Context, profile *model.User, password string) (*model.User, error)
+	ChangePassword(ctx context.Context, oldPassword string, newPassword string) error
+	RequestPasswordReset(ctx context.Context, name string) error
+	ResetPassword(ctx context.Context, name string, code string, newPassword string) error
+	ExportUserData(ctx context.Context) (string, error)
+	CloseAccount(ctx context.Context, password string) error
+	EnrollTOTP(ctx context.Context, password string) (*model.TOTPEnrollment, error)
+	ConfirmTOTP(ctx context.Context, code string) error
+	DisableTOTP(ctx context.Context, password string, code string) error
+	CreateApiKey(ctx context.Context, key *model.ApiKey) (string, error)
+	ListApiKeys(ctx context.Context) ([]*model.ApiKey, error)
+	RevokeApiKey(ctx context.Context, keyID int64) error
+	VerifyApiKey(ctx context.Context, key string) (*model.ApiKey, *model.User, error)
+	ListAuditLogs(ctx context.Context, filter *model.AuditLogFilter) ([]*model.AuditLog, int64, error)
+	CreateWebhookEndpoint(ctx context.Context, endpoint *model.WebhookEndpoint) (string, error)
+	ListWebhookEndpoints(ctx context.Context, shopID int64) ([]*model.WebhookEndpoint, error)
+	UpdateWebhookEndpoint(ctx context.Context, endpoint *model.WebhookEndpoint, enabled bool) error
+	DeleteWebhookEndpoint(ctx context.Context, endpointID int64) error
+	ListWebhookDeliveries(ctx context.Context, endpointID int64, pageNum, pageSize int64) ([]*model.WebhookDelivery, int64, error)
+	RedeliverWebhook(ctx context.Context, deliveryID int64) (*model.WebhookDelivery, error)
 }
 
 // useCase 实现了 domain.UserUseCase
@@ -43,12 +82,14 @@ type useCase struct {
 	db    repository.UserDB
 	svc   *service.UserService
 	cache repository.UserCache
+	rpc   repository.RPC
 }
 
-func NewUserCase(db repository.UserDB, svc *service.UserService, re repository.UserCache) *useCase {
+func NewUserCase(db repository.UserDB, svc *service.UserService, re repository.UserCache, rpc repository.RPC) *useCase {
 	return &useCase{
 		db:    db,
 		svc:   svc,
 		cache: re,
+		rpc:   rpc,
 	}
 }
@@ -0,0 +1,101 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package usecase
+
+import (
+	"context"
+
+	"github.com/west2-online/DomTok/app/user/domain/model"
+	"github.com/west2-online/DomTok/pkg/constants"
+	"github.com/west2-online/DomTok/pkg/errno"
+)
+
+// CreateWebhookEndpoint 为店主的店铺注册 webhook 端点, 已经关闭的店铺不能注册
+func (uc *useCase) CreateWebhookEndpoint(ctx context.Context, endpoint *model.WebhookEndpoint) (string, error) {
+	if err := uc.svc.Verify(uc.svc.VerifyWebhookEndpoint(endpoint)); err != nil {
+		return "", err
+	}
+
+	shop, err := uc.getOwnedShop(ctx, endpoint.ShopID)
+	if err != nil {
+		return "", err
+	}
+	if shop.Status == constants.ShopStatusClosed {
+		return "", errno.NewErrNo(errno.ServiceShopClosed, "shop is closed")
+	}
+	return uc.svc.CreateWebhookEndpoint(ctx, endpoint)
+}
+
+func (uc *useCase) ListWebhookEndpoints(ctx context.Context, shopID int64) ([]*model.WebhookEndpoint, error) {
+	if _, err := uc.getOwnedShop(ctx, shopID); err != nil {
+		return nil, err
+	}
+	return uc.db.ListWebhookEndpoints(ctx, shopID)
+}
+
+func (uc *useCase) UpdateWebhookEndpoint(ctx context.Context, endpoint *model.WebhookEndpoint, enabled bool) error {
+	if err := uc.svc.Verify(uc.svc.VerifyWebhookEndpoint(endpoint)); err != nil {
+		return err
+	}
+
+	e, err := uc.getOwnedWebhookEndpoint(ctx, endpoint.EndpointID)
+	if err != nil {
+		return err
+	}
+	e.URL = endpoint.URL
+	e.EventTypes = endpoint.EventTypes
+	return uc.svc.UpdateWebhookEndpoint(ctx, e, enabled)
+}
+
+func (uc *useCase) DeleteWebhookEndpoint(ctx context.Context, endpointID int64) error {
+	if _, err := uc.getOwnedWebhookEndpoint(ctx, endpointID); err != nil {
+		return err
+	}
+	return uc.db.DeleteWebhookEndpoint(ctx, endpointID)
+}
+
+func (uc *useCase) ListWebhookDeliveries(ctx context.Context, endpointID int64, pageNum, pageSize int64,
+) ([]*model.WebhookDelivery, int64, error) {
+	if _, err := uc.getOwnedWebhookEndpoint(ctx, endpointID); err != nil {
+		return nil, 0, err
+	}
+	return uc.svc.ListWebhookDeliveries(ctx, endpointID, pageNum, pageSize)
+}
+
+func (uc *useCase) RedeliverWebhook(ctx context.Context, deliveryID int64) (*model.WebhookDelivery, error) {
+	delivery, err := uc.db.GetWebhookDelivery(ctx, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+	endpoint, err := uc.getOwnedWebhookEndpoint(ctx, delivery.EndpointID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.svc.RedeliverWebhook(ctx, endpoint, delivery)
+}
+
+// getOwnedWebhookEndpoint 获取端点并确认端点所属的店铺属于当前用户
+func (uc *useCase) getOwnedWebhookEndpoint(ctx context.Context, endpointID int64) (*model.WebhookEndpoint, error) {
+	endpoint, err := uc.db.GetWebhookEndpoint(ctx, endpointID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = uc.getOwnedShop(ctx, endpoint.ShopID); err != nil {
+		return nil, err
+	}
+	return endpoint, nil
+}
@@ -26,13 +26,16 @@ import (
 	"github.com/west2-online/DomTok/app/assistant/cli/ai/driver/eino"
 	"github.com/west2-online/DomTok/app/assistant/cli/server/adapter"
 	"github.com/west2-online/DomTok/app/assistant/cli/server/driver/http"
+	"github.com/west2-online/DomTok/app/assistant/handler"
 	"github.com/west2-online/DomTok/app/assistant/router"
 	"github.com/west2-online/DomTok/app/assistant/service"
 	"github.com/west2-online/DomTok/app/gateway/mw"
 	"github.com/west2-online/DomTok/config"
 	"github.com/west2-online/DomTok/pkg/base"
 	"github.com/west2-online/DomTok/pkg/constants"
+	"github.com/west2-online/DomTok/pkg/health"
 	"github.com/west2-online/DomTok/pkg/logger"
+	"github.com/west2-online/DomTok/pkg/shutdown"
 	"github.com/west2-online/DomTok/pkg/utils"
 )
 
@@ -44,7 +47,7 @@ func init() {
 
 	ai := eino.NewClient()
 	httpCli := http.NewClient(&http.ClientConfig{
-		BaseUrl: config.Server.IntranetUrl,
+		BaseUrl: config.GetServer().IntranetUrl,
 	})
 	ai.SetServerStrategy(func(functionName string) adapter.ServerCaller {
 		return httpCli
@@ -75,7 +78,9 @@ func main() {
 		server.WithHostPorts(listenAddr),
 		server.WithHandleMethodNotAllowed(true),
 		server.WithMaxRequestBodySize(constants.ServerMaxRequestBodySize),
+		server.WithExitWaitTime(constants.ServerExitWaitTime),
 	)
+	h.SetCustomSignalWaiter(shutdown.WaitSignal)
 
 	h.Use(
 		mw.RecoveryMW(), // recovery
@@ -84,6 +89,11 @@ func main() {
 		mw.SentinelMW(), // sentinel
 	)
 
+	// 退出时等待进行中的对话把回复发送完
+	h.OnShutdown = append(h.OnShutdown, handler.Shutdown)
+
 	router.GeneratedRegister(h)
+	// 助手服务没有需要检查的依赖, 只提供存活检查
+	health.Serve()
 	h.Spin()
 }
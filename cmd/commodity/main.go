@@ -31,7 +31,11 @@ import (
 	"github.com/west2-online/DomTok/kitex_gen/commodity/commodityservice"
 	"github.com/west2-online/DomTok/pkg/base"
 	"github.com/west2-online/DomTok/pkg/constants"
+	"github.com/west2-online/DomTok/pkg/health"
 	"github.com/west2-online/DomTok/pkg/logger"
+	"github.com/west2-online/DomTok/pkg/middleware"
+	"github.com/west2-online/DomTok/pkg/rbac"
+	"github.com/west2-online/DomTok/pkg/shutdown"
 	"github.com/west2-online/DomTok/pkg/utils"
 )
 
@@ -71,12 +75,23 @@ func main() {
 
 		server.WithServiceAddr(addr),
 		server.WithRegistry(r),
+		server.WithExitWaitTime(constants.ServerExitWaitTime),
 		server.WithLimit(&limit.Option{
 			MaxConnections: constants.MaxConnections,
 			MaxQPS:         constants.MaxQPS,
 		}),
+
+		server.WithMiddleware(middleware.ErrorLog()),
+		server.WithMiddleware(middleware.Respond()),
+		server.WithMiddleware(middleware.Permission(rbac.CommodityServicePermissions)),
 	)
-	if err = svr.Run(); err != nil {
+	health.Serve()
+	// Run 在收到 SIGTERM 等信号后返回, 此时已经从 etcd 注销并等待处理中的请求结束, 接着清理消费者和连接池
+	err = svr.Run()
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ShutdownTimeout)
+	defer cancel()
+	shutdown.Run(ctx)
+	if err != nil {
 		logger.Fatalf("Commodity: run server failed, err: %v", err)
 	}
 }
@@ -26,8 +26,11 @@ import (
 	"github.com/west2-online/DomTok/app/gateway/rpc"
 	"github.com/west2-online/DomTok/config"
 	"github.com/west2-online/DomTok/pkg/base"
+	"github.com/west2-online/DomTok/pkg/base/client"
 	"github.com/west2-online/DomTok/pkg/constants"
+	"github.com/west2-online/DomTok/pkg/health"
 	"github.com/west2-online/DomTok/pkg/logger"
+	"github.com/west2-online/DomTok/pkg/shutdown"
 	"github.com/west2-online/DomTok/pkg/utils"
 )
 
@@ -53,15 +56,35 @@ func main() {
 		server.WithHostPorts(listenAddr),
 		server.WithHandleMethodNotAllowed(true),
 		server.WithMaxRequestBodySize(constants.ServerMaxRequestBodySize),
+		server.WithExitWaitTime(constants.ServerExitWaitTime),
 	)
+	h.SetCustomSignalWaiter(shutdown.WaitSignal)
+	// 只信任来自 ingress 的 X-Forwarded-For, 否则客户端可以伪造 IP 绕过登录锁定和限流
+	clientIP, err := mw.ClientIPFunc(config.GetServer().TrustedProxies)
+	if err != nil {
+		logger.Fatalf("gateway: %v", err)
+	}
+	h.SetClientIPFunc(clientIP)
 
 	h.Use(
-		mw.RecoveryMW(), // recovery
-		mw.CorsMW(),     // cors
-		mw.GzipMW(),     // gzip
-		mw.SentinelMW(), // sentinel
+		mw.RecoveryMW(),    // recovery
+		mw.CorsMW(),        // cors
+		mw.GzipMW(),        // gzip
+		mw.SentinelMW(),    // sentinel
+		mw.RateLimitMW(),   // rate limit
+		mw.IdempotencyMW(), // idempotency
 	)
 
 	router.GeneratedRegister(h)
+	router.CustomizedRegister(h)
+	mw.RegisterSentinelRoutes(h.Routes())
+	// 网关消费 spu 事件依赖 kafka, redis 的检查项由 GateWayService 注册
+	health.Register(constants.HealthCheckKafka, client.PingKafka)
+	health.Serve()
 	h.Spin()
+
+	// Spin 在等待处理中的请求结束后返回, 接着停止 kafka 消费并关闭 redis 连接池
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ShutdownTimeout)
+	defer cancel()
+	shutdown.Run(ctx)
 }
@@ -18,7 +18,12 @@ package config
 
 import (
 	"errors"
+	"fmt"
 	"os"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/fsnotify/fsnotify"
 	"github.com/spf13/viper"
@@ -28,8 +33,8 @@ import (
 	"github.com/west2-online/DomTok/pkg/logger"
 )
 
+// 以下配置只在启动时映射一次, 数据库, 消息队列等连接在启动时建立, 修改后需要重启服务才能生效
 var (
-	Server        *server
 	Mysql         *mySQL
 	Snowflake     *snowflake
 	Service       *service
@@ -44,7 +49,13 @@ var (
 	Rocketmq      *rocketmq
 	Otel          *otel
 	Administrator *administrator
+	Notifier      *notifier
 	runtimeViper  = viper.New()
+
+	// current 保存最近一次成功映射的完整配置, 支持热更新的配置通过 GetServer 等方法读取
+	current     atomic.Pointer[config]
+	reloadMu    sync.Mutex
+	reloadHooks []func()
 )
 
 const (
@@ -78,26 +89,11 @@ func Init(service string) {
 		}
 		logger.Fatalf("config.Init: read config error: %v", err)
 	}
-	configMapping(service)
-
-	// 设置持续监听
-	runtimeViper.OnConfigChange(func(e fsnotify.Event) {
-		// 我们无法确定监听到配置变更时是否已经初始化完毕，所以此处需要做一个判断
-		logger.Infof("config: notice config changed: %v\n", e.String())
-		configMapping(service) // 重新映射配置
-	})
-	runtimeViper.WatchConfig()
-}
-
-// configMapping 用于将配置映射到全局变量
-func configMapping(srv string) {
-	c := new(config)
-	if err := runtimeViper.Unmarshal(&c); err != nil {
-		// 由于这个函数会在配置重载时被再次触发，所以需要判断日志记录方式
-		logger.Fatalf("config.configMapping: config: unmarshal error: %v", err)
+	c, err := configMapping()
+	if err != nil {
+		logger.Fatalf("config.Init: %v", err)
 	}
 	Snowflake = &c.Snowflake
-	Server = &c.Server
 	Jaeger = &c.Jaeger
 	Mysql = &c.MySQL
 	Redis = &c.Redis
@@ -107,9 +103,108 @@ func configMapping(srv string) {
 	Volcengine = &c.Volcengine
 	Upyun = &c.Upyun
 	Rocketmq = &c.Rocketmq
-	Service = getService(srv)
+	Service = getService(service)
 	Otel = &c.Otel
 	Administrator = &c.Administrator
+	Notifier = &c.Notifier
+	store(c)
+
+	// 设置持续监听
+	runtimeViper.OnConfigChange(func(e fsnotify.Event) {
+		logger.Infof("config: notice config changed: %v\n", e.String())
+		reload()
+	})
+	runtimeViper.WatchConfig()
+	// WatchConfig 只能监听本地文件, etcd 中的配置需要定期拉取
+	go watchRemoteConfig()
+}
+
+// OnReload 注册在配置映射完成后执行的回调, 包括首次读入和之后的每次变更,
+// 用于重建依赖配置的缓存, 如 jwt 密钥轮换后重新解析密钥
+func OnReload(fn func()) {
+	reloadHooks = append(reloadHooks, fn)
+}
+
+// watchRemoteConfig 定期从 etcd 拉取配置, 发生变更时重新映射
+func watchRemoteConfig() {
+	for range time.Tick(constants.ConfigReloadInterval) {
+		reloadRemote()
+	}
+}
+
+func reloadRemote() {
+	reloadMu.Lock()
+	defer reloadMu.Unlock()
+	before := runtimeViper.AllSettings()
+	if err := runtimeViper.WatchRemoteConfig(); err != nil {
+		logger.Errorf("config.watchRemoteConfig: read remote config error: %v", err)
+		return
+	}
+	if reflect.DeepEqual(before, runtimeViper.AllSettings()) {
+		return
+	}
+	logger.Infof("config: notice remote config changed")
+	reloadLocked()
+}
+
+// reload 重新映射配置, 映射失败时保留旧的配置, 不能因为一次错误的推送让所有服务退出
+func reload() {
+	reloadMu.Lock()
+	defer reloadMu.Unlock()
+	reloadLocked()
+}
+
+func reloadLocked() {
+	c, err := configMapping()
+	if err != nil {
+		logger.Errorf("config.reload: keep the previous config: %v", err)
+		return
+	}
+	store(c)
+}
+
+// store 替换当前配置并通知依赖配置的模块
+func store(c *config) {
+	current.Store(c)
+	for _, fn := range reloadHooks {
+		fn()
+	}
+}
+
+// configMapping 将 viper 中的配置映射为新的配置对象
+func configMapping() (*config, error) {
+	c := new(config)
+	if err := runtimeViper.Unmarshal(&c); err != nil {
+		return nil, fmt.Errorf("config.configMapping: unmarshal error: %w", err)
+	}
+	return c, nil
+}
+
+// GetServer 返回当前的服务配置, 包括 jwt 密钥, 支持热更新, 调用方不应修改返回值
+func GetServer() *server {
+	c := current.Load()
+	if c == nil {
+		return nil
+	}
+	return &c.Server
+}
+
+// GetRateLimit 返回当前的网关限流配置, 支持热更新, 调用方不应修改返回值
+func GetRateLimit() *rateLimit {
+	c := current.Load()
+	if c == nil {
+		return nil
+	}
+	return &c.RateLimit
+}
+
+// GetBreaker 返回当前的熔断配置, 支持热更新, 调用方不应修改返回值
+func GetBreaker() *circuitBreaker {
+	c := current.Load()
+	if c == nil {
+		return nil
+	}
+	return &c.Breaker
 }
 
 func getService(name string) *service {
@@ -124,10 +219,11 @@ func getService(name string) *service {
 
 // GetLoggerLevel 会返回服务的日志等级
 func GetLoggerLevel() string {
-	if Server == nil {
+	srv := GetServer()
+	if srv == nil {
 		return constants.DefaultLogLevel
 	}
-	return Server.LogLevel
+	return srv.LogLevel
 }
 
 func GetDataCenterID() int64 {
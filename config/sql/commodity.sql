@@ -107,6 +107,7 @@ CREATE TABLE `spu_info` (
                             `id` BIGINT NOT NULL PRIMARY KEY COMMENT 'SPU ID',
                             `name` VARCHAR(255) NOT NULL COMMENT 'SPU名称',
                             `creator_id` BIGINT NOT NULL COMMENT '创建者ID',
+                            `shop_id` BIGINT NOT NULL COMMENT '所属店铺ID',
                             `description` VARCHAR(255) DEFAULT '' COMMENT '描述',
                             `category_id` BIGINT NOT NULL COMMENT '类别ID',
                             `goods_head_drawing` VARCHAR(512) NOT NULL COMMENT '商品头图 URL',
@@ -116,7 +117,8 @@ CREATE TABLE `spu_info` (
                             `created_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
                             `updated_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
                             `deleted_at` TIMESTAMP COMMENT '删除时间',
-                            INDEX `idx_user_delete_forSale` (`creator_id`, `deleted_at`, `for_sale`)
+                            INDEX `idx_user_delete_forSale` (`creator_id`, `deleted_at`, `for_sale`),
+                            INDEX `idx_shop_id` (`shop_id`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
 
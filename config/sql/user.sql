@@ -6,6 +6,143 @@ CREATE TABLE `users` (
                         `username` VARCHAR(30) NOT NULL COMMENT '用户名最多 10 个中文字符或等长英文字符',
                         `password` VARCHAR(255) NOT NULL COMMENT '数字+字母组合，总长度上限 16',
                         `email` VARCHAR(50) NOT NULL COMMENT '邮箱',
-                        `role` SMALLINT NOT NULL default 0,
-                        `phone` VARCHAR(11) NOT NULL COMMENT '手机号'
+                        `role` SMALLINT NOT NULL default 0 COMMENT '角色: 0 顾客, 1 超级管理员, 2 商家, 3 运营, 4 财务',
+                        `phone` VARCHAR(11) NULL DEFAULT NULL COMMENT '手机号, 没有绑定时为 NULL',
+                        UNIQUE INDEX `uk_users_username` (`username`),
+                        UNIQUE INDEX `uk_users_email` (`email`),
+                        UNIQUE INDEX `uk_users_phone` (`phone`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- 地址表
+CREATE TABLE `address` (
+                        `id` BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT '使用自增主键',
+                        `user_id` BIGINT NOT NULL COMMENT '地址所属用户ID',
+                        `province` VARCHAR(50) NOT NULL COMMENT '省份',
+                        `city` VARCHAR(50) NOT NULL COMMENT '城市',
+                        `detail` VARCHAR(255) NOT NULL COMMENT '详细地址',
+                        `recipient_name` VARCHAR(30) NOT NULL COMMENT '收件人',
+                        `phone` VARCHAR(11) NOT NULL COMMENT '收件人手机号',
+                        `is_default` BOOLEAN NOT NULL DEFAULT FALSE COMMENT '是否为默认地址',
+                        `created_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                        `updated_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+                        `deleted_at` TIMESTAMP NULL DEFAULT NULL COMMENT '删除时间',
+                        INDEX `idx_address_user_id` (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- 店铺表
+CREATE TABLE `shop` (
+                        `id` BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT '使用自增主键',
+                        `name` VARCHAR(30) NOT NULL COMMENT '店铺名称',
+                        `logo` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '店铺 logo 的 url',
+                        `description` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '店铺简介',
+                        `status` SMALLINT NOT NULL DEFAULT 0 COMMENT '店铺状态: 0 营业中, 1 已关闭',
+                        `owner_id` BIGINT NOT NULL COMMENT '店主的用户ID',
+                        `created_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                        `updated_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+                        INDEX `idx_shop_owner_id` (`owner_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- 店铺成员表, 店主同样会作为成员记录在这里
+CREATE TABLE `shop_staff` (
+                        `id` BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT '使用自增主键',
+                        `shop_id` BIGINT NOT NULL COMMENT '店铺ID',
+                        `user_id` BIGINT NOT NULL COMMENT '成员的用户ID',
+                        `role` SMALLINT NOT NULL DEFAULT 0 COMMENT '店铺内角色: 0 店员, 1 店主',
+                        `created_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '加入时间',
+                        UNIQUE INDEX `uk_shop_staff` (`shop_id`, `user_id`),
+                        INDEX `idx_shop_staff_user_id` (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- 封禁记录表, 解封后记录会保留作为封禁历史
+CREATE TABLE `user_ban` (
+                        `id` BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT '使用自增主键',
+                        `user_id` BIGINT NOT NULL COMMENT '被封禁的用户ID',
+                        `reason` VARCHAR(255) NOT NULL COMMENT '封禁原因',
+                        `operator_id` BIGINT NOT NULL COMMENT '执行封禁的管理员ID',
+                        `start_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '封禁开始时间',
+                        `end_at` TIMESTAMP NULL DEFAULT NULL COMMENT '封禁结束时间, NULL 表示永久封禁',
+                        `lifted_at` TIMESTAMP NULL DEFAULT NULL COMMENT '手动解封时间, NULL 表示没有被手动解封',
+                        `lifted_by` BIGINT NOT NULL DEFAULT 0 COMMENT '执行解封的管理员ID',
+                        INDEX `idx_user_ban_user_id` (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- 两步验证表, 每个用户最多一条, enabled 为 FALSE 时表示用户还没有完成绑定确认
+CREATE TABLE `user_totp` (
+                        `user_id` BIGINT NOT NULL PRIMARY KEY COMMENT '用户ID',
+                        `secret` VARCHAR(64) NOT NULL COMMENT 'base32 编码的 TOTP 密钥',
+                        `enabled` BOOLEAN NOT NULL DEFAULT FALSE COMMENT '是否已经完成绑定确认',
+                        `last_used_step` BIGINT NOT NULL DEFAULT 0 COMMENT '最近一次校验通过的时间步, 用于防止验证码被重复使用',
+                        `created_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                        `updated_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间'
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- 两步验证的恢复码, 只保存 sha256 摘要, 每个恢复码只能使用一次
+CREATE TABLE `user_recovery_code` (
+                        `id` BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT '使用自增主键',
+                        `user_id` BIGINT NOT NULL COMMENT '用户ID',
+                        `code_hash` CHAR(64) NOT NULL COMMENT '恢复码的 sha256 摘要',
+                        `used_at` TIMESTAMP NULL DEFAULT NULL COMMENT '使用时间, NULL 表示还没有被使用',
+                        INDEX `idx_user_recovery_code_user_id` (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- 商家的 API Key, 只保存 sha256 摘要, 吊销时直接删除
+CREATE TABLE `merchant_api_key` (
+                        `id` BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT '使用自增主键',
+                        `user_id` BIGINT NOT NULL COMMENT '持有者的用户ID',
+                        `name` VARCHAR(30) NOT NULL COMMENT '便于用户区分的名称',
+                        `prefix` VARCHAR(16) NOT NULL COMMENT 'key 的前几位, 用于在列表中展示',
+                        `key_hash` CHAR(64) NOT NULL COMMENT 'key 的 sha256 摘要',
+                        `scopes` VARCHAR(255) NOT NULL COMMENT '逗号分隔的 scope 列表',
+                        `created_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                        UNIQUE INDEX `uk_merchant_api_key_hash` (`key_hash`),
+                        INDEX `idx_merchant_api_key_user_id` (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE `audit_log` (
+                        `id` BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT '使用自增主键',
+                        `event_id` CHAR(32) NOT NULL COMMENT '审计事件ID, 用于消费时去重',
+                        `actor_id` BIGINT NOT NULL COMMENT '执行操作的用户ID',
+                        `action` VARCHAR(64) NOT NULL COMMENT '操作类型',
+                        `target` VARCHAR(64) NOT NULL COMMENT '被操作的对象',
+                        `before` TEXT NOT NULL COMMENT '操作前的快照',
+                        `after` TEXT NOT NULL COMMENT '操作后的快照',
+                        `client_ip` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '客户端IP',
+                        `trace_id` CHAR(32) NOT NULL DEFAULT '' COMMENT '链路追踪ID',
+                        `created_at` DATETIME(3) NOT NULL COMMENT '操作时间',
+                        UNIQUE INDEX `uk_audit_log_event_id` (`event_id`),
+                        INDEX `idx_audit_log_actor_id` (`actor_id`, `created_at`),
+                        INDEX `idx_audit_log_action` (`action`, `created_at`),
+                        INDEX `idx_audit_log_created_at` (`created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='管理员审计日志, 只追加不修改';
+
+-- 商家 webhook 端点表, 连续投递失败达到阈值后会被自动停用
+CREATE TABLE `webhook_endpoint` (
+                        `id` BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT '使用自增主键',
+                        `shop_id` BIGINT NOT NULL COMMENT '店铺ID',
+                        `url` VARCHAR(512) NOT NULL COMMENT '接收事件的地址',
+                        `secret` CHAR(64) NOT NULL COMMENT '签名密钥',
+                        `event_types` VARCHAR(255) NOT NULL COMMENT '订阅的事件类型, 逗号分隔',
+                        `status` SMALLINT NOT NULL DEFAULT 0 COMMENT '端点状态: 0 启用, 1 停用',
+                        `consecutive_failures` BIGINT NOT NULL DEFAULT 0 COMMENT '连续投递失败的次数',
+                        `created_at` DATETIME(3) NOT NULL COMMENT '创建时间',
+                        `updated_at` DATETIME(3) NOT NULL COMMENT '更新时间',
+                        INDEX `idx_webhook_endpoint_shop_id` (`shop_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- webhook 投递记录表, 同时作为投递日志和重试队列
+CREATE TABLE `webhook_delivery` (
+                        `id` BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT '使用自增主键',
+                        `endpoint_id` BIGINT NOT NULL COMMENT '端点ID',
+                        `event_id` CHAR(32) NOT NULL COMMENT '事件ID, 用于消费时去重',
+                        `event_type` VARCHAR(64) NOT NULL COMMENT '事件类型',
+                        `payload` TEXT NOT NULL COMMENT '投递的请求体',
+                        `status` SMALLINT NOT NULL DEFAULT 0 COMMENT '投递状态: 0 等待投递, 1 投递成功, 2 投递失败',
+                        `attempts` BIGINT NOT NULL DEFAULT 0 COMMENT '已经尝试投递的次数',
+                        `next_retry_at` BIGINT NOT NULL DEFAULT 0 COMMENT '下次投递的毫秒时间戳',
+                        `last_status_code` BIGINT NOT NULL DEFAULT 0 COMMENT '最近一次投递的响应状态码',
+                        `last_error` VARCHAR(1024) NOT NULL DEFAULT '' COMMENT '最近一次投递的错误信息',
+                        `created_at` DATETIME(3) NOT NULL COMMENT '创建时间',
+                        `updated_at` DATETIME(3) NOT NULL COMMENT '更新时间',
+                        UNIQUE INDEX `uk_webhook_delivery_event` (`endpoint_id`, `event_id`),
+                        INDEX `idx_webhook_delivery_due` (`status`, `next_retry_at`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
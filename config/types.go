@@ -17,12 +17,22 @@ limitations under the License.
 package config
 
 type server struct {
-	Secret      string `mapstructure:"private-key"`
-	PublicKey   string `mapstructure:"public-key"`
-	Version     string
-	Name        string
-	LogLevel    string `mapstructure:"log-level"`
-	IntranetUrl string `mapstructure:"intranet-url"`
+	Secret         string   `mapstructure:"private-key"` // 旧的单密钥配置, kid 为 default
+	PublicKey      string   `mapstructure:"public-key"`
+	SigningKeyID   string   `mapstructure:"signing-key-id"` // 当前用于签发 token 的密钥, 为空时使用旧的单密钥
+	JWTKeys        []JWTKey `mapstructure:"jwt-keys"`
+	Version        string
+	Name           string
+	LogLevel       string   `mapstructure:"log-level"`
+	IntranetUrl    string   `mapstructure:"intranet-url"`
+	TrustedProxies []string `mapstructure:"trusted-proxies"` // 网关前反向代理的网段, 只信任来自这些地址的 X-Forwarded-For
+}
+
+// JWTKey 是一个 jwt 签名密钥, 退役的密钥只需要保留公钥, 用于校验退役前签发且还没有过期的 token
+type JWTKey struct {
+	ID         string `mapstructure:"kid"`
+	PrivateKey string `mapstructure:"private-key"` // PKCS#8, 只有当前签发 token 的密钥需要配置
+	PublicKey  string `mapstructure:"public-key"`
 }
 
 type snowflake struct {
@@ -143,8 +153,56 @@ type config struct {
 	Rocketmq      rocketmq
 	Otel          otel
 	Administrator administrator
+	Notifier      notifier
+	RateLimit     rateLimit      `mapstructure:"rate-limit"`
+	Breaker       circuitBreaker `mapstructure:"circuit-breaker"`
 }
 
 type administrator struct {
 	Secret string
 }
+
+// notifier 配置验证码等通知的投递方式, Type 为 log 时打印到日志, 为 file 时追加写入 Path 指定的文件
+type notifier struct {
+	Type string
+	Path string
+}
+
+// rateLimit 网关限流配置, 支持热更新
+// QPS 是 sentinel 的单机阈值, 用于保护网关自身; IP 和 User 是基于 redis 的令牌桶, 在所有网关实例间共享
+type rateLimit struct {
+	QPS    float64     `mapstructure:"qps"` // 每个路由默认的单机 QPS 阈值
+	IP     tokenBucket `mapstructure:"ip"`
+	User   tokenBucket `mapstructure:"user"`
+	Routes []routeRateLimit
+}
+
+// tokenBucket 令牌桶每秒补充 Rate 个令牌, 最多积攒 Burst 个, Rate 不大于 0 时不限流
+type tokenBucket struct {
+	Rate  float64
+	Burst int
+}
+
+// routeRateLimit 覆盖单个路由的限流规则, 未配置的字段沿用默认值
+type routeRateLimit struct {
+	Route string      // 请求方法和 hertz 完整路由, 例如 POST /api/v1/order/create
+	QPS   float64     `mapstructure:"qps"`
+	IP    tokenBucket `mapstructure:"ip"`
+	User  tokenBucket `mapstructure:"user"`
+}
+
+// circuitBreaker 网关调用下游服务的熔断配置, 以 服务/方法 为粒度熔断, 支持热更新
+// 统计窗口内的请求数达到 MinSample 且错误率达到 ErrRate 时熔断, 未配置时使用 kitex 的默认值
+type circuitBreaker struct {
+	ErrRate   float64 `mapstructure:"err-rate"`
+	MinSample int64   `mapstructure:"min-sample"`
+	Methods   []methodCircuitBreaker
+}
+
+// methodCircuitBreaker 覆盖单个方法的熔断规则, Method 的格式为 服务名/方法名, 例如 commodity/ViewSpu
+type methodCircuitBreaker struct {
+	Method    string
+	Disable   bool
+	ErrRate   float64 `mapstructure:"err-rate"`
+	MinSample int64   `mapstructure:"min-sample"`
+}
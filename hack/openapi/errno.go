@@ -0,0 +1,92 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// errnoFiles 是定义错误码的文件, 它们只包含常量, 可以单独做类型检查以计算 iota
+var errnoFiles = []string{"code.go", "code_service.go"}
+
+type errnoCode struct {
+	Code        int64
+	Name        string
+	Description string
+}
+
+// loadErrnoCodes 从 errno 包中读取所有的整数错误码, 注释作为描述
+func loadErrnoCodes(dir string) ([]errnoCode, error) {
+	fset := token.NewFileSet()
+	files := make([]*ast.File, 0, len(errnoFiles))
+	for _, name := range errnoFiles {
+		f, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.ParseComments)
+		if err != nil {
+			return nil, fmt.Errorf("parse %s failed: %w", name, err)
+		}
+		files = append(files, f)
+	}
+
+	defs := &types.Info{Defs: make(map[*ast.Ident]types.Object)}
+	if _, err := new(types.Config).Check("errno", fset, files, defs); err != nil {
+		return nil, fmt.Errorf("type check errno failed: %w", err)
+	}
+
+	var codes []errnoCode
+	for _, f := range files {
+		for _, decl := range f.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.CONST {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				vs := spec.(*ast.ValueSpec) //nolint:forcetypeassert
+				for _, ident := range vs.Names {
+					c, ok := defs.Defs[ident].(*types.Const)
+					if !ok || c.Val().Kind() != constant.Int {
+						continue
+					}
+					code, _ := constant.Int64Val(c.Val())
+					codes = append(codes, errnoCode{
+						Code:        code,
+						Name:        ident.Name,
+						Description: commentText(vs.Comment, vs.Doc),
+					})
+				}
+			}
+		}
+	}
+	sort.SliceStable(codes, func(i, j int) bool { return codes[i].Code < codes[j].Code })
+	return codes, nil
+}
+
+func commentText(groups ...*ast.CommentGroup) string {
+	for _, g := range groups {
+		if text := strings.TrimSpace(g.Text()); text != "" {
+			return text
+		}
+	}
+	return ""
+}
@@ -0,0 +1,403 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// openapi 根据 idl/api 中的 thrift 定义生成网关的 OpenAPI 3 文档, 通过 make openapi 执行
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cloudwego/thriftgo/parser"
+)
+
+var (
+	idlDir   = flag.String("idl", "idl/api", "directory of the gateway thrift files")
+	errnoDir = flag.String("errno", "pkg/errno", "directory of the errno package")
+	output   = flag.String("o", "app/gateway/handler/docs/openapi.json", "output file")
+)
+
+// httpMethods 是 hz 支持的路由注解, 按照 OpenAPI 中的顺序排列
+var httpMethods = []string{"get", "put", "post", "delete", "patch"}
+
+// paramAnnotations 是 hz 中指定参数位置的注解, 其余字段按照请求方法放在 query 或者请求体中
+var paramAnnotations = [][2]string{
+	{"api.query", "query"},
+	{"api.header", "header"},
+	{"api.path", "path"},
+	{"api.cookie", "cookie"},
+}
+
+func main() {
+	flag.Parse()
+
+	codes, err := loadErrnoCodes(*errnoDir)
+	if err != nil {
+		fatal(err)
+	}
+	g := newGenerator(codes)
+
+	files, err := filepath.Glob(filepath.Join(*idlDir, "*.thrift"))
+	if err != nil {
+		fatal(err)
+	}
+	sort.Strings(files)
+	for _, file := range files {
+		ast, err := parser.ParseFile(file, nil, true)
+		if err != nil {
+			fatal(fmt.Errorf("parse %s failed: %w", file, err))
+		}
+		g.addThrift(ast)
+	}
+
+	data, err := g.marshal()
+	if err != nil {
+		fatal(err)
+	}
+	if err = os.WriteFile(*output, data, 0o644); err != nil { //nolint:gosec
+		fatal(err)
+	}
+}
+
+func fatal(err error) {
+	fmt.Fprintln(os.Stderr, "openapi:", err)
+	os.Exit(1)
+}
+
+type generator struct {
+	doc *document
+	// structs 保存所有 thrift 文件中的结构体, key 为 文件名.结构体名, 只有被接口引用到的结构体才会生成 schema
+	structs map[string]*parser.StructLike
+	visited map[string]bool
+	pending []string
+}
+
+func newGenerator(codes []errnoCode) *generator {
+	doc := &document{
+		OpenAPI: "3.0.3",
+		Info: info{
+			Title:   "DomTok Gateway API",
+			Version: "1.0",
+			Description: "由 idl/api 中的 thrift 定义生成, 请不要手动修改, 使用 make openapi 重新生成\n\n" +
+				"所有响应都使用 BaseResp 信封, code 为 errno 错误码, 业务数据在 data 中",
+		},
+		Paths: make(map[string]pathItem),
+		Components: components{
+			Schemas: map[string]*schema{
+				"BaseResp":  baseRespSchema(),
+				"ErrorCode": errorCodeSchema(codes),
+			},
+			Parameters: map[string]*parameter{
+				"IdempotencyKey": {
+					Name:        "Idempotency-Key",
+					In:          "header",
+					Description: "重试时携带相同的值, 已完成的请求会重放保存的响应, 处理中的请求返回 409",
+					Schema:      &schema{Type: "string"},
+				},
+			},
+			Responses: map[string]*response{
+				"TooManyRequests": {
+					Description: "请求被网关限流",
+					Headers: map[string]*header{
+						"Retry-After": {Description: "至少需要等待的秒数", Schema: &schema{Type: "integer"}},
+					},
+					Content: jsonContent(refSchema("BaseResp")),
+				},
+			},
+			SecuritySchemes: map[string]*securityScheme{
+				"AccessToken": {Type: "apiKey", In: "header", Name: "Authorization", Description: "登录或刷新时返回的 access token"},
+				"ApiKey":      {Type: "apiKey", In: "header", Name: "X-Api-Key", Description: "商家 API Key, 只能访问授权范围内的接口"},
+			},
+		},
+		// 空的要求表示接口也可以匿名访问, 具体是否需要登录以接口说明为准
+		Security: []map[string][]string{{}, {"AccessToken": {}}, {"ApiKey": {}}},
+	}
+	return &generator{
+		doc:     doc,
+		structs: make(map[string]*parser.StructLike),
+		visited: make(map[string]bool),
+	}
+}
+
+// baseRespSchema 是 model.BaseResp 在网关中的 json 形式, 错误码会被序列化为字符串
+func baseRespSchema() *schema {
+	return &schema{
+		Type:        "object",
+		Description: "model.BaseResp, 网关所有响应的信封",
+		Properties: map[string]*schema{
+			"code":    {Ref: "#/components/schemas/ErrorCode"},
+			"message": {Type: "string"},
+		},
+		Required: []string{"code", "message"},
+	}
+}
+
+func errorCodeSchema(codes []errnoCode) *schema {
+	s := &schema{Type: "string", Description: "errno 错误码, SuccessCode 表示成功"}
+	for _, c := range codes {
+		s.Enum = append(s.Enum, strconv.FormatInt(c.Code, 10))
+		s.EnumVarNames = append(s.EnumVarNames, c.Name)
+		s.EnumDescriptions = append(s.EnumDescriptions, c.Description)
+	}
+	return s
+}
+
+func (g *generator) addThrift(ast *parser.Thrift) {
+	g.collectStructs(ast)
+	prefix := fileName(ast)
+	for _, svc := range ast.Services {
+		g.doc.Tags = append(g.doc.Tags, tag{Name: prefix, Description: svc.Name})
+		for _, fn := range svc.Functions {
+			for _, method := range httpMethods {
+				for _, path := range annotation(fn.Annotations, "api."+method) {
+					g.addOperation(prefix, method, path, fn)
+				}
+			}
+		}
+	}
+}
+
+func (g *generator) collectStructs(ast *parser.Thrift) {
+	prefix := fileName(ast)
+	if g.visited[ast.Filename] {
+		return
+	}
+	g.visited[ast.Filename] = true
+	for _, s := range ast.Structs {
+		g.structs[prefix+"."+s.Name] = s
+	}
+	for _, inc := range ast.Includes {
+		if inc.Reference != nil {
+			g.collectStructs(inc.Reference)
+		}
+	}
+}
+
+func (g *generator) addOperation(prefix, method, path string, fn *parser.Function) {
+	path = "/" + strings.TrimPrefix(path, "/")
+	op := &operation{
+		OperationID: fn.Name,
+		Summary:     fn.Name,
+		Tags:        []string{prefix},
+		Responses: map[string]*response{
+			"200": {
+				Description: "成功时 code 为 SuccessCode, 失败时没有 data",
+				Content: jsonContent(&schema{AllOf: []*schema{
+					refSchema("BaseResp"),
+					{Type: "object", Properties: map[string]*schema{"data": g.typeSchema(prefix, fn.FunctionType)}},
+				}}),
+			},
+			"429": {Ref: "#/components/responses/TooManyRequests"},
+		},
+	}
+	if method != "get" {
+		op.Parameters = append(op.Parameters, &parameter{Ref: "#/components/parameters/IdempotencyKey"})
+	}
+
+	if len(fn.Arguments) > 0 {
+		g.addRequest(op, prefix, method, fn.Arguments[0].Type)
+	}
+
+	if g.doc.Paths[path] == nil {
+		g.doc.Paths[path] = make(pathItem)
+	}
+	g.doc.Paths[path][method] = op
+}
+
+// addRequest get 和 delete 请求的字段作为 query 参数, 其余请求的字段放在请求体中
+// 通过注解指定了位置的字段同时也会出现在请求体的定义中, 以保持和 thrift 定义一致
+func (g *generator) addRequest(op *operation, prefix, method string, t *parser.Type) {
+	name, s := g.lookup(prefix, t.Name)
+	if s == nil {
+		return
+	}
+	inQuery := method == "get" || method == "delete"
+	hasBody, bodyRequired := false, false
+	for _, f := range s.Fields {
+		fieldName, in := fieldLocation(f)
+		if in == "" && inQuery {
+			in = "query"
+		}
+		required := f.Requiredness == parser.FieldType_Required
+		if in == "" {
+			hasBody = true
+			bodyRequired = bodyRequired || required
+			continue
+		}
+		fs := g.typeSchema(prefixOf(name), f.Type)
+		op.Parameters = append(op.Parameters, &parameter{
+			Name:        fieldName,
+			In:          in,
+			Description: comment(f.ReservedComments),
+			Required:    required || in == "path",
+			Schema:      fs,
+		})
+	}
+	if !hasBody {
+		return
+	}
+	g.define(name)
+	body := refSchema(name)
+	op.RequestBody = &requestBody{
+		Required: bodyRequired,
+		Content: map[string]*mediaType{
+			"application/json":                  {Schema: body},
+			"application/x-www-form-urlencoded": {Schema: body},
+			"multipart/form-data":               {Schema: body},
+		},
+	}
+}
+
+// fieldLocation 返回字段在请求中的名称和位置, 位置为空时由请求方法决定
+func fieldLocation(f *parser.Field) (string, string) {
+	for _, a := range paramAnnotations {
+		if v := annotation(f.Annotations, a[0]); len(v) > 0 {
+			return v[0], a[1]
+		}
+	}
+	for _, key := range []string{"api.body", "api.form"} {
+		if v := annotation(f.Annotations, key); len(v) > 0 {
+			return v[0], ""
+		}
+	}
+	return f.Name, ""
+}
+
+// typeSchema 把 thrift 类型转换为 schema, 结构体会引用 components 中的定义
+func (g *generator) typeSchema(prefix string, t *parser.Type) *schema {
+	switch t.Name {
+	case "bool":
+		return &schema{Type: "boolean"}
+	case "byte", "i8", "i16", "i32":
+		return &schema{Type: "integer", Format: "int32"}
+	case "i64":
+		return &schema{Type: "integer", Format: "int64"}
+	case "double":
+		return &schema{Type: "number", Format: "double"}
+	case "string":
+		return &schema{Type: "string"}
+	case "binary":
+		return &schema{Type: "string", Format: "binary"}
+	case "list", "set":
+		return &schema{Type: "array", Items: g.typeSchema(prefix, t.ValueType)}
+	case "map":
+		return &schema{Type: "object", AdditionalProperties: g.typeSchema(prefix, t.ValueType)}
+	}
+	name, s := g.lookup(prefix, t.Name)
+	if s == nil {
+		return &schema{Type: "object"}
+	}
+	g.define(name)
+	return refSchema(name)
+}
+
+// lookup 查找结构体, 没有前缀的类型名属于当前文件
+func (g *generator) lookup(prefix, typeName string) (string, *parser.StructLike) {
+	name := typeName
+	if !strings.Contains(typeName, ".") {
+		name = prefix + "." + typeName
+	}
+	return name, g.structs[name]
+}
+
+func (g *generator) define(name string) {
+	if _, ok := g.doc.Components.Schemas[name]; ok {
+		return
+	}
+	// 先占位, 避免结构体递归引用时无限展开
+	g.doc.Components.Schemas[name] = nil
+	g.pending = append(g.pending, name)
+}
+
+func (g *generator) resolvePending() {
+	for len(g.pending) > 0 {
+		name := g.pending[0]
+		g.pending = g.pending[1:]
+		s := g.structs[name]
+		prefix := prefixOf(name)
+		obj := &schema{Type: "object", Description: comment(s.ReservedComments), Properties: make(map[string]*schema)}
+		for _, f := range s.Fields {
+			fieldName, _ := fieldLocation(f)
+			fs := g.typeSchema(prefix, f.Type)
+			if fs.Ref == "" {
+				fs.Description = comment(f.ReservedComments)
+			}
+			obj.Properties[fieldName] = fs
+			if f.Requiredness == parser.FieldType_Required {
+				obj.Required = append(obj.Required, fieldName)
+			}
+		}
+		g.doc.Components.Schemas[name] = obj
+	}
+}
+
+func (g *generator) marshal() ([]byte, error) {
+	g.resolvePending()
+	buf := new(bytes.Buffer)
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(g.doc); err != nil {
+		return nil, fmt.Errorf("marshal openapi document failed: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func jsonContent(s *schema) map[string]*mediaType {
+	return map[string]*mediaType{"application/json": {Schema: s}}
+}
+
+func annotation(annotations parser.Annotations, key string) []string {
+	for _, a := range annotations {
+		if a.Key == key {
+			return a.Values
+		}
+	}
+	return nil
+}
+
+// comment 去掉 thrift 注释中的注释符号, 多行注释使用换行连接
+func comment(raw string) string {
+	raw = strings.TrimSpace(raw)
+	raw = strings.TrimSuffix(strings.TrimPrefix(raw, "/*"), "*/")
+	lines := strings.Split(raw, "\n")
+	res := make([]string, 0, len(lines))
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		line = strings.TrimLeft(line, "/#*")
+		if line = strings.TrimSpace(line); line != "" {
+			res = append(res, line)
+		}
+	}
+	return strings.Join(res, "\n")
+}
+
+func fileName(ast *parser.Thrift) string {
+	return strings.TrimSuffix(filepath.Base(ast.Filename), ".thrift")
+}
+
+// prefixOf 返回 schema 名称中的文件名部分
+func prefixOf(name string) string {
+	prefix, _, _ := strings.Cut(name, ".")
+	return prefix
+}
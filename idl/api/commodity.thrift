@@ -69,6 +69,7 @@ struct CreateSpuReq {
     4: required double price;
     5: required i32 forSale;
     6: required double shipping;
+    7: required i64 shopID;
 }
 
 struct CreateSpuResp {
@@ -66,10 +66,45 @@ struct DeleteOrderResp {
     1: required model.BaseResp base;
 }
 
+struct ViewOrderDetailReq {
+    1: required i64 orderID;
+}
+
+// 聚合接口中单个分区的错误, 分区失败不影响其他分区的返回
+struct SectionError {
+    1: required i64 code;
+    2: required string msg;
+}
+
+struct OrderAddressSection {
+    1: optional model.AddressInfo address; // 收货地址
+    2: optional SectionError error;
+}
+
+struct OrderPaymentSection {
+    1: optional model.PaymentStatusInfo payment; // 支付单, 尚未发起支付时为空
+    2: optional model.RefundStatusInfo refund; // 退款单, 没有退款时为空
+    3: optional SectionError error;
+}
+
+struct OrderSkuImageSection {
+    1: required i64 skuID; // 即订单商品的 styleId
+    2: optional list<model.SkuImage> images;
+    3: optional SectionError error;
+}
+
+struct ViewOrderDetailResp {
+    1: required model.orderWithGoods order; // 订单本身查询失败时整个接口返回错误
+    2: required OrderAddressSection address;
+    3: required OrderPaymentSection payment;
+    4: required list<OrderSkuImageSection> skuImages;
+}
+
 service OrderService {
     CreateOrderResp CreateOrder(1:CreateOrderReq req) (api.post="/api/v1/order/create")
     ViewOrderListResp ViewOrderList(1:ViewOrderListReq req) (api.get="/api/v1/order/list")
     ViewOrderResp ViewOrder(1:ViewOrderReq req) (api.get="/api/v1/order/view")
+    ViewOrderDetailResp ViewOrderDetail(1:ViewOrderDetailReq req) (api.get="/api/v1/order/detail")
     CancelOrderResp CancelOrder(1:CancelOrderReq req) (api.delete="/api/v1/order/cancel")
     ChangeDeliverAddressResp ChangeDeliverAddress(1:ChangeDeliverAddressReq req) (api.put="/api/v1/order/change-address")
     DeleteOrderResp DeleteOrder(1:DeleteOrderReq req) (api.delete="/api/v1/order/delete")
@@ -15,12 +15,20 @@ struct RegisterResponse {
 struct LoginRequest {
     1: required string name
     2: required string password
+    3: optional string totp_code // 开启了两步验证的用户需要提供动态验证码或恢复码
 }
 
 struct LoginResponse {
     2: model.UserInfo user,
 }
 
+struct RefreshTokenRequest {
+    1: required string refresh_token
+}
+
+struct RefreshTokenResponse {
+}
+
 struct GetAddressRequest {
     1: required i64 address_id
 }
@@ -33,14 +41,52 @@ struct AddAddressRequest {
     1: required string province
     2: required string city
     3: required string detail
+    4: required string recipient_name
+    5: required string phone
+    6: optional bool is_default
 }
 
 struct AddAddressResponse {
     1: required i64 address_id
 }
 
+struct ListAddressRequest {
+}
+
+struct ListAddressResponse {
+    1: list<model.AddressInfo> addresses,
+}
+
+struct UpdateAddressRequest {
+    1: required i64 address_id
+    2: optional string province
+    3: optional string city
+    4: optional string detail
+    5: optional string recipient_name
+    6: optional string phone
+}
+
+struct UpdateAddressResponse {
+}
+
+struct DeleteAddressRequest {
+    1: required i64 address_id
+}
+
+struct DeleteAddressResponse {
+}
+
+struct SetDefaultAddressRequest {
+    1: required i64 address_id
+}
+
+struct SetDefaultAddressResponse {
+}
+
 struct BanUserReq {
     1: required i64 uid
+    2: required string reason
+    3: optional i64 duration
 }
 
 struct BanUserResp {
@@ -54,6 +100,22 @@ struct LiftBanUserResp {
 
 }
 
+struct ListBanHistoryRequest {
+    1: required i64 uid
+}
+
+struct ListBanHistoryResponse {
+    1: list<model.BanInfo> bans,
+}
+
+struct UnlockUserReq {
+    1: required i64 uid
+}
+
+struct UnlockUserResp {
+
+}
+
 struct LogoutReq {
 }
 
@@ -61,6 +123,26 @@ struct LogoutResp {
 
 }
 
+struct ListSessionsRequest {
+}
+
+struct ListSessionsResponse {
+    1: list<model.SessionInfo> sessions,
+}
+
+struct RevokeSessionRequest {
+    1: required string session_id
+}
+
+struct RevokeSessionResponse {
+}
+
+struct RevokeAllSessionsRequest {
+}
+
+struct RevokeAllSessionsResponse {
+}
+
 struct SetAdministratorReq {
     1: required i64 uid
     2: required string password
@@ -71,14 +153,275 @@ struct SetAdministratorResp {
     1: required model.BaseResp base,
 }
 
+struct CreateShopRequest {
+    1: required string name
+    2: optional string logo
+    3: optional string description
+}
+
+struct CreateShopResponse {
+    1: required i64 shop_id
+}
+
+struct UpdateShopRequest {
+    1: required i64 shop_id
+    2: optional string name
+    3: optional string logo
+    4: optional string description
+}
+
+struct UpdateShopResponse {
+}
+
+struct CloseShopRequest {
+    1: required i64 shop_id
+}
+
+struct CloseShopResponse {
+}
+
+struct GetShopRequest {
+    1: required i64 shop_id
+}
+
+struct GetShopResponse {
+    1: model.ShopInfo shop,
+}
+
+struct AddShopStaffRequest {
+    1: required i64 shop_id
+    2: required i64 uid
+}
+
+struct AddShopStaffResponse {
+}
+
+struct RemoveShopStaffRequest {
+    1: required i64 shop_id
+    2: required i64 uid
+}
+
+struct RemoveShopStaffResponse {
+}
+
+struct ListShopStaffRequest {
+    1: required i64 shop_id
+}
+
+struct ListShopStaffResponse {
+    1: list<model.ShopStaffInfo> staff,
+}
+
+struct UpdateProfileRequest {
+    1: optional string name
+    2: optional string email
+    3: optional string phone
+    4: optional string password
+}
+
+struct UpdateProfileResponse {
+    1: model.UserInfo user,
+}
+
+struct ChangePasswordRequest {
+    1: required string old_password
+    2: required string new_password
+}
+
+struct ChangePasswordResponse {
+}
+
+struct RequestPasswordResetRequest {
+    1: required string name
+}
+
+struct RequestPasswordResetResponse {
+}
+
+struct ResetPasswordRequest {
+    1: required string name
+    2: required string code
+    3: required string new_password
+}
+
+struct ResetPasswordResponse {
+}
+
+struct ExportUserDataRequest {
+}
+
+struct ExportUserDataResponse {
+}
+
+struct CloseAccountRequest {
+    1: required string password
+}
+
+struct CloseAccountResponse {
+}
+
+struct EnrollTOTPRequest {
+    1: required string password
+}
+
+struct EnrollTOTPResponse {
+    1: required string secret
+    2: required string uri
+    3: required list<string> recovery_codes
+}
+
+struct ConfirmTOTPRequest {
+    1: required string code
+}
+
+struct ConfirmTOTPResponse {
+}
+
+struct DisableTOTPRequest {
+    1: required string password
+    2: required string code
+}
+
+struct DisableTOTPResponse {
+}
+
+struct CreateApiKeyRequest {
+    1: required string name
+    2: required list<string> scopes // 可选值: commodity, coupon
+}
+
+struct CreateApiKeyResponse {
+    1: required string key
+    2: required model.ApiKeyInfo info
+}
+
+struct ListApiKeysRequest {
+}
+
+struct ListApiKeysResponse {
+    1: list<model.ApiKeyInfo> keys
+}
+
+struct RevokeApiKeyRequest {
+    1: required i64 key_id
+}
+
+struct RevokeApiKeyResponse {
+}
+
+struct ListAuditLogsRequest {
+    1: optional i64 actor_id
+    2: optional string action
+    3: optional i64 start_time // 毫秒时间戳
+    4: optional i64 end_time
+    5: optional i64 page_num
+    6: optional i64 page_size
+}
+
+struct ListAuditLogsResponse {
+    1: list<model.AuditLogInfo> logs
+    2: i64 total
+}
+
+struct CreateWebhookEndpointRequest {
+    1: required i64 shop_id
+    2: required string url // 只支持 http 和 https
+    3: required list<string> event_types // 可选值: order.paid, order.canceled, refund.approved, refund.rejected
+}
+
+struct CreateWebhookEndpointResponse {
+    1: required string secret // 用于校验请求签名, 只会在创建时返回一次
+    2: required model.WebhookEndpointInfo endpoint
+}
+
+struct ListWebhookEndpointsRequest {
+    1: required i64 shop_id
+}
+
+struct ListWebhookEndpointsResponse {
+    1: list<model.WebhookEndpointInfo> endpoints
+}
+
+struct UpdateWebhookEndpointRequest {
+    1: required i64 endpoint_id
+    2: required string url
+    3: required list<string> event_types
+    4: required bool enabled // 重新启用被自动停用的端点时会清零连续失败次数
+}
+
+struct UpdateWebhookEndpointResponse {
+}
+
+struct DeleteWebhookEndpointRequest {
+    1: required i64 endpoint_id
+}
+
+struct DeleteWebhookEndpointResponse {
+}
+
+struct ListWebhookDeliveriesRequest {
+    1: required i64 endpoint_id
+    2: optional i64 page_num
+    3: optional i64 page_size
+}
+
+struct ListWebhookDeliveriesResponse {
+    1: list<model.WebhookDeliveryInfo> deliveries
+    2: i64 total
+}
+
+struct RedeliverWebhookRequest {
+    1: required i64 delivery_id
+}
+
+struct RedeliverWebhookResponse {
+    1: model.WebhookDeliveryInfo delivery
+}
+
 service UserService {
     RegisterResponse Register(1: RegisterRequest req)(api.post = "api/v1/user/register"),
     LoginResponse Login(1: LoginRequest req)(api.post = "api/v1/user/login")
+    RefreshTokenResponse RefreshToken(1: RefreshTokenRequest req)(api.post = "api/v1/user/refresh")
     GetAddressResponse GetAddress(1: GetAddressRequest req)(api.get = "api/v1/user/location"),
     AddAddressResponse AddAddress(1: AddAddressRequest req)(api.post = "api/v1/user/address"),
+    ListAddressResponse ListAddress(1: ListAddressRequest req)(api.get = "api/v1/user/address/list"),
+    UpdateAddressResponse UpdateAddress(1: UpdateAddressRequest req)(api.put = "api/v1/user/address/update"),
+    DeleteAddressResponse DeleteAddress(1: DeleteAddressRequest req)(api.delete = "api/v1/user/address/delete"),
+    SetDefaultAddressResponse SetDefaultAddress(1: SetDefaultAddressRequest req)(api.put = "api/v1/user/address/default"),
     BanUserResp BanUser(1: BanUserReq req) (api.post="api/v1/user/ban"),
     LiftBanUserResp LiftBandUser(1: LiftBanUserReq req) (api.post="api/v1/user/lift"),
+    ListBanHistoryResponse ListBanHistory(1: ListBanHistoryRequest req)(api.get = "api/v1/user/ban/history"),
+    UnlockUserResp UnlockUser(1: UnlockUserReq req) (api.post="api/v1/user/unlock"),
     LogoutResp Logout(1: LogoutReq req) (api.post="api/v1/user/logout")
+    ListSessionsResponse ListSessions(1: ListSessionsRequest req)(api.get = "api/v1/user/session/list"),
+    RevokeSessionResponse RevokeSession(1: RevokeSessionRequest req)(api.delete = "api/v1/user/session/revoke"),
+    RevokeAllSessionsResponse RevokeAllSessions(1: RevokeAllSessionsRequest req)(api.delete = "api/v1/user/session/revoke-all"),
     SetAdministratorResp SetAdministrator(1:SetAdministratorReq req) (api.post="api/v1/user/administrator")
+    CreateShopResponse CreateShop(1: CreateShopRequest req)(api.post = "api/v1/user/shop"),
+    UpdateShopResponse UpdateShop(1: UpdateShopRequest req)(api.put = "api/v1/user/shop/update"),
+    CloseShopResponse CloseShop(1: CloseShopRequest req)(api.put = "api/v1/user/shop/close"),
+    GetShopResponse GetShop(1: GetShopRequest req)(api.get = "api/v1/user/shop/info"),
+    AddShopStaffResponse AddShopStaff(1: AddShopStaffRequest req)(api.post = "api/v1/user/shop/staff"),
+    RemoveShopStaffResponse RemoveShopStaff(1: RemoveShopStaffRequest req)(api.delete = "api/v1/user/shop/staff"),
+    ListShopStaffResponse ListShopStaff(1: ListShopStaffRequest req)(api.get = "api/v1/user/shop/staff/list"),
+    UpdateProfileResponse UpdateProfile(1: UpdateProfileRequest req)(api.put = "api/v1/user/profile"),
+    ChangePasswordResponse ChangePassword(1: ChangePasswordRequest req)(api.put = "api/v1/user/password"),
+    RequestPasswordResetResponse RequestPasswordReset(1: RequestPasswordResetRequest req)(api.post = "api/v1/user/password/reset/code"),
+    ResetPasswordResponse ResetPassword(1: ResetPasswordRequest req)(api.post = "api/v1/user/password/reset"),
+    ExportUserDataResponse ExportUserData(1: ExportUserDataRequest req)(api.get = "api/v1/user/export"),
+    CloseAccountResponse CloseAccount(1: CloseAccountRequest req)(api.post = "api/v1/user/account/close"),
+    EnrollTOTPResponse EnrollTOTP(1: EnrollTOTPRequest req)(api.post = "api/v1/user/totp/enroll"),
+    ConfirmTOTPResponse ConfirmTOTP(1: ConfirmTOTPRequest req)(api.post = "api/v1/user/totp/confirm"),
+    DisableTOTPResponse DisableTOTP(1: DisableTOTPRequest req)(api.post = "api/v1/user/totp/disable"),
+    CreateApiKeyResponse CreateApiKey(1: CreateApiKeyRequest req)(api.post = "api/v1/user/apikey"),
+    ListApiKeysResponse ListApiKeys(1: ListApiKeysRequest req)(api.get = "api/v1/user/apikey/list"),
+    RevokeApiKeyResponse RevokeApiKey(1: RevokeApiKeyRequest req)(api.delete = "api/v1/user/apikey"),
+    ListAuditLogsResponse ListAuditLogs(1: ListAuditLogsRequest req)(api.get = "api/v1/user/audit/list"),
+    CreateWebhookEndpointResponse CreateWebhookEndpoint(1: CreateWebhookEndpointRequest req)(api.post = "api/v1/user/shop/webhook"),
+    ListWebhookEndpointsResponse ListWebhookEndpoints(1: ListWebhookEndpointsRequest req)(api.get = "api/v1/user/shop/webhook/list"),
+    UpdateWebhookEndpointResponse UpdateWebhookEndpoint(1: UpdateWebhookEndpointRequest req)(api.put = "api/v1/user/shop/webhook/update"),
+    DeleteWebhookEndpointResponse DeleteWebhookEndpoint(1: DeleteWebhookEndpointRequest req)(api.delete = "api/v1/user/shop/webhook"),
+    ListWebhookDeliveriesResponse ListWebhookDeliveries(1: ListWebhookDeliveriesRequest req)(api.get = "api/v1/user/shop/webhook/delivery/list"),
+    RedeliverWebhookResponse RedeliverWebhook(1: RedeliverWebhookRequest req)(api.post = "api/v1/user/shop/webhook/delivery/redeliver"),
 }
 
@@ -66,10 +66,35 @@ struct DeleteAllCartGoodsResponse{
     1: required model.BaseResp base,
 }
 
+/* struct ExportUserDataRequest 导出用户的购物车数据，仅供 user 服务调用
+* @Param uid 用户ID
+*/
+struct ExportUserDataRequest{
+    1: required i64 uid,
+}
+
+struct ExportUserDataResponse{
+    1: required model.BaseResp base,
+    2: required string data,
+}
+
+/* struct EraseUserDataRequest 注销账号时清除用户的购物车，仅供 user 服务调用
+* @Param uid 用户ID
+*/
+struct EraseUserDataRequest{
+    1: required i64 uid,
+}
+
+struct EraseUserDataResponse{
+    1: required model.BaseResp base,
+}
+
 service CartService {
     AddGoodsIntoCartResponse AddGoodsIntoCart(1: AddGoodsIntoCartRequest req),
     ShowCartGoodsListResponse ShowCartGoodsList(1: ShowCartGoodsListRequest req),
     UpdateCartGoodsResponse UpdateCartGoods(1: UpdateCartGoodsRequest req),
     PurChaseCartGoodsResponse PurChaseCartGoods(1: PurChaseCartGoodsRequest req)
     DeleteAllCartGoodsResponse DeleteAllCartGoods(1:DeleteAllCartGoodsRequest req),
+    ExportUserDataResponse ExportUserData(1: ExportUserDataRequest req),
+    EraseUserDataResponse EraseUserData(1: EraseUserDataRequest req),
 }
@@ -108,6 +108,7 @@ struct GetCouponAndPriceResp {
 * @Param shipping 运费
 * @Param goodsHeadDrawing 款式头图数据
 * @Param spu展示图数据
+* @Param shopID 所属店铺ID, 调用者必须是该店铺的店员
 */
 struct CreateSpuReq {
     1: required string name;
@@ -118,6 +119,7 @@ struct CreateSpuReq {
     6: required i32 forSale;
     7: required double shipping;
     8: required i64 bufferCount;
+    9: required i64 shopID;
 }
 
 struct CreateSpuResp {
@@ -529,6 +531,31 @@ struct UploadImageResp {
 
 }
 
+/*
+* struct ExportUserCouponReq 导出用户持有的优惠券, 仅供 user 服务调用
+* @Param uid 用户ID
+*/
+struct ExportUserCouponReq {
+    1: required i64 uid;
+}
+
+struct ExportUserCouponResp {
+    1: required model.BaseResp base;
+    2: required string data;
+}
+
+/*
+* struct EraseUserCouponReq 注销账号时清除用户持有的优惠券, 仅供 user 服务调用
+* @Param uid 用户ID
+*/
+struct EraseUserCouponReq {
+    1: required i64 uid;
+}
+
+struct EraseUserCouponResp {
+    1: required model.BaseResp base;
+}
+
 service CommodityService {
     // 优惠券
     CreateCouponResp CreateCoupon(1: CreateCouponReq req);
@@ -537,6 +564,8 @@ service CommodityService {
     ViewCouponResp ViewCoupon(1: ViewCouponReq req);
     ViewUserAllCouponResp ViewUserAllCoupon(1: ViewUserAllCouponReq req);
     GetCouponAndPriceResp GetCouponAndPrice(1: GetCouponAndPriceReq req);
+    ExportUserCouponResp ExportUserCoupon(1: ExportUserCouponReq req);
+    EraseUserCouponResp EraseUserCoupon(1: EraseUserCouponReq req);
 
     // SPU
     CreateSpuResp CreateSpu(1: CreateSpuReq req) (streaming.mode="client");
@@ -9,6 +9,8 @@ struct UserInfo {
     1: i64 userId,
     2: string name,
     3: i64 role,
+    4: string email,
+    5: string phone,
 }
 
 struct AddressInfo {
@@ -16,6 +18,98 @@ struct AddressInfo {
     2: string city
     3: string detail // 具体地址信息
     4: i64 addressID
+    5: string recipientName // 收件人
+    6: string phone // 收件人手机号
+    7: bool isDefault // 是否为默认地址
+}
+
+struct TokenInfo {
+    1: string accessToken,
+    2: string refreshToken,
+}
+
+struct SessionInfo {
+    1: string sessionID
+    2: string device // 登录设备, 一般为 User-Agent
+    3: string ip // 登录时的 IP
+    4: i64 createdAt // 登录时间
+    5: i64 activeAt // 最后一次刷新 token 的时间
+    6: bool current // 是否为当前请求所在的会话
+}
+
+struct ShopInfo {
+    1: i64 shopID
+    2: string name
+    3: string logo
+    4: string description
+    5: i32 status // 店铺状态: 0 营业中, 1 已关闭
+    6: i64 ownerID // 店主的 uid
+    7: i64 createdAt
+    8: i64 updatedAt
+}
+
+struct ShopStaffInfo {
+    1: i64 shopID
+    2: i64 uid
+    3: i32 role // 店铺内角色: 0 店员, 1 店主
+    4: i64 createdAt
+}
+
+struct BanInfo {
+    1: i64 banID
+    2: i64 uid
+    3: string reason
+    4: i64 operatorID // 执行封禁的管理员 uid
+    5: i64 startAt
+    6: i64 endAt // 封禁结束时间, 0 表示永久封禁
+    7: i64 liftedAt // 解封时间, 0 表示没有被手动解封
+    8: i64 liftedBy // 执行解封的管理员 uid
+}
+
+struct ApiKeyInfo {
+    1: i64 keyID
+    2: string name
+    3: string prefix // key 的前几位, 用于区分不同的 key, 完整的 key 只会在创建时返回一次
+    4: list<string> scopes
+    5: i64 createdAt
+}
+
+struct AuditLogInfo {
+    1: i64 logID
+    2: i64 actorID // 执行操作的用户 uid
+    3: string action
+    4: string target // 被操作的对象, 如 user:1, coupon:2
+    5: string before // 操作前的快照, JSON 格式
+    6: string after // 操作后的快照, JSON 格式
+    7: string clientIP
+    8: string traceID
+    9: i64 createdAt
+}
+
+struct WebhookEndpointInfo {
+    1: i64 endpointID
+    2: i64 shopID
+    3: string url
+    4: list<string> eventTypes // 订阅的事件类型, 如 order.paid, refund.approved
+    5: bool enabled // 连续投递失败过多时会被自动停用
+    6: i64 consecutiveFailures
+    7: i64 createdAt
+    8: i64 updatedAt
+}
+
+struct WebhookDeliveryInfo {
+    1: i64 deliveryID
+    2: i64 endpointID
+    3: string eventID // 重试和重新投递时保持不变, 商家可以据此去重
+    4: string eventType
+    5: string payload // 投递的请求体, JSON 格式
+    6: i32 status // 0-等待投递, 1-成功, 2-失败
+    7: i64 attempts
+    8: i64 nextRetryAt // 毫秒时间戳
+    9: i64 lastStatusCode // 最后一次投递时商家返回的 HTTP 状态码, 没有收到响应时为 0
+    10: string lastError
+    11: i64 createdAt
+    12: i64 updatedAt
 }
 
 struct LoginData {
@@ -91,6 +185,7 @@ struct Spu {
     10: required i64 createdAt;
     11: required i64 updatedAt;
     12: optional i64 deletedAt;
+    13: required i64 shopID;
 }
 
 struct Sku {
@@ -121,6 +216,7 @@ struct SkuInfo {
     7: required string styleHeadDrawing;
     8: required i64 spuID;
     9: required i64 historyID;
+    10: required i64 shopID;
 }
 
 struct SkuVersion {
@@ -205,6 +301,7 @@ struct OrderGoods {
     17: i64 couponId;
     18: string couponName;
     19: required i64 orderId;
+    20: string merchantName; // 店铺名称
 }
 
 struct BaseOrderGoods {
@@ -239,6 +336,27 @@ struct RefundResponseInfo{
     2: required i64 status
 }
 
+/*
+ * struct PaymentStatusInfo 订单的支付单状态
+ * @Param status 支付状态：0-待支付，1-处理中，2-成功支付，3-支付失败
+ */
+struct PaymentStatusInfo{
+    1: required i64 paymentID
+    2: required i64 status
+    3: required double amount
+}
+
+/*
+ * struct RefundStatusInfo 订单的退款单状态
+ * @Param status 退款状态：0-待处理，1-处理中，2-成功退款，3-退款失败
+ */
+struct RefundStatusInfo{
+    1: required i64 refundID
+    2: required i64 status
+    3: required double refundAmount
+    4: required string refundReason
+}
+
 struct CartGoods {
     1: required i64 merchantId; // 商家 ID
     2: required i64 goodsId; // 商品 ID
@@ -250,4 +368,5 @@ struct CartGoods {
     11: required i64 purchaseQuantity; // 购买数量
     12: required double totalAmount; // 本应总金额
     14: required double discountAmount; // 折扣总金额
+    15: string merchantName; // 店铺名称
 }
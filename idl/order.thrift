@@ -90,6 +90,32 @@ struct GetOrderPaymentAmountResp {
     2: required double amount
 }
 
+struct GetOrderMerchantsReq {
+    1: required i64 orderID
+}
+
+struct GetOrderMerchantsResp {
+    1: required model.BaseResp base
+    2: required list<i64> merchantIDs // 订单中商品所属的店铺, 已去重
+}
+
+struct ExportUserDataReq {
+    1: required i64 uid
+}
+
+struct ExportUserDataResp {
+    1: required model.BaseResp base
+    2: required string data
+}
+
+struct EraseUserDataReq {
+    1: required i64 uid
+}
+
+struct EraseUserDataResp {
+    1: required model.BaseResp base
+}
+
 service OrderService {
     CreateOrderResp CreateOrder(1:CreateOrderReq req) (api.post="/api/order/create")
     ViewOrderListResp ViewOrderList(1:ViewOrderListReq req) (api.get="/api/order/list")
@@ -102,4 +128,10 @@ service OrderService {
     GetOrderPaymentAmountResp GetOrderPaymentAmount(1:GetOrderPaymentAmount req)
     UpdateOrderStatusResp OrderPaymentSuccess(1:UpdateOrderStatusReq req)
     UpdateOrderStatusResp OrderPaymentCancel(1:UpdateOrderStatusReq req)
+    // 供 payment 服务在发布退款的 webhook 事件时调用
+    GetOrderMerchantsResp GetOrderMerchants(1:GetOrderMerchantsReq req)
+
+    // 供 user 服务在导出个人数据和注销账号时调用
+    ExportUserDataResp ExportUserData(1:ExportUserDataReq req)
+    EraseUserDataResp EraseUserData(1:EraseUserDataReq req)
 }
@@ -121,6 +121,42 @@ struct PaymentCheckoutResponse {
     1: model.BaseResp base
 }
 
+/*
+ * struct ExportUserDataRequest 导出用户的支付数据, 仅供 user 服务调用
+ * @Param uid 用户ID
+ */
+struct ExportUserDataRequest {
+    1: required i64 uid
+}
+
+/*
+ * struct ExportUserDataResponse 导出用户的支付数据响应
+ * @Param data JSON 格式的支付单, 退款单和流水
+ */
+struct ExportUserDataResponse {
+    1: model.BaseResp base
+    2: string data
+}
+
+/*
+ * struct PaymentStatusRequest 查询订单的支付和退款状态
+ * @Param orderID 商户订单号
+ */
+struct PaymentStatusRequest {
+    1: required i64 orderID
+}
+
+/*
+ * struct PaymentStatusResponse 订单的支付和退款状态
+ * @Param payment 支付单, 尚未发起支付时为空
+ * @Param refund 退款单, 没有退款时为空
+ */
+struct PaymentStatusResponse {
+    1: model.BaseResp base
+    2: optional model.PaymentStatusInfo payment
+    3: optional model.RefundStatusInfo refund
+}
+
 /*
  * service PaymentService 支付服务
  * @Method RequestPaymentToken 请求支付令牌
@@ -128,6 +164,8 @@ struct PaymentCheckoutResponse {
  * @Method PaymentCheckout 订单结算
  * @Method RequestRefundToken 请求退款令牌
  * @Method ProcessRefund 处理退款
+ * @Method ExportUserData 导出用户的支付数据, 支付记录需要依法留存, 所以注销账号时不会删除
+ * @Method QueryPaymentStatus 查询订单的支付和退款状态
  */
 service PaymentService {
     PaymentResponse ProcessPayment(1: PaymentRequest request) (api.post="/api/payment/process")
@@ -135,5 +173,7 @@ service PaymentService {
     PaymentCheckoutResponse RequestPaymentCheckout(1: PaymentCheckoutRequest request) (api.post="/api/payment/checkout")
     RefundReviewResponse RefundReview(1: RefundReviewRequest request) (api.post="/api/payment/refund/review")
     RefundResponse RequestRefund(1: RefundRequest request) (api.get="/api/payment/refund")
+    ExportUserDataResponse ExportUserData(1: ExportUserDataRequest request)
+    PaymentStatusResponse QueryPaymentStatus(1: PaymentStatusRequest request)
 }
 
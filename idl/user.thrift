@@ -18,11 +18,24 @@ struct LoginRequest {
     1: string username,
     2: string password,
     3: string confirm_password,
+    4: optional string device,
+    5: optional string ip,
+    6: optional string totp_code, // 开启了两步验证的用户需要提供动态验证码或恢复码
 }
 
 struct LoginResponse {
     1: model.BaseResp base,
     2: model.UserInfo user,
+    3: model.TokenInfo token,
+}
+
+struct RefreshTokenReq {
+    1: required string refreshToken,
+}
+
+struct RefreshTokenResp {
+    1: required model.BaseResp base,
+    2: required model.TokenInfo token,
 }
 
 struct GetAddressRequest {
@@ -38,6 +51,9 @@ struct AddAddressRequest {
     1: required string province,
     2: required string city,
     3: required string detail,
+    4: required string recipientName,
+    5: required string phone,
+    6: optional bool isDefault,
 }
 
 struct AddAddressResponse {
@@ -45,8 +61,47 @@ struct AddAddressResponse {
     2: required i64 addressID,
 }
 
+struct ListAddressReq {
+}
+
+struct ListAddressResp {
+    1: required model.BaseResp base,
+    2: required list<model.AddressInfo> addresses,
+}
+
+struct UpdateAddressReq {
+    1: required i64 addressID,
+    2: optional string province,
+    3: optional string city,
+    4: optional string detail,
+    5: optional string recipientName,
+    6: optional string phone,
+}
+
+struct UpdateAddressResp {
+    1: required model.BaseResp base,
+}
+
+struct DeleteAddressReq {
+    1: required i64 addressID,
+}
+
+struct DeleteAddressResp {
+    1: required model.BaseResp base,
+}
+
+struct SetDefaultAddressReq {
+    1: required i64 addressID,
+}
+
+struct SetDefaultAddressResp {
+    1: required model.BaseResp base,
+}
+
 struct BanUserReq {
     1: required i64 uid
+    2: required string reason
+    3: optional i64 duration // 封禁时长, 单位为秒, 不传或为 0 表示永久封禁
 }
 
 struct BanUserResp {
@@ -61,6 +116,23 @@ struct LiftBanUserResp {
     1: required model.BaseResp base,
 }
 
+struct ListBanHistoryReq {
+    1: required i64 uid
+}
+
+struct ListBanHistoryResp {
+    1: required model.BaseResp base,
+    2: required list<model.BanInfo> bans,
+}
+
+struct UnlockUserReq {
+    1: required i64 uid
+}
+
+struct UnlockUserResp {
+    1: required model.BaseResp base,
+}
+
 struct LogoutReq {
 }
 
@@ -68,6 +140,29 @@ struct LogoutResp {
     1: required model.BaseResp base,
 }
 
+struct ListSessionsReq {
+}
+
+struct ListSessionsResp {
+    1: required model.BaseResp base,
+    2: required list<model.SessionInfo> sessions,
+}
+
+struct RevokeSessionReq {
+    1: required string sessionID,
+}
+
+struct RevokeSessionResp {
+    1: required model.BaseResp base,
+}
+
+struct RevokeAllSessionsReq {
+}
+
+struct RevokeAllSessionsResp {
+    1: required model.BaseResp base,
+}
+
 struct SetAdministratorReq {
     1: required i64 uid
     2: required string password
@@ -87,14 +182,338 @@ struct GetUserInfoResp {
         2: required model.UserInfo info
 }
 
+struct CreateShopReq {
+    1: required string name,
+    2: optional string logo,
+    3: optional string description,
+}
+
+struct CreateShopResp {
+    1: required model.BaseResp base,
+    2: required i64 shopID,
+}
+
+struct UpdateShopReq {
+    1: required i64 shopID,
+    2: optional string name,
+    3: optional string logo,
+    4: optional string description,
+}
+
+struct UpdateShopResp {
+    1: required model.BaseResp base,
+}
+
+struct CloseShopReq {
+    1: required i64 shopID,
+}
+
+struct CloseShopResp {
+    1: required model.BaseResp base,
+}
+
+struct GetShopReq {
+    1: required i64 shopID,
+}
+
+struct GetShopResp {
+    1: required model.BaseResp base,
+    2: required model.ShopInfo shop,
+}
+
+// GetShopsReq 批量获取店铺信息, 供购物车和订单服务展示店铺名称使用
+struct GetShopsReq {
+    1: required list<i64> shopIDs,
+}
+
+struct GetShopsResp {
+    1: required model.BaseResp base,
+    2: required list<model.ShopInfo> shops,
+}
+
+struct AddShopStaffReq {
+    1: required i64 shopID,
+    2: required i64 uid,
+}
+
+struct AddShopStaffResp {
+    1: required model.BaseResp base,
+}
+
+struct RemoveShopStaffReq {
+    1: required i64 shopID,
+    2: required i64 uid,
+}
+
+struct RemoveShopStaffResp {
+    1: required model.BaseResp base,
+}
+
+struct ListShopStaffReq {
+    1: required i64 shopID,
+}
+
+struct ListShopStaffResp {
+    1: required model.BaseResp base,
+    2: required list<model.ShopStaffInfo> staff,
+}
+
+// IsShopStaffReq 校验用户是否为店铺成员, 供商品服务在创建商品时使用
+struct IsShopStaffReq {
+    1: required i64 shopID,
+    2: required i64 uid,
+}
+
+struct IsShopStaffResp {
+    1: required model.BaseResp base,
+    2: required bool isStaff,
+    3: required model.ShopInfo shop,
+}
+
+struct UpdateProfileReq {
+    1: optional string name,
+    2: optional string email,
+    3: optional string phone,
+    4: optional string password, // 修改邮箱或手机号时需要重新确认密码
+}
+
+struct UpdateProfileResp {
+    1: required model.BaseResp base,
+    2: required model.UserInfo user,
+}
+
+struct ChangePasswordReq {
+    1: required string oldPassword,
+    2: required string password,
+}
+
+struct ChangePasswordResp {
+    1: required model.BaseResp base,
+}
+
+struct RequestPasswordResetReq {
+    1: required string name,
+}
+
+struct RequestPasswordResetResp {
+    1: required model.BaseResp base,
+}
+
+struct ResetPasswordReq {
+    1: required string name,
+    2: required string code,
+    3: required string password,
+}
+
+struct ResetPasswordResp {
+    1: required model.BaseResp base,
+}
+
+struct ExportUserDataReq {
+}
+
+struct ExportUserDataResp {
+    1: required model.BaseResp base,
+    2: required string data, // JSON 格式的个人数据
+}
+
+struct CloseAccountReq {
+    1: required string password,
+}
+
+struct CloseAccountResp {
+    1: required model.BaseResp base,
+}
+
+struct EnrollTOTPReq {
+    1: required string password,
+}
+
+struct EnrollTOTPResp {
+    1: required model.BaseResp base,
+    2: required string secret,
+    3: required string uri, // otpauth URI, 可以生成二维码供验证器扫描
+    4: required list<string> recovery_codes,
+}
+
+struct ConfirmTOTPReq {
+    1: required string code,
+}
+
+struct ConfirmTOTPResp {
+    1: required model.BaseResp base,
+}
+
+struct DisableTOTPReq {
+    1: required string password,
+    2: required string code,
+}
+
+struct DisableTOTPResp {
+    1: required model.BaseResp base,
+}
+
+struct CreateApiKeyReq {
+    1: required string name,
+    2: required list<string> scopes,
+}
+
+struct CreateApiKeyResp {
+    1: required model.BaseResp base,
+    2: required string key,
+    3: required model.ApiKeyInfo info,
+}
+
+struct ListApiKeysReq {
+}
+
+struct ListApiKeysResp {
+    1: required model.BaseResp base,
+    2: required list<model.ApiKeyInfo> keys,
+}
+
+struct RevokeApiKeyReq {
+    1: required i64 keyID,
+}
+
+struct RevokeApiKeyResp {
+    1: required model.BaseResp base,
+}
+
+struct VerifyApiKeyReq {
+    1: required string key,
+}
+
+struct VerifyApiKeyResp {
+    1: required model.BaseResp base,
+    2: required i64 uid,
+    3: required i64 role,
+    4: required list<string> scopes,
+}
+
+struct ListAuditLogsReq {
+    1: optional i64 actorID,
+    2: optional string action,
+    3: optional i64 startTime,
+    4: optional i64 endTime,
+    5: optional i64 pageNum,
+    6: optional i64 pageSize,
+}
+
+struct ListAuditLogsResp {
+    1: required model.BaseResp base,
+    2: required list<model.AuditLogInfo> logs,
+    3: required i64 total,
+}
+
+struct CreateWebhookEndpointReq {
+    1: required i64 shopID,
+    2: required string url,
+    3: required list<string> eventTypes,
+}
+
+struct CreateWebhookEndpointResp {
+    1: required model.BaseResp base,
+    2: required string secret,
+    3: required model.WebhookEndpointInfo endpoint,
+}
+
+struct ListWebhookEndpointsReq {
+    1: required i64 shopID,
+}
+
+struct ListWebhookEndpointsResp {
+    1: required model.BaseResp base,
+    2: required list<model.WebhookEndpointInfo> endpoints,
+}
+
+struct UpdateWebhookEndpointReq {
+    1: required i64 endpointID,
+    2: required string url,
+    3: required list<string> eventTypes,
+    4: required bool enabled,
+}
+
+struct UpdateWebhookEndpointResp {
+    1: required model.BaseResp base,
+}
+
+struct DeleteWebhookEndpointReq {
+    1: required i64 endpointID,
+}
+
+struct DeleteWebhookEndpointResp {
+    1: required model.BaseResp base,
+}
+
+struct ListWebhookDeliveriesReq {
+    1: required i64 endpointID,
+    2: optional i64 pageNum,
+    3: optional i64 pageSize,
+}
+
+struct ListWebhookDeliveriesResp {
+    1: required model.BaseResp base,
+    2: required list<model.WebhookDeliveryInfo> deliveries,
+    3: required i64 total,
+}
+
+struct RedeliverWebhookReq {
+    1: required i64 deliveryID,
+}
+
+struct RedeliverWebhookResp {
+    1: required model.BaseResp base,
+    2: required model.WebhookDeliveryInfo delivery,
+}
+
 service UserService {
     RegisterResponse Register(1: RegisterRequest req),
     LoginResponse Login(1: LoginRequest req),
+    RefreshTokenResp RefreshToken(1: RefreshTokenReq req),
     GetAddressResponse GetAddress(1: GetAddressRequest req),
     AddAddressResponse AddAddress(1: AddAddressRequest req),
+    ListAddressResp ListAddress(1: ListAddressReq req),
+    UpdateAddressResp UpdateAddress(1: UpdateAddressReq req),
+    DeleteAddressResp DeleteAddress(1: DeleteAddressReq req),
+    SetDefaultAddressResp SetDefaultAddress(1: SetDefaultAddressReq req),
     BanUserResp BanUser(1: BanUserReq req),
     LiftBanUserResp LiftBandUser(1: LiftBanUserReq req) ,
+    ListBanHistoryResp ListBanHistory(1: ListBanHistoryReq req),
+    UnlockUserResp UnlockUser(1: UnlockUserReq req),
     LogoutResp Logout(1: LogoutReq req),
+    ListSessionsResp ListSessions(1: ListSessionsReq req),
+    RevokeSessionResp RevokeSession(1: RevokeSessionReq req),
+    RevokeAllSessionsResp RevokeAllSessions(1: RevokeAllSessionsReq req),
     SetAdministratorResp SetAdministrator(1:SetAdministratorReq req)
     GetUserInfoResp GetUserInfo(1: GetUserInfoReq req)
+    CreateShopResp CreateShop(1: CreateShopReq req),
+    UpdateShopResp UpdateShop(1: UpdateShopReq req),
+    CloseShopResp CloseShop(1: CloseShopReq req),
+    GetShopResp GetShop(1: GetShopReq req),
+    GetShopsResp GetShops(1: GetShopsReq req),
+    AddShopStaffResp AddShopStaff(1: AddShopStaffReq req),
+    RemoveShopStaffResp RemoveShopStaff(1: RemoveShopStaffReq req),
+    ListShopStaffResp ListShopStaff(1: ListShopStaffReq req),
+    IsShopStaffResp IsShopStaff(1: IsShopStaffReq req),
+    UpdateProfileResp UpdateProfile(1: UpdateProfileReq req),
+    ChangePasswordResp ChangePassword(1: ChangePasswordReq req),
+    RequestPasswordResetResp RequestPasswordReset(1: RequestPasswordResetReq req),
+    ResetPasswordResp ResetPassword(1: ResetPasswordReq req),
+    ExportUserDataResp ExportUserData(1: ExportUserDataReq req),
+    CloseAccountResp CloseAccount(1: CloseAccountReq req),
+    EnrollTOTPResp EnrollTOTP(1: EnrollTOTPReq req),
+    ConfirmTOTPResp ConfirmTOTP(1: ConfirmTOTPReq req),
+    DisableTOTPResp DisableTOTP(1: DisableTOTPReq req),
+    CreateApiKeyResp CreateApiKey(1: CreateApiKeyReq req),
+    ListApiKeysResp ListApiKeys(1: ListApiKeysReq req),
+    RevokeApiKeyResp RevokeApiKey(1: RevokeApiKeyReq req),
+    VerifyApiKeyResp VerifyApiKey(1: VerifyApiKeyReq req),
+    ListAuditLogsResp ListAuditLogs(1: ListAuditLogsReq req),
+    CreateWebhookEndpointResp CreateWebhookEndpoint(1: CreateWebhookEndpointReq req),
+    ListWebhookEndpointsResp ListWebhookEndpoints(1: ListWebhookEndpointsReq req),
+    UpdateWebhookEndpointResp UpdateWebhookEndpoint(1: UpdateWebhookEndpointReq req),
+    DeleteWebhookEndpointResp DeleteWebhookEndpoint(1: DeleteWebhookEndpointReq req),
+    ListWebhookDeliveriesResp ListWebhookDeliveries(1: ListWebhookDeliveriesReq req),
+    RedeliverWebhookResp RedeliverWebhook(1: RedeliverWebhookReq req),
 }
@@ -21,6 +21,7 @@ package cart
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/west2-online/DomTok/kitex_gen/model"
 )
@@ -703,6 +704,239 @@ var fieldIDToName_DeleteAllCartGoodsResponse = map[int16]string{
 	1: "base",
 }
 
+type ExportUserDataRequest struct {
+	Uid int64 `thrift:"uid,1,required" frugal:"1,required,i64" json:"uid"`
+}
+
+func NewExportUserDataRequest() *ExportUserDataRequest {
+	return &ExportUserDataRequest{}
+}
+
+func (p *ExportUserDataRequest) InitDefault() {
+}
+
+func (p *ExportUserDataRequest) GetUid() (v int64) {
+	return p.Uid
+}
+func (p *ExportUserDataRequest) SetUid(val int64) {
+	p.Uid = val
+}
+
+func (p *ExportUserDataRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExportUserDataRequest(%+v)", *p)
+}
+
+func (p *ExportUserDataRequest) DeepEqual(ano *ExportUserDataRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Uid) {
+		return false
+	}
+	return true
+}
+
+func (p *ExportUserDataRequest) Field1DeepEqual(src int64) bool {
+
+	if p.Uid != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_ExportUserDataRequest = map[int16]string{
+	1: "uid",
+}
+
+type ExportUserDataResponse struct {
+	Base *model.BaseResp `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+	Data string          `thrift:"data,2,required" frugal:"2,required,string" json:"data"`
+}
+
+func NewExportUserDataResponse() *ExportUserDataResponse {
+	return &ExportUserDataResponse{}
+}
+
+func (p *ExportUserDataResponse) InitDefault() {
+}
+
+var ExportUserDataResponse_Base_DEFAULT *model.BaseResp
+
+func (p *ExportUserDataResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return ExportUserDataResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *ExportUserDataResponse) GetData() (v string) {
+	return p.Data
+}
+func (p *ExportUserDataResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *ExportUserDataResponse) SetData(val string) {
+	p.Data = val
+}
+
+func (p *ExportUserDataResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ExportUserDataResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExportUserDataResponse(%+v)", *p)
+}
+
+func (p *ExportUserDataResponse) DeepEqual(ano *ExportUserDataResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Data) {
+		return false
+	}
+	return true
+}
+
+func (p *ExportUserDataResponse) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *ExportUserDataResponse) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.Data, src) != 0 {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_ExportUserDataResponse = map[int16]string{
+	1: "base",
+	2: "data",
+}
+
+type EraseUserDataRequest struct {
+	Uid int64 `thrift:"uid,1,required" frugal:"1,required,i64" json:"uid"`
+}
+
+func NewEraseUserDataRequest() *EraseUserDataRequest {
+	return &EraseUserDataRequest{}
+}
+
+func (p *EraseUserDataRequest) InitDefault() {
+}
+
+func (p *EraseUserDataRequest) GetUid() (v int64) {
+	return p.Uid
+}
+func (p *EraseUserDataRequest) SetUid(val int64) {
+	p.Uid = val
+}
+
+func (p *EraseUserDataRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EraseUserDataRequest(%+v)", *p)
+}
+
+func (p *EraseUserDataRequest) DeepEqual(ano *EraseUserDataRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Uid) {
+		return false
+	}
+	return true
+}
+
+func (p *EraseUserDataRequest) Field1DeepEqual(src int64) bool {
+
+	if p.Uid != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_EraseUserDataRequest = map[int16]string{
+	1: "uid",
+}
+
+type EraseUserDataResponse struct {
+	Base *model.BaseResp `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+}
+
+func NewEraseUserDataResponse() *EraseUserDataResponse {
+	return &EraseUserDataResponse{}
+}
+
+func (p *EraseUserDataResponse) InitDefault() {
+}
+
+var EraseUserDataResponse_Base_DEFAULT *model.BaseResp
+
+func (p *EraseUserDataResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return EraseUserDataResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *EraseUserDataResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+
+func (p *EraseUserDataResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *EraseUserDataResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EraseUserDataResponse(%+v)", *p)
+}
+
+func (p *EraseUserDataResponse) DeepEqual(ano *EraseUserDataResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	return true
+}
+
+func (p *EraseUserDataResponse) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_EraseUserDataResponse = map[int16]string{
+	1: "base",
+}
+
 type CartService interface {
 	AddGoodsIntoCart(ctx context.Context, req *AddGoodsIntoCartRequest) (r *AddGoodsIntoCartResponse, err error)
 
@@ -713,6 +947,10 @@ type CartService interface {
 	PurChaseCartGoods(ctx context.Context, req *PurChaseCartGoodsRequest) (r *PurChaseCartGoodsResponse, err error)
 
 	DeleteAllCartGoods(ctx context.Context, req *DeleteAllCartGoodsRequest) (r *DeleteAllCartGoodsResponse, err error)
+
+	ExportUserData(ctx context.Context, req *ExportUserDataRequest) (r *ExportUserDataResponse, err error)
+
+	EraseUserData(ctx context.Context, req *EraseUserDataRequest) (r *EraseUserDataResponse, err error)
 }
 
 type CartServiceAddGoodsIntoCartArgs struct {
@@ -1294,3 +1532,235 @@ func (p *CartServiceDeleteAllCartGoodsResult) Field0DeepEqual(src *DeleteAllCart
 var fieldIDToName_CartServiceDeleteAllCartGoodsResult = map[int16]string{
 	0: "success",
 }
+
+type CartServiceExportUserDataArgs struct {
+	Req *ExportUserDataRequest `thrift:"req,1" frugal:"1,default,ExportUserDataRequest" json:"req"`
+}
+
+func NewCartServiceExportUserDataArgs() *CartServiceExportUserDataArgs {
+	return &CartServiceExportUserDataArgs{}
+}
+
+func (p *CartServiceExportUserDataArgs) InitDefault() {
+}
+
+var CartServiceExportUserDataArgs_Req_DEFAULT *ExportUserDataRequest
+
+func (p *CartServiceExportUserDataArgs) GetReq() (v *ExportUserDataRequest) {
+	if !p.IsSetReq() {
+		return CartServiceExportUserDataArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *CartServiceExportUserDataArgs) SetReq(val *ExportUserDataRequest) {
+	p.Req = val
+}
+
+func (p *CartServiceExportUserDataArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CartServiceExportUserDataArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CartServiceExportUserDataArgs(%+v)", *p)
+}
+
+func (p *CartServiceExportUserDataArgs) DeepEqual(ano *CartServiceExportUserDataArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *CartServiceExportUserDataArgs) Field1DeepEqual(src *ExportUserDataRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_CartServiceExportUserDataArgs = map[int16]string{
+	1: "req",
+}
+
+type CartServiceExportUserDataResult struct {
+	Success *ExportUserDataResponse `thrift:"success,0,optional" frugal:"0,optional,ExportUserDataResponse" json:"success,omitempty"`
+}
+
+func NewCartServiceExportUserDataResult() *CartServiceExportUserDataResult {
+	return &CartServiceExportUserDataResult{}
+}
+
+func (p *CartServiceExportUserDataResult) InitDefault() {
+}
+
+var CartServiceExportUserDataResult_Success_DEFAULT *ExportUserDataResponse
+
+func (p *CartServiceExportUserDataResult) GetSuccess() (v *ExportUserDataResponse) {
+	if !p.IsSetSuccess() {
+		return CartServiceExportUserDataResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *CartServiceExportUserDataResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ExportUserDataResponse)
+}
+
+func (p *CartServiceExportUserDataResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CartServiceExportUserDataResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CartServiceExportUserDataResult(%+v)", *p)
+}
+
+func (p *CartServiceExportUserDataResult) DeepEqual(ano *CartServiceExportUserDataResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *CartServiceExportUserDataResult) Field0DeepEqual(src *ExportUserDataResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_CartServiceExportUserDataResult = map[int16]string{
+	0: "success",
+}
+
+type CartServiceEraseUserDataArgs struct {
+	Req *EraseUserDataRequest `thrift:"req,1" frugal:"1,default,EraseUserDataRequest" json:"req"`
+}
+
+func NewCartServiceEraseUserDataArgs() *CartServiceEraseUserDataArgs {
+	return &CartServiceEraseUserDataArgs{}
+}
+
+func (p *CartServiceEraseUserDataArgs) InitDefault() {
+}
+
+var CartServiceEraseUserDataArgs_Req_DEFAULT *EraseUserDataRequest
+
+func (p *CartServiceEraseUserDataArgs) GetReq() (v *EraseUserDataRequest) {
+	if !p.IsSetReq() {
+		return CartServiceEraseUserDataArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *CartServiceEraseUserDataArgs) SetReq(val *EraseUserDataRequest) {
+	p.Req = val
+}
+
+func (p *CartServiceEraseUserDataArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CartServiceEraseUserDataArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CartServiceEraseUserDataArgs(%+v)", *p)
+}
+
+func (p *CartServiceEraseUserDataArgs) DeepEqual(ano *CartServiceEraseUserDataArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *CartServiceEraseUserDataArgs) Field1DeepEqual(src *EraseUserDataRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_CartServiceEraseUserDataArgs = map[int16]string{
+	1: "req",
+}
+
+type CartServiceEraseUserDataResult struct {
+	Success *EraseUserDataResponse `thrift:"success,0,optional" frugal:"0,optional,EraseUserDataResponse" json:"success,omitempty"`
+}
+
+func NewCartServiceEraseUserDataResult() *CartServiceEraseUserDataResult {
+	return &CartServiceEraseUserDataResult{}
+}
+
+func (p *CartServiceEraseUserDataResult) InitDefault() {
+}
+
+var CartServiceEraseUserDataResult_Success_DEFAULT *EraseUserDataResponse
+
+func (p *CartServiceEraseUserDataResult) GetSuccess() (v *EraseUserDataResponse) {
+	if !p.IsSetSuccess() {
+		return CartServiceEraseUserDataResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *CartServiceEraseUserDataResult) SetSuccess(x interface{}) {
+	p.Success = x.(*EraseUserDataResponse)
+}
+
+func (p *CartServiceEraseUserDataResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CartServiceEraseUserDataResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CartServiceEraseUserDataResult(%+v)", *p)
+}
+
+func (p *CartServiceEraseUserDataResult) DeepEqual(ano *CartServiceEraseUserDataResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *CartServiceEraseUserDataResult) Field0DeepEqual(src *EraseUserDataResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_CartServiceEraseUserDataResult = map[int16]string{
+	0: "success",
+}
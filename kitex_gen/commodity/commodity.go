@@ -1045,6 +1045,7 @@ type CreateSpuReq struct {
 	ForSale          int32   `thrift:"forSale,6,required" frugal:"6,required,i32" json:"forSale"`
 	Shipping         float64 `thrift:"shipping,7,required" frugal:"7,required,double" json:"shipping"`
 	BufferCount      int64   `thrift:"bufferCount,8,required" frugal:"8,required,i64" json:"bufferCount"`
+	ShopID           int64   `thrift:"shopID,9,required" frugal:"9,required,i64" json:"shopID"`
 }
 
 func NewCreateSpuReq() *CreateSpuReq {
@@ -1085,6 +1086,10 @@ func (p *CreateSpuReq) GetShipping() (v float64) {
 func (p *CreateSpuReq) GetBufferCount() (v int64) {
 	return p.BufferCount
 }
+
+func (p *CreateSpuReq) GetShopID() (v int64) {
+	return p.ShopID
+}
 func (p *CreateSpuReq) SetName(val string) {
 	p.Name = val
 }
@@ -1109,6 +1114,9 @@ func (p *CreateSpuReq) SetShipping(val float64) {
 func (p *CreateSpuReq) SetBufferCount(val int64) {
 	p.BufferCount = val
 }
+func (p *CreateSpuReq) SetShopID(val int64) {
+	p.ShopID = val
+}
 
 func (p *CreateSpuReq) String() string {
 	if p == nil {
@@ -1147,6 +1155,9 @@ func (p *CreateSpuReq) DeepEqual(ano *CreateSpuReq) bool {
 	if !p.Field8DeepEqual(ano.BufferCount) {
 		return false
 	}
+	if !p.Field9DeepEqual(ano.ShopID) {
+		return false
+	}
 	return true
 }
 
@@ -1206,6 +1217,13 @@ func (p *CreateSpuReq) Field8DeepEqual(src int64) bool {
 	}
 	return true
 }
+func (p *CreateSpuReq) Field9DeepEqual(src int64) bool {
+
+	if p.ShopID != src {
+		return false
+	}
+	return true
+}
 
 var fieldIDToName_CreateSpuReq = map[int16]string{
 	1: "name",
@@ -1216,6 +1234,7 @@ var fieldIDToName_CreateSpuReq = map[int16]string{
 	6: "forSale",
 	7: "shipping",
 	8: "bufferCount",
+	9: "shopID",
 }
 
 type CreateSpuResp struct {
@@ -5993,6 +6012,239 @@ func (p *UploadImageResp) DeepEqual(ano *UploadImageResp) bool {
 
 var fieldIDToName_UploadImageResp = map[int16]string{}
 
+type ExportUserCouponReq struct {
+	Uid int64 `thrift:"uid,1,required" frugal:"1,required,i64" json:"uid"`
+}
+
+func NewExportUserCouponReq() *ExportUserCouponReq {
+	return &ExportUserCouponReq{}
+}
+
+func (p *ExportUserCouponReq) InitDefault() {
+}
+
+func (p *ExportUserCouponReq) GetUid() (v int64) {
+	return p.Uid
+}
+func (p *ExportUserCouponReq) SetUid(val int64) {
+	p.Uid = val
+}
+
+func (p *ExportUserCouponReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExportUserCouponReq(%+v)", *p)
+}
+
+func (p *ExportUserCouponReq) DeepEqual(ano *ExportUserCouponReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Uid) {
+		return false
+	}
+	return true
+}
+
+func (p *ExportUserCouponReq) Field1DeepEqual(src int64) bool {
+
+	if p.Uid != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_ExportUserCouponReq = map[int16]string{
+	1: "uid",
+}
+
+type ExportUserCouponResp struct {
+	Base *model.BaseResp `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+	Data string          `thrift:"data,2,required" frugal:"2,required,string" json:"data"`
+}
+
+func NewExportUserCouponResp() *ExportUserCouponResp {
+	return &ExportUserCouponResp{}
+}
+
+func (p *ExportUserCouponResp) InitDefault() {
+}
+
+var ExportUserCouponResp_Base_DEFAULT *model.BaseResp
+
+func (p *ExportUserCouponResp) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return ExportUserCouponResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *ExportUserCouponResp) GetData() (v string) {
+	return p.Data
+}
+func (p *ExportUserCouponResp) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *ExportUserCouponResp) SetData(val string) {
+	p.Data = val
+}
+
+func (p *ExportUserCouponResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ExportUserCouponResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExportUserCouponResp(%+v)", *p)
+}
+
+func (p *ExportUserCouponResp) DeepEqual(ano *ExportUserCouponResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Data) {
+		return false
+	}
+	return true
+}
+
+func (p *ExportUserCouponResp) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *ExportUserCouponResp) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.Data, src) != 0 {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_ExportUserCouponResp = map[int16]string{
+	1: "base",
+	2: "data",
+}
+
+type EraseUserCouponReq struct {
+	Uid int64 `thrift:"uid,1,required" frugal:"1,required,i64" json:"uid"`
+}
+
+func NewEraseUserCouponReq() *EraseUserCouponReq {
+	return &EraseUserCouponReq{}
+}
+
+func (p *EraseUserCouponReq) InitDefault() {
+}
+
+func (p *EraseUserCouponReq) GetUid() (v int64) {
+	return p.Uid
+}
+func (p *EraseUserCouponReq) SetUid(val int64) {
+	p.Uid = val
+}
+
+func (p *EraseUserCouponReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EraseUserCouponReq(%+v)", *p)
+}
+
+func (p *EraseUserCouponReq) DeepEqual(ano *EraseUserCouponReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Uid) {
+		return false
+	}
+	return true
+}
+
+func (p *EraseUserCouponReq) Field1DeepEqual(src int64) bool {
+
+	if p.Uid != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_EraseUserCouponReq = map[int16]string{
+	1: "uid",
+}
+
+type EraseUserCouponResp struct {
+	Base *model.BaseResp `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+}
+
+func NewEraseUserCouponResp() *EraseUserCouponResp {
+	return &EraseUserCouponResp{}
+}
+
+func (p *EraseUserCouponResp) InitDefault() {
+}
+
+var EraseUserCouponResp_Base_DEFAULT *model.BaseResp
+
+func (p *EraseUserCouponResp) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return EraseUserCouponResp_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *EraseUserCouponResp) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+
+func (p *EraseUserCouponResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *EraseUserCouponResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EraseUserCouponResp(%+v)", *p)
+}
+
+func (p *EraseUserCouponResp) DeepEqual(ano *EraseUserCouponResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	return true
+}
+
+func (p *EraseUserCouponResp) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_EraseUserCouponResp = map[int16]string{
+	1: "base",
+}
+
 type CommodityService interface {
 	CreateCoupon(ctx context.Context, req *CreateCouponReq) (r *CreateCouponResp, err error)
 
@@ -6006,6 +6258,10 @@ type CommodityService interface {
 
 	GetCouponAndPrice(ctx context.Context, req *GetCouponAndPriceReq) (r *GetCouponAndPriceResp, err error)
 
+	ExportUserCoupon(ctx context.Context, req *ExportUserCouponReq) (r *ExportUserCouponResp, err error)
+
+	EraseUserCoupon(ctx context.Context, req *EraseUserCouponReq) (r *EraseUserCouponResp, err error)
+
 	CreateSpu(stream CommodityService_CreateSpuServer) (err error)
 
 	UpdateSpu(stream CommodityService_UpdateSpuServer) (err error)
@@ -6757,6 +7013,238 @@ var fieldIDToName_CommodityServiceGetCouponAndPriceResult = map[int16]string{
 	0: "success",
 }
 
+type CommodityServiceExportUserCouponArgs struct {
+	Req *ExportUserCouponReq `thrift:"req,1" frugal:"1,default,ExportUserCouponReq" json:"req"`
+}
+
+func NewCommodityServiceExportUserCouponArgs() *CommodityServiceExportUserCouponArgs {
+	return &CommodityServiceExportUserCouponArgs{}
+}
+
+func (p *CommodityServiceExportUserCouponArgs) InitDefault() {
+}
+
+var CommodityServiceExportUserCouponArgs_Req_DEFAULT *ExportUserCouponReq
+
+func (p *CommodityServiceExportUserCouponArgs) GetReq() (v *ExportUserCouponReq) {
+	if !p.IsSetReq() {
+		return CommodityServiceExportUserCouponArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *CommodityServiceExportUserCouponArgs) SetReq(val *ExportUserCouponReq) {
+	p.Req = val
+}
+
+func (p *CommodityServiceExportUserCouponArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CommodityServiceExportUserCouponArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CommodityServiceExportUserCouponArgs(%+v)", *p)
+}
+
+func (p *CommodityServiceExportUserCouponArgs) DeepEqual(ano *CommodityServiceExportUserCouponArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *CommodityServiceExportUserCouponArgs) Field1DeepEqual(src *ExportUserCouponReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_CommodityServiceExportUserCouponArgs = map[int16]string{
+	1: "req",
+}
+
+type CommodityServiceExportUserCouponResult struct {
+	Success *ExportUserCouponResp `thrift:"success,0,optional" frugal:"0,optional,ExportUserCouponResp" json:"success,omitempty"`
+}
+
+func NewCommodityServiceExportUserCouponResult() *CommodityServiceExportUserCouponResult {
+	return &CommodityServiceExportUserCouponResult{}
+}
+
+func (p *CommodityServiceExportUserCouponResult) InitDefault() {
+}
+
+var CommodityServiceExportUserCouponResult_Success_DEFAULT *ExportUserCouponResp
+
+func (p *CommodityServiceExportUserCouponResult) GetSuccess() (v *ExportUserCouponResp) {
+	if !p.IsSetSuccess() {
+		return CommodityServiceExportUserCouponResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *CommodityServiceExportUserCouponResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ExportUserCouponResp)
+}
+
+func (p *CommodityServiceExportUserCouponResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CommodityServiceExportUserCouponResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CommodityServiceExportUserCouponResult(%+v)", *p)
+}
+
+func (p *CommodityServiceExportUserCouponResult) DeepEqual(ano *CommodityServiceExportUserCouponResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *CommodityServiceExportUserCouponResult) Field0DeepEqual(src *ExportUserCouponResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_CommodityServiceExportUserCouponResult = map[int16]string{
+	0: "success",
+}
+
+type CommodityServiceEraseUserCouponArgs struct {
+	Req *EraseUserCouponReq `thrift:"req,1" frugal:"1,default,EraseUserCouponReq" json:"req"`
+}
+
+func NewCommodityServiceEraseUserCouponArgs() *CommodityServiceEraseUserCouponArgs {
+	return &CommodityServiceEraseUserCouponArgs{}
+}
+
+func (p *CommodityServiceEraseUserCouponArgs) InitDefault() {
+}
+
+var CommodityServiceEraseUserCouponArgs_Req_DEFAULT *EraseUserCouponReq
+
+func (p *CommodityServiceEraseUserCouponArgs) GetReq() (v *EraseUserCouponReq) {
+	if !p.IsSetReq() {
+		return CommodityServiceEraseUserCouponArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *CommodityServiceEraseUserCouponArgs) SetReq(val *EraseUserCouponReq) {
+	p.Req = val
+}
+
+func (p *CommodityServiceEraseUserCouponArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CommodityServiceEraseUserCouponArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CommodityServiceEraseUserCouponArgs(%+v)", *p)
+}
+
+func (p *CommodityServiceEraseUserCouponArgs) DeepEqual(ano *CommodityServiceEraseUserCouponArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *CommodityServiceEraseUserCouponArgs) Field1DeepEqual(src *EraseUserCouponReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_CommodityServiceEraseUserCouponArgs = map[int16]string{
+	1: "req",
+}
+
+type CommodityServiceEraseUserCouponResult struct {
+	Success *EraseUserCouponResp `thrift:"success,0,optional" frugal:"0,optional,EraseUserCouponResp" json:"success,omitempty"`
+}
+
+func NewCommodityServiceEraseUserCouponResult() *CommodityServiceEraseUserCouponResult {
+	return &CommodityServiceEraseUserCouponResult{}
+}
+
+func (p *CommodityServiceEraseUserCouponResult) InitDefault() {
+}
+
+var CommodityServiceEraseUserCouponResult_Success_DEFAULT *EraseUserCouponResp
+
+func (p *CommodityServiceEraseUserCouponResult) GetSuccess() (v *EraseUserCouponResp) {
+	if !p.IsSetSuccess() {
+		return CommodityServiceEraseUserCouponResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *CommodityServiceEraseUserCouponResult) SetSuccess(x interface{}) {
+	p.Success = x.(*EraseUserCouponResp)
+}
+
+func (p *CommodityServiceEraseUserCouponResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CommodityServiceEraseUserCouponResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CommodityServiceEraseUserCouponResult(%+v)", *p)
+}
+
+func (p *CommodityServiceEraseUserCouponResult) DeepEqual(ano *CommodityServiceEraseUserCouponResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *CommodityServiceEraseUserCouponResult) Field0DeepEqual(src *EraseUserCouponResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_CommodityServiceEraseUserCouponResult = map[int16]string{
+	0: "success",
+}
+
 type CommodityServiceCreateSpuArgs struct {
 	Req *CreateSpuReq `thrift:"req,1" frugal:"1,default,CreateSpuReq" json:"req"`
 }
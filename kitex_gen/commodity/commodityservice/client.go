@@ -39,6 +39,8 @@ type Client interface {
 	ViewCoupon(ctx context.Context, req *commodity.ViewCouponReq, callOptions ...callopt.Option) (r *commodity.ViewCouponResp, err error)
 	ViewUserAllCoupon(ctx context.Context, req *commodity.ViewUserAllCouponReq, callOptions ...callopt.Option) (r *commodity.ViewUserAllCouponResp, err error)
 	GetCouponAndPrice(ctx context.Context, req *commodity.GetCouponAndPriceReq, callOptions ...callopt.Option) (r *commodity.GetCouponAndPriceResp, err error)
+	ExportUserCoupon(ctx context.Context, req *commodity.ExportUserCouponReq, callOptions ...callopt.Option) (r *commodity.ExportUserCouponResp, err error)
+	EraseUserCoupon(ctx context.Context, req *commodity.EraseUserCouponReq, callOptions ...callopt.Option) (r *commodity.EraseUserCouponResp, err error)
 	ViewSpu(ctx context.Context, req *commodity.ViewSpuReq, callOptions ...callopt.Option) (r *commodity.ViewSpuResp, err error)
 	DeleteSpu(ctx context.Context, req *commodity.DeleteSpuReq, callOptions ...callopt.Option) (r *commodity.DeleteSpuResp, err error)
 	ViewSpuImage(ctx context.Context, req *commodity.ViewSpuImageReq, callOptions ...callopt.Option) (r *commodity.ViewSpuImageResp, err error)
@@ -179,6 +181,16 @@ func (p *kCommodityServiceClient) GetCouponAndPrice(ctx context.Context, req *co
 	return p.kClient.GetCouponAndPrice(ctx, req)
 }
 
+func (p *kCommodityServiceClient) ExportUserCoupon(ctx context.Context, req *commodity.ExportUserCouponReq, callOptions ...callopt.Option) (r *commodity.ExportUserCouponResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ExportUserCoupon(ctx, req)
+}
+
+func (p *kCommodityServiceClient) EraseUserCoupon(ctx context.Context, req *commodity.EraseUserCouponReq, callOptions ...callopt.Option) (r *commodity.EraseUserCouponResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.EraseUserCoupon(ctx, req)
+}
+
 func (p *kCommodityServiceClient) ViewSpu(ctx context.Context, req *commodity.ViewSpuReq, callOptions ...callopt.Option) (r *commodity.ViewSpuResp, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.ViewSpu(ctx, req)
@@ -75,6 +75,20 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"ExportUserCoupon": kitex.NewMethodInfo(
+		exportUserCouponHandler,
+		newCommodityServiceExportUserCouponArgs,
+		newCommodityServiceExportUserCouponResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"EraseUserCoupon": kitex.NewMethodInfo(
+		eraseUserCouponHandler,
+		newCommodityServiceEraseUserCouponArgs,
+		newCommodityServiceEraseUserCouponResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 	"CreateSpu": kitex.NewMethodInfo(
 		createSpuHandler,
 		newCommodityServiceCreateSpuArgs,
@@ -438,6 +452,42 @@ func newCommodityServiceGetCouponAndPriceResult() interface{} {
 	return commodity.NewCommodityServiceGetCouponAndPriceResult()
 }
 
+func exportUserCouponHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*commodity.CommodityServiceExportUserCouponArgs)
+	realResult := result.(*commodity.CommodityServiceExportUserCouponResult)
+	success, err := handler.(commodity.CommodityService).ExportUserCoupon(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newCommodityServiceExportUserCouponArgs() interface{} {
+	return commodity.NewCommodityServiceExportUserCouponArgs()
+}
+
+func newCommodityServiceExportUserCouponResult() interface{} {
+	return commodity.NewCommodityServiceExportUserCouponResult()
+}
+
+func eraseUserCouponHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*commodity.CommodityServiceEraseUserCouponArgs)
+	realResult := result.(*commodity.CommodityServiceEraseUserCouponResult)
+	success, err := handler.(commodity.CommodityService).EraseUserCoupon(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newCommodityServiceEraseUserCouponArgs() interface{} {
+	return commodity.NewCommodityServiceEraseUserCouponArgs()
+}
+
+func newCommodityServiceEraseUserCouponResult() interface{} {
+	return commodity.NewCommodityServiceEraseUserCouponResult()
+}
+
 func createSpuHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	st, ok := arg.(*streaming.Args)
 	if !ok {
@@ -1266,6 +1316,26 @@ func (p *kClient) GetCouponAndPrice(ctx context.Context, req *commodity.GetCoupo
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) ExportUserCoupon(ctx context.Context, req *commodity.ExportUserCouponReq) (r *commodity.ExportUserCouponResp, err error) {
+	var _args commodity.CommodityServiceExportUserCouponArgs
+	_args.Req = req
+	var _result commodity.CommodityServiceExportUserCouponResult
+	if err = p.c.Call(ctx, "ExportUserCoupon", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) EraseUserCoupon(ctx context.Context, req *commodity.EraseUserCouponReq) (r *commodity.EraseUserCouponResp, err error) {
+	var _args commodity.CommodityServiceEraseUserCouponArgs
+	_args.Req = req
+	var _result commodity.CommodityServiceEraseUserCouponResult
+	if err = p.c.Call(ctx, "EraseUserCoupon", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) CreateSpu(ctx context.Context) (CommodityService_CreateSpuClient, error) {
 	streamClient, ok := p.c.(client.Streaming)
 	if !ok {
@@ -2170,6 +2170,7 @@ func (p *CreateSpuReq) FastRead(buf []byte) (int, error) {
 	var issetForSale bool = false
 	var issetShipping bool = false
 	var issetBufferCount bool = false
+	var issetShopID bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -2300,6 +2301,21 @@ func (p *CreateSpuReq) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 9:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField9(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetShopID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -2348,6 +2364,11 @@ func (p *CreateSpuReq) FastRead(buf []byte) (int, error) {
 		fieldId = 8
 		goto RequiredFieldNotSetError
 	}
+
+	if !issetShopID {
+		fieldId = 9
+		goto RequiredFieldNotSetError
+	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
@@ -2472,6 +2493,20 @@ func (p *CreateSpuReq) FastReadField8(buf []byte) (int, error) {
 	return offset, nil
 }
 
+func (p *CreateSpuReq) FastReadField9(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.ShopID = _field
+	return offset, nil
+}
+
 func (p *CreateSpuReq) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
@@ -2484,6 +2519,7 @@ func (p *CreateSpuReq) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 		offset += p.fastWriteField6(buf[offset:], w)
 		offset += p.fastWriteField7(buf[offset:], w)
 		offset += p.fastWriteField8(buf[offset:], w)
+		offset += p.fastWriteField9(buf[offset:], w)
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField4(buf[offset:], w)
@@ -2503,6 +2539,7 @@ func (p *CreateSpuReq) BLength() int {
 		l += p.field6Length()
 		l += p.field7Length()
 		l += p.field8Length()
+		l += p.field9Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
@@ -2564,6 +2601,13 @@ func (p *CreateSpuReq) fastWriteField8(buf []byte, w thrift.NocopyWriter) int {
 	return offset
 }
 
+func (p *CreateSpuReq) fastWriteField9(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 9)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.ShopID)
+	return offset
+}
+
 func (p *CreateSpuReq) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
@@ -2620,6 +2664,13 @@ func (p *CreateSpuReq) field8Length() int {
 	return l
 }
 
+func (p *CreateSpuReq) field9Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
 func (p *CreateSpuResp) FastRead(buf []byte) (int, error) {
 
 	var err error
@@ -12195,13 +12246,14 @@ func (p *UploadImageResp) BLength() int {
 	return l
 }
 
-func (p *CommodityServiceCreateCouponArgs) FastRead(buf []byte) (int, error) {
+func (p *ExportUserCouponReq) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
 	var l int
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetUid bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -12213,12 +12265,13 @@ func (p *CommodityServiceCreateCouponArgs) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I64 {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
+				issetUid = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -12235,32 +12288,40 @@ func (p *CommodityServiceCreateCouponArgs) FastRead(buf []byte) (int, error) {
 		}
 	}
 
+	if !issetUid {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommodityServiceCreateCouponArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExportUserCouponReq[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ExportUserCouponReq[fieldId]))
 }
 
-func (p *CommodityServiceCreateCouponArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExportUserCouponReq) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCreateCouponReq()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
+		_field = v
 	}
-	p.Req = _field
+	p.Uid = _field
 	return offset, nil
 }
 
-func (p *CommodityServiceCreateCouponArgs) FastWrite(buf []byte) int {
+func (p *ExportUserCouponReq) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *CommodityServiceCreateCouponArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExportUserCouponReq) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -12269,7 +12330,7 @@ func (p *CommodityServiceCreateCouponArgs) FastWriteNocopy(buf []byte, w thrift.
 	return offset
 }
 
-func (p *CommodityServiceCreateCouponArgs) BLength() int {
+func (p *ExportUserCouponReq) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -12278,27 +12339,29 @@ func (p *CommodityServiceCreateCouponArgs) BLength() int {
 	return l
 }
 
-func (p *CommodityServiceCreateCouponArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExportUserCouponReq) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
-	offset += p.Req.FastWriteNocopy(buf[offset:], w)
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.Uid)
 	return offset
 }
 
-func (p *CommodityServiceCreateCouponArgs) field1Length() int {
+func (p *ExportUserCouponReq) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
-	l += p.Req.BLength()
+	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *CommodityServiceCreateCouponResult) FastRead(buf []byte) (int, error) {
+func (p *ExportUserCouponResp) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
 	var l int
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetBase bool = false
+	var issetData bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -12309,13 +12372,29 @@ func (p *CommodityServiceCreateCouponResult) FastRead(buf []byte) (int, error) {
 			break
 		}
 		switch fieldId {
-		case 0:
+		case 1:
 			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField0(buf[offset:])
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetBase = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
+				issetData = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -12332,74 +12411,112 @@ func (p *CommodityServiceCreateCouponResult) FastRead(buf []byte) (int, error) {
 		}
 	}
 
+	if !issetBase {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetData {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommodityServiceCreateCouponResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExportUserCouponResp[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ExportUserCouponResp[fieldId]))
 }
 
-func (p *CommodityServiceCreateCouponResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExportUserCouponResp) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCreateCouponResp()
+	_field := model.NewBaseResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 	}
-	p.Success = _field
+	p.Base = _field
 	return offset, nil
 }
 
-func (p *CommodityServiceCreateCouponResult) FastWrite(buf []byte) int {
+func (p *ExportUserCouponResp) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.Data = _field
+	return offset, nil
+}
+
+func (p *ExportUserCouponResp) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *CommodityServiceCreateCouponResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExportUserCouponResp) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
-		offset += p.fastWriteField0(buf[offset:], w)
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *CommodityServiceCreateCouponResult) BLength() int {
+func (p *ExportUserCouponResp) BLength() int {
 	l := 0
 	if p != nil {
-		l += p.field0Length()
+		l += p.field1Length()
+		l += p.field2Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *CommodityServiceCreateCouponResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExportUserCouponResp) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetSuccess() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
-		offset += p.Success.FastWriteNocopy(buf[offset:], w)
-	}
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Base.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *CommodityServiceCreateCouponResult) field0Length() int {
+func (p *ExportUserCouponResp) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.Data)
+	return offset
+}
+
+func (p *ExportUserCouponResp) field1Length() int {
 	l := 0
-	if p.IsSetSuccess() {
-		l += thrift.Binary.FieldBeginLength()
-		l += p.Success.BLength()
-	}
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Base.BLength()
 	return l
 }
 
-func (p *CommodityServiceDeleteCouponArgs) FastRead(buf []byte) (int, error) {
+func (p *ExportUserCouponResp) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.StringLengthNocopy(p.Data)
+	return l
+}
+
+func (p *EraseUserCouponReq) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
 	var l int
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetUid bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -12411,12 +12528,13 @@ func (p *CommodityServiceDeleteCouponArgs) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I64 {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
+				issetUid = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -12433,32 +12551,40 @@ func (p *CommodityServiceDeleteCouponArgs) FastRead(buf []byte) (int, error) {
 		}
 	}
 
+	if !issetUid {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommodityServiceDeleteCouponArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EraseUserCouponReq[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_EraseUserCouponReq[fieldId]))
 }
 
-func (p *CommodityServiceDeleteCouponArgs) FastReadField1(buf []byte) (int, error) {
+func (p *EraseUserCouponReq) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewDeleteCouponReq()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
+		_field = v
 	}
-	p.Req = _field
+	p.Uid = _field
 	return offset, nil
 }
 
-func (p *CommodityServiceDeleteCouponArgs) FastWrite(buf []byte) int {
+func (p *EraseUserCouponReq) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *CommodityServiceDeleteCouponArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EraseUserCouponReq) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -12467,7 +12593,7 @@ func (p *CommodityServiceDeleteCouponArgs) FastWriteNocopy(buf []byte, w thrift.
 	return offset
 }
 
-func (p *CommodityServiceDeleteCouponArgs) BLength() int {
+func (p *EraseUserCouponReq) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -12476,27 +12602,28 @@ func (p *CommodityServiceDeleteCouponArgs) BLength() int {
 	return l
 }
 
-func (p *CommodityServiceDeleteCouponArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EraseUserCouponReq) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
-	offset += p.Req.FastWriteNocopy(buf[offset:], w)
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.Uid)
 	return offset
 }
 
-func (p *CommodityServiceDeleteCouponArgs) field1Length() int {
+func (p *EraseUserCouponReq) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
-	l += p.Req.BLength()
+	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *CommodityServiceDeleteCouponResult) FastRead(buf []byte) (int, error) {
+func (p *EraseUserCouponResp) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
 	var l int
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetBase bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -12507,13 +12634,14 @@ func (p *CommodityServiceDeleteCouponResult) FastRead(buf []byte) (int, error) {
 			break
 		}
 		switch fieldId {
-		case 0:
+		case 1:
 			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField0(buf[offset:])
+				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
+				issetBase = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -12530,68 +12658,70 @@ func (p *CommodityServiceDeleteCouponResult) FastRead(buf []byte) (int, error) {
 		}
 	}
 
+	if !issetBase {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommodityServiceDeleteCouponResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EraseUserCouponResp[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_EraseUserCouponResp[fieldId]))
 }
 
-func (p *CommodityServiceDeleteCouponResult) FastReadField0(buf []byte) (int, error) {
+func (p *EraseUserCouponResp) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewDeleteCouponResp()
+	_field := model.NewBaseResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 	}
-	p.Success = _field
+	p.Base = _field
 	return offset, nil
 }
 
-func (p *CommodityServiceDeleteCouponResult) FastWrite(buf []byte) int {
+func (p *EraseUserCouponResp) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *CommodityServiceDeleteCouponResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EraseUserCouponResp) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
-		offset += p.fastWriteField0(buf[offset:], w)
+		offset += p.fastWriteField1(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *CommodityServiceDeleteCouponResult) BLength() int {
+func (p *EraseUserCouponResp) BLength() int {
 	l := 0
 	if p != nil {
-		l += p.field0Length()
+		l += p.field1Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *CommodityServiceDeleteCouponResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *EraseUserCouponResp) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetSuccess() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
-		offset += p.Success.FastWriteNocopy(buf[offset:], w)
-	}
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Base.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *CommodityServiceDeleteCouponResult) field0Length() int {
+func (p *EraseUserCouponResp) field1Length() int {
 	l := 0
-	if p.IsSetSuccess() {
-		l += thrift.Binary.FieldBeginLength()
-		l += p.Success.BLength()
-	}
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Base.BLength()
 	return l
 }
 
-func (p *CommodityServiceCreateUserCouponArgs) FastRead(buf []byte) (int, error) {
+func (p *CommodityServiceCreateCouponArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -12635,14 +12765,14 @@ func (p *CommodityServiceCreateUserCouponArgs) FastRead(buf []byte) (int, error)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommodityServiceCreateUserCouponArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommodityServiceCreateCouponArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *CommodityServiceCreateUserCouponArgs) FastReadField1(buf []byte) (int, error) {
+func (p *CommodityServiceCreateCouponArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCreateUserCouponReq()
+	_field := NewCreateCouponReq()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -12652,11 +12782,11 @@ func (p *CommodityServiceCreateUserCouponArgs) FastReadField1(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *CommodityServiceCreateUserCouponArgs) FastWrite(buf []byte) int {
+func (p *CommodityServiceCreateCouponArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *CommodityServiceCreateUserCouponArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *CommodityServiceCreateCouponArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -12665,7 +12795,7 @@ func (p *CommodityServiceCreateUserCouponArgs) FastWriteNocopy(buf []byte, w thr
 	return offset
 }
 
-func (p *CommodityServiceCreateUserCouponArgs) BLength() int {
+func (p *CommodityServiceCreateCouponArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -12674,21 +12804,21 @@ func (p *CommodityServiceCreateUserCouponArgs) BLength() int {
 	return l
 }
 
-func (p *CommodityServiceCreateUserCouponArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *CommodityServiceCreateCouponArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *CommodityServiceCreateUserCouponArgs) field1Length() int {
+func (p *CommodityServiceCreateCouponArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *CommodityServiceCreateUserCouponResult) FastRead(buf []byte) (int, error) {
+func (p *CommodityServiceCreateCouponResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -12732,14 +12862,14 @@ func (p *CommodityServiceCreateUserCouponResult) FastRead(buf []byte) (int, erro
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommodityServiceCreateUserCouponResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommodityServiceCreateCouponResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *CommodityServiceCreateUserCouponResult) FastReadField0(buf []byte) (int, error) {
+func (p *CommodityServiceCreateCouponResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCreateUserCouponResp()
+	_field := NewCreateCouponResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -12749,11 +12879,11 @@ func (p *CommodityServiceCreateUserCouponResult) FastReadField0(buf []byte) (int
 	return offset, nil
 }
 
-func (p *CommodityServiceCreateUserCouponResult) FastWrite(buf []byte) int {
+func (p *CommodityServiceCreateCouponResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *CommodityServiceCreateUserCouponResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *CommodityServiceCreateCouponResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -12762,7 +12892,7 @@ func (p *CommodityServiceCreateUserCouponResult) FastWriteNocopy(buf []byte, w t
 	return offset
 }
 
-func (p *CommodityServiceCreateUserCouponResult) BLength() int {
+func (p *CommodityServiceCreateCouponResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -12771,7 +12901,7 @@ func (p *CommodityServiceCreateUserCouponResult) BLength() int {
 	return l
 }
 
-func (p *CommodityServiceCreateUserCouponResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *CommodityServiceCreateCouponResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -12780,7 +12910,7 @@ func (p *CommodityServiceCreateUserCouponResult) fastWriteField0(buf []byte, w t
 	return offset
 }
 
-func (p *CommodityServiceCreateUserCouponResult) field0Length() int {
+func (p *CommodityServiceCreateCouponResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -12789,7 +12919,403 @@ func (p *CommodityServiceCreateUserCouponResult) field0Length() int {
 	return l
 }
 
-func (p *CommodityServiceViewCouponArgs) FastRead(buf []byte) (int, error) {
+func (p *CommodityServiceDeleteCouponArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommodityServiceDeleteCouponArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *CommodityServiceDeleteCouponArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewDeleteCouponReq()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Req = _field
+	return offset, nil
+}
+
+func (p *CommodityServiceDeleteCouponArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *CommodityServiceDeleteCouponArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *CommodityServiceDeleteCouponArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *CommodityServiceDeleteCouponArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *CommodityServiceDeleteCouponArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Req.BLength()
+	return l
+}
+
+func (p *CommodityServiceDeleteCouponResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommodityServiceDeleteCouponResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *CommodityServiceDeleteCouponResult) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+	_field := NewDeleteCouponResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Success = _field
+	return offset, nil
+}
+
+func (p *CommodityServiceDeleteCouponResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *CommodityServiceDeleteCouponResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *CommodityServiceDeleteCouponResult) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *CommodityServiceDeleteCouponResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *CommodityServiceDeleteCouponResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
+	}
+	return l
+}
+
+func (p *CommodityServiceCreateUserCouponArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommodityServiceCreateUserCouponArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *CommodityServiceCreateUserCouponArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewCreateUserCouponReq()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Req = _field
+	return offset, nil
+}
+
+func (p *CommodityServiceCreateUserCouponArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *CommodityServiceCreateUserCouponArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *CommodityServiceCreateUserCouponArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *CommodityServiceCreateUserCouponArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *CommodityServiceCreateUserCouponArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Req.BLength()
+	return l
+}
+
+func (p *CommodityServiceCreateUserCouponResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommodityServiceCreateUserCouponResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *CommodityServiceCreateUserCouponResult) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+	_field := NewCreateUserCouponResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Success = _field
+	return offset, nil
+}
+
+func (p *CommodityServiceCreateUserCouponResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *CommodityServiceCreateUserCouponResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *CommodityServiceCreateUserCouponResult) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *CommodityServiceCreateUserCouponResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *CommodityServiceCreateUserCouponResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
+	}
+	return l
+}
+
+func (p *CommodityServiceViewCouponArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -12838,9 +13364,405 @@ SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *CommodityServiceViewCouponArgs) FastReadField1(buf []byte) (int, error) {
+func (p *CommodityServiceViewCouponArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewViewCouponReq()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Req = _field
+	return offset, nil
+}
+
+func (p *CommodityServiceViewCouponArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *CommodityServiceViewCouponArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *CommodityServiceViewCouponArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *CommodityServiceViewCouponArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *CommodityServiceViewCouponArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Req.BLength()
+	return l
+}
+
+func (p *CommodityServiceViewCouponResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommodityServiceViewCouponResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *CommodityServiceViewCouponResult) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+	_field := NewViewCouponResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Success = _field
+	return offset, nil
+}
+
+func (p *CommodityServiceViewCouponResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *CommodityServiceViewCouponResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *CommodityServiceViewCouponResult) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *CommodityServiceViewCouponResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *CommodityServiceViewCouponResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
+	}
+	return l
+}
+
+func (p *CommodityServiceViewUserAllCouponArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommodityServiceViewUserAllCouponArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *CommodityServiceViewUserAllCouponArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewViewUserAllCouponReq()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Req = _field
+	return offset, nil
+}
+
+func (p *CommodityServiceViewUserAllCouponArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *CommodityServiceViewUserAllCouponArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *CommodityServiceViewUserAllCouponArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *CommodityServiceViewUserAllCouponArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *CommodityServiceViewUserAllCouponArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Req.BLength()
+	return l
+}
+
+func (p *CommodityServiceViewUserAllCouponResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommodityServiceViewUserAllCouponResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *CommodityServiceViewUserAllCouponResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewViewCouponReq()
+	_field := NewViewUserAllCouponResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Success = _field
+	return offset, nil
+}
+
+func (p *CommodityServiceViewUserAllCouponResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *CommodityServiceViewUserAllCouponResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *CommodityServiceViewUserAllCouponResult) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *CommodityServiceViewUserAllCouponResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *CommodityServiceViewUserAllCouponResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
+	}
+	return l
+}
+
+func (p *CommodityServiceGetCouponAndPriceArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommodityServiceGetCouponAndPriceArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *CommodityServiceGetCouponAndPriceArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewGetCouponAndPriceReq()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -12850,11 +13772,11 @@ func (p *CommodityServiceViewCouponArgs) FastReadField1(buf []byte) (int, error)
 	return offset, nil
 }
 
-func (p *CommodityServiceViewCouponArgs) FastWrite(buf []byte) int {
+func (p *CommodityServiceGetCouponAndPriceArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *CommodityServiceViewCouponArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *CommodityServiceGetCouponAndPriceArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -12863,7 +13785,7 @@ func (p *CommodityServiceViewCouponArgs) FastWriteNocopy(buf []byte, w thrift.No
 	return offset
 }
 
-func (p *CommodityServiceViewCouponArgs) BLength() int {
+func (p *CommodityServiceGetCouponAndPriceArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -12872,21 +13794,21 @@ func (p *CommodityServiceViewCouponArgs) BLength() int {
 	return l
 }
 
-func (p *CommodityServiceViewCouponArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *CommodityServiceGetCouponAndPriceArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *CommodityServiceViewCouponArgs) field1Length() int {
+func (p *CommodityServiceGetCouponAndPriceArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *CommodityServiceViewCouponResult) FastRead(buf []byte) (int, error) {
+func (p *CommodityServiceGetCouponAndPriceResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -12930,14 +13852,14 @@ func (p *CommodityServiceViewCouponResult) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommodityServiceViewCouponResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommodityServiceGetCouponAndPriceResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *CommodityServiceViewCouponResult) FastReadField0(buf []byte) (int, error) {
+func (p *CommodityServiceGetCouponAndPriceResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewViewCouponResp()
+	_field := NewGetCouponAndPriceResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -12947,11 +13869,11 @@ func (p *CommodityServiceViewCouponResult) FastReadField0(buf []byte) (int, erro
 	return offset, nil
 }
 
-func (p *CommodityServiceViewCouponResult) FastWrite(buf []byte) int {
+func (p *CommodityServiceGetCouponAndPriceResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *CommodityServiceViewCouponResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *CommodityServiceGetCouponAndPriceResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -12960,7 +13882,7 @@ func (p *CommodityServiceViewCouponResult) FastWriteNocopy(buf []byte, w thrift.
 	return offset
 }
 
-func (p *CommodityServiceViewCouponResult) BLength() int {
+func (p *CommodityServiceGetCouponAndPriceResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -12969,7 +13891,7 @@ func (p *CommodityServiceViewCouponResult) BLength() int {
 	return l
 }
 
-func (p *CommodityServiceViewCouponResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *CommodityServiceGetCouponAndPriceResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -12978,7 +13900,7 @@ func (p *CommodityServiceViewCouponResult) fastWriteField0(buf []byte, w thrift.
 	return offset
 }
 
-func (p *CommodityServiceViewCouponResult) field0Length() int {
+func (p *CommodityServiceGetCouponAndPriceResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -12987,7 +13909,7 @@ func (p *CommodityServiceViewCouponResult) field0Length() int {
 	return l
 }
 
-func (p *CommodityServiceViewUserAllCouponArgs) FastRead(buf []byte) (int, error) {
+func (p *CommodityServiceExportUserCouponArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -13031,14 +13953,14 @@ func (p *CommodityServiceViewUserAllCouponArgs) FastRead(buf []byte) (int, error
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommodityServiceViewUserAllCouponArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommodityServiceExportUserCouponArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *CommodityServiceViewUserAllCouponArgs) FastReadField1(buf []byte) (int, error) {
+func (p *CommodityServiceExportUserCouponArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewViewUserAllCouponReq()
+	_field := NewExportUserCouponReq()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -13048,11 +13970,11 @@ func (p *CommodityServiceViewUserAllCouponArgs) FastReadField1(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *CommodityServiceViewUserAllCouponArgs) FastWrite(buf []byte) int {
+func (p *CommodityServiceExportUserCouponArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *CommodityServiceViewUserAllCouponArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *CommodityServiceExportUserCouponArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -13061,7 +13983,7 @@ func (p *CommodityServiceViewUserAllCouponArgs) FastWriteNocopy(buf []byte, w th
 	return offset
 }
 
-func (p *CommodityServiceViewUserAllCouponArgs) BLength() int {
+func (p *CommodityServiceExportUserCouponArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -13070,21 +13992,21 @@ func (p *CommodityServiceViewUserAllCouponArgs) BLength() int {
 	return l
 }
 
-func (p *CommodityServiceViewUserAllCouponArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *CommodityServiceExportUserCouponArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *CommodityServiceViewUserAllCouponArgs) field1Length() int {
+func (p *CommodityServiceExportUserCouponArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *CommodityServiceViewUserAllCouponResult) FastRead(buf []byte) (int, error) {
+func (p *CommodityServiceExportUserCouponResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -13128,14 +14050,14 @@ func (p *CommodityServiceViewUserAllCouponResult) FastRead(buf []byte) (int, err
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommodityServiceViewUserAllCouponResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommodityServiceExportUserCouponResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *CommodityServiceViewUserAllCouponResult) FastReadField0(buf []byte) (int, error) {
+func (p *CommodityServiceExportUserCouponResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewViewUserAllCouponResp()
+	_field := NewExportUserCouponResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -13145,11 +14067,11 @@ func (p *CommodityServiceViewUserAllCouponResult) FastReadField0(buf []byte) (in
 	return offset, nil
 }
 
-func (p *CommodityServiceViewUserAllCouponResult) FastWrite(buf []byte) int {
+func (p *CommodityServiceExportUserCouponResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *CommodityServiceViewUserAllCouponResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *CommodityServiceExportUserCouponResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -13158,7 +14080,7 @@ func (p *CommodityServiceViewUserAllCouponResult) FastWriteNocopy(buf []byte, w
 	return offset
 }
 
-func (p *CommodityServiceViewUserAllCouponResult) BLength() int {
+func (p *CommodityServiceExportUserCouponResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -13167,7 +14089,7 @@ func (p *CommodityServiceViewUserAllCouponResult) BLength() int {
 	return l
 }
 
-func (p *CommodityServiceViewUserAllCouponResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *CommodityServiceExportUserCouponResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -13176,7 +14098,7 @@ func (p *CommodityServiceViewUserAllCouponResult) fastWriteField0(buf []byte, w
 	return offset
 }
 
-func (p *CommodityServiceViewUserAllCouponResult) field0Length() int {
+func (p *CommodityServiceExportUserCouponResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -13185,7 +14107,7 @@ func (p *CommodityServiceViewUserAllCouponResult) field0Length() int {
 	return l
 }
 
-func (p *CommodityServiceGetCouponAndPriceArgs) FastRead(buf []byte) (int, error) {
+func (p *CommodityServiceEraseUserCouponArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -13229,14 +14151,14 @@ func (p *CommodityServiceGetCouponAndPriceArgs) FastRead(buf []byte) (int, error
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommodityServiceGetCouponAndPriceArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommodityServiceEraseUserCouponArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *CommodityServiceGetCouponAndPriceArgs) FastReadField1(buf []byte) (int, error) {
+func (p *CommodityServiceEraseUserCouponArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetCouponAndPriceReq()
+	_field := NewEraseUserCouponReq()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -13246,11 +14168,11 @@ func (p *CommodityServiceGetCouponAndPriceArgs) FastReadField1(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *CommodityServiceGetCouponAndPriceArgs) FastWrite(buf []byte) int {
+func (p *CommodityServiceEraseUserCouponArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *CommodityServiceGetCouponAndPriceArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *CommodityServiceEraseUserCouponArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -13259,7 +14181,7 @@ func (p *CommodityServiceGetCouponAndPriceArgs) FastWriteNocopy(buf []byte, w th
 	return offset
 }
 
-func (p *CommodityServiceGetCouponAndPriceArgs) BLength() int {
+func (p *CommodityServiceEraseUserCouponArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -13268,21 +14190,21 @@ func (p *CommodityServiceGetCouponAndPriceArgs) BLength() int {
 	return l
 }
 
-func (p *CommodityServiceGetCouponAndPriceArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *CommodityServiceEraseUserCouponArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *CommodityServiceGetCouponAndPriceArgs) field1Length() int {
+func (p *CommodityServiceEraseUserCouponArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *CommodityServiceGetCouponAndPriceResult) FastRead(buf []byte) (int, error) {
+func (p *CommodityServiceEraseUserCouponResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -13326,14 +14248,14 @@ func (p *CommodityServiceGetCouponAndPriceResult) FastRead(buf []byte) (int, err
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommodityServiceGetCouponAndPriceResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommodityServiceEraseUserCouponResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *CommodityServiceGetCouponAndPriceResult) FastReadField0(buf []byte) (int, error) {
+func (p *CommodityServiceEraseUserCouponResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetCouponAndPriceResp()
+	_field := NewEraseUserCouponResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -13343,11 +14265,11 @@ func (p *CommodityServiceGetCouponAndPriceResult) FastReadField0(buf []byte) (in
 	return offset, nil
 }
 
-func (p *CommodityServiceGetCouponAndPriceResult) FastWrite(buf []byte) int {
+func (p *CommodityServiceEraseUserCouponResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *CommodityServiceGetCouponAndPriceResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *CommodityServiceEraseUserCouponResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -13356,7 +14278,7 @@ func (p *CommodityServiceGetCouponAndPriceResult) FastWriteNocopy(buf []byte, w
 	return offset
 }
 
-func (p *CommodityServiceGetCouponAndPriceResult) BLength() int {
+func (p *CommodityServiceEraseUserCouponResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -13365,7 +14287,7 @@ func (p *CommodityServiceGetCouponAndPriceResult) BLength() int {
 	return l
 }
 
-func (p *CommodityServiceGetCouponAndPriceResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *CommodityServiceEraseUserCouponResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -13374,7 +14296,7 @@ func (p *CommodityServiceGetCouponAndPriceResult) fastWriteField0(buf []byte, w
 	return offset
 }
 
-func (p *CommodityServiceGetCouponAndPriceResult) field0Length() int {
+func (p *CommodityServiceEraseUserCouponResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -18777,6 +19699,22 @@ func (p *CommodityServiceGetCouponAndPriceResult) GetResult() interface{} {
 	return p.Success
 }
 
+func (p *CommodityServiceExportUserCouponArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *CommodityServiceExportUserCouponResult) GetResult() interface{} {
+	return p.Success
+}
+
+func (p *CommodityServiceEraseUserCouponArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *CommodityServiceEraseUserCouponResult) GetResult() interface{} {
+	return p.Success
+}
+
 func (p *CommodityServiceCreateSpuArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
@@ -95,6 +95,8 @@ type UserInfo struct {
 	UserId int64  `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
 	Name   string `thrift:"name,2" frugal:"2,default,string" json:"name"`
 	Role   int64  `thrift:"role,3" frugal:"3,default,i64" json:"role"`
+	Email  string `thrift:"email,4" frugal:"4,default,string" json:"email"`
+	Phone  string `thrift:"phone,5" frugal:"5,default,string" json:"phone"`
 }
 
 func NewUserInfo() *UserInfo {
@@ -115,6 +117,14 @@ func (p *UserInfo) GetName() (v string) {
 func (p *UserInfo) GetRole() (v int64) {
 	return p.Role
 }
+
+func (p *UserInfo) GetEmail() (v string) {
+	return p.Email
+}
+
+func (p *UserInfo) GetPhone() (v string) {
+	return p.Phone
+}
 func (p *UserInfo) SetUserId(val int64) {
 	p.UserId = val
 }
@@ -124,6 +134,12 @@ func (p *UserInfo) SetName(val string) {
 func (p *UserInfo) SetRole(val int64) {
 	p.Role = val
 }
+func (p *UserInfo) SetEmail(val string) {
+	p.Email = val
+}
+func (p *UserInfo) SetPhone(val string) {
+	p.Phone = val
+}
 
 func (p *UserInfo) String() string {
 	if p == nil {
@@ -147,6 +163,12 @@ func (p *UserInfo) DeepEqual(ano *UserInfo) bool {
 	if !p.Field3DeepEqual(ano.Role) {
 		return false
 	}
+	if !p.Field4DeepEqual(ano.Email) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.Phone) {
+		return false
+	}
 	return true
 }
 
@@ -171,18 +193,37 @@ func (p *UserInfo) Field3DeepEqual(src int64) bool {
 	}
 	return true
 }
+func (p *UserInfo) Field4DeepEqual(src string) bool {
+
+	if strings.Compare(p.Email, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *UserInfo) Field5DeepEqual(src string) bool {
+
+	if strings.Compare(p.Phone, src) != 0 {
+		return false
+	}
+	return true
+}
 
 var fieldIDToName_UserInfo = map[int16]string{
 	1: "userId",
 	2: "name",
 	3: "role",
+	4: "email",
+	5: "phone",
 }
 
 type AddressInfo struct {
-	Province  string `thrift:"province,1" frugal:"1,default,string" json:"province"`
-	City      string `thrift:"city,2" frugal:"2,default,string" json:"city"`
-	Detail    string `thrift:"detail,3" frugal:"3,default,string" json:"detail"`
-	AddressID int64  `thrift:"addressID,4" frugal:"4,default,i64" json:"addressID"`
+	Province      string `thrift:"province,1" frugal:"1,default,string" json:"province"`
+	City          string `thrift:"city,2" frugal:"2,default,string" json:"city"`
+	Detail        string `thrift:"detail,3" frugal:"3,default,string" json:"detail"`
+	AddressID     int64  `thrift:"addressID,4" frugal:"4,default,i64" json:"addressID"`
+	RecipientName string `thrift:"recipientName,5" frugal:"5,default,string" json:"recipientName"`
+	Phone         string `thrift:"phone,6" frugal:"6,default,string" json:"phone"`
+	IsDefault     bool   `thrift:"isDefault,7" frugal:"7,default,bool" json:"isDefault"`
 }
 
 func NewAddressInfo() *AddressInfo {
@@ -207,6 +248,18 @@ func (p *AddressInfo) GetDetail() (v string) {
 func (p *AddressInfo) GetAddressID() (v int64) {
 	return p.AddressID
 }
+
+func (p *AddressInfo) GetRecipientName() (v string) {
+	return p.RecipientName
+}
+
+func (p *AddressInfo) GetPhone() (v string) {
+	return p.Phone
+}
+
+func (p *AddressInfo) GetIsDefault() (v bool) {
+	return p.IsDefault
+}
 func (p *AddressInfo) SetProvince(val string) {
 	p.Province = val
 }
@@ -219,69 +272,1571 @@ func (p *AddressInfo) SetDetail(val string) {
 func (p *AddressInfo) SetAddressID(val int64) {
 	p.AddressID = val
 }
+func (p *AddressInfo) SetRecipientName(val string) {
+	p.RecipientName = val
+}
+func (p *AddressInfo) SetPhone(val string) {
+	p.Phone = val
+}
+func (p *AddressInfo) SetIsDefault(val bool) {
+	p.IsDefault = val
+}
+
+func (p *AddressInfo) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AddressInfo(%+v)", *p)
+}
+
+func (p *AddressInfo) DeepEqual(ano *AddressInfo) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Province) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.City) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Detail) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.AddressID) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.RecipientName) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.Phone) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.IsDefault) {
+		return false
+	}
+	return true
+}
+
+func (p *AddressInfo) Field1DeepEqual(src string) bool {
+
+	if strings.Compare(p.Province, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *AddressInfo) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.City, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *AddressInfo) Field3DeepEqual(src string) bool {
+
+	if strings.Compare(p.Detail, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *AddressInfo) Field4DeepEqual(src int64) bool {
+
+	if p.AddressID != src {
+		return false
+	}
+	return true
+}
+func (p *AddressInfo) Field5DeepEqual(src string) bool {
+
+	if strings.Compare(p.RecipientName, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *AddressInfo) Field6DeepEqual(src string) bool {
+
+	if strings.Compare(p.Phone, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *AddressInfo) Field7DeepEqual(src bool) bool {
+
+	if p.IsDefault != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_AddressInfo = map[int16]string{
+	1: "province",
+	2: "city",
+	3: "detail",
+	4: "addressID",
+	5: "recipientName",
+	6: "phone",
+	7: "isDefault",
+}
+
+type TokenInfo struct {
+	AccessToken  string `thrift:"accessToken,1" frugal:"1,default,string" json:"accessToken"`
+	RefreshToken string `thrift:"refreshToken,2" frugal:"2,default,string" json:"refreshToken"`
+}
+
+func NewTokenInfo() *TokenInfo {
+	return &TokenInfo{}
+}
+
+func (p *TokenInfo) InitDefault() {
+}
+
+func (p *TokenInfo) GetAccessToken() (v string) {
+	return p.AccessToken
+}
+
+func (p *TokenInfo) GetRefreshToken() (v string) {
+	return p.RefreshToken
+}
+func (p *TokenInfo) SetAccessToken(val string) {
+	p.AccessToken = val
+}
+func (p *TokenInfo) SetRefreshToken(val string) {
+	p.RefreshToken = val
+}
+
+func (p *TokenInfo) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TokenInfo(%+v)", *p)
+}
+
+func (p *TokenInfo) DeepEqual(ano *TokenInfo) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.AccessToken) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.RefreshToken) {
+		return false
+	}
+	return true
+}
+
+func (p *TokenInfo) Field1DeepEqual(src string) bool {
+
+	if strings.Compare(p.AccessToken, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *TokenInfo) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.RefreshToken, src) != 0 {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_TokenInfo = map[int16]string{
+	1: "accessToken",
+	2: "refreshToken",
+}
+
+type SessionInfo struct {
+	SessionID string `thrift:"sessionID,1" frugal:"1,default,string" json:"sessionID"`
+	Device    string `thrift:"device,2" frugal:"2,default,string" json:"device"`
+	Ip        string `thrift:"ip,3" frugal:"3,default,string" json:"ip"`
+	CreatedAt int64  `thrift:"createdAt,4" frugal:"4,default,i64" json:"createdAt"`
+	ActiveAt  int64  `thrift:"activeAt,5" frugal:"5,default,i64" json:"activeAt"`
+	Current   bool   `thrift:"current,6" frugal:"6,default,bool" json:"current"`
+}
+
+func NewSessionInfo() *SessionInfo {
+	return &SessionInfo{}
+}
+
+func (p *SessionInfo) InitDefault() {
+}
+
+func (p *SessionInfo) GetSessionID() (v string) {
+	return p.SessionID
+}
+
+func (p *SessionInfo) GetDevice() (v string) {
+	return p.Device
+}
+
+func (p *SessionInfo) GetIp() (v string) {
+	return p.Ip
+}
+
+func (p *SessionInfo) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+
+func (p *SessionInfo) GetActiveAt() (v int64) {
+	return p.ActiveAt
+}
+
+func (p *SessionInfo) GetCurrent() (v bool) {
+	return p.Current
+}
+func (p *SessionInfo) SetSessionID(val string) {
+	p.SessionID = val
+}
+func (p *SessionInfo) SetDevice(val string) {
+	p.Device = val
+}
+func (p *SessionInfo) SetIp(val string) {
+	p.Ip = val
+}
+func (p *SessionInfo) SetCreatedAt(val int64) {
+	p.CreatedAt = val
+}
+func (p *SessionInfo) SetActiveAt(val int64) {
+	p.ActiveAt = val
+}
+func (p *SessionInfo) SetCurrent(val bool) {
+	p.Current = val
+}
+
+func (p *SessionInfo) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SessionInfo(%+v)", *p)
+}
+
+func (p *SessionInfo) DeepEqual(ano *SessionInfo) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.SessionID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Device) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Ip) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.CreatedAt) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.ActiveAt) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.Current) {
+		return false
+	}
+	return true
+}
+
+func (p *SessionInfo) Field1DeepEqual(src string) bool {
+
+	if strings.Compare(p.SessionID, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *SessionInfo) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.Device, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *SessionInfo) Field3DeepEqual(src string) bool {
+
+	if strings.Compare(p.Ip, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *SessionInfo) Field4DeepEqual(src int64) bool {
+
+	if p.CreatedAt != src {
+		return false
+	}
+	return true
+}
+func (p *SessionInfo) Field5DeepEqual(src int64) bool {
+
+	if p.ActiveAt != src {
+		return false
+	}
+	return true
+}
+func (p *SessionInfo) Field6DeepEqual(src bool) bool {
+
+	if p.Current != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_SessionInfo = map[int16]string{
+	1: "sessionID",
+	2: "device",
+	3: "ip",
+	4: "createdAt",
+	5: "activeAt",
+	6: "current",
+}
+
+type ShopInfo struct {
+	ShopID      int64  `thrift:"shopID,1" frugal:"1,default,i64" json:"shopID"`
+	Name        string `thrift:"name,2" frugal:"2,default,string" json:"name"`
+	Logo        string `thrift:"logo,3" frugal:"3,default,string" json:"logo"`
+	Description string `thrift:"description,4" frugal:"4,default,string" json:"description"`
+	Status      int32  `thrift:"status,5" frugal:"5,default,i32" json:"status"`
+	OwnerID     int64  `thrift:"ownerID,6" frugal:"6,default,i64" json:"ownerID"`
+	CreatedAt   int64  `thrift:"createdAt,7" frugal:"7,default,i64" json:"createdAt"`
+	UpdatedAt   int64  `thrift:"updatedAt,8" frugal:"8,default,i64" json:"updatedAt"`
+}
+
+func NewShopInfo() *ShopInfo {
+	return &ShopInfo{}
+}
+
+func (p *ShopInfo) InitDefault() {
+}
+
+func (p *ShopInfo) GetShopID() (v int64) {
+	return p.ShopID
+}
+
+func (p *ShopInfo) GetName() (v string) {
+	return p.Name
+}
+
+func (p *ShopInfo) GetLogo() (v string) {
+	return p.Logo
+}
+
+func (p *ShopInfo) GetDescription() (v string) {
+	return p.Description
+}
+
+func (p *ShopInfo) GetStatus() (v int32) {
+	return p.Status
+}
+
+func (p *ShopInfo) GetOwnerID() (v int64) {
+	return p.OwnerID
+}
+
+func (p *ShopInfo) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+
+func (p *ShopInfo) GetUpdatedAt() (v int64) {
+	return p.UpdatedAt
+}
+func (p *ShopInfo) SetShopID(val int64) {
+	p.ShopID = val
+}
+func (p *ShopInfo) SetName(val string) {
+	p.Name = val
+}
+func (p *ShopInfo) SetLogo(val string) {
+	p.Logo = val
+}
+func (p *ShopInfo) SetDescription(val string) {
+	p.Description = val
+}
+func (p *ShopInfo) SetStatus(val int32) {
+	p.Status = val
+}
+func (p *ShopInfo) SetOwnerID(val int64) {
+	p.OwnerID = val
+}
+func (p *ShopInfo) SetCreatedAt(val int64) {
+	p.CreatedAt = val
+}
+func (p *ShopInfo) SetUpdatedAt(val int64) {
+	p.UpdatedAt = val
+}
+
+func (p *ShopInfo) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ShopInfo(%+v)", *p)
+}
+
+func (p *ShopInfo) DeepEqual(ano *ShopInfo) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.ShopID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Name) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Logo) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Description) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.Status) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.OwnerID) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.CreatedAt) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.UpdatedAt) {
+		return false
+	}
+	return true
+}
+
+func (p *ShopInfo) Field1DeepEqual(src int64) bool {
+
+	if p.ShopID != src {
+		return false
+	}
+	return true
+}
+func (p *ShopInfo) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.Name, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ShopInfo) Field3DeepEqual(src string) bool {
+
+	if strings.Compare(p.Logo, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ShopInfo) Field4DeepEqual(src string) bool {
+
+	if strings.Compare(p.Description, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ShopInfo) Field5DeepEqual(src int32) bool {
+
+	if p.Status != src {
+		return false
+	}
+	return true
+}
+func (p *ShopInfo) Field6DeepEqual(src int64) bool {
+
+	if p.OwnerID != src {
+		return false
+	}
+	return true
+}
+func (p *ShopInfo) Field7DeepEqual(src int64) bool {
+
+	if p.CreatedAt != src {
+		return false
+	}
+	return true
+}
+func (p *ShopInfo) Field8DeepEqual(src int64) bool {
+
+	if p.UpdatedAt != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_ShopInfo = map[int16]string{
+	1: "shopID",
+	2: "name",
+	3: "logo",
+	4: "description",
+	5: "status",
+	6: "ownerID",
+	7: "createdAt",
+	8: "updatedAt",
+}
+
+type ShopStaffInfo struct {
+	ShopID    int64 `thrift:"shopID,1" frugal:"1,default,i64" json:"shopID"`
+	Uid       int64 `thrift:"uid,2" frugal:"2,default,i64" json:"uid"`
+	Role      int32 `thrift:"role,3" frugal:"3,default,i32" json:"role"`
+	CreatedAt int64 `thrift:"createdAt,4" frugal:"4,default,i64" json:"createdAt"`
+}
+
+func NewShopStaffInfo() *ShopStaffInfo {
+	return &ShopStaffInfo{}
+}
+
+func (p *ShopStaffInfo) InitDefault() {
+}
+
+func (p *ShopStaffInfo) GetShopID() (v int64) {
+	return p.ShopID
+}
+
+func (p *ShopStaffInfo) GetUid() (v int64) {
+	return p.Uid
+}
+
+func (p *ShopStaffInfo) GetRole() (v int32) {
+	return p.Role
+}
+
+func (p *ShopStaffInfo) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+func (p *ShopStaffInfo) SetShopID(val int64) {
+	p.ShopID = val
+}
+func (p *ShopStaffInfo) SetUid(val int64) {
+	p.Uid = val
+}
+func (p *ShopStaffInfo) SetRole(val int32) {
+	p.Role = val
+}
+func (p *ShopStaffInfo) SetCreatedAt(val int64) {
+	p.CreatedAt = val
+}
+
+func (p *ShopStaffInfo) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ShopStaffInfo(%+v)", *p)
+}
+
+func (p *ShopStaffInfo) DeepEqual(ano *ShopStaffInfo) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.ShopID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Uid) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Role) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.CreatedAt) {
+		return false
+	}
+	return true
+}
+
+func (p *ShopStaffInfo) Field1DeepEqual(src int64) bool {
+
+	if p.ShopID != src {
+		return false
+	}
+	return true
+}
+func (p *ShopStaffInfo) Field2DeepEqual(src int64) bool {
+
+	if p.Uid != src {
+		return false
+	}
+	return true
+}
+func (p *ShopStaffInfo) Field3DeepEqual(src int32) bool {
+
+	if p.Role != src {
+		return false
+	}
+	return true
+}
+func (p *ShopStaffInfo) Field4DeepEqual(src int64) bool {
+
+	if p.CreatedAt != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_ShopStaffInfo = map[int16]string{
+	1: "shopID",
+	2: "uid",
+	3: "role",
+	4: "createdAt",
+}
+
+type BanInfo struct {
+	BanID      int64  `thrift:"banID,1" frugal:"1,default,i64" json:"banID"`
+	Uid        int64  `thrift:"uid,2" frugal:"2,default,i64" json:"uid"`
+	Reason     string `thrift:"reason,3" frugal:"3,default,string" json:"reason"`
+	OperatorID int64  `thrift:"operatorID,4" frugal:"4,default,i64" json:"operatorID"`
+	StartAt    int64  `thrift:"startAt,5" frugal:"5,default,i64" json:"startAt"`
+	EndAt      int64  `thrift:"endAt,6" frugal:"6,default,i64" json:"endAt"`
+	LiftedAt   int64  `thrift:"liftedAt,7" frugal:"7,default,i64" json:"liftedAt"`
+	LiftedBy   int64  `thrift:"liftedBy,8" frugal:"8,default,i64" json:"liftedBy"`
+}
+
+func NewBanInfo() *BanInfo {
+	return &BanInfo{}
+}
+
+func (p *BanInfo) InitDefault() {
+}
+
+func (p *BanInfo) GetBanID() (v int64) {
+	return p.BanID
+}
+
+func (p *BanInfo) GetUid() (v int64) {
+	return p.Uid
+}
+
+func (p *BanInfo) GetReason() (v string) {
+	return p.Reason
+}
+
+func (p *BanInfo) GetOperatorID() (v int64) {
+	return p.OperatorID
+}
+
+func (p *BanInfo) GetStartAt() (v int64) {
+	return p.StartAt
+}
+
+func (p *BanInfo) GetEndAt() (v int64) {
+	return p.EndAt
+}
+
+func (p *BanInfo) GetLiftedAt() (v int64) {
+	return p.LiftedAt
+}
+
+func (p *BanInfo) GetLiftedBy() (v int64) {
+	return p.LiftedBy
+}
+func (p *BanInfo) SetBanID(val int64) {
+	p.BanID = val
+}
+func (p *BanInfo) SetUid(val int64) {
+	p.Uid = val
+}
+func (p *BanInfo) SetReason(val string) {
+	p.Reason = val
+}
+func (p *BanInfo) SetOperatorID(val int64) {
+	p.OperatorID = val
+}
+func (p *BanInfo) SetStartAt(val int64) {
+	p.StartAt = val
+}
+func (p *BanInfo) SetEndAt(val int64) {
+	p.EndAt = val
+}
+func (p *BanInfo) SetLiftedAt(val int64) {
+	p.LiftedAt = val
+}
+func (p *BanInfo) SetLiftedBy(val int64) {
+	p.LiftedBy = val
+}
+
+func (p *BanInfo) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BanInfo(%+v)", *p)
+}
+
+func (p *BanInfo) DeepEqual(ano *BanInfo) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.BanID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Uid) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Reason) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.OperatorID) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.StartAt) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.EndAt) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.LiftedAt) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.LiftedBy) {
+		return false
+	}
+	return true
+}
+
+func (p *BanInfo) Field1DeepEqual(src int64) bool {
+
+	if p.BanID != src {
+		return false
+	}
+	return true
+}
+func (p *BanInfo) Field2DeepEqual(src int64) bool {
+
+	if p.Uid != src {
+		return false
+	}
+	return true
+}
+func (p *BanInfo) Field3DeepEqual(src string) bool {
+
+	if strings.Compare(p.Reason, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *BanInfo) Field4DeepEqual(src int64) bool {
+
+	if p.OperatorID != src {
+		return false
+	}
+	return true
+}
+func (p *BanInfo) Field5DeepEqual(src int64) bool {
+
+	if p.StartAt != src {
+		return false
+	}
+	return true
+}
+func (p *BanInfo) Field6DeepEqual(src int64) bool {
+
+	if p.EndAt != src {
+		return false
+	}
+	return true
+}
+func (p *BanInfo) Field7DeepEqual(src int64) bool {
+
+	if p.LiftedAt != src {
+		return false
+	}
+	return true
+}
+func (p *BanInfo) Field8DeepEqual(src int64) bool {
+
+	if p.LiftedBy != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_BanInfo = map[int16]string{
+	1: "banID",
+	2: "uid",
+	3: "reason",
+	4: "operatorID",
+	5: "startAt",
+	6: "endAt",
+	7: "liftedAt",
+	8: "liftedBy",
+}
+
+type ApiKeyInfo struct {
+	KeyID     int64    `thrift:"keyID,1" frugal:"1,default,i64" json:"keyID"`
+	Name      string   `thrift:"name,2" frugal:"2,default,string" json:"name"`
+	Prefix    string   `thrift:"prefix,3" frugal:"3,default,string" json:"prefix"`
+	Scopes    []string `thrift:"scopes,4" frugal:"4,default,list<string>" json:"scopes"`
+	CreatedAt int64    `thrift:"createdAt,5" frugal:"5,default,i64" json:"createdAt"`
+}
+
+func NewApiKeyInfo() *ApiKeyInfo {
+	return &ApiKeyInfo{}
+}
+
+func (p *ApiKeyInfo) InitDefault() {
+}
+
+func (p *ApiKeyInfo) GetKeyID() (v int64) {
+	return p.KeyID
+}
+
+func (p *ApiKeyInfo) GetName() (v string) {
+	return p.Name
+}
+
+func (p *ApiKeyInfo) GetPrefix() (v string) {
+	return p.Prefix
+}
+
+func (p *ApiKeyInfo) GetScopes() (v []string) {
+	return p.Scopes
+}
+
+func (p *ApiKeyInfo) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+func (p *ApiKeyInfo) SetKeyID(val int64) {
+	p.KeyID = val
+}
+func (p *ApiKeyInfo) SetName(val string) {
+	p.Name = val
+}
+func (p *ApiKeyInfo) SetPrefix(val string) {
+	p.Prefix = val
+}
+func (p *ApiKeyInfo) SetScopes(val []string) {
+	p.Scopes = val
+}
+func (p *ApiKeyInfo) SetCreatedAt(val int64) {
+	p.CreatedAt = val
+}
+
+func (p *ApiKeyInfo) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ApiKeyInfo(%+v)", *p)
+}
+
+func (p *ApiKeyInfo) DeepEqual(ano *ApiKeyInfo) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.KeyID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Name) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Prefix) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Scopes) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.CreatedAt) {
+		return false
+	}
+	return true
+}
+
+func (p *ApiKeyInfo) Field1DeepEqual(src int64) bool {
+
+	if p.KeyID != src {
+		return false
+	}
+	return true
+}
+func (p *ApiKeyInfo) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.Name, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ApiKeyInfo) Field3DeepEqual(src string) bool {
+
+	if strings.Compare(p.Prefix, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ApiKeyInfo) Field4DeepEqual(src []string) bool {
+
+	if len(p.Scopes) != len(src) {
+		return false
+	}
+	for i, v := range p.Scopes {
+		_src := src[i]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
+	}
+	return true
+}
+func (p *ApiKeyInfo) Field5DeepEqual(src int64) bool {
+
+	if p.CreatedAt != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_ApiKeyInfo = map[int16]string{
+	1: "keyID",
+	2: "name",
+	3: "prefix",
+	4: "scopes",
+	5: "createdAt",
+}
+
+type AuditLogInfo struct {
+	LogID     int64  `thrift:"logID,1" frugal:"1,default,i64" json:"logID"`
+	ActorID   int64  `thrift:"actorID,2" frugal:"2,default,i64" json:"actorID"`
+	Action    string `thrift:"action,3" frugal:"3,default,string" json:"action"`
+	Target    string `thrift:"target,4" frugal:"4,default,string" json:"target"`
+	Before    string `thrift:"before,5" frugal:"5,default,string" json:"before"`
+	After     string `thrift:"after,6" frugal:"6,default,string" json:"after"`
+	ClientIP  string `thrift:"clientIP,7" frugal:"7,default,string" json:"clientIP"`
+	TraceID   string `thrift:"traceID,8" frugal:"8,default,string" json:"traceID"`
+	CreatedAt int64  `thrift:"createdAt,9" frugal:"9,default,i64" json:"createdAt"`
+}
+
+func NewAuditLogInfo() *AuditLogInfo {
+	return &AuditLogInfo{}
+}
+
+func (p *AuditLogInfo) InitDefault() {
+}
+
+func (p *AuditLogInfo) GetLogID() (v int64) {
+	return p.LogID
+}
+
+func (p *AuditLogInfo) GetActorID() (v int64) {
+	return p.ActorID
+}
+
+func (p *AuditLogInfo) GetAction() (v string) {
+	return p.Action
+}
+
+func (p *AuditLogInfo) GetTarget() (v string) {
+	return p.Target
+}
+
+func (p *AuditLogInfo) GetBefore() (v string) {
+	return p.Before
+}
+
+func (p *AuditLogInfo) GetAfter() (v string) {
+	return p.After
+}
+
+func (p *AuditLogInfo) GetClientIP() (v string) {
+	return p.ClientIP
+}
+
+func (p *AuditLogInfo) GetTraceID() (v string) {
+	return p.TraceID
+}
+
+func (p *AuditLogInfo) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+func (p *AuditLogInfo) SetLogID(val int64) {
+	p.LogID = val
+}
+func (p *AuditLogInfo) SetActorID(val int64) {
+	p.ActorID = val
+}
+func (p *AuditLogInfo) SetAction(val string) {
+	p.Action = val
+}
+func (p *AuditLogInfo) SetTarget(val string) {
+	p.Target = val
+}
+func (p *AuditLogInfo) SetBefore(val string) {
+	p.Before = val
+}
+func (p *AuditLogInfo) SetAfter(val string) {
+	p.After = val
+}
+func (p *AuditLogInfo) SetClientIP(val string) {
+	p.ClientIP = val
+}
+func (p *AuditLogInfo) SetTraceID(val string) {
+	p.TraceID = val
+}
+func (p *AuditLogInfo) SetCreatedAt(val int64) {
+	p.CreatedAt = val
+}
+
+func (p *AuditLogInfo) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AuditLogInfo(%+v)", *p)
+}
+
+func (p *AuditLogInfo) DeepEqual(ano *AuditLogInfo) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.LogID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.ActorID) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Action) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Target) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.Before) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.After) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.ClientIP) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.TraceID) {
+		return false
+	}
+	if !p.Field9DeepEqual(ano.CreatedAt) {
+		return false
+	}
+	return true
+}
+
+func (p *AuditLogInfo) Field1DeepEqual(src int64) bool {
+
+	if p.LogID != src {
+		return false
+	}
+	return true
+}
+func (p *AuditLogInfo) Field2DeepEqual(src int64) bool {
+
+	if p.ActorID != src {
+		return false
+	}
+	return true
+}
+func (p *AuditLogInfo) Field3DeepEqual(src string) bool {
+
+	if strings.Compare(p.Action, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *AuditLogInfo) Field4DeepEqual(src string) bool {
+
+	if strings.Compare(p.Target, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *AuditLogInfo) Field5DeepEqual(src string) bool {
+
+	if strings.Compare(p.Before, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *AuditLogInfo) Field6DeepEqual(src string) bool {
+
+	if strings.Compare(p.After, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *AuditLogInfo) Field7DeepEqual(src string) bool {
+
+	if strings.Compare(p.ClientIP, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *AuditLogInfo) Field8DeepEqual(src string) bool {
+
+	if strings.Compare(p.TraceID, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *AuditLogInfo) Field9DeepEqual(src int64) bool {
+
+	if p.CreatedAt != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_AuditLogInfo = map[int16]string{
+	1: "logID",
+	2: "actorID",
+	3: "action",
+	4: "target",
+	5: "before",
+	6: "after",
+	7: "clientIP",
+	8: "traceID",
+	9: "createdAt",
+}
+
+type WebhookEndpointInfo struct {
+	EndpointID          int64    `thrift:"endpointID,1" frugal:"1,default,i64" json:"endpointID"`
+	ShopID              int64    `thrift:"shopID,2" frugal:"2,default,i64" json:"shopID"`
+	Url                 string   `thrift:"url,3" frugal:"3,default,string" json:"url"`
+	EventTypes          []string `thrift:"eventTypes,4" frugal:"4,default,list<string>" json:"eventTypes"`
+	Enabled             bool     `thrift:"enabled,5" frugal:"5,default,bool" json:"enabled"`
+	ConsecutiveFailures int64    `thrift:"consecutiveFailures,6" frugal:"6,default,i64" json:"consecutiveFailures"`
+	CreatedAt           int64    `thrift:"createdAt,7" frugal:"7,default,i64" json:"createdAt"`
+	UpdatedAt           int64    `thrift:"updatedAt,8" frugal:"8,default,i64" json:"updatedAt"`
+}
+
+func NewWebhookEndpointInfo() *WebhookEndpointInfo {
+	return &WebhookEndpointInfo{}
+}
+
+func (p *WebhookEndpointInfo) InitDefault() {
+}
+
+func (p *WebhookEndpointInfo) GetEndpointID() (v int64) {
+	return p.EndpointID
+}
+
+func (p *WebhookEndpointInfo) GetShopID() (v int64) {
+	return p.ShopID
+}
+
+func (p *WebhookEndpointInfo) GetUrl() (v string) {
+	return p.Url
+}
+
+func (p *WebhookEndpointInfo) GetEventTypes() (v []string) {
+	return p.EventTypes
+}
+
+func (p *WebhookEndpointInfo) GetEnabled() (v bool) {
+	return p.Enabled
+}
+
+func (p *WebhookEndpointInfo) GetConsecutiveFailures() (v int64) {
+	return p.ConsecutiveFailures
+}
+
+func (p *WebhookEndpointInfo) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+
+func (p *WebhookEndpointInfo) GetUpdatedAt() (v int64) {
+	return p.UpdatedAt
+}
+func (p *WebhookEndpointInfo) SetEndpointID(val int64) {
+	p.EndpointID = val
+}
+func (p *WebhookEndpointInfo) SetShopID(val int64) {
+	p.ShopID = val
+}
+func (p *WebhookEndpointInfo) SetUrl(val string) {
+	p.Url = val
+}
+func (p *WebhookEndpointInfo) SetEventTypes(val []string) {
+	p.EventTypes = val
+}
+func (p *WebhookEndpointInfo) SetEnabled(val bool) {
+	p.Enabled = val
+}
+func (p *WebhookEndpointInfo) SetConsecutiveFailures(val int64) {
+	p.ConsecutiveFailures = val
+}
+func (p *WebhookEndpointInfo) SetCreatedAt(val int64) {
+	p.CreatedAt = val
+}
+func (p *WebhookEndpointInfo) SetUpdatedAt(val int64) {
+	p.UpdatedAt = val
+}
+
+func (p *WebhookEndpointInfo) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("WebhookEndpointInfo(%+v)", *p)
+}
+
+func (p *WebhookEndpointInfo) DeepEqual(ano *WebhookEndpointInfo) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.EndpointID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.ShopID) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Url) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.EventTypes) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.Enabled) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.ConsecutiveFailures) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.CreatedAt) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.UpdatedAt) {
+		return false
+	}
+	return true
+}
+
+func (p *WebhookEndpointInfo) Field1DeepEqual(src int64) bool {
+
+	if p.EndpointID != src {
+		return false
+	}
+	return true
+}
+func (p *WebhookEndpointInfo) Field2DeepEqual(src int64) bool {
+
+	if p.ShopID != src {
+		return false
+	}
+	return true
+}
+func (p *WebhookEndpointInfo) Field3DeepEqual(src string) bool {
+
+	if strings.Compare(p.Url, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *WebhookEndpointInfo) Field4DeepEqual(src []string) bool {
+
+	if len(p.EventTypes) != len(src) {
+		return false
+	}
+	for i, v := range p.EventTypes {
+		_src := src[i]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
+	}
+	return true
+}
+func (p *WebhookEndpointInfo) Field5DeepEqual(src bool) bool {
+
+	if p.Enabled != src {
+		return false
+	}
+	return true
+}
+func (p *WebhookEndpointInfo) Field6DeepEqual(src int64) bool {
+
+	if p.ConsecutiveFailures != src {
+		return false
+	}
+	return true
+}
+func (p *WebhookEndpointInfo) Field7DeepEqual(src int64) bool {
+
+	if p.CreatedAt != src {
+		return false
+	}
+	return true
+}
+func (p *WebhookEndpointInfo) Field8DeepEqual(src int64) bool {
+
+	if p.UpdatedAt != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_WebhookEndpointInfo = map[int16]string{
+	1: "endpointID",
+	2: "shopID",
+	3: "url",
+	4: "eventTypes",
+	5: "enabled",
+	6: "consecutiveFailures",
+	7: "createdAt",
+	8: "updatedAt",
+}
+
+type WebhookDeliveryInfo struct {
+	DeliveryID     int64  `thrift:"deliveryID,1" frugal:"1,default,i64" json:"deliveryID"`
+	EndpointID     int64  `thrift:"endpointID,2" frugal:"2,default,i64" json:"endpointID"`
+	EventID        string `thrift:"eventID,3" frugal:"3,default,string" json:"eventID"`
+	EventType      string `thrift:"eventType,4" frugal:"4,default,string" json:"eventType"`
+	Payload        string `thrift:"payload,5" frugal:"5,default,string" json:"payload"`
+	Status         int32  `thrift:"status,6" frugal:"6,default,i32" json:"status"`
+	Attempts       int64  `thrift:"attempts,7" frugal:"7,default,i64" json:"attempts"`
+	NextRetryAt    int64  `thrift:"nextRetryAt,8" frugal:"8,default,i64" json:"nextRetryAt"`
+	LastStatusCode int64  `thrift:"lastStatusCode,9" frugal:"9,default,i64" json:"lastStatusCode"`
+	LastError      string `thrift:"lastError,10" frugal:"10,default,string" json:"lastError"`
+	CreatedAt      int64  `thrift:"createdAt,11" frugal:"11,default,i64" json:"createdAt"`
+	UpdatedAt      int64  `thrift:"updatedAt,12" frugal:"12,default,i64" json:"updatedAt"`
+}
+
+func NewWebhookDeliveryInfo() *WebhookDeliveryInfo {
+	return &WebhookDeliveryInfo{}
+}
+
+func (p *WebhookDeliveryInfo) InitDefault() {
+}
+
+func (p *WebhookDeliveryInfo) GetDeliveryID() (v int64) {
+	return p.DeliveryID
+}
+
+func (p *WebhookDeliveryInfo) GetEndpointID() (v int64) {
+	return p.EndpointID
+}
+
+func (p *WebhookDeliveryInfo) GetEventID() (v string) {
+	return p.EventID
+}
+
+func (p *WebhookDeliveryInfo) GetEventType() (v string) {
+	return p.EventType
+}
+
+func (p *WebhookDeliveryInfo) GetPayload() (v string) {
+	return p.Payload
+}
+
+func (p *WebhookDeliveryInfo) GetStatus() (v int32) {
+	return p.Status
+}
+
+func (p *WebhookDeliveryInfo) GetAttempts() (v int64) {
+	return p.Attempts
+}
+
+func (p *WebhookDeliveryInfo) GetNextRetryAt() (v int64) {
+	return p.NextRetryAt
+}
+
+func (p *WebhookDeliveryInfo) GetLastStatusCode() (v int64) {
+	return p.LastStatusCode
+}
+
+func (p *WebhookDeliveryInfo) GetLastError() (v string) {
+	return p.LastError
+}
+
+func (p *WebhookDeliveryInfo) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+
+func (p *WebhookDeliveryInfo) GetUpdatedAt() (v int64) {
+	return p.UpdatedAt
+}
+func (p *WebhookDeliveryInfo) SetDeliveryID(val int64) {
+	p.DeliveryID = val
+}
+func (p *WebhookDeliveryInfo) SetEndpointID(val int64) {
+	p.EndpointID = val
+}
+func (p *WebhookDeliveryInfo) SetEventID(val string) {
+	p.EventID = val
+}
+func (p *WebhookDeliveryInfo) SetEventType(val string) {
+	p.EventType = val
+}
+func (p *WebhookDeliveryInfo) SetPayload(val string) {
+	p.Payload = val
+}
+func (p *WebhookDeliveryInfo) SetStatus(val int32) {
+	p.Status = val
+}
+func (p *WebhookDeliveryInfo) SetAttempts(val int64) {
+	p.Attempts = val
+}
+func (p *WebhookDeliveryInfo) SetNextRetryAt(val int64) {
+	p.NextRetryAt = val
+}
+func (p *WebhookDeliveryInfo) SetLastStatusCode(val int64) {
+	p.LastStatusCode = val
+}
+func (p *WebhookDeliveryInfo) SetLastError(val string) {
+	p.LastError = val
+}
+func (p *WebhookDeliveryInfo) SetCreatedAt(val int64) {
+	p.CreatedAt = val
+}
+func (p *WebhookDeliveryInfo) SetUpdatedAt(val int64) {
+	p.UpdatedAt = val
+}
 
-func (p *AddressInfo) String() string {
+func (p *WebhookDeliveryInfo) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AddressInfo(%+v)", *p)
+	return fmt.Sprintf("WebhookDeliveryInfo(%+v)", *p)
 }
 
-func (p *AddressInfo) DeepEqual(ano *AddressInfo) bool {
+func (p *WebhookDeliveryInfo) DeepEqual(ano *WebhookDeliveryInfo) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Province) {
+	if !p.Field1DeepEqual(ano.DeliveryID) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.City) {
+	if !p.Field2DeepEqual(ano.EndpointID) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.Detail) {
+	if !p.Field3DeepEqual(ano.EventID) {
 		return false
 	}
-	if !p.Field4DeepEqual(ano.AddressID) {
+	if !p.Field4DeepEqual(ano.EventType) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.Payload) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.Status) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.Attempts) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.NextRetryAt) {
+		return false
+	}
+	if !p.Field9DeepEqual(ano.LastStatusCode) {
+		return false
+	}
+	if !p.Field10DeepEqual(ano.LastError) {
+		return false
+	}
+	if !p.Field11DeepEqual(ano.CreatedAt) {
+		return false
+	}
+	if !p.Field12DeepEqual(ano.UpdatedAt) {
 		return false
 	}
 	return true
 }
 
-func (p *AddressInfo) Field1DeepEqual(src string) bool {
+func (p *WebhookDeliveryInfo) Field1DeepEqual(src int64) bool {
 
-	if strings.Compare(p.Province, src) != 0 {
+	if p.DeliveryID != src {
 		return false
 	}
 	return true
 }
-func (p *AddressInfo) Field2DeepEqual(src string) bool {
+func (p *WebhookDeliveryInfo) Field2DeepEqual(src int64) bool {
 
-	if strings.Compare(p.City, src) != 0 {
+	if p.EndpointID != src {
 		return false
 	}
 	return true
 }
-func (p *AddressInfo) Field3DeepEqual(src string) bool {
+func (p *WebhookDeliveryInfo) Field3DeepEqual(src string) bool {
 
-	if strings.Compare(p.Detail, src) != 0 {
+	if strings.Compare(p.EventID, src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *AddressInfo) Field4DeepEqual(src int64) bool {
+func (p *WebhookDeliveryInfo) Field4DeepEqual(src string) bool {
 
-	if p.AddressID != src {
+	if strings.Compare(p.EventType, src) != 0 {
 		return false
 	}
 	return true
 }
+func (p *WebhookDeliveryInfo) Field5DeepEqual(src string) bool {
 
-var fieldIDToName_AddressInfo = map[int16]string{
-	1: "province",
-	2: "city",
-	3: "detail",
-	4: "addressID",
+	if strings.Compare(p.Payload, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *WebhookDeliveryInfo) Field6DeepEqual(src int32) bool {
+
+	if p.Status != src {
+		return false
+	}
+	return true
+}
+func (p *WebhookDeliveryInfo) Field7DeepEqual(src int64) bool {
+
+	if p.Attempts != src {
+		return false
+	}
+	return true
+}
+func (p *WebhookDeliveryInfo) Field8DeepEqual(src int64) bool {
+
+	if p.NextRetryAt != src {
+		return false
+	}
+	return true
+}
+func (p *WebhookDeliveryInfo) Field9DeepEqual(src int64) bool {
+
+	if p.LastStatusCode != src {
+		return false
+	}
+	return true
+}
+func (p *WebhookDeliveryInfo) Field10DeepEqual(src string) bool {
+
+	if strings.Compare(p.LastError, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *WebhookDeliveryInfo) Field11DeepEqual(src int64) bool {
+
+	if p.CreatedAt != src {
+		return false
+	}
+	return true
+}
+func (p *WebhookDeliveryInfo) Field12DeepEqual(src int64) bool {
+
+	if p.UpdatedAt != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_WebhookDeliveryInfo = map[int16]string{
+	1:  "deliveryID",
+	2:  "endpointID",
+	3:  "eventID",
+	4:  "eventType",
+	5:  "payload",
+	6:  "status",
+	7:  "attempts",
+	8:  "nextRetryAt",
+	9:  "lastStatusCode",
+	10: "lastError",
+	11: "createdAt",
+	12: "updatedAt",
 }
 
 type LoginData struct {
@@ -1323,6 +2878,7 @@ type Spu struct {
 	CreatedAt        int64   `thrift:"createdAt,10,required" frugal:"10,required,i64" json:"createdAt"`
 	UpdatedAt        int64   `thrift:"updatedAt,11,required" frugal:"11,required,i64" json:"updatedAt"`
 	DeletedAt        *int64  `thrift:"deletedAt,12,optional" frugal:"12,optional,i64" json:"deletedAt,omitempty"`
+	ShopID           int64   `thrift:"shopID,13,required" frugal:"13,required,i64" json:"shopID"`
 }
 
 func NewSpu() *Spu {
@@ -1384,6 +2940,10 @@ func (p *Spu) GetDeletedAt() (v int64) {
 	}
 	return *p.DeletedAt
 }
+
+func (p *Spu) GetShopID() (v int64) {
+	return p.ShopID
+}
 func (p *Spu) SetSpuID(val int64) {
 	p.SpuID = val
 }
@@ -1420,6 +2980,9 @@ func (p *Spu) SetUpdatedAt(val int64) {
 func (p *Spu) SetDeletedAt(val *int64) {
 	p.DeletedAt = val
 }
+func (p *Spu) SetShopID(val int64) {
+	p.ShopID = val
+}
 
 func (p *Spu) IsSetDeletedAt() bool {
 	return p.DeletedAt != nil
@@ -1474,6 +3037,9 @@ func (p *Spu) DeepEqual(ano *Spu) bool {
 	if !p.Field12DeepEqual(ano.DeletedAt) {
 		return false
 	}
+	if !p.Field13DeepEqual(ano.ShopID) {
+		return false
+	}
 	return true
 }
 
@@ -1566,6 +3132,13 @@ func (p *Spu) Field12DeepEqual(src *int64) bool {
 	}
 	return true
 }
+func (p *Spu) Field13DeepEqual(src int64) bool {
+
+	if p.ShopID != src {
+		return false
+	}
+	return true
+}
 
 var fieldIDToName_Spu = map[int16]string{
 	1:  "spuID",
@@ -1580,6 +3153,7 @@ var fieldIDToName_Spu = map[int16]string{
 	10: "createdAt",
 	11: "updatedAt",
 	12: "deletedAt",
+	13: "shopID",
 }
 
 type Sku struct {
@@ -1936,6 +3510,7 @@ type SkuInfo struct {
 	StyleHeadDrawing string  `thrift:"styleHeadDrawing,7,required" frugal:"7,required,string" json:"styleHeadDrawing"`
 	SpuID            int64   `thrift:"spuID,8,required" frugal:"8,required,i64" json:"spuID"`
 	HistoryID        int64   `thrift:"historyID,9,required" frugal:"9,required,i64" json:"historyID"`
+	ShopID           int64   `thrift:"shopID,10,required" frugal:"10,required,i64" json:"shopID"`
 }
 
 func NewSkuInfo() *SkuInfo {
@@ -1980,6 +3555,10 @@ func (p *SkuInfo) GetSpuID() (v int64) {
 func (p *SkuInfo) GetHistoryID() (v int64) {
 	return p.HistoryID
 }
+
+func (p *SkuInfo) GetShopID() (v int64) {
+	return p.ShopID
+}
 func (p *SkuInfo) SetSkuID(val int64) {
 	p.SkuID = val
 }
@@ -2007,6 +3586,9 @@ func (p *SkuInfo) SetSpuID(val int64) {
 func (p *SkuInfo) SetHistoryID(val int64) {
 	p.HistoryID = val
 }
+func (p *SkuInfo) SetShopID(val int64) {
+	p.ShopID = val
+}
 
 func (p *SkuInfo) String() string {
 	if p == nil {
@@ -2048,6 +3630,9 @@ func (p *SkuInfo) DeepEqual(ano *SkuInfo) bool {
 	if !p.Field9DeepEqual(ano.HistoryID) {
 		return false
 	}
+	if !p.Field10DeepEqual(ano.ShopID) {
+		return false
+	}
 	return true
 }
 
@@ -2114,17 +3699,25 @@ func (p *SkuInfo) Field9DeepEqual(src int64) bool {
 	}
 	return true
 }
+func (p *SkuInfo) Field10DeepEqual(src int64) bool {
+
+	if p.ShopID != src {
+		return false
+	}
+	return true
+}
 
 var fieldIDToName_SkuInfo = map[int16]string{
-	1: "skuID",
-	2: "creatorID",
-	3: "price",
-	4: "name",
-	5: "forSale",
-	6: "lockStock",
-	7: "styleHeadDrawing",
-	8: "spuID",
-	9: "historyID",
+	1:  "skuID",
+	2:  "creatorID",
+	3:  "price",
+	4:  "name",
+	5:  "forSale",
+	6:  "lockStock",
+	7:  "styleHeadDrawing",
+	8:  "spuID",
+	9:  "historyID",
+	10: "shopID",
 }
 
 type SkuVersion struct {
@@ -3066,6 +4659,7 @@ type OrderGoods struct {
 	CouponId           int64   `thrift:"couponId,17" frugal:"17,default,i64" json:"couponId"`
 	CouponName         string  `thrift:"couponName,18" frugal:"18,default,string" json:"couponName"`
 	OrderId            int64   `thrift:"orderId,19,required" frugal:"19,required,i64" json:"orderId"`
+	MerchantName       string  `thrift:"merchantName,20" frugal:"20,default,string" json:"merchantName"`
 }
 
 func NewOrderGoods() *OrderGoods {
@@ -3150,6 +4744,10 @@ func (p *OrderGoods) GetCouponName() (v string) {
 func (p *OrderGoods) GetOrderId() (v int64) {
 	return p.OrderId
 }
+
+func (p *OrderGoods) GetMerchantName() (v string) {
+	return p.MerchantName
+}
 func (p *OrderGoods) SetMerchantId(val int64) {
 	p.MerchantId = val
 }
@@ -3207,6 +4805,9 @@ func (p *OrderGoods) SetCouponName(val string) {
 func (p *OrderGoods) SetOrderId(val int64) {
 	p.OrderId = val
 }
+func (p *OrderGoods) SetMerchantName(val string) {
+	p.MerchantName = val
+}
 
 func (p *OrderGoods) String() string {
 	if p == nil {
@@ -3278,6 +4879,9 @@ func (p *OrderGoods) DeepEqual(ano *OrderGoods) bool {
 	if !p.Field19DeepEqual(ano.OrderId) {
 		return false
 	}
+	if !p.Field20DeepEqual(ano.MerchantName) {
+		return false
+	}
 	return true
 }
 
@@ -3414,6 +5018,13 @@ func (p *OrderGoods) Field19DeepEqual(src int64) bool {
 	}
 	return true
 }
+func (p *OrderGoods) Field20DeepEqual(src string) bool {
+
+	if strings.Compare(p.MerchantName, src) != 0 {
+		return false
+	}
+	return true
+}
 
 var fieldIDToName_OrderGoods = map[int16]string{
 	1:  "merchantId",
@@ -3435,6 +5046,7 @@ var fieldIDToName_OrderGoods = map[int16]string{
 	17: "couponId",
 	18: "couponName",
 	19: "orderId",
+	20: "merchantName",
 }
 
 type BaseOrderGoods struct {
@@ -3804,6 +5416,199 @@ var fieldIDToName_RefundResponseInfo = map[int16]string{
 	2: "status",
 }
 
+type PaymentStatusInfo struct {
+	PaymentID int64   `thrift:"paymentID,1,required" frugal:"1,required,i64" json:"paymentID"`
+	Status    int64   `thrift:"status,2,required" frugal:"2,required,i64" json:"status"`
+	Amount    float64 `thrift:"amount,3,required" frugal:"3,required,double" json:"amount"`
+}
+
+func NewPaymentStatusInfo() *PaymentStatusInfo {
+	return &PaymentStatusInfo{}
+}
+
+func (p *PaymentStatusInfo) InitDefault() {
+}
+
+func (p *PaymentStatusInfo) GetPaymentID() (v int64) {
+	return p.PaymentID
+}
+
+func (p *PaymentStatusInfo) GetStatus() (v int64) {
+	return p.Status
+}
+
+func (p *PaymentStatusInfo) GetAmount() (v float64) {
+	return p.Amount
+}
+func (p *PaymentStatusInfo) SetPaymentID(val int64) {
+	p.PaymentID = val
+}
+func (p *PaymentStatusInfo) SetStatus(val int64) {
+	p.Status = val
+}
+func (p *PaymentStatusInfo) SetAmount(val float64) {
+	p.Amount = val
+}
+
+func (p *PaymentStatusInfo) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PaymentStatusInfo(%+v)", *p)
+}
+
+func (p *PaymentStatusInfo) DeepEqual(ano *PaymentStatusInfo) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.PaymentID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Status) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Amount) {
+		return false
+	}
+	return true
+}
+
+func (p *PaymentStatusInfo) Field1DeepEqual(src int64) bool {
+
+	if p.PaymentID != src {
+		return false
+	}
+	return true
+}
+func (p *PaymentStatusInfo) Field2DeepEqual(src int64) bool {
+
+	if p.Status != src {
+		return false
+	}
+	return true
+}
+func (p *PaymentStatusInfo) Field3DeepEqual(src float64) bool {
+
+	if p.Amount != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_PaymentStatusInfo = map[int16]string{
+	1: "paymentID",
+	2: "status",
+	3: "amount",
+}
+
+type RefundStatusInfo struct {
+	RefundID     int64   `thrift:"refundID,1,required" frugal:"1,required,i64" json:"refundID"`
+	Status       int64   `thrift:"status,2,required" frugal:"2,required,i64" json:"status"`
+	RefundAmount float64 `thrift:"refundAmount,3,required" frugal:"3,required,double" json:"refundAmount"`
+	RefundReason string  `thrift:"refundReason,4,required" frugal:"4,required,string" json:"refundReason"`
+}
+
+func NewRefundStatusInfo() *RefundStatusInfo {
+	return &RefundStatusInfo{}
+}
+
+func (p *RefundStatusInfo) InitDefault() {
+}
+
+func (p *RefundStatusInfo) GetRefundID() (v int64) {
+	return p.RefundID
+}
+
+func (p *RefundStatusInfo) GetStatus() (v int64) {
+	return p.Status
+}
+
+func (p *RefundStatusInfo) GetRefundAmount() (v float64) {
+	return p.RefundAmount
+}
+
+func (p *RefundStatusInfo) GetRefundReason() (v string) {
+	return p.RefundReason
+}
+func (p *RefundStatusInfo) SetRefundID(val int64) {
+	p.RefundID = val
+}
+func (p *RefundStatusInfo) SetStatus(val int64) {
+	p.Status = val
+}
+func (p *RefundStatusInfo) SetRefundAmount(val float64) {
+	p.RefundAmount = val
+}
+func (p *RefundStatusInfo) SetRefundReason(val string) {
+	p.RefundReason = val
+}
+
+func (p *RefundStatusInfo) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RefundStatusInfo(%+v)", *p)
+}
+
+func (p *RefundStatusInfo) DeepEqual(ano *RefundStatusInfo) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.RefundID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Status) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.RefundAmount) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.RefundReason) {
+		return false
+	}
+	return true
+}
+
+func (p *RefundStatusInfo) Field1DeepEqual(src int64) bool {
+
+	if p.RefundID != src {
+		return false
+	}
+	return true
+}
+func (p *RefundStatusInfo) Field2DeepEqual(src int64) bool {
+
+	if p.Status != src {
+		return false
+	}
+	return true
+}
+func (p *RefundStatusInfo) Field3DeepEqual(src float64) bool {
+
+	if p.RefundAmount != src {
+		return false
+	}
+	return true
+}
+func (p *RefundStatusInfo) Field4DeepEqual(src string) bool {
+
+	if strings.Compare(p.RefundReason, src) != 0 {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_RefundStatusInfo = map[int16]string{
+	1: "refundID",
+	2: "status",
+	3: "refundAmount",
+	4: "refundReason",
+}
+
 type CartGoods struct {
 	MerchantId       int64   `thrift:"merchantId,1,required" frugal:"1,required,i64" json:"merchantId"`
 	GoodsId          int64   `thrift:"goodsId,2,required" frugal:"2,required,i64" json:"goodsId"`
@@ -3815,6 +5620,7 @@ type CartGoods struct {
 	PurchaseQuantity int64   `thrift:"purchaseQuantity,11,required" frugal:"11,required,i64" json:"purchaseQuantity"`
 	TotalAmount      float64 `thrift:"totalAmount,12,required" frugal:"12,required,double" json:"totalAmount"`
 	DiscountAmount   float64 `thrift:"discountAmount,14,required" frugal:"14,required,double" json:"discountAmount"`
+	MerchantName     string  `thrift:"merchantName,15" frugal:"15,default,string" json:"merchantName"`
 }
 
 func NewCartGoods() *CartGoods {
@@ -3863,6 +5669,10 @@ func (p *CartGoods) GetTotalAmount() (v float64) {
 func (p *CartGoods) GetDiscountAmount() (v float64) {
 	return p.DiscountAmount
 }
+
+func (p *CartGoods) GetMerchantName() (v string) {
+	return p.MerchantName
+}
 func (p *CartGoods) SetMerchantId(val int64) {
 	p.MerchantId = val
 }
@@ -3893,6 +5703,9 @@ func (p *CartGoods) SetTotalAmount(val float64) {
 func (p *CartGoods) SetDiscountAmount(val float64) {
 	p.DiscountAmount = val
 }
+func (p *CartGoods) SetMerchantName(val string) {
+	p.MerchantName = val
+}
 
 func (p *CartGoods) String() string {
 	if p == nil {
@@ -3937,6 +5750,9 @@ func (p *CartGoods) DeepEqual(ano *CartGoods) bool {
 	if !p.Field14DeepEqual(ano.DiscountAmount) {
 		return false
 	}
+	if !p.Field15DeepEqual(ano.MerchantName) {
+		return false
+	}
 	return true
 }
 
@@ -4010,6 +5826,13 @@ func (p *CartGoods) Field14DeepEqual(src float64) bool {
 	}
 	return true
 }
+func (p *CartGoods) Field15DeepEqual(src string) bool {
+
+	if strings.Compare(p.MerchantName, src) != 0 {
+		return false
+	}
+	return true
+}
 
 var fieldIDToName_CartGoods = map[int16]string{
 	1:  "merchantId",
@@ -4022,4 +5845,5 @@ var fieldIDToName_CartGoods = map[int16]string{
 	11: "purchaseQuantity",
 	12: "totalAmount",
 	14: "discountAmount",
+	15: "merchantName",
 }
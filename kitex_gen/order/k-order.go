@@ -2691,6 +2691,763 @@ func (p *GetOrderPaymentAmountResp) field2Length() int {
 	return l
 }
 
+func (p *GetOrderMerchantsReq) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetOrderID bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetOrderID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetOrderID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetOrderMerchantsReq[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_GetOrderMerchantsReq[fieldId]))
+}
+
+func (p *GetOrderMerchantsReq) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.OrderID = _field
+	return offset, nil
+}
+
+func (p *GetOrderMerchantsReq) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *GetOrderMerchantsReq) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *GetOrderMerchantsReq) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *GetOrderMerchantsReq) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.OrderID)
+	return offset
+}
+
+func (p *GetOrderMerchantsReq) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *GetOrderMerchantsResp) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBase bool = false
+	var issetMerchantIDs bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetBase = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetMerchantIDs = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetBase {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetMerchantIDs {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetOrderMerchantsResp[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_GetOrderMerchantsResp[fieldId]))
+}
+
+func (p *GetOrderMerchantsResp) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := model.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *GetOrderMerchantsResp) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]int64, 0, size)
+	for i := 0; i < size; i++ {
+		var _elem int64
+		if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.MerchantIDs = _field
+	return offset, nil
+}
+
+func (p *GetOrderMerchantsResp) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *GetOrderMerchantsResp) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *GetOrderMerchantsResp) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *GetOrderMerchantsResp) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *GetOrderMerchantsResp) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 2)
+	listBeginOffset := offset
+	offset += thrift.Binary.ListBeginLength()
+	var length int
+	for _, v := range p.MerchantIDs {
+		length++
+		offset += thrift.Binary.WriteI64(buf[offset:], v)
+	}
+	thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.I64, length)
+	return offset
+}
+
+func (p *GetOrderMerchantsResp) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Base.BLength()
+	return l
+}
+
+func (p *GetOrderMerchantsResp) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.ListBeginLength()
+	l +=
+		thrift.Binary.I64Length() * len(p.MerchantIDs)
+	return l
+}
+
+func (p *ExportUserDataReq) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetUid bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetUid = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetUid {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExportUserDataReq[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ExportUserDataReq[fieldId]))
+}
+
+func (p *ExportUserDataReq) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.Uid = _field
+	return offset, nil
+}
+
+func (p *ExportUserDataReq) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ExportUserDataReq) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ExportUserDataReq) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ExportUserDataReq) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.Uid)
+	return offset
+}
+
+func (p *ExportUserDataReq) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *ExportUserDataResp) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBase bool = false
+	var issetData bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetBase = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetData = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetBase {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetData {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExportUserDataResp[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ExportUserDataResp[fieldId]))
+}
+
+func (p *ExportUserDataResp) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := model.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *ExportUserDataResp) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.Data = _field
+	return offset, nil
+}
+
+func (p *ExportUserDataResp) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ExportUserDataResp) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ExportUserDataResp) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ExportUserDataResp) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *ExportUserDataResp) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.Data)
+	return offset
+}
+
+func (p *ExportUserDataResp) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Base.BLength()
+	return l
+}
+
+func (p *ExportUserDataResp) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.StringLengthNocopy(p.Data)
+	return l
+}
+
+func (p *EraseUserDataReq) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetUid bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetUid = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetUid {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EraseUserDataReq[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_EraseUserDataReq[fieldId]))
+}
+
+func (p *EraseUserDataReq) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.Uid = _field
+	return offset, nil
+}
+
+func (p *EraseUserDataReq) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *EraseUserDataReq) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *EraseUserDataReq) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *EraseUserDataReq) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.Uid)
+	return offset
+}
+
+func (p *EraseUserDataReq) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *EraseUserDataResp) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBase bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetBase = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetBase {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EraseUserDataResp[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_EraseUserDataResp[fieldId]))
+}
+
+func (p *EraseUserDataResp) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := model.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *EraseUserDataResp) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *EraseUserDataResp) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *EraseUserDataResp) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *EraseUserDataResp) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *EraseUserDataResp) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Base.BLength()
+	return l
+}
+
 func (p *OrderServiceCreateOrderArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
@@ -2735,14 +3492,608 @@ func (p *OrderServiceCreateOrderArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceCreateOrderArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceCreateOrderArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *OrderServiceCreateOrderArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewCreateOrderReq()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Req = _field
+	return offset, nil
+}
+
+func (p *OrderServiceCreateOrderArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *OrderServiceCreateOrderArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *OrderServiceCreateOrderArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *OrderServiceCreateOrderArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *OrderServiceCreateOrderArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Req.BLength()
+	return l
+}
+
+func (p *OrderServiceCreateOrderResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceCreateOrderResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *OrderServiceCreateOrderResult) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+	_field := NewCreateOrderResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Success = _field
+	return offset, nil
+}
+
+func (p *OrderServiceCreateOrderResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *OrderServiceCreateOrderResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *OrderServiceCreateOrderResult) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *OrderServiceCreateOrderResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *OrderServiceCreateOrderResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
+	}
+	return l
+}
+
+func (p *OrderServiceViewOrderListArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceViewOrderListArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *OrderServiceViewOrderListArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewViewOrderListReq()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Req = _field
+	return offset, nil
+}
+
+func (p *OrderServiceViewOrderListArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *OrderServiceViewOrderListArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *OrderServiceViewOrderListArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *OrderServiceViewOrderListArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *OrderServiceViewOrderListArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Req.BLength()
+	return l
+}
+
+func (p *OrderServiceViewOrderListResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceViewOrderListResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *OrderServiceViewOrderListResult) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+	_field := NewViewOrderListResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Success = _field
+	return offset, nil
+}
+
+func (p *OrderServiceViewOrderListResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *OrderServiceViewOrderListResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *OrderServiceViewOrderListResult) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *OrderServiceViewOrderListResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *OrderServiceViewOrderListResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
+	}
+	return l
+}
+
+func (p *OrderServiceViewOrderArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceViewOrderArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *OrderServiceViewOrderArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewViewOrderReq()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Req = _field
+	return offset, nil
+}
+
+func (p *OrderServiceViewOrderArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *OrderServiceViewOrderArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *OrderServiceViewOrderArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *OrderServiceViewOrderArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *OrderServiceViewOrderArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Req.BLength()
+	return l
+}
+
+func (p *OrderServiceViewOrderResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceViewOrderResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *OrderServiceViewOrderResult) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+	_field := NewViewOrderResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Success = _field
+	return offset, nil
+}
+
+func (p *OrderServiceViewOrderResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *OrderServiceViewOrderResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *OrderServiceViewOrderResult) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *OrderServiceViewOrderResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *OrderServiceViewOrderResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
+	}
+	return l
+}
+
+func (p *OrderServiceCancelOrderArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceCancelOrderArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *OrderServiceCreateOrderArgs) FastReadField1(buf []byte) (int, error) {
+func (p *OrderServiceCancelOrderArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCreateOrderReq()
+	_field := NewCancelOrderReq()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -2752,11 +4103,11 @@ func (p *OrderServiceCreateOrderArgs) FastReadField1(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *OrderServiceCreateOrderArgs) FastWrite(buf []byte) int {
+func (p *OrderServiceCancelOrderArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *OrderServiceCreateOrderArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *OrderServiceCancelOrderArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -2765,7 +4116,7 @@ func (p *OrderServiceCreateOrderArgs) FastWriteNocopy(buf []byte, w thrift.Nocop
 	return offset
 }
 
-func (p *OrderServiceCreateOrderArgs) BLength() int {
+func (p *OrderServiceCancelOrderArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -2774,21 +4125,21 @@ func (p *OrderServiceCreateOrderArgs) BLength() int {
 	return l
 }
 
-func (p *OrderServiceCreateOrderArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *OrderServiceCancelOrderArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *OrderServiceCreateOrderArgs) field1Length() int {
+func (p *OrderServiceCancelOrderArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *OrderServiceCreateOrderResult) FastRead(buf []byte) (int, error) {
+func (p *OrderServiceCancelOrderResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -2832,14 +4183,14 @@ func (p *OrderServiceCreateOrderResult) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceCreateOrderResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceCancelOrderResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *OrderServiceCreateOrderResult) FastReadField0(buf []byte) (int, error) {
+func (p *OrderServiceCancelOrderResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCreateOrderResp()
+	_field := NewCancelOrderResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -2849,11 +4200,11 @@ func (p *OrderServiceCreateOrderResult) FastReadField0(buf []byte) (int, error)
 	return offset, nil
 }
 
-func (p *OrderServiceCreateOrderResult) FastWrite(buf []byte) int {
+func (p *OrderServiceCancelOrderResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *OrderServiceCreateOrderResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *OrderServiceCancelOrderResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -2862,7 +4213,7 @@ func (p *OrderServiceCreateOrderResult) FastWriteNocopy(buf []byte, w thrift.Noc
 	return offset
 }
 
-func (p *OrderServiceCreateOrderResult) BLength() int {
+func (p *OrderServiceCancelOrderResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -2871,7 +4222,7 @@ func (p *OrderServiceCreateOrderResult) BLength() int {
 	return l
 }
 
-func (p *OrderServiceCreateOrderResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *OrderServiceCancelOrderResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -2880,7 +4231,7 @@ func (p *OrderServiceCreateOrderResult) fastWriteField0(buf []byte, w thrift.Noc
 	return offset
 }
 
-func (p *OrderServiceCreateOrderResult) field0Length() int {
+func (p *OrderServiceCancelOrderResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -2889,7 +4240,7 @@ func (p *OrderServiceCreateOrderResult) field0Length() int {
 	return l
 }
 
-func (p *OrderServiceViewOrderListArgs) FastRead(buf []byte) (int, error) {
+func (p *OrderServiceChangeDeliverAddressArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -2933,14 +4284,14 @@ func (p *OrderServiceViewOrderListArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceViewOrderListArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceChangeDeliverAddressArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *OrderServiceViewOrderListArgs) FastReadField1(buf []byte) (int, error) {
+func (p *OrderServiceChangeDeliverAddressArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewViewOrderListReq()
+	_field := NewChangeDeliverAddressReq()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -2950,11 +4301,11 @@ func (p *OrderServiceViewOrderListArgs) FastReadField1(buf []byte) (int, error)
 	return offset, nil
 }
 
-func (p *OrderServiceViewOrderListArgs) FastWrite(buf []byte) int {
+func (p *OrderServiceChangeDeliverAddressArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *OrderServiceViewOrderListArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *OrderServiceChangeDeliverAddressArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -2963,7 +4314,7 @@ func (p *OrderServiceViewOrderListArgs) FastWriteNocopy(buf []byte, w thrift.Noc
 	return offset
 }
 
-func (p *OrderServiceViewOrderListArgs) BLength() int {
+func (p *OrderServiceChangeDeliverAddressArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -2972,21 +4323,21 @@ func (p *OrderServiceViewOrderListArgs) BLength() int {
 	return l
 }
 
-func (p *OrderServiceViewOrderListArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *OrderServiceChangeDeliverAddressArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *OrderServiceViewOrderListArgs) field1Length() int {
+func (p *OrderServiceChangeDeliverAddressArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *OrderServiceViewOrderListResult) FastRead(buf []byte) (int, error) {
+func (p *OrderServiceChangeDeliverAddressResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -3030,14 +4381,14 @@ func (p *OrderServiceViewOrderListResult) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceViewOrderListResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceChangeDeliverAddressResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *OrderServiceViewOrderListResult) FastReadField0(buf []byte) (int, error) {
+func (p *OrderServiceChangeDeliverAddressResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewViewOrderListResp()
+	_field := NewChangeDeliverAddressResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -3047,11 +4398,11 @@ func (p *OrderServiceViewOrderListResult) FastReadField0(buf []byte) (int, error
 	return offset, nil
 }
 
-func (p *OrderServiceViewOrderListResult) FastWrite(buf []byte) int {
+func (p *OrderServiceChangeDeliverAddressResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *OrderServiceViewOrderListResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *OrderServiceChangeDeliverAddressResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -3060,7 +4411,7 @@ func (p *OrderServiceViewOrderListResult) FastWriteNocopy(buf []byte, w thrift.N
 	return offset
 }
 
-func (p *OrderServiceViewOrderListResult) BLength() int {
+func (p *OrderServiceChangeDeliverAddressResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -3069,7 +4420,7 @@ func (p *OrderServiceViewOrderListResult) BLength() int {
 	return l
 }
 
-func (p *OrderServiceViewOrderListResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *OrderServiceChangeDeliverAddressResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -3078,7 +4429,7 @@ func (p *OrderServiceViewOrderListResult) fastWriteField0(buf []byte, w thrift.N
 	return offset
 }
 
-func (p *OrderServiceViewOrderListResult) field0Length() int {
+func (p *OrderServiceChangeDeliverAddressResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -3087,7 +4438,7 @@ func (p *OrderServiceViewOrderListResult) field0Length() int {
 	return l
 }
 
-func (p *OrderServiceViewOrderArgs) FastRead(buf []byte) (int, error) {
+func (p *OrderServiceDeleteOrderArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -3131,14 +4482,14 @@ func (p *OrderServiceViewOrderArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceViewOrderArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceDeleteOrderArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *OrderServiceViewOrderArgs) FastReadField1(buf []byte) (int, error) {
+func (p *OrderServiceDeleteOrderArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewViewOrderReq()
+	_field := NewDeleteOrderReq()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -3148,11 +4499,11 @@ func (p *OrderServiceViewOrderArgs) FastReadField1(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *OrderServiceViewOrderArgs) FastWrite(buf []byte) int {
+func (p *OrderServiceDeleteOrderArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *OrderServiceViewOrderArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *OrderServiceDeleteOrderArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -3161,7 +4512,7 @@ func (p *OrderServiceViewOrderArgs) FastWriteNocopy(buf []byte, w thrift.NocopyW
 	return offset
 }
 
-func (p *OrderServiceViewOrderArgs) BLength() int {
+func (p *OrderServiceDeleteOrderArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -3170,21 +4521,21 @@ func (p *OrderServiceViewOrderArgs) BLength() int {
 	return l
 }
 
-func (p *OrderServiceViewOrderArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *OrderServiceDeleteOrderArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *OrderServiceViewOrderArgs) field1Length() int {
+func (p *OrderServiceDeleteOrderArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *OrderServiceViewOrderResult) FastRead(buf []byte) (int, error) {
+func (p *OrderServiceDeleteOrderResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -3228,14 +4579,14 @@ func (p *OrderServiceViewOrderResult) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceViewOrderResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceDeleteOrderResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *OrderServiceViewOrderResult) FastReadField0(buf []byte) (int, error) {
+func (p *OrderServiceDeleteOrderResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewViewOrderResp()
+	_field := NewDeleteOrderResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -3245,11 +4596,11 @@ func (p *OrderServiceViewOrderResult) FastReadField0(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *OrderServiceViewOrderResult) FastWrite(buf []byte) int {
+func (p *OrderServiceDeleteOrderResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *OrderServiceViewOrderResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *OrderServiceDeleteOrderResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -3258,7 +4609,7 @@ func (p *OrderServiceViewOrderResult) FastWriteNocopy(buf []byte, w thrift.Nocop
 	return offset
 }
 
-func (p *OrderServiceViewOrderResult) BLength() int {
+func (p *OrderServiceDeleteOrderResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -3267,7 +4618,7 @@ func (p *OrderServiceViewOrderResult) BLength() int {
 	return l
 }
 
-func (p *OrderServiceViewOrderResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *OrderServiceDeleteOrderResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -3276,7 +4627,7 @@ func (p *OrderServiceViewOrderResult) fastWriteField0(buf []byte, w thrift.Nocop
 	return offset
 }
 
-func (p *OrderServiceViewOrderResult) field0Length() int {
+func (p *OrderServiceDeleteOrderResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -3285,7 +4636,7 @@ func (p *OrderServiceViewOrderResult) field0Length() int {
 	return l
 }
 
-func (p *OrderServiceCancelOrderArgs) FastRead(buf []byte) (int, error) {
+func (p *OrderServiceIsOrderExistArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -3329,14 +4680,14 @@ func (p *OrderServiceCancelOrderArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceCancelOrderArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceIsOrderExistArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *OrderServiceCancelOrderArgs) FastReadField1(buf []byte) (int, error) {
+func (p *OrderServiceIsOrderExistArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCancelOrderReq()
+	_field := NewIsOrderExistReq()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -3346,11 +4697,11 @@ func (p *OrderServiceCancelOrderArgs) FastReadField1(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *OrderServiceCancelOrderArgs) FastWrite(buf []byte) int {
+func (p *OrderServiceIsOrderExistArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *OrderServiceCancelOrderArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *OrderServiceIsOrderExistArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -3359,7 +4710,7 @@ func (p *OrderServiceCancelOrderArgs) FastWriteNocopy(buf []byte, w thrift.Nocop
 	return offset
 }
 
-func (p *OrderServiceCancelOrderArgs) BLength() int {
+func (p *OrderServiceIsOrderExistArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -3368,21 +4719,21 @@ func (p *OrderServiceCancelOrderArgs) BLength() int {
 	return l
 }
 
-func (p *OrderServiceCancelOrderArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *OrderServiceIsOrderExistArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *OrderServiceCancelOrderArgs) field1Length() int {
+func (p *OrderServiceIsOrderExistArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *OrderServiceCancelOrderResult) FastRead(buf []byte) (int, error) {
+func (p *OrderServiceIsOrderExistResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -3426,14 +4777,14 @@ func (p *OrderServiceCancelOrderResult) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceCancelOrderResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceIsOrderExistResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *OrderServiceCancelOrderResult) FastReadField0(buf []byte) (int, error) {
+func (p *OrderServiceIsOrderExistResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCancelOrderResp()
+	_field := NewIsOrderExistResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -3443,11 +4794,11 @@ func (p *OrderServiceCancelOrderResult) FastReadField0(buf []byte) (int, error)
 	return offset, nil
 }
 
-func (p *OrderServiceCancelOrderResult) FastWrite(buf []byte) int {
+func (p *OrderServiceIsOrderExistResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *OrderServiceCancelOrderResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *OrderServiceIsOrderExistResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -3456,7 +4807,7 @@ func (p *OrderServiceCancelOrderResult) FastWriteNocopy(buf []byte, w thrift.Noc
 	return offset
 }
 
-func (p *OrderServiceCancelOrderResult) BLength() int {
+func (p *OrderServiceIsOrderExistResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -3465,7 +4816,7 @@ func (p *OrderServiceCancelOrderResult) BLength() int {
 	return l
 }
 
-func (p *OrderServiceCancelOrderResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *OrderServiceIsOrderExistResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -3474,7 +4825,7 @@ func (p *OrderServiceCancelOrderResult) fastWriteField0(buf []byte, w thrift.Noc
 	return offset
 }
 
-func (p *OrderServiceCancelOrderResult) field0Length() int {
+func (p *OrderServiceIsOrderExistResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -3483,7 +4834,7 @@ func (p *OrderServiceCancelOrderResult) field0Length() int {
 	return l
 }
 
-func (p *OrderServiceChangeDeliverAddressArgs) FastRead(buf []byte) (int, error) {
+func (p *OrderServiceGetOrderPaymentAmountArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -3527,14 +4878,14 @@ func (p *OrderServiceChangeDeliverAddressArgs) FastRead(buf []byte) (int, error)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceChangeDeliverAddressArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceGetOrderPaymentAmountArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *OrderServiceChangeDeliverAddressArgs) FastReadField1(buf []byte) (int, error) {
+func (p *OrderServiceGetOrderPaymentAmountArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewChangeDeliverAddressReq()
+	_field := NewGetOrderPaymentAmount()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -3544,11 +4895,11 @@ func (p *OrderServiceChangeDeliverAddressArgs) FastReadField1(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *OrderServiceChangeDeliverAddressArgs) FastWrite(buf []byte) int {
+func (p *OrderServiceGetOrderPaymentAmountArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *OrderServiceChangeDeliverAddressArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *OrderServiceGetOrderPaymentAmountArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -3557,7 +4908,7 @@ func (p *OrderServiceChangeDeliverAddressArgs) FastWriteNocopy(buf []byte, w thr
 	return offset
 }
 
-func (p *OrderServiceChangeDeliverAddressArgs) BLength() int {
+func (p *OrderServiceGetOrderPaymentAmountArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -3566,21 +4917,21 @@ func (p *OrderServiceChangeDeliverAddressArgs) BLength() int {
 	return l
 }
 
-func (p *OrderServiceChangeDeliverAddressArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *OrderServiceGetOrderPaymentAmountArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *OrderServiceChangeDeliverAddressArgs) field1Length() int {
+func (p *OrderServiceGetOrderPaymentAmountArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *OrderServiceChangeDeliverAddressResult) FastRead(buf []byte) (int, error) {
+func (p *OrderServiceGetOrderPaymentAmountResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -3624,14 +4975,14 @@ func (p *OrderServiceChangeDeliverAddressResult) FastRead(buf []byte) (int, erro
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceChangeDeliverAddressResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceGetOrderPaymentAmountResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *OrderServiceChangeDeliverAddressResult) FastReadField0(buf []byte) (int, error) {
+func (p *OrderServiceGetOrderPaymentAmountResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewChangeDeliverAddressResp()
+	_field := NewGetOrderPaymentAmountResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -3641,11 +4992,11 @@ func (p *OrderServiceChangeDeliverAddressResult) FastReadField0(buf []byte) (int
 	return offset, nil
 }
 
-func (p *OrderServiceChangeDeliverAddressResult) FastWrite(buf []byte) int {
+func (p *OrderServiceGetOrderPaymentAmountResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *OrderServiceChangeDeliverAddressResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *OrderServiceGetOrderPaymentAmountResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -3654,7 +5005,7 @@ func (p *OrderServiceChangeDeliverAddressResult) FastWriteNocopy(buf []byte, w t
 	return offset
 }
 
-func (p *OrderServiceChangeDeliverAddressResult) BLength() int {
+func (p *OrderServiceGetOrderPaymentAmountResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -3663,7 +5014,7 @@ func (p *OrderServiceChangeDeliverAddressResult) BLength() int {
 	return l
 }
 
-func (p *OrderServiceChangeDeliverAddressResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *OrderServiceGetOrderPaymentAmountResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -3672,7 +5023,7 @@ func (p *OrderServiceChangeDeliverAddressResult) fastWriteField0(buf []byte, w t
 	return offset
 }
 
-func (p *OrderServiceChangeDeliverAddressResult) field0Length() int {
+func (p *OrderServiceGetOrderPaymentAmountResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -3681,7 +5032,7 @@ func (p *OrderServiceChangeDeliverAddressResult) field0Length() int {
 	return l
 }
 
-func (p *OrderServiceDeleteOrderArgs) FastRead(buf []byte) (int, error) {
+func (p *OrderServiceOrderPaymentSuccessArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -3725,14 +5076,14 @@ func (p *OrderServiceDeleteOrderArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceDeleteOrderArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceOrderPaymentSuccessArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *OrderServiceDeleteOrderArgs) FastReadField1(buf []byte) (int, error) {
+func (p *OrderServiceOrderPaymentSuccessArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewDeleteOrderReq()
+	_field := NewUpdateOrderStatusReq()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -3742,11 +5093,11 @@ func (p *OrderServiceDeleteOrderArgs) FastReadField1(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *OrderServiceDeleteOrderArgs) FastWrite(buf []byte) int {
+func (p *OrderServiceOrderPaymentSuccessArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *OrderServiceDeleteOrderArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *OrderServiceOrderPaymentSuccessArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -3755,7 +5106,7 @@ func (p *OrderServiceDeleteOrderArgs) FastWriteNocopy(buf []byte, w thrift.Nocop
 	return offset
 }
 
-func (p *OrderServiceDeleteOrderArgs) BLength() int {
+func (p *OrderServiceOrderPaymentSuccessArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -3764,21 +5115,21 @@ func (p *OrderServiceDeleteOrderArgs) BLength() int {
 	return l
 }
 
-func (p *OrderServiceDeleteOrderArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *OrderServiceOrderPaymentSuccessArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *OrderServiceDeleteOrderArgs) field1Length() int {
+func (p *OrderServiceOrderPaymentSuccessArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *OrderServiceDeleteOrderResult) FastRead(buf []byte) (int, error) {
+func (p *OrderServiceOrderPaymentSuccessResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -3822,14 +5173,14 @@ func (p *OrderServiceDeleteOrderResult) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceDeleteOrderResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceOrderPaymentSuccessResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *OrderServiceDeleteOrderResult) FastReadField0(buf []byte) (int, error) {
+func (p *OrderServiceOrderPaymentSuccessResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewDeleteOrderResp()
+	_field := NewUpdateOrderStatusResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -3839,11 +5190,11 @@ func (p *OrderServiceDeleteOrderResult) FastReadField0(buf []byte) (int, error)
 	return offset, nil
 }
 
-func (p *OrderServiceDeleteOrderResult) FastWrite(buf []byte) int {
+func (p *OrderServiceOrderPaymentSuccessResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *OrderServiceDeleteOrderResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *OrderServiceOrderPaymentSuccessResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -3852,7 +5203,7 @@ func (p *OrderServiceDeleteOrderResult) FastWriteNocopy(buf []byte, w thrift.Noc
 	return offset
 }
 
-func (p *OrderServiceDeleteOrderResult) BLength() int {
+func (p *OrderServiceOrderPaymentSuccessResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -3861,7 +5212,7 @@ func (p *OrderServiceDeleteOrderResult) BLength() int {
 	return l
 }
 
-func (p *OrderServiceDeleteOrderResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *OrderServiceOrderPaymentSuccessResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -3870,7 +5221,7 @@ func (p *OrderServiceDeleteOrderResult) fastWriteField0(buf []byte, w thrift.Noc
 	return offset
 }
 
-func (p *OrderServiceDeleteOrderResult) field0Length() int {
+func (p *OrderServiceOrderPaymentSuccessResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -3879,7 +5230,7 @@ func (p *OrderServiceDeleteOrderResult) field0Length() int {
 	return l
 }
 
-func (p *OrderServiceIsOrderExistArgs) FastRead(buf []byte) (int, error) {
+func (p *OrderServiceOrderPaymentCancelArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -3923,14 +5274,14 @@ func (p *OrderServiceIsOrderExistArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceIsOrderExistArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceOrderPaymentCancelArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *OrderServiceIsOrderExistArgs) FastReadField1(buf []byte) (int, error) {
+func (p *OrderServiceOrderPaymentCancelArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewIsOrderExistReq()
+	_field := NewUpdateOrderStatusReq()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -3940,11 +5291,11 @@ func (p *OrderServiceIsOrderExistArgs) FastReadField1(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *OrderServiceIsOrderExistArgs) FastWrite(buf []byte) int {
+func (p *OrderServiceOrderPaymentCancelArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *OrderServiceIsOrderExistArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *OrderServiceOrderPaymentCancelArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -3953,7 +5304,7 @@ func (p *OrderServiceIsOrderExistArgs) FastWriteNocopy(buf []byte, w thrift.Noco
 	return offset
 }
 
-func (p *OrderServiceIsOrderExistArgs) BLength() int {
+func (p *OrderServiceOrderPaymentCancelArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -3962,21 +5313,21 @@ func (p *OrderServiceIsOrderExistArgs) BLength() int {
 	return l
 }
 
-func (p *OrderServiceIsOrderExistArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *OrderServiceOrderPaymentCancelArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *OrderServiceIsOrderExistArgs) field1Length() int {
+func (p *OrderServiceOrderPaymentCancelArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *OrderServiceIsOrderExistResult) FastRead(buf []byte) (int, error) {
+func (p *OrderServiceOrderPaymentCancelResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -4020,14 +5371,14 @@ func (p *OrderServiceIsOrderExistResult) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceIsOrderExistResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceOrderPaymentCancelResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *OrderServiceIsOrderExistResult) FastReadField0(buf []byte) (int, error) {
+func (p *OrderServiceOrderPaymentCancelResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewIsOrderExistResp()
+	_field := NewUpdateOrderStatusResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -4037,11 +5388,11 @@ func (p *OrderServiceIsOrderExistResult) FastReadField0(buf []byte) (int, error)
 	return offset, nil
 }
 
-func (p *OrderServiceIsOrderExistResult) FastWrite(buf []byte) int {
+func (p *OrderServiceOrderPaymentCancelResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *OrderServiceIsOrderExistResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *OrderServiceOrderPaymentCancelResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -4050,7 +5401,7 @@ func (p *OrderServiceIsOrderExistResult) FastWriteNocopy(buf []byte, w thrift.No
 	return offset
 }
 
-func (p *OrderServiceIsOrderExistResult) BLength() int {
+func (p *OrderServiceOrderPaymentCancelResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -4059,7 +5410,7 @@ func (p *OrderServiceIsOrderExistResult) BLength() int {
 	return l
 }
 
-func (p *OrderServiceIsOrderExistResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *OrderServiceOrderPaymentCancelResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -4068,7 +5419,7 @@ func (p *OrderServiceIsOrderExistResult) fastWriteField0(buf []byte, w thrift.No
 	return offset
 }
 
-func (p *OrderServiceIsOrderExistResult) field0Length() int {
+func (p *OrderServiceOrderPaymentCancelResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -4077,7 +5428,7 @@ func (p *OrderServiceIsOrderExistResult) field0Length() int {
 	return l
 }
 
-func (p *OrderServiceGetOrderPaymentAmountArgs) FastRead(buf []byte) (int, error) {
+func (p *OrderServiceGetOrderMerchantsArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -4121,14 +5472,14 @@ func (p *OrderServiceGetOrderPaymentAmountArgs) FastRead(buf []byte) (int, error
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceGetOrderPaymentAmountArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceGetOrderMerchantsArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *OrderServiceGetOrderPaymentAmountArgs) FastReadField1(buf []byte) (int, error) {
+func (p *OrderServiceGetOrderMerchantsArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetOrderPaymentAmount()
+	_field := NewGetOrderMerchantsReq()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -4138,11 +5489,11 @@ func (p *OrderServiceGetOrderPaymentAmountArgs) FastReadField1(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *OrderServiceGetOrderPaymentAmountArgs) FastWrite(buf []byte) int {
+func (p *OrderServiceGetOrderMerchantsArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *OrderServiceGetOrderPaymentAmountArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *OrderServiceGetOrderMerchantsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -4151,7 +5502,7 @@ func (p *OrderServiceGetOrderPaymentAmountArgs) FastWriteNocopy(buf []byte, w th
 	return offset
 }
 
-func (p *OrderServiceGetOrderPaymentAmountArgs) BLength() int {
+func (p *OrderServiceGetOrderMerchantsArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -4160,21 +5511,21 @@ func (p *OrderServiceGetOrderPaymentAmountArgs) BLength() int {
 	return l
 }
 
-func (p *OrderServiceGetOrderPaymentAmountArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *OrderServiceGetOrderMerchantsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *OrderServiceGetOrderPaymentAmountArgs) field1Length() int {
+func (p *OrderServiceGetOrderMerchantsArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *OrderServiceGetOrderPaymentAmountResult) FastRead(buf []byte) (int, error) {
+func (p *OrderServiceGetOrderMerchantsResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -4218,14 +5569,14 @@ func (p *OrderServiceGetOrderPaymentAmountResult) FastRead(buf []byte) (int, err
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceGetOrderPaymentAmountResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceGetOrderMerchantsResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *OrderServiceGetOrderPaymentAmountResult) FastReadField0(buf []byte) (int, error) {
+func (p *OrderServiceGetOrderMerchantsResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetOrderPaymentAmountResp()
+	_field := NewGetOrderMerchantsResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -4235,11 +5586,11 @@ func (p *OrderServiceGetOrderPaymentAmountResult) FastReadField0(buf []byte) (in
 	return offset, nil
 }
 
-func (p *OrderServiceGetOrderPaymentAmountResult) FastWrite(buf []byte) int {
+func (p *OrderServiceGetOrderMerchantsResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *OrderServiceGetOrderPaymentAmountResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *OrderServiceGetOrderMerchantsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -4248,7 +5599,7 @@ func (p *OrderServiceGetOrderPaymentAmountResult) FastWriteNocopy(buf []byte, w
 	return offset
 }
 
-func (p *OrderServiceGetOrderPaymentAmountResult) BLength() int {
+func (p *OrderServiceGetOrderMerchantsResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -4257,7 +5608,7 @@ func (p *OrderServiceGetOrderPaymentAmountResult) BLength() int {
 	return l
 }
 
-func (p *OrderServiceGetOrderPaymentAmountResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *OrderServiceGetOrderMerchantsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -4266,7 +5617,7 @@ func (p *OrderServiceGetOrderPaymentAmountResult) fastWriteField0(buf []byte, w
 	return offset
 }
 
-func (p *OrderServiceGetOrderPaymentAmountResult) field0Length() int {
+func (p *OrderServiceGetOrderMerchantsResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -4275,7 +5626,7 @@ func (p *OrderServiceGetOrderPaymentAmountResult) field0Length() int {
 	return l
 }
 
-func (p *OrderServiceOrderPaymentSuccessArgs) FastRead(buf []byte) (int, error) {
+func (p *OrderServiceExportUserDataArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -4319,14 +5670,14 @@ func (p *OrderServiceOrderPaymentSuccessArgs) FastRead(buf []byte) (int, error)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceOrderPaymentSuccessArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceExportUserDataArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *OrderServiceOrderPaymentSuccessArgs) FastReadField1(buf []byte) (int, error) {
+func (p *OrderServiceExportUserDataArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewUpdateOrderStatusReq()
+	_field := NewExportUserDataReq()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -4336,11 +5687,11 @@ func (p *OrderServiceOrderPaymentSuccessArgs) FastReadField1(buf []byte) (int, e
 	return offset, nil
 }
 
-func (p *OrderServiceOrderPaymentSuccessArgs) FastWrite(buf []byte) int {
+func (p *OrderServiceExportUserDataArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *OrderServiceOrderPaymentSuccessArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *OrderServiceExportUserDataArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -4349,7 +5700,7 @@ func (p *OrderServiceOrderPaymentSuccessArgs) FastWriteNocopy(buf []byte, w thri
 	return offset
 }
 
-func (p *OrderServiceOrderPaymentSuccessArgs) BLength() int {
+func (p *OrderServiceExportUserDataArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -4358,21 +5709,21 @@ func (p *OrderServiceOrderPaymentSuccessArgs) BLength() int {
 	return l
 }
 
-func (p *OrderServiceOrderPaymentSuccessArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *OrderServiceExportUserDataArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *OrderServiceOrderPaymentSuccessArgs) field1Length() int {
+func (p *OrderServiceExportUserDataArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *OrderServiceOrderPaymentSuccessResult) FastRead(buf []byte) (int, error) {
+func (p *OrderServiceExportUserDataResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -4416,14 +5767,14 @@ func (p *OrderServiceOrderPaymentSuccessResult) FastRead(buf []byte) (int, error
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceOrderPaymentSuccessResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceExportUserDataResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *OrderServiceOrderPaymentSuccessResult) FastReadField0(buf []byte) (int, error) {
+func (p *OrderServiceExportUserDataResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewUpdateOrderStatusResp()
+	_field := NewExportUserDataResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -4433,11 +5784,11 @@ func (p *OrderServiceOrderPaymentSuccessResult) FastReadField0(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *OrderServiceOrderPaymentSuccessResult) FastWrite(buf []byte) int {
+func (p *OrderServiceExportUserDataResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *OrderServiceOrderPaymentSuccessResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *OrderServiceExportUserDataResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -4446,7 +5797,7 @@ func (p *OrderServiceOrderPaymentSuccessResult) FastWriteNocopy(buf []byte, w th
 	return offset
 }
 
-func (p *OrderServiceOrderPaymentSuccessResult) BLength() int {
+func (p *OrderServiceExportUserDataResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -4455,7 +5806,7 @@ func (p *OrderServiceOrderPaymentSuccessResult) BLength() int {
 	return l
 }
 
-func (p *OrderServiceOrderPaymentSuccessResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *OrderServiceExportUserDataResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -4464,7 +5815,7 @@ func (p *OrderServiceOrderPaymentSuccessResult) fastWriteField0(buf []byte, w th
 	return offset
 }
 
-func (p *OrderServiceOrderPaymentSuccessResult) field0Length() int {
+func (p *OrderServiceExportUserDataResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -4473,7 +5824,7 @@ func (p *OrderServiceOrderPaymentSuccessResult) field0Length() int {
 	return l
 }
 
-func (p *OrderServiceOrderPaymentCancelArgs) FastRead(buf []byte) (int, error) {
+func (p *OrderServiceEraseUserDataArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -4517,14 +5868,14 @@ func (p *OrderServiceOrderPaymentCancelArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceOrderPaymentCancelArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceEraseUserDataArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *OrderServiceOrderPaymentCancelArgs) FastReadField1(buf []byte) (int, error) {
+func (p *OrderServiceEraseUserDataArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewUpdateOrderStatusReq()
+	_field := NewEraseUserDataReq()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -4534,11 +5885,11 @@ func (p *OrderServiceOrderPaymentCancelArgs) FastReadField1(buf []byte) (int, er
 	return offset, nil
 }
 
-func (p *OrderServiceOrderPaymentCancelArgs) FastWrite(buf []byte) int {
+func (p *OrderServiceEraseUserDataArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *OrderServiceOrderPaymentCancelArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *OrderServiceEraseUserDataArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -4547,7 +5898,7 @@ func (p *OrderServiceOrderPaymentCancelArgs) FastWriteNocopy(buf []byte, w thrif
 	return offset
 }
 
-func (p *OrderServiceOrderPaymentCancelArgs) BLength() int {
+func (p *OrderServiceEraseUserDataArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -4556,21 +5907,21 @@ func (p *OrderServiceOrderPaymentCancelArgs) BLength() int {
 	return l
 }
 
-func (p *OrderServiceOrderPaymentCancelArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *OrderServiceEraseUserDataArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *OrderServiceOrderPaymentCancelArgs) field1Length() int {
+func (p *OrderServiceEraseUserDataArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *OrderServiceOrderPaymentCancelResult) FastRead(buf []byte) (int, error) {
+func (p *OrderServiceEraseUserDataResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -4614,14 +5965,14 @@ func (p *OrderServiceOrderPaymentCancelResult) FastRead(buf []byte) (int, error)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceOrderPaymentCancelResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceEraseUserDataResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *OrderServiceOrderPaymentCancelResult) FastReadField0(buf []byte) (int, error) {
+func (p *OrderServiceEraseUserDataResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewUpdateOrderStatusResp()
+	_field := NewEraseUserDataResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -4631,11 +5982,11 @@ func (p *OrderServiceOrderPaymentCancelResult) FastReadField0(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *OrderServiceOrderPaymentCancelResult) FastWrite(buf []byte) int {
+func (p *OrderServiceEraseUserDataResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *OrderServiceOrderPaymentCancelResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *OrderServiceEraseUserDataResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -4644,7 +5995,7 @@ func (p *OrderServiceOrderPaymentCancelResult) FastWriteNocopy(buf []byte, w thr
 	return offset
 }
 
-func (p *OrderServiceOrderPaymentCancelResult) BLength() int {
+func (p *OrderServiceEraseUserDataResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -4653,7 +6004,7 @@ func (p *OrderServiceOrderPaymentCancelResult) BLength() int {
 	return l
 }
 
-func (p *OrderServiceOrderPaymentCancelResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *OrderServiceEraseUserDataResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -4662,7 +6013,7 @@ func (p *OrderServiceOrderPaymentCancelResult) fastWriteField0(buf []byte, w thr
 	return offset
 }
 
-func (p *OrderServiceOrderPaymentCancelResult) field0Length() int {
+func (p *OrderServiceEraseUserDataResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -4750,3 +6101,27 @@ func (p *OrderServiceOrderPaymentCancelArgs) GetFirstArgument() interface{} {
 func (p *OrderServiceOrderPaymentCancelResult) GetResult() interface{} {
 	return p.Success
 }
+
+func (p *OrderServiceGetOrderMerchantsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *OrderServiceGetOrderMerchantsResult) GetResult() interface{} {
+	return p.Success
+}
+
+func (p *OrderServiceExportUserDataArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *OrderServiceExportUserDataResult) GetResult() interface{} {
+	return p.Success
+}
+
+func (p *OrderServiceEraseUserDataArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *OrderServiceEraseUserDataResult) GetResult() interface{} {
+	return p.Success
+}
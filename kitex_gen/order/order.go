@@ -1276,6 +1276,371 @@ var fieldIDToName_GetOrderPaymentAmountResp = map[int16]string{
 	2: "amount",
 }
 
+type GetOrderMerchantsReq struct {
+	OrderID int64 `thrift:"orderID,1,required" frugal:"1,required,i64" json:"orderID"`
+}
+
+func NewGetOrderMerchantsReq() *GetOrderMerchantsReq {
+	return &GetOrderMerchantsReq{}
+}
+
+func (p *GetOrderMerchantsReq) InitDefault() {
+}
+
+func (p *GetOrderMerchantsReq) GetOrderID() (v int64) {
+	return p.OrderID
+}
+func (p *GetOrderMerchantsReq) SetOrderID(val int64) {
+	p.OrderID = val
+}
+
+func (p *GetOrderMerchantsReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetOrderMerchantsReq(%+v)", *p)
+}
+
+func (p *GetOrderMerchantsReq) DeepEqual(ano *GetOrderMerchantsReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.OrderID) {
+		return false
+	}
+	return true
+}
+
+func (p *GetOrderMerchantsReq) Field1DeepEqual(src int64) bool {
+
+	if p.OrderID != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_GetOrderMerchantsReq = map[int16]string{
+	1: "orderID",
+}
+
+type GetOrderMerchantsResp struct {
+	Base        *model.BaseResp `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+	MerchantIDs []int64         `thrift:"merchantIDs,2,required" frugal:"2,required,list<i64>" json:"merchantIDs"`
+}
+
+func NewGetOrderMerchantsResp() *GetOrderMerchantsResp {
+	return &GetOrderMerchantsResp{}
+}
+
+func (p *GetOrderMerchantsResp) InitDefault() {
+}
+
+var GetOrderMerchantsResp_Base_DEFAULT *model.BaseResp
+
+func (p *GetOrderMerchantsResp) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return GetOrderMerchantsResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *GetOrderMerchantsResp) GetMerchantIDs() (v []int64) {
+	return p.MerchantIDs
+}
+func (p *GetOrderMerchantsResp) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *GetOrderMerchantsResp) SetMerchantIDs(val []int64) {
+	p.MerchantIDs = val
+}
+
+func (p *GetOrderMerchantsResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *GetOrderMerchantsResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetOrderMerchantsResp(%+v)", *p)
+}
+
+func (p *GetOrderMerchantsResp) DeepEqual(ano *GetOrderMerchantsResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.MerchantIDs) {
+		return false
+	}
+	return true
+}
+
+func (p *GetOrderMerchantsResp) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *GetOrderMerchantsResp) Field2DeepEqual(src []int64) bool {
+
+	if len(p.MerchantIDs) != len(src) {
+		return false
+	}
+	for i, v := range p.MerchantIDs {
+		_src := src[i]
+		if v != _src {
+			return false
+		}
+	}
+	return true
+}
+
+var fieldIDToName_GetOrderMerchantsResp = map[int16]string{
+	1: "base",
+	2: "merchantIDs",
+}
+
+type ExportUserDataReq struct {
+	Uid int64 `thrift:"uid,1,required" frugal:"1,required,i64" json:"uid"`
+}
+
+func NewExportUserDataReq() *ExportUserDataReq {
+	return &ExportUserDataReq{}
+}
+
+func (p *ExportUserDataReq) InitDefault() {
+}
+
+func (p *ExportUserDataReq) GetUid() (v int64) {
+	return p.Uid
+}
+func (p *ExportUserDataReq) SetUid(val int64) {
+	p.Uid = val
+}
+
+func (p *ExportUserDataReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExportUserDataReq(%+v)", *p)
+}
+
+func (p *ExportUserDataReq) DeepEqual(ano *ExportUserDataReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Uid) {
+		return false
+	}
+	return true
+}
+
+func (p *ExportUserDataReq) Field1DeepEqual(src int64) bool {
+
+	if p.Uid != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_ExportUserDataReq = map[int16]string{
+	1: "uid",
+}
+
+type ExportUserDataResp struct {
+	Base *model.BaseResp `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+	Data string          `thrift:"data,2,required" frugal:"2,required,string" json:"data"`
+}
+
+func NewExportUserDataResp() *ExportUserDataResp {
+	return &ExportUserDataResp{}
+}
+
+func (p *ExportUserDataResp) InitDefault() {
+}
+
+var ExportUserDataResp_Base_DEFAULT *model.BaseResp
+
+func (p *ExportUserDataResp) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return ExportUserDataResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *ExportUserDataResp) GetData() (v string) {
+	return p.Data
+}
+func (p *ExportUserDataResp) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *ExportUserDataResp) SetData(val string) {
+	p.Data = val
+}
+
+func (p *ExportUserDataResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ExportUserDataResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExportUserDataResp(%+v)", *p)
+}
+
+func (p *ExportUserDataResp) DeepEqual(ano *ExportUserDataResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Data) {
+		return false
+	}
+	return true
+}
+
+func (p *ExportUserDataResp) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *ExportUserDataResp) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.Data, src) != 0 {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_ExportUserDataResp = map[int16]string{
+	1: "base",
+	2: "data",
+}
+
+type EraseUserDataReq struct {
+	Uid int64 `thrift:"uid,1,required" frugal:"1,required,i64" json:"uid"`
+}
+
+func NewEraseUserDataReq() *EraseUserDataReq {
+	return &EraseUserDataReq{}
+}
+
+func (p *EraseUserDataReq) InitDefault() {
+}
+
+func (p *EraseUserDataReq) GetUid() (v int64) {
+	return p.Uid
+}
+func (p *EraseUserDataReq) SetUid(val int64) {
+	p.Uid = val
+}
+
+func (p *EraseUserDataReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EraseUserDataReq(%+v)", *p)
+}
+
+func (p *EraseUserDataReq) DeepEqual(ano *EraseUserDataReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Uid) {
+		return false
+	}
+	return true
+}
+
+func (p *EraseUserDataReq) Field1DeepEqual(src int64) bool {
+
+	if p.Uid != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_EraseUserDataReq = map[int16]string{
+	1: "uid",
+}
+
+type EraseUserDataResp struct {
+	Base *model.BaseResp `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+}
+
+func NewEraseUserDataResp() *EraseUserDataResp {
+	return &EraseUserDataResp{}
+}
+
+func (p *EraseUserDataResp) InitDefault() {
+}
+
+var EraseUserDataResp_Base_DEFAULT *model.BaseResp
+
+func (p *EraseUserDataResp) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return EraseUserDataResp_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *EraseUserDataResp) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+
+func (p *EraseUserDataResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *EraseUserDataResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EraseUserDataResp(%+v)", *p)
+}
+
+func (p *EraseUserDataResp) DeepEqual(ano *EraseUserDataResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	return true
+}
+
+func (p *EraseUserDataResp) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_EraseUserDataResp = map[int16]string{
+	1: "base",
+}
+
 type OrderService interface {
 	CreateOrder(ctx context.Context, req *CreateOrderReq) (r *CreateOrderResp, err error)
 
@@ -1295,7 +1660,13 @@ type OrderService interface {
 
 	OrderPaymentSuccess(ctx context.Context, req *UpdateOrderStatusReq) (r *UpdateOrderStatusResp, err error)
 
-	OrderPaymentCancel(ctx context.Context, req *UpdateOrderStatusReq) (r *UpdateOrderStatusResp, err error)
+	OrderPaymentCancel(ctx context.Context, req *UpdateOrderStatusReq) (r *UpdateOrderStatusResp, err error)
+
+	GetOrderMerchants(ctx context.Context, req *GetOrderMerchantsReq) (r *GetOrderMerchantsResp, err error)
+
+	ExportUserData(ctx context.Context, req *ExportUserDataReq) (r *ExportUserDataResp, err error)
+
+	EraseUserData(ctx context.Context, req *EraseUserDataReq) (r *EraseUserDataResp, err error)
 }
 
 type OrderServiceCreateOrderArgs struct {
@@ -2457,3 +2828,351 @@ func (p *OrderServiceOrderPaymentCancelResult) Field0DeepEqual(src *UpdateOrderS
 var fieldIDToName_OrderServiceOrderPaymentCancelResult = map[int16]string{
 	0: "success",
 }
+
+type OrderServiceGetOrderMerchantsArgs struct {
+	Req *GetOrderMerchantsReq `thrift:"req,1" frugal:"1,default,GetOrderMerchantsReq" json:"req"`
+}
+
+func NewOrderServiceGetOrderMerchantsArgs() *OrderServiceGetOrderMerchantsArgs {
+	return &OrderServiceGetOrderMerchantsArgs{}
+}
+
+func (p *OrderServiceGetOrderMerchantsArgs) InitDefault() {
+}
+
+var OrderServiceGetOrderMerchantsArgs_Req_DEFAULT *GetOrderMerchantsReq
+
+func (p *OrderServiceGetOrderMerchantsArgs) GetReq() (v *GetOrderMerchantsReq) {
+	if !p.IsSetReq() {
+		return OrderServiceGetOrderMerchantsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *OrderServiceGetOrderMerchantsArgs) SetReq(val *GetOrderMerchantsReq) {
+	p.Req = val
+}
+
+func (p *OrderServiceGetOrderMerchantsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *OrderServiceGetOrderMerchantsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceGetOrderMerchantsArgs(%+v)", *p)
+}
+
+func (p *OrderServiceGetOrderMerchantsArgs) DeepEqual(ano *OrderServiceGetOrderMerchantsArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *OrderServiceGetOrderMerchantsArgs) Field1DeepEqual(src *GetOrderMerchantsReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_OrderServiceGetOrderMerchantsArgs = map[int16]string{
+	1: "req",
+}
+
+type OrderServiceGetOrderMerchantsResult struct {
+	Success *GetOrderMerchantsResp `thrift:"success,0,optional" frugal:"0,optional,GetOrderMerchantsResp" json:"success,omitempty"`
+}
+
+func NewOrderServiceGetOrderMerchantsResult() *OrderServiceGetOrderMerchantsResult {
+	return &OrderServiceGetOrderMerchantsResult{}
+}
+
+func (p *OrderServiceGetOrderMerchantsResult) InitDefault() {
+}
+
+var OrderServiceGetOrderMerchantsResult_Success_DEFAULT *GetOrderMerchantsResp
+
+func (p *OrderServiceGetOrderMerchantsResult) GetSuccess() (v *GetOrderMerchantsResp) {
+	if !p.IsSetSuccess() {
+		return OrderServiceGetOrderMerchantsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *OrderServiceGetOrderMerchantsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetOrderMerchantsResp)
+}
+
+func (p *OrderServiceGetOrderMerchantsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *OrderServiceGetOrderMerchantsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceGetOrderMerchantsResult(%+v)", *p)
+}
+
+func (p *OrderServiceGetOrderMerchantsResult) DeepEqual(ano *OrderServiceGetOrderMerchantsResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *OrderServiceGetOrderMerchantsResult) Field0DeepEqual(src *GetOrderMerchantsResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_OrderServiceGetOrderMerchantsResult = map[int16]string{
+	0: "success",
+}
+
+type OrderServiceExportUserDataArgs struct {
+	Req *ExportUserDataReq `thrift:"req,1" frugal:"1,default,ExportUserDataReq" json:"req"`
+}
+
+func NewOrderServiceExportUserDataArgs() *OrderServiceExportUserDataArgs {
+	return &OrderServiceExportUserDataArgs{}
+}
+
+func (p *OrderServiceExportUserDataArgs) InitDefault() {
+}
+
+var OrderServiceExportUserDataArgs_Req_DEFAULT *ExportUserDataReq
+
+func (p *OrderServiceExportUserDataArgs) GetReq() (v *ExportUserDataReq) {
+	if !p.IsSetReq() {
+		return OrderServiceExportUserDataArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *OrderServiceExportUserDataArgs) SetReq(val *ExportUserDataReq) {
+	p.Req = val
+}
+
+func (p *OrderServiceExportUserDataArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *OrderServiceExportUserDataArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceExportUserDataArgs(%+v)", *p)
+}
+
+func (p *OrderServiceExportUserDataArgs) DeepEqual(ano *OrderServiceExportUserDataArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *OrderServiceExportUserDataArgs) Field1DeepEqual(src *ExportUserDataReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_OrderServiceExportUserDataArgs = map[int16]string{
+	1: "req",
+}
+
+type OrderServiceExportUserDataResult struct {
+	Success *ExportUserDataResp `thrift:"success,0,optional" frugal:"0,optional,ExportUserDataResp" json:"success,omitempty"`
+}
+
+func NewOrderServiceExportUserDataResult() *OrderServiceExportUserDataResult {
+	return &OrderServiceExportUserDataResult{}
+}
+
+func (p *OrderServiceExportUserDataResult) InitDefault() {
+}
+
+var OrderServiceExportUserDataResult_Success_DEFAULT *ExportUserDataResp
+
+func (p *OrderServiceExportUserDataResult) GetSuccess() (v *ExportUserDataResp) {
+	if !p.IsSetSuccess() {
+		return OrderServiceExportUserDataResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *OrderServiceExportUserDataResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ExportUserDataResp)
+}
+
+func (p *OrderServiceExportUserDataResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *OrderServiceExportUserDataResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceExportUserDataResult(%+v)", *p)
+}
+
+func (p *OrderServiceExportUserDataResult) DeepEqual(ano *OrderServiceExportUserDataResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *OrderServiceExportUserDataResult) Field0DeepEqual(src *ExportUserDataResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_OrderServiceExportUserDataResult = map[int16]string{
+	0: "success",
+}
+
+type OrderServiceEraseUserDataArgs struct {
+	Req *EraseUserDataReq `thrift:"req,1" frugal:"1,default,EraseUserDataReq" json:"req"`
+}
+
+func NewOrderServiceEraseUserDataArgs() *OrderServiceEraseUserDataArgs {
+	return &OrderServiceEraseUserDataArgs{}
+}
+
+func (p *OrderServiceEraseUserDataArgs) InitDefault() {
+}
+
+var OrderServiceEraseUserDataArgs_Req_DEFAULT *EraseUserDataReq
+
+func (p *OrderServiceEraseUserDataArgs) GetReq() (v *EraseUserDataReq) {
+	if !p.IsSetReq() {
+		return OrderServiceEraseUserDataArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *OrderServiceEraseUserDataArgs) SetReq(val *EraseUserDataReq) {
+	p.Req = val
+}
+
+func (p *OrderServiceEraseUserDataArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *OrderServiceEraseUserDataArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceEraseUserDataArgs(%+v)", *p)
+}
+
+func (p *OrderServiceEraseUserDataArgs) DeepEqual(ano *OrderServiceEraseUserDataArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *OrderServiceEraseUserDataArgs) Field1DeepEqual(src *EraseUserDataReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_OrderServiceEraseUserDataArgs = map[int16]string{
+	1: "req",
+}
+
+type OrderServiceEraseUserDataResult struct {
+	Success *EraseUserDataResp `thrift:"success,0,optional" frugal:"0,optional,EraseUserDataResp" json:"success,omitempty"`
+}
+
+func NewOrderServiceEraseUserDataResult() *OrderServiceEraseUserDataResult {
+	return &OrderServiceEraseUserDataResult{}
+}
+
+func (p *OrderServiceEraseUserDataResult) InitDefault() {
+}
+
+var OrderServiceEraseUserDataResult_Success_DEFAULT *EraseUserDataResp
+
+func (p *OrderServiceEraseUserDataResult) GetSuccess() (v *EraseUserDataResp) {
+	if !p.IsSetSuccess() {
+		return OrderServiceEraseUserDataResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *OrderServiceEraseUserDataResult) SetSuccess(x interface{}) {
+	p.Success = x.(*EraseUserDataResp)
+}
+
+func (p *OrderServiceEraseUserDataResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *OrderServiceEraseUserDataResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceEraseUserDataResult(%+v)", *p)
+}
+
+func (p *OrderServiceEraseUserDataResult) DeepEqual(ano *OrderServiceEraseUserDataResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *OrderServiceEraseUserDataResult) Field0DeepEqual(src *EraseUserDataResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_OrderServiceEraseUserDataResult = map[int16]string{
+	0: "success",
+}
@@ -39,6 +39,9 @@ type Client interface {
 	GetOrderPaymentAmount(ctx context.Context, req *order.GetOrderPaymentAmount, callOptions ...callopt.Option) (r *order.GetOrderPaymentAmountResp, err error)
 	OrderPaymentSuccess(ctx context.Context, req *order.UpdateOrderStatusReq, callOptions ...callopt.Option) (r *order.UpdateOrderStatusResp, err error)
 	OrderPaymentCancel(ctx context.Context, req *order.UpdateOrderStatusReq, callOptions ...callopt.Option) (r *order.UpdateOrderStatusResp, err error)
+	GetOrderMerchants(ctx context.Context, req *order.GetOrderMerchantsReq, callOptions ...callopt.Option) (r *order.GetOrderMerchantsResp, err error)
+	ExportUserData(ctx context.Context, req *order.ExportUserDataReq, callOptions ...callopt.Option) (r *order.ExportUserDataResp, err error)
+	EraseUserData(ctx context.Context, req *order.EraseUserDataReq, callOptions ...callopt.Option) (r *order.EraseUserDataResp, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -119,3 +122,18 @@ func (p *kOrderServiceClient) OrderPaymentCancel(ctx context.Context, req *order
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.OrderPaymentCancel(ctx, req)
 }
+
+func (p *kOrderServiceClient) GetOrderMerchants(ctx context.Context, req *order.GetOrderMerchantsReq, callOptions ...callopt.Option) (r *order.GetOrderMerchantsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetOrderMerchants(ctx, req)
+}
+
+func (p *kOrderServiceClient) ExportUserData(ctx context.Context, req *order.ExportUserDataReq, callOptions ...callopt.Option) (r *order.ExportUserDataResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ExportUserData(ctx, req)
+}
+
+func (p *kOrderServiceClient) EraseUserData(ctx context.Context, req *order.EraseUserDataReq, callOptions ...callopt.Option) (r *order.EraseUserDataResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.EraseUserData(ctx, req)
+}
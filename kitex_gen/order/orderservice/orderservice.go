@@ -101,6 +101,27 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"GetOrderMerchants": kitex.NewMethodInfo(
+		getOrderMerchantsHandler,
+		newOrderServiceGetOrderMerchantsArgs,
+		newOrderServiceGetOrderMerchantsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ExportUserData": kitex.NewMethodInfo(
+		exportUserDataHandler,
+		newOrderServiceExportUserDataArgs,
+		newOrderServiceExportUserDataResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"EraseUserData": kitex.NewMethodInfo(
+		eraseUserDataHandler,
+		newOrderServiceEraseUserDataArgs,
+		newOrderServiceEraseUserDataResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 }
 
 var (
@@ -347,6 +368,60 @@ func newOrderServiceOrderPaymentCancelResult() interface{} {
 	return order.NewOrderServiceOrderPaymentCancelResult()
 }
 
+func getOrderMerchantsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*order.OrderServiceGetOrderMerchantsArgs)
+	realResult := result.(*order.OrderServiceGetOrderMerchantsResult)
+	success, err := handler.(order.OrderService).GetOrderMerchants(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newOrderServiceGetOrderMerchantsArgs() interface{} {
+	return order.NewOrderServiceGetOrderMerchantsArgs()
+}
+
+func newOrderServiceGetOrderMerchantsResult() interface{} {
+	return order.NewOrderServiceGetOrderMerchantsResult()
+}
+
+func exportUserDataHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*order.OrderServiceExportUserDataArgs)
+	realResult := result.(*order.OrderServiceExportUserDataResult)
+	success, err := handler.(order.OrderService).ExportUserData(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newOrderServiceExportUserDataArgs() interface{} {
+	return order.NewOrderServiceExportUserDataArgs()
+}
+
+func newOrderServiceExportUserDataResult() interface{} {
+	return order.NewOrderServiceExportUserDataResult()
+}
+
+func eraseUserDataHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*order.OrderServiceEraseUserDataArgs)
+	realResult := result.(*order.OrderServiceEraseUserDataResult)
+	success, err := handler.(order.OrderService).EraseUserData(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newOrderServiceEraseUserDataArgs() interface{} {
+	return order.NewOrderServiceEraseUserDataArgs()
+}
+
+func newOrderServiceEraseUserDataResult() interface{} {
+	return order.NewOrderServiceEraseUserDataResult()
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -456,3 +531,33 @@ func (p *kClient) OrderPaymentCancel(ctx context.Context, req *order.UpdateOrder
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) GetOrderMerchants(ctx context.Context, req *order.GetOrderMerchantsReq) (r *order.GetOrderMerchantsResp, err error) {
+	var _args order.OrderServiceGetOrderMerchantsArgs
+	_args.Req = req
+	var _result order.OrderServiceGetOrderMerchantsResult
+	if err = p.c.Call(ctx, "GetOrderMerchants", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ExportUserData(ctx context.Context, req *order.ExportUserDataReq) (r *order.ExportUserDataResp, err error) {
+	var _args order.OrderServiceExportUserDataArgs
+	_args.Req = req
+	var _result order.OrderServiceExportUserDataResult
+	if err = p.c.Call(ctx, "ExportUserData", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) EraseUserData(ctx context.Context, req *order.EraseUserDataReq) (r *order.EraseUserDataResp, err error) {
+	var _args order.OrderServiceEraseUserDataArgs
+	_args.Req = req
+	var _result order.OrderServiceEraseUserDataResult
+	if err = p.c.Call(ctx, "EraseUserData", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
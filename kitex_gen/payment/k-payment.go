@@ -1806,6 +1806,550 @@ func (p *PaymentCheckoutResponse) field1Length() int {
 	return l
 }
 
+func (p *ExportUserDataRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetUid bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetUid = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetUid {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExportUserDataRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ExportUserDataRequest[fieldId]))
+}
+
+func (p *ExportUserDataRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.Uid = _field
+	return offset, nil
+}
+
+func (p *ExportUserDataRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ExportUserDataRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ExportUserDataRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ExportUserDataRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.Uid)
+	return offset
+}
+
+func (p *ExportUserDataRequest) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *ExportUserDataResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExportUserDataResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *ExportUserDataResponse) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := model.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *ExportUserDataResponse) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.Data = _field
+	return offset, nil
+}
+
+func (p *ExportUserDataResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ExportUserDataResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ExportUserDataResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ExportUserDataResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *ExportUserDataResponse) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.Data)
+	return offset
+}
+
+func (p *ExportUserDataResponse) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Base.BLength()
+	return l
+}
+
+func (p *ExportUserDataResponse) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.StringLengthNocopy(p.Data)
+	return l
+}
+
+func (p *PaymentStatusRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetOrderID bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetOrderID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetOrderID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PaymentStatusRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_PaymentStatusRequest[fieldId]))
+}
+
+func (p *PaymentStatusRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.OrderID = _field
+	return offset, nil
+}
+
+func (p *PaymentStatusRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *PaymentStatusRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *PaymentStatusRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *PaymentStatusRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.OrderID)
+	return offset
+}
+
+func (p *PaymentStatusRequest) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *PaymentStatusResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PaymentStatusResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *PaymentStatusResponse) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := model.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *PaymentStatusResponse) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+	_field := model.NewPaymentStatusInfo()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Payment = _field
+	return offset, nil
+}
+
+func (p *PaymentStatusResponse) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+	_field := model.NewRefundStatusInfo()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Refund = _field
+	return offset, nil
+}
+
+func (p *PaymentStatusResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *PaymentStatusResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *PaymentStatusResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *PaymentStatusResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *PaymentStatusResponse) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPayment() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 2)
+		offset += p.Payment.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *PaymentStatusResponse) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetRefund() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 3)
+		offset += p.Refund.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *PaymentStatusResponse) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Base.BLength()
+	return l
+}
+
+func (p *PaymentStatusResponse) field2Length() int {
+	l := 0
+	if p.IsSetPayment() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Payment.BLength()
+	}
+	return l
+}
+
+func (p *PaymentStatusResponse) field3Length() int {
+	l := 0
+	if p.IsSetRefund() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Refund.BLength()
+	}
+	return l
+}
+
 func (p *PaymentServiceProcessPaymentArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
@@ -1850,14 +2394,410 @@ func (p *PaymentServiceProcessPaymentArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PaymentServiceProcessPaymentArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PaymentServiceProcessPaymentArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *PaymentServiceProcessPaymentArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewPaymentRequest()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Request = _field
+	return offset, nil
+}
+
+func (p *PaymentServiceProcessPaymentArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *PaymentServiceProcessPaymentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *PaymentServiceProcessPaymentArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *PaymentServiceProcessPaymentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Request.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *PaymentServiceProcessPaymentArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Request.BLength()
+	return l
+}
+
+func (p *PaymentServiceProcessPaymentResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PaymentServiceProcessPaymentResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *PaymentServiceProcessPaymentResult) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+	_field := NewPaymentResponse()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Success = _field
+	return offset, nil
+}
+
+func (p *PaymentServiceProcessPaymentResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *PaymentServiceProcessPaymentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *PaymentServiceProcessPaymentResult) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *PaymentServiceProcessPaymentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *PaymentServiceProcessPaymentResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
+	}
+	return l
+}
+
+func (p *PaymentServiceRequestPaymentTokenArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PaymentServiceRequestPaymentTokenArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *PaymentServiceRequestPaymentTokenArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewPaymentTokenRequest()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Request = _field
+	return offset, nil
+}
+
+func (p *PaymentServiceRequestPaymentTokenArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *PaymentServiceRequestPaymentTokenArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *PaymentServiceRequestPaymentTokenArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *PaymentServiceRequestPaymentTokenArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Request.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *PaymentServiceRequestPaymentTokenArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Request.BLength()
+	return l
+}
+
+func (p *PaymentServiceRequestPaymentTokenResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PaymentServiceRequestPaymentTokenResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *PaymentServiceRequestPaymentTokenResult) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+	_field := NewPaymentTokenResponse()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Success = _field
+	return offset, nil
+}
+
+func (p *PaymentServiceRequestPaymentTokenResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *PaymentServiceRequestPaymentTokenResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *PaymentServiceRequestPaymentTokenResult) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *PaymentServiceRequestPaymentTokenResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *PaymentServiceRequestPaymentTokenResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
+	}
+	return l
+}
+
+func (p *PaymentServiceRequestPaymentCheckoutArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PaymentServiceRequestPaymentCheckoutArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *PaymentServiceProcessPaymentArgs) FastReadField1(buf []byte) (int, error) {
+func (p *PaymentServiceRequestPaymentCheckoutArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewPaymentRequest()
+	_field := NewPaymentCheckoutRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -1867,11 +2807,11 @@ func (p *PaymentServiceProcessPaymentArgs) FastReadField1(buf []byte) (int, erro
 	return offset, nil
 }
 
-func (p *PaymentServiceProcessPaymentArgs) FastWrite(buf []byte) int {
+func (p *PaymentServiceRequestPaymentCheckoutArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *PaymentServiceProcessPaymentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *PaymentServiceRequestPaymentCheckoutArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -1880,7 +2820,7 @@ func (p *PaymentServiceProcessPaymentArgs) FastWriteNocopy(buf []byte, w thrift.
 	return offset
 }
 
-func (p *PaymentServiceProcessPaymentArgs) BLength() int {
+func (p *PaymentServiceRequestPaymentCheckoutArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -1889,21 +2829,21 @@ func (p *PaymentServiceProcessPaymentArgs) BLength() int {
 	return l
 }
 
-func (p *PaymentServiceProcessPaymentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *PaymentServiceRequestPaymentCheckoutArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Request.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *PaymentServiceProcessPaymentArgs) field1Length() int {
+func (p *PaymentServiceRequestPaymentCheckoutArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Request.BLength()
 	return l
 }
 
-func (p *PaymentServiceProcessPaymentResult) FastRead(buf []byte) (int, error) {
+func (p *PaymentServiceRequestPaymentCheckoutResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -1947,14 +2887,14 @@ func (p *PaymentServiceProcessPaymentResult) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PaymentServiceProcessPaymentResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PaymentServiceRequestPaymentCheckoutResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *PaymentServiceProcessPaymentResult) FastReadField0(buf []byte) (int, error) {
+func (p *PaymentServiceRequestPaymentCheckoutResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewPaymentResponse()
+	_field := NewPaymentCheckoutResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -1964,11 +2904,11 @@ func (p *PaymentServiceProcessPaymentResult) FastReadField0(buf []byte) (int, er
 	return offset, nil
 }
 
-func (p *PaymentServiceProcessPaymentResult) FastWrite(buf []byte) int {
+func (p *PaymentServiceRequestPaymentCheckoutResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *PaymentServiceProcessPaymentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *PaymentServiceRequestPaymentCheckoutResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -1977,7 +2917,7 @@ func (p *PaymentServiceProcessPaymentResult) FastWriteNocopy(buf []byte, w thrif
 	return offset
 }
 
-func (p *PaymentServiceProcessPaymentResult) BLength() int {
+func (p *PaymentServiceRequestPaymentCheckoutResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -1986,7 +2926,7 @@ func (p *PaymentServiceProcessPaymentResult) BLength() int {
 	return l
 }
 
-func (p *PaymentServiceProcessPaymentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *PaymentServiceRequestPaymentCheckoutResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -1995,7 +2935,7 @@ func (p *PaymentServiceProcessPaymentResult) fastWriteField0(buf []byte, w thrif
 	return offset
 }
 
-func (p *PaymentServiceProcessPaymentResult) field0Length() int {
+func (p *PaymentServiceRequestPaymentCheckoutResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -2004,7 +2944,7 @@ func (p *PaymentServiceProcessPaymentResult) field0Length() int {
 	return l
 }
 
-func (p *PaymentServiceRequestPaymentTokenArgs) FastRead(buf []byte) (int, error) {
+func (p *PaymentServiceRefundReviewArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -2048,14 +2988,14 @@ func (p *PaymentServiceRequestPaymentTokenArgs) FastRead(buf []byte) (int, error
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PaymentServiceRequestPaymentTokenArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PaymentServiceRefundReviewArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *PaymentServiceRequestPaymentTokenArgs) FastReadField1(buf []byte) (int, error) {
+func (p *PaymentServiceRefundReviewArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewPaymentTokenRequest()
+	_field := NewRefundReviewRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -2065,11 +3005,11 @@ func (p *PaymentServiceRequestPaymentTokenArgs) FastReadField1(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *PaymentServiceRequestPaymentTokenArgs) FastWrite(buf []byte) int {
+func (p *PaymentServiceRefundReviewArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *PaymentServiceRequestPaymentTokenArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *PaymentServiceRefundReviewArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -2078,7 +3018,7 @@ func (p *PaymentServiceRequestPaymentTokenArgs) FastWriteNocopy(buf []byte, w th
 	return offset
 }
 
-func (p *PaymentServiceRequestPaymentTokenArgs) BLength() int {
+func (p *PaymentServiceRefundReviewArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -2087,21 +3027,21 @@ func (p *PaymentServiceRequestPaymentTokenArgs) BLength() int {
 	return l
 }
 
-func (p *PaymentServiceRequestPaymentTokenArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *PaymentServiceRefundReviewArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Request.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *PaymentServiceRequestPaymentTokenArgs) field1Length() int {
+func (p *PaymentServiceRefundReviewArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Request.BLength()
 	return l
 }
 
-func (p *PaymentServiceRequestPaymentTokenResult) FastRead(buf []byte) (int, error) {
+func (p *PaymentServiceRefundReviewResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -2145,14 +3085,14 @@ func (p *PaymentServiceRequestPaymentTokenResult) FastRead(buf []byte) (int, err
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PaymentServiceRequestPaymentTokenResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PaymentServiceRefundReviewResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *PaymentServiceRequestPaymentTokenResult) FastReadField0(buf []byte) (int, error) {
+func (p *PaymentServiceRefundReviewResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewPaymentTokenResponse()
+	_field := NewRefundReviewResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -2162,11 +3102,11 @@ func (p *PaymentServiceRequestPaymentTokenResult) FastReadField0(buf []byte) (in
 	return offset, nil
 }
 
-func (p *PaymentServiceRequestPaymentTokenResult) FastWrite(buf []byte) int {
+func (p *PaymentServiceRefundReviewResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *PaymentServiceRequestPaymentTokenResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *PaymentServiceRefundReviewResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -2175,7 +3115,7 @@ func (p *PaymentServiceRequestPaymentTokenResult) FastWriteNocopy(buf []byte, w
 	return offset
 }
 
-func (p *PaymentServiceRequestPaymentTokenResult) BLength() int {
+func (p *PaymentServiceRefundReviewResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -2184,7 +3124,7 @@ func (p *PaymentServiceRequestPaymentTokenResult) BLength() int {
 	return l
 }
 
-func (p *PaymentServiceRequestPaymentTokenResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *PaymentServiceRefundReviewResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -2193,7 +3133,7 @@ func (p *PaymentServiceRequestPaymentTokenResult) fastWriteField0(buf []byte, w
 	return offset
 }
 
-func (p *PaymentServiceRequestPaymentTokenResult) field0Length() int {
+func (p *PaymentServiceRefundReviewResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -2202,7 +3142,7 @@ func (p *PaymentServiceRequestPaymentTokenResult) field0Length() int {
 	return l
 }
 
-func (p *PaymentServiceRequestPaymentCheckoutArgs) FastRead(buf []byte) (int, error) {
+func (p *PaymentServiceRequestRefundArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -2246,14 +3186,14 @@ func (p *PaymentServiceRequestPaymentCheckoutArgs) FastRead(buf []byte) (int, er
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PaymentServiceRequestPaymentCheckoutArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PaymentServiceRequestRefundArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *PaymentServiceRequestPaymentCheckoutArgs) FastReadField1(buf []byte) (int, error) {
+func (p *PaymentServiceRequestRefundArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewPaymentCheckoutRequest()
+	_field := NewRefundRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -2263,11 +3203,11 @@ func (p *PaymentServiceRequestPaymentCheckoutArgs) FastReadField1(buf []byte) (i
 	return offset, nil
 }
 
-func (p *PaymentServiceRequestPaymentCheckoutArgs) FastWrite(buf []byte) int {
+func (p *PaymentServiceRequestRefundArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *PaymentServiceRequestPaymentCheckoutArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *PaymentServiceRequestRefundArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -2276,7 +3216,7 @@ func (p *PaymentServiceRequestPaymentCheckoutArgs) FastWriteNocopy(buf []byte, w
 	return offset
 }
 
-func (p *PaymentServiceRequestPaymentCheckoutArgs) BLength() int {
+func (p *PaymentServiceRequestRefundArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -2285,21 +3225,21 @@ func (p *PaymentServiceRequestPaymentCheckoutArgs) BLength() int {
 	return l
 }
 
-func (p *PaymentServiceRequestPaymentCheckoutArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *PaymentServiceRequestRefundArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Request.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *PaymentServiceRequestPaymentCheckoutArgs) field1Length() int {
+func (p *PaymentServiceRequestRefundArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Request.BLength()
 	return l
 }
 
-func (p *PaymentServiceRequestPaymentCheckoutResult) FastRead(buf []byte) (int, error) {
+func (p *PaymentServiceRequestRefundResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -2343,14 +3283,14 @@ func (p *PaymentServiceRequestPaymentCheckoutResult) FastRead(buf []byte) (int,
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PaymentServiceRequestPaymentCheckoutResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PaymentServiceRequestRefundResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *PaymentServiceRequestPaymentCheckoutResult) FastReadField0(buf []byte) (int, error) {
+func (p *PaymentServiceRequestRefundResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewPaymentCheckoutResponse()
+	_field := NewRefundResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -2360,11 +3300,11 @@ func (p *PaymentServiceRequestPaymentCheckoutResult) FastReadField0(buf []byte)
 	return offset, nil
 }
 
-func (p *PaymentServiceRequestPaymentCheckoutResult) FastWrite(buf []byte) int {
+func (p *PaymentServiceRequestRefundResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *PaymentServiceRequestPaymentCheckoutResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *PaymentServiceRequestRefundResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -2373,7 +3313,7 @@ func (p *PaymentServiceRequestPaymentCheckoutResult) FastWriteNocopy(buf []byte,
 	return offset
 }
 
-func (p *PaymentServiceRequestPaymentCheckoutResult) BLength() int {
+func (p *PaymentServiceRequestRefundResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -2382,7 +3322,7 @@ func (p *PaymentServiceRequestPaymentCheckoutResult) BLength() int {
 	return l
 }
 
-func (p *PaymentServiceRequestPaymentCheckoutResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *PaymentServiceRequestRefundResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -2391,7 +3331,7 @@ func (p *PaymentServiceRequestPaymentCheckoutResult) fastWriteField0(buf []byte,
 	return offset
 }
 
-func (p *PaymentServiceRequestPaymentCheckoutResult) field0Length() int {
+func (p *PaymentServiceRequestRefundResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -2400,7 +3340,7 @@ func (p *PaymentServiceRequestPaymentCheckoutResult) field0Length() int {
 	return l
 }
 
-func (p *PaymentServiceRefundReviewArgs) FastRead(buf []byte) (int, error) {
+func (p *PaymentServiceExportUserDataArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -2444,14 +3384,14 @@ func (p *PaymentServiceRefundReviewArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PaymentServiceRefundReviewArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PaymentServiceExportUserDataArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *PaymentServiceRefundReviewArgs) FastReadField1(buf []byte) (int, error) {
+func (p *PaymentServiceExportUserDataArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewRefundReviewRequest()
+	_field := NewExportUserDataRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -2461,11 +3401,11 @@ func (p *PaymentServiceRefundReviewArgs) FastReadField1(buf []byte) (int, error)
 	return offset, nil
 }
 
-func (p *PaymentServiceRefundReviewArgs) FastWrite(buf []byte) int {
+func (p *PaymentServiceExportUserDataArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *PaymentServiceRefundReviewArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *PaymentServiceExportUserDataArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -2474,7 +3414,7 @@ func (p *PaymentServiceRefundReviewArgs) FastWriteNocopy(buf []byte, w thrift.No
 	return offset
 }
 
-func (p *PaymentServiceRefundReviewArgs) BLength() int {
+func (p *PaymentServiceExportUserDataArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -2483,21 +3423,21 @@ func (p *PaymentServiceRefundReviewArgs) BLength() int {
 	return l
 }
 
-func (p *PaymentServiceRefundReviewArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *PaymentServiceExportUserDataArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Request.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *PaymentServiceRefundReviewArgs) field1Length() int {
+func (p *PaymentServiceExportUserDataArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Request.BLength()
 	return l
 }
 
-func (p *PaymentServiceRefundReviewResult) FastRead(buf []byte) (int, error) {
+func (p *PaymentServiceExportUserDataResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -2541,14 +3481,14 @@ func (p *PaymentServiceRefundReviewResult) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PaymentServiceRefundReviewResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PaymentServiceExportUserDataResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *PaymentServiceRefundReviewResult) FastReadField0(buf []byte) (int, error) {
+func (p *PaymentServiceExportUserDataResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewRefundReviewResponse()
+	_field := NewExportUserDataResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -2558,11 +3498,11 @@ func (p *PaymentServiceRefundReviewResult) FastReadField0(buf []byte) (int, erro
 	return offset, nil
 }
 
-func (p *PaymentServiceRefundReviewResult) FastWrite(buf []byte) int {
+func (p *PaymentServiceExportUserDataResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *PaymentServiceRefundReviewResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *PaymentServiceExportUserDataResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -2571,7 +3511,7 @@ func (p *PaymentServiceRefundReviewResult) FastWriteNocopy(buf []byte, w thrift.
 	return offset
 }
 
-func (p *PaymentServiceRefundReviewResult) BLength() int {
+func (p *PaymentServiceExportUserDataResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -2580,7 +3520,7 @@ func (p *PaymentServiceRefundReviewResult) BLength() int {
 	return l
 }
 
-func (p *PaymentServiceRefundReviewResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *PaymentServiceExportUserDataResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -2589,7 +3529,7 @@ func (p *PaymentServiceRefundReviewResult) fastWriteField0(buf []byte, w thrift.
 	return offset
 }
 
-func (p *PaymentServiceRefundReviewResult) field0Length() int {
+func (p *PaymentServiceExportUserDataResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -2598,7 +3538,7 @@ func (p *PaymentServiceRefundReviewResult) field0Length() int {
 	return l
 }
 
-func (p *PaymentServiceRequestRefundArgs) FastRead(buf []byte) (int, error) {
+func (p *PaymentServiceQueryPaymentStatusArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -2642,14 +3582,14 @@ func (p *PaymentServiceRequestRefundArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PaymentServiceRequestRefundArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PaymentServiceQueryPaymentStatusArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *PaymentServiceRequestRefundArgs) FastReadField1(buf []byte) (int, error) {
+func (p *PaymentServiceQueryPaymentStatusArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewRefundRequest()
+	_field := NewPaymentStatusRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -2659,11 +3599,11 @@ func (p *PaymentServiceRequestRefundArgs) FastReadField1(buf []byte) (int, error
 	return offset, nil
 }
 
-func (p *PaymentServiceRequestRefundArgs) FastWrite(buf []byte) int {
+func (p *PaymentServiceQueryPaymentStatusArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *PaymentServiceRequestRefundArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *PaymentServiceQueryPaymentStatusArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -2672,7 +3612,7 @@ func (p *PaymentServiceRequestRefundArgs) FastWriteNocopy(buf []byte, w thrift.N
 	return offset
 }
 
-func (p *PaymentServiceRequestRefundArgs) BLength() int {
+func (p *PaymentServiceQueryPaymentStatusArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -2681,21 +3621,21 @@ func (p *PaymentServiceRequestRefundArgs) BLength() int {
 	return l
 }
 
-func (p *PaymentServiceRequestRefundArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *PaymentServiceQueryPaymentStatusArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Request.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *PaymentServiceRequestRefundArgs) field1Length() int {
+func (p *PaymentServiceQueryPaymentStatusArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Request.BLength()
 	return l
 }
 
-func (p *PaymentServiceRequestRefundResult) FastRead(buf []byte) (int, error) {
+func (p *PaymentServiceQueryPaymentStatusResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -2739,14 +3679,14 @@ func (p *PaymentServiceRequestRefundResult) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PaymentServiceRequestRefundResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PaymentServiceQueryPaymentStatusResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *PaymentServiceRequestRefundResult) FastReadField0(buf []byte) (int, error) {
+func (p *PaymentServiceQueryPaymentStatusResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewRefundResponse()
+	_field := NewPaymentStatusResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -2756,11 +3696,11 @@ func (p *PaymentServiceRequestRefundResult) FastReadField0(buf []byte) (int, err
 	return offset, nil
 }
 
-func (p *PaymentServiceRequestRefundResult) FastWrite(buf []byte) int {
+func (p *PaymentServiceQueryPaymentStatusResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *PaymentServiceRequestRefundResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *PaymentServiceQueryPaymentStatusResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -2769,7 +3709,7 @@ func (p *PaymentServiceRequestRefundResult) FastWriteNocopy(buf []byte, w thrift
 	return offset
 }
 
-func (p *PaymentServiceRequestRefundResult) BLength() int {
+func (p *PaymentServiceQueryPaymentStatusResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -2778,7 +3718,7 @@ func (p *PaymentServiceRequestRefundResult) BLength() int {
 	return l
 }
 
-func (p *PaymentServiceRequestRefundResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *PaymentServiceQueryPaymentStatusResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -2787,7 +3727,7 @@ func (p *PaymentServiceRequestRefundResult) fastWriteField0(buf []byte, w thrift
 	return offset
 }
 
-func (p *PaymentServiceRequestRefundResult) field0Length() int {
+func (p *PaymentServiceQueryPaymentStatusResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -2835,3 +3775,19 @@ func (p *PaymentServiceRequestRefundArgs) GetFirstArgument() interface{} {
 func (p *PaymentServiceRequestRefundResult) GetResult() interface{} {
 	return p.Success
 }
+
+func (p *PaymentServiceExportUserDataArgs) GetFirstArgument() interface{} {
+	return p.Request
+}
+
+func (p *PaymentServiceExportUserDataResult) GetResult() interface{} {
+	return p.Success
+}
+
+func (p *PaymentServiceQueryPaymentStatusArgs) GetFirstArgument() interface{} {
+	return p.Request
+}
+
+func (p *PaymentServiceQueryPaymentStatusResult) GetResult() interface{} {
+	return p.Success
+}
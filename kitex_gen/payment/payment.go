@@ -918,6 +918,295 @@ var fieldIDToName_PaymentCheckoutResponse = map[int16]string{
 	1: "base",
 }
 
+type ExportUserDataRequest struct {
+	Uid int64 `thrift:"uid,1,required" frugal:"1,required,i64" json:"uid"`
+}
+
+func NewExportUserDataRequest() *ExportUserDataRequest {
+	return &ExportUserDataRequest{}
+}
+
+func (p *ExportUserDataRequest) InitDefault() {
+}
+
+func (p *ExportUserDataRequest) GetUid() (v int64) {
+	return p.Uid
+}
+func (p *ExportUserDataRequest) SetUid(val int64) {
+	p.Uid = val
+}
+
+func (p *ExportUserDataRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExportUserDataRequest(%+v)", *p)
+}
+
+func (p *ExportUserDataRequest) DeepEqual(ano *ExportUserDataRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Uid) {
+		return false
+	}
+	return true
+}
+
+func (p *ExportUserDataRequest) Field1DeepEqual(src int64) bool {
+
+	if p.Uid != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_ExportUserDataRequest = map[int16]string{
+	1: "uid",
+}
+
+type ExportUserDataResponse struct {
+	Base *model.BaseResp `thrift:"base,1" frugal:"1,default,model.BaseResp" json:"base"`
+	Data string          `thrift:"data,2" frugal:"2,default,string" json:"data"`
+}
+
+func NewExportUserDataResponse() *ExportUserDataResponse {
+	return &ExportUserDataResponse{}
+}
+
+func (p *ExportUserDataResponse) InitDefault() {
+}
+
+var ExportUserDataResponse_Base_DEFAULT *model.BaseResp
+
+func (p *ExportUserDataResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return ExportUserDataResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *ExportUserDataResponse) GetData() (v string) {
+	return p.Data
+}
+func (p *ExportUserDataResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *ExportUserDataResponse) SetData(val string) {
+	p.Data = val
+}
+
+func (p *ExportUserDataResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ExportUserDataResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExportUserDataResponse(%+v)", *p)
+}
+
+func (p *ExportUserDataResponse) DeepEqual(ano *ExportUserDataResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Data) {
+		return false
+	}
+	return true
+}
+
+func (p *ExportUserDataResponse) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *ExportUserDataResponse) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.Data, src) != 0 {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_ExportUserDataResponse = map[int16]string{
+	1: "base",
+	2: "data",
+}
+
+type PaymentStatusRequest struct {
+	OrderID int64 `thrift:"orderID,1,required" frugal:"1,required,i64" json:"orderID"`
+}
+
+func NewPaymentStatusRequest() *PaymentStatusRequest {
+	return &PaymentStatusRequest{}
+}
+
+func (p *PaymentStatusRequest) InitDefault() {
+}
+
+func (p *PaymentStatusRequest) GetOrderID() (v int64) {
+	return p.OrderID
+}
+func (p *PaymentStatusRequest) SetOrderID(val int64) {
+	p.OrderID = val
+}
+
+func (p *PaymentStatusRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PaymentStatusRequest(%+v)", *p)
+}
+
+func (p *PaymentStatusRequest) DeepEqual(ano *PaymentStatusRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.OrderID) {
+		return false
+	}
+	return true
+}
+
+func (p *PaymentStatusRequest) Field1DeepEqual(src int64) bool {
+
+	if p.OrderID != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_PaymentStatusRequest = map[int16]string{
+	1: "orderID",
+}
+
+type PaymentStatusResponse struct {
+	Base    *model.BaseResp          `thrift:"base,1" frugal:"1,default,model.BaseResp" json:"base"`
+	Payment *model.PaymentStatusInfo `thrift:"payment,2,optional" frugal:"2,optional,model.PaymentStatusInfo" json:"payment,omitempty"`
+	Refund  *model.RefundStatusInfo  `thrift:"refund,3,optional" frugal:"3,optional,model.RefundStatusInfo" json:"refund,omitempty"`
+}
+
+func NewPaymentStatusResponse() *PaymentStatusResponse {
+	return &PaymentStatusResponse{}
+}
+
+func (p *PaymentStatusResponse) InitDefault() {
+}
+
+var PaymentStatusResponse_Base_DEFAULT *model.BaseResp
+
+func (p *PaymentStatusResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return PaymentStatusResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var PaymentStatusResponse_Payment_DEFAULT *model.PaymentStatusInfo
+
+func (p *PaymentStatusResponse) GetPayment() (v *model.PaymentStatusInfo) {
+	if !p.IsSetPayment() {
+		return PaymentStatusResponse_Payment_DEFAULT
+	}
+	return p.Payment
+}
+
+var PaymentStatusResponse_Refund_DEFAULT *model.RefundStatusInfo
+
+func (p *PaymentStatusResponse) GetRefund() (v *model.RefundStatusInfo) {
+	if !p.IsSetRefund() {
+		return PaymentStatusResponse_Refund_DEFAULT
+	}
+	return p.Refund
+}
+func (p *PaymentStatusResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *PaymentStatusResponse) SetPayment(val *model.PaymentStatusInfo) {
+	p.Payment = val
+}
+func (p *PaymentStatusResponse) SetRefund(val *model.RefundStatusInfo) {
+	p.Refund = val
+}
+
+func (p *PaymentStatusResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *PaymentStatusResponse) IsSetPayment() bool {
+	return p.Payment != nil
+}
+
+func (p *PaymentStatusResponse) IsSetRefund() bool {
+	return p.Refund != nil
+}
+
+func (p *PaymentStatusResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PaymentStatusResponse(%+v)", *p)
+}
+
+func (p *PaymentStatusResponse) DeepEqual(ano *PaymentStatusResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Payment) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Refund) {
+		return false
+	}
+	return true
+}
+
+func (p *PaymentStatusResponse) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *PaymentStatusResponse) Field2DeepEqual(src *model.PaymentStatusInfo) bool {
+
+	if !p.Payment.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *PaymentStatusResponse) Field3DeepEqual(src *model.RefundStatusInfo) bool {
+
+	if !p.Refund.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_PaymentStatusResponse = map[int16]string{
+	1: "base",
+	2: "payment",
+	3: "refund",
+}
+
 type PaymentService interface {
 	ProcessPayment(ctx context.Context, request *PaymentRequest) (r *PaymentResponse, err error)
 
@@ -928,6 +1217,10 @@ type PaymentService interface {
 	RefundReview(ctx context.Context, request *RefundReviewRequest) (r *RefundReviewResponse, err error)
 
 	RequestRefund(ctx context.Context, request *RefundRequest) (r *RefundResponse, err error)
+
+	ExportUserData(ctx context.Context, request *ExportUserDataRequest) (r *ExportUserDataResponse, err error)
+
+	QueryPaymentStatus(ctx context.Context, request *PaymentStatusRequest) (r *PaymentStatusResponse, err error)
 }
 
 type PaymentServiceProcessPaymentArgs struct {
@@ -1509,3 +1802,235 @@ func (p *PaymentServiceRequestRefundResult) Field0DeepEqual(src *RefundResponse)
 var fieldIDToName_PaymentServiceRequestRefundResult = map[int16]string{
 	0: "success",
 }
+
+type PaymentServiceExportUserDataArgs struct {
+	Request *ExportUserDataRequest `thrift:"request,1" frugal:"1,default,ExportUserDataRequest" json:"request"`
+}
+
+func NewPaymentServiceExportUserDataArgs() *PaymentServiceExportUserDataArgs {
+	return &PaymentServiceExportUserDataArgs{}
+}
+
+func (p *PaymentServiceExportUserDataArgs) InitDefault() {
+}
+
+var PaymentServiceExportUserDataArgs_Request_DEFAULT *ExportUserDataRequest
+
+func (p *PaymentServiceExportUserDataArgs) GetRequest() (v *ExportUserDataRequest) {
+	if !p.IsSetRequest() {
+		return PaymentServiceExportUserDataArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+func (p *PaymentServiceExportUserDataArgs) SetRequest(val *ExportUserDataRequest) {
+	p.Request = val
+}
+
+func (p *PaymentServiceExportUserDataArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *PaymentServiceExportUserDataArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PaymentServiceExportUserDataArgs(%+v)", *p)
+}
+
+func (p *PaymentServiceExportUserDataArgs) DeepEqual(ano *PaymentServiceExportUserDataArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Request) {
+		return false
+	}
+	return true
+}
+
+func (p *PaymentServiceExportUserDataArgs) Field1DeepEqual(src *ExportUserDataRequest) bool {
+
+	if !p.Request.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_PaymentServiceExportUserDataArgs = map[int16]string{
+	1: "request",
+}
+
+type PaymentServiceExportUserDataResult struct {
+	Success *ExportUserDataResponse `thrift:"success,0,optional" frugal:"0,optional,ExportUserDataResponse" json:"success,omitempty"`
+}
+
+func NewPaymentServiceExportUserDataResult() *PaymentServiceExportUserDataResult {
+	return &PaymentServiceExportUserDataResult{}
+}
+
+func (p *PaymentServiceExportUserDataResult) InitDefault() {
+}
+
+var PaymentServiceExportUserDataResult_Success_DEFAULT *ExportUserDataResponse
+
+func (p *PaymentServiceExportUserDataResult) GetSuccess() (v *ExportUserDataResponse) {
+	if !p.IsSetSuccess() {
+		return PaymentServiceExportUserDataResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *PaymentServiceExportUserDataResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ExportUserDataResponse)
+}
+
+func (p *PaymentServiceExportUserDataResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PaymentServiceExportUserDataResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PaymentServiceExportUserDataResult(%+v)", *p)
+}
+
+func (p *PaymentServiceExportUserDataResult) DeepEqual(ano *PaymentServiceExportUserDataResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *PaymentServiceExportUserDataResult) Field0DeepEqual(src *ExportUserDataResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_PaymentServiceExportUserDataResult = map[int16]string{
+	0: "success",
+}
+
+type PaymentServiceQueryPaymentStatusArgs struct {
+	Request *PaymentStatusRequest `thrift:"request,1" frugal:"1,default,PaymentStatusRequest" json:"request"`
+}
+
+func NewPaymentServiceQueryPaymentStatusArgs() *PaymentServiceQueryPaymentStatusArgs {
+	return &PaymentServiceQueryPaymentStatusArgs{}
+}
+
+func (p *PaymentServiceQueryPaymentStatusArgs) InitDefault() {
+}
+
+var PaymentServiceQueryPaymentStatusArgs_Request_DEFAULT *PaymentStatusRequest
+
+func (p *PaymentServiceQueryPaymentStatusArgs) GetRequest() (v *PaymentStatusRequest) {
+	if !p.IsSetRequest() {
+		return PaymentServiceQueryPaymentStatusArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+func (p *PaymentServiceQueryPaymentStatusArgs) SetRequest(val *PaymentStatusRequest) {
+	p.Request = val
+}
+
+func (p *PaymentServiceQueryPaymentStatusArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *PaymentServiceQueryPaymentStatusArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PaymentServiceQueryPaymentStatusArgs(%+v)", *p)
+}
+
+func (p *PaymentServiceQueryPaymentStatusArgs) DeepEqual(ano *PaymentServiceQueryPaymentStatusArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Request) {
+		return false
+	}
+	return true
+}
+
+func (p *PaymentServiceQueryPaymentStatusArgs) Field1DeepEqual(src *PaymentStatusRequest) bool {
+
+	if !p.Request.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_PaymentServiceQueryPaymentStatusArgs = map[int16]string{
+	1: "request",
+}
+
+type PaymentServiceQueryPaymentStatusResult struct {
+	Success *PaymentStatusResponse `thrift:"success,0,optional" frugal:"0,optional,PaymentStatusResponse" json:"success,omitempty"`
+}
+
+func NewPaymentServiceQueryPaymentStatusResult() *PaymentServiceQueryPaymentStatusResult {
+	return &PaymentServiceQueryPaymentStatusResult{}
+}
+
+func (p *PaymentServiceQueryPaymentStatusResult) InitDefault() {
+}
+
+var PaymentServiceQueryPaymentStatusResult_Success_DEFAULT *PaymentStatusResponse
+
+func (p *PaymentServiceQueryPaymentStatusResult) GetSuccess() (v *PaymentStatusResponse) {
+	if !p.IsSetSuccess() {
+		return PaymentServiceQueryPaymentStatusResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *PaymentServiceQueryPaymentStatusResult) SetSuccess(x interface{}) {
+	p.Success = x.(*PaymentStatusResponse)
+}
+
+func (p *PaymentServiceQueryPaymentStatusResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PaymentServiceQueryPaymentStatusResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PaymentServiceQueryPaymentStatusResult(%+v)", *p)
+}
+
+func (p *PaymentServiceQueryPaymentStatusResult) DeepEqual(ano *PaymentServiceQueryPaymentStatusResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *PaymentServiceQueryPaymentStatusResult) Field0DeepEqual(src *PaymentStatusResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_PaymentServiceQueryPaymentStatusResult = map[int16]string{
+	0: "success",
+}
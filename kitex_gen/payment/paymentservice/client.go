@@ -34,6 +34,8 @@ type Client interface {
 	RequestPaymentCheckout(ctx context.Context, request *payment.PaymentCheckoutRequest, callOptions ...callopt.Option) (r *payment.PaymentCheckoutResponse, err error)
 	RefundReview(ctx context.Context, request *payment.RefundReviewRequest, callOptions ...callopt.Option) (r *payment.RefundReviewResponse, err error)
 	RequestRefund(ctx context.Context, request *payment.RefundRequest, callOptions ...callopt.Option) (r *payment.RefundResponse, err error)
+	ExportUserData(ctx context.Context, request *payment.ExportUserDataRequest, callOptions ...callopt.Option) (r *payment.ExportUserDataResponse, err error)
+	QueryPaymentStatus(ctx context.Context, request *payment.PaymentStatusRequest, callOptions ...callopt.Option) (r *payment.PaymentStatusResponse, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -89,3 +91,13 @@ func (p *kPaymentServiceClient) RequestRefund(ctx context.Context, request *paym
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.RequestRefund(ctx, request)
 }
+
+func (p *kPaymentServiceClient) ExportUserData(ctx context.Context, request *payment.ExportUserDataRequest, callOptions ...callopt.Option) (r *payment.ExportUserDataResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ExportUserData(ctx, request)
+}
+
+func (p *kPaymentServiceClient) QueryPaymentStatus(ctx context.Context, request *payment.PaymentStatusRequest, callOptions ...callopt.Option) (r *payment.PaymentStatusResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.QueryPaymentStatus(ctx, request)
+}
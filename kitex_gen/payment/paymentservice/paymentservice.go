@@ -66,6 +66,20 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"ExportUserData": kitex.NewMethodInfo(
+		exportUserDataHandler,
+		newPaymentServiceExportUserDataArgs,
+		newPaymentServiceExportUserDataResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"QueryPaymentStatus": kitex.NewMethodInfo(
+		queryPaymentStatusHandler,
+		newPaymentServiceQueryPaymentStatusArgs,
+		newPaymentServiceQueryPaymentStatusResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 }
 
 var (
@@ -222,6 +236,42 @@ func newPaymentServiceRequestRefundResult() interface{} {
 	return payment.NewPaymentServiceRequestRefundResult()
 }
 
+func exportUserDataHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*payment.PaymentServiceExportUserDataArgs)
+	realResult := result.(*payment.PaymentServiceExportUserDataResult)
+	success, err := handler.(payment.PaymentService).ExportUserData(ctx, realArg.Request)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newPaymentServiceExportUserDataArgs() interface{} {
+	return payment.NewPaymentServiceExportUserDataArgs()
+}
+
+func newPaymentServiceExportUserDataResult() interface{} {
+	return payment.NewPaymentServiceExportUserDataResult()
+}
+
+func queryPaymentStatusHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*payment.PaymentServiceQueryPaymentStatusArgs)
+	realResult := result.(*payment.PaymentServiceQueryPaymentStatusResult)
+	success, err := handler.(payment.PaymentService).QueryPaymentStatus(ctx, realArg.Request)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newPaymentServiceQueryPaymentStatusArgs() interface{} {
+	return payment.NewPaymentServiceQueryPaymentStatusArgs()
+}
+
+func newPaymentServiceQueryPaymentStatusResult() interface{} {
+	return payment.NewPaymentServiceQueryPaymentStatusResult()
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -281,3 +331,23 @@ func (p *kClient) RequestRefund(ctx context.Context, request *payment.RefundRequ
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) ExportUserData(ctx context.Context, request *payment.ExportUserDataRequest) (r *payment.ExportUserDataResponse, err error) {
+	var _args payment.PaymentServiceExportUserDataArgs
+	_args.Request = request
+	var _result payment.PaymentServiceExportUserDataResult
+	if err = p.c.Call(ctx, "ExportUserData", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) QueryPaymentStatus(ctx context.Context, request *payment.PaymentStatusRequest) (r *payment.PaymentStatusResponse, err error) {
+	var _args payment.PaymentServiceQueryPaymentStatusArgs
+	_args.Request = request
+	var _result payment.PaymentServiceQueryPaymentStatusResult
+	if err = p.c.Call(ctx, "QueryPaymentStatus", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
@@ -210,9 +210,12 @@ var fieldIDToName_RegisterResponse = map[int16]string{
 }
 
 type LoginRequest struct {
-	Username        string `thrift:"username,1" frugal:"1,default,string" json:"username"`
-	Password        string `thrift:"password,2" frugal:"2,default,string" json:"password"`
-	ConfirmPassword string `thrift:"confirm_password,3" frugal:"3,default,string" json:"confirm_password"`
+	Username        string  `thrift:"username,1" frugal:"1,default,string" json:"username"`
+	Password        string  `thrift:"password,2" frugal:"2,default,string" json:"password"`
+	ConfirmPassword string  `thrift:"confirm_password,3" frugal:"3,default,string" json:"confirm_password"`
+	Device          *string `thrift:"device,4,optional" frugal:"4,optional,string" json:"device,omitempty"`
+	Ip              *string `thrift:"ip,5,optional" frugal:"5,optional,string" json:"ip,omitempty"`
+	TotpCode        *string `thrift:"totp_code,6,optional" frugal:"6,optional,string" json:"totp_code,omitempty"`
 }
 
 func NewLoginRequest() *LoginRequest {
@@ -233,6 +236,33 @@ func (p *LoginRequest) GetPassword() (v string) {
 func (p *LoginRequest) GetConfirmPassword() (v string) {
 	return p.ConfirmPassword
 }
+
+var LoginRequest_Device_DEFAULT string
+
+func (p *LoginRequest) GetDevice() (v string) {
+	if !p.IsSetDevice() {
+		return LoginRequest_Device_DEFAULT
+	}
+	return *p.Device
+}
+
+var LoginRequest_Ip_DEFAULT string
+
+func (p *LoginRequest) GetIp() (v string) {
+	if !p.IsSetIp() {
+		return LoginRequest_Ip_DEFAULT
+	}
+	return *p.Ip
+}
+
+var LoginRequest_TotpCode_DEFAULT string
+
+func (p *LoginRequest) GetTotpCode() (v string) {
+	if !p.IsSetTotpCode() {
+		return LoginRequest_TotpCode_DEFAULT
+	}
+	return *p.TotpCode
+}
 func (p *LoginRequest) SetUsername(val string) {
 	p.Username = val
 }
@@ -242,6 +272,27 @@ func (p *LoginRequest) SetPassword(val string) {
 func (p *LoginRequest) SetConfirmPassword(val string) {
 	p.ConfirmPassword = val
 }
+func (p *LoginRequest) SetDevice(val *string) {
+	p.Device = val
+}
+func (p *LoginRequest) SetIp(val *string) {
+	p.Ip = val
+}
+func (p *LoginRequest) SetTotpCode(val *string) {
+	p.TotpCode = val
+}
+
+func (p *LoginRequest) IsSetDevice() bool {
+	return p.Device != nil
+}
+
+func (p *LoginRequest) IsSetIp() bool {
+	return p.Ip != nil
+}
+
+func (p *LoginRequest) IsSetTotpCode() bool {
+	return p.TotpCode != nil
+}
 
 func (p *LoginRequest) String() string {
 	if p == nil {
@@ -265,6 +316,15 @@ func (p *LoginRequest) DeepEqual(ano *LoginRequest) bool {
 	if !p.Field3DeepEqual(ano.ConfirmPassword) {
 		return false
 	}
+	if !p.Field4DeepEqual(ano.Device) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.Ip) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.TotpCode) {
+		return false
+	}
 	return true
 }
 
@@ -289,16 +349,56 @@ func (p *LoginRequest) Field3DeepEqual(src string) bool {
 	}
 	return true
 }
+func (p *LoginRequest) Field4DeepEqual(src *string) bool {
+
+	if p.Device == src {
+		return true
+	} else if p.Device == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Device, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *LoginRequest) Field5DeepEqual(src *string) bool {
+
+	if p.Ip == src {
+		return true
+	} else if p.Ip == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Ip, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *LoginRequest) Field6DeepEqual(src *string) bool {
+
+	if p.TotpCode == src {
+		return true
+	} else if p.TotpCode == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.TotpCode, *src) != 0 {
+		return false
+	}
+	return true
+}
 
 var fieldIDToName_LoginRequest = map[int16]string{
 	1: "username",
 	2: "password",
 	3: "confirm_password",
+	4: "device",
+	5: "ip",
+	6: "totp_code",
 }
 
 type LoginResponse struct {
-	Base *model.BaseResp `thrift:"base,1" frugal:"1,default,model.BaseResp" json:"base"`
-	User *model.UserInfo `thrift:"user,2" frugal:"2,default,model.UserInfo" json:"user"`
+	Base  *model.BaseResp  `thrift:"base,1" frugal:"1,default,model.BaseResp" json:"base"`
+	User  *model.UserInfo  `thrift:"user,2" frugal:"2,default,model.UserInfo" json:"user"`
+	Token *model.TokenInfo `thrift:"token,3" frugal:"3,default,model.TokenInfo" json:"token"`
 }
 
 func NewLoginResponse() *LoginResponse {
@@ -325,12 +425,24 @@ func (p *LoginResponse) GetUser() (v *model.UserInfo) {
 	}
 	return p.User
 }
+
+var LoginResponse_Token_DEFAULT *model.TokenInfo
+
+func (p *LoginResponse) GetToken() (v *model.TokenInfo) {
+	if !p.IsSetToken() {
+		return LoginResponse_Token_DEFAULT
+	}
+	return p.Token
+}
 func (p *LoginResponse) SetBase(val *model.BaseResp) {
 	p.Base = val
 }
 func (p *LoginResponse) SetUser(val *model.UserInfo) {
 	p.User = val
 }
+func (p *LoginResponse) SetToken(val *model.TokenInfo) {
+	p.Token = val
+}
 
 func (p *LoginResponse) IsSetBase() bool {
 	return p.Base != nil
@@ -340,6 +452,10 @@ func (p *LoginResponse) IsSetUser() bool {
 	return p.User != nil
 }
 
+func (p *LoginResponse) IsSetToken() bool {
+	return p.Token != nil
+}
+
 func (p *LoginResponse) String() string {
 	if p == nil {
 		return "<nil>"
@@ -359,6 +475,9 @@ func (p *LoginResponse) DeepEqual(ano *LoginResponse) bool {
 	if !p.Field2DeepEqual(ano.User) {
 		return false
 	}
+	if !p.Field3DeepEqual(ano.Token) {
+		return false
+	}
 	return true
 }
 
@@ -376,10 +495,153 @@ func (p *LoginResponse) Field2DeepEqual(src *model.UserInfo) bool {
 	}
 	return true
 }
+func (p *LoginResponse) Field3DeepEqual(src *model.TokenInfo) bool {
+
+	if !p.Token.DeepEqual(src) {
+		return false
+	}
+	return true
+}
 
 var fieldIDToName_LoginResponse = map[int16]string{
 	1: "base",
 	2: "user",
+	3: "token",
+}
+
+type RefreshTokenReq struct {
+	RefreshToken string `thrift:"refreshToken,1,required" frugal:"1,required,string" json:"refreshToken"`
+}
+
+func NewRefreshTokenReq() *RefreshTokenReq {
+	return &RefreshTokenReq{}
+}
+
+func (p *RefreshTokenReq) InitDefault() {
+}
+
+func (p *RefreshTokenReq) GetRefreshToken() (v string) {
+	return p.RefreshToken
+}
+func (p *RefreshTokenReq) SetRefreshToken(val string) {
+	p.RefreshToken = val
+}
+
+func (p *RefreshTokenReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RefreshTokenReq(%+v)", *p)
+}
+
+func (p *RefreshTokenReq) DeepEqual(ano *RefreshTokenReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.RefreshToken) {
+		return false
+	}
+	return true
+}
+
+func (p *RefreshTokenReq) Field1DeepEqual(src string) bool {
+
+	if strings.Compare(p.RefreshToken, src) != 0 {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_RefreshTokenReq = map[int16]string{
+	1: "refreshToken",
+}
+
+type RefreshTokenResp struct {
+	Base  *model.BaseResp  `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+	Token *model.TokenInfo `thrift:"token,2,required" frugal:"2,required,model.TokenInfo" json:"token"`
+}
+
+func NewRefreshTokenResp() *RefreshTokenResp {
+	return &RefreshTokenResp{}
+}
+
+func (p *RefreshTokenResp) InitDefault() {
+}
+
+var RefreshTokenResp_Base_DEFAULT *model.BaseResp
+
+func (p *RefreshTokenResp) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return RefreshTokenResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var RefreshTokenResp_Token_DEFAULT *model.TokenInfo
+
+func (p *RefreshTokenResp) GetToken() (v *model.TokenInfo) {
+	if !p.IsSetToken() {
+		return RefreshTokenResp_Token_DEFAULT
+	}
+	return p.Token
+}
+func (p *RefreshTokenResp) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *RefreshTokenResp) SetToken(val *model.TokenInfo) {
+	p.Token = val
+}
+
+func (p *RefreshTokenResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *RefreshTokenResp) IsSetToken() bool {
+	return p.Token != nil
+}
+
+func (p *RefreshTokenResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RefreshTokenResp(%+v)", *p)
+}
+
+func (p *RefreshTokenResp) DeepEqual(ano *RefreshTokenResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Token) {
+		return false
+	}
+	return true
+}
+
+func (p *RefreshTokenResp) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *RefreshTokenResp) Field2DeepEqual(src *model.TokenInfo) bool {
+
+	if !p.Token.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_RefreshTokenResp = map[int16]string{
+	1: "base",
+	2: "token",
 }
 
 type GetAddressRequest struct {
@@ -518,9 +780,12 @@ var fieldIDToName_GetAddressResponse = map[int16]string{
 }
 
 type AddAddressRequest struct {
-	Province string `thrift:"province,1,required" frugal:"1,required,string" json:"province"`
-	City     string `thrift:"city,2,required" frugal:"2,required,string" json:"city"`
-	Detail   string `thrift:"detail,3,required" frugal:"3,required,string" json:"detail"`
+	Province      string `thrift:"province,1,required" frugal:"1,required,string" json:"province"`
+	City          string `thrift:"city,2,required" frugal:"2,required,string" json:"city"`
+	Detail        string `thrift:"detail,3,required" frugal:"3,required,string" json:"detail"`
+	RecipientName string `thrift:"recipientName,4,required" frugal:"4,required,string" json:"recipientName"`
+	Phone         string `thrift:"phone,5,required" frugal:"5,required,string" json:"phone"`
+	IsDefault     *bool  `thrift:"isDefault,6,optional" frugal:"6,optional,bool" json:"isDefault,omitempty"`
 }
 
 func NewAddAddressRequest() *AddAddressRequest {
@@ -541,6 +806,23 @@ func (p *AddAddressRequest) GetCity() (v string) {
 func (p *AddAddressRequest) GetDetail() (v string) {
 	return p.Detail
 }
+
+func (p *AddAddressRequest) GetRecipientName() (v string) {
+	return p.RecipientName
+}
+
+func (p *AddAddressRequest) GetPhone() (v string) {
+	return p.Phone
+}
+
+var AddAddressRequest_IsDefault_DEFAULT bool
+
+func (p *AddAddressRequest) GetIsDefault() (v bool) {
+	if !p.IsSetIsDefault() {
+		return AddAddressRequest_IsDefault_DEFAULT
+	}
+	return *p.IsDefault
+}
 func (p *AddAddressRequest) SetProvince(val string) {
 	p.Province = val
 }
@@ -550,6 +832,19 @@ func (p *AddAddressRequest) SetCity(val string) {
 func (p *AddAddressRequest) SetDetail(val string) {
 	p.Detail = val
 }
+func (p *AddAddressRequest) SetRecipientName(val string) {
+	p.RecipientName = val
+}
+func (p *AddAddressRequest) SetPhone(val string) {
+	p.Phone = val
+}
+func (p *AddAddressRequest) SetIsDefault(val *bool) {
+	p.IsDefault = val
+}
+
+func (p *AddAddressRequest) IsSetIsDefault() bool {
+	return p.IsDefault != nil
+}
 
 func (p *AddAddressRequest) String() string {
 	if p == nil {
@@ -573,6 +868,15 @@ func (p *AddAddressRequest) DeepEqual(ano *AddAddressRequest) bool {
 	if !p.Field3DeepEqual(ano.Detail) {
 		return false
 	}
+	if !p.Field4DeepEqual(ano.RecipientName) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.Phone) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.IsDefault) {
+		return false
+	}
 	return true
 }
 
@@ -597,11 +901,40 @@ func (p *AddAddressRequest) Field3DeepEqual(src string) bool {
 	}
 	return true
 }
+func (p *AddAddressRequest) Field4DeepEqual(src string) bool {
+
+	if strings.Compare(p.RecipientName, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *AddAddressRequest) Field5DeepEqual(src string) bool {
+
+	if strings.Compare(p.Phone, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *AddAddressRequest) Field6DeepEqual(src *bool) bool {
+
+	if p.IsDefault == src {
+		return true
+	} else if p.IsDefault == nil || src == nil {
+		return false
+	}
+	if *p.IsDefault != *src {
+		return false
+	}
+	return true
+}
 
 var fieldIDToName_AddAddressRequest = map[int16]string{
 	1: "province",
 	2: "city",
 	3: "detail",
+	4: "recipientName",
+	5: "phone",
+	6: "isDefault",
 }
 
 type AddAddressResponse struct {
@@ -681,90 +1014,77 @@ var fieldIDToName_AddAddressResponse = map[int16]string{
 	2: "addressID",
 }
 
-type BanUserReq struct {
-	Uid int64 `thrift:"uid,1,required" frugal:"1,required,i64" json:"uid"`
-}
-
-func NewBanUserReq() *BanUserReq {
-	return &BanUserReq{}
+type ListAddressReq struct {
 }
 
-func (p *BanUserReq) InitDefault() {
+func NewListAddressReq() *ListAddressReq {
+	return &ListAddressReq{}
 }
 
-func (p *BanUserReq) GetUid() (v int64) {
-	return p.Uid
-}
-func (p *BanUserReq) SetUid(val int64) {
-	p.Uid = val
+func (p *ListAddressReq) InitDefault() {
 }
 
-func (p *BanUserReq) String() string {
+func (p *ListAddressReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("BanUserReq(%+v)", *p)
+	return fmt.Sprintf("ListAddressReq(%+v)", *p)
 }
 
-func (p *BanUserReq) DeepEqual(ano *BanUserReq) bool {
+func (p *ListAddressReq) DeepEqual(ano *ListAddressReq) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Uid) {
-		return false
-	}
-	return true
-}
-
-func (p *BanUserReq) Field1DeepEqual(src int64) bool {
-
-	if p.Uid != src {
-		return false
-	}
 	return true
 }
 
-var fieldIDToName_BanUserReq = map[int16]string{
-	1: "uid",
-}
+var fieldIDToName_ListAddressReq = map[int16]string{}
 
-type BanUserResp struct {
-	Base *model.BaseResp `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+type ListAddressResp struct {
+	Base      *model.BaseResp      `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+	Addresses []*model.AddressInfo `thrift:"addresses,2,required" frugal:"2,required,list<model.AddressInfo>" json:"addresses"`
 }
 
-func NewBanUserResp() *BanUserResp {
-	return &BanUserResp{}
+func NewListAddressResp() *ListAddressResp {
+	return &ListAddressResp{}
 }
 
-func (p *BanUserResp) InitDefault() {
+func (p *ListAddressResp) InitDefault() {
 }
 
-var BanUserResp_Base_DEFAULT *model.BaseResp
+var ListAddressResp_Base_DEFAULT *model.BaseResp
 
-func (p *BanUserResp) GetBase() (v *model.BaseResp) {
+func (p *ListAddressResp) GetBase() (v *model.BaseResp) {
 	if !p.IsSetBase() {
-		return BanUserResp_Base_DEFAULT
+		return ListAddressResp_Base_DEFAULT
 	}
 	return p.Base
 }
-func (p *BanUserResp) SetBase(val *model.BaseResp) {
+
+func (p *ListAddressResp) GetAddresses() (v []*model.AddressInfo) {
+	return p.Addresses
+}
+func (p *ListAddressResp) SetBase(val *model.BaseResp) {
 	p.Base = val
 }
+func (p *ListAddressResp) SetAddresses(val []*model.AddressInfo) {
+	p.Addresses = val
+}
 
-func (p *BanUserResp) IsSetBase() bool {
+func (p *ListAddressResp) IsSetBase() bool {
 	return p.Base != nil
 }
 
-func (p *BanUserResp) String() string {
+func (p *ListAddressResp) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("BanUserResp(%+v)", *p)
+	return fmt.Sprintf("ListAddressResp(%+v)", *p)
 }
 
-func (p *BanUserResp) DeepEqual(ano *BanUserResp) bool {
+func (p *ListAddressResp) DeepEqual(ano *ListAddressResp) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -773,191 +1093,287 @@ func (p *BanUserResp) DeepEqual(ano *BanUserResp) bool {
 	if !p.Field1DeepEqual(ano.Base) {
 		return false
 	}
+	if !p.Field2DeepEqual(ano.Addresses) {
+		return false
+	}
 	return true
 }
 
-func (p *BanUserResp) Field1DeepEqual(src *model.BaseResp) bool {
+func (p *ListAddressResp) Field1DeepEqual(src *model.BaseResp) bool {
 
 	if !p.Base.DeepEqual(src) {
 		return false
 	}
 	return true
 }
+func (p *ListAddressResp) Field2DeepEqual(src []*model.AddressInfo) bool {
 
-var fieldIDToName_BanUserResp = map[int16]string{
-	1: "base",
+	if len(p.Addresses) != len(src) {
+		return false
+	}
+	for i, v := range p.Addresses {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
 }
 
-type LiftBanUserReq struct {
-	Uid int64 `thrift:"uid,1,required" frugal:"1,required,i64" json:"uid"`
+var fieldIDToName_ListAddressResp = map[int16]string{
+	1: "base",
+	2: "addresses",
 }
 
-func NewLiftBanUserReq() *LiftBanUserReq {
-	return &LiftBanUserReq{}
+type UpdateAddressReq struct {
+	AddressID     int64   `thrift:"addressID,1,required" frugal:"1,required,i64" json:"addressID"`
+	Province      *string `thrift:"province,2,optional" frugal:"2,optional,string" json:"province,omitempty"`
+	City          *string `thrift:"city,3,optional" frugal:"3,optional,string" json:"city,omitempty"`
+	Detail        *string `thrift:"detail,4,optional" frugal:"4,optional,string" json:"detail,omitempty"`
+	RecipientName *string `thrift:"recipientName,5,optional" frugal:"5,optional,string" json:"recipientName,omitempty"`
+	Phone         *string `thrift:"phone,6,optional" frugal:"6,optional,string" json:"phone,omitempty"`
 }
 
-func (p *LiftBanUserReq) InitDefault() {
+func NewUpdateAddressReq() *UpdateAddressReq {
+	return &UpdateAddressReq{}
 }
 
-func (p *LiftBanUserReq) GetUid() (v int64) {
-	return p.Uid
+func (p *UpdateAddressReq) InitDefault() {
 }
-func (p *LiftBanUserReq) SetUid(val int64) {
-	p.Uid = val
+
+func (p *UpdateAddressReq) GetAddressID() (v int64) {
+	return p.AddressID
 }
 
-func (p *LiftBanUserReq) String() string {
-	if p == nil {
-		return "<nil>"
+var UpdateAddressReq_Province_DEFAULT string
+
+func (p *UpdateAddressReq) GetProvince() (v string) {
+	if !p.IsSetProvince() {
+		return UpdateAddressReq_Province_DEFAULT
 	}
-	return fmt.Sprintf("LiftBanUserReq(%+v)", *p)
+	return *p.Province
 }
 
-func (p *LiftBanUserReq) DeepEqual(ano *LiftBanUserReq) bool {
-	if p == ano {
-		return true
-	} else if p == nil || ano == nil {
-		return false
-	}
-	if !p.Field1DeepEqual(ano.Uid) {
-		return false
+var UpdateAddressReq_City_DEFAULT string
+
+func (p *UpdateAddressReq) GetCity() (v string) {
+	if !p.IsSetCity() {
+		return UpdateAddressReq_City_DEFAULT
 	}
-	return true
+	return *p.City
 }
 
-func (p *LiftBanUserReq) Field1DeepEqual(src int64) bool {
+var UpdateAddressReq_Detail_DEFAULT string
 
-	if p.Uid != src {
-		return false
+func (p *UpdateAddressReq) GetDetail() (v string) {
+	if !p.IsSetDetail() {
+		return UpdateAddressReq_Detail_DEFAULT
 	}
-	return true
+	return *p.Detail
 }
 
-var fieldIDToName_LiftBanUserReq = map[int16]string{
-	1: "uid",
-}
+var UpdateAddressReq_RecipientName_DEFAULT string
 
-type LiftBanUserResp struct {
-	Base *model.BaseResp `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+func (p *UpdateAddressReq) GetRecipientName() (v string) {
+	if !p.IsSetRecipientName() {
+		return UpdateAddressReq_RecipientName_DEFAULT
+	}
+	return *p.RecipientName
 }
 
-func NewLiftBanUserResp() *LiftBanUserResp {
-	return &LiftBanUserResp{}
+var UpdateAddressReq_Phone_DEFAULT string
+
+func (p *UpdateAddressReq) GetPhone() (v string) {
+	if !p.IsSetPhone() {
+		return UpdateAddressReq_Phone_DEFAULT
+	}
+	return *p.Phone
+}
+func (p *UpdateAddressReq) SetAddressID(val int64) {
+	p.AddressID = val
+}
+func (p *UpdateAddressReq) SetProvince(val *string) {
+	p.Province = val
+}
+func (p *UpdateAddressReq) SetCity(val *string) {
+	p.City = val
+}
+func (p *UpdateAddressReq) SetDetail(val *string) {
+	p.Detail = val
+}
+func (p *UpdateAddressReq) SetRecipientName(val *string) {
+	p.RecipientName = val
+}
+func (p *UpdateAddressReq) SetPhone(val *string) {
+	p.Phone = val
 }
 
-func (p *LiftBanUserResp) InitDefault() {
+func (p *UpdateAddressReq) IsSetProvince() bool {
+	return p.Province != nil
 }
 
-var LiftBanUserResp_Base_DEFAULT *model.BaseResp
+func (p *UpdateAddressReq) IsSetCity() bool {
+	return p.City != nil
+}
 
-func (p *LiftBanUserResp) GetBase() (v *model.BaseResp) {
-	if !p.IsSetBase() {
-		return LiftBanUserResp_Base_DEFAULT
-	}
-	return p.Base
+func (p *UpdateAddressReq) IsSetDetail() bool {
+	return p.Detail != nil
 }
-func (p *LiftBanUserResp) SetBase(val *model.BaseResp) {
-	p.Base = val
+
+func (p *UpdateAddressReq) IsSetRecipientName() bool {
+	return p.RecipientName != nil
 }
 
-func (p *LiftBanUserResp) IsSetBase() bool {
-	return p.Base != nil
+func (p *UpdateAddressReq) IsSetPhone() bool {
+	return p.Phone != nil
 }
 
-func (p *LiftBanUserResp) String() string {
+func (p *UpdateAddressReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("LiftBanUserResp(%+v)", *p)
+	return fmt.Sprintf("UpdateAddressReq(%+v)", *p)
 }
 
-func (p *LiftBanUserResp) DeepEqual(ano *LiftBanUserResp) bool {
+func (p *UpdateAddressReq) DeepEqual(ano *UpdateAddressReq) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Base) {
+	if !p.Field1DeepEqual(ano.AddressID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Province) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.City) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Detail) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.RecipientName) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.Phone) {
 		return false
 	}
 	return true
 }
 
-func (p *LiftBanUserResp) Field1DeepEqual(src *model.BaseResp) bool {
+func (p *UpdateAddressReq) Field1DeepEqual(src int64) bool {
 
-	if !p.Base.DeepEqual(src) {
+	if p.AddressID != src {
 		return false
 	}
 	return true
 }
+func (p *UpdateAddressReq) Field2DeepEqual(src *string) bool {
 
-var fieldIDToName_LiftBanUserResp = map[int16]string{
-	1: "base",
-}
-
-type LogoutReq struct {
+	if p.Province == src {
+		return true
+	} else if p.Province == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Province, *src) != 0 {
+		return false
+	}
+	return true
 }
+func (p *UpdateAddressReq) Field3DeepEqual(src *string) bool {
 
-func NewLogoutReq() *LogoutReq {
-	return &LogoutReq{}
+	if p.City == src {
+		return true
+	} else if p.City == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.City, *src) != 0 {
+		return false
+	}
+	return true
 }
+func (p *UpdateAddressReq) Field4DeepEqual(src *string) bool {
 
-func (p *LogoutReq) InitDefault() {
+	if p.Detail == src {
+		return true
+	} else if p.Detail == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Detail, *src) != 0 {
+		return false
+	}
+	return true
 }
+func (p *UpdateAddressReq) Field5DeepEqual(src *string) bool {
 
-func (p *LogoutReq) String() string {
-	if p == nil {
-		return "<nil>"
+	if p.RecipientName == src {
+		return true
+	} else if p.RecipientName == nil || src == nil {
+		return false
 	}
-	return fmt.Sprintf("LogoutReq(%+v)", *p)
+	if strings.Compare(*p.RecipientName, *src) != 0 {
+		return false
+	}
+	return true
 }
+func (p *UpdateAddressReq) Field6DeepEqual(src *string) bool {
 
-func (p *LogoutReq) DeepEqual(ano *LogoutReq) bool {
-	if p == ano {
+	if p.Phone == src {
 		return true
-	} else if p == nil || ano == nil {
+	} else if p.Phone == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Phone, *src) != 0 {
 		return false
 	}
 	return true
 }
 
-var fieldIDToName_LogoutReq = map[int16]string{}
+var fieldIDToName_UpdateAddressReq = map[int16]string{
+	1: "addressID",
+	2: "province",
+	3: "city",
+	4: "detail",
+	5: "recipientName",
+	6: "phone",
+}
 
-type LogoutResp struct {
+type UpdateAddressResp struct {
 	Base *model.BaseResp `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
 }
 
-func NewLogoutResp() *LogoutResp {
-	return &LogoutResp{}
+func NewUpdateAddressResp() *UpdateAddressResp {
+	return &UpdateAddressResp{}
 }
 
-func (p *LogoutResp) InitDefault() {
+func (p *UpdateAddressResp) InitDefault() {
 }
 
-var LogoutResp_Base_DEFAULT *model.BaseResp
+var UpdateAddressResp_Base_DEFAULT *model.BaseResp
 
-func (p *LogoutResp) GetBase() (v *model.BaseResp) {
+func (p *UpdateAddressResp) GetBase() (v *model.BaseResp) {
 	if !p.IsSetBase() {
-		return LogoutResp_Base_DEFAULT
+		return UpdateAddressResp_Base_DEFAULT
 	}
 	return p.Base
 }
-func (p *LogoutResp) SetBase(val *model.BaseResp) {
+func (p *UpdateAddressResp) SetBase(val *model.BaseResp) {
 	p.Base = val
 }
 
-func (p *LogoutResp) IsSetBase() bool {
+func (p *UpdateAddressResp) IsSetBase() bool {
 	return p.Base != nil
 }
 
-func (p *LogoutResp) String() string {
+func (p *UpdateAddressResp) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("LogoutResp(%+v)", *p)
+	return fmt.Sprintf("UpdateAddressResp(%+v)", *p)
 }
 
-func (p *LogoutResp) DeepEqual(ano *LogoutResp) bool {
+func (p *UpdateAddressResp) DeepEqual(ano *UpdateAddressResp) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -969,7 +1385,7 @@ func (p *LogoutResp) DeepEqual(ano *LogoutResp) bool {
 	return true
 }
 
-func (p *LogoutResp) Field1DeepEqual(src *model.BaseResp) bool {
+func (p *UpdateAddressResp) Field1DeepEqual(src *model.BaseResp) bool {
 
 	if !p.Base.DeepEqual(src) {
 		return false
@@ -977,132 +1393,94 @@ func (p *LogoutResp) Field1DeepEqual(src *model.BaseResp) bool {
 	return true
 }
 
-var fieldIDToName_LogoutResp = map[int16]string{
+var fieldIDToName_UpdateAddressResp = map[int16]string{
 	1: "base",
 }
 
-type SetAdministratorReq struct {
-	Uid      int64  `thrift:"uid,1,required" frugal:"1,required,i64" json:"uid"`
-	Password string `thrift:"password,2,required" frugal:"2,required,string" json:"password"`
-	Action   int16  `thrift:"action,3,required" frugal:"3,required,i16" json:"action"`
-}
-
-func NewSetAdministratorReq() *SetAdministratorReq {
-	return &SetAdministratorReq{}
-}
-
-func (p *SetAdministratorReq) InitDefault() {
+type DeleteAddressReq struct {
+	AddressID int64 `thrift:"addressID,1,required" frugal:"1,required,i64" json:"addressID"`
 }
 
-func (p *SetAdministratorReq) GetUid() (v int64) {
-	return p.Uid
+func NewDeleteAddressReq() *DeleteAddressReq {
+	return &DeleteAddressReq{}
 }
 
-func (p *SetAdministratorReq) GetPassword() (v string) {
-	return p.Password
+func (p *DeleteAddressReq) InitDefault() {
 }
 
-func (p *SetAdministratorReq) GetAction() (v int16) {
-	return p.Action
-}
-func (p *SetAdministratorReq) SetUid(val int64) {
-	p.Uid = val
-}
-func (p *SetAdministratorReq) SetPassword(val string) {
-	p.Password = val
+func (p *DeleteAddressReq) GetAddressID() (v int64) {
+	return p.AddressID
 }
-func (p *SetAdministratorReq) SetAction(val int16) {
-	p.Action = val
+func (p *DeleteAddressReq) SetAddressID(val int64) {
+	p.AddressID = val
 }
 
-func (p *SetAdministratorReq) String() string {
+func (p *DeleteAddressReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SetAdministratorReq(%+v)", *p)
+	return fmt.Sprintf("DeleteAddressReq(%+v)", *p)
 }
 
-func (p *SetAdministratorReq) DeepEqual(ano *SetAdministratorReq) bool {
+func (p *DeleteAddressReq) DeepEqual(ano *DeleteAddressReq) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Uid) {
-		return false
-	}
-	if !p.Field2DeepEqual(ano.Password) {
-		return false
-	}
-	if !p.Field3DeepEqual(ano.Action) {
-		return false
-	}
-	return true
-}
-
-func (p *SetAdministratorReq) Field1DeepEqual(src int64) bool {
-
-	if p.Uid != src {
+	if !p.Field1DeepEqual(ano.AddressID) {
 		return false
 	}
 	return true
 }
-func (p *SetAdministratorReq) Field2DeepEqual(src string) bool {
 
-	if strings.Compare(p.Password, src) != 0 {
-		return false
-	}
-	return true
-}
-func (p *SetAdministratorReq) Field3DeepEqual(src int16) bool {
+func (p *DeleteAddressReq) Field1DeepEqual(src int64) bool {
 
-	if p.Action != src {
+	if p.AddressID != src {
 		return false
 	}
 	return true
 }
 
-var fieldIDToName_SetAdministratorReq = map[int16]string{
-	1: "uid",
-	2: "password",
-	3: "action",
+var fieldIDToName_DeleteAddressReq = map[int16]string{
+	1: "addressID",
 }
 
-type SetAdministratorResp struct {
+type DeleteAddressResp struct {
 	Base *model.BaseResp `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
 }
 
-func NewSetAdministratorResp() *SetAdministratorResp {
-	return &SetAdministratorResp{}
+func NewDeleteAddressResp() *DeleteAddressResp {
+	return &DeleteAddressResp{}
 }
 
-func (p *SetAdministratorResp) InitDefault() {
+func (p *DeleteAddressResp) InitDefault() {
 }
 
-var SetAdministratorResp_Base_DEFAULT *model.BaseResp
+var DeleteAddressResp_Base_DEFAULT *model.BaseResp
 
-func (p *SetAdministratorResp) GetBase() (v *model.BaseResp) {
+func (p *DeleteAddressResp) GetBase() (v *model.BaseResp) {
 	if !p.IsSetBase() {
-		return SetAdministratorResp_Base_DEFAULT
+		return DeleteAddressResp_Base_DEFAULT
 	}
 	return p.Base
 }
-func (p *SetAdministratorResp) SetBase(val *model.BaseResp) {
+func (p *DeleteAddressResp) SetBase(val *model.BaseResp) {
 	p.Base = val
 }
 
-func (p *SetAdministratorResp) IsSetBase() bool {
+func (p *DeleteAddressResp) IsSetBase() bool {
 	return p.Base != nil
 }
 
-func (p *SetAdministratorResp) String() string {
+func (p *DeleteAddressResp) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SetAdministratorResp(%+v)", *p)
+	return fmt.Sprintf("DeleteAddressResp(%+v)", *p)
 }
 
-func (p *SetAdministratorResp) DeepEqual(ano *SetAdministratorResp) bool {
+func (p *DeleteAddressResp) DeepEqual(ano *DeleteAddressResp) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -1114,7 +1492,7 @@ func (p *SetAdministratorResp) DeepEqual(ano *SetAdministratorResp) bool {
 	return true
 }
 
-func (p *SetAdministratorResp) Field1DeepEqual(src *model.BaseResp) bool {
+func (p *DeleteAddressResp) Field1DeepEqual(src *model.BaseResp) bool {
 
 	if !p.Base.DeepEqual(src) {
 		return false
@@ -1122,111 +1500,94 @@ func (p *SetAdministratorResp) Field1DeepEqual(src *model.BaseResp) bool {
 	return true
 }
 
-var fieldIDToName_SetAdministratorResp = map[int16]string{
+var fieldIDToName_DeleteAddressResp = map[int16]string{
 	1: "base",
 }
 
-type GetUserInfoReq struct {
-	Uid int64 `thrift:"uid,1,required" frugal:"1,required,i64" json:"uid"`
+type SetDefaultAddressReq struct {
+	AddressID int64 `thrift:"addressID,1,required" frugal:"1,required,i64" json:"addressID"`
 }
 
-func NewGetUserInfoReq() *GetUserInfoReq {
-	return &GetUserInfoReq{}
+func NewSetDefaultAddressReq() *SetDefaultAddressReq {
+	return &SetDefaultAddressReq{}
 }
 
-func (p *GetUserInfoReq) InitDefault() {
+func (p *SetDefaultAddressReq) InitDefault() {
 }
 
-func (p *GetUserInfoReq) GetUid() (v int64) {
-	return p.Uid
+func (p *SetDefaultAddressReq) GetAddressID() (v int64) {
+	return p.AddressID
 }
-func (p *GetUserInfoReq) SetUid(val int64) {
-	p.Uid = val
+func (p *SetDefaultAddressReq) SetAddressID(val int64) {
+	p.AddressID = val
 }
 
-func (p *GetUserInfoReq) String() string {
+func (p *SetDefaultAddressReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetUserInfoReq(%+v)", *p)
+	return fmt.Sprintf("SetDefaultAddressReq(%+v)", *p)
 }
 
-func (p *GetUserInfoReq) DeepEqual(ano *GetUserInfoReq) bool {
+func (p *SetDefaultAddressReq) DeepEqual(ano *SetDefaultAddressReq) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Uid) {
+	if !p.Field1DeepEqual(ano.AddressID) {
 		return false
 	}
 	return true
 }
 
-func (p *GetUserInfoReq) Field1DeepEqual(src int64) bool {
+func (p *SetDefaultAddressReq) Field1DeepEqual(src int64) bool {
 
-	if p.Uid != src {
+	if p.AddressID != src {
 		return false
 	}
 	return true
 }
 
-var fieldIDToName_GetUserInfoReq = map[int16]string{
-	1: "uid",
+var fieldIDToName_SetDefaultAddressReq = map[int16]string{
+	1: "addressID",
 }
 
-type GetUserInfoResp struct {
+type SetDefaultAddressResp struct {
 	Base *model.BaseResp `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
-	Info *model.UserInfo `thrift:"info,2,required" frugal:"2,required,model.UserInfo" json:"info"`
 }
 
-func NewGetUserInfoResp() *GetUserInfoResp {
-	return &GetUserInfoResp{}
+func NewSetDefaultAddressResp() *SetDefaultAddressResp {
+	return &SetDefaultAddressResp{}
 }
 
-func (p *GetUserInfoResp) InitDefault() {
+func (p *SetDefaultAddressResp) InitDefault() {
 }
 
-var GetUserInfoResp_Base_DEFAULT *model.BaseResp
+var SetDefaultAddressResp_Base_DEFAULT *model.BaseResp
 
-func (p *GetUserInfoResp) GetBase() (v *model.BaseResp) {
+func (p *SetDefaultAddressResp) GetBase() (v *model.BaseResp) {
 	if !p.IsSetBase() {
-		return GetUserInfoResp_Base_DEFAULT
+		return SetDefaultAddressResp_Base_DEFAULT
 	}
 	return p.Base
 }
-
-var GetUserInfoResp_Info_DEFAULT *model.UserInfo
-
-func (p *GetUserInfoResp) GetInfo() (v *model.UserInfo) {
-	if !p.IsSetInfo() {
-		return GetUserInfoResp_Info_DEFAULT
-	}
-	return p.Info
-}
-func (p *GetUserInfoResp) SetBase(val *model.BaseResp) {
+func (p *SetDefaultAddressResp) SetBase(val *model.BaseResp) {
 	p.Base = val
 }
-func (p *GetUserInfoResp) SetInfo(val *model.UserInfo) {
-	p.Info = val
-}
 
-func (p *GetUserInfoResp) IsSetBase() bool {
+func (p *SetDefaultAddressResp) IsSetBase() bool {
 	return p.Base != nil
 }
 
-func (p *GetUserInfoResp) IsSetInfo() bool {
-	return p.Info != nil
-}
-
-func (p *GetUserInfoResp) String() string {
+func (p *SetDefaultAddressResp) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetUserInfoResp(%+v)", *p)
+	return fmt.Sprintf("SetDefaultAddressResp(%+v)", *p)
 }
 
-func (p *GetUserInfoResp) DeepEqual(ano *GetUserInfoResp) bool {
+func (p *SetDefaultAddressResp) DeepEqual(ano *SetDefaultAddressResp) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -1235,20 +1596,3938 @@ func (p *GetUserInfoResp) DeepEqual(ano *GetUserInfoResp) bool {
 	if !p.Field1DeepEqual(ano.Base) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.Info) {
-		return false
-	}
 	return true
 }
 
-func (p *GetUserInfoResp) Field1DeepEqual(src *model.BaseResp) bool {
+func (p *SetDefaultAddressResp) Field1DeepEqual(src *model.BaseResp) bool {
 
 	if !p.Base.DeepEqual(src) {
 		return false
 	}
 	return true
 }
-func (p *GetUserInfoResp) Field2DeepEqual(src *model.UserInfo) bool {
+
+var fieldIDToName_SetDefaultAddressResp = map[int16]string{
+	1: "base",
+}
+
+type BanUserReq struct {
+	Uid      int64  `thrift:"uid,1,required" frugal:"1,required,i64" json:"uid"`
+	Reason   string `thrift:"reason,2,required" frugal:"2,required,string" json:"reason"`
+	Duration *int64 `thrift:"duration,3,optional" frugal:"3,optional,i64" json:"duration,omitempty"`
+}
+
+func NewBanUserReq() *BanUserReq {
+	return &BanUserReq{}
+}
+
+func (p *BanUserReq) InitDefault() {
+}
+
+func (p *BanUserReq) GetUid() (v int64) {
+	return p.Uid
+}
+
+func (p *BanUserReq) GetReason() (v string) {
+	return p.Reason
+}
+
+var BanUserReq_Duration_DEFAULT int64
+
+func (p *BanUserReq) GetDuration() (v int64) {
+	if !p.IsSetDuration() {
+		return BanUserReq_Duration_DEFAULT
+	}
+	return *p.Duration
+}
+func (p *BanUserReq) SetUid(val int64) {
+	p.Uid = val
+}
+func (p *BanUserReq) SetReason(val string) {
+	p.Reason = val
+}
+func (p *BanUserReq) SetDuration(val *int64) {
+	p.Duration = val
+}
+
+func (p *BanUserReq) IsSetDuration() bool {
+	return p.Duration != nil
+}
+
+func (p *BanUserReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BanUserReq(%+v)", *p)
+}
+
+func (p *BanUserReq) DeepEqual(ano *BanUserReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Uid) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Reason) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Duration) {
+		return false
+	}
+	return true
+}
+
+func (p *BanUserReq) Field1DeepEqual(src int64) bool {
+
+	if p.Uid != src {
+		return false
+	}
+	return true
+}
+func (p *BanUserReq) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.Reason, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *BanUserReq) Field3DeepEqual(src *int64) bool {
+
+	if p.Duration == src {
+		return true
+	} else if p.Duration == nil || src == nil {
+		return false
+	}
+	if *p.Duration != *src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_BanUserReq = map[int16]string{
+	1: "uid",
+	2: "reason",
+	3: "duration",
+}
+
+type BanUserResp struct {
+	Base *model.BaseResp `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+}
+
+func NewBanUserResp() *BanUserResp {
+	return &BanUserResp{}
+}
+
+func (p *BanUserResp) InitDefault() {
+}
+
+var BanUserResp_Base_DEFAULT *model.BaseResp
+
+func (p *BanUserResp) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return BanUserResp_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *BanUserResp) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+
+func (p *BanUserResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *BanUserResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BanUserResp(%+v)", *p)
+}
+
+func (p *BanUserResp) DeepEqual(ano *BanUserResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	return true
+}
+
+func (p *BanUserResp) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_BanUserResp = map[int16]string{
+	1: "base",
+}
+
+type LiftBanUserReq struct {
+	Uid int64 `thrift:"uid,1,required" frugal:"1,required,i64" json:"uid"`
+}
+
+func NewLiftBanUserReq() *LiftBanUserReq {
+	return &LiftBanUserReq{}
+}
+
+func (p *LiftBanUserReq) InitDefault() {
+}
+
+func (p *LiftBanUserReq) GetUid() (v int64) {
+	return p.Uid
+}
+func (p *LiftBanUserReq) SetUid(val int64) {
+	p.Uid = val
+}
+
+func (p *LiftBanUserReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("LiftBanUserReq(%+v)", *p)
+}
+
+func (p *LiftBanUserReq) DeepEqual(ano *LiftBanUserReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Uid) {
+		return false
+	}
+	return true
+}
+
+func (p *LiftBanUserReq) Field1DeepEqual(src int64) bool {
+
+	if p.Uid != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_LiftBanUserReq = map[int16]string{
+	1: "uid",
+}
+
+type LiftBanUserResp struct {
+	Base *model.BaseResp `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+}
+
+func NewLiftBanUserResp() *LiftBanUserResp {
+	return &LiftBanUserResp{}
+}
+
+func (p *LiftBanUserResp) InitDefault() {
+}
+
+var LiftBanUserResp_Base_DEFAULT *model.BaseResp
+
+func (p *LiftBanUserResp) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return LiftBanUserResp_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *LiftBanUserResp) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+
+func (p *LiftBanUserResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *LiftBanUserResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("LiftBanUserResp(%+v)", *p)
+}
+
+func (p *LiftBanUserResp) DeepEqual(ano *LiftBanUserResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	return true
+}
+
+func (p *LiftBanUserResp) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_LiftBanUserResp = map[int16]string{
+	1: "base",
+}
+
+type ListBanHistoryReq struct {
+	Uid int64 `thrift:"uid,1,required" frugal:"1,required,i64" json:"uid"`
+}
+
+func NewListBanHistoryReq() *ListBanHistoryReq {
+	return &ListBanHistoryReq{}
+}
+
+func (p *ListBanHistoryReq) InitDefault() {
+}
+
+func (p *ListBanHistoryReq) GetUid() (v int64) {
+	return p.Uid
+}
+func (p *ListBanHistoryReq) SetUid(val int64) {
+	p.Uid = val
+}
+
+func (p *ListBanHistoryReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListBanHistoryReq(%+v)", *p)
+}
+
+func (p *ListBanHistoryReq) DeepEqual(ano *ListBanHistoryReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Uid) {
+		return false
+	}
+	return true
+}
+
+func (p *ListBanHistoryReq) Field1DeepEqual(src int64) bool {
+
+	if p.Uid != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_ListBanHistoryReq = map[int16]string{
+	1: "uid",
+}
+
+type ListBanHistoryResp struct {
+	Base *model.BaseResp  `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+	Bans []*model.BanInfo `thrift:"bans,2,required" frugal:"2,required,list<model.BanInfo>" json:"bans"`
+}
+
+func NewListBanHistoryResp() *ListBanHistoryResp {
+	return &ListBanHistoryResp{}
+}
+
+func (p *ListBanHistoryResp) InitDefault() {
+}
+
+var ListBanHistoryResp_Base_DEFAULT *model.BaseResp
+
+func (p *ListBanHistoryResp) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return ListBanHistoryResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *ListBanHistoryResp) GetBans() (v []*model.BanInfo) {
+	return p.Bans
+}
+func (p *ListBanHistoryResp) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *ListBanHistoryResp) SetBans(val []*model.BanInfo) {
+	p.Bans = val
+}
+
+func (p *ListBanHistoryResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ListBanHistoryResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListBanHistoryResp(%+v)", *p)
+}
+
+func (p *ListBanHistoryResp) DeepEqual(ano *ListBanHistoryResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Bans) {
+		return false
+	}
+	return true
+}
+
+func (p *ListBanHistoryResp) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *ListBanHistoryResp) Field2DeepEqual(src []*model.BanInfo) bool {
+
+	if len(p.Bans) != len(src) {
+		return false
+	}
+	for i, v := range p.Bans {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+
+var fieldIDToName_ListBanHistoryResp = map[int16]string{
+	1: "base",
+	2: "bans",
+}
+
+type UnlockUserReq struct {
+	Uid int64 `thrift:"uid,1,required" frugal:"1,required,i64" json:"uid"`
+}
+
+func NewUnlockUserReq() *UnlockUserReq {
+	return &UnlockUserReq{}
+}
+
+func (p *UnlockUserReq) InitDefault() {
+}
+
+func (p *UnlockUserReq) GetUid() (v int64) {
+	return p.Uid
+}
+func (p *UnlockUserReq) SetUid(val int64) {
+	p.Uid = val
+}
+
+func (p *UnlockUserReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UnlockUserReq(%+v)", *p)
+}
+
+func (p *UnlockUserReq) DeepEqual(ano *UnlockUserReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Uid) {
+		return false
+	}
+	return true
+}
+
+func (p *UnlockUserReq) Field1DeepEqual(src int64) bool {
+
+	if p.Uid != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UnlockUserReq = map[int16]string{
+	1: "uid",
+}
+
+type UnlockUserResp struct {
+	Base *model.BaseResp `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+}
+
+func NewUnlockUserResp() *UnlockUserResp {
+	return &UnlockUserResp{}
+}
+
+func (p *UnlockUserResp) InitDefault() {
+}
+
+var UnlockUserResp_Base_DEFAULT *model.BaseResp
+
+func (p *UnlockUserResp) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return UnlockUserResp_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *UnlockUserResp) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+
+func (p *UnlockUserResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *UnlockUserResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UnlockUserResp(%+v)", *p)
+}
+
+func (p *UnlockUserResp) DeepEqual(ano *UnlockUserResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	return true
+}
+
+func (p *UnlockUserResp) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UnlockUserResp = map[int16]string{
+	1: "base",
+}
+
+type LogoutReq struct {
+}
+
+func NewLogoutReq() *LogoutReq {
+	return &LogoutReq{}
+}
+
+func (p *LogoutReq) InitDefault() {
+}
+
+func (p *LogoutReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("LogoutReq(%+v)", *p)
+}
+
+func (p *LogoutReq) DeepEqual(ano *LogoutReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_LogoutReq = map[int16]string{}
+
+type LogoutResp struct {
+	Base *model.BaseResp `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+}
+
+func NewLogoutResp() *LogoutResp {
+	return &LogoutResp{}
+}
+
+func (p *LogoutResp) InitDefault() {
+}
+
+var LogoutResp_Base_DEFAULT *model.BaseResp
+
+func (p *LogoutResp) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return LogoutResp_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *LogoutResp) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+
+func (p *LogoutResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *LogoutResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("LogoutResp(%+v)", *p)
+}
+
+func (p *LogoutResp) DeepEqual(ano *LogoutResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	return true
+}
+
+func (p *LogoutResp) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_LogoutResp = map[int16]string{
+	1: "base",
+}
+
+type ListSessionsReq struct {
+}
+
+func NewListSessionsReq() *ListSessionsReq {
+	return &ListSessionsReq{}
+}
+
+func (p *ListSessionsReq) InitDefault() {
+}
+
+func (p *ListSessionsReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListSessionsReq(%+v)", *p)
+}
+
+func (p *ListSessionsReq) DeepEqual(ano *ListSessionsReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_ListSessionsReq = map[int16]string{}
+
+type ListSessionsResp struct {
+	Base     *model.BaseResp      `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+	Sessions []*model.SessionInfo `thrift:"sessions,2,required" frugal:"2,required,list<model.SessionInfo>" json:"sessions"`
+}
+
+func NewListSessionsResp() *ListSessionsResp {
+	return &ListSessionsResp{}
+}
+
+func (p *ListSessionsResp) InitDefault() {
+}
+
+var ListSessionsResp_Base_DEFAULT *model.BaseResp
+
+func (p *ListSessionsResp) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return ListSessionsResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *ListSessionsResp) GetSessions() (v []*model.SessionInfo) {
+	return p.Sessions
+}
+func (p *ListSessionsResp) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *ListSessionsResp) SetSessions(val []*model.SessionInfo) {
+	p.Sessions = val
+}
+
+func (p *ListSessionsResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ListSessionsResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListSessionsResp(%+v)", *p)
+}
+
+func (p *ListSessionsResp) DeepEqual(ano *ListSessionsResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Sessions) {
+		return false
+	}
+	return true
+}
+
+func (p *ListSessionsResp) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *ListSessionsResp) Field2DeepEqual(src []*model.SessionInfo) bool {
+
+	if len(p.Sessions) != len(src) {
+		return false
+	}
+	for i, v := range p.Sessions {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+
+var fieldIDToName_ListSessionsResp = map[int16]string{
+	1: "base",
+	2: "sessions",
+}
+
+type RevokeSessionReq struct {
+	SessionID string `thrift:"sessionID,1,required" frugal:"1,required,string" json:"sessionID"`
+}
+
+func NewRevokeSessionReq() *RevokeSessionReq {
+	return &RevokeSessionReq{}
+}
+
+func (p *RevokeSessionReq) InitDefault() {
+}
+
+func (p *RevokeSessionReq) GetSessionID() (v string) {
+	return p.SessionID
+}
+func (p *RevokeSessionReq) SetSessionID(val string) {
+	p.SessionID = val
+}
+
+func (p *RevokeSessionReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RevokeSessionReq(%+v)", *p)
+}
+
+func (p *RevokeSessionReq) DeepEqual(ano *RevokeSessionReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.SessionID) {
+		return false
+	}
+	return true
+}
+
+func (p *RevokeSessionReq) Field1DeepEqual(src string) bool {
+
+	if strings.Compare(p.SessionID, src) != 0 {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_RevokeSessionReq = map[int16]string{
+	1: "sessionID",
+}
+
+type RevokeSessionResp struct {
+	Base *model.BaseResp `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+}
+
+func NewRevokeSessionResp() *RevokeSessionResp {
+	return &RevokeSessionResp{}
+}
+
+func (p *RevokeSessionResp) InitDefault() {
+}
+
+var RevokeSessionResp_Base_DEFAULT *model.BaseResp
+
+func (p *RevokeSessionResp) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return RevokeSessionResp_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *RevokeSessionResp) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+
+func (p *RevokeSessionResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *RevokeSessionResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RevokeSessionResp(%+v)", *p)
+}
+
+func (p *RevokeSessionResp) DeepEqual(ano *RevokeSessionResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	return true
+}
+
+func (p *RevokeSessionResp) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_RevokeSessionResp = map[int16]string{
+	1: "base",
+}
+
+type RevokeAllSessionsReq struct {
+}
+
+func NewRevokeAllSessionsReq() *RevokeAllSessionsReq {
+	return &RevokeAllSessionsReq{}
+}
+
+func (p *RevokeAllSessionsReq) InitDefault() {
+}
+
+func (p *RevokeAllSessionsReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RevokeAllSessionsReq(%+v)", *p)
+}
+
+func (p *RevokeAllSessionsReq) DeepEqual(ano *RevokeAllSessionsReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_RevokeAllSessionsReq = map[int16]string{}
+
+type RevokeAllSessionsResp struct {
+	Base *model.BaseResp `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+}
+
+func NewRevokeAllSessionsResp() *RevokeAllSessionsResp {
+	return &RevokeAllSessionsResp{}
+}
+
+func (p *RevokeAllSessionsResp) InitDefault() {
+}
+
+var RevokeAllSessionsResp_Base_DEFAULT *model.BaseResp
+
+func (p *RevokeAllSessionsResp) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return RevokeAllSessionsResp_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *RevokeAllSessionsResp) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+
+func (p *RevokeAllSessionsResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *RevokeAllSessionsResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RevokeAllSessionsResp(%+v)", *p)
+}
+
+func (p *RevokeAllSessionsResp) DeepEqual(ano *RevokeAllSessionsResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	return true
+}
+
+func (p *RevokeAllSessionsResp) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_RevokeAllSessionsResp = map[int16]string{
+	1: "base",
+}
+
+type SetAdministratorReq struct {
+	Uid      int64  `thrift:"uid,1,required" frugal:"1,required,i64" json:"uid"`
+	Password string `thrift:"password,2,required" frugal:"2,required,string" json:"password"`
+	Action   int16  `thrift:"action,3,required" frugal:"3,required,i16" json:"action"`
+}
+
+func NewSetAdministratorReq() *SetAdministratorReq {
+	return &SetAdministratorReq{}
+}
+
+func (p *SetAdministratorReq) InitDefault() {
+}
+
+func (p *SetAdministratorReq) GetUid() (v int64) {
+	return p.Uid
+}
+
+func (p *SetAdministratorReq) GetPassword() (v string) {
+	return p.Password
+}
+
+func (p *SetAdministratorReq) GetAction() (v int16) {
+	return p.Action
+}
+func (p *SetAdministratorReq) SetUid(val int64) {
+	p.Uid = val
+}
+func (p *SetAdministratorReq) SetPassword(val string) {
+	p.Password = val
+}
+func (p *SetAdministratorReq) SetAction(val int16) {
+	p.Action = val
+}
+
+func (p *SetAdministratorReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SetAdministratorReq(%+v)", *p)
+}
+
+func (p *SetAdministratorReq) DeepEqual(ano *SetAdministratorReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Uid) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Password) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Action) {
+		return false
+	}
+	return true
+}
+
+func (p *SetAdministratorReq) Field1DeepEqual(src int64) bool {
+
+	if p.Uid != src {
+		return false
+	}
+	return true
+}
+func (p *SetAdministratorReq) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.Password, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *SetAdministratorReq) Field3DeepEqual(src int16) bool {
+
+	if p.Action != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_SetAdministratorReq = map[int16]string{
+	1: "uid",
+	2: "password",
+	3: "action",
+}
+
+type SetAdministratorResp struct {
+	Base *model.BaseResp `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+}
+
+func NewSetAdministratorResp() *SetAdministratorResp {
+	return &SetAdministratorResp{}
+}
+
+func (p *SetAdministratorResp) InitDefault() {
+}
+
+var SetAdministratorResp_Base_DEFAULT *model.BaseResp
+
+func (p *SetAdministratorResp) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return SetAdministratorResp_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *SetAdministratorResp) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+
+func (p *SetAdministratorResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *SetAdministratorResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SetAdministratorResp(%+v)", *p)
+}
+
+func (p *SetAdministratorResp) DeepEqual(ano *SetAdministratorResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	return true
+}
+
+func (p *SetAdministratorResp) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_SetAdministratorResp = map[int16]string{
+	1: "base",
+}
+
+type GetUserInfoReq struct {
+	Uid int64 `thrift:"uid,1,required" frugal:"1,required,i64" json:"uid"`
+}
+
+func NewGetUserInfoReq() *GetUserInfoReq {
+	return &GetUserInfoReq{}
+}
+
+func (p *GetUserInfoReq) InitDefault() {
+}
+
+func (p *GetUserInfoReq) GetUid() (v int64) {
+	return p.Uid
+}
+func (p *GetUserInfoReq) SetUid(val int64) {
+	p.Uid = val
+}
+
+func (p *GetUserInfoReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetUserInfoReq(%+v)", *p)
+}
+
+func (p *GetUserInfoReq) DeepEqual(ano *GetUserInfoReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Uid) {
+		return false
+	}
+	return true
+}
+
+func (p *GetUserInfoReq) Field1DeepEqual(src int64) bool {
+
+	if p.Uid != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_GetUserInfoReq = map[int16]string{
+	1: "uid",
+}
+
+type GetUserInfoResp struct {
+	Base *model.BaseResp `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+	Info *model.UserInfo `thrift:"info,2,required" frugal:"2,required,model.UserInfo" json:"info"`
+}
+
+func NewGetUserInfoResp() *GetUserInfoResp {
+	return &GetUserInfoResp{}
+}
+
+func (p *GetUserInfoResp) InitDefault() {
+}
+
+var GetUserInfoResp_Base_DEFAULT *model.BaseResp
+
+func (p *GetUserInfoResp) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return GetUserInfoResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var GetUserInfoResp_Info_DEFAULT *model.UserInfo
+
+func (p *GetUserInfoResp) GetInfo() (v *model.UserInfo) {
+	if !p.IsSetInfo() {
+		return GetUserInfoResp_Info_DEFAULT
+	}
+	return p.Info
+}
+func (p *GetUserInfoResp) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *GetUserInfoResp) SetInfo(val *model.UserInfo) {
+	p.Info = val
+}
+
+func (p *GetUserInfoResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *GetUserInfoResp) IsSetInfo() bool {
+	return p.Info != nil
+}
+
+func (p *GetUserInfoResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetUserInfoResp(%+v)", *p)
+}
+
+func (p *GetUserInfoResp) DeepEqual(ano *GetUserInfoResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Info) {
+		return false
+	}
+	return true
+}
+
+func (p *GetUserInfoResp) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *GetUserInfoResp) Field2DeepEqual(src *model.UserInfo) bool {
+
+	if !p.Info.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_GetUserInfoResp = map[int16]string{
+	1: "base",
+	2: "info",
+}
+
+type CreateShopReq struct {
+	Name        string  `thrift:"name,1,required" frugal:"1,required,string" json:"name"`
+	Logo        *string `thrift:"logo,2,optional" frugal:"2,optional,string" json:"logo,omitempty"`
+	Description *string `thrift:"description,3,optional" frugal:"3,optional,string" json:"description,omitempty"`
+}
+
+func NewCreateShopReq() *CreateShopReq {
+	return &CreateShopReq{}
+}
+
+func (p *CreateShopReq) InitDefault() {
+}
+
+func (p *CreateShopReq) GetName() (v string) {
+	return p.Name
+}
+
+var CreateShopReq_Logo_DEFAULT string
+
+func (p *CreateShopReq) GetLogo() (v string) {
+	if !p.IsSetLogo() {
+		return CreateShopReq_Logo_DEFAULT
+	}
+	return *p.Logo
+}
+
+var CreateShopReq_Description_DEFAULT string
+
+func (p *CreateShopReq) GetDescription() (v string) {
+	if !p.IsSetDescription() {
+		return CreateShopReq_Description_DEFAULT
+	}
+	return *p.Description
+}
+func (p *CreateShopReq) SetName(val string) {
+	p.Name = val
+}
+func (p *CreateShopReq) SetLogo(val *string) {
+	p.Logo = val
+}
+func (p *CreateShopReq) SetDescription(val *string) {
+	p.Description = val
+}
+
+func (p *CreateShopReq) IsSetLogo() bool {
+	return p.Logo != nil
+}
+
+func (p *CreateShopReq) IsSetDescription() bool {
+	return p.Description != nil
+}
+
+func (p *CreateShopReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateShopReq(%+v)", *p)
+}
+
+func (p *CreateShopReq) DeepEqual(ano *CreateShopReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Name) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Logo) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Description) {
+		return false
+	}
+	return true
+}
+
+func (p *CreateShopReq) Field1DeepEqual(src string) bool {
+
+	if strings.Compare(p.Name, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *CreateShopReq) Field2DeepEqual(src *string) bool {
+
+	if p.Logo == src {
+		return true
+	} else if p.Logo == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Logo, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *CreateShopReq) Field3DeepEqual(src *string) bool {
+
+	if p.Description == src {
+		return true
+	} else if p.Description == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Description, *src) != 0 {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_CreateShopReq = map[int16]string{
+	1: "name",
+	2: "logo",
+	3: "description",
+}
+
+type CreateShopResp struct {
+	Base   *model.BaseResp `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+	ShopID int64           `thrift:"shopID,2,required" frugal:"2,required,i64" json:"shopID"`
+}
+
+func NewCreateShopResp() *CreateShopResp {
+	return &CreateShopResp{}
+}
+
+func (p *CreateShopResp) InitDefault() {
+}
+
+var CreateShopResp_Base_DEFAULT *model.BaseResp
+
+func (p *CreateShopResp) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return CreateShopResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *CreateShopResp) GetShopID() (v int64) {
+	return p.ShopID
+}
+func (p *CreateShopResp) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *CreateShopResp) SetShopID(val int64) {
+	p.ShopID = val
+}
+
+func (p *CreateShopResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *CreateShopResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateShopResp(%+v)", *p)
+}
+
+func (p *CreateShopResp) DeepEqual(ano *CreateShopResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.ShopID) {
+		return false
+	}
+	return true
+}
+
+func (p *CreateShopResp) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *CreateShopResp) Field2DeepEqual(src int64) bool {
+
+	if p.ShopID != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_CreateShopResp = map[int16]string{
+	1: "base",
+	2: "shopID",
+}
+
+type UpdateShopReq struct {
+	ShopID      int64   `thrift:"shopID,1,required" frugal:"1,required,i64" json:"shopID"`
+	Name        *string `thrift:"name,2,optional" frugal:"2,optional,string" json:"name,omitempty"`
+	Logo        *string `thrift:"logo,3,optional" frugal:"3,optional,string" json:"logo,omitempty"`
+	Description *string `thrift:"description,4,optional" frugal:"4,optional,string" json:"description,omitempty"`
+}
+
+func NewUpdateShopReq() *UpdateShopReq {
+	return &UpdateShopReq{}
+}
+
+func (p *UpdateShopReq) InitDefault() {
+}
+
+func (p *UpdateShopReq) GetShopID() (v int64) {
+	return p.ShopID
+}
+
+var UpdateShopReq_Name_DEFAULT string
+
+func (p *UpdateShopReq) GetName() (v string) {
+	if !p.IsSetName() {
+		return UpdateShopReq_Name_DEFAULT
+	}
+	return *p.Name
+}
+
+var UpdateShopReq_Logo_DEFAULT string
+
+func (p *UpdateShopReq) GetLogo() (v string) {
+	if !p.IsSetLogo() {
+		return UpdateShopReq_Logo_DEFAULT
+	}
+	return *p.Logo
+}
+
+var UpdateShopReq_Description_DEFAULT string
+
+func (p *UpdateShopReq) GetDescription() (v string) {
+	if !p.IsSetDescription() {
+		return UpdateShopReq_Description_DEFAULT
+	}
+	return *p.Description
+}
+func (p *UpdateShopReq) SetShopID(val int64) {
+	p.ShopID = val
+}
+func (p *UpdateShopReq) SetName(val *string) {
+	p.Name = val
+}
+func (p *UpdateShopReq) SetLogo(val *string) {
+	p.Logo = val
+}
+func (p *UpdateShopReq) SetDescription(val *string) {
+	p.Description = val
+}
+
+func (p *UpdateShopReq) IsSetName() bool {
+	return p.Name != nil
+}
+
+func (p *UpdateShopReq) IsSetLogo() bool {
+	return p.Logo != nil
+}
+
+func (p *UpdateShopReq) IsSetDescription() bool {
+	return p.Description != nil
+}
+
+func (p *UpdateShopReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateShopReq(%+v)", *p)
+}
+
+func (p *UpdateShopReq) DeepEqual(ano *UpdateShopReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.ShopID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Name) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Logo) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Description) {
+		return false
+	}
+	return true
+}
+
+func (p *UpdateShopReq) Field1DeepEqual(src int64) bool {
+
+	if p.ShopID != src {
+		return false
+	}
+	return true
+}
+func (p *UpdateShopReq) Field2DeepEqual(src *string) bool {
+
+	if p.Name == src {
+		return true
+	} else if p.Name == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Name, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *UpdateShopReq) Field3DeepEqual(src *string) bool {
+
+	if p.Logo == src {
+		return true
+	} else if p.Logo == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Logo, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *UpdateShopReq) Field4DeepEqual(src *string) bool {
+
+	if p.Description == src {
+		return true
+	} else if p.Description == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Description, *src) != 0 {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UpdateShopReq = map[int16]string{
+	1: "shopID",
+	2: "name",
+	3: "logo",
+	4: "description",
+}
+
+type UpdateShopResp struct {
+	Base *model.BaseResp `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+}
+
+func NewUpdateShopResp() *UpdateShopResp {
+	return &UpdateShopResp{}
+}
+
+func (p *UpdateShopResp) InitDefault() {
+}
+
+var UpdateShopResp_Base_DEFAULT *model.BaseResp
+
+func (p *UpdateShopResp) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return UpdateShopResp_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *UpdateShopResp) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+
+func (p *UpdateShopResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *UpdateShopResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateShopResp(%+v)", *p)
+}
+
+func (p *UpdateShopResp) DeepEqual(ano *UpdateShopResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	return true
+}
+
+func (p *UpdateShopResp) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UpdateShopResp = map[int16]string{
+	1: "base",
+}
+
+type CloseShopReq struct {
+	ShopID int64 `thrift:"shopID,1,required" frugal:"1,required,i64" json:"shopID"`
+}
+
+func NewCloseShopReq() *CloseShopReq {
+	return &CloseShopReq{}
+}
+
+func (p *CloseShopReq) InitDefault() {
+}
+
+func (p *CloseShopReq) GetShopID() (v int64) {
+	return p.ShopID
+}
+func (p *CloseShopReq) SetShopID(val int64) {
+	p.ShopID = val
+}
+
+func (p *CloseShopReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CloseShopReq(%+v)", *p)
+}
+
+func (p *CloseShopReq) DeepEqual(ano *CloseShopReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.ShopID) {
+		return false
+	}
+	return true
+}
+
+func (p *CloseShopReq) Field1DeepEqual(src int64) bool {
+
+	if p.ShopID != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_CloseShopReq = map[int16]string{
+	1: "shopID",
+}
+
+type CloseShopResp struct {
+	Base *model.BaseResp `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+}
+
+func NewCloseShopResp() *CloseShopResp {
+	return &CloseShopResp{}
+}
+
+func (p *CloseShopResp) InitDefault() {
+}
+
+var CloseShopResp_Base_DEFAULT *model.BaseResp
+
+func (p *CloseShopResp) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return CloseShopResp_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *CloseShopResp) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+
+func (p *CloseShopResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *CloseShopResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CloseShopResp(%+v)", *p)
+}
+
+func (p *CloseShopResp) DeepEqual(ano *CloseShopResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	return true
+}
+
+func (p *CloseShopResp) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_CloseShopResp = map[int16]string{
+	1: "base",
+}
+
+type GetShopReq struct {
+	ShopID int64 `thrift:"shopID,1,required" frugal:"1,required,i64" json:"shopID"`
+}
+
+func NewGetShopReq() *GetShopReq {
+	return &GetShopReq{}
+}
+
+func (p *GetShopReq) InitDefault() {
+}
+
+func (p *GetShopReq) GetShopID() (v int64) {
+	return p.ShopID
+}
+func (p *GetShopReq) SetShopID(val int64) {
+	p.ShopID = val
+}
+
+func (p *GetShopReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetShopReq(%+v)", *p)
+}
+
+func (p *GetShopReq) DeepEqual(ano *GetShopReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.ShopID) {
+		return false
+	}
+	return true
+}
+
+func (p *GetShopReq) Field1DeepEqual(src int64) bool {
+
+	if p.ShopID != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_GetShopReq = map[int16]string{
+	1: "shopID",
+}
+
+type GetShopResp struct {
+	Base *model.BaseResp `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+	Shop *model.ShopInfo `thrift:"shop,2,required" frugal:"2,required,model.ShopInfo" json:"shop"`
+}
+
+func NewGetShopResp() *GetShopResp {
+	return &GetShopResp{}
+}
+
+func (p *GetShopResp) InitDefault() {
+}
+
+var GetShopResp_Base_DEFAULT *model.BaseResp
+
+func (p *GetShopResp) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return GetShopResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var GetShopResp_Shop_DEFAULT *model.ShopInfo
+
+func (p *GetShopResp) GetShop() (v *model.ShopInfo) {
+	if !p.IsSetShop() {
+		return GetShopResp_Shop_DEFAULT
+	}
+	return p.Shop
+}
+func (p *GetShopResp) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *GetShopResp) SetShop(val *model.ShopInfo) {
+	p.Shop = val
+}
+
+func (p *GetShopResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *GetShopResp) IsSetShop() bool {
+	return p.Shop != nil
+}
+
+func (p *GetShopResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetShopResp(%+v)", *p)
+}
+
+func (p *GetShopResp) DeepEqual(ano *GetShopResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Shop) {
+		return false
+	}
+	return true
+}
+
+func (p *GetShopResp) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *GetShopResp) Field2DeepEqual(src *model.ShopInfo) bool {
+
+	if !p.Shop.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_GetShopResp = map[int16]string{
+	1: "base",
+	2: "shop",
+}
+
+type GetShopsReq struct {
+	ShopIDs []int64 `thrift:"shopIDs,1,required" frugal:"1,required,list<i64>" json:"shopIDs"`
+}
+
+func NewGetShopsReq() *GetShopsReq {
+	return &GetShopsReq{}
+}
+
+func (p *GetShopsReq) InitDefault() {
+}
+
+func (p *GetShopsReq) GetShopIDs() (v []int64) {
+	return p.ShopIDs
+}
+func (p *GetShopsReq) SetShopIDs(val []int64) {
+	p.ShopIDs = val
+}
+
+func (p *GetShopsReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetShopsReq(%+v)", *p)
+}
+
+func (p *GetShopsReq) DeepEqual(ano *GetShopsReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.ShopIDs) {
+		return false
+	}
+	return true
+}
+
+func (p *GetShopsReq) Field1DeepEqual(src []int64) bool {
+
+	if len(p.ShopIDs) != len(src) {
+		return false
+	}
+	for i, v := range p.ShopIDs {
+		_src := src[i]
+		if v != _src {
+			return false
+		}
+	}
+	return true
+}
+
+var fieldIDToName_GetShopsReq = map[int16]string{
+	1: "shopIDs",
+}
+
+type GetShopsResp struct {
+	Base  *model.BaseResp   `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+	Shops []*model.ShopInfo `thrift:"shops,2,required" frugal:"2,required,list<model.ShopInfo>" json:"shops"`
+}
+
+func NewGetShopsResp() *GetShopsResp {
+	return &GetShopsResp{}
+}
+
+func (p *GetShopsResp) InitDefault() {
+}
+
+var GetShopsResp_Base_DEFAULT *model.BaseResp
+
+func (p *GetShopsResp) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return GetShopsResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *GetShopsResp) GetShops() (v []*model.ShopInfo) {
+	return p.Shops
+}
+func (p *GetShopsResp) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *GetShopsResp) SetShops(val []*model.ShopInfo) {
+	p.Shops = val
+}
+
+func (p *GetShopsResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *GetShopsResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetShopsResp(%+v)", *p)
+}
+
+func (p *GetShopsResp) DeepEqual(ano *GetShopsResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Shops) {
+		return false
+	}
+	return true
+}
+
+func (p *GetShopsResp) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *GetShopsResp) Field2DeepEqual(src []*model.ShopInfo) bool {
+
+	if len(p.Shops) != len(src) {
+		return false
+	}
+	for i, v := range p.Shops {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+
+var fieldIDToName_GetShopsResp = map[int16]string{
+	1: "base",
+	2: "shops",
+}
+
+type AddShopStaffReq struct {
+	ShopID int64 `thrift:"shopID,1,required" frugal:"1,required,i64" json:"shopID"`
+	Uid    int64 `thrift:"uid,2,required" frugal:"2,required,i64" json:"uid"`
+}
+
+func NewAddShopStaffReq() *AddShopStaffReq {
+	return &AddShopStaffReq{}
+}
+
+func (p *AddShopStaffReq) InitDefault() {
+}
+
+func (p *AddShopStaffReq) GetShopID() (v int64) {
+	return p.ShopID
+}
+
+func (p *AddShopStaffReq) GetUid() (v int64) {
+	return p.Uid
+}
+func (p *AddShopStaffReq) SetShopID(val int64) {
+	p.ShopID = val
+}
+func (p *AddShopStaffReq) SetUid(val int64) {
+	p.Uid = val
+}
+
+func (p *AddShopStaffReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AddShopStaffReq(%+v)", *p)
+}
+
+func (p *AddShopStaffReq) DeepEqual(ano *AddShopStaffReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.ShopID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Uid) {
+		return false
+	}
+	return true
+}
+
+func (p *AddShopStaffReq) Field1DeepEqual(src int64) bool {
+
+	if p.ShopID != src {
+		return false
+	}
+	return true
+}
+func (p *AddShopStaffReq) Field2DeepEqual(src int64) bool {
+
+	if p.Uid != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_AddShopStaffReq = map[int16]string{
+	1: "shopID",
+	2: "uid",
+}
+
+type AddShopStaffResp struct {
+	Base *model.BaseResp `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+}
+
+func NewAddShopStaffResp() *AddShopStaffResp {
+	return &AddShopStaffResp{}
+}
+
+func (p *AddShopStaffResp) InitDefault() {
+}
+
+var AddShopStaffResp_Base_DEFAULT *model.BaseResp
+
+func (p *AddShopStaffResp) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return AddShopStaffResp_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *AddShopStaffResp) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+
+func (p *AddShopStaffResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *AddShopStaffResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AddShopStaffResp(%+v)", *p)
+}
+
+func (p *AddShopStaffResp) DeepEqual(ano *AddShopStaffResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	return true
+}
+
+func (p *AddShopStaffResp) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_AddShopStaffResp = map[int16]string{
+	1: "base",
+}
+
+type RemoveShopStaffReq struct {
+	ShopID int64 `thrift:"shopID,1,required" frugal:"1,required,i64" json:"shopID"`
+	Uid    int64 `thrift:"uid,2,required" frugal:"2,required,i64" json:"uid"`
+}
+
+func NewRemoveShopStaffReq() *RemoveShopStaffReq {
+	return &RemoveShopStaffReq{}
+}
+
+func (p *RemoveShopStaffReq) InitDefault() {
+}
+
+func (p *RemoveShopStaffReq) GetShopID() (v int64) {
+	return p.ShopID
+}
+
+func (p *RemoveShopStaffReq) GetUid() (v int64) {
+	return p.Uid
+}
+func (p *RemoveShopStaffReq) SetShopID(val int64) {
+	p.ShopID = val
+}
+func (p *RemoveShopStaffReq) SetUid(val int64) {
+	p.Uid = val
+}
+
+func (p *RemoveShopStaffReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RemoveShopStaffReq(%+v)", *p)
+}
+
+func (p *RemoveShopStaffReq) DeepEqual(ano *RemoveShopStaffReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.ShopID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Uid) {
+		return false
+	}
+	return true
+}
+
+func (p *RemoveShopStaffReq) Field1DeepEqual(src int64) bool {
+
+	if p.ShopID != src {
+		return false
+	}
+	return true
+}
+func (p *RemoveShopStaffReq) Field2DeepEqual(src int64) bool {
+
+	if p.Uid != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_RemoveShopStaffReq = map[int16]string{
+	1: "shopID",
+	2: "uid",
+}
+
+type RemoveShopStaffResp struct {
+	Base *model.BaseResp `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+}
+
+func NewRemoveShopStaffResp() *RemoveShopStaffResp {
+	return &RemoveShopStaffResp{}
+}
+
+func (p *RemoveShopStaffResp) InitDefault() {
+}
+
+var RemoveShopStaffResp_Base_DEFAULT *model.BaseResp
+
+func (p *RemoveShopStaffResp) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return RemoveShopStaffResp_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *RemoveShopStaffResp) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+
+func (p *RemoveShopStaffResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *RemoveShopStaffResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RemoveShopStaffResp(%+v)", *p)
+}
+
+func (p *RemoveShopStaffResp) DeepEqual(ano *RemoveShopStaffResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	return true
+}
+
+func (p *RemoveShopStaffResp) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_RemoveShopStaffResp = map[int16]string{
+	1: "base",
+}
+
+type ListShopStaffReq struct {
+	ShopID int64 `thrift:"shopID,1,required" frugal:"1,required,i64" json:"shopID"`
+}
+
+func NewListShopStaffReq() *ListShopStaffReq {
+	return &ListShopStaffReq{}
+}
+
+func (p *ListShopStaffReq) InitDefault() {
+}
+
+func (p *ListShopStaffReq) GetShopID() (v int64) {
+	return p.ShopID
+}
+func (p *ListShopStaffReq) SetShopID(val int64) {
+	p.ShopID = val
+}
+
+func (p *ListShopStaffReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListShopStaffReq(%+v)", *p)
+}
+
+func (p *ListShopStaffReq) DeepEqual(ano *ListShopStaffReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.ShopID) {
+		return false
+	}
+	return true
+}
+
+func (p *ListShopStaffReq) Field1DeepEqual(src int64) bool {
+
+	if p.ShopID != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_ListShopStaffReq = map[int16]string{
+	1: "shopID",
+}
+
+type ListShopStaffResp struct {
+	Base  *model.BaseResp        `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+	Staff []*model.ShopStaffInfo `thrift:"staff,2,required" frugal:"2,required,list<model.ShopStaffInfo>" json:"staff"`
+}
+
+func NewListShopStaffResp() *ListShopStaffResp {
+	return &ListShopStaffResp{}
+}
+
+func (p *ListShopStaffResp) InitDefault() {
+}
+
+var ListShopStaffResp_Base_DEFAULT *model.BaseResp
+
+func (p *ListShopStaffResp) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return ListShopStaffResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *ListShopStaffResp) GetStaff() (v []*model.ShopStaffInfo) {
+	return p.Staff
+}
+func (p *ListShopStaffResp) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *ListShopStaffResp) SetStaff(val []*model.ShopStaffInfo) {
+	p.Staff = val
+}
+
+func (p *ListShopStaffResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ListShopStaffResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListShopStaffResp(%+v)", *p)
+}
+
+func (p *ListShopStaffResp) DeepEqual(ano *ListShopStaffResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Staff) {
+		return false
+	}
+	return true
+}
+
+func (p *ListShopStaffResp) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *ListShopStaffResp) Field2DeepEqual(src []*model.ShopStaffInfo) bool {
+
+	if len(p.Staff) != len(src) {
+		return false
+	}
+	for i, v := range p.Staff {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+
+var fieldIDToName_ListShopStaffResp = map[int16]string{
+	1: "base",
+	2: "staff",
+}
+
+type IsShopStaffReq struct {
+	ShopID int64 `thrift:"shopID,1,required" frugal:"1,required,i64" json:"shopID"`
+	Uid    int64 `thrift:"uid,2,required" frugal:"2,required,i64" json:"uid"`
+}
+
+func NewIsShopStaffReq() *IsShopStaffReq {
+	return &IsShopStaffReq{}
+}
+
+func (p *IsShopStaffReq) InitDefault() {
+}
+
+func (p *IsShopStaffReq) GetShopID() (v int64) {
+	return p.ShopID
+}
+
+func (p *IsShopStaffReq) GetUid() (v int64) {
+	return p.Uid
+}
+func (p *IsShopStaffReq) SetShopID(val int64) {
+	p.ShopID = val
+}
+func (p *IsShopStaffReq) SetUid(val int64) {
+	p.Uid = val
+}
+
+func (p *IsShopStaffReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IsShopStaffReq(%+v)", *p)
+}
+
+func (p *IsShopStaffReq) DeepEqual(ano *IsShopStaffReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.ShopID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Uid) {
+		return false
+	}
+	return true
+}
+
+func (p *IsShopStaffReq) Field1DeepEqual(src int64) bool {
+
+	if p.ShopID != src {
+		return false
+	}
+	return true
+}
+func (p *IsShopStaffReq) Field2DeepEqual(src int64) bool {
+
+	if p.Uid != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_IsShopStaffReq = map[int16]string{
+	1: "shopID",
+	2: "uid",
+}
+
+type IsShopStaffResp struct {
+	Base    *model.BaseResp `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+	IsStaff bool            `thrift:"isStaff,2,required" frugal:"2,required,bool" json:"isStaff"`
+	Shop    *model.ShopInfo `thrift:"shop,3,required" frugal:"3,required,model.ShopInfo" json:"shop"`
+}
+
+func NewIsShopStaffResp() *IsShopStaffResp {
+	return &IsShopStaffResp{}
+}
+
+func (p *IsShopStaffResp) InitDefault() {
+}
+
+var IsShopStaffResp_Base_DEFAULT *model.BaseResp
+
+func (p *IsShopStaffResp) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return IsShopStaffResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *IsShopStaffResp) GetIsStaff() (v bool) {
+	return p.IsStaff
+}
+
+var IsShopStaffResp_Shop_DEFAULT *model.ShopInfo
+
+func (p *IsShopStaffResp) GetShop() (v *model.ShopInfo) {
+	if !p.IsSetShop() {
+		return IsShopStaffResp_Shop_DEFAULT
+	}
+	return p.Shop
+}
+func (p *IsShopStaffResp) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *IsShopStaffResp) SetIsStaff(val bool) {
+	p.IsStaff = val
+}
+func (p *IsShopStaffResp) SetShop(val *model.ShopInfo) {
+	p.Shop = val
+}
+
+func (p *IsShopStaffResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *IsShopStaffResp) IsSetShop() bool {
+	return p.Shop != nil
+}
+
+func (p *IsShopStaffResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IsShopStaffResp(%+v)", *p)
+}
+
+func (p *IsShopStaffResp) DeepEqual(ano *IsShopStaffResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.IsStaff) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Shop) {
+		return false
+	}
+	return true
+}
+
+func (p *IsShopStaffResp) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *IsShopStaffResp) Field2DeepEqual(src bool) bool {
+
+	if p.IsStaff != src {
+		return false
+	}
+	return true
+}
+func (p *IsShopStaffResp) Field3DeepEqual(src *model.ShopInfo) bool {
+
+	if !p.Shop.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_IsShopStaffResp = map[int16]string{
+	1: "base",
+	2: "isStaff",
+	3: "shop",
+}
+
+type UpdateProfileReq struct {
+	Name     *string `thrift:"name,1,optional" frugal:"1,optional,string" json:"name,omitempty"`
+	Email    *string `thrift:"email,2,optional" frugal:"2,optional,string" json:"email,omitempty"`
+	Phone    *string `thrift:"phone,3,optional" frugal:"3,optional,string" json:"phone,omitempty"`
+	Password *string `thrift:"password,4,optional" frugal:"4,optional,string" json:"password,omitempty"`
+}
+
+func NewUpdateProfileReq() *UpdateProfileReq {
+	return &UpdateProfileReq{}
+}
+
+func (p *UpdateProfileReq) InitDefault() {
+}
+
+var UpdateProfileReq_Name_DEFAULT string
+
+func (p *UpdateProfileReq) GetName() (v string) {
+	if !p.IsSetName() {
+		return UpdateProfileReq_Name_DEFAULT
+	}
+	return *p.Name
+}
+
+var UpdateProfileReq_Email_DEFAULT string
+
+func (p *UpdateProfileReq) GetEmail() (v string) {
+	if !p.IsSetEmail() {
+		return UpdateProfileReq_Email_DEFAULT
+	}
+	return *p.Email
+}
+
+var UpdateProfileReq_Phone_DEFAULT string
+
+func (p *UpdateProfileReq) GetPhone() (v string) {
+	if !p.IsSetPhone() {
+		return UpdateProfileReq_Phone_DEFAULT
+	}
+	return *p.Phone
+}
+
+var UpdateProfileReq_Password_DEFAULT string
+
+func (p *UpdateProfileReq) GetPassword() (v string) {
+	if !p.IsSetPassword() {
+		return UpdateProfileReq_Password_DEFAULT
+	}
+	return *p.Password
+}
+func (p *UpdateProfileReq) SetName(val *string) {
+	p.Name = val
+}
+func (p *UpdateProfileReq) SetEmail(val *string) {
+	p.Email = val
+}
+func (p *UpdateProfileReq) SetPhone(val *string) {
+	p.Phone = val
+}
+func (p *UpdateProfileReq) SetPassword(val *string) {
+	p.Password = val
+}
+
+func (p *UpdateProfileReq) IsSetName() bool {
+	return p.Name != nil
+}
+
+func (p *UpdateProfileReq) IsSetEmail() bool {
+	return p.Email != nil
+}
+
+func (p *UpdateProfileReq) IsSetPhone() bool {
+	return p.Phone != nil
+}
+
+func (p *UpdateProfileReq) IsSetPassword() bool {
+	return p.Password != nil
+}
+
+func (p *UpdateProfileReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateProfileReq(%+v)", *p)
+}
+
+func (p *UpdateProfileReq) DeepEqual(ano *UpdateProfileReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Name) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Email) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Phone) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Password) {
+		return false
+	}
+	return true
+}
+
+func (p *UpdateProfileReq) Field1DeepEqual(src *string) bool {
+
+	if p.Name == src {
+		return true
+	} else if p.Name == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Name, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *UpdateProfileReq) Field2DeepEqual(src *string) bool {
+
+	if p.Email == src {
+		return true
+	} else if p.Email == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Email, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *UpdateProfileReq) Field3DeepEqual(src *string) bool {
+
+	if p.Phone == src {
+		return true
+	} else if p.Phone == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Phone, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *UpdateProfileReq) Field4DeepEqual(src *string) bool {
+
+	if p.Password == src {
+		return true
+	} else if p.Password == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Password, *src) != 0 {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UpdateProfileReq = map[int16]string{
+	1: "name",
+	2: "email",
+	3: "phone",
+	4: "password",
+}
+
+type UpdateProfileResp struct {
+	Base *model.BaseResp `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+	User *model.UserInfo `thrift:"user,2,required" frugal:"2,required,model.UserInfo" json:"user"`
+}
+
+func NewUpdateProfileResp() *UpdateProfileResp {
+	return &UpdateProfileResp{}
+}
+
+func (p *UpdateProfileResp) InitDefault() {
+}
+
+var UpdateProfileResp_Base_DEFAULT *model.BaseResp
+
+func (p *UpdateProfileResp) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return UpdateProfileResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var UpdateProfileResp_User_DEFAULT *model.UserInfo
+
+func (p *UpdateProfileResp) GetUser() (v *model.UserInfo) {
+	if !p.IsSetUser() {
+		return UpdateProfileResp_User_DEFAULT
+	}
+	return p.User
+}
+func (p *UpdateProfileResp) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *UpdateProfileResp) SetUser(val *model.UserInfo) {
+	p.User = val
+}
+
+func (p *UpdateProfileResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *UpdateProfileResp) IsSetUser() bool {
+	return p.User != nil
+}
+
+func (p *UpdateProfileResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateProfileResp(%+v)", *p)
+}
+
+func (p *UpdateProfileResp) DeepEqual(ano *UpdateProfileResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.User) {
+		return false
+	}
+	return true
+}
+
+func (p *UpdateProfileResp) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *UpdateProfileResp) Field2DeepEqual(src *model.UserInfo) bool {
+
+	if !p.User.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UpdateProfileResp = map[int16]string{
+	1: "base",
+	2: "user",
+}
+
+type ChangePasswordReq struct {
+	OldPassword string `thrift:"oldPassword,1,required" frugal:"1,required,string" json:"oldPassword"`
+	Password    string `thrift:"password,2,required" frugal:"2,required,string" json:"password"`
+}
+
+func NewChangePasswordReq() *ChangePasswordReq {
+	return &ChangePasswordReq{}
+}
+
+func (p *ChangePasswordReq) InitDefault() {
+}
+
+func (p *ChangePasswordReq) GetOldPassword() (v string) {
+	return p.OldPassword
+}
+
+func (p *ChangePasswordReq) GetPassword() (v string) {
+	return p.Password
+}
+func (p *ChangePasswordReq) SetOldPassword(val string) {
+	p.OldPassword = val
+}
+func (p *ChangePasswordReq) SetPassword(val string) {
+	p.Password = val
+}
+
+func (p *ChangePasswordReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ChangePasswordReq(%+v)", *p)
+}
+
+func (p *ChangePasswordReq) DeepEqual(ano *ChangePasswordReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.OldPassword) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Password) {
+		return false
+	}
+	return true
+}
+
+func (p *ChangePasswordReq) Field1DeepEqual(src string) bool {
+
+	if strings.Compare(p.OldPassword, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ChangePasswordReq) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.Password, src) != 0 {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_ChangePasswordReq = map[int16]string{
+	1: "oldPassword",
+	2: "password",
+}
+
+type ChangePasswordResp struct {
+	Base *model.BaseResp `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+}
+
+func NewChangePasswordResp() *ChangePasswordResp {
+	return &ChangePasswordResp{}
+}
+
+func (p *ChangePasswordResp) InitDefault() {
+}
+
+var ChangePasswordResp_Base_DEFAULT *model.BaseResp
+
+func (p *ChangePasswordResp) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return ChangePasswordResp_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *ChangePasswordResp) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+
+func (p *ChangePasswordResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ChangePasswordResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ChangePasswordResp(%+v)", *p)
+}
+
+func (p *ChangePasswordResp) DeepEqual(ano *ChangePasswordResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	return true
+}
+
+func (p *ChangePasswordResp) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_ChangePasswordResp = map[int16]string{
+	1: "base",
+}
+
+type RequestPasswordResetReq struct {
+	Name string `thrift:"name,1,required" frugal:"1,required,string" json:"name"`
+}
+
+func NewRequestPasswordResetReq() *RequestPasswordResetReq {
+	return &RequestPasswordResetReq{}
+}
+
+func (p *RequestPasswordResetReq) InitDefault() {
+}
+
+func (p *RequestPasswordResetReq) GetName() (v string) {
+	return p.Name
+}
+func (p *RequestPasswordResetReq) SetName(val string) {
+	p.Name = val
+}
+
+func (p *RequestPasswordResetReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RequestPasswordResetReq(%+v)", *p)
+}
+
+func (p *RequestPasswordResetReq) DeepEqual(ano *RequestPasswordResetReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Name) {
+		return false
+	}
+	return true
+}
+
+func (p *RequestPasswordResetReq) Field1DeepEqual(src string) bool {
+
+	if strings.Compare(p.Name, src) != 0 {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_RequestPasswordResetReq = map[int16]string{
+	1: "name",
+}
+
+type RequestPasswordResetResp struct {
+	Base *model.BaseResp `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+}
+
+func NewRequestPasswordResetResp() *RequestPasswordResetResp {
+	return &RequestPasswordResetResp{}
+}
+
+func (p *RequestPasswordResetResp) InitDefault() {
+}
+
+var RequestPasswordResetResp_Base_DEFAULT *model.BaseResp
+
+func (p *RequestPasswordResetResp) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return RequestPasswordResetResp_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *RequestPasswordResetResp) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+
+func (p *RequestPasswordResetResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *RequestPasswordResetResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RequestPasswordResetResp(%+v)", *p)
+}
+
+func (p *RequestPasswordResetResp) DeepEqual(ano *RequestPasswordResetResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	return true
+}
+
+func (p *RequestPasswordResetResp) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_RequestPasswordResetResp = map[int16]string{
+	1: "base",
+}
+
+type ResetPasswordReq struct {
+	Name     string `thrift:"name,1,required" frugal:"1,required,string" json:"name"`
+	Code     string `thrift:"code,2,required" frugal:"2,required,string" json:"code"`
+	Password string `thrift:"password,3,required" frugal:"3,required,string" json:"password"`
+}
+
+func NewResetPasswordReq() *ResetPasswordReq {
+	return &ResetPasswordReq{}
+}
+
+func (p *ResetPasswordReq) InitDefault() {
+}
+
+func (p *ResetPasswordReq) GetName() (v string) {
+	return p.Name
+}
+
+func (p *ResetPasswordReq) GetCode() (v string) {
+	return p.Code
+}
+
+func (p *ResetPasswordReq) GetPassword() (v string) {
+	return p.Password
+}
+func (p *ResetPasswordReq) SetName(val string) {
+	p.Name = val
+}
+func (p *ResetPasswordReq) SetCode(val string) {
+	p.Code = val
+}
+func (p *ResetPasswordReq) SetPassword(val string) {
+	p.Password = val
+}
+
+func (p *ResetPasswordReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ResetPasswordReq(%+v)", *p)
+}
+
+func (p *ResetPasswordReq) DeepEqual(ano *ResetPasswordReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Name) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Code) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Password) {
+		return false
+	}
+	return true
+}
+
+func (p *ResetPasswordReq) Field1DeepEqual(src string) bool {
+
+	if strings.Compare(p.Name, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ResetPasswordReq) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.Code, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ResetPasswordReq) Field3DeepEqual(src string) bool {
+
+	if strings.Compare(p.Password, src) != 0 {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_ResetPasswordReq = map[int16]string{
+	1: "name",
+	2: "code",
+	3: "password",
+}
+
+type ResetPasswordResp struct {
+	Base *model.BaseResp `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+}
+
+func NewResetPasswordResp() *ResetPasswordResp {
+	return &ResetPasswordResp{}
+}
+
+func (p *ResetPasswordResp) InitDefault() {
+}
+
+var ResetPasswordResp_Base_DEFAULT *model.BaseResp
+
+func (p *ResetPasswordResp) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return ResetPasswordResp_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *ResetPasswordResp) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+
+func (p *ResetPasswordResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ResetPasswordResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ResetPasswordResp(%+v)", *p)
+}
+
+func (p *ResetPasswordResp) DeepEqual(ano *ResetPasswordResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	return true
+}
+
+func (p *ResetPasswordResp) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_ResetPasswordResp = map[int16]string{
+	1: "base",
+}
+
+type ExportUserDataReq struct {
+}
+
+func NewExportUserDataReq() *ExportUserDataReq {
+	return &ExportUserDataReq{}
+}
+
+func (p *ExportUserDataReq) InitDefault() {
+}
+
+func (p *ExportUserDataReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExportUserDataReq(%+v)", *p)
+}
+
+func (p *ExportUserDataReq) DeepEqual(ano *ExportUserDataReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_ExportUserDataReq = map[int16]string{}
+
+type ExportUserDataResp struct {
+	Base *model.BaseResp `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+	Data string          `thrift:"data,2,required" frugal:"2,required,string" json:"data"`
+}
+
+func NewExportUserDataResp() *ExportUserDataResp {
+	return &ExportUserDataResp{}
+}
+
+func (p *ExportUserDataResp) InitDefault() {
+}
+
+var ExportUserDataResp_Base_DEFAULT *model.BaseResp
+
+func (p *ExportUserDataResp) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return ExportUserDataResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *ExportUserDataResp) GetData() (v string) {
+	return p.Data
+}
+func (p *ExportUserDataResp) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *ExportUserDataResp) SetData(val string) {
+	p.Data = val
+}
+
+func (p *ExportUserDataResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ExportUserDataResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExportUserDataResp(%+v)", *p)
+}
+
+func (p *ExportUserDataResp) DeepEqual(ano *ExportUserDataResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Data) {
+		return false
+	}
+	return true
+}
+
+func (p *ExportUserDataResp) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *ExportUserDataResp) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.Data, src) != 0 {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_ExportUserDataResp = map[int16]string{
+	1: "base",
+	2: "data",
+}
+
+type CloseAccountReq struct {
+	Password string `thrift:"password,1,required" frugal:"1,required,string" json:"password"`
+}
+
+func NewCloseAccountReq() *CloseAccountReq {
+	return &CloseAccountReq{}
+}
+
+func (p *CloseAccountReq) InitDefault() {
+}
+
+func (p *CloseAccountReq) GetPassword() (v string) {
+	return p.Password
+}
+func (p *CloseAccountReq) SetPassword(val string) {
+	p.Password = val
+}
+
+func (p *CloseAccountReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CloseAccountReq(%+v)", *p)
+}
+
+func (p *CloseAccountReq) DeepEqual(ano *CloseAccountReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Password) {
+		return false
+	}
+	return true
+}
+
+func (p *CloseAccountReq) Field1DeepEqual(src string) bool {
+
+	if strings.Compare(p.Password, src) != 0 {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_CloseAccountReq = map[int16]string{
+	1: "password",
+}
+
+type CloseAccountResp struct {
+	Base *model.BaseResp `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+}
+
+func NewCloseAccountResp() *CloseAccountResp {
+	return &CloseAccountResp{}
+}
+
+func (p *CloseAccountResp) InitDefault() {
+}
+
+var CloseAccountResp_Base_DEFAULT *model.BaseResp
+
+func (p *CloseAccountResp) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return CloseAccountResp_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *CloseAccountResp) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+
+func (p *CloseAccountResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *CloseAccountResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CloseAccountResp(%+v)", *p)
+}
+
+func (p *CloseAccountResp) DeepEqual(ano *CloseAccountResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	return true
+}
+
+func (p *CloseAccountResp) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_CloseAccountResp = map[int16]string{
+	1: "base",
+}
+
+type EnrollTOTPReq struct {
+	Password string `thrift:"password,1,required" frugal:"1,required,string" json:"password"`
+}
+
+func NewEnrollTOTPReq() *EnrollTOTPReq {
+	return &EnrollTOTPReq{}
+}
+
+func (p *EnrollTOTPReq) InitDefault() {
+}
+
+func (p *EnrollTOTPReq) GetPassword() (v string) {
+	return p.Password
+}
+func (p *EnrollTOTPReq) SetPassword(val string) {
+	p.Password = val
+}
+
+func (p *EnrollTOTPReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EnrollTOTPReq(%+v)", *p)
+}
+
+func (p *EnrollTOTPReq) DeepEqual(ano *EnrollTOTPReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Password) {
+		return false
+	}
+	return true
+}
+
+func (p *EnrollTOTPReq) Field1DeepEqual(src string) bool {
+
+	if strings.Compare(p.Password, src) != 0 {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_EnrollTOTPReq = map[int16]string{
+	1: "password",
+}
+
+type EnrollTOTPResp struct {
+	Base          *model.BaseResp `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+	Secret        string          `thrift:"secret,2,required" frugal:"2,required,string" json:"secret"`
+	Uri           string          `thrift:"uri,3,required" frugal:"3,required,string" json:"uri"`
+	RecoveryCodes []string        `thrift:"recovery_codes,4,required" frugal:"4,required,list<string>" json:"recovery_codes"`
+}
+
+func NewEnrollTOTPResp() *EnrollTOTPResp {
+	return &EnrollTOTPResp{}
+}
+
+func (p *EnrollTOTPResp) InitDefault() {
+}
+
+var EnrollTOTPResp_Base_DEFAULT *model.BaseResp
+
+func (p *EnrollTOTPResp) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return EnrollTOTPResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *EnrollTOTPResp) GetSecret() (v string) {
+	return p.Secret
+}
+
+func (p *EnrollTOTPResp) GetUri() (v string) {
+	return p.Uri
+}
+
+func (p *EnrollTOTPResp) GetRecoveryCodes() (v []string) {
+	return p.RecoveryCodes
+}
+func (p *EnrollTOTPResp) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *EnrollTOTPResp) SetSecret(val string) {
+	p.Secret = val
+}
+func (p *EnrollTOTPResp) SetUri(val string) {
+	p.Uri = val
+}
+func (p *EnrollTOTPResp) SetRecoveryCodes(val []string) {
+	p.RecoveryCodes = val
+}
+
+func (p *EnrollTOTPResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *EnrollTOTPResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EnrollTOTPResp(%+v)", *p)
+}
+
+func (p *EnrollTOTPResp) DeepEqual(ano *EnrollTOTPResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Secret) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Uri) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.RecoveryCodes) {
+		return false
+	}
+	return true
+}
+
+func (p *EnrollTOTPResp) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *EnrollTOTPResp) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.Secret, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *EnrollTOTPResp) Field3DeepEqual(src string) bool {
+
+	if strings.Compare(p.Uri, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *EnrollTOTPResp) Field4DeepEqual(src []string) bool {
+
+	if len(p.RecoveryCodes) != len(src) {
+		return false
+	}
+	for i, v := range p.RecoveryCodes {
+		_src := src[i]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+var fieldIDToName_EnrollTOTPResp = map[int16]string{
+	1: "base",
+	2: "secret",
+	3: "uri",
+	4: "recovery_codes",
+}
+
+type ConfirmTOTPReq struct {
+	Code string `thrift:"code,1,required" frugal:"1,required,string" json:"code"`
+}
+
+func NewConfirmTOTPReq() *ConfirmTOTPReq {
+	return &ConfirmTOTPReq{}
+}
+
+func (p *ConfirmTOTPReq) InitDefault() {
+}
+
+func (p *ConfirmTOTPReq) GetCode() (v string) {
+	return p.Code
+}
+func (p *ConfirmTOTPReq) SetCode(val string) {
+	p.Code = val
+}
+
+func (p *ConfirmTOTPReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ConfirmTOTPReq(%+v)", *p)
+}
+
+func (p *ConfirmTOTPReq) DeepEqual(ano *ConfirmTOTPReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Code) {
+		return false
+	}
+	return true
+}
+
+func (p *ConfirmTOTPReq) Field1DeepEqual(src string) bool {
+
+	if strings.Compare(p.Code, src) != 0 {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_ConfirmTOTPReq = map[int16]string{
+	1: "code",
+}
+
+type ConfirmTOTPResp struct {
+	Base *model.BaseResp `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+}
+
+func NewConfirmTOTPResp() *ConfirmTOTPResp {
+	return &ConfirmTOTPResp{}
+}
+
+func (p *ConfirmTOTPResp) InitDefault() {
+}
+
+var ConfirmTOTPResp_Base_DEFAULT *model.BaseResp
+
+func (p *ConfirmTOTPResp) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return ConfirmTOTPResp_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *ConfirmTOTPResp) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+
+func (p *ConfirmTOTPResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ConfirmTOTPResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ConfirmTOTPResp(%+v)", *p)
+}
+
+func (p *ConfirmTOTPResp) DeepEqual(ano *ConfirmTOTPResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	return true
+}
+
+func (p *ConfirmTOTPResp) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_ConfirmTOTPResp = map[int16]string{
+	1: "base",
+}
+
+type DisableTOTPReq struct {
+	Password string `thrift:"password,1,required" frugal:"1,required,string" json:"password"`
+	Code     string `thrift:"code,2,required" frugal:"2,required,string" json:"code"`
+}
+
+func NewDisableTOTPReq() *DisableTOTPReq {
+	return &DisableTOTPReq{}
+}
+
+func (p *DisableTOTPReq) InitDefault() {
+}
+
+func (p *DisableTOTPReq) GetPassword() (v string) {
+	return p.Password
+}
+
+func (p *DisableTOTPReq) GetCode() (v string) {
+	return p.Code
+}
+func (p *DisableTOTPReq) SetPassword(val string) {
+	p.Password = val
+}
+func (p *DisableTOTPReq) SetCode(val string) {
+	p.Code = val
+}
+
+func (p *DisableTOTPReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DisableTOTPReq(%+v)", *p)
+}
+
+func (p *DisableTOTPReq) DeepEqual(ano *DisableTOTPReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Password) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Code) {
+		return false
+	}
+	return true
+}
+
+func (p *DisableTOTPReq) Field1DeepEqual(src string) bool {
+
+	if strings.Compare(p.Password, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *DisableTOTPReq) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.Code, src) != 0 {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_DisableTOTPReq = map[int16]string{
+	1: "password",
+	2: "code",
+}
+
+type DisableTOTPResp struct {
+	Base *model.BaseResp `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+}
+
+func NewDisableTOTPResp() *DisableTOTPResp {
+	return &DisableTOTPResp{}
+}
+
+func (p *DisableTOTPResp) InitDefault() {
+}
+
+var DisableTOTPResp_Base_DEFAULT *model.BaseResp
+
+func (p *DisableTOTPResp) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return DisableTOTPResp_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *DisableTOTPResp) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+
+func (p *DisableTOTPResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *DisableTOTPResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DisableTOTPResp(%+v)", *p)
+}
+
+func (p *DisableTOTPResp) DeepEqual(ano *DisableTOTPResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	return true
+}
+
+func (p *DisableTOTPResp) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_DisableTOTPResp = map[int16]string{
+	1: "base",
+}
+
+type CreateApiKeyReq struct {
+	Name   string   `thrift:"name,1,required" frugal:"1,required,string" json:"name"`
+	Scopes []string `thrift:"scopes,2,required" frugal:"2,required,list<string>" json:"scopes"`
+}
+
+func NewCreateApiKeyReq() *CreateApiKeyReq {
+	return &CreateApiKeyReq{}
+}
+
+func (p *CreateApiKeyReq) InitDefault() {
+}
+
+func (p *CreateApiKeyReq) GetName() (v string) {
+	return p.Name
+}
+
+func (p *CreateApiKeyReq) GetScopes() (v []string) {
+	return p.Scopes
+}
+func (p *CreateApiKeyReq) SetName(val string) {
+	p.Name = val
+}
+func (p *CreateApiKeyReq) SetScopes(val []string) {
+	p.Scopes = val
+}
+
+func (p *CreateApiKeyReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateApiKeyReq(%+v)", *p)
+}
+
+func (p *CreateApiKeyReq) DeepEqual(ano *CreateApiKeyReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Name) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Scopes) {
+		return false
+	}
+	return true
+}
+
+func (p *CreateApiKeyReq) Field1DeepEqual(src string) bool {
+
+	if strings.Compare(p.Name, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *CreateApiKeyReq) Field2DeepEqual(src []string) bool {
+
+	if len(p.Scopes) != len(src) {
+		return false
+	}
+	for i, v := range p.Scopes {
+		_src := src[i]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+var fieldIDToName_CreateApiKeyReq = map[int16]string{
+	1: "name",
+	2: "scopes",
+}
+
+type CreateApiKeyResp struct {
+	Base *model.BaseResp   `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+	Key  string            `thrift:"key,2,required" frugal:"2,required,string" json:"key"`
+	Info *model.ApiKeyInfo `thrift:"info,3,required" frugal:"3,required,model.ApiKeyInfo" json:"info"`
+}
+
+func NewCreateApiKeyResp() *CreateApiKeyResp {
+	return &CreateApiKeyResp{}
+}
+
+func (p *CreateApiKeyResp) InitDefault() {
+}
+
+var CreateApiKeyResp_Base_DEFAULT *model.BaseResp
+
+func (p *CreateApiKeyResp) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return CreateApiKeyResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *CreateApiKeyResp) GetKey() (v string) {
+	return p.Key
+}
+
+var CreateApiKeyResp_Info_DEFAULT *model.ApiKeyInfo
+
+func (p *CreateApiKeyResp) GetInfo() (v *model.ApiKeyInfo) {
+	if !p.IsSetInfo() {
+		return CreateApiKeyResp_Info_DEFAULT
+	}
+	return p.Info
+}
+func (p *CreateApiKeyResp) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *CreateApiKeyResp) SetKey(val string) {
+	p.Key = val
+}
+func (p *CreateApiKeyResp) SetInfo(val *model.ApiKeyInfo) {
+	p.Info = val
+}
+
+func (p *CreateApiKeyResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *CreateApiKeyResp) IsSetInfo() bool {
+	return p.Info != nil
+}
+
+func (p *CreateApiKeyResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateApiKeyResp(%+v)", *p)
+}
+
+func (p *CreateApiKeyResp) DeepEqual(ano *CreateApiKeyResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Key) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Info) {
+		return false
+	}
+	return true
+}
+
+func (p *CreateApiKeyResp) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *CreateApiKeyResp) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.Key, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *CreateApiKeyResp) Field3DeepEqual(src *model.ApiKeyInfo) bool {
 
 	if !p.Info.DeepEqual(src) {
 		return false
@@ -1256,66 +5535,6114 @@ func (p *GetUserInfoResp) Field2DeepEqual(src *model.UserInfo) bool {
 	return true
 }
 
-var fieldIDToName_GetUserInfoResp = map[int16]string{
-	1: "base",
-	2: "info",
+var fieldIDToName_CreateApiKeyResp = map[int16]string{
+	1: "base",
+	2: "key",
+	3: "info",
+}
+
+type ListApiKeysReq struct {
+}
+
+func NewListApiKeysReq() *ListApiKeysReq {
+	return &ListApiKeysReq{}
+}
+
+func (p *ListApiKeysReq) InitDefault() {
+}
+
+func (p *ListApiKeysReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListApiKeysReq(%+v)", *p)
+}
+
+func (p *ListApiKeysReq) DeepEqual(ano *ListApiKeysReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_ListApiKeysReq = map[int16]string{}
+
+type ListApiKeysResp struct {
+	Base *model.BaseResp     `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+	Keys []*model.ApiKeyInfo `thrift:"keys,2,required" frugal:"2,required,list<model.ApiKeyInfo>" json:"keys"`
+}
+
+func NewListApiKeysResp() *ListApiKeysResp {
+	return &ListApiKeysResp{}
+}
+
+func (p *ListApiKeysResp) InitDefault() {
+}
+
+var ListApiKeysResp_Base_DEFAULT *model.BaseResp
+
+func (p *ListApiKeysResp) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return ListApiKeysResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *ListApiKeysResp) GetKeys() (v []*model.ApiKeyInfo) {
+	return p.Keys
+}
+func (p *ListApiKeysResp) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *ListApiKeysResp) SetKeys(val []*model.ApiKeyInfo) {
+	p.Keys = val
+}
+
+func (p *ListApiKeysResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ListApiKeysResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListApiKeysResp(%+v)", *p)
+}
+
+func (p *ListApiKeysResp) DeepEqual(ano *ListApiKeysResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Keys) {
+		return false
+	}
+	return true
+}
+
+func (p *ListApiKeysResp) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *ListApiKeysResp) Field2DeepEqual(src []*model.ApiKeyInfo) bool {
+
+	if len(p.Keys) != len(src) {
+		return false
+	}
+	for i, v := range p.Keys {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+
+var fieldIDToName_ListApiKeysResp = map[int16]string{
+	1: "base",
+	2: "keys",
+}
+
+type RevokeApiKeyReq struct {
+	KeyID int64 `thrift:"keyID,1,required" frugal:"1,required,i64" json:"keyID"`
+}
+
+func NewRevokeApiKeyReq() *RevokeApiKeyReq {
+	return &RevokeApiKeyReq{}
+}
+
+func (p *RevokeApiKeyReq) InitDefault() {
+}
+
+func (p *RevokeApiKeyReq) GetKeyID() (v int64) {
+	return p.KeyID
+}
+func (p *RevokeApiKeyReq) SetKeyID(val int64) {
+	p.KeyID = val
+}
+
+func (p *RevokeApiKeyReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RevokeApiKeyReq(%+v)", *p)
+}
+
+func (p *RevokeApiKeyReq) DeepEqual(ano *RevokeApiKeyReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.KeyID) {
+		return false
+	}
+	return true
+}
+
+func (p *RevokeApiKeyReq) Field1DeepEqual(src int64) bool {
+
+	if p.KeyID != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_RevokeApiKeyReq = map[int16]string{
+	1: "keyID",
+}
+
+type RevokeApiKeyResp struct {
+	Base *model.BaseResp `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+}
+
+func NewRevokeApiKeyResp() *RevokeApiKeyResp {
+	return &RevokeApiKeyResp{}
+}
+
+func (p *RevokeApiKeyResp) InitDefault() {
+}
+
+var RevokeApiKeyResp_Base_DEFAULT *model.BaseResp
+
+func (p *RevokeApiKeyResp) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return RevokeApiKeyResp_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *RevokeApiKeyResp) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+
+func (p *RevokeApiKeyResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *RevokeApiKeyResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RevokeApiKeyResp(%+v)", *p)
+}
+
+func (p *RevokeApiKeyResp) DeepEqual(ano *RevokeApiKeyResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	return true
+}
+
+func (p *RevokeApiKeyResp) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_RevokeApiKeyResp = map[int16]string{
+	1: "base",
+}
+
+type VerifyApiKeyReq struct {
+	Key string `thrift:"key,1,required" frugal:"1,required,string" json:"key"`
+}
+
+func NewVerifyApiKeyReq() *VerifyApiKeyReq {
+	return &VerifyApiKeyReq{}
+}
+
+func (p *VerifyApiKeyReq) InitDefault() {
+}
+
+func (p *VerifyApiKeyReq) GetKey() (v string) {
+	return p.Key
+}
+func (p *VerifyApiKeyReq) SetKey(val string) {
+	p.Key = val
+}
+
+func (p *VerifyApiKeyReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VerifyApiKeyReq(%+v)", *p)
+}
+
+func (p *VerifyApiKeyReq) DeepEqual(ano *VerifyApiKeyReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Key) {
+		return false
+	}
+	return true
+}
+
+func (p *VerifyApiKeyReq) Field1DeepEqual(src string) bool {
+
+	if strings.Compare(p.Key, src) != 0 {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VerifyApiKeyReq = map[int16]string{
+	1: "key",
+}
+
+type VerifyApiKeyResp struct {
+	Base   *model.BaseResp `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+	Uid    int64           `thrift:"uid,2,required" frugal:"2,required,i64" json:"uid"`
+	Role   int64           `thrift:"role,3,required" frugal:"3,required,i64" json:"role"`
+	Scopes []string        `thrift:"scopes,4,required" frugal:"4,required,list<string>" json:"scopes"`
+}
+
+func NewVerifyApiKeyResp() *VerifyApiKeyResp {
+	return &VerifyApiKeyResp{}
+}
+
+func (p *VerifyApiKeyResp) InitDefault() {
+}
+
+var VerifyApiKeyResp_Base_DEFAULT *model.BaseResp
+
+func (p *VerifyApiKeyResp) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return VerifyApiKeyResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *VerifyApiKeyResp) GetUid() (v int64) {
+	return p.Uid
+}
+
+func (p *VerifyApiKeyResp) GetRole() (v int64) {
+	return p.Role
+}
+
+func (p *VerifyApiKeyResp) GetScopes() (v []string) {
+	return p.Scopes
+}
+func (p *VerifyApiKeyResp) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *VerifyApiKeyResp) SetUid(val int64) {
+	p.Uid = val
+}
+func (p *VerifyApiKeyResp) SetRole(val int64) {
+	p.Role = val
+}
+func (p *VerifyApiKeyResp) SetScopes(val []string) {
+	p.Scopes = val
+}
+
+func (p *VerifyApiKeyResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *VerifyApiKeyResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VerifyApiKeyResp(%+v)", *p)
+}
+
+func (p *VerifyApiKeyResp) DeepEqual(ano *VerifyApiKeyResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Uid) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Role) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Scopes) {
+		return false
+	}
+	return true
+}
+
+func (p *VerifyApiKeyResp) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *VerifyApiKeyResp) Field2DeepEqual(src int64) bool {
+
+	if p.Uid != src {
+		return false
+	}
+	return true
+}
+func (p *VerifyApiKeyResp) Field3DeepEqual(src int64) bool {
+
+	if p.Role != src {
+		return false
+	}
+	return true
+}
+func (p *VerifyApiKeyResp) Field4DeepEqual(src []string) bool {
+
+	if len(p.Scopes) != len(src) {
+		return false
+	}
+	for i, v := range p.Scopes {
+		_src := src[i]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+var fieldIDToName_VerifyApiKeyResp = map[int16]string{
+	1: "base",
+	2: "uid",
+	3: "role",
+	4: "scopes",
+}
+
+type ListAuditLogsReq struct {
+	ActorID   *int64  `thrift:"actorID,1,optional" frugal:"1,optional,i64" json:"actorID,omitempty"`
+	Action    *string `thrift:"action,2,optional" frugal:"2,optional,string" json:"action,omitempty"`
+	StartTime *int64  `thrift:"startTime,3,optional" frugal:"3,optional,i64" json:"startTime,omitempty"`
+	EndTime   *int64  `thrift:"endTime,4,optional" frugal:"4,optional,i64" json:"endTime,omitempty"`
+	PageNum   *int64  `thrift:"pageNum,5,optional" frugal:"5,optional,i64" json:"pageNum,omitempty"`
+	PageSize  *int64  `thrift:"pageSize,6,optional" frugal:"6,optional,i64" json:"pageSize,omitempty"`
+}
+
+func NewListAuditLogsReq() *ListAuditLogsReq {
+	return &ListAuditLogsReq{}
+}
+
+func (p *ListAuditLogsReq) InitDefault() {
+}
+
+var ListAuditLogsReq_ActorID_DEFAULT int64
+
+func (p *ListAuditLogsReq) GetActorID() (v int64) {
+	if !p.IsSetActorID() {
+		return ListAuditLogsReq_ActorID_DEFAULT
+	}
+	return *p.ActorID
+}
+
+var ListAuditLogsReq_Action_DEFAULT string
+
+func (p *ListAuditLogsReq) GetAction() (v string) {
+	if !p.IsSetAction() {
+		return ListAuditLogsReq_Action_DEFAULT
+	}
+	return *p.Action
+}
+
+var ListAuditLogsReq_StartTime_DEFAULT int64
+
+func (p *ListAuditLogsReq) GetStartTime() (v int64) {
+	if !p.IsSetStartTime() {
+		return ListAuditLogsReq_StartTime_DEFAULT
+	}
+	return *p.StartTime
+}
+
+var ListAuditLogsReq_EndTime_DEFAULT int64
+
+func (p *ListAuditLogsReq) GetEndTime() (v int64) {
+	if !p.IsSetEndTime() {
+		return ListAuditLogsReq_EndTime_DEFAULT
+	}
+	return *p.EndTime
+}
+
+var ListAuditLogsReq_PageNum_DEFAULT int64
+
+func (p *ListAuditLogsReq) GetPageNum() (v int64) {
+	if !p.IsSetPageNum() {
+		return ListAuditLogsReq_PageNum_DEFAULT
+	}
+	return *p.PageNum
+}
+
+var ListAuditLogsReq_PageSize_DEFAULT int64
+
+func (p *ListAuditLogsReq) GetPageSize() (v int64) {
+	if !p.IsSetPageSize() {
+		return ListAuditLogsReq_PageSize_DEFAULT
+	}
+	return *p.PageSize
+}
+func (p *ListAuditLogsReq) SetActorID(val *int64) {
+	p.ActorID = val
+}
+func (p *ListAuditLogsReq) SetAction(val *string) {
+	p.Action = val
+}
+func (p *ListAuditLogsReq) SetStartTime(val *int64) {
+	p.StartTime = val
+}
+func (p *ListAuditLogsReq) SetEndTime(val *int64) {
+	p.EndTime = val
+}
+func (p *ListAuditLogsReq) SetPageNum(val *int64) {
+	p.PageNum = val
+}
+func (p *ListAuditLogsReq) SetPageSize(val *int64) {
+	p.PageSize = val
+}
+
+func (p *ListAuditLogsReq) IsSetActorID() bool {
+	return p.ActorID != nil
+}
+
+func (p *ListAuditLogsReq) IsSetAction() bool {
+	return p.Action != nil
+}
+
+func (p *ListAuditLogsReq) IsSetStartTime() bool {
+	return p.StartTime != nil
+}
+
+func (p *ListAuditLogsReq) IsSetEndTime() bool {
+	return p.EndTime != nil
+}
+
+func (p *ListAuditLogsReq) IsSetPageNum() bool {
+	return p.PageNum != nil
+}
+
+func (p *ListAuditLogsReq) IsSetPageSize() bool {
+	return p.PageSize != nil
+}
+
+func (p *ListAuditLogsReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListAuditLogsReq(%+v)", *p)
+}
+
+func (p *ListAuditLogsReq) DeepEqual(ano *ListAuditLogsReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.ActorID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Action) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.StartTime) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.EndTime) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.PageNum) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.PageSize) {
+		return false
+	}
+	return true
+}
+
+func (p *ListAuditLogsReq) Field1DeepEqual(src *int64) bool {
+
+	if p.ActorID == src {
+		return true
+	} else if p.ActorID == nil || src == nil {
+		return false
+	}
+	if *p.ActorID != *src {
+		return false
+	}
+	return true
+}
+func (p *ListAuditLogsReq) Field2DeepEqual(src *string) bool {
+
+	if p.Action == src {
+		return true
+	} else if p.Action == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Action, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ListAuditLogsReq) Field3DeepEqual(src *int64) bool {
+
+	if p.StartTime == src {
+		return true
+	} else if p.StartTime == nil || src == nil {
+		return false
+	}
+	if *p.StartTime != *src {
+		return false
+	}
+	return true
+}
+func (p *ListAuditLogsReq) Field4DeepEqual(src *int64) bool {
+
+	if p.EndTime == src {
+		return true
+	} else if p.EndTime == nil || src == nil {
+		return false
+	}
+	if *p.EndTime != *src {
+		return false
+	}
+	return true
+}
+func (p *ListAuditLogsReq) Field5DeepEqual(src *int64) bool {
+
+	if p.PageNum == src {
+		return true
+	} else if p.PageNum == nil || src == nil {
+		return false
+	}
+	if *p.PageNum != *src {
+		return false
+	}
+	return true
+}
+func (p *ListAuditLogsReq) Field6DeepEqual(src *int64) bool {
+
+	if p.PageSize == src {
+		return true
+	} else if p.PageSize == nil || src == nil {
+		return false
+	}
+	if *p.PageSize != *src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_ListAuditLogsReq = map[int16]string{
+	1: "actorID",
+	2: "action",
+	3: "startTime",
+	4: "endTime",
+	5: "pageNum",
+	6: "pageSize",
+}
+
+type ListAuditLogsResp struct {
+	Base  *model.BaseResp       `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+	Logs  []*model.AuditLogInfo `thrift:"logs,2,required" frugal:"2,required,list<model.AuditLogInfo>" json:"logs"`
+	Total int64                 `thrift:"total,3,required" frugal:"3,required,i64" json:"total"`
+}
+
+func NewListAuditLogsResp() *ListAuditLogsResp {
+	return &ListAuditLogsResp{}
+}
+
+func (p *ListAuditLogsResp) InitDefault() {
+}
+
+var ListAuditLogsResp_Base_DEFAULT *model.BaseResp
+
+func (p *ListAuditLogsResp) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return ListAuditLogsResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *ListAuditLogsResp) GetLogs() (v []*model.AuditLogInfo) {
+	return p.Logs
+}
+
+func (p *ListAuditLogsResp) GetTotal() (v int64) {
+	return p.Total
+}
+func (p *ListAuditLogsResp) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *ListAuditLogsResp) SetLogs(val []*model.AuditLogInfo) {
+	p.Logs = val
+}
+func (p *ListAuditLogsResp) SetTotal(val int64) {
+	p.Total = val
+}
+
+func (p *ListAuditLogsResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ListAuditLogsResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListAuditLogsResp(%+v)", *p)
+}
+
+func (p *ListAuditLogsResp) DeepEqual(ano *ListAuditLogsResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Logs) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Total) {
+		return false
+	}
+	return true
+}
+
+func (p *ListAuditLogsResp) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *ListAuditLogsResp) Field2DeepEqual(src []*model.AuditLogInfo) bool {
+
+	if len(p.Logs) != len(src) {
+		return false
+	}
+	for i, v := range p.Logs {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *ListAuditLogsResp) Field3DeepEqual(src int64) bool {
+
+	if p.Total != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_ListAuditLogsResp = map[int16]string{
+	1: "base",
+	2: "logs",
+	3: "total",
+}
+
+type CreateWebhookEndpointReq struct {
+	ShopID     int64    `thrift:"shopID,1,required" frugal:"1,required,i64" json:"shopID"`
+	Url        string   `thrift:"url,2,required" frugal:"2,required,string" json:"url"`
+	EventTypes []string `thrift:"eventTypes,3,required" frugal:"3,required,list<string>" json:"eventTypes"`
+}
+
+func NewCreateWebhookEndpointReq() *CreateWebhookEndpointReq {
+	return &CreateWebhookEndpointReq{}
+}
+
+func (p *CreateWebhookEndpointReq) InitDefault() {
+}
+
+func (p *CreateWebhookEndpointReq) GetShopID() (v int64) {
+	return p.ShopID
+}
+
+func (p *CreateWebhookEndpointReq) GetUrl() (v string) {
+	return p.Url
+}
+
+func (p *CreateWebhookEndpointReq) GetEventTypes() (v []string) {
+	return p.EventTypes
+}
+func (p *CreateWebhookEndpointReq) SetShopID(val int64) {
+	p.ShopID = val
+}
+func (p *CreateWebhookEndpointReq) SetUrl(val string) {
+	p.Url = val
+}
+func (p *CreateWebhookEndpointReq) SetEventTypes(val []string) {
+	p.EventTypes = val
+}
+
+func (p *CreateWebhookEndpointReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateWebhookEndpointReq(%+v)", *p)
+}
+
+func (p *CreateWebhookEndpointReq) DeepEqual(ano *CreateWebhookEndpointReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.ShopID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Url) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.EventTypes) {
+		return false
+	}
+	return true
+}
+
+func (p *CreateWebhookEndpointReq) Field1DeepEqual(src int64) bool {
+
+	if p.ShopID != src {
+		return false
+	}
+	return true
+}
+func (p *CreateWebhookEndpointReq) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.Url, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *CreateWebhookEndpointReq) Field3DeepEqual(src []string) bool {
+
+	if len(p.EventTypes) != len(src) {
+		return false
+	}
+	for i, v := range p.EventTypes {
+		_src := src[i]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+var fieldIDToName_CreateWebhookEndpointReq = map[int16]string{
+	1: "shopID",
+	2: "url",
+	3: "eventTypes",
+}
+
+type CreateWebhookEndpointResp struct {
+	Base     *model.BaseResp            `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+	Secret   string                     `thrift:"secret,2,required" frugal:"2,required,string" json:"secret"`
+	Endpoint *model.WebhookEndpointInfo `thrift:"endpoint,3,required" frugal:"3,required,model.WebhookEndpointInfo" json:"endpoint"`
+}
+
+func NewCreateWebhookEndpointResp() *CreateWebhookEndpointResp {
+	return &CreateWebhookEndpointResp{}
+}
+
+func (p *CreateWebhookEndpointResp) InitDefault() {
+}
+
+var CreateWebhookEndpointResp_Base_DEFAULT *model.BaseResp
+
+func (p *CreateWebhookEndpointResp) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return CreateWebhookEndpointResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *CreateWebhookEndpointResp) GetSecret() (v string) {
+	return p.Secret
+}
+
+var CreateWebhookEndpointResp_Endpoint_DEFAULT *model.WebhookEndpointInfo
+
+func (p *CreateWebhookEndpointResp) GetEndpoint() (v *model.WebhookEndpointInfo) {
+	if !p.IsSetEndpoint() {
+		return CreateWebhookEndpointResp_Endpoint_DEFAULT
+	}
+	return p.Endpoint
+}
+func (p *CreateWebhookEndpointResp) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *CreateWebhookEndpointResp) SetSecret(val string) {
+	p.Secret = val
+}
+func (p *CreateWebhookEndpointResp) SetEndpoint(val *model.WebhookEndpointInfo) {
+	p.Endpoint = val
+}
+
+func (p *CreateWebhookEndpointResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *CreateWebhookEndpointResp) IsSetEndpoint() bool {
+	return p.Endpoint != nil
+}
+
+func (p *CreateWebhookEndpointResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateWebhookEndpointResp(%+v)", *p)
+}
+
+func (p *CreateWebhookEndpointResp) DeepEqual(ano *CreateWebhookEndpointResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Secret) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Endpoint) {
+		return false
+	}
+	return true
+}
+
+func (p *CreateWebhookEndpointResp) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *CreateWebhookEndpointResp) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.Secret, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *CreateWebhookEndpointResp) Field3DeepEqual(src *model.WebhookEndpointInfo) bool {
+
+	if !p.Endpoint.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_CreateWebhookEndpointResp = map[int16]string{
+	1: "base",
+	2: "secret",
+	3: "endpoint",
+}
+
+type ListWebhookEndpointsReq struct {
+	ShopID int64 `thrift:"shopID,1,required" frugal:"1,required,i64" json:"shopID"`
+}
+
+func NewListWebhookEndpointsReq() *ListWebhookEndpointsReq {
+	return &ListWebhookEndpointsReq{}
+}
+
+func (p *ListWebhookEndpointsReq) InitDefault() {
+}
+
+func (p *ListWebhookEndpointsReq) GetShopID() (v int64) {
+	return p.ShopID
+}
+func (p *ListWebhookEndpointsReq) SetShopID(val int64) {
+	p.ShopID = val
+}
+
+func (p *ListWebhookEndpointsReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListWebhookEndpointsReq(%+v)", *p)
+}
+
+func (p *ListWebhookEndpointsReq) DeepEqual(ano *ListWebhookEndpointsReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.ShopID) {
+		return false
+	}
+	return true
+}
+
+func (p *ListWebhookEndpointsReq) Field1DeepEqual(src int64) bool {
+
+	if p.ShopID != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_ListWebhookEndpointsReq = map[int16]string{
+	1: "shopID",
+}
+
+type ListWebhookEndpointsResp struct {
+	Base      *model.BaseResp              `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+	Endpoints []*model.WebhookEndpointInfo `thrift:"endpoints,2,required" frugal:"2,required,list<model.WebhookEndpointInfo>" json:"endpoints"`
+}
+
+func NewListWebhookEndpointsResp() *ListWebhookEndpointsResp {
+	return &ListWebhookEndpointsResp{}
+}
+
+func (p *ListWebhookEndpointsResp) InitDefault() {
+}
+
+var ListWebhookEndpointsResp_Base_DEFAULT *model.BaseResp
+
+func (p *ListWebhookEndpointsResp) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return ListWebhookEndpointsResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *ListWebhookEndpointsResp) GetEndpoints() (v []*model.WebhookEndpointInfo) {
+	return p.Endpoints
+}
+func (p *ListWebhookEndpointsResp) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *ListWebhookEndpointsResp) SetEndpoints(val []*model.WebhookEndpointInfo) {
+	p.Endpoints = val
+}
+
+func (p *ListWebhookEndpointsResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ListWebhookEndpointsResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListWebhookEndpointsResp(%+v)", *p)
+}
+
+func (p *ListWebhookEndpointsResp) DeepEqual(ano *ListWebhookEndpointsResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Endpoints) {
+		return false
+	}
+	return true
+}
+
+func (p *ListWebhookEndpointsResp) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *ListWebhookEndpointsResp) Field2DeepEqual(src []*model.WebhookEndpointInfo) bool {
+
+	if len(p.Endpoints) != len(src) {
+		return false
+	}
+	for i, v := range p.Endpoints {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+
+var fieldIDToName_ListWebhookEndpointsResp = map[int16]string{
+	1: "base",
+	2: "endpoints",
+}
+
+type UpdateWebhookEndpointReq struct {
+	EndpointID int64    `thrift:"endpointID,1,required" frugal:"1,required,i64" json:"endpointID"`
+	Url        string   `thrift:"url,2,required" frugal:"2,required,string" json:"url"`
+	EventTypes []string `thrift:"eventTypes,3,required" frugal:"3,required,list<string>" json:"eventTypes"`
+	Enabled    bool     `thrift:"enabled,4,required" frugal:"4,required,bool" json:"enabled"`
+}
+
+func NewUpdateWebhookEndpointReq() *UpdateWebhookEndpointReq {
+	return &UpdateWebhookEndpointReq{}
+}
+
+func (p *UpdateWebhookEndpointReq) InitDefault() {
+}
+
+func (p *UpdateWebhookEndpointReq) GetEndpointID() (v int64) {
+	return p.EndpointID
+}
+
+func (p *UpdateWebhookEndpointReq) GetUrl() (v string) {
+	return p.Url
+}
+
+func (p *UpdateWebhookEndpointReq) GetEventTypes() (v []string) {
+	return p.EventTypes
+}
+
+func (p *UpdateWebhookEndpointReq) GetEnabled() (v bool) {
+	return p.Enabled
+}
+func (p *UpdateWebhookEndpointReq) SetEndpointID(val int64) {
+	p.EndpointID = val
+}
+func (p *UpdateWebhookEndpointReq) SetUrl(val string) {
+	p.Url = val
+}
+func (p *UpdateWebhookEndpointReq) SetEventTypes(val []string) {
+	p.EventTypes = val
+}
+func (p *UpdateWebhookEndpointReq) SetEnabled(val bool) {
+	p.Enabled = val
+}
+
+func (p *UpdateWebhookEndpointReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateWebhookEndpointReq(%+v)", *p)
+}
+
+func (p *UpdateWebhookEndpointReq) DeepEqual(ano *UpdateWebhookEndpointReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.EndpointID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Url) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.EventTypes) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Enabled) {
+		return false
+	}
+	return true
+}
+
+func (p *UpdateWebhookEndpointReq) Field1DeepEqual(src int64) bool {
+
+	if p.EndpointID != src {
+		return false
+	}
+	return true
+}
+func (p *UpdateWebhookEndpointReq) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.Url, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *UpdateWebhookEndpointReq) Field3DeepEqual(src []string) bool {
+
+	if len(p.EventTypes) != len(src) {
+		return false
+	}
+	for i, v := range p.EventTypes {
+		_src := src[i]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
+	}
+	return true
+}
+func (p *UpdateWebhookEndpointReq) Field4DeepEqual(src bool) bool {
+
+	if p.Enabled != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UpdateWebhookEndpointReq = map[int16]string{
+	1: "endpointID",
+	2: "url",
+	3: "eventTypes",
+	4: "enabled",
+}
+
+type UpdateWebhookEndpointResp struct {
+	Base *model.BaseResp `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+}
+
+func NewUpdateWebhookEndpointResp() *UpdateWebhookEndpointResp {
+	return &UpdateWebhookEndpointResp{}
+}
+
+func (p *UpdateWebhookEndpointResp) InitDefault() {
+}
+
+var UpdateWebhookEndpointResp_Base_DEFAULT *model.BaseResp
+
+func (p *UpdateWebhookEndpointResp) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return UpdateWebhookEndpointResp_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *UpdateWebhookEndpointResp) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+
+func (p *UpdateWebhookEndpointResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *UpdateWebhookEndpointResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateWebhookEndpointResp(%+v)", *p)
+}
+
+func (p *UpdateWebhookEndpointResp) DeepEqual(ano *UpdateWebhookEndpointResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	return true
+}
+
+func (p *UpdateWebhookEndpointResp) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UpdateWebhookEndpointResp = map[int16]string{
+	1: "base",
+}
+
+type DeleteWebhookEndpointReq struct {
+	EndpointID int64 `thrift:"endpointID,1,required" frugal:"1,required,i64" json:"endpointID"`
+}
+
+func NewDeleteWebhookEndpointReq() *DeleteWebhookEndpointReq {
+	return &DeleteWebhookEndpointReq{}
+}
+
+func (p *DeleteWebhookEndpointReq) InitDefault() {
+}
+
+func (p *DeleteWebhookEndpointReq) GetEndpointID() (v int64) {
+	return p.EndpointID
+}
+func (p *DeleteWebhookEndpointReq) SetEndpointID(val int64) {
+	p.EndpointID = val
+}
+
+func (p *DeleteWebhookEndpointReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteWebhookEndpointReq(%+v)", *p)
+}
+
+func (p *DeleteWebhookEndpointReq) DeepEqual(ano *DeleteWebhookEndpointReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.EndpointID) {
+		return false
+	}
+	return true
+}
+
+func (p *DeleteWebhookEndpointReq) Field1DeepEqual(src int64) bool {
+
+	if p.EndpointID != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_DeleteWebhookEndpointReq = map[int16]string{
+	1: "endpointID",
+}
+
+type DeleteWebhookEndpointResp struct {
+	Base *model.BaseResp `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+}
+
+func NewDeleteWebhookEndpointResp() *DeleteWebhookEndpointResp {
+	return &DeleteWebhookEndpointResp{}
+}
+
+func (p *DeleteWebhookEndpointResp) InitDefault() {
+}
+
+var DeleteWebhookEndpointResp_Base_DEFAULT *model.BaseResp
+
+func (p *DeleteWebhookEndpointResp) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return DeleteWebhookEndpointResp_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *DeleteWebhookEndpointResp) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+
+func (p *DeleteWebhookEndpointResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *DeleteWebhookEndpointResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteWebhookEndpointResp(%+v)", *p)
+}
+
+func (p *DeleteWebhookEndpointResp) DeepEqual(ano *DeleteWebhookEndpointResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	return true
+}
+
+func (p *DeleteWebhookEndpointResp) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_DeleteWebhookEndpointResp = map[int16]string{
+	1: "base",
+}
+
+type ListWebhookDeliveriesReq struct {
+	EndpointID int64  `thrift:"endpointID,1,required" frugal:"1,required,i64" json:"endpointID"`
+	PageNum    *int64 `thrift:"pageNum,2,optional" frugal:"2,optional,i64" json:"pageNum,omitempty"`
+	PageSize   *int64 `thrift:"pageSize,3,optional" frugal:"3,optional,i64" json:"pageSize,omitempty"`
+}
+
+func NewListWebhookDeliveriesReq() *ListWebhookDeliveriesReq {
+	return &ListWebhookDeliveriesReq{}
+}
+
+func (p *ListWebhookDeliveriesReq) InitDefault() {
+}
+
+func (p *ListWebhookDeliveriesReq) GetEndpointID() (v int64) {
+	return p.EndpointID
+}
+
+var ListWebhookDeliveriesReq_PageNum_DEFAULT int64
+
+func (p *ListWebhookDeliveriesReq) GetPageNum() (v int64) {
+	if !p.IsSetPageNum() {
+		return ListWebhookDeliveriesReq_PageNum_DEFAULT
+	}
+	return *p.PageNum
+}
+
+var ListWebhookDeliveriesReq_PageSize_DEFAULT int64
+
+func (p *ListWebhookDeliveriesReq) GetPageSize() (v int64) {
+	if !p.IsSetPageSize() {
+		return ListWebhookDeliveriesReq_PageSize_DEFAULT
+	}
+	return *p.PageSize
+}
+func (p *ListWebhookDeliveriesReq) SetEndpointID(val int64) {
+	p.EndpointID = val
+}
+func (p *ListWebhookDeliveriesReq) SetPageNum(val *int64) {
+	p.PageNum = val
+}
+func (p *ListWebhookDeliveriesReq) SetPageSize(val *int64) {
+	p.PageSize = val
+}
+
+func (p *ListWebhookDeliveriesReq) IsSetPageNum() bool {
+	return p.PageNum != nil
+}
+
+func (p *ListWebhookDeliveriesReq) IsSetPageSize() bool {
+	return p.PageSize != nil
+}
+
+func (p *ListWebhookDeliveriesReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListWebhookDeliveriesReq(%+v)", *p)
+}
+
+func (p *ListWebhookDeliveriesReq) DeepEqual(ano *ListWebhookDeliveriesReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.EndpointID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.PageNum) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.PageSize) {
+		return false
+	}
+	return true
+}
+
+func (p *ListWebhookDeliveriesReq) Field1DeepEqual(src int64) bool {
+
+	if p.EndpointID != src {
+		return false
+	}
+	return true
+}
+func (p *ListWebhookDeliveriesReq) Field2DeepEqual(src *int64) bool {
+
+	if p.PageNum == src {
+		return true
+	} else if p.PageNum == nil || src == nil {
+		return false
+	}
+	if *p.PageNum != *src {
+		return false
+	}
+	return true
+}
+func (p *ListWebhookDeliveriesReq) Field3DeepEqual(src *int64) bool {
+
+	if p.PageSize == src {
+		return true
+	} else if p.PageSize == nil || src == nil {
+		return false
+	}
+	if *p.PageSize != *src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_ListWebhookDeliveriesReq = map[int16]string{
+	1: "endpointID",
+	2: "pageNum",
+	3: "pageSize",
+}
+
+type ListWebhookDeliveriesResp struct {
+	Base       *model.BaseResp              `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+	Deliveries []*model.WebhookDeliveryInfo `thrift:"deliveries,2,required" frugal:"2,required,list<model.WebhookDeliveryInfo>" json:"deliveries"`
+	Total      int64                        `thrift:"total,3,required" frugal:"3,required,i64" json:"total"`
+}
+
+func NewListWebhookDeliveriesResp() *ListWebhookDeliveriesResp {
+	return &ListWebhookDeliveriesResp{}
+}
+
+func (p *ListWebhookDeliveriesResp) InitDefault() {
+}
+
+var ListWebhookDeliveriesResp_Base_DEFAULT *model.BaseResp
+
+func (p *ListWebhookDeliveriesResp) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return ListWebhookDeliveriesResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *ListWebhookDeliveriesResp) GetDeliveries() (v []*model.WebhookDeliveryInfo) {
+	return p.Deliveries
+}
+
+func (p *ListWebhookDeliveriesResp) GetTotal() (v int64) {
+	return p.Total
+}
+func (p *ListWebhookDeliveriesResp) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *ListWebhookDeliveriesResp) SetDeliveries(val []*model.WebhookDeliveryInfo) {
+	p.Deliveries = val
+}
+func (p *ListWebhookDeliveriesResp) SetTotal(val int64) {
+	p.Total = val
+}
+
+func (p *ListWebhookDeliveriesResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ListWebhookDeliveriesResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListWebhookDeliveriesResp(%+v)", *p)
+}
+
+func (p *ListWebhookDeliveriesResp) DeepEqual(ano *ListWebhookDeliveriesResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Deliveries) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Total) {
+		return false
+	}
+	return true
+}
+
+func (p *ListWebhookDeliveriesResp) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *ListWebhookDeliveriesResp) Field2DeepEqual(src []*model.WebhookDeliveryInfo) bool {
+
+	if len(p.Deliveries) != len(src) {
+		return false
+	}
+	for i, v := range p.Deliveries {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *ListWebhookDeliveriesResp) Field3DeepEqual(src int64) bool {
+
+	if p.Total != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_ListWebhookDeliveriesResp = map[int16]string{
+	1: "base",
+	2: "deliveries",
+	3: "total",
+}
+
+type RedeliverWebhookReq struct {
+	DeliveryID int64 `thrift:"deliveryID,1,required" frugal:"1,required,i64" json:"deliveryID"`
+}
+
+func NewRedeliverWebhookReq() *RedeliverWebhookReq {
+	return &RedeliverWebhookReq{}
+}
+
+func (p *RedeliverWebhookReq) InitDefault() {
+}
+
+func (p *RedeliverWebhookReq) GetDeliveryID() (v int64) {
+	return p.DeliveryID
+}
+func (p *RedeliverWebhookReq) SetDeliveryID(val int64) {
+	p.DeliveryID = val
+}
+
+func (p *RedeliverWebhookReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RedeliverWebhookReq(%+v)", *p)
+}
+
+func (p *RedeliverWebhookReq) DeepEqual(ano *RedeliverWebhookReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.DeliveryID) {
+		return false
+	}
+	return true
+}
+
+func (p *RedeliverWebhookReq) Field1DeepEqual(src int64) bool {
+
+	if p.DeliveryID != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_RedeliverWebhookReq = map[int16]string{
+	1: "deliveryID",
+}
+
+type RedeliverWebhookResp struct {
+	Base     *model.BaseResp            `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+	Delivery *model.WebhookDeliveryInfo `thrift:"delivery,2,required" frugal:"2,required,model.WebhookDeliveryInfo" json:"delivery"`
+}
+
+func NewRedeliverWebhookResp() *RedeliverWebhookResp {
+	return &RedeliverWebhookResp{}
+}
+
+func (p *RedeliverWebhookResp) InitDefault() {
+}
+
+var RedeliverWebhookResp_Base_DEFAULT *model.BaseResp
+
+func (p *RedeliverWebhookResp) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return RedeliverWebhookResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var RedeliverWebhookResp_Delivery_DEFAULT *model.WebhookDeliveryInfo
+
+func (p *RedeliverWebhookResp) GetDelivery() (v *model.WebhookDeliveryInfo) {
+	if !p.IsSetDelivery() {
+		return RedeliverWebhookResp_Delivery_DEFAULT
+	}
+	return p.Delivery
+}
+func (p *RedeliverWebhookResp) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *RedeliverWebhookResp) SetDelivery(val *model.WebhookDeliveryInfo) {
+	p.Delivery = val
+}
+
+func (p *RedeliverWebhookResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *RedeliverWebhookResp) IsSetDelivery() bool {
+	return p.Delivery != nil
+}
+
+func (p *RedeliverWebhookResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RedeliverWebhookResp(%+v)", *p)
+}
+
+func (p *RedeliverWebhookResp) DeepEqual(ano *RedeliverWebhookResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Delivery) {
+		return false
+	}
+	return true
+}
+
+func (p *RedeliverWebhookResp) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *RedeliverWebhookResp) Field2DeepEqual(src *model.WebhookDeliveryInfo) bool {
+
+	if !p.Delivery.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_RedeliverWebhookResp = map[int16]string{
+	1: "base",
+	2: "delivery",
+}
+
+type UserService interface {
+	Register(ctx context.Context, req *RegisterRequest) (r *RegisterResponse, err error)
+
+	Login(ctx context.Context, req *LoginRequest) (r *LoginResponse, err error)
+
+	RefreshToken(ctx context.Context, req *RefreshTokenReq) (r *RefreshTokenResp, err error)
+
+	GetAddress(ctx context.Context, req *GetAddressRequest) (r *GetAddressResponse, err error)
+
+	AddAddress(ctx context.Context, req *AddAddressRequest) (r *AddAddressResponse, err error)
+
+	ListAddress(ctx context.Context, req *ListAddressReq) (r *ListAddressResp, err error)
+
+	UpdateAddress(ctx context.Context, req *UpdateAddressReq) (r *UpdateAddressResp, err error)
+
+	DeleteAddress(ctx context.Context, req *DeleteAddressReq) (r *DeleteAddressResp, err error)
+
+	SetDefaultAddress(ctx context.Context, req *SetDefaultAddressReq) (r *SetDefaultAddressResp, err error)
+
+	BanUser(ctx context.Context, req *BanUserReq) (r *BanUserResp, err error)
+
+	LiftBandUser(ctx context.Context, req *LiftBanUserReq) (r *LiftBanUserResp, err error)
+
+	ListBanHistory(ctx context.Context, req *ListBanHistoryReq) (r *ListBanHistoryResp, err error)
+
+	UnlockUser(ctx context.Context, req *UnlockUserReq) (r *UnlockUserResp, err error)
+
+	Logout(ctx context.Context, req *LogoutReq) (r *LogoutResp, err error)
+
+	ListSessions(ctx context.Context, req *ListSessionsReq) (r *ListSessionsResp, err error)
+
+	RevokeSession(ctx context.Context, req *RevokeSessionReq) (r *RevokeSessionResp, err error)
+
+	RevokeAllSessions(ctx context.Context, req *RevokeAllSessionsReq) (r *RevokeAllSessionsResp, err error)
+
+	SetAdministrator(ctx context.Context, req *SetAdministratorReq) (r *SetAdministratorResp, err error)
+
+	GetUserInfo(ctx context.Context, req *GetUserInfoReq) (r *GetUserInfoResp, err error)
+
+	CreateShop(ctx context.Context, req *CreateShopReq) (r *CreateShopResp, err error)
+
+	UpdateShop(ctx context.Context, req *UpdateShopReq) (r *UpdateShopResp, err error)
+
+	CloseShop(ctx context.Context, req *CloseShopReq) (r *CloseShopResp, err error)
+
+	GetShop(ctx context.Context, req *GetShopReq) (r *GetShopResp, err error)
+
+	GetShops(ctx context.Context, req *GetShopsReq) (r *GetShopsResp, err error)
+
+	AddShopStaff(ctx context.Context, req *AddShopStaffReq) (r *AddShopStaffResp, err error)
+
+	RemoveShopStaff(ctx context.Context, req *RemoveShopStaffReq) (r *RemoveShopStaffResp, err error)
+
+	ListShopStaff(ctx context.Context, req *ListShopStaffReq) (r *ListShopStaffResp, err error)
+
+	IsShopStaff(ctx context.Context, req *IsShopStaffReq) (r *IsShopStaffResp, err error)
+
+	UpdateProfile(ctx context.Context, req *UpdateProfileReq) (r *UpdateProfileResp, err error)
+
+	ChangePassword(ctx context.Context, req *ChangePasswordReq) (r *ChangePasswordResp, err error)
+
+	RequestPasswordReset(ctx context.Context, req *RequestPasswordResetReq) (r *RequestPasswordResetResp, err error)
+
+	ResetPassword(ctx context.Context, req *ResetPasswordReq) (r *ResetPasswordResp, err error)
+
+	ExportUserData(ctx context.Context, req *ExportUserDataReq) (r *ExportUserDataResp, err error)
+
+	CloseAccount(ctx context.Context, req *CloseAccountReq) (r *CloseAccountResp, err error)
+
+	EnrollTOTP(ctx context.Context, req *EnrollTOTPReq) (r *EnrollTOTPResp, err error)
+
+	ConfirmTOTP(ctx context.Context, req *ConfirmTOTPReq) (r *ConfirmTOTPResp, err error)
+
+	DisableTOTP(ctx context.Context, req *DisableTOTPReq) (r *DisableTOTPResp, err error)
+
+	CreateApiKey(ctx context.Context, req *CreateApiKeyReq) (r *CreateApiKeyResp, err error)
+
+	ListApiKeys(ctx context.Context, req *ListApiKeysReq) (r *ListApiKeysResp, err error)
+
+	RevokeApiKey(ctx context.Context, req *RevokeApiKeyReq) (r *RevokeApiKeyResp, err error)
+
+	VerifyApiKey(ctx context.Context, req *VerifyApiKeyReq) (r *VerifyApiKeyResp, err error)
+
+	ListAuditLogs(ctx context.Context, req *ListAuditLogsReq) (r *ListAuditLogsResp, err error)
+
+	CreateWebhookEndpoint(ctx context.Context, req *CreateWebhookEndpointReq) (r *CreateWebhookEndpointResp, err error)
+
+	ListWebhookEndpoints(ctx context.Context, req *ListWebhookEndpointsReq) (r *ListWebhookEndpointsResp, err error)
+
+	UpdateWebhookEndpoint(ctx context.Context, req *UpdateWebhookEndpointReq) (r *UpdateWebhookEndpointResp, err error)
+
+	DeleteWebhookEndpoint(ctx context.Context, req *DeleteWebhookEndpointReq) (r *DeleteWebhookEndpointResp, err error)
+
+	ListWebhookDeliveries(ctx context.Context, req *ListWebhookDeliveriesReq) (r *ListWebhookDeliveriesResp, err error)
+
+	RedeliverWebhook(ctx context.Context, req *RedeliverWebhookReq) (r *RedeliverWebhookResp, err error)
+}
+
+type UserServiceRegisterArgs struct {
+	Req *RegisterRequest `thrift:"req,1" frugal:"1,default,RegisterRequest" json:"req"`
+}
+
+func NewUserServiceRegisterArgs() *UserServiceRegisterArgs {
+	return &UserServiceRegisterArgs{}
+}
+
+func (p *UserServiceRegisterArgs) InitDefault() {
+}
+
+var UserServiceRegisterArgs_Req_DEFAULT *RegisterRequest
+
+func (p *UserServiceRegisterArgs) GetReq() (v *RegisterRequest) {
+	if !p.IsSetReq() {
+		return UserServiceRegisterArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceRegisterArgs) SetReq(val *RegisterRequest) {
+	p.Req = val
+}
+
+func (p *UserServiceRegisterArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceRegisterArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceRegisterArgs(%+v)", *p)
+}
+
+func (p *UserServiceRegisterArgs) DeepEqual(ano *UserServiceRegisterArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceRegisterArgs) Field1DeepEqual(src *RegisterRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceRegisterArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceRegisterResult struct {
+	Success *RegisterResponse `thrift:"success,0,optional" frugal:"0,optional,RegisterResponse" json:"success,omitempty"`
+}
+
+func NewUserServiceRegisterResult() *UserServiceRegisterResult {
+	return &UserServiceRegisterResult{}
+}
+
+func (p *UserServiceRegisterResult) InitDefault() {
+}
+
+var UserServiceRegisterResult_Success_DEFAULT *RegisterResponse
+
+func (p *UserServiceRegisterResult) GetSuccess() (v *RegisterResponse) {
+	if !p.IsSetSuccess() {
+		return UserServiceRegisterResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceRegisterResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RegisterResponse)
+}
+
+func (p *UserServiceRegisterResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceRegisterResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceRegisterResult(%+v)", *p)
+}
+
+func (p *UserServiceRegisterResult) DeepEqual(ano *UserServiceRegisterResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceRegisterResult) Field0DeepEqual(src *RegisterResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceRegisterResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceLoginArgs struct {
+	Req *LoginRequest `thrift:"req,1" frugal:"1,default,LoginRequest" json:"req"`
+}
+
+func NewUserServiceLoginArgs() *UserServiceLoginArgs {
+	return &UserServiceLoginArgs{}
+}
+
+func (p *UserServiceLoginArgs) InitDefault() {
+}
+
+var UserServiceLoginArgs_Req_DEFAULT *LoginRequest
+
+func (p *UserServiceLoginArgs) GetReq() (v *LoginRequest) {
+	if !p.IsSetReq() {
+		return UserServiceLoginArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceLoginArgs) SetReq(val *LoginRequest) {
+	p.Req = val
+}
+
+func (p *UserServiceLoginArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceLoginArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceLoginArgs(%+v)", *p)
+}
+
+func (p *UserServiceLoginArgs) DeepEqual(ano *UserServiceLoginArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceLoginArgs) Field1DeepEqual(src *LoginRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceLoginArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceLoginResult struct {
+	Success *LoginResponse `thrift:"success,0,optional" frugal:"0,optional,LoginResponse" json:"success,omitempty"`
+}
+
+func NewUserServiceLoginResult() *UserServiceLoginResult {
+	return &UserServiceLoginResult{}
+}
+
+func (p *UserServiceLoginResult) InitDefault() {
+}
+
+var UserServiceLoginResult_Success_DEFAULT *LoginResponse
+
+func (p *UserServiceLoginResult) GetSuccess() (v *LoginResponse) {
+	if !p.IsSetSuccess() {
+		return UserServiceLoginResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceLoginResult) SetSuccess(x interface{}) {
+	p.Success = x.(*LoginResponse)
+}
+
+func (p *UserServiceLoginResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceLoginResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceLoginResult(%+v)", *p)
+}
+
+func (p *UserServiceLoginResult) DeepEqual(ano *UserServiceLoginResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceLoginResult) Field0DeepEqual(src *LoginResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceLoginResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceRefreshTokenArgs struct {
+	Req *RefreshTokenReq `thrift:"req,1" frugal:"1,default,RefreshTokenReq" json:"req"`
+}
+
+func NewUserServiceRefreshTokenArgs() *UserServiceRefreshTokenArgs {
+	return &UserServiceRefreshTokenArgs{}
+}
+
+func (p *UserServiceRefreshTokenArgs) InitDefault() {
+}
+
+var UserServiceRefreshTokenArgs_Req_DEFAULT *RefreshTokenReq
+
+func (p *UserServiceRefreshTokenArgs) GetReq() (v *RefreshTokenReq) {
+	if !p.IsSetReq() {
+		return UserServiceRefreshTokenArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceRefreshTokenArgs) SetReq(val *RefreshTokenReq) {
+	p.Req = val
+}
+
+func (p *UserServiceRefreshTokenArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceRefreshTokenArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceRefreshTokenArgs(%+v)", *p)
+}
+
+func (p *UserServiceRefreshTokenArgs) DeepEqual(ano *UserServiceRefreshTokenArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceRefreshTokenArgs) Field1DeepEqual(src *RefreshTokenReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceRefreshTokenArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceRefreshTokenResult struct {
+	Success *RefreshTokenResp `thrift:"success,0,optional" frugal:"0,optional,RefreshTokenResp" json:"success,omitempty"`
+}
+
+func NewUserServiceRefreshTokenResult() *UserServiceRefreshTokenResult {
+	return &UserServiceRefreshTokenResult{}
+}
+
+func (p *UserServiceRefreshTokenResult) InitDefault() {
+}
+
+var UserServiceRefreshTokenResult_Success_DEFAULT *RefreshTokenResp
+
+func (p *UserServiceRefreshTokenResult) GetSuccess() (v *RefreshTokenResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceRefreshTokenResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceRefreshTokenResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RefreshTokenResp)
+}
+
+func (p *UserServiceRefreshTokenResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceRefreshTokenResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceRefreshTokenResult(%+v)", *p)
+}
+
+func (p *UserServiceRefreshTokenResult) DeepEqual(ano *UserServiceRefreshTokenResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceRefreshTokenResult) Field0DeepEqual(src *RefreshTokenResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceRefreshTokenResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceGetAddressArgs struct {
+	Req *GetAddressRequest `thrift:"req,1" frugal:"1,default,GetAddressRequest" json:"req"`
+}
+
+func NewUserServiceGetAddressArgs() *UserServiceGetAddressArgs {
+	return &UserServiceGetAddressArgs{}
+}
+
+func (p *UserServiceGetAddressArgs) InitDefault() {
+}
+
+var UserServiceGetAddressArgs_Req_DEFAULT *GetAddressRequest
+
+func (p *UserServiceGetAddressArgs) GetReq() (v *GetAddressRequest) {
+	if !p.IsSetReq() {
+		return UserServiceGetAddressArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceGetAddressArgs) SetReq(val *GetAddressRequest) {
+	p.Req = val
+}
+
+func (p *UserServiceGetAddressArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceGetAddressArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceGetAddressArgs(%+v)", *p)
+}
+
+func (p *UserServiceGetAddressArgs) DeepEqual(ano *UserServiceGetAddressArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceGetAddressArgs) Field1DeepEqual(src *GetAddressRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceGetAddressArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceGetAddressResult struct {
+	Success *GetAddressResponse `thrift:"success,0,optional" frugal:"0,optional,GetAddressResponse" json:"success,omitempty"`
+}
+
+func NewUserServiceGetAddressResult() *UserServiceGetAddressResult {
+	return &UserServiceGetAddressResult{}
+}
+
+func (p *UserServiceGetAddressResult) InitDefault() {
+}
+
+var UserServiceGetAddressResult_Success_DEFAULT *GetAddressResponse
+
+func (p *UserServiceGetAddressResult) GetSuccess() (v *GetAddressResponse) {
+	if !p.IsSetSuccess() {
+		return UserServiceGetAddressResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceGetAddressResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetAddressResponse)
+}
+
+func (p *UserServiceGetAddressResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceGetAddressResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceGetAddressResult(%+v)", *p)
+}
+
+func (p *UserServiceGetAddressResult) DeepEqual(ano *UserServiceGetAddressResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceGetAddressResult) Field0DeepEqual(src *GetAddressResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceGetAddressResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceAddAddressArgs struct {
+	Req *AddAddressRequest `thrift:"req,1" frugal:"1,default,AddAddressRequest" json:"req"`
+}
+
+func NewUserServiceAddAddressArgs() *UserServiceAddAddressArgs {
+	return &UserServiceAddAddressArgs{}
+}
+
+func (p *UserServiceAddAddressArgs) InitDefault() {
+}
+
+var UserServiceAddAddressArgs_Req_DEFAULT *AddAddressRequest
+
+func (p *UserServiceAddAddressArgs) GetReq() (v *AddAddressRequest) {
+	if !p.IsSetReq() {
+		return UserServiceAddAddressArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceAddAddressArgs) SetReq(val *AddAddressRequest) {
+	p.Req = val
+}
+
+func (p *UserServiceAddAddressArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceAddAddressArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceAddAddressArgs(%+v)", *p)
+}
+
+func (p *UserServiceAddAddressArgs) DeepEqual(ano *UserServiceAddAddressArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceAddAddressArgs) Field1DeepEqual(src *AddAddressRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceAddAddressArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceAddAddressResult struct {
+	Success *AddAddressResponse `thrift:"success,0,optional" frugal:"0,optional,AddAddressResponse" json:"success,omitempty"`
+}
+
+func NewUserServiceAddAddressResult() *UserServiceAddAddressResult {
+	return &UserServiceAddAddressResult{}
+}
+
+func (p *UserServiceAddAddressResult) InitDefault() {
+}
+
+var UserServiceAddAddressResult_Success_DEFAULT *AddAddressResponse
+
+func (p *UserServiceAddAddressResult) GetSuccess() (v *AddAddressResponse) {
+	if !p.IsSetSuccess() {
+		return UserServiceAddAddressResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceAddAddressResult) SetSuccess(x interface{}) {
+	p.Success = x.(*AddAddressResponse)
+}
+
+func (p *UserServiceAddAddressResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceAddAddressResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceAddAddressResult(%+v)", *p)
+}
+
+func (p *UserServiceAddAddressResult) DeepEqual(ano *UserServiceAddAddressResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceAddAddressResult) Field0DeepEqual(src *AddAddressResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceAddAddressResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceListAddressArgs struct {
+	Req *ListAddressReq `thrift:"req,1" frugal:"1,default,ListAddressReq" json:"req"`
+}
+
+func NewUserServiceListAddressArgs() *UserServiceListAddressArgs {
+	return &UserServiceListAddressArgs{}
+}
+
+func (p *UserServiceListAddressArgs) InitDefault() {
+}
+
+var UserServiceListAddressArgs_Req_DEFAULT *ListAddressReq
+
+func (p *UserServiceListAddressArgs) GetReq() (v *ListAddressReq) {
+	if !p.IsSetReq() {
+		return UserServiceListAddressArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceListAddressArgs) SetReq(val *ListAddressReq) {
+	p.Req = val
+}
+
+func (p *UserServiceListAddressArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceListAddressArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceListAddressArgs(%+v)", *p)
+}
+
+func (p *UserServiceListAddressArgs) DeepEqual(ano *UserServiceListAddressArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceListAddressArgs) Field1DeepEqual(src *ListAddressReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceListAddressArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceListAddressResult struct {
+	Success *ListAddressResp `thrift:"success,0,optional" frugal:"0,optional,ListAddressResp" json:"success,omitempty"`
+}
+
+func NewUserServiceListAddressResult() *UserServiceListAddressResult {
+	return &UserServiceListAddressResult{}
+}
+
+func (p *UserServiceListAddressResult) InitDefault() {
+}
+
+var UserServiceListAddressResult_Success_DEFAULT *ListAddressResp
+
+func (p *UserServiceListAddressResult) GetSuccess() (v *ListAddressResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceListAddressResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceListAddressResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListAddressResp)
+}
+
+func (p *UserServiceListAddressResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceListAddressResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceListAddressResult(%+v)", *p)
+}
+
+func (p *UserServiceListAddressResult) DeepEqual(ano *UserServiceListAddressResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceListAddressResult) Field0DeepEqual(src *ListAddressResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceListAddressResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceUpdateAddressArgs struct {
+	Req *UpdateAddressReq `thrift:"req,1" frugal:"1,default,UpdateAddressReq" json:"req"`
+}
+
+func NewUserServiceUpdateAddressArgs() *UserServiceUpdateAddressArgs {
+	return &UserServiceUpdateAddressArgs{}
+}
+
+func (p *UserServiceUpdateAddressArgs) InitDefault() {
+}
+
+var UserServiceUpdateAddressArgs_Req_DEFAULT *UpdateAddressReq
+
+func (p *UserServiceUpdateAddressArgs) GetReq() (v *UpdateAddressReq) {
+	if !p.IsSetReq() {
+		return UserServiceUpdateAddressArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceUpdateAddressArgs) SetReq(val *UpdateAddressReq) {
+	p.Req = val
+}
+
+func (p *UserServiceUpdateAddressArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceUpdateAddressArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceUpdateAddressArgs(%+v)", *p)
+}
+
+func (p *UserServiceUpdateAddressArgs) DeepEqual(ano *UserServiceUpdateAddressArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceUpdateAddressArgs) Field1DeepEqual(src *UpdateAddressReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceUpdateAddressArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceUpdateAddressResult struct {
+	Success *UpdateAddressResp `thrift:"success,0,optional" frugal:"0,optional,UpdateAddressResp" json:"success,omitempty"`
+}
+
+func NewUserServiceUpdateAddressResult() *UserServiceUpdateAddressResult {
+	return &UserServiceUpdateAddressResult{}
+}
+
+func (p *UserServiceUpdateAddressResult) InitDefault() {
+}
+
+var UserServiceUpdateAddressResult_Success_DEFAULT *UpdateAddressResp
+
+func (p *UserServiceUpdateAddressResult) GetSuccess() (v *UpdateAddressResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceUpdateAddressResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceUpdateAddressResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UpdateAddressResp)
+}
+
+func (p *UserServiceUpdateAddressResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceUpdateAddressResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceUpdateAddressResult(%+v)", *p)
+}
+
+func (p *UserServiceUpdateAddressResult) DeepEqual(ano *UserServiceUpdateAddressResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceUpdateAddressResult) Field0DeepEqual(src *UpdateAddressResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceUpdateAddressResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceDeleteAddressArgs struct {
+	Req *DeleteAddressReq `thrift:"req,1" frugal:"1,default,DeleteAddressReq" json:"req"`
+}
+
+func NewUserServiceDeleteAddressArgs() *UserServiceDeleteAddressArgs {
+	return &UserServiceDeleteAddressArgs{}
+}
+
+func (p *UserServiceDeleteAddressArgs) InitDefault() {
+}
+
+var UserServiceDeleteAddressArgs_Req_DEFAULT *DeleteAddressReq
+
+func (p *UserServiceDeleteAddressArgs) GetReq() (v *DeleteAddressReq) {
+	if !p.IsSetReq() {
+		return UserServiceDeleteAddressArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceDeleteAddressArgs) SetReq(val *DeleteAddressReq) {
+	p.Req = val
+}
+
+func (p *UserServiceDeleteAddressArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceDeleteAddressArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceDeleteAddressArgs(%+v)", *p)
+}
+
+func (p *UserServiceDeleteAddressArgs) DeepEqual(ano *UserServiceDeleteAddressArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceDeleteAddressArgs) Field1DeepEqual(src *DeleteAddressReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceDeleteAddressArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceDeleteAddressResult struct {
+	Success *DeleteAddressResp `thrift:"success,0,optional" frugal:"0,optional,DeleteAddressResp" json:"success,omitempty"`
+}
+
+func NewUserServiceDeleteAddressResult() *UserServiceDeleteAddressResult {
+	return &UserServiceDeleteAddressResult{}
+}
+
+func (p *UserServiceDeleteAddressResult) InitDefault() {
+}
+
+var UserServiceDeleteAddressResult_Success_DEFAULT *DeleteAddressResp
+
+func (p *UserServiceDeleteAddressResult) GetSuccess() (v *DeleteAddressResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceDeleteAddressResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceDeleteAddressResult) SetSuccess(x interface{}) {
+	p.Success = x.(*DeleteAddressResp)
+}
+
+func (p *UserServiceDeleteAddressResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceDeleteAddressResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceDeleteAddressResult(%+v)", *p)
+}
+
+func (p *UserServiceDeleteAddressResult) DeepEqual(ano *UserServiceDeleteAddressResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceDeleteAddressResult) Field0DeepEqual(src *DeleteAddressResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceDeleteAddressResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceSetDefaultAddressArgs struct {
+	Req *SetDefaultAddressReq `thrift:"req,1" frugal:"1,default,SetDefaultAddressReq" json:"req"`
+}
+
+func NewUserServiceSetDefaultAddressArgs() *UserServiceSetDefaultAddressArgs {
+	return &UserServiceSetDefaultAddressArgs{}
+}
+
+func (p *UserServiceSetDefaultAddressArgs) InitDefault() {
+}
+
+var UserServiceSetDefaultAddressArgs_Req_DEFAULT *SetDefaultAddressReq
+
+func (p *UserServiceSetDefaultAddressArgs) GetReq() (v *SetDefaultAddressReq) {
+	if !p.IsSetReq() {
+		return UserServiceSetDefaultAddressArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceSetDefaultAddressArgs) SetReq(val *SetDefaultAddressReq) {
+	p.Req = val
+}
+
+func (p *UserServiceSetDefaultAddressArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceSetDefaultAddressArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceSetDefaultAddressArgs(%+v)", *p)
+}
+
+func (p *UserServiceSetDefaultAddressArgs) DeepEqual(ano *UserServiceSetDefaultAddressArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceSetDefaultAddressArgs) Field1DeepEqual(src *SetDefaultAddressReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceSetDefaultAddressArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceSetDefaultAddressResult struct {
+	Success *SetDefaultAddressResp `thrift:"success,0,optional" frugal:"0,optional,SetDefaultAddressResp" json:"success,omitempty"`
+}
+
+func NewUserServiceSetDefaultAddressResult() *UserServiceSetDefaultAddressResult {
+	return &UserServiceSetDefaultAddressResult{}
+}
+
+func (p *UserServiceSetDefaultAddressResult) InitDefault() {
+}
+
+var UserServiceSetDefaultAddressResult_Success_DEFAULT *SetDefaultAddressResp
+
+func (p *UserServiceSetDefaultAddressResult) GetSuccess() (v *SetDefaultAddressResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceSetDefaultAddressResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceSetDefaultAddressResult) SetSuccess(x interface{}) {
+	p.Success = x.(*SetDefaultAddressResp)
+}
+
+func (p *UserServiceSetDefaultAddressResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceSetDefaultAddressResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceSetDefaultAddressResult(%+v)", *p)
+}
+
+func (p *UserServiceSetDefaultAddressResult) DeepEqual(ano *UserServiceSetDefaultAddressResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceSetDefaultAddressResult) Field0DeepEqual(src *SetDefaultAddressResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceSetDefaultAddressResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceBanUserArgs struct {
+	Req *BanUserReq `thrift:"req,1" frugal:"1,default,BanUserReq" json:"req"`
+}
+
+func NewUserServiceBanUserArgs() *UserServiceBanUserArgs {
+	return &UserServiceBanUserArgs{}
+}
+
+func (p *UserServiceBanUserArgs) InitDefault() {
+}
+
+var UserServiceBanUserArgs_Req_DEFAULT *BanUserReq
+
+func (p *UserServiceBanUserArgs) GetReq() (v *BanUserReq) {
+	if !p.IsSetReq() {
+		return UserServiceBanUserArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceBanUserArgs) SetReq(val *BanUserReq) {
+	p.Req = val
+}
+
+func (p *UserServiceBanUserArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceBanUserArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceBanUserArgs(%+v)", *p)
+}
+
+func (p *UserServiceBanUserArgs) DeepEqual(ano *UserServiceBanUserArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceBanUserArgs) Field1DeepEqual(src *BanUserReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceBanUserArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceBanUserResult struct {
+	Success *BanUserResp `thrift:"success,0,optional" frugal:"0,optional,BanUserResp" json:"success,omitempty"`
+}
+
+func NewUserServiceBanUserResult() *UserServiceBanUserResult {
+	return &UserServiceBanUserResult{}
+}
+
+func (p *UserServiceBanUserResult) InitDefault() {
+}
+
+var UserServiceBanUserResult_Success_DEFAULT *BanUserResp
+
+func (p *UserServiceBanUserResult) GetSuccess() (v *BanUserResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceBanUserResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceBanUserResult) SetSuccess(x interface{}) {
+	p.Success = x.(*BanUserResp)
+}
+
+func (p *UserServiceBanUserResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceBanUserResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceBanUserResult(%+v)", *p)
+}
+
+func (p *UserServiceBanUserResult) DeepEqual(ano *UserServiceBanUserResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceBanUserResult) Field0DeepEqual(src *BanUserResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceBanUserResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceLiftBandUserArgs struct {
+	Req *LiftBanUserReq `thrift:"req,1" frugal:"1,default,LiftBanUserReq" json:"req"`
+}
+
+func NewUserServiceLiftBandUserArgs() *UserServiceLiftBandUserArgs {
+	return &UserServiceLiftBandUserArgs{}
+}
+
+func (p *UserServiceLiftBandUserArgs) InitDefault() {
+}
+
+var UserServiceLiftBandUserArgs_Req_DEFAULT *LiftBanUserReq
+
+func (p *UserServiceLiftBandUserArgs) GetReq() (v *LiftBanUserReq) {
+	if !p.IsSetReq() {
+		return UserServiceLiftBandUserArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceLiftBandUserArgs) SetReq(val *LiftBanUserReq) {
+	p.Req = val
+}
+
+func (p *UserServiceLiftBandUserArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceLiftBandUserArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceLiftBandUserArgs(%+v)", *p)
+}
+
+func (p *UserServiceLiftBandUserArgs) DeepEqual(ano *UserServiceLiftBandUserArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceLiftBandUserArgs) Field1DeepEqual(src *LiftBanUserReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceLiftBandUserArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceLiftBandUserResult struct {
+	Success *LiftBanUserResp `thrift:"success,0,optional" frugal:"0,optional,LiftBanUserResp" json:"success,omitempty"`
+}
+
+func NewUserServiceLiftBandUserResult() *UserServiceLiftBandUserResult {
+	return &UserServiceLiftBandUserResult{}
+}
+
+func (p *UserServiceLiftBandUserResult) InitDefault() {
+}
+
+var UserServiceLiftBandUserResult_Success_DEFAULT *LiftBanUserResp
+
+func (p *UserServiceLiftBandUserResult) GetSuccess() (v *LiftBanUserResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceLiftBandUserResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceLiftBandUserResult) SetSuccess(x interface{}) {
+	p.Success = x.(*LiftBanUserResp)
+}
+
+func (p *UserServiceLiftBandUserResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceLiftBandUserResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceLiftBandUserResult(%+v)", *p)
+}
+
+func (p *UserServiceLiftBandUserResult) DeepEqual(ano *UserServiceLiftBandUserResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceLiftBandUserResult) Field0DeepEqual(src *LiftBanUserResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceLiftBandUserResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceListBanHistoryArgs struct {
+	Req *ListBanHistoryReq `thrift:"req,1" frugal:"1,default,ListBanHistoryReq" json:"req"`
+}
+
+func NewUserServiceListBanHistoryArgs() *UserServiceListBanHistoryArgs {
+	return &UserServiceListBanHistoryArgs{}
+}
+
+func (p *UserServiceListBanHistoryArgs) InitDefault() {
+}
+
+var UserServiceListBanHistoryArgs_Req_DEFAULT *ListBanHistoryReq
+
+func (p *UserServiceListBanHistoryArgs) GetReq() (v *ListBanHistoryReq) {
+	if !p.IsSetReq() {
+		return UserServiceListBanHistoryArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceListBanHistoryArgs) SetReq(val *ListBanHistoryReq) {
+	p.Req = val
+}
+
+func (p *UserServiceListBanHistoryArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceListBanHistoryArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceListBanHistoryArgs(%+v)", *p)
+}
+
+func (p *UserServiceListBanHistoryArgs) DeepEqual(ano *UserServiceListBanHistoryArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceListBanHistoryArgs) Field1DeepEqual(src *ListBanHistoryReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceListBanHistoryArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceListBanHistoryResult struct {
+	Success *ListBanHistoryResp `thrift:"success,0,optional" frugal:"0,optional,ListBanHistoryResp" json:"success,omitempty"`
+}
+
+func NewUserServiceListBanHistoryResult() *UserServiceListBanHistoryResult {
+	return &UserServiceListBanHistoryResult{}
+}
+
+func (p *UserServiceListBanHistoryResult) InitDefault() {
+}
+
+var UserServiceListBanHistoryResult_Success_DEFAULT *ListBanHistoryResp
+
+func (p *UserServiceListBanHistoryResult) GetSuccess() (v *ListBanHistoryResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceListBanHistoryResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceListBanHistoryResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListBanHistoryResp)
+}
+
+func (p *UserServiceListBanHistoryResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceListBanHistoryResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceListBanHistoryResult(%+v)", *p)
+}
+
+func (p *UserServiceListBanHistoryResult) DeepEqual(ano *UserServiceListBanHistoryResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceListBanHistoryResult) Field0DeepEqual(src *ListBanHistoryResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceListBanHistoryResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceUnlockUserArgs struct {
+	Req *UnlockUserReq `thrift:"req,1" frugal:"1,default,UnlockUserReq" json:"req"`
+}
+
+func NewUserServiceUnlockUserArgs() *UserServiceUnlockUserArgs {
+	return &UserServiceUnlockUserArgs{}
+}
+
+func (p *UserServiceUnlockUserArgs) InitDefault() {
+}
+
+var UserServiceUnlockUserArgs_Req_DEFAULT *UnlockUserReq
+
+func (p *UserServiceUnlockUserArgs) GetReq() (v *UnlockUserReq) {
+	if !p.IsSetReq() {
+		return UserServiceUnlockUserArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceUnlockUserArgs) SetReq(val *UnlockUserReq) {
+	p.Req = val
+}
+
+func (p *UserServiceUnlockUserArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceUnlockUserArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceUnlockUserArgs(%+v)", *p)
+}
+
+func (p *UserServiceUnlockUserArgs) DeepEqual(ano *UserServiceUnlockUserArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceUnlockUserArgs) Field1DeepEqual(src *UnlockUserReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceUnlockUserArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceUnlockUserResult struct {
+	Success *UnlockUserResp `thrift:"success,0,optional" frugal:"0,optional,UnlockUserResp" json:"success,omitempty"`
+}
+
+func NewUserServiceUnlockUserResult() *UserServiceUnlockUserResult {
+	return &UserServiceUnlockUserResult{}
+}
+
+func (p *UserServiceUnlockUserResult) InitDefault() {
+}
+
+var UserServiceUnlockUserResult_Success_DEFAULT *UnlockUserResp
+
+func (p *UserServiceUnlockUserResult) GetSuccess() (v *UnlockUserResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceUnlockUserResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceUnlockUserResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UnlockUserResp)
+}
+
+func (p *UserServiceUnlockUserResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceUnlockUserResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceUnlockUserResult(%+v)", *p)
+}
+
+func (p *UserServiceUnlockUserResult) DeepEqual(ano *UserServiceUnlockUserResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceUnlockUserResult) Field0DeepEqual(src *UnlockUserResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceUnlockUserResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceLogoutArgs struct {
+	Req *LogoutReq `thrift:"req,1" frugal:"1,default,LogoutReq" json:"req"`
+}
+
+func NewUserServiceLogoutArgs() *UserServiceLogoutArgs {
+	return &UserServiceLogoutArgs{}
+}
+
+func (p *UserServiceLogoutArgs) InitDefault() {
+}
+
+var UserServiceLogoutArgs_Req_DEFAULT *LogoutReq
+
+func (p *UserServiceLogoutArgs) GetReq() (v *LogoutReq) {
+	if !p.IsSetReq() {
+		return UserServiceLogoutArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceLogoutArgs) SetReq(val *LogoutReq) {
+	p.Req = val
+}
+
+func (p *UserServiceLogoutArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceLogoutArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceLogoutArgs(%+v)", *p)
+}
+
+func (p *UserServiceLogoutArgs) DeepEqual(ano *UserServiceLogoutArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceLogoutArgs) Field1DeepEqual(src *LogoutReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceLogoutArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceLogoutResult struct {
+	Success *LogoutResp `thrift:"success,0,optional" frugal:"0,optional,LogoutResp" json:"success,omitempty"`
+}
+
+func NewUserServiceLogoutResult() *UserServiceLogoutResult {
+	return &UserServiceLogoutResult{}
+}
+
+func (p *UserServiceLogoutResult) InitDefault() {
+}
+
+var UserServiceLogoutResult_Success_DEFAULT *LogoutResp
+
+func (p *UserServiceLogoutResult) GetSuccess() (v *LogoutResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceLogoutResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceLogoutResult) SetSuccess(x interface{}) {
+	p.Success = x.(*LogoutResp)
+}
+
+func (p *UserServiceLogoutResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceLogoutResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceLogoutResult(%+v)", *p)
+}
+
+func (p *UserServiceLogoutResult) DeepEqual(ano *UserServiceLogoutResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceLogoutResult) Field0DeepEqual(src *LogoutResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceLogoutResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceListSessionsArgs struct {
+	Req *ListSessionsReq `thrift:"req,1" frugal:"1,default,ListSessionsReq" json:"req"`
+}
+
+func NewUserServiceListSessionsArgs() *UserServiceListSessionsArgs {
+	return &UserServiceListSessionsArgs{}
+}
+
+func (p *UserServiceListSessionsArgs) InitDefault() {
+}
+
+var UserServiceListSessionsArgs_Req_DEFAULT *ListSessionsReq
+
+func (p *UserServiceListSessionsArgs) GetReq() (v *ListSessionsReq) {
+	if !p.IsSetReq() {
+		return UserServiceListSessionsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceListSessionsArgs) SetReq(val *ListSessionsReq) {
+	p.Req = val
+}
+
+func (p *UserServiceListSessionsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceListSessionsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceListSessionsArgs(%+v)", *p)
+}
+
+func (p *UserServiceListSessionsArgs) DeepEqual(ano *UserServiceListSessionsArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceListSessionsArgs) Field1DeepEqual(src *ListSessionsReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceListSessionsArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceListSessionsResult struct {
+	Success *ListSessionsResp `thrift:"success,0,optional" frugal:"0,optional,ListSessionsResp" json:"success,omitempty"`
+}
+
+func NewUserServiceListSessionsResult() *UserServiceListSessionsResult {
+	return &UserServiceListSessionsResult{}
+}
+
+func (p *UserServiceListSessionsResult) InitDefault() {
+}
+
+var UserServiceListSessionsResult_Success_DEFAULT *ListSessionsResp
+
+func (p *UserServiceListSessionsResult) GetSuccess() (v *ListSessionsResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceListSessionsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceListSessionsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListSessionsResp)
+}
+
+func (p *UserServiceListSessionsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceListSessionsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceListSessionsResult(%+v)", *p)
+}
+
+func (p *UserServiceListSessionsResult) DeepEqual(ano *UserServiceListSessionsResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceListSessionsResult) Field0DeepEqual(src *ListSessionsResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceListSessionsResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceRevokeSessionArgs struct {
+	Req *RevokeSessionReq `thrift:"req,1" frugal:"1,default,RevokeSessionReq" json:"req"`
+}
+
+func NewUserServiceRevokeSessionArgs() *UserServiceRevokeSessionArgs {
+	return &UserServiceRevokeSessionArgs{}
+}
+
+func (p *UserServiceRevokeSessionArgs) InitDefault() {
+}
+
+var UserServiceRevokeSessionArgs_Req_DEFAULT *RevokeSessionReq
+
+func (p *UserServiceRevokeSessionArgs) GetReq() (v *RevokeSessionReq) {
+	if !p.IsSetReq() {
+		return UserServiceRevokeSessionArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceRevokeSessionArgs) SetReq(val *RevokeSessionReq) {
+	p.Req = val
+}
+
+func (p *UserServiceRevokeSessionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceRevokeSessionArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceRevokeSessionArgs(%+v)", *p)
+}
+
+func (p *UserServiceRevokeSessionArgs) DeepEqual(ano *UserServiceRevokeSessionArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceRevokeSessionArgs) Field1DeepEqual(src *RevokeSessionReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceRevokeSessionArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceRevokeSessionResult struct {
+	Success *RevokeSessionResp `thrift:"success,0,optional" frugal:"0,optional,RevokeSessionResp" json:"success,omitempty"`
+}
+
+func NewUserServiceRevokeSessionResult() *UserServiceRevokeSessionResult {
+	return &UserServiceRevokeSessionResult{}
+}
+
+func (p *UserServiceRevokeSessionResult) InitDefault() {
+}
+
+var UserServiceRevokeSessionResult_Success_DEFAULT *RevokeSessionResp
+
+func (p *UserServiceRevokeSessionResult) GetSuccess() (v *RevokeSessionResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceRevokeSessionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceRevokeSessionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RevokeSessionResp)
+}
+
+func (p *UserServiceRevokeSessionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceRevokeSessionResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceRevokeSessionResult(%+v)", *p)
+}
+
+func (p *UserServiceRevokeSessionResult) DeepEqual(ano *UserServiceRevokeSessionResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceRevokeSessionResult) Field0DeepEqual(src *RevokeSessionResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceRevokeSessionResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceRevokeAllSessionsArgs struct {
+	Req *RevokeAllSessionsReq `thrift:"req,1" frugal:"1,default,RevokeAllSessionsReq" json:"req"`
+}
+
+func NewUserServiceRevokeAllSessionsArgs() *UserServiceRevokeAllSessionsArgs {
+	return &UserServiceRevokeAllSessionsArgs{}
+}
+
+func (p *UserServiceRevokeAllSessionsArgs) InitDefault() {
+}
+
+var UserServiceRevokeAllSessionsArgs_Req_DEFAULT *RevokeAllSessionsReq
+
+func (p *UserServiceRevokeAllSessionsArgs) GetReq() (v *RevokeAllSessionsReq) {
+	if !p.IsSetReq() {
+		return UserServiceRevokeAllSessionsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceRevokeAllSessionsArgs) SetReq(val *RevokeAllSessionsReq) {
+	p.Req = val
+}
+
+func (p *UserServiceRevokeAllSessionsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceRevokeAllSessionsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceRevokeAllSessionsArgs(%+v)", *p)
+}
+
+func (p *UserServiceRevokeAllSessionsArgs) DeepEqual(ano *UserServiceRevokeAllSessionsArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceRevokeAllSessionsArgs) Field1DeepEqual(src *RevokeAllSessionsReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceRevokeAllSessionsArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceRevokeAllSessionsResult struct {
+	Success *RevokeAllSessionsResp `thrift:"success,0,optional" frugal:"0,optional,RevokeAllSessionsResp" json:"success,omitempty"`
+}
+
+func NewUserServiceRevokeAllSessionsResult() *UserServiceRevokeAllSessionsResult {
+	return &UserServiceRevokeAllSessionsResult{}
+}
+
+func (p *UserServiceRevokeAllSessionsResult) InitDefault() {
+}
+
+var UserServiceRevokeAllSessionsResult_Success_DEFAULT *RevokeAllSessionsResp
+
+func (p *UserServiceRevokeAllSessionsResult) GetSuccess() (v *RevokeAllSessionsResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceRevokeAllSessionsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceRevokeAllSessionsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RevokeAllSessionsResp)
+}
+
+func (p *UserServiceRevokeAllSessionsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceRevokeAllSessionsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceRevokeAllSessionsResult(%+v)", *p)
+}
+
+func (p *UserServiceRevokeAllSessionsResult) DeepEqual(ano *UserServiceRevokeAllSessionsResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceRevokeAllSessionsResult) Field0DeepEqual(src *RevokeAllSessionsResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceRevokeAllSessionsResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceSetAdministratorArgs struct {
+	Req *SetAdministratorReq `thrift:"req,1" frugal:"1,default,SetAdministratorReq" json:"req"`
+}
+
+func NewUserServiceSetAdministratorArgs() *UserServiceSetAdministratorArgs {
+	return &UserServiceSetAdministratorArgs{}
+}
+
+func (p *UserServiceSetAdministratorArgs) InitDefault() {
+}
+
+var UserServiceSetAdministratorArgs_Req_DEFAULT *SetAdministratorReq
+
+func (p *UserServiceSetAdministratorArgs) GetReq() (v *SetAdministratorReq) {
+	if !p.IsSetReq() {
+		return UserServiceSetAdministratorArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceSetAdministratorArgs) SetReq(val *SetAdministratorReq) {
+	p.Req = val
+}
+
+func (p *UserServiceSetAdministratorArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceSetAdministratorArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceSetAdministratorArgs(%+v)", *p)
+}
+
+func (p *UserServiceSetAdministratorArgs) DeepEqual(ano *UserServiceSetAdministratorArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceSetAdministratorArgs) Field1DeepEqual(src *SetAdministratorReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceSetAdministratorArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceSetAdministratorResult struct {
+	Success *SetAdministratorResp `thrift:"success,0,optional" frugal:"0,optional,SetAdministratorResp" json:"success,omitempty"`
+}
+
+func NewUserServiceSetAdministratorResult() *UserServiceSetAdministratorResult {
+	return &UserServiceSetAdministratorResult{}
+}
+
+func (p *UserServiceSetAdministratorResult) InitDefault() {
+}
+
+var UserServiceSetAdministratorResult_Success_DEFAULT *SetAdministratorResp
+
+func (p *UserServiceSetAdministratorResult) GetSuccess() (v *SetAdministratorResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceSetAdministratorResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceSetAdministratorResult) SetSuccess(x interface{}) {
+	p.Success = x.(*SetAdministratorResp)
+}
+
+func (p *UserServiceSetAdministratorResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceSetAdministratorResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceSetAdministratorResult(%+v)", *p)
+}
+
+func (p *UserServiceSetAdministratorResult) DeepEqual(ano *UserServiceSetAdministratorResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceSetAdministratorResult) Field0DeepEqual(src *SetAdministratorResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceSetAdministratorResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceGetUserInfoArgs struct {
+	Req *GetUserInfoReq `thrift:"req,1" frugal:"1,default,GetUserInfoReq" json:"req"`
+}
+
+func NewUserServiceGetUserInfoArgs() *UserServiceGetUserInfoArgs {
+	return &UserServiceGetUserInfoArgs{}
+}
+
+func (p *UserServiceGetUserInfoArgs) InitDefault() {
+}
+
+var UserServiceGetUserInfoArgs_Req_DEFAULT *GetUserInfoReq
+
+func (p *UserServiceGetUserInfoArgs) GetReq() (v *GetUserInfoReq) {
+	if !p.IsSetReq() {
+		return UserServiceGetUserInfoArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceGetUserInfoArgs) SetReq(val *GetUserInfoReq) {
+	p.Req = val
+}
+
+func (p *UserServiceGetUserInfoArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceGetUserInfoArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceGetUserInfoArgs(%+v)", *p)
+}
+
+func (p *UserServiceGetUserInfoArgs) DeepEqual(ano *UserServiceGetUserInfoArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceGetUserInfoArgs) Field1DeepEqual(src *GetUserInfoReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceGetUserInfoArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceGetUserInfoResult struct {
+	Success *GetUserInfoResp `thrift:"success,0,optional" frugal:"0,optional,GetUserInfoResp" json:"success,omitempty"`
+}
+
+func NewUserServiceGetUserInfoResult() *UserServiceGetUserInfoResult {
+	return &UserServiceGetUserInfoResult{}
+}
+
+func (p *UserServiceGetUserInfoResult) InitDefault() {
+}
+
+var UserServiceGetUserInfoResult_Success_DEFAULT *GetUserInfoResp
+
+func (p *UserServiceGetUserInfoResult) GetSuccess() (v *GetUserInfoResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceGetUserInfoResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceGetUserInfoResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetUserInfoResp)
+}
+
+func (p *UserServiceGetUserInfoResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceGetUserInfoResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceGetUserInfoResult(%+v)", *p)
+}
+
+func (p *UserServiceGetUserInfoResult) DeepEqual(ano *UserServiceGetUserInfoResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceGetUserInfoResult) Field0DeepEqual(src *GetUserInfoResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceGetUserInfoResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceCreateShopArgs struct {
+	Req *CreateShopReq `thrift:"req,1" frugal:"1,default,CreateShopReq" json:"req"`
+}
+
+func NewUserServiceCreateShopArgs() *UserServiceCreateShopArgs {
+	return &UserServiceCreateShopArgs{}
+}
+
+func (p *UserServiceCreateShopArgs) InitDefault() {
+}
+
+var UserServiceCreateShopArgs_Req_DEFAULT *CreateShopReq
+
+func (p *UserServiceCreateShopArgs) GetReq() (v *CreateShopReq) {
+	if !p.IsSetReq() {
+		return UserServiceCreateShopArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceCreateShopArgs) SetReq(val *CreateShopReq) {
+	p.Req = val
+}
+
+func (p *UserServiceCreateShopArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceCreateShopArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceCreateShopArgs(%+v)", *p)
+}
+
+func (p *UserServiceCreateShopArgs) DeepEqual(ano *UserServiceCreateShopArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceCreateShopArgs) Field1DeepEqual(src *CreateShopReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceCreateShopArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceCreateShopResult struct {
+	Success *CreateShopResp `thrift:"success,0,optional" frugal:"0,optional,CreateShopResp" json:"success,omitempty"`
+}
+
+func NewUserServiceCreateShopResult() *UserServiceCreateShopResult {
+	return &UserServiceCreateShopResult{}
+}
+
+func (p *UserServiceCreateShopResult) InitDefault() {
+}
+
+var UserServiceCreateShopResult_Success_DEFAULT *CreateShopResp
+
+func (p *UserServiceCreateShopResult) GetSuccess() (v *CreateShopResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceCreateShopResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceCreateShopResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CreateShopResp)
+}
+
+func (p *UserServiceCreateShopResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceCreateShopResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceCreateShopResult(%+v)", *p)
+}
+
+func (p *UserServiceCreateShopResult) DeepEqual(ano *UserServiceCreateShopResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceCreateShopResult) Field0DeepEqual(src *CreateShopResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceCreateShopResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceUpdateShopArgs struct {
+	Req *UpdateShopReq `thrift:"req,1" frugal:"1,default,UpdateShopReq" json:"req"`
+}
+
+func NewUserServiceUpdateShopArgs() *UserServiceUpdateShopArgs {
+	return &UserServiceUpdateShopArgs{}
+}
+
+func (p *UserServiceUpdateShopArgs) InitDefault() {
+}
+
+var UserServiceUpdateShopArgs_Req_DEFAULT *UpdateShopReq
+
+func (p *UserServiceUpdateShopArgs) GetReq() (v *UpdateShopReq) {
+	if !p.IsSetReq() {
+		return UserServiceUpdateShopArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceUpdateShopArgs) SetReq(val *UpdateShopReq) {
+	p.Req = val
+}
+
+func (p *UserServiceUpdateShopArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceUpdateShopArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceUpdateShopArgs(%+v)", *p)
+}
+
+func (p *UserServiceUpdateShopArgs) DeepEqual(ano *UserServiceUpdateShopArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceUpdateShopArgs) Field1DeepEqual(src *UpdateShopReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceUpdateShopArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceUpdateShopResult struct {
+	Success *UpdateShopResp `thrift:"success,0,optional" frugal:"0,optional,UpdateShopResp" json:"success,omitempty"`
+}
+
+func NewUserServiceUpdateShopResult() *UserServiceUpdateShopResult {
+	return &UserServiceUpdateShopResult{}
+}
+
+func (p *UserServiceUpdateShopResult) InitDefault() {
+}
+
+var UserServiceUpdateShopResult_Success_DEFAULT *UpdateShopResp
+
+func (p *UserServiceUpdateShopResult) GetSuccess() (v *UpdateShopResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceUpdateShopResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceUpdateShopResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UpdateShopResp)
+}
+
+func (p *UserServiceUpdateShopResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceUpdateShopResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceUpdateShopResult(%+v)", *p)
+}
+
+func (p *UserServiceUpdateShopResult) DeepEqual(ano *UserServiceUpdateShopResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceUpdateShopResult) Field0DeepEqual(src *UpdateShopResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceUpdateShopResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceCloseShopArgs struct {
+	Req *CloseShopReq `thrift:"req,1" frugal:"1,default,CloseShopReq" json:"req"`
+}
+
+func NewUserServiceCloseShopArgs() *UserServiceCloseShopArgs {
+	return &UserServiceCloseShopArgs{}
+}
+
+func (p *UserServiceCloseShopArgs) InitDefault() {
+}
+
+var UserServiceCloseShopArgs_Req_DEFAULT *CloseShopReq
+
+func (p *UserServiceCloseShopArgs) GetReq() (v *CloseShopReq) {
+	if !p.IsSetReq() {
+		return UserServiceCloseShopArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceCloseShopArgs) SetReq(val *CloseShopReq) {
+	p.Req = val
+}
+
+func (p *UserServiceCloseShopArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceCloseShopArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceCloseShopArgs(%+v)", *p)
+}
+
+func (p *UserServiceCloseShopArgs) DeepEqual(ano *UserServiceCloseShopArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceCloseShopArgs) Field1DeepEqual(src *CloseShopReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceCloseShopArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceCloseShopResult struct {
+	Success *CloseShopResp `thrift:"success,0,optional" frugal:"0,optional,CloseShopResp" json:"success,omitempty"`
+}
+
+func NewUserServiceCloseShopResult() *UserServiceCloseShopResult {
+	return &UserServiceCloseShopResult{}
+}
+
+func (p *UserServiceCloseShopResult) InitDefault() {
+}
+
+var UserServiceCloseShopResult_Success_DEFAULT *CloseShopResp
+
+func (p *UserServiceCloseShopResult) GetSuccess() (v *CloseShopResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceCloseShopResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceCloseShopResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CloseShopResp)
+}
+
+func (p *UserServiceCloseShopResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceCloseShopResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceCloseShopResult(%+v)", *p)
+}
+
+func (p *UserServiceCloseShopResult) DeepEqual(ano *UserServiceCloseShopResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceCloseShopResult) Field0DeepEqual(src *CloseShopResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceCloseShopResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceGetShopArgs struct {
+	Req *GetShopReq `thrift:"req,1" frugal:"1,default,GetShopReq" json:"req"`
+}
+
+func NewUserServiceGetShopArgs() *UserServiceGetShopArgs {
+	return &UserServiceGetShopArgs{}
+}
+
+func (p *UserServiceGetShopArgs) InitDefault() {
+}
+
+var UserServiceGetShopArgs_Req_DEFAULT *GetShopReq
+
+func (p *UserServiceGetShopArgs) GetReq() (v *GetShopReq) {
+	if !p.IsSetReq() {
+		return UserServiceGetShopArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceGetShopArgs) SetReq(val *GetShopReq) {
+	p.Req = val
+}
+
+func (p *UserServiceGetShopArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceGetShopArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceGetShopArgs(%+v)", *p)
+}
+
+func (p *UserServiceGetShopArgs) DeepEqual(ano *UserServiceGetShopArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceGetShopArgs) Field1DeepEqual(src *GetShopReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceGetShopArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceGetShopResult struct {
+	Success *GetShopResp `thrift:"success,0,optional" frugal:"0,optional,GetShopResp" json:"success,omitempty"`
+}
+
+func NewUserServiceGetShopResult() *UserServiceGetShopResult {
+	return &UserServiceGetShopResult{}
+}
+
+func (p *UserServiceGetShopResult) InitDefault() {
+}
+
+var UserServiceGetShopResult_Success_DEFAULT *GetShopResp
+
+func (p *UserServiceGetShopResult) GetSuccess() (v *GetShopResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceGetShopResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceGetShopResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetShopResp)
+}
+
+func (p *UserServiceGetShopResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceGetShopResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceGetShopResult(%+v)", *p)
+}
+
+func (p *UserServiceGetShopResult) DeepEqual(ano *UserServiceGetShopResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceGetShopResult) Field0DeepEqual(src *GetShopResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceGetShopResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceGetShopsArgs struct {
+	Req *GetShopsReq `thrift:"req,1" frugal:"1,default,GetShopsReq" json:"req"`
+}
+
+func NewUserServiceGetShopsArgs() *UserServiceGetShopsArgs {
+	return &UserServiceGetShopsArgs{}
+}
+
+func (p *UserServiceGetShopsArgs) InitDefault() {
+}
+
+var UserServiceGetShopsArgs_Req_DEFAULT *GetShopsReq
+
+func (p *UserServiceGetShopsArgs) GetReq() (v *GetShopsReq) {
+	if !p.IsSetReq() {
+		return UserServiceGetShopsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceGetShopsArgs) SetReq(val *GetShopsReq) {
+	p.Req = val
+}
+
+func (p *UserServiceGetShopsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceGetShopsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceGetShopsArgs(%+v)", *p)
+}
+
+func (p *UserServiceGetShopsArgs) DeepEqual(ano *UserServiceGetShopsArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceGetShopsArgs) Field1DeepEqual(src *GetShopsReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceGetShopsArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceGetShopsResult struct {
+	Success *GetShopsResp `thrift:"success,0,optional" frugal:"0,optional,GetShopsResp" json:"success,omitempty"`
+}
+
+func NewUserServiceGetShopsResult() *UserServiceGetShopsResult {
+	return &UserServiceGetShopsResult{}
+}
+
+func (p *UserServiceGetShopsResult) InitDefault() {
+}
+
+var UserServiceGetShopsResult_Success_DEFAULT *GetShopsResp
+
+func (p *UserServiceGetShopsResult) GetSuccess() (v *GetShopsResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceGetShopsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceGetShopsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetShopsResp)
+}
+
+func (p *UserServiceGetShopsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceGetShopsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceGetShopsResult(%+v)", *p)
+}
+
+func (p *UserServiceGetShopsResult) DeepEqual(ano *UserServiceGetShopsResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceGetShopsResult) Field0DeepEqual(src *GetShopsResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceGetShopsResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceAddShopStaffArgs struct {
+	Req *AddShopStaffReq `thrift:"req,1" frugal:"1,default,AddShopStaffReq" json:"req"`
+}
+
+func NewUserServiceAddShopStaffArgs() *UserServiceAddShopStaffArgs {
+	return &UserServiceAddShopStaffArgs{}
+}
+
+func (p *UserServiceAddShopStaffArgs) InitDefault() {
+}
+
+var UserServiceAddShopStaffArgs_Req_DEFAULT *AddShopStaffReq
+
+func (p *UserServiceAddShopStaffArgs) GetReq() (v *AddShopStaffReq) {
+	if !p.IsSetReq() {
+		return UserServiceAddShopStaffArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceAddShopStaffArgs) SetReq(val *AddShopStaffReq) {
+	p.Req = val
+}
+
+func (p *UserServiceAddShopStaffArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceAddShopStaffArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceAddShopStaffArgs(%+v)", *p)
+}
+
+func (p *UserServiceAddShopStaffArgs) DeepEqual(ano *UserServiceAddShopStaffArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceAddShopStaffArgs) Field1DeepEqual(src *AddShopStaffReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceAddShopStaffArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceAddShopStaffResult struct {
+	Success *AddShopStaffResp `thrift:"success,0,optional" frugal:"0,optional,AddShopStaffResp" json:"success,omitempty"`
+}
+
+func NewUserServiceAddShopStaffResult() *UserServiceAddShopStaffResult {
+	return &UserServiceAddShopStaffResult{}
+}
+
+func (p *UserServiceAddShopStaffResult) InitDefault() {
+}
+
+var UserServiceAddShopStaffResult_Success_DEFAULT *AddShopStaffResp
+
+func (p *UserServiceAddShopStaffResult) GetSuccess() (v *AddShopStaffResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceAddShopStaffResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceAddShopStaffResult) SetSuccess(x interface{}) {
+	p.Success = x.(*AddShopStaffResp)
+}
+
+func (p *UserServiceAddShopStaffResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceAddShopStaffResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceAddShopStaffResult(%+v)", *p)
+}
+
+func (p *UserServiceAddShopStaffResult) DeepEqual(ano *UserServiceAddShopStaffResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceAddShopStaffResult) Field0DeepEqual(src *AddShopStaffResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceAddShopStaffResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceRemoveShopStaffArgs struct {
+	Req *RemoveShopStaffReq `thrift:"req,1" frugal:"1,default,RemoveShopStaffReq" json:"req"`
+}
+
+func NewUserServiceRemoveShopStaffArgs() *UserServiceRemoveShopStaffArgs {
+	return &UserServiceRemoveShopStaffArgs{}
+}
+
+func (p *UserServiceRemoveShopStaffArgs) InitDefault() {
+}
+
+var UserServiceRemoveShopStaffArgs_Req_DEFAULT *RemoveShopStaffReq
+
+func (p *UserServiceRemoveShopStaffArgs) GetReq() (v *RemoveShopStaffReq) {
+	if !p.IsSetReq() {
+		return UserServiceRemoveShopStaffArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceRemoveShopStaffArgs) SetReq(val *RemoveShopStaffReq) {
+	p.Req = val
+}
+
+func (p *UserServiceRemoveShopStaffArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceRemoveShopStaffArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceRemoveShopStaffArgs(%+v)", *p)
+}
+
+func (p *UserServiceRemoveShopStaffArgs) DeepEqual(ano *UserServiceRemoveShopStaffArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceRemoveShopStaffArgs) Field1DeepEqual(src *RemoveShopStaffReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceRemoveShopStaffArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceRemoveShopStaffResult struct {
+	Success *RemoveShopStaffResp `thrift:"success,0,optional" frugal:"0,optional,RemoveShopStaffResp" json:"success,omitempty"`
+}
+
+func NewUserServiceRemoveShopStaffResult() *UserServiceRemoveShopStaffResult {
+	return &UserServiceRemoveShopStaffResult{}
+}
+
+func (p *UserServiceRemoveShopStaffResult) InitDefault() {
+}
+
+var UserServiceRemoveShopStaffResult_Success_DEFAULT *RemoveShopStaffResp
+
+func (p *UserServiceRemoveShopStaffResult) GetSuccess() (v *RemoveShopStaffResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceRemoveShopStaffResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceRemoveShopStaffResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RemoveShopStaffResp)
+}
+
+func (p *UserServiceRemoveShopStaffResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceRemoveShopStaffResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceRemoveShopStaffResult(%+v)", *p)
+}
+
+func (p *UserServiceRemoveShopStaffResult) DeepEqual(ano *UserServiceRemoveShopStaffResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceRemoveShopStaffResult) Field0DeepEqual(src *RemoveShopStaffResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceRemoveShopStaffResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceListShopStaffArgs struct {
+	Req *ListShopStaffReq `thrift:"req,1" frugal:"1,default,ListShopStaffReq" json:"req"`
+}
+
+func NewUserServiceListShopStaffArgs() *UserServiceListShopStaffArgs {
+	return &UserServiceListShopStaffArgs{}
+}
+
+func (p *UserServiceListShopStaffArgs) InitDefault() {
+}
+
+var UserServiceListShopStaffArgs_Req_DEFAULT *ListShopStaffReq
+
+func (p *UserServiceListShopStaffArgs) GetReq() (v *ListShopStaffReq) {
+	if !p.IsSetReq() {
+		return UserServiceListShopStaffArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceListShopStaffArgs) SetReq(val *ListShopStaffReq) {
+	p.Req = val
+}
+
+func (p *UserServiceListShopStaffArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceListShopStaffArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceListShopStaffArgs(%+v)", *p)
+}
+
+func (p *UserServiceListShopStaffArgs) DeepEqual(ano *UserServiceListShopStaffArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceListShopStaffArgs) Field1DeepEqual(src *ListShopStaffReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceListShopStaffArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceListShopStaffResult struct {
+	Success *ListShopStaffResp `thrift:"success,0,optional" frugal:"0,optional,ListShopStaffResp" json:"success,omitempty"`
+}
+
+func NewUserServiceListShopStaffResult() *UserServiceListShopStaffResult {
+	return &UserServiceListShopStaffResult{}
+}
+
+func (p *UserServiceListShopStaffResult) InitDefault() {
+}
+
+var UserServiceListShopStaffResult_Success_DEFAULT *ListShopStaffResp
+
+func (p *UserServiceListShopStaffResult) GetSuccess() (v *ListShopStaffResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceListShopStaffResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceListShopStaffResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListShopStaffResp)
+}
+
+func (p *UserServiceListShopStaffResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceListShopStaffResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceListShopStaffResult(%+v)", *p)
+}
+
+func (p *UserServiceListShopStaffResult) DeepEqual(ano *UserServiceListShopStaffResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceListShopStaffResult) Field0DeepEqual(src *ListShopStaffResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceListShopStaffResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceIsShopStaffArgs struct {
+	Req *IsShopStaffReq `thrift:"req,1" frugal:"1,default,IsShopStaffReq" json:"req"`
+}
+
+func NewUserServiceIsShopStaffArgs() *UserServiceIsShopStaffArgs {
+	return &UserServiceIsShopStaffArgs{}
+}
+
+func (p *UserServiceIsShopStaffArgs) InitDefault() {
+}
+
+var UserServiceIsShopStaffArgs_Req_DEFAULT *IsShopStaffReq
+
+func (p *UserServiceIsShopStaffArgs) GetReq() (v *IsShopStaffReq) {
+	if !p.IsSetReq() {
+		return UserServiceIsShopStaffArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceIsShopStaffArgs) SetReq(val *IsShopStaffReq) {
+	p.Req = val
+}
+
+func (p *UserServiceIsShopStaffArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceIsShopStaffArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceIsShopStaffArgs(%+v)", *p)
+}
+
+func (p *UserServiceIsShopStaffArgs) DeepEqual(ano *UserServiceIsShopStaffArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceIsShopStaffArgs) Field1DeepEqual(src *IsShopStaffReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceIsShopStaffArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceIsShopStaffResult struct {
+	Success *IsShopStaffResp `thrift:"success,0,optional" frugal:"0,optional,IsShopStaffResp" json:"success,omitempty"`
+}
+
+func NewUserServiceIsShopStaffResult() *UserServiceIsShopStaffResult {
+	return &UserServiceIsShopStaffResult{}
+}
+
+func (p *UserServiceIsShopStaffResult) InitDefault() {
+}
+
+var UserServiceIsShopStaffResult_Success_DEFAULT *IsShopStaffResp
+
+func (p *UserServiceIsShopStaffResult) GetSuccess() (v *IsShopStaffResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceIsShopStaffResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceIsShopStaffResult) SetSuccess(x interface{}) {
+	p.Success = x.(*IsShopStaffResp)
+}
+
+func (p *UserServiceIsShopStaffResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceIsShopStaffResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceIsShopStaffResult(%+v)", *p)
+}
+
+func (p *UserServiceIsShopStaffResult) DeepEqual(ano *UserServiceIsShopStaffResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceIsShopStaffResult) Field0DeepEqual(src *IsShopStaffResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceIsShopStaffResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceUpdateProfileArgs struct {
+	Req *UpdateProfileReq `thrift:"req,1" frugal:"1,default,UpdateProfileReq" json:"req"`
+}
+
+func NewUserServiceUpdateProfileArgs() *UserServiceUpdateProfileArgs {
+	return &UserServiceUpdateProfileArgs{}
+}
+
+func (p *UserServiceUpdateProfileArgs) InitDefault() {
+}
+
+var UserServiceUpdateProfileArgs_Req_DEFAULT *UpdateProfileReq
+
+func (p *UserServiceUpdateProfileArgs) GetReq() (v *UpdateProfileReq) {
+	if !p.IsSetReq() {
+		return UserServiceUpdateProfileArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceUpdateProfileArgs) SetReq(val *UpdateProfileReq) {
+	p.Req = val
+}
+
+func (p *UserServiceUpdateProfileArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceUpdateProfileArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceUpdateProfileArgs(%+v)", *p)
+}
+
+func (p *UserServiceUpdateProfileArgs) DeepEqual(ano *UserServiceUpdateProfileArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceUpdateProfileArgs) Field1DeepEqual(src *UpdateProfileReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceUpdateProfileArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceUpdateProfileResult struct {
+	Success *UpdateProfileResp `thrift:"success,0,optional" frugal:"0,optional,UpdateProfileResp" json:"success,omitempty"`
+}
+
+func NewUserServiceUpdateProfileResult() *UserServiceUpdateProfileResult {
+	return &UserServiceUpdateProfileResult{}
+}
+
+func (p *UserServiceUpdateProfileResult) InitDefault() {
+}
+
+var UserServiceUpdateProfileResult_Success_DEFAULT *UpdateProfileResp
+
+func (p *UserServiceUpdateProfileResult) GetSuccess() (v *UpdateProfileResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceUpdateProfileResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceUpdateProfileResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UpdateProfileResp)
+}
+
+func (p *UserServiceUpdateProfileResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceUpdateProfileResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceUpdateProfileResult(%+v)", *p)
+}
+
+func (p *UserServiceUpdateProfileResult) DeepEqual(ano *UserServiceUpdateProfileResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceUpdateProfileResult) Field0DeepEqual(src *UpdateProfileResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceUpdateProfileResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceChangePasswordArgs struct {
+	Req *ChangePasswordReq `thrift:"req,1" frugal:"1,default,ChangePasswordReq" json:"req"`
+}
+
+func NewUserServiceChangePasswordArgs() *UserServiceChangePasswordArgs {
+	return &UserServiceChangePasswordArgs{}
+}
+
+func (p *UserServiceChangePasswordArgs) InitDefault() {
+}
+
+var UserServiceChangePasswordArgs_Req_DEFAULT *ChangePasswordReq
+
+func (p *UserServiceChangePasswordArgs) GetReq() (v *ChangePasswordReq) {
+	if !p.IsSetReq() {
+		return UserServiceChangePasswordArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceChangePasswordArgs) SetReq(val *ChangePasswordReq) {
+	p.Req = val
+}
+
+func (p *UserServiceChangePasswordArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceChangePasswordArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceChangePasswordArgs(%+v)", *p)
+}
+
+func (p *UserServiceChangePasswordArgs) DeepEqual(ano *UserServiceChangePasswordArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceChangePasswordArgs) Field1DeepEqual(src *ChangePasswordReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceChangePasswordArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceChangePasswordResult struct {
+	Success *ChangePasswordResp `thrift:"success,0,optional" frugal:"0,optional,ChangePasswordResp" json:"success,omitempty"`
+}
+
+func NewUserServiceChangePasswordResult() *UserServiceChangePasswordResult {
+	return &UserServiceChangePasswordResult{}
+}
+
+func (p *UserServiceChangePasswordResult) InitDefault() {
+}
+
+var UserServiceChangePasswordResult_Success_DEFAULT *ChangePasswordResp
+
+func (p *UserServiceChangePasswordResult) GetSuccess() (v *ChangePasswordResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceChangePasswordResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceChangePasswordResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ChangePasswordResp)
+}
+
+func (p *UserServiceChangePasswordResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceChangePasswordResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceChangePasswordResult(%+v)", *p)
+}
+
+func (p *UserServiceChangePasswordResult) DeepEqual(ano *UserServiceChangePasswordResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceChangePasswordResult) Field0DeepEqual(src *ChangePasswordResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceChangePasswordResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceRequestPasswordResetArgs struct {
+	Req *RequestPasswordResetReq `thrift:"req,1" frugal:"1,default,RequestPasswordResetReq" json:"req"`
+}
+
+func NewUserServiceRequestPasswordResetArgs() *UserServiceRequestPasswordResetArgs {
+	return &UserServiceRequestPasswordResetArgs{}
+}
+
+func (p *UserServiceRequestPasswordResetArgs) InitDefault() {
+}
+
+var UserServiceRequestPasswordResetArgs_Req_DEFAULT *RequestPasswordResetReq
+
+func (p *UserServiceRequestPasswordResetArgs) GetReq() (v *RequestPasswordResetReq) {
+	if !p.IsSetReq() {
+		return UserServiceRequestPasswordResetArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceRequestPasswordResetArgs) SetReq(val *RequestPasswordResetReq) {
+	p.Req = val
+}
+
+func (p *UserServiceRequestPasswordResetArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceRequestPasswordResetArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceRequestPasswordResetArgs(%+v)", *p)
+}
+
+func (p *UserServiceRequestPasswordResetArgs) DeepEqual(ano *UserServiceRequestPasswordResetArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceRequestPasswordResetArgs) Field1DeepEqual(src *RequestPasswordResetReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceRequestPasswordResetArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceRequestPasswordResetResult struct {
+	Success *RequestPasswordResetResp `thrift:"success,0,optional" frugal:"0,optional,RequestPasswordResetResp" json:"success,omitempty"`
+}
+
+func NewUserServiceRequestPasswordResetResult() *UserServiceRequestPasswordResetResult {
+	return &UserServiceRequestPasswordResetResult{}
+}
+
+func (p *UserServiceRequestPasswordResetResult) InitDefault() {
+}
+
+var UserServiceRequestPasswordResetResult_Success_DEFAULT *RequestPasswordResetResp
+
+func (p *UserServiceRequestPasswordResetResult) GetSuccess() (v *RequestPasswordResetResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceRequestPasswordResetResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceRequestPasswordResetResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RequestPasswordResetResp)
+}
+
+func (p *UserServiceRequestPasswordResetResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceRequestPasswordResetResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceRequestPasswordResetResult(%+v)", *p)
+}
+
+func (p *UserServiceRequestPasswordResetResult) DeepEqual(ano *UserServiceRequestPasswordResetResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceRequestPasswordResetResult) Field0DeepEqual(src *RequestPasswordResetResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceRequestPasswordResetResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceResetPasswordArgs struct {
+	Req *ResetPasswordReq `thrift:"req,1" frugal:"1,default,ResetPasswordReq" json:"req"`
+}
+
+func NewUserServiceResetPasswordArgs() *UserServiceResetPasswordArgs {
+	return &UserServiceResetPasswordArgs{}
+}
+
+func (p *UserServiceResetPasswordArgs) InitDefault() {
+}
+
+var UserServiceResetPasswordArgs_Req_DEFAULT *ResetPasswordReq
+
+func (p *UserServiceResetPasswordArgs) GetReq() (v *ResetPasswordReq) {
+	if !p.IsSetReq() {
+		return UserServiceResetPasswordArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceResetPasswordArgs) SetReq(val *ResetPasswordReq) {
+	p.Req = val
+}
+
+func (p *UserServiceResetPasswordArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceResetPasswordArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceResetPasswordArgs(%+v)", *p)
+}
+
+func (p *UserServiceResetPasswordArgs) DeepEqual(ano *UserServiceResetPasswordArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceResetPasswordArgs) Field1DeepEqual(src *ResetPasswordReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceResetPasswordArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceResetPasswordResult struct {
+	Success *ResetPasswordResp `thrift:"success,0,optional" frugal:"0,optional,ResetPasswordResp" json:"success,omitempty"`
+}
+
+func NewUserServiceResetPasswordResult() *UserServiceResetPasswordResult {
+	return &UserServiceResetPasswordResult{}
+}
+
+func (p *UserServiceResetPasswordResult) InitDefault() {
+}
+
+var UserServiceResetPasswordResult_Success_DEFAULT *ResetPasswordResp
+
+func (p *UserServiceResetPasswordResult) GetSuccess() (v *ResetPasswordResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceResetPasswordResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceResetPasswordResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ResetPasswordResp)
+}
+
+func (p *UserServiceResetPasswordResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceResetPasswordResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceResetPasswordResult(%+v)", *p)
+}
+
+func (p *UserServiceResetPasswordResult) DeepEqual(ano *UserServiceResetPasswordResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceResetPasswordResult) Field0DeepEqual(src *ResetPasswordResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceResetPasswordResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceExportUserDataArgs struct {
+	Req *ExportUserDataReq `thrift:"req,1" frugal:"1,default,ExportUserDataReq" json:"req"`
+}
+
+func NewUserServiceExportUserDataArgs() *UserServiceExportUserDataArgs {
+	return &UserServiceExportUserDataArgs{}
+}
+
+func (p *UserServiceExportUserDataArgs) InitDefault() {
+}
+
+var UserServiceExportUserDataArgs_Req_DEFAULT *ExportUserDataReq
+
+func (p *UserServiceExportUserDataArgs) GetReq() (v *ExportUserDataReq) {
+	if !p.IsSetReq() {
+		return UserServiceExportUserDataArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceExportUserDataArgs) SetReq(val *ExportUserDataReq) {
+	p.Req = val
+}
+
+func (p *UserServiceExportUserDataArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceExportUserDataArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceExportUserDataArgs(%+v)", *p)
+}
+
+func (p *UserServiceExportUserDataArgs) DeepEqual(ano *UserServiceExportUserDataArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceExportUserDataArgs) Field1DeepEqual(src *ExportUserDataReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceExportUserDataArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceExportUserDataResult struct {
+	Success *ExportUserDataResp `thrift:"success,0,optional" frugal:"0,optional,ExportUserDataResp" json:"success,omitempty"`
+}
+
+func NewUserServiceExportUserDataResult() *UserServiceExportUserDataResult {
+	return &UserServiceExportUserDataResult{}
+}
+
+func (p *UserServiceExportUserDataResult) InitDefault() {
+}
+
+var UserServiceExportUserDataResult_Success_DEFAULT *ExportUserDataResp
+
+func (p *UserServiceExportUserDataResult) GetSuccess() (v *ExportUserDataResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceExportUserDataResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceExportUserDataResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ExportUserDataResp)
+}
+
+func (p *UserServiceExportUserDataResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceExportUserDataResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceExportUserDataResult(%+v)", *p)
+}
+
+func (p *UserServiceExportUserDataResult) DeepEqual(ano *UserServiceExportUserDataResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceExportUserDataResult) Field0DeepEqual(src *ExportUserDataResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceExportUserDataResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceCloseAccountArgs struct {
+	Req *CloseAccountReq `thrift:"req,1" frugal:"1,default,CloseAccountReq" json:"req"`
+}
+
+func NewUserServiceCloseAccountArgs() *UserServiceCloseAccountArgs {
+	return &UserServiceCloseAccountArgs{}
+}
+
+func (p *UserServiceCloseAccountArgs) InitDefault() {
+}
+
+var UserServiceCloseAccountArgs_Req_DEFAULT *CloseAccountReq
+
+func (p *UserServiceCloseAccountArgs) GetReq() (v *CloseAccountReq) {
+	if !p.IsSetReq() {
+		return UserServiceCloseAccountArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceCloseAccountArgs) SetReq(val *CloseAccountReq) {
+	p.Req = val
+}
+
+func (p *UserServiceCloseAccountArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceCloseAccountArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceCloseAccountArgs(%+v)", *p)
+}
+
+func (p *UserServiceCloseAccountArgs) DeepEqual(ano *UserServiceCloseAccountArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceCloseAccountArgs) Field1DeepEqual(src *CloseAccountReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceCloseAccountArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceCloseAccountResult struct {
+	Success *CloseAccountResp `thrift:"success,0,optional" frugal:"0,optional,CloseAccountResp" json:"success,omitempty"`
+}
+
+func NewUserServiceCloseAccountResult() *UserServiceCloseAccountResult {
+	return &UserServiceCloseAccountResult{}
+}
+
+func (p *UserServiceCloseAccountResult) InitDefault() {
+}
+
+var UserServiceCloseAccountResult_Success_DEFAULT *CloseAccountResp
+
+func (p *UserServiceCloseAccountResult) GetSuccess() (v *CloseAccountResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceCloseAccountResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceCloseAccountResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CloseAccountResp)
+}
+
+func (p *UserServiceCloseAccountResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceCloseAccountResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceCloseAccountResult(%+v)", *p)
+}
+
+func (p *UserServiceCloseAccountResult) DeepEqual(ano *UserServiceCloseAccountResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceCloseAccountResult) Field0DeepEqual(src *CloseAccountResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceCloseAccountResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceEnrollTOTPArgs struct {
+	Req *EnrollTOTPReq `thrift:"req,1" frugal:"1,default,EnrollTOTPReq" json:"req"`
+}
+
+func NewUserServiceEnrollTOTPArgs() *UserServiceEnrollTOTPArgs {
+	return &UserServiceEnrollTOTPArgs{}
+}
+
+func (p *UserServiceEnrollTOTPArgs) InitDefault() {
+}
+
+var UserServiceEnrollTOTPArgs_Req_DEFAULT *EnrollTOTPReq
+
+func (p *UserServiceEnrollTOTPArgs) GetReq() (v *EnrollTOTPReq) {
+	if !p.IsSetReq() {
+		return UserServiceEnrollTOTPArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceEnrollTOTPArgs) SetReq(val *EnrollTOTPReq) {
+	p.Req = val
+}
+
+func (p *UserServiceEnrollTOTPArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceEnrollTOTPArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceEnrollTOTPArgs(%+v)", *p)
+}
+
+func (p *UserServiceEnrollTOTPArgs) DeepEqual(ano *UserServiceEnrollTOTPArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceEnrollTOTPArgs) Field1DeepEqual(src *EnrollTOTPReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceEnrollTOTPArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceEnrollTOTPResult struct {
+	Success *EnrollTOTPResp `thrift:"success,0,optional" frugal:"0,optional,EnrollTOTPResp" json:"success,omitempty"`
+}
+
+func NewUserServiceEnrollTOTPResult() *UserServiceEnrollTOTPResult {
+	return &UserServiceEnrollTOTPResult{}
+}
+
+func (p *UserServiceEnrollTOTPResult) InitDefault() {
+}
+
+var UserServiceEnrollTOTPResult_Success_DEFAULT *EnrollTOTPResp
+
+func (p *UserServiceEnrollTOTPResult) GetSuccess() (v *EnrollTOTPResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceEnrollTOTPResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceEnrollTOTPResult) SetSuccess(x interface{}) {
+	p.Success = x.(*EnrollTOTPResp)
+}
+
+func (p *UserServiceEnrollTOTPResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceEnrollTOTPResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceEnrollTOTPResult(%+v)", *p)
+}
+
+func (p *UserServiceEnrollTOTPResult) DeepEqual(ano *UserServiceEnrollTOTPResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceEnrollTOTPResult) Field0DeepEqual(src *EnrollTOTPResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceEnrollTOTPResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceConfirmTOTPArgs struct {
+	Req *ConfirmTOTPReq `thrift:"req,1" frugal:"1,default,ConfirmTOTPReq" json:"req"`
+}
+
+func NewUserServiceConfirmTOTPArgs() *UserServiceConfirmTOTPArgs {
+	return &UserServiceConfirmTOTPArgs{}
+}
+
+func (p *UserServiceConfirmTOTPArgs) InitDefault() {
+}
+
+var UserServiceConfirmTOTPArgs_Req_DEFAULT *ConfirmTOTPReq
+
+func (p *UserServiceConfirmTOTPArgs) GetReq() (v *ConfirmTOTPReq) {
+	if !p.IsSetReq() {
+		return UserServiceConfirmTOTPArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceConfirmTOTPArgs) SetReq(val *ConfirmTOTPReq) {
+	p.Req = val
+}
+
+func (p *UserServiceConfirmTOTPArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceConfirmTOTPArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceConfirmTOTPArgs(%+v)", *p)
+}
+
+func (p *UserServiceConfirmTOTPArgs) DeepEqual(ano *UserServiceConfirmTOTPArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceConfirmTOTPArgs) Field1DeepEqual(src *ConfirmTOTPReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceConfirmTOTPArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceConfirmTOTPResult struct {
+	Success *ConfirmTOTPResp `thrift:"success,0,optional" frugal:"0,optional,ConfirmTOTPResp" json:"success,omitempty"`
+}
+
+func NewUserServiceConfirmTOTPResult() *UserServiceConfirmTOTPResult {
+	return &UserServiceConfirmTOTPResult{}
+}
+
+func (p *UserServiceConfirmTOTPResult) InitDefault() {
+}
+
+var UserServiceConfirmTOTPResult_Success_DEFAULT *ConfirmTOTPResp
+
+func (p *UserServiceConfirmTOTPResult) GetSuccess() (v *ConfirmTOTPResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceConfirmTOTPResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceConfirmTOTPResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ConfirmTOTPResp)
+}
+
+func (p *UserServiceConfirmTOTPResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceConfirmTOTPResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceConfirmTOTPResult(%+v)", *p)
+}
+
+func (p *UserServiceConfirmTOTPResult) DeepEqual(ano *UserServiceConfirmTOTPResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceConfirmTOTPResult) Field0DeepEqual(src *ConfirmTOTPResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceConfirmTOTPResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceDisableTOTPArgs struct {
+	Req *DisableTOTPReq `thrift:"req,1" frugal:"1,default,DisableTOTPReq" json:"req"`
+}
+
+func NewUserServiceDisableTOTPArgs() *UserServiceDisableTOTPArgs {
+	return &UserServiceDisableTOTPArgs{}
+}
+
+func (p *UserServiceDisableTOTPArgs) InitDefault() {
+}
+
+var UserServiceDisableTOTPArgs_Req_DEFAULT *DisableTOTPReq
+
+func (p *UserServiceDisableTOTPArgs) GetReq() (v *DisableTOTPReq) {
+	if !p.IsSetReq() {
+		return UserServiceDisableTOTPArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceDisableTOTPArgs) SetReq(val *DisableTOTPReq) {
+	p.Req = val
+}
+
+func (p *UserServiceDisableTOTPArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceDisableTOTPArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceDisableTOTPArgs(%+v)", *p)
+}
+
+func (p *UserServiceDisableTOTPArgs) DeepEqual(ano *UserServiceDisableTOTPArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceDisableTOTPArgs) Field1DeepEqual(src *DisableTOTPReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
 }
 
-type UserService interface {
-	Register(ctx context.Context, req *RegisterRequest) (r *RegisterResponse, err error)
+var fieldIDToName_UserServiceDisableTOTPArgs = map[int16]string{
+	1: "req",
+}
 
-	Login(ctx context.Context, req *LoginRequest) (r *LoginResponse, err error)
+type UserServiceDisableTOTPResult struct {
+	Success *DisableTOTPResp `thrift:"success,0,optional" frugal:"0,optional,DisableTOTPResp" json:"success,omitempty"`
+}
 
-	GetAddress(ctx context.Context, req *GetAddressRequest) (r *GetAddressResponse, err error)
+func NewUserServiceDisableTOTPResult() *UserServiceDisableTOTPResult {
+	return &UserServiceDisableTOTPResult{}
+}
 
-	AddAddress(ctx context.Context, req *AddAddressRequest) (r *AddAddressResponse, err error)
+func (p *UserServiceDisableTOTPResult) InitDefault() {
+}
 
-	BanUser(ctx context.Context, req *BanUserReq) (r *BanUserResp, err error)
+var UserServiceDisableTOTPResult_Success_DEFAULT *DisableTOTPResp
 
-	LiftBandUser(ctx context.Context, req *LiftBanUserReq) (r *LiftBanUserResp, err error)
+func (p *UserServiceDisableTOTPResult) GetSuccess() (v *DisableTOTPResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceDisableTOTPResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceDisableTOTPResult) SetSuccess(x interface{}) {
+	p.Success = x.(*DisableTOTPResp)
+}
 
-	Logout(ctx context.Context, req *LogoutReq) (r *LogoutResp, err error)
+func (p *UserServiceDisableTOTPResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
 
-	SetAdministrator(ctx context.Context, req *SetAdministratorReq) (r *SetAdministratorResp, err error)
+func (p *UserServiceDisableTOTPResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceDisableTOTPResult(%+v)", *p)
+}
 
-	GetUserInfo(ctx context.Context, req *GetUserInfoReq) (r *GetUserInfoResp, err error)
+func (p *UserServiceDisableTOTPResult) DeepEqual(ano *UserServiceDisableTOTPResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
 }
 
-type UserServiceRegisterArgs struct {
-	Req *RegisterRequest `thrift:"req,1" frugal:"1,default,RegisterRequest" json:"req"`
+func (p *UserServiceDisableTOTPResult) Field0DeepEqual(src *DisableTOTPResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
 }
 
-func NewUserServiceRegisterArgs() *UserServiceRegisterArgs {
-	return &UserServiceRegisterArgs{}
+var fieldIDToName_UserServiceDisableTOTPResult = map[int16]string{
+	0: "success",
 }
 
-func (p *UserServiceRegisterArgs) InitDefault() {
+type UserServiceCreateApiKeyArgs struct {
+	Req *CreateApiKeyReq `thrift:"req,1" frugal:"1,default,CreateApiKeyReq" json:"req"`
 }
 
-var UserServiceRegisterArgs_Req_DEFAULT *RegisterRequest
+func NewUserServiceCreateApiKeyArgs() *UserServiceCreateApiKeyArgs {
+	return &UserServiceCreateApiKeyArgs{}
+}
 
-func (p *UserServiceRegisterArgs) GetReq() (v *RegisterRequest) {
+func (p *UserServiceCreateApiKeyArgs) InitDefault() {
+}
+
+var UserServiceCreateApiKeyArgs_Req_DEFAULT *CreateApiKeyReq
+
+func (p *UserServiceCreateApiKeyArgs) GetReq() (v *CreateApiKeyReq) {
 	if !p.IsSetReq() {
-		return UserServiceRegisterArgs_Req_DEFAULT
+		return UserServiceCreateApiKeyArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *UserServiceRegisterArgs) SetReq(val *RegisterRequest) {
+func (p *UserServiceCreateApiKeyArgs) SetReq(val *CreateApiKeyReq) {
 	p.Req = val
 }
 
-func (p *UserServiceRegisterArgs) IsSetReq() bool {
+func (p *UserServiceCreateApiKeyArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UserServiceRegisterArgs) String() string {
+func (p *UserServiceCreateApiKeyArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceRegisterArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceCreateApiKeyArgs(%+v)", *p)
 }
 
-func (p *UserServiceRegisterArgs) DeepEqual(ano *UserServiceRegisterArgs) bool {
+func (p *UserServiceCreateApiKeyArgs) DeepEqual(ano *UserServiceCreateApiKeyArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -1327,7 +11654,7 @@ func (p *UserServiceRegisterArgs) DeepEqual(ano *UserServiceRegisterArgs) bool {
 	return true
 }
 
-func (p *UserServiceRegisterArgs) Field1DeepEqual(src *RegisterRequest) bool {
+func (p *UserServiceCreateApiKeyArgs) Field1DeepEqual(src *CreateApiKeyReq) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -1335,45 +11662,277 @@ func (p *UserServiceRegisterArgs) Field1DeepEqual(src *RegisterRequest) bool {
 	return true
 }
 
-var fieldIDToName_UserServiceRegisterArgs = map[int16]string{
+var fieldIDToName_UserServiceCreateApiKeyArgs = map[int16]string{
 	1: "req",
 }
 
-type UserServiceRegisterResult struct {
-	Success *RegisterResponse `thrift:"success,0,optional" frugal:"0,optional,RegisterResponse" json:"success,omitempty"`
+type UserServiceCreateApiKeyResult struct {
+	Success *CreateApiKeyResp `thrift:"success,0,optional" frugal:"0,optional,CreateApiKeyResp" json:"success,omitempty"`
 }
 
-func NewUserServiceRegisterResult() *UserServiceRegisterResult {
-	return &UserServiceRegisterResult{}
+func NewUserServiceCreateApiKeyResult() *UserServiceCreateApiKeyResult {
+	return &UserServiceCreateApiKeyResult{}
 }
 
-func (p *UserServiceRegisterResult) InitDefault() {
+func (p *UserServiceCreateApiKeyResult) InitDefault() {
 }
 
-var UserServiceRegisterResult_Success_DEFAULT *RegisterResponse
+var UserServiceCreateApiKeyResult_Success_DEFAULT *CreateApiKeyResp
 
-func (p *UserServiceRegisterResult) GetSuccess() (v *RegisterResponse) {
+func (p *UserServiceCreateApiKeyResult) GetSuccess() (v *CreateApiKeyResp) {
 	if !p.IsSetSuccess() {
-		return UserServiceRegisterResult_Success_DEFAULT
+		return UserServiceCreateApiKeyResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceCreateApiKeyResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CreateApiKeyResp)
+}
+
+func (p *UserServiceCreateApiKeyResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceCreateApiKeyResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceCreateApiKeyResult(%+v)", *p)
+}
+
+func (p *UserServiceCreateApiKeyResult) DeepEqual(ano *UserServiceCreateApiKeyResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceCreateApiKeyResult) Field0DeepEqual(src *CreateApiKeyResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceCreateApiKeyResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceListApiKeysArgs struct {
+	Req *ListApiKeysReq `thrift:"req,1" frugal:"1,default,ListApiKeysReq" json:"req"`
+}
+
+func NewUserServiceListApiKeysArgs() *UserServiceListApiKeysArgs {
+	return &UserServiceListApiKeysArgs{}
+}
+
+func (p *UserServiceListApiKeysArgs) InitDefault() {
+}
+
+var UserServiceListApiKeysArgs_Req_DEFAULT *ListApiKeysReq
+
+func (p *UserServiceListApiKeysArgs) GetReq() (v *ListApiKeysReq) {
+	if !p.IsSetReq() {
+		return UserServiceListApiKeysArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceListApiKeysArgs) SetReq(val *ListApiKeysReq) {
+	p.Req = val
+}
+
+func (p *UserServiceListApiKeysArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceListApiKeysArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceListApiKeysArgs(%+v)", *p)
+}
+
+func (p *UserServiceListApiKeysArgs) DeepEqual(ano *UserServiceListApiKeysArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceListApiKeysArgs) Field1DeepEqual(src *ListApiKeysReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceListApiKeysArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceListApiKeysResult struct {
+	Success *ListApiKeysResp `thrift:"success,0,optional" frugal:"0,optional,ListApiKeysResp" json:"success,omitempty"`
+}
+
+func NewUserServiceListApiKeysResult() *UserServiceListApiKeysResult {
+	return &UserServiceListApiKeysResult{}
+}
+
+func (p *UserServiceListApiKeysResult) InitDefault() {
+}
+
+var UserServiceListApiKeysResult_Success_DEFAULT *ListApiKeysResp
+
+func (p *UserServiceListApiKeysResult) GetSuccess() (v *ListApiKeysResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceListApiKeysResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceListApiKeysResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListApiKeysResp)
+}
+
+func (p *UserServiceListApiKeysResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceListApiKeysResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceListApiKeysResult(%+v)", *p)
+}
+
+func (p *UserServiceListApiKeysResult) DeepEqual(ano *UserServiceListApiKeysResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceListApiKeysResult) Field0DeepEqual(src *ListApiKeysResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceListApiKeysResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceRevokeApiKeyArgs struct {
+	Req *RevokeApiKeyReq `thrift:"req,1" frugal:"1,default,RevokeApiKeyReq" json:"req"`
+}
+
+func NewUserServiceRevokeApiKeyArgs() *UserServiceRevokeApiKeyArgs {
+	return &UserServiceRevokeApiKeyArgs{}
+}
+
+func (p *UserServiceRevokeApiKeyArgs) InitDefault() {
+}
+
+var UserServiceRevokeApiKeyArgs_Req_DEFAULT *RevokeApiKeyReq
+
+func (p *UserServiceRevokeApiKeyArgs) GetReq() (v *RevokeApiKeyReq) {
+	if !p.IsSetReq() {
+		return UserServiceRevokeApiKeyArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceRevokeApiKeyArgs) SetReq(val *RevokeApiKeyReq) {
+	p.Req = val
+}
+
+func (p *UserServiceRevokeApiKeyArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceRevokeApiKeyArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceRevokeApiKeyArgs(%+v)", *p)
+}
+
+func (p *UserServiceRevokeApiKeyArgs) DeepEqual(ano *UserServiceRevokeApiKeyArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceRevokeApiKeyArgs) Field1DeepEqual(src *RevokeApiKeyReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceRevokeApiKeyArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceRevokeApiKeyResult struct {
+	Success *RevokeApiKeyResp `thrift:"success,0,optional" frugal:"0,optional,RevokeApiKeyResp" json:"success,omitempty"`
+}
+
+func NewUserServiceRevokeApiKeyResult() *UserServiceRevokeApiKeyResult {
+	return &UserServiceRevokeApiKeyResult{}
+}
+
+func (p *UserServiceRevokeApiKeyResult) InitDefault() {
+}
+
+var UserServiceRevokeApiKeyResult_Success_DEFAULT *RevokeApiKeyResp
+
+func (p *UserServiceRevokeApiKeyResult) GetSuccess() (v *RevokeApiKeyResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceRevokeApiKeyResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *UserServiceRegisterResult) SetSuccess(x interface{}) {
-	p.Success = x.(*RegisterResponse)
+func (p *UserServiceRevokeApiKeyResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RevokeApiKeyResp)
 }
 
-func (p *UserServiceRegisterResult) IsSetSuccess() bool {
+func (p *UserServiceRevokeApiKeyResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceRegisterResult) String() string {
+func (p *UserServiceRevokeApiKeyResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceRegisterResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceRevokeApiKeyResult(%+v)", *p)
 }
 
-func (p *UserServiceRegisterResult) DeepEqual(ano *UserServiceRegisterResult) bool {
+func (p *UserServiceRevokeApiKeyResult) DeepEqual(ano *UserServiceRevokeApiKeyResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -1385,7 +11944,7 @@ func (p *UserServiceRegisterResult) DeepEqual(ano *UserServiceRegisterResult) bo
 	return true
 }
 
-func (p *UserServiceRegisterResult) Field0DeepEqual(src *RegisterResponse) bool {
+func (p *UserServiceRevokeApiKeyResult) Field0DeepEqual(src *RevokeApiKeyResp) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -1393,45 +11952,45 @@ func (p *UserServiceRegisterResult) Field0DeepEqual(src *RegisterResponse) bool
 	return true
 }
 
-var fieldIDToName_UserServiceRegisterResult = map[int16]string{
+var fieldIDToName_UserServiceRevokeApiKeyResult = map[int16]string{
 	0: "success",
 }
 
-type UserServiceLoginArgs struct {
-	Req *LoginRequest `thrift:"req,1" frugal:"1,default,LoginRequest" json:"req"`
+type UserServiceVerifyApiKeyArgs struct {
+	Req *VerifyApiKeyReq `thrift:"req,1" frugal:"1,default,VerifyApiKeyReq" json:"req"`
 }
 
-func NewUserServiceLoginArgs() *UserServiceLoginArgs {
-	return &UserServiceLoginArgs{}
+func NewUserServiceVerifyApiKeyArgs() *UserServiceVerifyApiKeyArgs {
+	return &UserServiceVerifyApiKeyArgs{}
 }
 
-func (p *UserServiceLoginArgs) InitDefault() {
+func (p *UserServiceVerifyApiKeyArgs) InitDefault() {
 }
 
-var UserServiceLoginArgs_Req_DEFAULT *LoginRequest
+var UserServiceVerifyApiKeyArgs_Req_DEFAULT *VerifyApiKeyReq
 
-func (p *UserServiceLoginArgs) GetReq() (v *LoginRequest) {
+func (p *UserServiceVerifyApiKeyArgs) GetReq() (v *VerifyApiKeyReq) {
 	if !p.IsSetReq() {
-		return UserServiceLoginArgs_Req_DEFAULT
+		return UserServiceVerifyApiKeyArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *UserServiceLoginArgs) SetReq(val *LoginRequest) {
+func (p *UserServiceVerifyApiKeyArgs) SetReq(val *VerifyApiKeyReq) {
 	p.Req = val
 }
 
-func (p *UserServiceLoginArgs) IsSetReq() bool {
+func (p *UserServiceVerifyApiKeyArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UserServiceLoginArgs) String() string {
+func (p *UserServiceVerifyApiKeyArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceLoginArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceVerifyApiKeyArgs(%+v)", *p)
 }
 
-func (p *UserServiceLoginArgs) DeepEqual(ano *UserServiceLoginArgs) bool {
+func (p *UserServiceVerifyApiKeyArgs) DeepEqual(ano *UserServiceVerifyApiKeyArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -1443,7 +12002,7 @@ func (p *UserServiceLoginArgs) DeepEqual(ano *UserServiceLoginArgs) bool {
 	return true
 }
 
-func (p *UserServiceLoginArgs) Field1DeepEqual(src *LoginRequest) bool {
+func (p *UserServiceVerifyApiKeyArgs) Field1DeepEqual(src *VerifyApiKeyReq) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -1451,45 +12010,45 @@ func (p *UserServiceLoginArgs) Field1DeepEqual(src *LoginRequest) bool {
 	return true
 }
 
-var fieldIDToName_UserServiceLoginArgs = map[int16]string{
+var fieldIDToName_UserServiceVerifyApiKeyArgs = map[int16]string{
 	1: "req",
 }
 
-type UserServiceLoginResult struct {
-	Success *LoginResponse `thrift:"success,0,optional" frugal:"0,optional,LoginResponse" json:"success,omitempty"`
+type UserServiceVerifyApiKeyResult struct {
+	Success *VerifyApiKeyResp `thrift:"success,0,optional" frugal:"0,optional,VerifyApiKeyResp" json:"success,omitempty"`
 }
 
-func NewUserServiceLoginResult() *UserServiceLoginResult {
-	return &UserServiceLoginResult{}
+func NewUserServiceVerifyApiKeyResult() *UserServiceVerifyApiKeyResult {
+	return &UserServiceVerifyApiKeyResult{}
 }
 
-func (p *UserServiceLoginResult) InitDefault() {
+func (p *UserServiceVerifyApiKeyResult) InitDefault() {
 }
 
-var UserServiceLoginResult_Success_DEFAULT *LoginResponse
+var UserServiceVerifyApiKeyResult_Success_DEFAULT *VerifyApiKeyResp
 
-func (p *UserServiceLoginResult) GetSuccess() (v *LoginResponse) {
+func (p *UserServiceVerifyApiKeyResult) GetSuccess() (v *VerifyApiKeyResp) {
 	if !p.IsSetSuccess() {
-		return UserServiceLoginResult_Success_DEFAULT
+		return UserServiceVerifyApiKeyResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *UserServiceLoginResult) SetSuccess(x interface{}) {
-	p.Success = x.(*LoginResponse)
+func (p *UserServiceVerifyApiKeyResult) SetSuccess(x interface{}) {
+	p.Success = x.(*VerifyApiKeyResp)
 }
 
-func (p *UserServiceLoginResult) IsSetSuccess() bool {
+func (p *UserServiceVerifyApiKeyResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceLoginResult) String() string {
+func (p *UserServiceVerifyApiKeyResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceLoginResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceVerifyApiKeyResult(%+v)", *p)
 }
 
-func (p *UserServiceLoginResult) DeepEqual(ano *UserServiceLoginResult) bool {
+func (p *UserServiceVerifyApiKeyResult) DeepEqual(ano *UserServiceVerifyApiKeyResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -1501,7 +12060,7 @@ func (p *UserServiceLoginResult) DeepEqual(ano *UserServiceLoginResult) bool {
 	return true
 }
 
-func (p *UserServiceLoginResult) Field0DeepEqual(src *LoginResponse) bool {
+func (p *UserServiceVerifyApiKeyResult) Field0DeepEqual(src *VerifyApiKeyResp) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -1509,45 +12068,45 @@ func (p *UserServiceLoginResult) Field0DeepEqual(src *LoginResponse) bool {
 	return true
 }
 
-var fieldIDToName_UserServiceLoginResult = map[int16]string{
+var fieldIDToName_UserServiceVerifyApiKeyResult = map[int16]string{
 	0: "success",
 }
 
-type UserServiceGetAddressArgs struct {
-	Req *GetAddressRequest `thrift:"req,1" frugal:"1,default,GetAddressRequest" json:"req"`
+type UserServiceListAuditLogsArgs struct {
+	Req *ListAuditLogsReq `thrift:"req,1" frugal:"1,default,ListAuditLogsReq" json:"req"`
 }
 
-func NewUserServiceGetAddressArgs() *UserServiceGetAddressArgs {
-	return &UserServiceGetAddressArgs{}
+func NewUserServiceListAuditLogsArgs() *UserServiceListAuditLogsArgs {
+	return &UserServiceListAuditLogsArgs{}
 }
 
-func (p *UserServiceGetAddressArgs) InitDefault() {
+func (p *UserServiceListAuditLogsArgs) InitDefault() {
 }
 
-var UserServiceGetAddressArgs_Req_DEFAULT *GetAddressRequest
+var UserServiceListAuditLogsArgs_Req_DEFAULT *ListAuditLogsReq
 
-func (p *UserServiceGetAddressArgs) GetReq() (v *GetAddressRequest) {
+func (p *UserServiceListAuditLogsArgs) GetReq() (v *ListAuditLogsReq) {
 	if !p.IsSetReq() {
-		return UserServiceGetAddressArgs_Req_DEFAULT
+		return UserServiceListAuditLogsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *UserServiceGetAddressArgs) SetReq(val *GetAddressRequest) {
+func (p *UserServiceListAuditLogsArgs) SetReq(val *ListAuditLogsReq) {
 	p.Req = val
 }
 
-func (p *UserServiceGetAddressArgs) IsSetReq() bool {
+func (p *UserServiceListAuditLogsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UserServiceGetAddressArgs) String() string {
+func (p *UserServiceListAuditLogsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceGetAddressArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceListAuditLogsArgs(%+v)", *p)
 }
 
-func (p *UserServiceGetAddressArgs) DeepEqual(ano *UserServiceGetAddressArgs) bool {
+func (p *UserServiceListAuditLogsArgs) DeepEqual(ano *UserServiceListAuditLogsArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -1559,7 +12118,7 @@ func (p *UserServiceGetAddressArgs) DeepEqual(ano *UserServiceGetAddressArgs) bo
 	return true
 }
 
-func (p *UserServiceGetAddressArgs) Field1DeepEqual(src *GetAddressRequest) bool {
+func (p *UserServiceListAuditLogsArgs) Field1DeepEqual(src *ListAuditLogsReq) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -1567,45 +12126,45 @@ func (p *UserServiceGetAddressArgs) Field1DeepEqual(src *GetAddressRequest) bool
 	return true
 }
 
-var fieldIDToName_UserServiceGetAddressArgs = map[int16]string{
+var fieldIDToName_UserServiceListAuditLogsArgs = map[int16]string{
 	1: "req",
 }
 
-type UserServiceGetAddressResult struct {
-	Success *GetAddressResponse `thrift:"success,0,optional" frugal:"0,optional,GetAddressResponse" json:"success,omitempty"`
+type UserServiceListAuditLogsResult struct {
+	Success *ListAuditLogsResp `thrift:"success,0,optional" frugal:"0,optional,ListAuditLogsResp" json:"success,omitempty"`
 }
 
-func NewUserServiceGetAddressResult() *UserServiceGetAddressResult {
-	return &UserServiceGetAddressResult{}
+func NewUserServiceListAuditLogsResult() *UserServiceListAuditLogsResult {
+	return &UserServiceListAuditLogsResult{}
 }
 
-func (p *UserServiceGetAddressResult) InitDefault() {
+func (p *UserServiceListAuditLogsResult) InitDefault() {
 }
 
-var UserServiceGetAddressResult_Success_DEFAULT *GetAddressResponse
+var UserServiceListAuditLogsResult_Success_DEFAULT *ListAuditLogsResp
 
-func (p *UserServiceGetAddressResult) GetSuccess() (v *GetAddressResponse) {
+func (p *UserServiceListAuditLogsResult) GetSuccess() (v *ListAuditLogsResp) {
 	if !p.IsSetSuccess() {
-		return UserServiceGetAddressResult_Success_DEFAULT
+		return UserServiceListAuditLogsResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *UserServiceGetAddressResult) SetSuccess(x interface{}) {
-	p.Success = x.(*GetAddressResponse)
+func (p *UserServiceListAuditLogsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListAuditLogsResp)
 }
 
-func (p *UserServiceGetAddressResult) IsSetSuccess() bool {
+func (p *UserServiceListAuditLogsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceGetAddressResult) String() string {
+func (p *UserServiceListAuditLogsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceGetAddressResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceListAuditLogsResult(%+v)", *p)
 }
 
-func (p *UserServiceGetAddressResult) DeepEqual(ano *UserServiceGetAddressResult) bool {
+func (p *UserServiceListAuditLogsResult) DeepEqual(ano *UserServiceListAuditLogsResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -1617,7 +12176,7 @@ func (p *UserServiceGetAddressResult) DeepEqual(ano *UserServiceGetAddressResult
 	return true
 }
 
-func (p *UserServiceGetAddressResult) Field0DeepEqual(src *GetAddressResponse) bool {
+func (p *UserServiceListAuditLogsResult) Field0DeepEqual(src *ListAuditLogsResp) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -1625,45 +12184,45 @@ func (p *UserServiceGetAddressResult) Field0DeepEqual(src *GetAddressResponse) b
 	return true
 }
 
-var fieldIDToName_UserServiceGetAddressResult = map[int16]string{
+var fieldIDToName_UserServiceListAuditLogsResult = map[int16]string{
 	0: "success",
 }
 
-type UserServiceAddAddressArgs struct {
-	Req *AddAddressRequest `thrift:"req,1" frugal:"1,default,AddAddressRequest" json:"req"`
+type UserServiceCreateWebhookEndpointArgs struct {
+	Req *CreateWebhookEndpointReq `thrift:"req,1" frugal:"1,default,CreateWebhookEndpointReq" json:"req"`
 }
 
-func NewUserServiceAddAddressArgs() *UserServiceAddAddressArgs {
-	return &UserServiceAddAddressArgs{}
+func NewUserServiceCreateWebhookEndpointArgs() *UserServiceCreateWebhookEndpointArgs {
+	return &UserServiceCreateWebhookEndpointArgs{}
 }
 
-func (p *UserServiceAddAddressArgs) InitDefault() {
+func (p *UserServiceCreateWebhookEndpointArgs) InitDefault() {
 }
 
-var UserServiceAddAddressArgs_Req_DEFAULT *AddAddressRequest
+var UserServiceCreateWebhookEndpointArgs_Req_DEFAULT *CreateWebhookEndpointReq
 
-func (p *UserServiceAddAddressArgs) GetReq() (v *AddAddressRequest) {
+func (p *UserServiceCreateWebhookEndpointArgs) GetReq() (v *CreateWebhookEndpointReq) {
 	if !p.IsSetReq() {
-		return UserServiceAddAddressArgs_Req_DEFAULT
+		return UserServiceCreateWebhookEndpointArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *UserServiceAddAddressArgs) SetReq(val *AddAddressRequest) {
+func (p *UserServiceCreateWebhookEndpointArgs) SetReq(val *CreateWebhookEndpointReq) {
 	p.Req = val
 }
 
-func (p *UserServiceAddAddressArgs) IsSetReq() bool {
+func (p *UserServiceCreateWebhookEndpointArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UserServiceAddAddressArgs) String() string {
+func (p *UserServiceCreateWebhookEndpointArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceAddAddressArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceCreateWebhookEndpointArgs(%+v)", *p)
 }
 
-func (p *UserServiceAddAddressArgs) DeepEqual(ano *UserServiceAddAddressArgs) bool {
+func (p *UserServiceCreateWebhookEndpointArgs) DeepEqual(ano *UserServiceCreateWebhookEndpointArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -1675,7 +12234,7 @@ func (p *UserServiceAddAddressArgs) DeepEqual(ano *UserServiceAddAddressArgs) bo
 	return true
 }
 
-func (p *UserServiceAddAddressArgs) Field1DeepEqual(src *AddAddressRequest) bool {
+func (p *UserServiceCreateWebhookEndpointArgs) Field1DeepEqual(src *CreateWebhookEndpointReq) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -1683,45 +12242,45 @@ func (p *UserServiceAddAddressArgs) Field1DeepEqual(src *AddAddressRequest) bool
 	return true
 }
 
-var fieldIDToName_UserServiceAddAddressArgs = map[int16]string{
+var fieldIDToName_UserServiceCreateWebhookEndpointArgs = map[int16]string{
 	1: "req",
 }
 
-type UserServiceAddAddressResult struct {
-	Success *AddAddressResponse `thrift:"success,0,optional" frugal:"0,optional,AddAddressResponse" json:"success,omitempty"`
+type UserServiceCreateWebhookEndpointResult struct {
+	Success *CreateWebhookEndpointResp `thrift:"success,0,optional" frugal:"0,optional,CreateWebhookEndpointResp" json:"success,omitempty"`
 }
 
-func NewUserServiceAddAddressResult() *UserServiceAddAddressResult {
-	return &UserServiceAddAddressResult{}
+func NewUserServiceCreateWebhookEndpointResult() *UserServiceCreateWebhookEndpointResult {
+	return &UserServiceCreateWebhookEndpointResult{}
 }
 
-func (p *UserServiceAddAddressResult) InitDefault() {
+func (p *UserServiceCreateWebhookEndpointResult) InitDefault() {
 }
 
-var UserServiceAddAddressResult_Success_DEFAULT *AddAddressResponse
+var UserServiceCreateWebhookEndpointResult_Success_DEFAULT *CreateWebhookEndpointResp
 
-func (p *UserServiceAddAddressResult) GetSuccess() (v *AddAddressResponse) {
+func (p *UserServiceCreateWebhookEndpointResult) GetSuccess() (v *CreateWebhookEndpointResp) {
 	if !p.IsSetSuccess() {
-		return UserServiceAddAddressResult_Success_DEFAULT
+		return UserServiceCreateWebhookEndpointResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *UserServiceAddAddressResult) SetSuccess(x interface{}) {
-	p.Success = x.(*AddAddressResponse)
+func (p *UserServiceCreateWebhookEndpointResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CreateWebhookEndpointResp)
 }
 
-func (p *UserServiceAddAddressResult) IsSetSuccess() bool {
+func (p *UserServiceCreateWebhookEndpointResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceAddAddressResult) String() string {
+func (p *UserServiceCreateWebhookEndpointResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceAddAddressResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceCreateWebhookEndpointResult(%+v)", *p)
 }
 
-func (p *UserServiceAddAddressResult) DeepEqual(ano *UserServiceAddAddressResult) bool {
+func (p *UserServiceCreateWebhookEndpointResult) DeepEqual(ano *UserServiceCreateWebhookEndpointResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -1733,7 +12292,7 @@ func (p *UserServiceAddAddressResult) DeepEqual(ano *UserServiceAddAddressResult
 	return true
 }
 
-func (p *UserServiceAddAddressResult) Field0DeepEqual(src *AddAddressResponse) bool {
+func (p *UserServiceCreateWebhookEndpointResult) Field0DeepEqual(src *CreateWebhookEndpointResp) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -1741,45 +12300,45 @@ func (p *UserServiceAddAddressResult) Field0DeepEqual(src *AddAddressResponse) b
 	return true
 }
 
-var fieldIDToName_UserServiceAddAddressResult = map[int16]string{
+var fieldIDToName_UserServiceCreateWebhookEndpointResult = map[int16]string{
 	0: "success",
 }
 
-type UserServiceBanUserArgs struct {
-	Req *BanUserReq `thrift:"req,1" frugal:"1,default,BanUserReq" json:"req"`
+type UserServiceListWebhookEndpointsArgs struct {
+	Req *ListWebhookEndpointsReq `thrift:"req,1" frugal:"1,default,ListWebhookEndpointsReq" json:"req"`
 }
 
-func NewUserServiceBanUserArgs() *UserServiceBanUserArgs {
-	return &UserServiceBanUserArgs{}
+func NewUserServiceListWebhookEndpointsArgs() *UserServiceListWebhookEndpointsArgs {
+	return &UserServiceListWebhookEndpointsArgs{}
 }
 
-func (p *UserServiceBanUserArgs) InitDefault() {
+func (p *UserServiceListWebhookEndpointsArgs) InitDefault() {
 }
 
-var UserServiceBanUserArgs_Req_DEFAULT *BanUserReq
+var UserServiceListWebhookEndpointsArgs_Req_DEFAULT *ListWebhookEndpointsReq
 
-func (p *UserServiceBanUserArgs) GetReq() (v *BanUserReq) {
+func (p *UserServiceListWebhookEndpointsArgs) GetReq() (v *ListWebhookEndpointsReq) {
 	if !p.IsSetReq() {
-		return UserServiceBanUserArgs_Req_DEFAULT
+		return UserServiceListWebhookEndpointsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *UserServiceBanUserArgs) SetReq(val *BanUserReq) {
+func (p *UserServiceListWebhookEndpointsArgs) SetReq(val *ListWebhookEndpointsReq) {
 	p.Req = val
 }
 
-func (p *UserServiceBanUserArgs) IsSetReq() bool {
+func (p *UserServiceListWebhookEndpointsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UserServiceBanUserArgs) String() string {
+func (p *UserServiceListWebhookEndpointsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceBanUserArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceListWebhookEndpointsArgs(%+v)", *p)
 }
 
-func (p *UserServiceBanUserArgs) DeepEqual(ano *UserServiceBanUserArgs) bool {
+func (p *UserServiceListWebhookEndpointsArgs) DeepEqual(ano *UserServiceListWebhookEndpointsArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -1791,7 +12350,7 @@ func (p *UserServiceBanUserArgs) DeepEqual(ano *UserServiceBanUserArgs) bool {
 	return true
 }
 
-func (p *UserServiceBanUserArgs) Field1DeepEqual(src *BanUserReq) bool {
+func (p *UserServiceListWebhookEndpointsArgs) Field1DeepEqual(src *ListWebhookEndpointsReq) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -1799,45 +12358,45 @@ func (p *UserServiceBanUserArgs) Field1DeepEqual(src *BanUserReq) bool {
 	return true
 }
 
-var fieldIDToName_UserServiceBanUserArgs = map[int16]string{
+var fieldIDToName_UserServiceListWebhookEndpointsArgs = map[int16]string{
 	1: "req",
 }
 
-type UserServiceBanUserResult struct {
-	Success *BanUserResp `thrift:"success,0,optional" frugal:"0,optional,BanUserResp" json:"success,omitempty"`
+type UserServiceListWebhookEndpointsResult struct {
+	Success *ListWebhookEndpointsResp `thrift:"success,0,optional" frugal:"0,optional,ListWebhookEndpointsResp" json:"success,omitempty"`
 }
 
-func NewUserServiceBanUserResult() *UserServiceBanUserResult {
-	return &UserServiceBanUserResult{}
+func NewUserServiceListWebhookEndpointsResult() *UserServiceListWebhookEndpointsResult {
+	return &UserServiceListWebhookEndpointsResult{}
 }
 
-func (p *UserServiceBanUserResult) InitDefault() {
+func (p *UserServiceListWebhookEndpointsResult) InitDefault() {
 }
 
-var UserServiceBanUserResult_Success_DEFAULT *BanUserResp
+var UserServiceListWebhookEndpointsResult_Success_DEFAULT *ListWebhookEndpointsResp
 
-func (p *UserServiceBanUserResult) GetSuccess() (v *BanUserResp) {
+func (p *UserServiceListWebhookEndpointsResult) GetSuccess() (v *ListWebhookEndpointsResp) {
 	if !p.IsSetSuccess() {
-		return UserServiceBanUserResult_Success_DEFAULT
+		return UserServiceListWebhookEndpointsResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *UserServiceBanUserResult) SetSuccess(x interface{}) {
-	p.Success = x.(*BanUserResp)
+func (p *UserServiceListWebhookEndpointsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListWebhookEndpointsResp)
 }
 
-func (p *UserServiceBanUserResult) IsSetSuccess() bool {
+func (p *UserServiceListWebhookEndpointsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceBanUserResult) String() string {
+func (p *UserServiceListWebhookEndpointsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceBanUserResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceListWebhookEndpointsResult(%+v)", *p)
 }
 
-func (p *UserServiceBanUserResult) DeepEqual(ano *UserServiceBanUserResult) bool {
+func (p *UserServiceListWebhookEndpointsResult) DeepEqual(ano *UserServiceListWebhookEndpointsResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -1849,7 +12408,7 @@ func (p *UserServiceBanUserResult) DeepEqual(ano *UserServiceBanUserResult) bool
 	return true
 }
 
-func (p *UserServiceBanUserResult) Field0DeepEqual(src *BanUserResp) bool {
+func (p *UserServiceListWebhookEndpointsResult) Field0DeepEqual(src *ListWebhookEndpointsResp) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -1857,45 +12416,45 @@ func (p *UserServiceBanUserResult) Field0DeepEqual(src *BanUserResp) bool {
 	return true
 }
 
-var fieldIDToName_UserServiceBanUserResult = map[int16]string{
+var fieldIDToName_UserServiceListWebhookEndpointsResult = map[int16]string{
 	0: "success",
 }
 
-type UserServiceLiftBandUserArgs struct {
-	Req *LiftBanUserReq `thrift:"req,1" frugal:"1,default,LiftBanUserReq" json:"req"`
+type UserServiceUpdateWebhookEndpointArgs struct {
+	Req *UpdateWebhookEndpointReq `thrift:"req,1" frugal:"1,default,UpdateWebhookEndpointReq" json:"req"`
 }
 
-func NewUserServiceLiftBandUserArgs() *UserServiceLiftBandUserArgs {
-	return &UserServiceLiftBandUserArgs{}
+func NewUserServiceUpdateWebhookEndpointArgs() *UserServiceUpdateWebhookEndpointArgs {
+	return &UserServiceUpdateWebhookEndpointArgs{}
 }
 
-func (p *UserServiceLiftBandUserArgs) InitDefault() {
+func (p *UserServiceUpdateWebhookEndpointArgs) InitDefault() {
 }
 
-var UserServiceLiftBandUserArgs_Req_DEFAULT *LiftBanUserReq
+var UserServiceUpdateWebhookEndpointArgs_Req_DEFAULT *UpdateWebhookEndpointReq
 
-func (p *UserServiceLiftBandUserArgs) GetReq() (v *LiftBanUserReq) {
+func (p *UserServiceUpdateWebhookEndpointArgs) GetReq() (v *UpdateWebhookEndpointReq) {
 	if !p.IsSetReq() {
-		return UserServiceLiftBandUserArgs_Req_DEFAULT
+		return UserServiceUpdateWebhookEndpointArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *UserServiceLiftBandUserArgs) SetReq(val *LiftBanUserReq) {
+func (p *UserServiceUpdateWebhookEndpointArgs) SetReq(val *UpdateWebhookEndpointReq) {
 	p.Req = val
 }
 
-func (p *UserServiceLiftBandUserArgs) IsSetReq() bool {
+func (p *UserServiceUpdateWebhookEndpointArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UserServiceLiftBandUserArgs) String() string {
+func (p *UserServiceUpdateWebhookEndpointArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceLiftBandUserArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceUpdateWebhookEndpointArgs(%+v)", *p)
 }
 
-func (p *UserServiceLiftBandUserArgs) DeepEqual(ano *UserServiceLiftBandUserArgs) bool {
+func (p *UserServiceUpdateWebhookEndpointArgs) DeepEqual(ano *UserServiceUpdateWebhookEndpointArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -1907,7 +12466,7 @@ func (p *UserServiceLiftBandUserArgs) DeepEqual(ano *UserServiceLiftBandUserArgs
 	return true
 }
 
-func (p *UserServiceLiftBandUserArgs) Field1DeepEqual(src *LiftBanUserReq) bool {
+func (p *UserServiceUpdateWebhookEndpointArgs) Field1DeepEqual(src *UpdateWebhookEndpointReq) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -1915,45 +12474,45 @@ func (p *UserServiceLiftBandUserArgs) Field1DeepEqual(src *LiftBanUserReq) bool
 	return true
 }
 
-var fieldIDToName_UserServiceLiftBandUserArgs = map[int16]string{
+var fieldIDToName_UserServiceUpdateWebhookEndpointArgs = map[int16]string{
 	1: "req",
 }
 
-type UserServiceLiftBandUserResult struct {
-	Success *LiftBanUserResp `thrift:"success,0,optional" frugal:"0,optional,LiftBanUserResp" json:"success,omitempty"`
+type UserServiceUpdateWebhookEndpointResult struct {
+	Success *UpdateWebhookEndpointResp `thrift:"success,0,optional" frugal:"0,optional,UpdateWebhookEndpointResp" json:"success,omitempty"`
 }
 
-func NewUserServiceLiftBandUserResult() *UserServiceLiftBandUserResult {
-	return &UserServiceLiftBandUserResult{}
+func NewUserServiceUpdateWebhookEndpointResult() *UserServiceUpdateWebhookEndpointResult {
+	return &UserServiceUpdateWebhookEndpointResult{}
 }
 
-func (p *UserServiceLiftBandUserResult) InitDefault() {
+func (p *UserServiceUpdateWebhookEndpointResult) InitDefault() {
 }
 
-var UserServiceLiftBandUserResult_Success_DEFAULT *LiftBanUserResp
+var UserServiceUpdateWebhookEndpointResult_Success_DEFAULT *UpdateWebhookEndpointResp
 
-func (p *UserServiceLiftBandUserResult) GetSuccess() (v *LiftBanUserResp) {
+func (p *UserServiceUpdateWebhookEndpointResult) GetSuccess() (v *UpdateWebhookEndpointResp) {
 	if !p.IsSetSuccess() {
-		return UserServiceLiftBandUserResult_Success_DEFAULT
+		return UserServiceUpdateWebhookEndpointResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *UserServiceLiftBandUserResult) SetSuccess(x interface{}) {
-	p.Success = x.(*LiftBanUserResp)
+func (p *UserServiceUpdateWebhookEndpointResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UpdateWebhookEndpointResp)
 }
 
-func (p *UserServiceLiftBandUserResult) IsSetSuccess() bool {
+func (p *UserServiceUpdateWebhookEndpointResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceLiftBandUserResult) String() string {
+func (p *UserServiceUpdateWebhookEndpointResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceLiftBandUserResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceUpdateWebhookEndpointResult(%+v)", *p)
 }
 
-func (p *UserServiceLiftBandUserResult) DeepEqual(ano *UserServiceLiftBandUserResult) bool {
+func (p *UserServiceUpdateWebhookEndpointResult) DeepEqual(ano *UserServiceUpdateWebhookEndpointResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -1965,7 +12524,7 @@ func (p *UserServiceLiftBandUserResult) DeepEqual(ano *UserServiceLiftBandUserRe
 	return true
 }
 
-func (p *UserServiceLiftBandUserResult) Field0DeepEqual(src *LiftBanUserResp) bool {
+func (p *UserServiceUpdateWebhookEndpointResult) Field0DeepEqual(src *UpdateWebhookEndpointResp) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -1973,45 +12532,45 @@ func (p *UserServiceLiftBandUserResult) Field0DeepEqual(src *LiftBanUserResp) bo
 	return true
 }
 
-var fieldIDToName_UserServiceLiftBandUserResult = map[int16]string{
+var fieldIDToName_UserServiceUpdateWebhookEndpointResult = map[int16]string{
 	0: "success",
 }
 
-type UserServiceLogoutArgs struct {
-	Req *LogoutReq `thrift:"req,1" frugal:"1,default,LogoutReq" json:"req"`
+type UserServiceDeleteWebhookEndpointArgs struct {
+	Req *DeleteWebhookEndpointReq `thrift:"req,1" frugal:"1,default,DeleteWebhookEndpointReq" json:"req"`
 }
 
-func NewUserServiceLogoutArgs() *UserServiceLogoutArgs {
-	return &UserServiceLogoutArgs{}
+func NewUserServiceDeleteWebhookEndpointArgs() *UserServiceDeleteWebhookEndpointArgs {
+	return &UserServiceDeleteWebhookEndpointArgs{}
 }
 
-func (p *UserServiceLogoutArgs) InitDefault() {
+func (p *UserServiceDeleteWebhookEndpointArgs) InitDefault() {
 }
 
-var UserServiceLogoutArgs_Req_DEFAULT *LogoutReq
+var UserServiceDeleteWebhookEndpointArgs_Req_DEFAULT *DeleteWebhookEndpointReq
 
-func (p *UserServiceLogoutArgs) GetReq() (v *LogoutReq) {
+func (p *UserServiceDeleteWebhookEndpointArgs) GetReq() (v *DeleteWebhookEndpointReq) {
 	if !p.IsSetReq() {
-		return UserServiceLogoutArgs_Req_DEFAULT
+		return UserServiceDeleteWebhookEndpointArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *UserServiceLogoutArgs) SetReq(val *LogoutReq) {
+func (p *UserServiceDeleteWebhookEndpointArgs) SetReq(val *DeleteWebhookEndpointReq) {
 	p.Req = val
 }
 
-func (p *UserServiceLogoutArgs) IsSetReq() bool {
+func (p *UserServiceDeleteWebhookEndpointArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UserServiceLogoutArgs) String() string {
+func (p *UserServiceDeleteWebhookEndpointArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceLogoutArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceDeleteWebhookEndpointArgs(%+v)", *p)
 }
 
-func (p *UserServiceLogoutArgs) DeepEqual(ano *UserServiceLogoutArgs) bool {
+func (p *UserServiceDeleteWebhookEndpointArgs) DeepEqual(ano *UserServiceDeleteWebhookEndpointArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -2023,7 +12582,7 @@ func (p *UserServiceLogoutArgs) DeepEqual(ano *UserServiceLogoutArgs) bool {
 	return true
 }
 
-func (p *UserServiceLogoutArgs) Field1DeepEqual(src *LogoutReq) bool {
+func (p *UserServiceDeleteWebhookEndpointArgs) Field1DeepEqual(src *DeleteWebhookEndpointReq) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -2031,45 +12590,45 @@ func (p *UserServiceLogoutArgs) Field1DeepEqual(src *LogoutReq) bool {
 	return true
 }
 
-var fieldIDToName_UserServiceLogoutArgs = map[int16]string{
+var fieldIDToName_UserServiceDeleteWebhookEndpointArgs = map[int16]string{
 	1: "req",
 }
 
-type UserServiceLogoutResult struct {
-	Success *LogoutResp `thrift:"success,0,optional" frugal:"0,optional,LogoutResp" json:"success,omitempty"`
+type UserServiceDeleteWebhookEndpointResult struct {
+	Success *DeleteWebhookEndpointResp `thrift:"success,0,optional" frugal:"0,optional,DeleteWebhookEndpointResp" json:"success,omitempty"`
 }
 
-func NewUserServiceLogoutResult() *UserServiceLogoutResult {
-	return &UserServiceLogoutResult{}
+func NewUserServiceDeleteWebhookEndpointResult() *UserServiceDeleteWebhookEndpointResult {
+	return &UserServiceDeleteWebhookEndpointResult{}
 }
 
-func (p *UserServiceLogoutResult) InitDefault() {
+func (p *UserServiceDeleteWebhookEndpointResult) InitDefault() {
 }
 
-var UserServiceLogoutResult_Success_DEFAULT *LogoutResp
+var UserServiceDeleteWebhookEndpointResult_Success_DEFAULT *DeleteWebhookEndpointResp
 
-func (p *UserServiceLogoutResult) GetSuccess() (v *LogoutResp) {
+func (p *UserServiceDeleteWebhookEndpointResult) GetSuccess() (v *DeleteWebhookEndpointResp) {
 	if !p.IsSetSuccess() {
-		return UserServiceLogoutResult_Success_DEFAULT
+		return UserServiceDeleteWebhookEndpointResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *UserServiceLogoutResult) SetSuccess(x interface{}) {
-	p.Success = x.(*LogoutResp)
+func (p *UserServiceDeleteWebhookEndpointResult) SetSuccess(x interface{}) {
+	p.Success = x.(*DeleteWebhookEndpointResp)
 }
 
-func (p *UserServiceLogoutResult) IsSetSuccess() bool {
+func (p *UserServiceDeleteWebhookEndpointResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceLogoutResult) String() string {
+func (p *UserServiceDeleteWebhookEndpointResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceLogoutResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceDeleteWebhookEndpointResult(%+v)", *p)
 }
 
-func (p *UserServiceLogoutResult) DeepEqual(ano *UserServiceLogoutResult) bool {
+func (p *UserServiceDeleteWebhookEndpointResult) DeepEqual(ano *UserServiceDeleteWebhookEndpointResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -2081,7 +12640,7 @@ func (p *UserServiceLogoutResult) DeepEqual(ano *UserServiceLogoutResult) bool {
 	return true
 }
 
-func (p *UserServiceLogoutResult) Field0DeepEqual(src *LogoutResp) bool {
+func (p *UserServiceDeleteWebhookEndpointResult) Field0DeepEqual(src *DeleteWebhookEndpointResp) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -2089,45 +12648,45 @@ func (p *UserServiceLogoutResult) Field0DeepEqual(src *LogoutResp) bool {
 	return true
 }
 
-var fieldIDToName_UserServiceLogoutResult = map[int16]string{
+var fieldIDToName_UserServiceDeleteWebhookEndpointResult = map[int16]string{
 	0: "success",
 }
 
-type UserServiceSetAdministratorArgs struct {
-	Req *SetAdministratorReq `thrift:"req,1" frugal:"1,default,SetAdministratorReq" json:"req"`
+type UserServiceListWebhookDeliveriesArgs struct {
+	Req *ListWebhookDeliveriesReq `thrift:"req,1" frugal:"1,default,ListWebhookDeliveriesReq" json:"req"`
 }
 
-func NewUserServiceSetAdministratorArgs() *UserServiceSetAdministratorArgs {
-	return &UserServiceSetAdministratorArgs{}
+func NewUserServiceListWebhookDeliveriesArgs() *UserServiceListWebhookDeliveriesArgs {
+	return &UserServiceListWebhookDeliveriesArgs{}
 }
 
-func (p *UserServiceSetAdministratorArgs) InitDefault() {
+func (p *UserServiceListWebhookDeliveriesArgs) InitDefault() {
 }
 
-var UserServiceSetAdministratorArgs_Req_DEFAULT *SetAdministratorReq
+var UserServiceListWebhookDeliveriesArgs_Req_DEFAULT *ListWebhookDeliveriesReq
 
-func (p *UserServiceSetAdministratorArgs) GetReq() (v *SetAdministratorReq) {
+func (p *UserServiceListWebhookDeliveriesArgs) GetReq() (v *ListWebhookDeliveriesReq) {
 	if !p.IsSetReq() {
-		return UserServiceSetAdministratorArgs_Req_DEFAULT
+		return UserServiceListWebhookDeliveriesArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *UserServiceSetAdministratorArgs) SetReq(val *SetAdministratorReq) {
+func (p *UserServiceListWebhookDeliveriesArgs) SetReq(val *ListWebhookDeliveriesReq) {
 	p.Req = val
 }
 
-func (p *UserServiceSetAdministratorArgs) IsSetReq() bool {
+func (p *UserServiceListWebhookDeliveriesArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UserServiceSetAdministratorArgs) String() string {
+func (p *UserServiceListWebhookDeliveriesArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceSetAdministratorArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceListWebhookDeliveriesArgs(%+v)", *p)
 }
 
-func (p *UserServiceSetAdministratorArgs) DeepEqual(ano *UserServiceSetAdministratorArgs) bool {
+func (p *UserServiceListWebhookDeliveriesArgs) DeepEqual(ano *UserServiceListWebhookDeliveriesArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -2139,7 +12698,7 @@ func (p *UserServiceSetAdministratorArgs) DeepEqual(ano *UserServiceSetAdministr
 	return true
 }
 
-func (p *UserServiceSetAdministratorArgs) Field1DeepEqual(src *SetAdministratorReq) bool {
+func (p *UserServiceListWebhookDeliveriesArgs) Field1DeepEqual(src *ListWebhookDeliveriesReq) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -2147,45 +12706,45 @@ func (p *UserServiceSetAdministratorArgs) Field1DeepEqual(src *SetAdministratorR
 	return true
 }
 
-var fieldIDToName_UserServiceSetAdministratorArgs = map[int16]string{
+var fieldIDToName_UserServiceListWebhookDeliveriesArgs = map[int16]string{
 	1: "req",
 }
 
-type UserServiceSetAdministratorResult struct {
-	Success *SetAdministratorResp `thrift:"success,0,optional" frugal:"0,optional,SetAdministratorResp" json:"success,omitempty"`
+type UserServiceListWebhookDeliveriesResult struct {
+	Success *ListWebhookDeliveriesResp `thrift:"success,0,optional" frugal:"0,optional,ListWebhookDeliveriesResp" json:"success,omitempty"`
 }
 
-func NewUserServiceSetAdministratorResult() *UserServiceSetAdministratorResult {
-	return &UserServiceSetAdministratorResult{}
+func NewUserServiceListWebhookDeliveriesResult() *UserServiceListWebhookDeliveriesResult {
+	return &UserServiceListWebhookDeliveriesResult{}
 }
 
-func (p *UserServiceSetAdministratorResult) InitDefault() {
+func (p *UserServiceListWebhookDeliveriesResult) InitDefault() {
 }
 
-var UserServiceSetAdministratorResult_Success_DEFAULT *SetAdministratorResp
+var UserServiceListWebhookDeliveriesResult_Success_DEFAULT *ListWebhookDeliveriesResp
 
-func (p *UserServiceSetAdministratorResult) GetSuccess() (v *SetAdministratorResp) {
+func (p *UserServiceListWebhookDeliveriesResult) GetSuccess() (v *ListWebhookDeliveriesResp) {
 	if !p.IsSetSuccess() {
-		return UserServiceSetAdministratorResult_Success_DEFAULT
+		return UserServiceListWebhookDeliveriesResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *UserServiceSetAdministratorResult) SetSuccess(x interface{}) {
-	p.Success = x.(*SetAdministratorResp)
+func (p *UserServiceListWebhookDeliveriesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListWebhookDeliveriesResp)
 }
 
-func (p *UserServiceSetAdministratorResult) IsSetSuccess() bool {
+func (p *UserServiceListWebhookDeliveriesResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceSetAdministratorResult) String() string {
+func (p *UserServiceListWebhookDeliveriesResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceSetAdministratorResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceListWebhookDeliveriesResult(%+v)", *p)
 }
 
-func (p *UserServiceSetAdministratorResult) DeepEqual(ano *UserServiceSetAdministratorResult) bool {
+func (p *UserServiceListWebhookDeliveriesResult) DeepEqual(ano *UserServiceListWebhookDeliveriesResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -2197,7 +12756,7 @@ func (p *UserServiceSetAdministratorResult) DeepEqual(ano *UserServiceSetAdminis
 	return true
 }
 
-func (p *UserServiceSetAdministratorResult) Field0DeepEqual(src *SetAdministratorResp) bool {
+func (p *UserServiceListWebhookDeliveriesResult) Field0DeepEqual(src *ListWebhookDeliveriesResp) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -2205,45 +12764,45 @@ func (p *UserServiceSetAdministratorResult) Field0DeepEqual(src *SetAdministrato
 	return true
 }
 
-var fieldIDToName_UserServiceSetAdministratorResult = map[int16]string{
+var fieldIDToName_UserServiceListWebhookDeliveriesResult = map[int16]string{
 	0: "success",
 }
 
-type UserServiceGetUserInfoArgs struct {
-	Req *GetUserInfoReq `thrift:"req,1" frugal:"1,default,GetUserInfoReq" json:"req"`
+type UserServiceRedeliverWebhookArgs struct {
+	Req *RedeliverWebhookReq `thrift:"req,1" frugal:"1,default,RedeliverWebhookReq" json:"req"`
 }
 
-func NewUserServiceGetUserInfoArgs() *UserServiceGetUserInfoArgs {
-	return &UserServiceGetUserInfoArgs{}
+func NewUserServiceRedeliverWebhookArgs() *UserServiceRedeliverWebhookArgs {
+	return &UserServiceRedeliverWebhookArgs{}
 }
 
-func (p *UserServiceGetUserInfoArgs) InitDefault() {
+func (p *UserServiceRedeliverWebhookArgs) InitDefault() {
 }
 
-var UserServiceGetUserInfoArgs_Req_DEFAULT *GetUserInfoReq
+var UserServiceRedeliverWebhookArgs_Req_DEFAULT *RedeliverWebhookReq
 
-func (p *UserServiceGetUserInfoArgs) GetReq() (v *GetUserInfoReq) {
+func (p *UserServiceRedeliverWebhookArgs) GetReq() (v *RedeliverWebhookReq) {
 	if !p.IsSetReq() {
-		return UserServiceGetUserInfoArgs_Req_DEFAULT
+		return UserServiceRedeliverWebhookArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *UserServiceGetUserInfoArgs) SetReq(val *GetUserInfoReq) {
+func (p *UserServiceRedeliverWebhookArgs) SetReq(val *RedeliverWebhookReq) {
 	p.Req = val
 }
 
-func (p *UserServiceGetUserInfoArgs) IsSetReq() bool {
+func (p *UserServiceRedeliverWebhookArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UserServiceGetUserInfoArgs) String() string {
+func (p *UserServiceRedeliverWebhookArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceGetUserInfoArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceRedeliverWebhookArgs(%+v)", *p)
 }
 
-func (p *UserServiceGetUserInfoArgs) DeepEqual(ano *UserServiceGetUserInfoArgs) bool {
+func (p *UserServiceRedeliverWebhookArgs) DeepEqual(ano *UserServiceRedeliverWebhookArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -2255,7 +12814,7 @@ func (p *UserServiceGetUserInfoArgs) DeepEqual(ano *UserServiceGetUserInfoArgs)
 	return true
 }
 
-func (p *UserServiceGetUserInfoArgs) Field1DeepEqual(src *GetUserInfoReq) bool {
+func (p *UserServiceRedeliverWebhookArgs) Field1DeepEqual(src *RedeliverWebhookReq) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -2263,45 +12822,45 @@ func (p *UserServiceGetUserInfoArgs) Field1DeepEqual(src *GetUserInfoReq) bool {
 	return true
 }
 
-var fieldIDToName_UserServiceGetUserInfoArgs = map[int16]string{
+var fieldIDToName_UserServiceRedeliverWebhookArgs = map[int16]string{
 	1: "req",
 }
 
-type UserServiceGetUserInfoResult struct {
-	Success *GetUserInfoResp `thrift:"success,0,optional" frugal:"0,optional,GetUserInfoResp" json:"success,omitempty"`
+type UserServiceRedeliverWebhookResult struct {
+	Success *RedeliverWebhookResp `thrift:"success,0,optional" frugal:"0,optional,RedeliverWebhookResp" json:"success,omitempty"`
 }
 
-func NewUserServiceGetUserInfoResult() *UserServiceGetUserInfoResult {
-	return &UserServiceGetUserInfoResult{}
+func NewUserServiceRedeliverWebhookResult() *UserServiceRedeliverWebhookResult {
+	return &UserServiceRedeliverWebhookResult{}
 }
 
-func (p *UserServiceGetUserInfoResult) InitDefault() {
+func (p *UserServiceRedeliverWebhookResult) InitDefault() {
 }
 
-var UserServiceGetUserInfoResult_Success_DEFAULT *GetUserInfoResp
+var UserServiceRedeliverWebhookResult_Success_DEFAULT *RedeliverWebhookResp
 
-func (p *UserServiceGetUserInfoResult) GetSuccess() (v *GetUserInfoResp) {
+func (p *UserServiceRedeliverWebhookResult) GetSuccess() (v *RedeliverWebhookResp) {
 	if !p.IsSetSuccess() {
-		return UserServiceGetUserInfoResult_Success_DEFAULT
+		return UserServiceRedeliverWebhookResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *UserServiceGetUserInfoResult) SetSuccess(x interface{}) {
-	p.Success = x.(*GetUserInfoResp)
+func (p *UserServiceRedeliverWebhookResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RedeliverWebhookResp)
 }
 
-func (p *UserServiceGetUserInfoResult) IsSetSuccess() bool {
+func (p *UserServiceRedeliverWebhookResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceGetUserInfoResult) String() string {
+func (p *UserServiceRedeliverWebhookResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceGetUserInfoResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceRedeliverWebhookResult(%+v)", *p)
 }
 
-func (p *UserServiceGetUserInfoResult) DeepEqual(ano *UserServiceGetUserInfoResult) bool {
+func (p *UserServiceRedeliverWebhookResult) DeepEqual(ano *UserServiceRedeliverWebhookResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -2313,7 +12872,7 @@ func (p *UserServiceGetUserInfoResult) DeepEqual(ano *UserServiceGetUserInfoResu
 	return true
 }
 
-func (p *UserServiceGetUserInfoResult) Field0DeepEqual(src *GetUserInfoResp) bool {
+func (p *UserServiceRedeliverWebhookResult) Field0DeepEqual(src *RedeliverWebhookResp) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -2321,6 +12880,6 @@ func (p *UserServiceGetUserInfoResult) Field0DeepEqual(src *GetUserInfoResp) boo
 	return true
 }
 
-var fieldIDToName_UserServiceGetUserInfoResult = map[int16]string{
+var fieldIDToName_UserServiceRedeliverWebhookResult = map[int16]string{
 	0: "success",
 }
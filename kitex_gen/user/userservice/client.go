@@ -31,13 +31,52 @@ import (
 type Client interface {
 	Register(ctx context.Context, req *user.RegisterRequest, callOptions ...callopt.Option) (r *user.RegisterResponse, err error)
 	Login(ctx context.Context, req *user.LoginRequest, callOptions ...callopt.Option) (r *user.LoginResponse, err error)
+	RefreshToken(ctx context.Context, req *user.RefreshTokenReq, callOptions ...callopt.Option) (r *user.RefreshTokenResp, err error)
 	GetAddress(ctx context.Context, req *user.GetAddressRequest, callOptions ...callopt.Option) (r *user.GetAddressResponse, err error)
 	AddAddress(ctx context.Context, req *user.AddAddressRequest, callOptions ...callopt.Option) (r *user.AddAddressResponse, err error)
+	ListAddress(ctx context.Context, req *user.ListAddressReq, callOptions ...callopt.Option) (r *user.ListAddressResp, err error)
+	UpdateAddress(ctx context.Context, req *user.UpdateAddressReq, callOptions ...callopt.Option) (r *user.UpdateAddressResp, err error)
+	DeleteAddress(ctx context.Context, req *user.DeleteAddressReq, callOptions ...callopt.Option) (r *user.DeleteAddressResp, err error)
+	SetDefaultAddress(ctx context.Context, req *user.SetDefaultAddressReq, callOptions ...callopt.Option) (r *user.SetDefaultAddressResp, err error)
 	BanUser(ctx context.Context, req *user.BanUserReq, callOptions ...callopt.Option) (r *user.BanUserResp, err error)
 	LiftBandUser(ctx context.Context, req *user.LiftBanUserReq, callOptions ...callopt.Option) (r *user.LiftBanUserResp, err error)
+	ListBanHistory(ctx context.Context, req *user.ListBanHistoryReq, callOptions ...callopt.Option) (r *user.ListBanHistoryResp, err error)
+	UnlockUser(ctx context.Context, req *user.UnlockUserReq, callOptions ...callopt.Option) (r *user.UnlockUserResp, err error)
 	Logout(ctx context.Context, req *user.LogoutReq, callOptions ...callopt.Option) (r *user.LogoutResp, err error)
+	ListSessions(ctx context.Context, req *user.ListSessionsReq, callOptions ...callopt.Option) (r *user.ListSessionsResp, err error)
+	RevokeSession(ctx context.Context, req *user.RevokeSessionReq, callOptions ...callopt.Option) (r *user.RevokeSessionResp, err error)
+	RevokeAllSessions(ctx context.Context, req *user.RevokeAllSessionsReq, callOptions ...callopt.Option) (r *user.RevokeAllSessionsResp, err error)
 	SetAdministrator(ctx context.Context, req *user.SetAdministratorReq, callOptions ...callopt.Option) (r *user.SetAdministratorResp, err error)
 	GetUserInfo(ctx context.Context, req *user.GetUserInfoReq, callOptions ...callopt.Option) (r *user.GetUserInfoResp, err error)
+	CreateShop(ctx context.Context, req *user.CreateShopReq, callOptions ...callopt.Option) (r *user.CreateShopResp, err error)
+	UpdateShop(ctx context.Context, req *user.UpdateShopReq, callOptions ...callopt.Option) (r *user.UpdateShopResp, err error)
+	CloseShop(ctx context.Context, req *user.CloseShopReq, callOptions ...callopt.Option) (r *user.CloseShopResp, err error)
+	GetShop(ctx context.Context, req *user.GetShopReq, callOptions ...callopt.Option) (r *user.GetShopResp, err error)
+	GetShops(ctx context.Context, req *user.GetShopsReq, callOptions ...callopt.Option) (r *user.GetShopsResp, err error)
+	AddShopStaff(ctx context.Context, req *user.AddShopStaffReq, callOptions ...callopt.Option) (r *user.AddShopStaffResp, err error)
+	RemoveShopStaff(ctx context.Context, req *user.RemoveShopStaffReq, callOptions ...callopt.Option) (r *user.RemoveShopStaffResp, err error)
+	ListShopStaff(ctx context.Context, req *user.ListShopStaffReq, callOptions ...callopt.Option) (r *user.ListShopStaffResp, err error)
+	IsShopStaff(ctx context.Context, req *user.IsShopStaffReq, callOptions ...callopt.Option) (r *user.IsShopStaffResp, err error)
+	UpdateProfile(ctx context.Context, req *user.UpdateProfileReq, callOptions ...callopt.Option) (r *user.UpdateProfileResp, err error)
+	ChangePassword(ctx context.Context, req *user.ChangePasswordReq, callOptions ...callopt.Option) (r *user.ChangePasswordResp, err error)
+	RequestPasswordReset(ctx context.Context, req *user.RequestPasswordResetReq, callOptions ...callopt.Option) (r *user.RequestPasswordResetResp, err error)
+	ResetPassword(ctx context.Context, req *user.ResetPasswordReq, callOptions ...callopt.Option) (r *user.ResetPasswordResp, err error)
+	ExportUserData(ctx context.Context, req *user.ExportUserDataReq, callOptions ...callopt.Option) (r *user.ExportUserDataResp, err error)
+	CloseAccount(ctx context.Context, req *user.CloseAccountReq, callOptions ...callopt.Option) (r *user.CloseAccountResp, err error)
+	EnrollTOTP(ctx context.Context, req *user.EnrollTOTPReq, callOptions ...callopt.Option) (r *user.EnrollTOTPResp, err error)
+	ConfirmTOTP(ctx context.Context, req *user.ConfirmTOTPReq, callOptions ...callopt.Option) (r *user.ConfirmTOTPResp, err error)
+	DisableTOTP(ctx context.Context, req *user.DisableTOTPReq, callOptions ...callopt.Option) (r *user.DisableTOTPResp, err error)
+	CreateApiKey(ctx context.Context, req *user.CreateApiKeyReq, callOptions ...callopt.Option) (r *user.CreateApiKeyResp, err error)
+	ListApiKeys(ctx context.Context, req *user.ListApiKeysReq, callOptions ...callopt.Option) (r *user.ListApiKeysResp, err error)
+	RevokeApiKey(ctx context.Context, req *user.RevokeApiKeyReq, callOptions ...callopt.Option) (r *user.RevokeApiKeyResp, err error)
+	VerifyApiKey(ctx context.Context, req *user.VerifyApiKeyReq, callOptions ...callopt.Option) (r *user.VerifyApiKeyResp, err error)
+	ListAuditLogs(ctx context.Context, req *user.ListAuditLogsReq, callOptions ...callopt.Option) (r *user.ListAuditLogsResp, err error)
+	CreateWebhookEndpoint(ctx context.Context, req *user.CreateWebhookEndpointReq, callOptions ...callopt.Option) (r *user.CreateWebhookEndpointResp, err error)
+	ListWebhookEndpoints(ctx context.Context, req *user.ListWebhookEndpointsReq, callOptions ...callopt.Option) (r *user.ListWebhookEndpointsResp, err error)
+	UpdateWebhookEndpoint(ctx context.Context, req *user.UpdateWebhookEndpointReq, callOptions ...callopt.Option) (r *user.UpdateWebhookEndpointResp, err error)
+	DeleteWebhookEndpoint(ctx context.Context, req *user.DeleteWebhookEndpointReq, callOptions ...callopt.Option) (r *user.DeleteWebhookEndpointResp, err error)
+	ListWebhookDeliveries(ctx context.Context, req *user.ListWebhookDeliveriesReq, callOptions ...callopt.Option) (r *user.ListWebhookDeliveriesResp, err error)
+	RedeliverWebhook(ctx context.Context, req *user.RedeliverWebhookReq, callOptions ...callopt.Option) (r *user.RedeliverWebhookResp, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -79,6 +118,11 @@ func (p *kUserServiceClient) Login(ctx context.Context, req *user.LoginRequest,
 	return p.kClient.Login(ctx, req)
 }
 
+func (p *kUserServiceClient) RefreshToken(ctx context.Context, req *user.RefreshTokenReq, callOptions ...callopt.Option) (r *user.RefreshTokenResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RefreshToken(ctx, req)
+}
+
 func (p *kUserServiceClient) GetAddress(ctx context.Context, req *user.GetAddressRequest, callOptions ...callopt.Option) (r *user.GetAddressResponse, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.GetAddress(ctx, req)
@@ -89,6 +133,26 @@ func (p *kUserServiceClient) AddAddress(ctx context.Context, req *user.AddAddres
 	return p.kClient.AddAddress(ctx, req)
 }
 
+func (p *kUserServiceClient) ListAddress(ctx context.Context, req *user.ListAddressReq, callOptions ...callopt.Option) (r *user.ListAddressResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListAddress(ctx, req)
+}
+
+func (p *kUserServiceClient) UpdateAddress(ctx context.Context, req *user.UpdateAddressReq, callOptions ...callopt.Option) (r *user.UpdateAddressResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateAddress(ctx, req)
+}
+
+func (p *kUserServiceClient) DeleteAddress(ctx context.Context, req *user.DeleteAddressReq, callOptions ...callopt.Option) (r *user.DeleteAddressResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DeleteAddress(ctx, req)
+}
+
+func (p *kUserServiceClient) SetDefaultAddress(ctx context.Context, req *user.SetDefaultAddressReq, callOptions ...callopt.Option) (r *user.SetDefaultAddressResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SetDefaultAddress(ctx, req)
+}
+
 func (p *kUserServiceClient) BanUser(ctx context.Context, req *user.BanUserReq, callOptions ...callopt.Option) (r *user.BanUserResp, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.BanUser(ctx, req)
@@ -99,11 +163,36 @@ func (p *kUserServiceClient) LiftBandUser(ctx context.Context, req *user.LiftBan
 	return p.kClient.LiftBandUser(ctx, req)
 }
 
+func (p *kUserServiceClient) ListBanHistory(ctx context.Context, req *user.ListBanHistoryReq, callOptions ...callopt.Option) (r *user.ListBanHistoryResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListBanHistory(ctx, req)
+}
+
+func (p *kUserServiceClient) UnlockUser(ctx context.Context, req *user.UnlockUserReq, callOptions ...callopt.Option) (r *user.UnlockUserResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UnlockUser(ctx, req)
+}
+
 func (p *kUserServiceClient) Logout(ctx context.Context, req *user.LogoutReq, callOptions ...callopt.Option) (r *user.LogoutResp, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.Logout(ctx, req)
 }
 
+func (p *kUserServiceClient) ListSessions(ctx context.Context, req *user.ListSessionsReq, callOptions ...callopt.Option) (r *user.ListSessionsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListSessions(ctx, req)
+}
+
+func (p *kUserServiceClient) RevokeSession(ctx context.Context, req *user.RevokeSessionReq, callOptions ...callopt.Option) (r *user.RevokeSessionResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RevokeSession(ctx, req)
+}
+
+func (p *kUserServiceClient) RevokeAllSessions(ctx context.Context, req *user.RevokeAllSessionsReq, callOptions ...callopt.Option) (r *user.RevokeAllSessionsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RevokeAllSessions(ctx, req)
+}
+
 func (p *kUserServiceClient) SetAdministrator(ctx context.Context, req *user.SetAdministratorReq, callOptions ...callopt.Option) (r *user.SetAdministratorResp, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.SetAdministrator(ctx, req)
@@ -113,3 +202,148 @@ func (p *kUserServiceClient) GetUserInfo(ctx context.Context, req *user.GetUserI
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.GetUserInfo(ctx, req)
 }
+
+func (p *kUserServiceClient) CreateShop(ctx context.Context, req *user.CreateShopReq, callOptions ...callopt.Option) (r *user.CreateShopResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateShop(ctx, req)
+}
+
+func (p *kUserServiceClient) UpdateShop(ctx context.Context, req *user.UpdateShopReq, callOptions ...callopt.Option) (r *user.UpdateShopResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateShop(ctx, req)
+}
+
+func (p *kUserServiceClient) CloseShop(ctx context.Context, req *user.CloseShopReq, callOptions ...callopt.Option) (r *user.CloseShopResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CloseShop(ctx, req)
+}
+
+func (p *kUserServiceClient) GetShop(ctx context.Context, req *user.GetShopReq, callOptions ...callopt.Option) (r *user.GetShopResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetShop(ctx, req)
+}
+
+func (p *kUserServiceClient) GetShops(ctx context.Context, req *user.GetShopsReq, callOptions ...callopt.Option) (r *user.GetShopsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetShops(ctx, req)
+}
+
+func (p *kUserServiceClient) AddShopStaff(ctx context.Context, req *user.AddShopStaffReq, callOptions ...callopt.Option) (r *user.AddShopStaffResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.AddShopStaff(ctx, req)
+}
+
+func (p *kUserServiceClient) RemoveShopStaff(ctx context.Context, req *user.RemoveShopStaffReq, callOptions ...callopt.Option) (r *user.RemoveShopStaffResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RemoveShopStaff(ctx, req)
+}
+
+func (p *kUserServiceClient) ListShopStaff(ctx context.Context, req *user.ListShopStaffReq, callOptions ...callopt.Option) (r *user.ListShopStaffResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListShopStaff(ctx, req)
+}
+
+func (p *kUserServiceClient) IsShopStaff(ctx context.Context, req *user.IsShopStaffReq, callOptions ...callopt.Option) (r *user.IsShopStaffResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.IsShopStaff(ctx, req)
+}
+
+func (p *kUserServiceClient) UpdateProfile(ctx context.Context, req *user.UpdateProfileReq, callOptions ...callopt.Option) (r *user.UpdateProfileResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateProfile(ctx, req)
+}
+
+func (p *kUserServiceClient) ChangePassword(ctx context.Context, req *user.ChangePasswordReq, callOptions ...callopt.Option) (r *user.ChangePasswordResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ChangePassword(ctx, req)
+}
+
+func (p *kUserServiceClient) RequestPasswordReset(ctx context.Context, req *user.RequestPasswordResetReq, callOptions ...callopt.Option) (r *user.RequestPasswordResetResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RequestPasswordReset(ctx, req)
+}
+
+func (p *kUserServiceClient) ResetPassword(ctx context.Context, req *user.ResetPasswordReq, callOptions ...callopt.Option) (r *user.ResetPasswordResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ResetPassword(ctx, req)
+}
+
+func (p *kUserServiceClient) ExportUserData(ctx context.Context, req *user.ExportUserDataReq, callOptions ...callopt.Option) (r *user.ExportUserDataResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ExportUserData(ctx, req)
+}
+
+func (p *kUserServiceClient) CloseAccount(ctx context.Context, req *user.CloseAccountReq, callOptions ...callopt.Option) (r *user.CloseAccountResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CloseAccount(ctx, req)
+}
+
+func (p *kUserServiceClient) EnrollTOTP(ctx context.Context, req *user.EnrollTOTPReq, callOptions ...callopt.Option) (r *user.EnrollTOTPResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.EnrollTOTP(ctx, req)
+}
+
+func (p *kUserServiceClient) ConfirmTOTP(ctx context.Context, req *user.ConfirmTOTPReq, callOptions ...callopt.Option) (r *user.ConfirmTOTPResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ConfirmTOTP(ctx, req)
+}
+
+func (p *kUserServiceClient) DisableTOTP(ctx context.Context, req *user.DisableTOTPReq, callOptions ...callopt.Option) (r *user.DisableTOTPResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DisableTOTP(ctx, req)
+}
+
+func (p *kUserServiceClient) CreateApiKey(ctx context.Context, req *user.CreateApiKeyReq, callOptions ...callopt.Option) (r *user.CreateApiKeyResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateApiKey(ctx, req)
+}
+
+func (p *kUserServiceClient) ListApiKeys(ctx context.Context, req *user.ListApiKeysReq, callOptions ...callopt.Option) (r *user.ListApiKeysResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListApiKeys(ctx, req)
+}
+
+func (p *kUserServiceClient) RevokeApiKey(ctx context.Context, req *user.RevokeApiKeyReq, callOptions ...callopt.Option) (r *user.RevokeApiKeyResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RevokeApiKey(ctx, req)
+}
+
+func (p *kUserServiceClient) VerifyApiKey(ctx context.Context, req *user.VerifyApiKeyReq, callOptions ...callopt.Option) (r *user.VerifyApiKeyResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.VerifyApiKey(ctx, req)
+}
+
+func (p *kUserServiceClient) ListAuditLogs(ctx context.Context, req *user.ListAuditLogsReq, callOptions ...callopt.Option) (r *user.ListAuditLogsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListAuditLogs(ctx, req)
+}
+
+func (p *kUserServiceClient) CreateWebhookEndpoint(ctx context.Context, req *user.CreateWebhookEndpointReq, callOptions ...callopt.Option) (r *user.CreateWebhookEndpointResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateWebhookEndpoint(ctx, req)
+}
+
+func (p *kUserServiceClient) ListWebhookEndpoints(ctx context.Context, req *user.ListWebhookEndpointsReq, callOptions ...callopt.Option) (r *user.ListWebhookEndpointsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListWebhookEndpoints(ctx, req)
+}
+
+func (p *kUserServiceClient) UpdateWebhookEndpoint(ctx context.Context, req *user.UpdateWebhookEndpointReq, callOptions ...callopt.Option) (r *user.UpdateWebhookEndpointResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateWebhookEndpoint(ctx, req)
+}
+
+func (p *kUserServiceClient) DeleteWebhookEndpoint(ctx context.Context, req *user.DeleteWebhookEndpointReq, callOptions ...callopt.Option) (r *user.DeleteWebhookEndpointResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DeleteWebhookEndpoint(ctx, req)
+}
+
+func (p *kUserServiceClient) ListWebhookDeliveries(ctx context.Context, req *user.ListWebhookDeliveriesReq, callOptions ...callopt.Option) (r *user.ListWebhookDeliveriesResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListWebhookDeliveries(ctx, req)
+}
+
+func (p *kUserServiceClient) RedeliverWebhook(ctx context.Context, req *user.RedeliverWebhookReq, callOptions ...callopt.Option) (r *user.RedeliverWebhookResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RedeliverWebhook(ctx, req)
+}
@@ -45,6 +45,13 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"RefreshToken": kitex.NewMethodInfo(
+		refreshTokenHandler,
+		newUserServiceRefreshTokenArgs,
+		newUserServiceRefreshTokenResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 	"GetAddress": kitex.NewMethodInfo(
 		getAddressHandler,
 		newUserServiceGetAddressArgs,
@@ -59,6 +66,34 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"ListAddress": kitex.NewMethodInfo(
+		listAddressHandler,
+		newUserServiceListAddressArgs,
+		newUserServiceListAddressResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"UpdateAddress": kitex.NewMethodInfo(
+		updateAddressHandler,
+		newUserServiceUpdateAddressArgs,
+		newUserServiceUpdateAddressResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"DeleteAddress": kitex.NewMethodInfo(
+		deleteAddressHandler,
+		newUserServiceDeleteAddressArgs,
+		newUserServiceDeleteAddressResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"SetDefaultAddress": kitex.NewMethodInfo(
+		setDefaultAddressHandler,
+		newUserServiceSetDefaultAddressArgs,
+		newUserServiceSetDefaultAddressResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 	"BanUser": kitex.NewMethodInfo(
 		banUserHandler,
 		newUserServiceBanUserArgs,
@@ -73,6 +108,20 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"ListBanHistory": kitex.NewMethodInfo(
+		listBanHistoryHandler,
+		newUserServiceListBanHistoryArgs,
+		newUserServiceListBanHistoryResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"UnlockUser": kitex.NewMethodInfo(
+		unlockUserHandler,
+		newUserServiceUnlockUserArgs,
+		newUserServiceUnlockUserResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 	"Logout": kitex.NewMethodInfo(
 		logoutHandler,
 		newUserServiceLogoutArgs,
@@ -80,6 +129,27 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"ListSessions": kitex.NewMethodInfo(
+		listSessionsHandler,
+		newUserServiceListSessionsArgs,
+		newUserServiceListSessionsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"RevokeSession": kitex.NewMethodInfo(
+		revokeSessionHandler,
+		newUserServiceRevokeSessionArgs,
+		newUserServiceRevokeSessionResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"RevokeAllSessions": kitex.NewMethodInfo(
+		revokeAllSessionsHandler,
+		newUserServiceRevokeAllSessionsArgs,
+		newUserServiceRevokeAllSessionsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 	"SetAdministrator": kitex.NewMethodInfo(
 		setAdministratorHandler,
 		newUserServiceSetAdministratorArgs,
@@ -94,6 +164,209 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"CreateShop": kitex.NewMethodInfo(
+		createShopHandler,
+		newUserServiceCreateShopArgs,
+		newUserServiceCreateShopResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"UpdateShop": kitex.NewMethodInfo(
+		updateShopHandler,
+		newUserServiceUpdateShopArgs,
+		newUserServiceUpdateShopResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"CloseShop": kitex.NewMethodInfo(
+		closeShopHandler,
+		newUserServiceCloseShopArgs,
+		newUserServiceCloseShopResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetShop": kitex.NewMethodInfo(
+		getShopHandler,
+		newUserServiceGetShopArgs,
+		newUserServiceGetShopResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetShops": kitex.NewMethodInfo(
+		getShopsHandler,
+		newUserServiceGetShopsArgs,
+		newUserServiceGetShopsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"AddShopStaff": kitex.NewMethodInfo(
+		addShopStaffHandler,
+		newUserServiceAddShopStaffArgs,
+		newUserServiceAddShopStaffResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"RemoveShopStaff": kitex.NewMethodInfo(
+		removeShopStaffHandler,
+		newUserServiceRemoveShopStaffArgs,
+		newUserServiceRemoveShopStaffResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListShopStaff": kitex.NewMethodInfo(
+		listShopStaffHandler,
+		newUserServiceListShopStaffArgs,
+		newUserServiceListShopStaffResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"IsShopStaff": kitex.NewMethodInfo(
+		isShopStaffHandler,
+		newUserServiceIsShopStaffArgs,
+		newUserServiceIsShopStaffResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"UpdateProfile": kitex.NewMethodInfo(
+		updateProfileHandler,
+		newUserServiceUpdateProfileArgs,
+		newUserServiceUpdateProfileResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ChangePassword": kitex.NewMethodInfo(
+		changePasswordHandler,
+		newUserServiceChangePasswordArgs,
+		newUserServiceChangePasswordResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"RequestPasswordReset": kitex.NewMethodInfo(
+		requestPasswordResetHandler,
+		newUserServiceRequestPasswordResetArgs,
+		newUserServiceRequestPasswordResetResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ResetPassword": kitex.NewMethodInfo(
+		resetPasswordHandler,
+		newUserServiceResetPasswordArgs,
+		newUserServiceResetPasswordResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ExportUserData": kitex.NewMethodInfo(
+		exportUserDataHandler,
+		newUserServiceExportUserDataArgs,
+		newUserServiceExportUserDataResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"CloseAccount": kitex.NewMethodInfo(
+		closeAccountHandler,
+		newUserServiceCloseAccountArgs,
+		newUserServiceCloseAccountResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"EnrollTOTP": kitex.NewMethodInfo(
+		enrollTOTPHandler,
+		newUserServiceEnrollTOTPArgs,
+		newUserServiceEnrollTOTPResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ConfirmTOTP": kitex.NewMethodInfo(
+		confirmTOTPHandler,
+		newUserServiceConfirmTOTPArgs,
+		newUserServiceConfirmTOTPResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"DisableTOTP": kitex.NewMethodInfo(
+		disableTOTPHandler,
+		newUserServiceDisableTOTPArgs,
+		newUserServiceDisableTOTPResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"CreateApiKey": kitex.NewMethodInfo(
+		createApiKeyHandler,
+		newUserServiceCreateApiKeyArgs,
+		newUserServiceCreateApiKeyResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListApiKeys": kitex.NewMethodInfo(
+		listApiKeysHandler,
+		newUserServiceListApiKeysArgs,
+		newUserServiceListApiKeysResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"RevokeApiKey": kitex.NewMethodInfo(
+		revokeApiKeyHandler,
+		newUserServiceRevokeApiKeyArgs,
+		newUserServiceRevokeApiKeyResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"VerifyApiKey": kitex.NewMethodInfo(
+		verifyApiKeyHandler,
+		newUserServiceVerifyApiKeyArgs,
+		newUserServiceVerifyApiKeyResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListAuditLogs": kitex.NewMethodInfo(
+		listAuditLogsHandler,
+		newUserServiceListAuditLogsArgs,
+		newUserServiceListAuditLogsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"CreateWebhookEndpoint": kitex.NewMethodInfo(
+		createWebhookEndpointHandler,
+		newUserServiceCreateWebhookEndpointArgs,
+		newUserServiceCreateWebhookEndpointResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListWebhookEndpoints": kitex.NewMethodInfo(
+		listWebhookEndpointsHandler,
+		newUserServiceListWebhookEndpointsArgs,
+		newUserServiceListWebhookEndpointsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"UpdateWebhookEndpoint": kitex.NewMethodInfo(
+		updateWebhookEndpointHandler,
+		newUserServiceUpdateWebhookEndpointArgs,
+		newUserServiceUpdateWebhookEndpointResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"DeleteWebhookEndpoint": kitex.NewMethodInfo(
+		deleteWebhookEndpointHandler,
+		newUserServiceDeleteWebhookEndpointArgs,
+		newUserServiceDeleteWebhookEndpointResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListWebhookDeliveries": kitex.NewMethodInfo(
+		listWebhookDeliveriesHandler,
+		newUserServiceListWebhookDeliveriesArgs,
+		newUserServiceListWebhookDeliveriesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"RedeliverWebhook": kitex.NewMethodInfo(
+		redeliverWebhookHandler,
+		newUserServiceRedeliverWebhookArgs,
+		newUserServiceRedeliverWebhookResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 }
 
 var (
@@ -196,6 +469,24 @@ func newUserServiceLoginResult() interface{} {
 	return user.NewUserServiceLoginResult()
 }
 
+func refreshTokenHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceRefreshTokenArgs)
+	realResult := result.(*user.UserServiceRefreshTokenResult)
+	success, err := handler.(user.UserService).RefreshToken(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceRefreshTokenArgs() interface{} {
+	return user.NewUserServiceRefreshTokenArgs()
+}
+
+func newUserServiceRefreshTokenResult() interface{} {
+	return user.NewUserServiceRefreshTokenResult()
+}
+
 func getAddressHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	realArg := arg.(*user.UserServiceGetAddressArgs)
 	realResult := result.(*user.UserServiceGetAddressResult)
@@ -232,6 +523,78 @@ func newUserServiceAddAddressResult() interface{} {
 	return user.NewUserServiceAddAddressResult()
 }
 
+func listAddressHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceListAddressArgs)
+	realResult := result.(*user.UserServiceListAddressResult)
+	success, err := handler.(user.UserService).ListAddress(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceListAddressArgs() interface{} {
+	return user.NewUserServiceListAddressArgs()
+}
+
+func newUserServiceListAddressResult() interface{} {
+	return user.NewUserServiceListAddressResult()
+}
+
+func updateAddressHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceUpdateAddressArgs)
+	realResult := result.(*user.UserServiceUpdateAddressResult)
+	success, err := handler.(user.UserService).UpdateAddress(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceUpdateAddressArgs() interface{} {
+	return user.NewUserServiceUpdateAddressArgs()
+}
+
+func newUserServiceUpdateAddressResult() interface{} {
+	return user.NewUserServiceUpdateAddressResult()
+}
+
+func deleteAddressHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceDeleteAddressArgs)
+	realResult := result.(*user.UserServiceDeleteAddressResult)
+	success, err := handler.(user.UserService).DeleteAddress(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceDeleteAddressArgs() interface{} {
+	return user.NewUserServiceDeleteAddressArgs()
+}
+
+func newUserServiceDeleteAddressResult() interface{} {
+	return user.NewUserServiceDeleteAddressResult()
+}
+
+func setDefaultAddressHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceSetDefaultAddressArgs)
+	realResult := result.(*user.UserServiceSetDefaultAddressResult)
+	success, err := handler.(user.UserService).SetDefaultAddress(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceSetDefaultAddressArgs() interface{} {
+	return user.NewUserServiceSetDefaultAddressArgs()
+}
+
+func newUserServiceSetDefaultAddressResult() interface{} {
+	return user.NewUserServiceSetDefaultAddressResult()
+}
+
 func banUserHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	realArg := arg.(*user.UserServiceBanUserArgs)
 	realResult := result.(*user.UserServiceBanUserResult)
@@ -268,6 +631,42 @@ func newUserServiceLiftBandUserResult() interface{} {
 	return user.NewUserServiceLiftBandUserResult()
 }
 
+func listBanHistoryHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceListBanHistoryArgs)
+	realResult := result.(*user.UserServiceListBanHistoryResult)
+	success, err := handler.(user.UserService).ListBanHistory(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceListBanHistoryArgs() interface{} {
+	return user.NewUserServiceListBanHistoryArgs()
+}
+
+func newUserServiceListBanHistoryResult() interface{} {
+	return user.NewUserServiceListBanHistoryResult()
+}
+
+func unlockUserHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceUnlockUserArgs)
+	realResult := result.(*user.UserServiceUnlockUserResult)
+	success, err := handler.(user.UserService).UnlockUser(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceUnlockUserArgs() interface{} {
+	return user.NewUserServiceUnlockUserArgs()
+}
+
+func newUserServiceUnlockUserResult() interface{} {
+	return user.NewUserServiceUnlockUserResult()
+}
+
 func logoutHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	realArg := arg.(*user.UserServiceLogoutArgs)
 	realResult := result.(*user.UserServiceLogoutResult)
@@ -286,6 +685,60 @@ func newUserServiceLogoutResult() interface{} {
 	return user.NewUserServiceLogoutResult()
 }
 
+func listSessionsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceListSessionsArgs)
+	realResult := result.(*user.UserServiceListSessionsResult)
+	success, err := handler.(user.UserService).ListSessions(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceListSessionsArgs() interface{} {
+	return user.NewUserServiceListSessionsArgs()
+}
+
+func newUserServiceListSessionsResult() interface{} {
+	return user.NewUserServiceListSessionsResult()
+}
+
+func revokeSessionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceRevokeSessionArgs)
+	realResult := result.(*user.UserServiceRevokeSessionResult)
+	success, err := handler.(user.UserService).RevokeSession(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceRevokeSessionArgs() interface{} {
+	return user.NewUserServiceRevokeSessionArgs()
+}
+
+func newUserServiceRevokeSessionResult() interface{} {
+	return user.NewUserServiceRevokeSessionResult()
+}
+
+func revokeAllSessionsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceRevokeAllSessionsArgs)
+	realResult := result.(*user.UserServiceRevokeAllSessionsResult)
+	success, err := handler.(user.UserService).RevokeAllSessions(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceRevokeAllSessionsArgs() interface{} {
+	return user.NewUserServiceRevokeAllSessionsArgs()
+}
+
+func newUserServiceRevokeAllSessionsResult() interface{} {
+	return user.NewUserServiceRevokeAllSessionsResult()
+}
+
 func setAdministratorHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	realArg := arg.(*user.UserServiceSetAdministratorArgs)
 	realResult := result.(*user.UserServiceSetAdministratorResult)
@@ -322,37 +775,569 @@ func newUserServiceGetUserInfoResult() interface{} {
 	return user.NewUserServiceGetUserInfoResult()
 }
 
-type kClient struct {
-	c client.Client
+func createShopHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceCreateShopArgs)
+	realResult := result.(*user.UserServiceCreateShopResult)
+	success, err := handler.(user.UserService).CreateShop(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceCreateShopArgs() interface{} {
+	return user.NewUserServiceCreateShopArgs()
 }
 
-func newServiceClient(c client.Client) *kClient {
-	return &kClient{
-		c: c,
-	}
+func newUserServiceCreateShopResult() interface{} {
+	return user.NewUserServiceCreateShopResult()
 }
 
-func (p *kClient) Register(ctx context.Context, req *user.RegisterRequest) (r *user.RegisterResponse, err error) {
-	var _args user.UserServiceRegisterArgs
-	_args.Req = req
-	var _result user.UserServiceRegisterResult
-	if err = p.c.Call(ctx, "Register", &_args, &_result); err != nil {
-		return
+func updateShopHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceUpdateShopArgs)
+	realResult := result.(*user.UserServiceUpdateShopResult)
+	success, err := handler.(user.UserService).UpdateShop(ctx, realArg.Req)
+	if err != nil {
+		return err
 	}
-	return _result.GetSuccess(), nil
+	realResult.Success = success
+	return nil
+}
+func newUserServiceUpdateShopArgs() interface{} {
+	return user.NewUserServiceUpdateShopArgs()
 }
 
-func (p *kClient) Login(ctx context.Context, req *user.LoginRequest) (r *user.LoginResponse, err error) {
-	var _args user.UserServiceLoginArgs
-	_args.Req = req
-	var _result user.UserServiceLoginResult
-	if err = p.c.Call(ctx, "Login", &_args, &_result); err != nil {
-		return
+func newUserServiceUpdateShopResult() interface{} {
+	return user.NewUserServiceUpdateShopResult()
+}
+
+func closeShopHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceCloseShopArgs)
+	realResult := result.(*user.UserServiceCloseShopResult)
+	success, err := handler.(user.UserService).CloseShop(ctx, realArg.Req)
+	if err != nil {
+		return err
 	}
-	return _result.GetSuccess(), nil
+	realResult.Success = success
+	return nil
+}
+func newUserServiceCloseShopArgs() interface{} {
+	return user.NewUserServiceCloseShopArgs()
 }
 
-func (p *kClient) GetAddress(ctx context.Context, req *user.GetAddressRequest) (r *user.GetAddressResponse, err error) {
+func newUserServiceCloseShopResult() interface{} {
+	return user.NewUserServiceCloseShopResult()
+}
+
+func getShopHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceGetShopArgs)
+	realResult := result.(*user.UserServiceGetShopResult)
+	success, err := handler.(user.UserService).GetShop(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceGetShopArgs() interface{} {
+	return user.NewUserServiceGetShopArgs()
+}
+
+func newUserServiceGetShopResult() interface{} {
+	return user.NewUserServiceGetShopResult()
+}
+
+func getShopsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceGetShopsArgs)
+	realResult := result.(*user.UserServiceGetShopsResult)
+	success, err := handler.(user.UserService).GetShops(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceGetShopsArgs() interface{} {
+	return user.NewUserServiceGetShopsArgs()
+}
+
+func newUserServiceGetShopsResult() interface{} {
+	return user.NewUserServiceGetShopsResult()
+}
+
+func addShopStaffHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceAddShopStaffArgs)
+	realResult := result.(*user.UserServiceAddShopStaffResult)
+	success, err := handler.(user.UserService).AddShopStaff(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceAddShopStaffArgs() interface{} {
+	return user.NewUserServiceAddShopStaffArgs()
+}
+
+func newUserServiceAddShopStaffResult() interface{} {
+	return user.NewUserServiceAddShopStaffResult()
+}
+
+func removeShopStaffHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceRemoveShopStaffArgs)
+	realResult := result.(*user.UserServiceRemoveShopStaffResult)
+	success, err := handler.(user.UserService).RemoveShopStaff(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceRemoveShopStaffArgs() interface{} {
+	return user.NewUserServiceRemoveShopStaffArgs()
+}
+
+func newUserServiceRemoveShopStaffResult() interface{} {
+	return user.NewUserServiceRemoveShopStaffResult()
+}
+
+func listShopStaffHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceListShopStaffArgs)
+	realResult := result.(*user.UserServiceListShopStaffResult)
+	success, err := handler.(user.UserService).ListShopStaff(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceListShopStaffArgs() interface{} {
+	return user.NewUserServiceListShopStaffArgs()
+}
+
+func newUserServiceListShopStaffResult() interface{} {
+	return user.NewUserServiceListShopStaffResult()
+}
+
+func isShopStaffHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceIsShopStaffArgs)
+	realResult := result.(*user.UserServiceIsShopStaffResult)
+	success, err := handler.(user.UserService).IsShopStaff(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceIsShopStaffArgs() interface{} {
+	return user.NewUserServiceIsShopStaffArgs()
+}
+
+func newUserServiceIsShopStaffResult() interface{} {
+	return user.NewUserServiceIsShopStaffResult()
+}
+
+func updateProfileHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceUpdateProfileArgs)
+	realResult := result.(*user.UserServiceUpdateProfileResult)
+	success, err := handler.(user.UserService).UpdateProfile(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceUpdateProfileArgs() interface{} {
+	return user.NewUserServiceUpdateProfileArgs()
+}
+
+func newUserServiceUpdateProfileResult() interface{} {
+	return user.NewUserServiceUpdateProfileResult()
+}
+
+func changePasswordHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceChangePasswordArgs)
+	realResult := result.(*user.UserServiceChangePasswordResult)
+	success, err := handler.(user.UserService).ChangePassword(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceChangePasswordArgs() interface{} {
+	return user.NewUserServiceChangePasswordArgs()
+}
+
+func newUserServiceChangePasswordResult() interface{} {
+	return user.NewUserServiceChangePasswordResult()
+}
+
+func requestPasswordResetHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceRequestPasswordResetArgs)
+	realResult := result.(*user.UserServiceRequestPasswordResetResult)
+	success, err := handler.(user.UserService).RequestPasswordReset(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceRequestPasswordResetArgs() interface{} {
+	return user.NewUserServiceRequestPasswordResetArgs()
+}
+
+func newUserServiceRequestPasswordResetResult() interface{} {
+	return user.NewUserServiceRequestPasswordResetResult()
+}
+
+func resetPasswordHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceResetPasswordArgs)
+	realResult := result.(*user.UserServiceResetPasswordResult)
+	success, err := handler.(user.UserService).ResetPassword(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceResetPasswordArgs() interface{} {
+	return user.NewUserServiceResetPasswordArgs()
+}
+
+func newUserServiceResetPasswordResult() interface{} {
+	return user.NewUserServiceResetPasswordResult()
+}
+
+func exportUserDataHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceExportUserDataArgs)
+	realResult := result.(*user.UserServiceExportUserDataResult)
+	success, err := handler.(user.UserService).ExportUserData(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceExportUserDataArgs() interface{} {
+	return user.NewUserServiceExportUserDataArgs()
+}
+
+func newUserServiceExportUserDataResult() interface{} {
+	return user.NewUserServiceExportUserDataResult()
+}
+
+func closeAccountHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceCloseAccountArgs)
+	realResult := result.(*user.UserServiceCloseAccountResult)
+	success, err := handler.(user.UserService).CloseAccount(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceCloseAccountArgs() interface{} {
+	return user.NewUserServiceCloseAccountArgs()
+}
+
+func newUserServiceCloseAccountResult() interface{} {
+	return user.NewUserServiceCloseAccountResult()
+}
+
+func enrollTOTPHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceEnrollTOTPArgs)
+	realResult := result.(*user.UserServiceEnrollTOTPResult)
+	success, err := handler.(user.UserService).EnrollTOTP(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceEnrollTOTPArgs() interface{} {
+	return user.NewUserServiceEnrollTOTPArgs()
+}
+
+func newUserServiceEnrollTOTPResult() interface{} {
+	return user.NewUserServiceEnrollTOTPResult()
+}
+
+func confirmTOTPHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceConfirmTOTPArgs)
+	realResult := result.(*user.UserServiceConfirmTOTPResult)
+	success, err := handler.(user.UserService).ConfirmTOTP(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceConfirmTOTPArgs() interface{} {
+	return user.NewUserServiceConfirmTOTPArgs()
+}
+
+func newUserServiceConfirmTOTPResult() interface{} {
+	return user.NewUserServiceConfirmTOTPResult()
+}
+
+func disableTOTPHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceDisableTOTPArgs)
+	realResult := result.(*user.UserServiceDisableTOTPResult)
+	success, err := handler.(user.UserService).DisableTOTP(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceDisableTOTPArgs() interface{} {
+	return user.NewUserServiceDisableTOTPArgs()
+}
+
+func newUserServiceDisableTOTPResult() interface{} {
+	return user.NewUserServiceDisableTOTPResult()
+}
+
+func createApiKeyHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceCreateApiKeyArgs)
+	realResult := result.(*user.UserServiceCreateApiKeyResult)
+	success, err := handler.(user.UserService).CreateApiKey(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceCreateApiKeyArgs() interface{} {
+	return user.NewUserServiceCreateApiKeyArgs()
+}
+
+func newUserServiceCreateApiKeyResult() interface{} {
+	return user.NewUserServiceCreateApiKeyResult()
+}
+
+func listApiKeysHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceListApiKeysArgs)
+	realResult := result.(*user.UserServiceListApiKeysResult)
+	success, err := handler.(user.UserService).ListApiKeys(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceListApiKeysArgs() interface{} {
+	return user.NewUserServiceListApiKeysArgs()
+}
+
+func newUserServiceListApiKeysResult() interface{} {
+	return user.NewUserServiceListApiKeysResult()
+}
+
+func revokeApiKeyHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceRevokeApiKeyArgs)
+	realResult := result.(*user.UserServiceRevokeApiKeyResult)
+	success, err := handler.(user.UserService).RevokeApiKey(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceRevokeApiKeyArgs() interface{} {
+	return user.NewUserServiceRevokeApiKeyArgs()
+}
+
+func newUserServiceRevokeApiKeyResult() interface{} {
+	return user.NewUserServiceRevokeApiKeyResult()
+}
+
+func verifyApiKeyHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceVerifyApiKeyArgs)
+	realResult := result.(*user.UserServiceVerifyApiKeyResult)
+	success, err := handler.(user.UserService).VerifyApiKey(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceVerifyApiKeyArgs() interface{} {
+	return user.NewUserServiceVerifyApiKeyArgs()
+}
+
+func newUserServiceVerifyApiKeyResult() interface{} {
+	return user.NewUserServiceVerifyApiKeyResult()
+}
+
+func listAuditLogsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceListAuditLogsArgs)
+	realResult := result.(*user.UserServiceListAuditLogsResult)
+	success, err := handler.(user.UserService).ListAuditLogs(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceListAuditLogsArgs() interface{} {
+	return user.NewUserServiceListAuditLogsArgs()
+}
+
+func newUserServiceListAuditLogsResult() interface{} {
+	return user.NewUserServiceListAuditLogsResult()
+}
+
+func createWebhookEndpointHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceCreateWebhookEndpointArgs)
+	realResult := result.(*user.UserServiceCreateWebhookEndpointResult)
+	success, err := handler.(user.UserService).CreateWebhookEndpoint(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceCreateWebhookEndpointArgs() interface{} {
+	return user.NewUserServiceCreateWebhookEndpointArgs()
+}
+
+func newUserServiceCreateWebhookEndpointResult() interface{} {
+	return user.NewUserServiceCreateWebhookEndpointResult()
+}
+
+func listWebhookEndpointsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceListWebhookEndpointsArgs)
+	realResult := result.(*user.UserServiceListWebhookEndpointsResult)
+	success, err := handler.(user.UserService).ListWebhookEndpoints(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceListWebhookEndpointsArgs() interface{} {
+	return user.NewUserServiceListWebhookEndpointsArgs()
+}
+
+func newUserServiceListWebhookEndpointsResult() interface{} {
+	return user.NewUserServiceListWebhookEndpointsResult()
+}
+
+func updateWebhookEndpointHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceUpdateWebhookEndpointArgs)
+	realResult := result.(*user.UserServiceUpdateWebhookEndpointResult)
+	success, err := handler.(user.UserService).UpdateWebhookEndpoint(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceUpdateWebhookEndpointArgs() interface{} {
+	return user.NewUserServiceUpdateWebhookEndpointArgs()
+}
+
+func newUserServiceUpdateWebhookEndpointResult() interface{} {
+	return user.NewUserServiceUpdateWebhookEndpointResult()
+}
+
+func deleteWebhookEndpointHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceDeleteWebhookEndpointArgs)
+	realResult := result.(*user.UserServiceDeleteWebhookEndpointResult)
+	success, err := handler.(user.UserService).DeleteWebhookEndpoint(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceDeleteWebhookEndpointArgs() interface{} {
+	return user.NewUserServiceDeleteWebhookEndpointArgs()
+}
+
+func newUserServiceDeleteWebhookEndpointResult() interface{} {
+	return user.NewUserServiceDeleteWebhookEndpointResult()
+}
+
+func listWebhookDeliveriesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceListWebhookDeliveriesArgs)
+	realResult := result.(*user.UserServiceListWebhookDeliveriesResult)
+	success, err := handler.(user.UserService).ListWebhookDeliveries(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceListWebhookDeliveriesArgs() interface{} {
+	return user.NewUserServiceListWebhookDeliveriesArgs()
+}
+
+func newUserServiceListWebhookDeliveriesResult() interface{} {
+	return user.NewUserServiceListWebhookDeliveriesResult()
+}
+
+func redeliverWebhookHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceRedeliverWebhookArgs)
+	realResult := result.(*user.UserServiceRedeliverWebhookResult)
+	success, err := handler.(user.UserService).RedeliverWebhook(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceRedeliverWebhookArgs() interface{} {
+	return user.NewUserServiceRedeliverWebhookArgs()
+}
+
+func newUserServiceRedeliverWebhookResult() interface{} {
+	return user.NewUserServiceRedeliverWebhookResult()
+}
+
+type kClient struct {
+	c client.Client
+}
+
+func newServiceClient(c client.Client) *kClient {
+	return &kClient{
+		c: c,
+	}
+}
+
+func (p *kClient) Register(ctx context.Context, req *user.RegisterRequest) (r *user.RegisterResponse, err error) {
+	var _args user.UserServiceRegisterArgs
+	_args.Req = req
+	var _result user.UserServiceRegisterResult
+	if err = p.c.Call(ctx, "Register", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) Login(ctx context.Context, req *user.LoginRequest) (r *user.LoginResponse, err error) {
+	var _args user.UserServiceLoginArgs
+	_args.Req = req
+	var _result user.UserServiceLoginResult
+	if err = p.c.Call(ctx, "Login", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RefreshToken(ctx context.Context, req *user.RefreshTokenReq) (r *user.RefreshTokenResp, err error) {
+	var _args user.UserServiceRefreshTokenArgs
+	_args.Req = req
+	var _result user.UserServiceRefreshTokenResult
+	if err = p.c.Call(ctx, "RefreshToken", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetAddress(ctx context.Context, req *user.GetAddressRequest) (r *user.GetAddressResponse, err error) {
 	var _args user.UserServiceGetAddressArgs
 	_args.Req = req
 	var _result user.UserServiceGetAddressResult
@@ -372,6 +1357,46 @@ func (p *kClient) AddAddress(ctx context.Context, req *user.AddAddressRequest) (
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) ListAddress(ctx context.Context, req *user.ListAddressReq) (r *user.ListAddressResp, err error) {
+	var _args user.UserServiceListAddressArgs
+	_args.Req = req
+	var _result user.UserServiceListAddressResult
+	if err = p.c.Call(ctx, "ListAddress", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateAddress(ctx context.Context, req *user.UpdateAddressReq) (r *user.UpdateAddressResp, err error) {
+	var _args user.UserServiceUpdateAddressArgs
+	_args.Req = req
+	var _result user.UserServiceUpdateAddressResult
+	if err = p.c.Call(ctx, "UpdateAddress", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DeleteAddress(ctx context.Context, req *user.DeleteAddressReq) (r *user.DeleteAddressResp, err error) {
+	var _args user.UserServiceDeleteAddressArgs
+	_args.Req = req
+	var _result user.UserServiceDeleteAddressResult
+	if err = p.c.Call(ctx, "DeleteAddress", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SetDefaultAddress(ctx context.Context, req *user.SetDefaultAddressReq) (r *user.SetDefaultAddressResp, err error) {
+	var _args user.UserServiceSetDefaultAddressArgs
+	_args.Req = req
+	var _result user.UserServiceSetDefaultAddressResult
+	if err = p.c.Call(ctx, "SetDefaultAddress", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) BanUser(ctx context.Context, req *user.BanUserReq) (r *user.BanUserResp, err error) {
 	var _args user.UserServiceBanUserArgs
 	_args.Req = req
@@ -392,6 +1417,26 @@ func (p *kClient) LiftBandUser(ctx context.Context, req *user.LiftBanUserReq) (r
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) ListBanHistory(ctx context.Context, req *user.ListBanHistoryReq) (r *user.ListBanHistoryResp, err error) {
+	var _args user.UserServiceListBanHistoryArgs
+	_args.Req = req
+	var _result user.UserServiceListBanHistoryResult
+	if err = p.c.Call(ctx, "ListBanHistory", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UnlockUser(ctx context.Context, req *user.UnlockUserReq) (r *user.UnlockUserResp, err error) {
+	var _args user.UserServiceUnlockUserArgs
+	_args.Req = req
+	var _result user.UserServiceUnlockUserResult
+	if err = p.c.Call(ctx, "UnlockUser", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) Logout(ctx context.Context, req *user.LogoutReq) (r *user.LogoutResp, err error) {
 	var _args user.UserServiceLogoutArgs
 	_args.Req = req
@@ -402,6 +1447,36 @@ func (p *kClient) Logout(ctx context.Context, req *user.LogoutReq) (r *user.Logo
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) ListSessions(ctx context.Context, req *user.ListSessionsReq) (r *user.ListSessionsResp, err error) {
+	var _args user.UserServiceListSessionsArgs
+	_args.Req = req
+	var _result user.UserServiceListSessionsResult
+	if err = p.c.Call(ctx, "ListSessions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RevokeSession(ctx context.Context, req *user.RevokeSessionReq) (r *user.RevokeSessionResp, err error) {
+	var _args user.UserServiceRevokeSessionArgs
+	_args.Req = req
+	var _result user.UserServiceRevokeSessionResult
+	if err = p.c.Call(ctx, "RevokeSession", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RevokeAllSessions(ctx context.Context, req *user.RevokeAllSessionsReq) (r *user.RevokeAllSessionsResp, err error) {
+	var _args user.UserServiceRevokeAllSessionsArgs
+	_args.Req = req
+	var _result user.UserServiceRevokeAllSessionsResult
+	if err = p.c.Call(ctx, "RevokeAllSessions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) SetAdministrator(ctx context.Context, req *user.SetAdministratorReq) (r *user.SetAdministratorResp, err error) {
 	var _args user.UserServiceSetAdministratorArgs
 	_args.Req = req
@@ -421,3 +1496,293 @@ func (p *kClient) GetUserInfo(ctx context.Context, req *user.GetUserInfoReq) (r
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) CreateShop(ctx context.Context, req *user.CreateShopReq) (r *user.CreateShopResp, err error) {
+	var _args user.UserServiceCreateShopArgs
+	_args.Req = req
+	var _result user.UserServiceCreateShopResult
+	if err = p.c.Call(ctx, "CreateShop", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateShop(ctx context.Context, req *user.UpdateShopReq) (r *user.UpdateShopResp, err error) {
+	var _args user.UserServiceUpdateShopArgs
+	_args.Req = req
+	var _result user.UserServiceUpdateShopResult
+	if err = p.c.Call(ctx, "UpdateShop", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CloseShop(ctx context.Context, req *user.CloseShopReq) (r *user.CloseShopResp, err error) {
+	var _args user.UserServiceCloseShopArgs
+	_args.Req = req
+	var _result user.UserServiceCloseShopResult
+	if err = p.c.Call(ctx, "CloseShop", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetShop(ctx context.Context, req *user.GetShopReq) (r *user.GetShopResp, err error) {
+	var _args user.UserServiceGetShopArgs
+	_args.Req = req
+	var _result user.UserServiceGetShopResult
+	if err = p.c.Call(ctx, "GetShop", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetShops(ctx context.Context, req *user.GetShopsReq) (r *user.GetShopsResp, err error) {
+	var _args user.UserServiceGetShopsArgs
+	_args.Req = req
+	var _result user.UserServiceGetShopsResult
+	if err = p.c.Call(ctx, "GetShops", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) AddShopStaff(ctx context.Context, req *user.AddShopStaffReq) (r *user.AddShopStaffResp, err error) {
+	var _args user.UserServiceAddShopStaffArgs
+	_args.Req = req
+	var _result user.UserServiceAddShopStaffResult
+	if err = p.c.Call(ctx, "AddShopStaff", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RemoveShopStaff(ctx context.Context, req *user.RemoveShopStaffReq) (r *user.RemoveShopStaffResp, err error) {
+	var _args user.UserServiceRemoveShopStaffArgs
+	_args.Req = req
+	var _result user.UserServiceRemoveShopStaffResult
+	if err = p.c.Call(ctx, "RemoveShopStaff", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListShopStaff(ctx context.Context, req *user.ListShopStaffReq) (r *user.ListShopStaffResp, err error) {
+	var _args user.UserServiceListShopStaffArgs
+	_args.Req = req
+	var _result user.UserServiceListShopStaffResult
+	if err = p.c.Call(ctx, "ListShopStaff", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) IsShopStaff(ctx context.Context, req *user.IsShopStaffReq) (r *user.IsShopStaffResp, err error) {
+	var _args user.UserServiceIsShopStaffArgs
+	_args.Req = req
+	var _result user.UserServiceIsShopStaffResult
+	if err = p.c.Call(ctx, "IsShopStaff", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateProfile(ctx context.Context, req *user.UpdateProfileReq) (r *user.UpdateProfileResp, err error) {
+	var _args user.UserServiceUpdateProfileArgs
+	_args.Req = req
+	var _result user.UserServiceUpdateProfileResult
+	if err = p.c.Call(ctx, "UpdateProfile", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ChangePassword(ctx context.Context, req *user.ChangePasswordReq) (r *user.ChangePasswordResp, err error) {
+	var _args user.UserServiceChangePasswordArgs
+	_args.Req = req
+	var _result user.UserServiceChangePasswordResult
+	if err = p.c.Call(ctx, "ChangePassword", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RequestPasswordReset(ctx context.Context, req *user.RequestPasswordResetReq) (r *user.RequestPasswordResetResp, err error) {
+	var _args user.UserServiceRequestPasswordResetArgs
+	_args.Req = req
+	var _result user.UserServiceRequestPasswordResetResult
+	if err = p.c.Call(ctx, "RequestPasswordReset", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ResetPassword(ctx context.Context, req *user.ResetPasswordReq) (r *user.ResetPasswordResp, err error) {
+	var _args user.UserServiceResetPasswordArgs
+	_args.Req = req
+	var _result user.UserServiceResetPasswordResult
+	if err = p.c.Call(ctx, "ResetPassword", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ExportUserData(ctx context.Context, req *user.ExportUserDataReq) (r *user.ExportUserDataResp, err error) {
+	var _args user.UserServiceExportUserDataArgs
+	_args.Req = req
+	var _result user.UserServiceExportUserDataResult
+	if err = p.c.Call(ctx, "ExportUserData", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CloseAccount(ctx context.Context, req *user.CloseAccountReq) (r *user.CloseAccountResp, err error) {
+	var _args user.UserServiceCloseAccountArgs
+	_args.Req = req
+	var _result user.UserServiceCloseAccountResult
+	if err = p.c.Call(ctx, "CloseAccount", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) EnrollTOTP(ctx context.Context, req *user.EnrollTOTPReq) (r *user.EnrollTOTPResp, err error) {
+	var _args user.UserServiceEnrollTOTPArgs
+	_args.Req = req
+	var _result user.UserServiceEnrollTOTPResult
+	if err = p.c.Call(ctx, "EnrollTOTP", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ConfirmTOTP(ctx context.Context, req *user.ConfirmTOTPReq) (r *user.ConfirmTOTPResp, err error) {
+	var _args user.UserServiceConfirmTOTPArgs
+	_args.Req = req
+	var _result user.UserServiceConfirmTOTPResult
+	if err = p.c.Call(ctx, "ConfirmTOTP", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DisableTOTP(ctx context.Context, req *user.DisableTOTPReq) (r *user.DisableTOTPResp, err error) {
+	var _args user.UserServiceDisableTOTPArgs
+	_args.Req = req
+	var _result user.UserServiceDisableTOTPResult
+	if err = p.c.Call(ctx, "DisableTOTP", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CreateApiKey(ctx context.Context, req *user.CreateApiKeyReq) (r *user.CreateApiKeyResp, err error) {
+	var _args user.UserServiceCreateApiKeyArgs
+	_args.Req = req
+	var _result user.UserServiceCreateApiKeyResult
+	if err = p.c.Call(ctx, "CreateApiKey", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListApiKeys(ctx context.Context, req *user.ListApiKeysReq) (r *user.ListApiKeysResp, err error) {
+	var _args user.UserServiceListApiKeysArgs
+	_args.Req = req
+	var _result user.UserServiceListApiKeysResult
+	if err = p.c.Call(ctx, "ListApiKeys", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RevokeApiKey(ctx context.Context, req *user.RevokeApiKeyReq) (r *user.RevokeApiKeyResp, err error) {
+	var _args user.UserServiceRevokeApiKeyArgs
+	_args.Req = req
+	var _result user.UserServiceRevokeApiKeyResult
+	if err = p.c.Call(ctx, "RevokeApiKey", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) VerifyApiKey(ctx context.Context, req *user.VerifyApiKeyReq) (r *user.VerifyApiKeyResp, err error) {
+	var _args user.UserServiceVerifyApiKeyArgs
+	_args.Req = req
+	var _result user.UserServiceVerifyApiKeyResult
+	if err = p.c.Call(ctx, "VerifyApiKey", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListAuditLogs(ctx context.Context, req *user.ListAuditLogsReq) (r *user.ListAuditLogsResp, err error) {
+	var _args user.UserServiceListAuditLogsArgs
+	_args.Req = req
+	var _result user.UserServiceListAuditLogsResult
+	if err = p.c.Call(ctx, "ListAuditLogs", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CreateWebhookEndpoint(ctx context.Context, req *user.CreateWebhookEndpointReq) (r *user.CreateWebhookEndpointResp, err error) {
+	var _args user.UserServiceCreateWebhookEndpointArgs
+	_args.Req = req
+	var _result user.UserServiceCreateWebhookEndpointResult
+	if err = p.c.Call(ctx, "CreateWebhookEndpoint", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListWebhookEndpoints(ctx context.Context, req *user.ListWebhookEndpointsReq) (r *user.ListWebhookEndpointsResp, err error) {
+	var _args user.UserServiceListWebhookEndpointsArgs
+	_args.Req = req
+	var _result user.UserServiceListWebhookEndpointsResult
+	if err = p.c.Call(ctx, "ListWebhookEndpoints", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateWebhookEndpoint(ctx context.Context, req *user.UpdateWebhookEndpointReq) (r *user.UpdateWebhookEndpointResp, err error) {
+	var _args user.UserServiceUpdateWebhookEndpointArgs
+	_args.Req = req
+	var _result user.UserServiceUpdateWebhookEndpointResult
+	if err = p.c.Call(ctx, "UpdateWebhookEndpoint", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DeleteWebhookEndpoint(ctx context.Context, req *user.DeleteWebhookEndpointReq) (r *user.DeleteWebhookEndpointResp, err error) {
+	var _args user.UserServiceDeleteWebhookEndpointArgs
+	_args.Req = req
+	var _result user.UserServiceDeleteWebhookEndpointResult
+	if err = p.c.Call(ctx, "DeleteWebhookEndpoint", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListWebhookDeliveries(ctx context.Context, req *user.ListWebhookDeliveriesReq) (r *user.ListWebhookDeliveriesResp, err error) {
+	var _args user.UserServiceListWebhookDeliveriesArgs
+	_args.Req = req
+	var _result user.UserServiceListWebhookDeliveriesResult
+	if err = p.c.Call(ctx, "ListWebhookDeliveries", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RedeliverWebhook(ctx context.Context, req *user.RedeliverWebhookReq) (r *user.RedeliverWebhookResp, err error) {
+	var _args user.UserServiceRedeliverWebhookArgs
+	_args.Req = req
+	var _result user.UserServiceRedeliverWebhookResult
+	if err = p.c.Call(ctx, "RedeliverWebhook", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
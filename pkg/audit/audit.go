@@ -0,0 +1,119 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package audit 为各服务提供管理员审计事件的构造和解析,
+// 事件由各服务通过自己的 mq adapter 发布到 kafka, 由用户服务统一消费并持久化
+package audit
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+
+	metainfoContext "github.com/west2-online/DomTok/pkg/base/context"
+	"github.com/west2-online/DomTok/pkg/utils"
+)
+
+// Event 是一条审计事件, 一经发布不会被修改或删除
+type Event struct {
+	EventID   string `json:"event_id"` // 用于在消费时去重
+	ActorID   int64  `json:"actor_id"`
+	Action    string `json:"action"`
+	Target    string `json:"target"`
+	Before    string `json:"before,omitempty"` // 操作前的快照, JSON 格式
+	After     string `json:"after,omitempty"`  // 操作后的快照, JSON 格式
+	ClientIP  string `json:"client_ip"`
+	TraceID   string `json:"trace_id"`
+	CreatedAt int64  `json:"created_at"`
+}
+
+// Publisher 负责发布审计事件, 由各服务的 infrastructure 实现
+type Publisher interface {
+	Publish(ctx context.Context, e *Event) error
+}
+
+// PublisherFunc 使普通函数可以作为 Publisher 使用, 如各服务 mq adapter 的 SendAuditEvent
+type PublisherFunc func(ctx context.Context, e *Event) error
+
+func (f PublisherFunc) Publish(ctx context.Context, e *Event) error {
+	return f(ctx, e)
+}
+
+// Target 返回被操作对象的标识, 如 user:1
+func Target(kind string, id int64) string {
+	return fmt.Sprintf("%s:%d", kind, id)
+}
+
+// NewEvent 根据 context 中携带的登录信息, 客户端 IP 和链路信息构造审计事件,
+// before 和 after 为 nil 时表示对象在操作前不存在或在操作后被删除
+func NewEvent(ctx context.Context, action, target string, before, after any) (*Event, error) {
+	actor, err := metainfoContext.GetLoginData(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("audit.NewEvent: get login data failed: %w", err)
+	}
+	id := make([]byte, 16)
+	if _, err = rand.Read(id); err != nil {
+		return nil, fmt.Errorf("audit.NewEvent: generate event id failed: %w", err)
+	}
+	e := &Event{
+		EventID:   hex.EncodeToString(id),
+		ActorID:   actor,
+		Action:    action,
+		Target:    target,
+		ClientIP:  metainfoContext.GetClientIPData(ctx),
+		CreatedAt: time.Now().UnixMilli(),
+	}
+	if sc := trace.SpanContextFromContext(ctx); sc.HasTraceID() {
+		e.TraceID = sc.TraceID().String()
+	}
+	if e.Before, err = snapshot(before); err != nil {
+		return nil, fmt.Errorf("audit.NewEvent: encode before snapshot failed: %w", err)
+	}
+	if e.After, err = snapshot(after); err != nil {
+		return nil, fmt.Errorf("audit.NewEvent: encode after snapshot failed: %w", err)
+	}
+	return e, nil
+}
+
+// Encode 将审计事件编码为 kafka 的消息体
+func Encode(e *Event) ([]byte, error) {
+	v, err := json.Marshal(e)
+	if err != nil {
+		return nil, fmt.Errorf("audit.Encode: marshal event failed: %w", err)
+	}
+	return v, nil
+}
+
+// Decode 解析从 kafka 中消费到的审计事件
+func Decode(v []byte) (*Event, error) {
+	e := new(Event)
+	if err := json.Unmarshal(v, e); err != nil {
+		return nil, fmt.Errorf("audit.Decode: unmarshal event failed: %w", err)
+	}
+	return e, nil
+}
+
+func snapshot(v any) (string, error) {
+	if v == nil {
+		return "", nil
+	}
+	return utils.JSONEncode(v)
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+
+	"github.com/west2-online/DomTok/pkg/logger"
+)
+
+type logPublisher struct{}
+
+// NewLogPublisher 返回一个只把审计事件输出到日志的 Publisher, 用于测试和本地调试
+func NewLogPublisher() Publisher {
+	return logPublisher{}
+}
+
+func (logPublisher) Publish(_ context.Context, e *Event) error {
+	logger.Infof("audit: actor %d %s %s", e.ActorID, e.Action, e.Target)
+	return nil
+}
+
+// Record 构造并发布审计事件, 审计失败不影响已经完成的操作, 只记录错误日志
+func Record(ctx context.Context, p Publisher, action, target string, before, after any) {
+	e, err := NewEvent(ctx, action, target, before, after)
+	if err != nil {
+		logger.Errorf("audit.Record: %s %s: %v", action, target, err)
+		return
+	}
+	if err = p.Publish(ctx, e); err != nil {
+		logger.Errorf("audit.Record: %s %s: %v", action, target, err)
+	}
+}
@@ -78,3 +78,15 @@ func InitMySQL() (db *gorm.DB, err error) {
 
 	return db, nil
 }
+
+// CloseMySQL 关闭 gorm 底层的连接池, 用于服务退出
+func CloseMySQL(db *gorm.DB) error {
+	if db == nil {
+		return nil
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.Close()
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package client
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"time"
@@ -128,3 +129,17 @@ func getTransport() *kafukago.Transport {
 		SASL: mechanism,
 	}
 }
+
+// PingKafka 建立一次连接并读取 broker 列表, 用于健康检查
+func PingKafka(ctx context.Context) error {
+	conn, err := getDialer().DialContext(ctx, config.Kafka.Network, config.Kafka.Address)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+	_, err = conn.Brokers()
+	return err
+}
@@ -35,8 +35,8 @@ import (
 	"github.com/west2-online/DomTok/pkg/constants"
 )
 
-// 通用的RPC客户端初始化函数
-func initRPCClient[T any](serviceName string, newClientFunc func(string, ...client.Option) (T, error)) (*T, error) {
+// 通用的RPC客户端初始化函数, opts 用于调用方追加额外的选项, 例如熔断
+func initRPCClient[T any](serviceName string, newClientFunc func(string, ...client.Option) (T, error), opts ...client.Option) (*T, error) {
 	if config.Etcd == nil || config.Etcd.Addr == "" {
 		return nil, errors.New("config.Etcd.Addr is nil")
 	}
@@ -46,28 +46,28 @@ func initRPCClient[T any](serviceName string, newClientFunc func(string, ...clie
 		return nil, fmt.Errorf("initRPCClient etcd.NewEtcdResolver failed: %w", err)
 	}
 	// 初始化具体的RPC客户端
-	client, err := newClientFunc(serviceName,
+	client, err := newClientFunc(serviceName, append([]client.Option{
 		client.WithResolver(r),
 		client.WithMuxConnection(constants.MuxConnection),
 		client.WithSuite(tracing.NewClientSuite()),
 		client.WithClientBasicInfo(&rpcinfo.EndpointBasicInfo{ServiceName: fmt.Sprintf(constants.KitexClientEndpointInfoFormat, serviceName)}),
-	)
+	}, opts...)...)
 	if err != nil {
 		return nil, fmt.Errorf("initRPCClient NewClient failed: %w", err)
 	}
 	return &client, nil
 }
 
-func InitUserRPC() (*userservice.Client, error) {
-	return initRPCClient("user", userservice.NewClient)
+func InitUserRPC(opts ...client.Option) (*userservice.Client, error) {
+	return initRPCClient("user", userservice.NewClient, opts...)
 }
 
-func InitOrderRPC() (*orderservice.Client, error) {
-	return initRPCClient(constants.OrderServiceName, orderservice.NewClient)
+func InitOrderRPC(opts ...client.Option) (*orderservice.Client, error) {
+	return initRPCClient(constants.OrderServiceName, orderservice.NewClient, opts...)
 }
 
-func InitCommodityRPC() (*commodityservice.Client, error) {
-	return initRPCClient(constants.CommodityServiceName, commodityservice.NewClient)
+func InitCommodityRPC(opts ...client.Option) (*commodityservice.Client, error) {
+	return initRPCClient(constants.CommodityServiceName, commodityservice.NewClient, opts...)
 }
 
 func InitCommodityStreamClientRPC() (*commodityservice.StreamClient, error) {
@@ -82,10 +82,10 @@ func InitCommodityStreamClientRPC() (*commodityservice.StreamClient, error) {
 	return &cli, nil
 }
 
-func InitCartRPC() (*cartservice.Client, error) {
-	return initRPCClient(constants.CartServiceName, cartservice.NewClient)
+func InitCartRPC(opts ...client.Option) (*cartservice.Client, error) {
+	return initRPCClient(constants.CartServiceName, cartservice.NewClient, opts...)
 }
 
-func InitPaymentRPC() (*paymentservice.Client, error) {
-	return initRPCClient(constants.PaymentServiceName, paymentservice.NewClient)
+func InitPaymentRPC(opts ...client.Option) (*paymentservice.Client, error) {
+	return initRPCClient(constants.PaymentServiceName, paymentservice.NewClient, opts...)
 }
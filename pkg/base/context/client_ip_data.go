@@ -0,0 +1,34 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package context
+
+import (
+	"context"
+
+	"github.com/west2-online/DomTok/pkg/constants"
+)
+
+// WithClientIPData 将发起请求的客户端 IP 加入到context中，通过metainfo传递到RPC server
+func WithClientIPData(ctx context.Context, ip string) context.Context {
+	return newContext(ctx, constants.ClientIPDataKey, ip)
+}
+
+// GetClientIPData 从context中取出发起请求的客户端 IP, 没有携带时返回空字符串
+func GetClientIPData(ctx context.Context) string {
+	ip, _ := fromContext(ctx, constants.ClientIPDataKey)
+	return ip
+}
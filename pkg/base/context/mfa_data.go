@@ -0,0 +1,39 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package context
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/west2-online/DomTok/pkg/constants"
+)
+
+// WithMFAData 将当前会话是否通过了两步验证加入到context中，通过metainfo传递到RPC server
+func WithMFAData(ctx context.Context, mfa bool) context.Context {
+	return newContext(ctx, constants.MFADataKey, strconv.FormatBool(mfa))
+}
+
+// GetMFAData 从context中取出当前会话是否通过了两步验证, 没有携带时视为没有通过
+func GetMFAData(ctx context.Context) bool {
+	value, ok := fromContext(ctx, constants.MFADataKey)
+	if !ok {
+		return false
+	}
+	mfa, _ := strconv.ParseBool(value)
+	return mfa
+}
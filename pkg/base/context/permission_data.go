@@ -0,0 +1,42 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package context
+
+import (
+	"context"
+	"strings"
+
+	"github.com/west2-online/DomTok/pkg/constants"
+	"github.com/west2-online/DomTok/pkg/errno"
+)
+
+// WithPermissionData 将当前用户拥有的权限加入到context中，通过metainfo传递到RPC server
+func WithPermissionData(ctx context.Context, permissions []string) context.Context {
+	return newContext(ctx, constants.PermissionDataKey, strings.Join(permissions, constants.PermissionDataSeparator))
+}
+
+// GetPermissionData 从context中取出当前用户拥有的权限
+func GetPermissionData(ctx context.Context) ([]string, error) {
+	value, ok := fromContext(ctx, constants.PermissionDataKey)
+	if !ok {
+		return nil, errno.NewErrNo(errno.ParamMissingErrorCode, "Failed to get permission in context")
+	}
+	if value == "" {
+		return []string{}, nil
+	}
+	return strings.Split(value, constants.PermissionDataSeparator), nil
+}
@@ -0,0 +1,38 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package context
+
+import (
+	"context"
+
+	"github.com/west2-online/DomTok/pkg/constants"
+	"github.com/west2-online/DomTok/pkg/errno"
+)
+
+// WithSessionData 将当前请求所在的会话 ID 加入到context中，通过metainfo传递到RPC server
+func WithSessionData(ctx context.Context, sessionID string) context.Context {
+	return newContext(ctx, constants.SessionDataKey, sessionID)
+}
+
+// GetSessionData 从context中取出当前请求所在的会话 ID
+func GetSessionData(ctx context.Context) (string, error) {
+	sessionID, ok := fromContext(ctx, constants.SessionDataKey)
+	if !ok || sessionID == "" {
+		return "", errno.NewErrNo(errno.ParamMissingErrorCode, "Failed to get session in context")
+	}
+	return sessionID, nil
+}
@@ -0,0 +1,32 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package constants
+
+// 审计事件的 action, 命名规则为 <服务>.<资源>.<操作>
+const (
+	AuditActionBanUser          = "user.user.ban"
+	AuditActionLiftBan          = "user.user.lift_ban"
+	AuditActionSetAdministrator = "user.user.set_administrator"
+	AuditActionDeleteCategory   = "commodity.category.delete"
+	AuditActionDeleteCoupon     = "commodity.coupon.delete"
+	AuditActionRefundReview     = "payment.refund.review"
+)
+
+const (
+	AuditLogDefaultPageSize = 20  // 查询审计日志时默认的每页数量
+	AuditLogMaxPageSize     = 100 // 查询审计日志时每页数量的上限
+)
@@ -16,7 +16,11 @@ limitations under the License.
 
 package constants
 
+import "time"
+
 const (
 	CheckFileTypeBufferSize = 512 // 适用于判断文件类型，需要读取前512个字节
 	FileStreamBufferSize    = 1024
+
+	ConfigReloadInterval = 30 * time.Second // 从 etcd 拉取配置检查是否变更的间隔
 )
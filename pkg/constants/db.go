@@ -35,6 +35,9 @@ const (
 const (
 	UserTableName       = "users"
 	AddressTableName    = "address"
+	ShopTableName       = "shop"
+	ShopStaffTableName  = "shop_staff"
+	UserBanTableName    = "user_ban"
 	CategoryTableName   = "category"
 	OrderTableName      = "orders"
 	OrderGoodsTableName = "order_goods"
@@ -43,6 +46,13 @@ const (
 	CouponTableName     = "coupon_info"
 	UserCouponTableName = "user_coupon"
 
+	UserTOTPTableName         = "user_totp"
+	UserRecoveryCodeTableName = "user_recovery_code"
+	MerchantApiKeyTableName   = "merchant_api_key"
+	AuditLogTableName         = "audit_log"
+	WebhookEndpointTableName  = "webhook_endpoint"
+	WebhookDeliveryTableName  = "webhook_delivery"
+
 	SpuSkuTableName        = "spu_to_sku"
 	CartTableName          = "cart"
 	PaymentTableName       = "payment_orders"
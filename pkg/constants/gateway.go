@@ -26,14 +26,44 @@ const (
 
 	SentinelThreshold        = 100
 	SentinelStatIntervalInMs = 1000
+	SentinelDefaultResource  = "api" // 没有匹配到路由的请求共用的资源
 	LoginDataKey             = "loginData"
+	SessionDataKey           = "sessionData"
+	PermissionDataKey        = "permissionData"
+	MFADataKey               = "mfaData"
+	ClientIPDataKey          = "clientIPData"
+	PermissionDataSeparator  = ","
 
-	BloomFilterSize   = 10000
-	FalsePositiveRate = 0.05
-	BFMaxSize         = 20
+	BanSetRebuildInterval = 5 * time.Minute // 网关定期全量重建封禁名单, 弥补订阅断线期间丢失的事件
+	BanSetScanCount       = 1000            // 使用 SCAN 加载封禁名单时每次迭代的数量
+
+	UserDataExportFileName = "domtok-user-data.json" // 个人数据导出时下载的文件名
+)
+
+// Idempotency-Key
+const (
+	IdempotencyKeyHeader      = "Idempotency-Key"
+	IdempotencyReplayedHeader = "Idempotency-Replayed" // 响应是重放的已保存结果时为 true
+	IdempotencyKeyMaxLength   = 255
+	IdempotencyProcessingTTL  = time.Minute    // 处理中的标记的有效期, 防止网关崩溃后幂等键永远无法使用
+	IdempotencyResponseTTL    = 24 * time.Hour // 已完成请求的响应保存的时间
 )
 
 const (
 	UserLogout = iota
 	UserBanned
 )
+
+// 商品查询接口的响应缓存
+const (
+	CatalogCacheTTL          = 30 * time.Second
+	CatalogStaleTTL          = 10 * time.Minute    // 降级数据保存的时间
+	CatalogCacheControlValue = "private, no-cache" // 浏览器每次都需要携带 If-None-Match 重新校验
+	CatalogStaleWarning      = `110 - "Response is Stale"`
+)
+
+// 接口文档, 由 make openapi 生成
+const (
+	OpenAPIPath = "/openapi.json"
+	DocsPath    = "/docs"
+)
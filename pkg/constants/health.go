@@ -0,0 +1,35 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package constants
+
+import "time"
+
+const (
+	HealthAddrEnv      = "HEALTH_ADDR" // 健康检查 HTTP 服务的监听地址, 为空时不启动
+	HealthzPath        = "/healthz"    // 存活检查, 进程能响应即可
+	ReadyzPath         = "/readyz"     // 就绪检查, 所有依赖都可用时才返回 200
+	HealthCheckTimeout = 2 * time.Second
+)
+
+// 依赖检查项的名称
+const (
+	HealthCheckMySQL         = "mysql"
+	HealthCheckRedis         = "redis"
+	HealthCheckElasticsearch = "elasticsearch"
+	HealthCheckKafka         = "kafka"
+	HealthCheckRocketMQ      = "rocketmq"
+)
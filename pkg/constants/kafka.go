@@ -48,6 +48,12 @@ const (
 	KafkaESConsumerChanCap = 10
 )
 
+// Gateway
+const (
+	KafkaCatalogCacheConsumerNum = 1                       // 每个 spu 话题的并发消费者数
+	KafkaCatalogCacheGroupId     = "gateway_catalog_cache" // 网关根据 spu 事件失效商品缓存的订阅组id
+)
+
 // CartService
 const (
 	KafkaCartTopic                = "cart"           // Kafka的话题
@@ -55,3 +61,17 @@ const (
 	KafkaCartAddGoodsConsumerNum  = 10               // Kafka的并发消费者数
 	KafkaCartAddGoodsGroupId      = "cart_add_goods" // Kafka的订阅组id
 )
+
+// AuditLog
+const (
+	KafkaAuditTopic       = "audit"     // 各服务发布审计事件的话题
+	KafkaAuditConsumerNum = 1           // 审计事件的并发消费者数
+	KafkaAuditGroupId     = "audit_log" // 用户服务持久化审计事件的订阅组id
+)
+
+// Webhook
+const (
+	KafkaWebhookTopic       = "webhook"          // 订单和支付服务发布商家 webhook 事件的话题
+	KafkaWebhookConsumerNum = 1                  // webhook 事件的并发消费者数
+	KafkaWebhookGroupId     = "webhook_dispatch" // 用户服务投递 webhook 事件的订阅组id
+)
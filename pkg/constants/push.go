@@ -0,0 +1,42 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package constants
+
+import "time"
+
+// 推送给客户端的事件类型
+const (
+	PushEventOrderStatus  = "order.status"  // 订单状态变化
+	PushEventRefundResult = "refund.result" // 退款审核结果
+)
+
+// 订单被取消的原因
+const (
+	PushCancelReasonUser    = "user"    // 用户主动取消
+	PushCancelReasonPayment = "payment" // 支付服务取消, 如退款通过
+	PushCancelReasonExpired = "expired" // 超时未支付, 库存已经回滚
+)
+
+const (
+	OrderPushPath        = "/api/v1/order/push" // 推送使用的 websocket 路由, 不由 IDL 生成
+	PushWriteTimeout     = 10 * time.Second     // 单次写入的超时时间
+	PushPingInterval     = 30 * time.Second     // 服务端发送 ping 的间隔
+	PushPongTimeout      = 60 * time.Second     // 超过这个时间没有收到客户端的任何消息(包括 pong)时断开连接
+	PushSubscriberBuffer = 16                   // 每个连接缓冲的事件数, 缓冲满时丢弃新事件
+	PushMaxConnsPerUser  = 5                    // 每个用户在单个网关实例上最多同时保持的连接数
+	PushReadLimit        = 512                  // 客户端只需要回复 pong, 限制单条消息的大小
+)
@@ -0,0 +1,42 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package constants
+
+// 用户角色, 为了兼容已有的数据, 顾客和超级管理员沿用原来的 0 和 1
+const (
+	RoleCustomer   = 0 // 普通顾客
+	RoleSuperAdmin = 1 // 超级管理员, 拥有所有权限
+	RoleMerchant   = 2 // 商家
+	RoleOperator   = 3 // 运营
+	RoleFinance    = 4 // 财务
+)
+
+// 权限命名规则为 <服务>:<资源>:<操作>
+const (
+	PermissionUserBan         = "user:user:ban"              // 封禁/解封用户, 解除登录锁定
+	PermissionCommodityManage = "commodity:commodity:manage" // 管理商品和优惠券
+	PermissionCategoryManage  = "commodity:category:manage"  // 管理商品分类
+	PermissionRefundReview    = "payment:refund:review"      // 审核退款
+	PermissionShopCreate      = "user:shop:create"           // 开设店铺
+	PermissionAuditView       = "user:audit:view"            // 查询管理员审计日志
+)
+
+// API Key 的 scope, 决定了 key 可以访问哪些路由, 路由和 scope 的对应关系见 rbac.ApiKeyScope
+const (
+	ApiKeyScopeCommodity = "commodity" // 管理商品, 库存和价格
+	ApiKeyScopeCoupon    = "coupon"    // 管理优惠券
+)
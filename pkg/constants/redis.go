@@ -34,8 +34,14 @@ const (
 	RedisNXExpireTime        = 3 * time.Second
 	RedisMaxLockRetryTime    = 400 * time.Millisecond
 	RedisRetryStopTime       = 100 * time.Millisecond
-	RedisUserLogoutKey       = "logout:user:"
 	RedisUserBanedKey        = "ban:user:"
+	RedisSessionKey          = "session:"
+	RedisUserSessionKey      = "user_sessions:"
+	RedisPasswordResetKey    = "password:reset:"
+	RedisLoginFailureKey     = "login:failure:"
+	RedisLoginLockKey        = "login:lock:"
+	RedisUserBanChannel      = "channel:user:ban" // 封禁和解封事件的发布订阅频道
+	RedisPushChannel         = "channel:push"     // 订单和退款状态推送的发布订阅频道
 	NeverExpire              = 0
 	RedisUserLoginExpireTime = 2 * 60 * 60 * time.Second
 )
@@ -83,6 +89,104 @@ const (
 
         return 1
     `
+
+	// UserVerifyPasswordResetCodeLuaScript 原子地校验重置密码的验证码
+	// 校验通过或错误次数达到上限时都会删除验证码, 保证验证码只能使用一次
+	UserVerifyPasswordResetCodeLuaScript = `
+        local codeK = KEYS[1]
+        local presented = ARGV[1]
+        local maxAttempts = tonumber(ARGV[2])
+
+        local code = redis.call('HGET', codeK, 'code')
+        if not code then
+            return -1
+        end
+
+        if code == presented then
+            redis.call('DEL', codeK)
+            return 1
+        end
+
+        local attempts = redis.call('HINCRBY', codeK, 'attempts', 1)
+        if attempts >= maxAttempts then
+            redis.call('DEL', codeK)
+        end
+        return 0
+    `
+)
+
+// User
+const (
+	// UserRotateSessionTokenLuaScript 原子地轮换会话中当前有效的 refresh token
+	// 如果出示的 token 不是当前有效的 token, 说明旧 token 被重复使用, 直接删除整个会话并从用户的会话集合中移除
+	// 轮换成功时会同时续期用户的会话集合, 避免会话集合先于会话过期
+	UserRotateSessionTokenLuaScript = `
+        local sessionK = KEYS[1]
+        local userK = KEYS[2]
+        local presented = ARGV[1]
+        local next = ARGV[2]
+        local expire = tonumber(ARGV[3])
+        local now = ARGV[4]
+        local sessionID = ARGV[5]
+
+        local current = redis.call('HGET', sessionK, 'current')
+        if not current then
+            return -1
+        end
+
+        if current ~= presented then
+            redis.call('DEL', sessionK)
+            redis.call('SREM', userK, sessionID)
+            return 0
+        end
+
+        redis.call('HSET', sessionK, 'current', next, 'active_at', now)
+        redis.call('EXPIRE', sessionK, expire)
+        redis.call('EXPIRE', userK, expire)
+        return 1
+    `
+)
+
+// Gateway
+const (
+	RedisRateLimitKey      = "ratelimit:"      // 网关限流令牌桶, 格式为 ratelimit:{ip|user}:{规则}:{标识}
+	RedisIdempotencyKey    = "idempotency:"    // 幂等键保存的请求指纹和响应, 格式为 idempotency:{ip|user}:{标识}:{幂等键}
+	RedisCatalogCacheKey   = "catalog:cache:"  // 商品查询接口的响应缓存, 格式为 catalog:cache:{版本}:{请求摘要}
+	RedisCatalogVersionKey = "catalog:version" // 商品缓存的版本, spu 变更时递增, 使旧版本的缓存全部失效
+	RedisCatalogStaleKey   = "catalog:stale:"  // 商品查询接口最近一次成功的响应, 下游服务不可用时作为降级数据
+
+	// GatewayTokenBucketLuaScript 原子地从令牌桶中取出一个令牌, 使用 redis 的时间保证多个网关实例的时钟一致
+	// 返回是否放行, 以及被拒绝时需要等待的毫秒数
+	GatewayTokenBucketLuaScript = `
+        local bucketK = KEYS[1]
+        local rate = tonumber(ARGV[1])
+        local burst = tonumber(ARGV[2])
+
+        local t = redis.call('TIME')
+        local now = tonumber(t[1]) * 1000 + math.floor(tonumber(t[2]) / 1000)
+
+        local bucket = redis.call('HMGET', bucketK, 'tokens', 'ts')
+        local tokens = tonumber(bucket[1])
+        local ts = tonumber(bucket[2])
+        if tokens == nil or ts == nil then
+            tokens = burst
+            ts = now
+        end
+        tokens = math.min(burst, tokens + math.max(0, now - ts) * rate / 1000)
+
+        local allowed = 0
+        local wait = 0
+        if tokens >= 1 then
+            tokens = tokens - 1
+            allowed = 1
+        else
+            wait = math.ceil((1 - tokens) * 1000 / rate)
+        end
+
+        redis.call('HSET', bucketK, 'tokens', tostring(tokens), 'ts', now)
+        redis.call('PEXPIRE', bucketK, math.ceil(burst * 1000 / rate) + 1000)
+        return {allowed, wait}
+    `
 )
 
 const (
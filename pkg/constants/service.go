@@ -33,11 +33,58 @@ const (
 const (
 	UserMaximumPasswordLength      = 72 // DO NOT EDIT (ref: bcrypt.GenerateFromPassword)
 	UserMinimumPasswordLength      = 5
+	UserMaximumNameLength          = 30
 	UserDefaultEncryptPasswordCost = 10
 	UserTestId                     = 1
 	UserTestAddr                   = 1
-	UserAdministrator              = 1
-	NormalUser                     = 0
+
+	ShopStatusOpen   = 0 // 店铺营业中
+	ShopStatusClosed = 1 // 店铺已关闭
+
+	ShopStaffRoleStaff = 0 // 店员
+	ShopStaffRoleOwner = 1 // 店主
+
+	ShopMaximumNameLength = 30
+	ShopMaximumBatchSize  = 100 // 批量获取店铺信息时一次最多查询的数量
+
+	PasswordResetCodeLength     = 6
+	PasswordResetCodeExpireTime = 10 * time.Minute // 重置密码验证码的有效期
+	PasswordResetResendInterval = time.Minute      // 两次发送验证码之间的最短间隔
+	PasswordResetMaxAttempts    = 5                // 验证码最多允许输错的次数, 超过后验证码作废
+
+	PasswordResetCodeValid    = 1  // 验证码校验通过
+	PasswordResetCodeInvalid  = 0  // 验证码错误
+	PasswordResetCodeNotExist = -1 // 验证码不存在(过期, 已使用或错误次数过多)
+
+	UserBanMaximumReasonLength = 255
+
+	ClosedAccountNamePrefix  = "deleted_"         // 注销后的账号用户名会被替换为该前缀加 uid, 普通用户不能使用该前缀
+	ClosedAccountEmailSuffix = "@deleted.invalid" // 邮箱有唯一索引, 注销后替换为一个不可投递的占位地址
+
+	LoginFailureWindow     = 15 * time.Minute // 统计登录失败次数的滑动窗口
+	LoginDelayThreshold    = 3                // 窗口内同一用户名失败达到该次数后, 每次失败都需要等待一段时间才能再次尝试
+	LoginBaseDelay         = time.Second      // 第一次延迟的时长, 之后每失败一次翻倍
+	LoginMaxDelay          = time.Minute      // 延迟的上限
+	LoginUserLockThreshold = 10               // 窗口内同一用户名失败达到该次数后锁定账号
+	LoginIPLockThreshold   = 50               // 窗口内同一 IP 失败达到该次数后锁定该 IP
+	LoginLockDuration      = 15 * time.Minute // 锁定时长
+
+	TOTPIssuer            = "DomTok"         // 验证器中显示的服务名称
+	TOTPSecretSize        = 20               // 密钥的字节数, RFC 4226 推荐 160 位
+	TOTPDigits            = 6                // 动态验证码的位数
+	TOTPPeriod            = 30 * time.Second // 动态验证码的时间步长
+	TOTPSkew              = 1                // 允许前后偏差的时间步数, 用于容忍客户端的时钟误差
+	TOTPRecoveryCodeCount = 10               // 开启两步验证时生成的恢复码数量
+	TOTPRecoveryCodeSize  = 5                // 每个恢复码的随机字节数, 编码后为 8 个字符
+
+	ApiKeyPrefix            = "dtk_" // 所有 API Key 的固定前缀, 方便在日志和代码仓库中识别泄露的 key
+	ApiKeySize              = 20     // API Key 的随机字节数
+	ApiKeyDisplayLength     = 12     // 列表中展示的 key 前缀长度, 包含固定前缀
+	ApiKeyMaximumPerUser    = 10     // 每个用户最多可以持有的 API Key 数量
+	ApiKeyMaximumNameLength = 30
+
+	NotifierTypeLog  = "log"  // 通知输出到日志
+	NotifierTypeFile = "file" // 通知追加写入到文件
 )
 
 // OrderService
@@ -0,0 +1,24 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package constants
+
+import "time"
+
+const (
+	ServerExitWaitTime = 5 * time.Second // 停止接收请求后等待处理中的请求结束的时间
+	ShutdownTimeout    = 4 * time.Second // 执行所有退出清理函数的总时间, 两者相加需要小于 docker 的 stop_grace_period(默认 10s)
+)
@@ -29,7 +29,19 @@ const (
 	RefreshTokenTTL = time.Hour * 24 * 30 // Refresh Token 有效期30天
 	Issuer          = "west2-online"      // token 颁发者
 
+	SessionTokenRotated  = 1  // 会话中的 refresh token 轮换成功
+	SessionTokenReused   = 0  // 出示了已经被轮换掉的 refresh token
+	SessionTokenNotExist = -1 // 会话不存在(过期或已被吊销)
+
 	AuthHeader         = "Authorization" // 获取 Token 时的请求头
+	ApiKeyHeader       = "X-Api-Key"     // 使用 API Key 认证时的请求头
 	AccessTokenHeader  = "Access-Token"  // 响应时的访问令牌头
 	RefreshTokenHeader = "Refresh-Token" // 响应时的刷新令牌头
 )
+
+// jwt 签名密钥
+const (
+	JWTLegacyKeyID = "default"                // 旧的单密钥配置(server.private-key)对应的 kid, 没有 kid 的 token 也使用它校验
+	JWKSPath       = "/.well-known/jwks.json" // 网关公开 jwt 公钥的路由
+	JWKSCacheAge   = 5 * time.Minute          // 其他组件缓存公钥的时间, 新密钥至少需要提前这么久加入配置
+)
@@ -0,0 +1,73 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package constants
+
+import "time"
+
+// 商家 webhook 的事件类型, 命名规则为 <资源>.<状态>
+const (
+	WebhookEventOrderPaid      = "order.paid"
+	WebhookEventOrderCanceled  = "order.canceled"
+	WebhookEventRefundApproved = "refund.approved"
+	WebhookEventRefundRejected = "refund.rejected"
+)
+
+// WebhookEventTypes 是商家可以订阅的全部事件类型
+var WebhookEventTypes = []string{
+	WebhookEventOrderPaid,
+	WebhookEventOrderCanceled,
+	WebhookEventRefundApproved,
+	WebhookEventRefundRejected,
+}
+
+// 投递 webhook 时携带的请求头, 签名为 HMAC-SHA256(secret, "<timestamp>.<body>") 的十六进制编码
+const (
+	WebhookEventHeader     = "X-DomTok-Event"
+	WebhookDeliveryHeader  = "X-DomTok-Delivery"
+	WebhookTimestampHeader = "X-DomTok-Timestamp"
+	WebhookSignatureHeader = "X-DomTok-Signature"
+	WebhookSignaturePrefix = "sha256="
+)
+
+const (
+	WebhookEndpointStatusEnabled  = 0 // 正常投递
+	WebhookEndpointStatusDisabled = 1 // 连续失败过多被自动停用, 或由商家手动停用
+
+	WebhookDeliveryStatusPending   = 0 // 等待投递或等待重试
+	WebhookDeliveryStatusSucceeded = 1 // 商家返回了 2xx
+	WebhookDeliveryStatusFailed    = 2 // 重试次数耗尽或端点已停用
+)
+
+const (
+	WebhookSecretLength         = 32               // 签名密钥的随机字节数
+	WebhookMaxEndpointsPerShop  = 10               // 每个店铺最多注册的端点数
+	WebhookURLMaxLength         = 512              // 端点 URL 的最大长度
+	WebhookRequestTimeout       = 10 * time.Second // 单次投递的超时时间
+	WebhookMaxAttempts          = 8                // 单个投递最多尝试的次数, 包括第一次
+	WebhookRetryBaseInterval    = 30 * time.Second // 第一次重试的间隔, 之后每次翻倍
+	WebhookRetryMaxInterval     = 2 * time.Hour    // 重试间隔的上限
+	WebhookRetryScanInterval    = 10 * time.Second // 扫描到期重试的间隔
+	WebhookRetryBatchSize       = 100              // 每次扫描最多处理的投递数
+	WebhookDeliveryLease        = time.Minute      // 投递被某个实例领取后, 其他实例在这段时间内不会重复领取
+	WebhookDisableThreshold     = 20               // 端点连续失败这么多次后自动停用
+	WebhookResponseErrorMaxSize = 512              // 投递日志中记录的错误信息的最大长度
+)
+
+const (
+	WebhookDeliveryDefaultPageSize = 20  // 查询投递日志时默认的每页数量
+	WebhookDeliveryMaxPageSize     = 100 // 查询投递日志时每页数量的上限
+)
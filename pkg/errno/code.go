@@ -45,6 +45,15 @@ const (
 	AuthNoOperatePermissionCode                // 没有操作权限
 	AuthMissingTokenCode                       // 缺少 token
 	IllegalOperatorCode                        // 不合格的操作(比如传入 payment status时传入了一个不存在的 status)
+	AuthRefreshTokenReusedCode                 // 刷新令牌被重复使用, 整个令牌家族已被吊销
+	AuthMFARequiredCode                        // 操作需要通过两步验证登录
+)
+
+// 400xx: 请求被网关拒绝
+const (
+	TooManyRequestsCode        = 40000 + iota // 请求过于频繁, 被网关限流
+	IdempotencyConflictCode                   // 相同幂等键的请求正在处理中
+	IdempotencyKeyMismatchCode                // 幂等键已经被另一个不同的请求使用
 )
 
 // 500xx: 内部错误，Internal 打头
@@ -28,6 +28,19 @@ const (
 	UserLogOut
 	UserBaned
 	UserAlreadyLogin
+	ServiceShopNotExist
+	ServiceShopClosed
+	ServiceNotShopStaff
+	ServiceWrongResetCode
+	ServiceLoginLocked
+	ServiceEmailExist
+	ServicePhoneExist
+	ServiceTOTPRequired
+	ServiceWrongTOTPCode
+	ServiceTOTPNotEnrolled
+	ServiceWebhookEndpointNotExist
+	ServiceWebhookDeliveryNotExist
+	ServiceWebhookEndpointDisabled
 )
 
 // order
@@ -29,6 +29,11 @@ var (
 	AuthAccessExpired       = NewErrNo(AuthAccessExpiredCode, "token expiration")
 	AuthNoToken             = NewErrNo(AuthNoTokenCode, "lack of token")
 	AuthNoOperatePermission = NewErrNo(AuthNoOperatePermissionCode, "No permission to operate")
+	AuthMFARequired         = NewErrNo(AuthMFARequiredCode, "two-factor authentication required")
+
+	TooManyRequests        = NewErrNo(TooManyRequestsCode, "too many requests, please try again later")
+	IdempotencyConflict    = NewErrNo(IdempotencyConflictCode, "a request with the same Idempotency-Key is being processed")
+	IdempotencyKeyMismatch = NewErrNo(IdempotencyKeyMismatchCode, "Idempotency-Key has been used by a different request")
 
 	InternalServiceError = NewErrNo(InternalServiceErrorCode, "internal server error")
 	OSOperationError     = NewErrNo(OSOperateErrorCode, "os operation failed")
@@ -0,0 +1,79 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/olivere/elastic/v7"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+)
+
+// errNotInitialized 表示客户端在启动时就没有初始化成功
+var errNotInitialized = errors.New("client is not initialized")
+
+func MySQL(db *gorm.DB) Checker {
+	return func(ctx context.Context) error {
+		if db == nil {
+			return errNotInitialized
+		}
+		sqlDB, err := db.DB()
+		if err != nil {
+			return err
+		}
+		return sqlDB.PingContext(ctx)
+	}
+}
+
+func Redis(c *redis.Client) Checker {
+	return func(ctx context.Context) error {
+		if c == nil {
+			return errNotInitialized
+		}
+		return c.Ping(ctx).Err()
+	}
+}
+
+// Elasticsearch 集群状态为 red 时认为不可用, yellow 只是副本未分配, 不影响读写
+func Elasticsearch(c *elastic.Client) Checker {
+	return func(ctx context.Context) error {
+		resp, err := c.ClusterHealth().Do(ctx)
+		if err != nil {
+			return err
+		}
+		if resp.Status == "red" {
+			return fmt.Errorf("cluster status is %s", resp.Status)
+		}
+		return nil
+	}
+}
+
+// TCP 只检查地址是否可以建立连接, 用于没有轻量探测接口的依赖, 如 RocketMQ 的 NameServer
+func TCP(addr string) Checker {
+	return func(ctx context.Context) error {
+		var d net.Dialer
+		conn, err := d.DialContext(ctx, "tcp", addr)
+		if err != nil {
+			return err
+		}
+		return conn.Close()
+	}
+}
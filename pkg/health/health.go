@@ -0,0 +1,129 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package health 汇总服务依赖的可用性, 供 docker 健康检查和负载均衡探测使用
+// 各服务在初始化客户端时调用 Register 注册检查项, 再通过 Serve 或网关的 /readyz 对外暴露
+package health
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/west2-online/DomTok/pkg/constants"
+)
+
+const (
+	StatusUp   = "up"
+	StatusDown = "down"
+)
+
+// Checker 检查一个依赖是否可用, ctx 带有 constants.HealthCheckTimeout 的超时
+type Checker func(ctx context.Context) error
+
+// Result 是单个依赖的检查结果
+type Result struct {
+	Status    string `json:"status"`
+	LatencyMs int64  `json:"latency_ms"`
+	Error     string `json:"error,omitempty"`
+}
+
+// Report 是一次就绪检查的结果, 任意一个依赖不可用时 Status 为 down
+type Report struct {
+	Status string            `json:"status"`
+	Checks map[string]Result `json:"checks"`
+}
+
+type Registry struct {
+	checkers map[string]Checker
+	mu       sync.RWMutex
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		checkers: make(map[string]Checker),
+	}
+}
+
+// Register 注册检查项, 同名的检查项会被覆盖
+func (r *Registry) Register(name string, c Checker) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.checkers[name] = c
+}
+
+// Check 并发执行所有检查项, 单个检查项超时不会拖慢其他检查项
+func (r *Registry) Check(ctx context.Context) *Report {
+	r.mu.RLock()
+	checkers := make(map[string]Checker, len(r.checkers))
+	for name, c := range r.checkers {
+		checkers[name] = c
+	}
+	r.mu.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, constants.HealthCheckTimeout)
+	defer cancel()
+
+	report := &Report{
+		Status: StatusUp,
+		Checks: make(map[string]Result, len(checkers)),
+	}
+	var (
+		wg sync.WaitGroup
+		mu sync.Mutex
+	)
+	for name, c := range checkers {
+		wg.Add(1)
+		go func(name string, c Checker) {
+			defer wg.Done()
+			res := run(ctx, c)
+			mu.Lock()
+			defer mu.Unlock()
+			report.Checks[name] = res
+			if res.Status == StatusDown {
+				report.Status = StatusDown
+			}
+		}(name, c)
+	}
+	wg.Wait()
+	return report
+}
+
+func run(ctx context.Context, c Checker) Result {
+	start := time.Now()
+	err := c(ctx)
+	res := Result{
+		Status:    StatusUp,
+		LatencyMs: time.Since(start).Milliseconds(),
+	}
+	if err != nil {
+		res.Status = StatusDown
+		res.Error = err.Error()
+	}
+	return res
+}
+
+var defaultRegistry = NewRegistry()
+
+// Register 向默认的 Registry 注册检查项
+func Register(name string, c Checker) {
+	defaultRegistry.Register(name, c)
+}
+
+// Check 执行默认 Registry 中的所有检查项
+func Check(ctx context.Context) *Report {
+	return defaultRegistry.Check(ctx)
+}
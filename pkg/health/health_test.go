@@ -0,0 +1,78 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/west2-online/DomTok/pkg/constants"
+)
+
+func TestRegistry_Check(t *testing.T) {
+	Convey("TestRegistry_Check", t, func() {
+		r := NewRegistry()
+		So(r.Check(context.Background()).Status, ShouldEqual, StatusUp)
+
+		r.Register(constants.HealthCheckRedis, func(ctx context.Context) error { return nil })
+		report := r.Check(context.Background())
+		So(report.Status, ShouldEqual, StatusUp)
+		So(report.Checks[constants.HealthCheckRedis].Status, ShouldEqual, StatusUp)
+
+		r.Register(constants.HealthCheckMySQL, func(ctx context.Context) error { return errors.New("connection refused") })
+		report = r.Check(context.Background())
+		So(report.Status, ShouldEqual, StatusDown)
+		So(report.Checks[constants.HealthCheckRedis].Status, ShouldEqual, StatusUp)
+		So(report.Checks[constants.HealthCheckMySQL].Status, ShouldEqual, StatusDown)
+		So(report.Checks[constants.HealthCheckMySQL].Error, ShouldEqual, "connection refused")
+
+		// 检查项需要遵守超时, 不能让整个检查一直阻塞
+		r.Register(constants.HealthCheckKafka, func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		})
+		report = r.Check(context.Background())
+		So(report.Checks[constants.HealthCheckKafka].Status, ShouldEqual, StatusDown)
+		So(report.Checks[constants.HealthCheckKafka].LatencyMs, ShouldBeLessThan, (constants.HealthCheckTimeout + constants.HealthCheckTimeout/2).Milliseconds())
+	})
+}
+
+func TestRegistry_Handler(t *testing.T) {
+	Convey("TestRegistry_Handler", t, func() {
+		r := NewRegistry()
+		r.Register(constants.HealthCheckRedis, func(ctx context.Context) error { return errors.New("down") })
+		h := r.Handler()
+
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, constants.HealthzPath, nil))
+		So(rec.Code, ShouldEqual, http.StatusOK)
+
+		rec = httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, constants.ReadyzPath, nil))
+		So(rec.Code, ShouldEqual, http.StatusServiceUnavailable)
+		var report Report
+		So(json.Unmarshal(rec.Body.Bytes(), &report), ShouldBeNil)
+		So(report.Status, ShouldEqual, StatusDown)
+		So(report.Checks[constants.HealthCheckRedis].Error, ShouldEqual, "down")
+	})
+}
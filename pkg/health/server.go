@@ -0,0 +1,72 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/west2-online/DomTok/pkg/constants"
+	"github.com/west2-online/DomTok/pkg/logger"
+)
+
+// Handler 返回提供 /healthz 和 /readyz 的 http.Handler
+func (r *Registry) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(constants.HealthzPath, func(w http.ResponseWriter, req *http.Request) {
+		writeReport(w, &Report{Status: StatusUp, Checks: map[string]Result{}})
+	})
+	mux.HandleFunc(constants.ReadyzPath, func(w http.ResponseWriter, req *http.Request) {
+		writeReport(w, r.Check(req.Context()))
+	})
+	return mux
+}
+
+// StatusCode 返回报告对应的 HTTP 状态码, 不可用时返回 503 让探测方摘除实例
+func (rep *Report) StatusCode() int {
+	if rep.Status == StatusUp {
+		return http.StatusOK
+	}
+	return http.StatusServiceUnavailable
+}
+
+func writeReport(w http.ResponseWriter, rep *Report) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(rep.StatusCode())
+	_ = json.NewEncoder(w).Encode(rep)
+}
+
+// Serve 在 HEALTH_ADDR 指定的地址上启动健康检查服务, 未设置时不启动
+// Kitex 服务没有 HTTP 端口, 需要单独的端口供 docker 的 HEALTHCHECK 访问
+func Serve() {
+	addr := os.Getenv(constants.HealthAddrEnv)
+	if addr == "" {
+		return
+	}
+	srv := &http.Server{
+		Addr:              addr,
+		Handler:           defaultRegistry.Handler(),
+		ReadHeaderTimeout: time.Second,
+	}
+	go func() {
+		if err := srv.ListenAndServe(); err != nil {
+			logger.Errorf("health: serve on %s failed: %v", addr, err)
+		}
+	}()
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"io"
+	"sync"
 
 	"github.com/samber/lo"
 	kafkago "github.com/segmentio/kafka-go"
@@ -33,6 +34,8 @@ type Kafka struct {
 	readers      []*kafkago.Reader
 	writers      map[string]*kafkago.Writer
 	consumeChans map[string]chan *Message
+	consumers    sync.WaitGroup
+	done         chan struct{}
 }
 
 // Message 属于domain层的通用Msg. DO NOT EDIT.
@@ -46,6 +49,7 @@ func NewKafkaInstance() *Kafka {
 		readers:      make([]*kafkago.Reader, 0),
 		writers:      make(map[string]*kafkago.Writer, 0),
 		consumeChans: make(map[string]chan *Message),
+		done:         make(chan struct{}),
 	}
 }
 
@@ -67,12 +71,14 @@ func (k *Kafka) Consume(ctx context.Context, topic string, consumerNum int, grou
 	for i := 0; i < consumerNum; i++ {
 		readers := client.GetNewReader(topic, groupID)
 		k.readers = append(k.readers, readers)
+		k.consumers.Add(1)
 		go k.consume(ctx, topic, readers)
 	}
 	return ch
 }
 
 func (k *Kafka) consume(ctx context.Context, topic string, r *kafkago.Reader) {
+	defer k.consumers.Done()
 	ch := k.consumeChans[topic]
 	for {
 		msg, err := r.ReadMessage(ctx)
@@ -84,7 +90,12 @@ func (k *Kafka) consume(ctx context.Context, topic string, r *kafkago.Reader) {
 			return
 		}
 
-		ch <- &Message{K: msg.Key, V: msg.Value}
+		// 关闭时 channel 可能已经满了并且没有人再读取, 不能一直阻塞
+		select {
+		case ch <- &Message{K: msg.Key, V: msg.Value}:
+		case <-k.done:
+			return
+		}
 	}
 }
 
@@ -135,13 +146,18 @@ func (k *Kafka) send(ctx context.Context, topic string, messages []*Message) []e
 	}
 }
 
+// Close 停止消费并刷新异步 writer 中缓冲的消息
+// 消费协程全部退出后才会关闭 consume channel, 读取 channel 的协程会在处理完缓冲的消息后退出
 func (k *Kafka) Close() {
+	close(k.done)
 	for _, reader := range k.readers {
 		if err := reader.Close(); err != nil {
 			logger.Errorf("close kafka reader failed, err: %v", err)
 		}
 	}
 
+	k.consumers.Wait()
+
 	for _, writer := range k.writers {
 		if err := writer.Close(); err != nil {
 			logger.Errorf("close kafka writer failed, err: %v", err)
@@ -0,0 +1,65 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/cloudwego/kitex/pkg/endpoint"
+	"github.com/cloudwego/kitex/pkg/utils/kitexutil"
+
+	"github.com/west2-online/DomTok/pkg/rbac"
+)
+
+type successSetter interface {
+	GetResult() interface{}
+	SetSuccess(x interface{})
+}
+
+// Permission 会根据 table 校验调用方是否拥有调用当前方法所需的权限
+// table 的 key 为 idl 中定义的方法名, value 为所需的权限, 不在 table 中的方法不做校验
+//
+// WARNING: 如果使用该中间件, 请务必注册于 Respond 之后
+//
+// Permission 流程如下:
+//  1. 从 rpcinfo 中获取当前调用的方法名, 查表得到所需的权限
+//  2. 从 metainfo 中取出网关透传的权限列表并进行比对, 管理类权限还要求会话通过了两步验证
+//  3. 没有权限时不会进入业务逻辑, 而是为 response 填充一个空的结果, 让 Respond 把错误写入 BaseResp
+func Permission(table map[string]string) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, req, resp interface{}) error {
+			method, _ := kitexutil.GetMethod(ctx)
+			permission, ok := table[method]
+			if !ok {
+				return next(ctx, req, resp)
+			}
+			err := rbac.CheckPermission(ctx, permission)
+			if err == nil {
+				return next(ctx, req, resp)
+			}
+
+			if setter, ok := resp.(successSetter); ok {
+				// GetResult 返回的是一个类型化的 nil 指针, 借助它构造出真正的 response
+				if typ := reflect.TypeOf(setter.GetResult()); typ != nil && typ.Kind() == reflect.Ptr {
+					setter.SetSuccess(reflect.New(typ.Elem()).Interface())
+				}
+			}
+			return err
+		}
+	}
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudwego/kitex/pkg/rpcinfo"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/west2-online/DomTok/kitex_gen/user"
+	metadata "github.com/west2-online/DomTok/pkg/base/context"
+	"github.com/west2-online/DomTok/pkg/constants"
+	"github.com/west2-online/DomTok/pkg/errno"
+)
+
+func permissionCtx(method string, mfa bool, permissions ...string) context.Context {
+	ri := rpcinfo.NewRPCInfo(nil, rpcinfo.NewEndpointInfo(constants.UserServiceName, method, nil, nil), nil, nil, nil)
+	ctx := rpcinfo.NewCtxWithRPCInfo(context.Background(), ri)
+	ctx = metadata.WithMFAData(ctx, mfa)
+	return metadata.WithPermissionData(ctx, permissions)
+}
+
+func TestPermission(t *testing.T) {
+	table := map[string]string{"BanUser": constants.PermissionUserBan}
+	mid := Respond()
+	called := false
+	next := func(ctx context.Context, req, resp interface{}) error {
+		called = true
+		resp.(*user.UserServiceBanUserResult).SetSuccess(&user.BanUserResp{})
+		return nil
+	}
+	endpoint := mid(Permission(table)(next))
+
+	Convey("Test the middleware permission", t, func() {
+		called = false
+
+		Convey("Test when method is not in the table", func() {
+			result := user.NewUserServiceBanUserResult()
+			err := endpoint(permissionCtx("Logout", false), nil, result)
+			So(err, ShouldBeNil)
+			So(called, ShouldBeTrue)
+		})
+
+		Convey("Test when caller has the permission", func() {
+			result := user.NewUserServiceBanUserResult()
+			err := endpoint(permissionCtx("BanUser", true, constants.PermissionRefundReview, constants.PermissionUserBan), nil, result)
+			So(err, ShouldBeNil)
+			So(called, ShouldBeTrue)
+			So(result.GetSuccess().GetBase().GetCode(), ShouldEqual, errno.SuccessCode)
+		})
+
+		Convey("Test when caller lacks the permission", func() {
+			result := user.NewUserServiceBanUserResult()
+			err := endpoint(permissionCtx("BanUser", true), nil, result)
+			So(err, ShouldBeNil)
+			So(called, ShouldBeFalse)
+			So(result.IsSetSuccess(), ShouldBeTrue)
+			So(result.GetSuccess().GetBase().GetCode(), ShouldEqual, errno.AuthNoOperatePermissionCode)
+		})
+
+		Convey("Test when caller has the permission but skipped two-factor authentication", func() {
+			result := user.NewUserServiceBanUserResult()
+			err := endpoint(permissionCtx("BanUser", false, constants.PermissionUserBan), nil, result)
+			So(err, ShouldBeNil)
+			So(called, ShouldBeFalse)
+			So(result.GetSuccess().GetBase().GetCode(), ShouldEqual, errno.AuthMFARequiredCode)
+		})
+	})
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package push 定义推送给用户的订单和退款状态事件
+// 事件由订单和支付服务通过 redis 的发布订阅发布, 每个网关实例都会收到全部事件, 再推送给连接在自己上面的用户
+// 发布订阅不保证送达, 客户端重新连接后应该主动查询一次订单状态
+package push
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/west2-online/DomTok/pkg/constants"
+)
+
+// Event 是推送给用户的一条状态变化, 网关会原样转发给客户端
+type Event struct {
+	Type      string `json:"type"`
+	Uid       int64  `json:"uid"`
+	OrderID   int64  `json:"order_id"`
+	Status    int8   `json:"status"` // order.status 时为订单状态, refund.result 时为退款状态
+	StatusMsg string `json:"status_msg"`
+	RefundID  int64  `json:"refund_id,omitempty"`
+	Reason    string `json:"reason,omitempty"` // 订单被取消的原因, 见 constants.PushCancelReason*
+	CreatedAt int64  `json:"created_at"`
+}
+
+// NewOrderStatusEvent 构造订单状态变化事件, reason 只在订单被取消时有意义
+func NewOrderStatusEvent(uid, orderID int64, status int8, reason string) *Event {
+	return &Event{
+		Type:      constants.PushEventOrderStatus,
+		Uid:       uid,
+		OrderID:   orderID,
+		Status:    status,
+		StatusMsg: constants.GetOrderStatusMsg(status),
+		Reason:    reason,
+		CreatedAt: time.Now().UnixMilli(),
+	}
+}
+
+// NewRefundResultEvent 构造退款审核结果事件
+func NewRefundResultEvent(uid, orderID, refundID int64, status int8) *Event {
+	return &Event{
+		Type:      constants.PushEventRefundResult,
+		Uid:       uid,
+		OrderID:   orderID,
+		Status:    status,
+		StatusMsg: constants.GetRefundStatus(status),
+		RefundID:  refundID,
+		CreatedAt: time.Now().UnixMilli(),
+	}
+}
+
+// Encode 将事件编码为发布订阅的消息内容
+func Encode(e *Event) (string, error) {
+	v, err := json.Marshal(e)
+	if err != nil {
+		return "", fmt.Errorf("push.Encode: marshal event failed: %w", err)
+	}
+	return string(v), nil
+}
+
+// Decode 解析网关收到的消息
+func Decode(v string) (*Event, error) {
+	e := new(Event)
+	if err := json.Unmarshal([]byte(v), e); err != nil {
+		return nil, fmt.Errorf("push.Decode: unmarshal event failed: %w", err)
+	}
+	return e, nil
+}
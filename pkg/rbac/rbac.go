@@ -0,0 +1,136 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rbac
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	metadata "github.com/west2-online/DomTok/pkg/base/context"
+	"github.com/west2-online/DomTok/pkg/constants"
+	"github.com/west2-online/DomTok/pkg/errno"
+)
+
+// rolePermissions 描述了每个角色拥有的权限, 超级管理员拥有所有权限, 不在这里列出
+var rolePermissions = map[int][]string{
+	constants.RoleCustomer: {},
+	constants.RoleMerchant: {
+		constants.PermissionCommodityManage,
+		constants.PermissionShopCreate,
+	},
+	constants.RoleOperator: {
+		constants.PermissionUserBan,
+		constants.PermissionCategoryManage,
+	},
+	constants.RoleFinance: {
+		constants.PermissionRefundReview,
+	},
+}
+
+// allPermissions 是超级管理员拥有的权限, 审计日志只有超级管理员可以查询
+var allPermissions = []string{
+	constants.PermissionUserBan,
+	constants.PermissionCommodityManage,
+	constants.PermissionCategoryManage,
+	constants.PermissionRefundReview,
+	constants.PermissionShopCreate,
+	constants.PermissionAuditView,
+}
+
+// mfaPermissions 是只有通过两步验证登录后才能使用的管理类权限
+var mfaPermissions = []string{
+	constants.PermissionUserBan,
+	constants.PermissionCategoryManage,
+	constants.PermissionRefundReview,
+	constants.PermissionAuditView,
+}
+
+// apiKeyScopePermissions 是创建 API Key 时可以选择的 scope 及其对应的权限, 网关按 key 持有的 scope 注入权限
+var apiKeyScopePermissions = map[string]string{
+	constants.ApiKeyScopeCommodity: constants.PermissionCommodityManage,
+	constants.ApiKeyScopeCoupon:    constants.PermissionCommodityManage,
+}
+
+// IsValidRole 判断 role 是否是一个已经定义的角色
+func IsValidRole(role int) bool {
+	if role == constants.RoleSuperAdmin {
+		return true
+	}
+	_, ok := rolePermissions[role]
+	return ok
+}
+
+// Permissions 返回角色拥有的所有权限, 未定义的角色没有任何权限
+func Permissions(role int) []string {
+	if role == constants.RoleSuperAdmin {
+		return slices.Clone(allPermissions)
+	}
+	return slices.Clone(rolePermissions[role])
+}
+
+// HasPermission 判断 context 中携带的权限是否包含 permission
+func HasPermission(ctx context.Context, permission string) bool {
+	permissions, err := metadata.GetPermissionData(ctx)
+	if err != nil {
+		return false
+	}
+	return slices.Contains(permissions, permission)
+}
+
+// RequiresMFA 判断 permission 是否需要通过两步验证才能使用
+func RequiresMFA(permission string) bool {
+	return slices.Contains(mfaPermissions, permission)
+}
+
+// CheckPermission 校验 context 中携带的权限是否包含 permission, 管理类权限还要求会话通过了两步验证
+func CheckPermission(ctx context.Context, permission string) error {
+	if !HasPermission(ctx, permission) {
+		return errno.AuthNoOperatePermission.WithMessage("permission denied: " + permission)
+	}
+	if RequiresMFA(permission) && !metadata.GetMFAData(ctx) {
+		return errno.AuthMFARequired.WithMessage("two-factor authentication required: " + permission)
+	}
+	return nil
+}
+
+// IsValidApiKeyScope 判断 scope 是否是一个已经定义的 API Key scope
+func IsValidApiKeyScope(scope string) bool {
+	_, ok := apiKeyScopePermissions[scope]
+	return ok
+}
+
+// ApiKeyPermissions 返回持有 scopes 的 API Key 拥有的权限, 未定义的 scope 会被忽略
+func ApiKeyPermissions(scopes []string) []string {
+	perms := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		if perm, ok := apiKeyScopePermissions[scope]; ok && !slices.Contains(perms, perm) {
+			perms = append(perms, perm)
+		}
+	}
+	return perms
+}
+
+// ApiKeyScope 返回使用 API Key 访问 path 所需的 scope, 不允许使用 API Key 访问的路由返回 false
+func ApiKeyScope(path string) (string, bool) {
+	for _, route := range apiKeyRouteScopes {
+		if strings.HasPrefix(path, route.prefix) {
+			return route.scope, true
+		}
+	}
+	return "", false
+}
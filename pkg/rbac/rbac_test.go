@@ -0,0 +1,103 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rbac
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	metadata "github.com/west2-online/DomTok/pkg/base/context"
+	"github.com/west2-online/DomTok/pkg/constants"
+	"github.com/west2-online/DomTok/pkg/errno"
+)
+
+func TestPermissions(t *testing.T) {
+	Convey("TestPermissions", t, func() {
+		So(Permissions(constants.RoleCustomer), ShouldBeEmpty)
+		So(Permissions(constants.RoleFinance), ShouldResemble, []string{constants.PermissionRefundReview})
+		So(Permissions(constants.RoleSuperAdmin), ShouldContain, constants.PermissionUserBan)
+		So(Permissions(constants.RoleSuperAdmin), ShouldContain, constants.PermissionRefundReview)
+		So(Permissions(constants.RoleSuperAdmin), ShouldContain, constants.PermissionAuditView)
+		So(Permissions(constants.RoleOperator), ShouldNotContain, constants.PermissionAuditView)
+		So(Permissions(-1), ShouldBeEmpty)
+
+		// 返回的切片被修改不应该影响到角色表
+		perms := Permissions(constants.RoleOperator)
+		perms[0] = "modified"
+		So(Permissions(constants.RoleOperator), ShouldNotContain, "modified")
+	})
+}
+
+func TestIsValidRole(t *testing.T) {
+	Convey("TestIsValidRole", t, func() {
+		So(IsValidRole(constants.RoleCustomer), ShouldBeTrue)
+		So(IsValidRole(constants.RoleSuperAdmin), ShouldBeTrue)
+		So(IsValidRole(constants.RoleMerchant), ShouldBeTrue)
+		So(IsValidRole(100), ShouldBeFalse)
+	})
+}
+
+func TestHasPermission(t *testing.T) {
+	Convey("TestHasPermission", t, func() {
+		So(HasPermission(context.Background(), constants.PermissionUserBan), ShouldBeFalse)
+
+		ctx := metadata.WithPermissionData(context.Background(), Permissions(constants.RoleOperator))
+		So(HasPermission(ctx, constants.PermissionUserBan), ShouldBeTrue)
+		So(HasPermission(ctx, constants.PermissionRefundReview), ShouldBeFalse)
+
+		ctx = metadata.WithPermissionData(context.Background(), nil)
+		So(HasPermission(ctx, constants.PermissionUserBan), ShouldBeFalse)
+	})
+}
+
+func TestCheckPermission(t *testing.T) {
+	Convey("TestCheckPermission", t, func() {
+		ctx := metadata.WithPermissionData(context.Background(), Permissions(constants.RoleSuperAdmin))
+		So(CheckPermission(ctx, constants.PermissionShopCreate), ShouldBeNil)
+		So(errno.ConvertErr(CheckPermission(ctx, constants.PermissionUserBan)).ErrorCode, ShouldEqual, errno.AuthMFARequiredCode)
+
+		ctx = metadata.WithMFAData(ctx, true)
+		So(CheckPermission(ctx, constants.PermissionUserBan), ShouldBeNil)
+
+		ctx = metadata.WithPermissionData(metadata.WithMFAData(context.Background(), true), Permissions(constants.RoleCustomer))
+		So(errno.ConvertErr(CheckPermission(ctx, constants.PermissionUserBan)).ErrorCode, ShouldEqual, errno.AuthNoOperatePermissionCode)
+	})
+}
+
+func TestApiKeyScope(t *testing.T) {
+	Convey("TestApiKeyScope", t, func() {
+		scope, ok := ApiKeyScope("/api/v1/commodity/spu/create")
+		So(ok, ShouldBeTrue)
+		So(scope, ShouldEqual, constants.ApiKeyScopeCommodity)
+
+		scope, ok = ApiKeyScope("/api/v1/commodity/coupon/create")
+		So(ok, ShouldBeTrue)
+		So(scope, ShouldEqual, constants.ApiKeyScopeCoupon)
+
+		_, ok = ApiKeyScope("/api/v1/user/apikey")
+		So(ok, ShouldBeFalse)
+
+		So(IsValidApiKeyScope(constants.ApiKeyScopeCoupon), ShouldBeTrue)
+		So(IsValidApiKeyScope("order"), ShouldBeFalse)
+
+		So(ApiKeyPermissions([]string{constants.ApiKeyScopeCommodity, constants.ApiKeyScopeCoupon}),
+			ShouldResemble, []string{constants.PermissionCommodityManage})
+		So(ApiKeyPermissions([]string{"order"}), ShouldBeEmpty)
+	})
+}
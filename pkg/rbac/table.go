@@ -0,0 +1,81 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rbac
+
+import (
+	"github.com/west2-online/DomTok/pkg/constants"
+)
+
+// 下面的表描述了调用各个接口所需的权限, 不在表中的接口只要求登录即可
+// RPC 的 key 为 idl 中定义的方法名, 由 middleware.Permission 统一校验
+// HTTP 的 key 为 "METHOD 路由", 由网关的 mw.Permission 统一校验
+
+var UserServicePermissions = map[string]string{
+	"BanUser":        constants.PermissionUserBan,
+	"LiftBandUser":   constants.PermissionUserBan,
+	"UnlockUser":     constants.PermissionUserBan,
+	"ListBanHistory": constants.PermissionUserBan,
+	"CreateShop":     constants.PermissionShopCreate,
+	"CreateApiKey":   constants.PermissionCommodityManage,
+	"ListAuditLogs":  constants.PermissionAuditView,
+}
+
+var PaymentServicePermissions = map[string]string{
+	"RefundReview": constants.PermissionRefundReview,
+}
+
+var CommodityServicePermissions = map[string]string{
+	"CreateCategory": constants.PermissionCategoryManage,
+	"UpdateCategory": constants.PermissionCategoryManage,
+	"DeleteCategory": constants.PermissionCategoryManage,
+	"CreateSpu":      constants.PermissionCommodityManage,
+	"UpdateSpu":      constants.PermissionCommodityManage,
+	"DeleteSpu":      constants.PermissionCommodityManage,
+	"CreateSpuImage": constants.PermissionCommodityManage,
+	"UpdateSpuImage": constants.PermissionCommodityManage,
+	"DeleteSpuImage": constants.PermissionCommodityManage,
+	"CreateSku":      constants.PermissionCommodityManage,
+	"UpdateSku":      constants.PermissionCommodityManage,
+	"DeleteSku":      constants.PermissionCommodityManage,
+	"CreateSkuImage": constants.PermissionCommodityManage,
+	"UpdateSkuImage": constants.PermissionCommodityManage,
+	"DeleteSkuImage": constants.PermissionCommodityManage,
+	"UploadSkuAttr":  constants.PermissionCommodityManage,
+	"CreateCoupon":   constants.PermissionCommodityManage,
+	"DeleteCoupon":   constants.PermissionCommodityManage,
+}
+
+var GatewayRoutePermissions = map[string]string{
+	"POST /api/v1/user/ban":           constants.PermissionUserBan,
+	"POST /api/v1/user/lift":          constants.PermissionUserBan,
+	"POST /api/v1/user/unlock":        constants.PermissionUserBan,
+	"GET /api/v1/user/ban/history":    constants.PermissionUserBan,
+	"POST /api/payment/refund/review": constants.PermissionRefundReview,
+	"POST /api/v1/user/shop":          constants.PermissionShopCreate,
+	"POST /api/v1/user/apikey":        constants.PermissionCommodityManage,
+	"GET /api/v1/user/audit/list":     constants.PermissionAuditView,
+}
+
+// apiKeyRouteScopes 描述了可以使用 API Key 访问的路由前缀及所需的 scope, 由网关的 mw.ApiKeyAuth 统一校验
+// 匹配时取第一个满足的前缀, 更具体的前缀需要排在前面
+var apiKeyRouteScopes = []struct {
+	prefix string
+	scope  string
+}{
+	{"/api/v1/commodity/coupon/", constants.ApiKeyScopeCoupon},
+	{"/api/v1/commodity/", constants.ApiKeyScopeCommodity},
+}
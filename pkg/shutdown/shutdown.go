@@ -0,0 +1,99 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package shutdown 负责服务退出时的资源清理
+// 服务先停止接收请求并等待处理中的请求结束(Kitex 的 Stop 或 Hertz 的 Shutdown), 再调用 Run 执行注册的清理函数
+package shutdown
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+
+	"github.com/west2-online/DomTok/pkg/logger"
+)
+
+type hook struct {
+	name string
+	fn   func() error
+}
+
+var (
+	hooks []hook
+	mu    sync.Mutex
+	once  sync.Once
+)
+
+// Register 注册退出时执行的清理函数, 清理函数按注册顺序的逆序执行
+// 依赖注入时总是先创建连接池, 再创建依赖连接池的消费者和生产者, 逆序执行正好先停止消费, 刷新生产者的缓冲, 最后关闭连接池
+func Register(name string, fn func() error) {
+	mu.Lock()
+	defer mu.Unlock()
+	hooks = append(hooks, hook{name: name, fn: fn})
+}
+
+// Run 逆序执行所有清理函数, 只会执行一次
+// 所有清理函数共用 ctx 的超时时间, 超时后不再等待当前的清理函数, 剩余的也不再执行
+func Run(ctx context.Context) {
+	once.Do(func() {
+		mu.Lock()
+		defer mu.Unlock()
+		runHooks(ctx, hooks)
+	})
+}
+
+func runHooks(ctx context.Context, hs []hook) {
+	for i := len(hs) - 1; i >= 0; i-- {
+		if err := run(ctx, hs[i]); err != nil {
+			logger.Errorf("shutdown: %s failed: %v", hs[i].name, err)
+		}
+		if ctx.Err() != nil {
+			logger.Errorf("shutdown: timeout, %d hooks are skipped", i)
+			return
+		}
+	}
+}
+
+func run(ctx context.Context, h hook) error {
+	done := make(chan error, 1)
+	go func() {
+		done <- h.fn()
+	}()
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// WaitSignal 用于 Hertz 的 SetCustomSignalWaiter
+// Hertz 默认在收到 SIGTERM 时立即关闭, 而 docker 和 k8s 停止容器时发送的正是 SIGTERM, 这里改为优雅退出
+func WaitSignal(errCh chan error) error {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM, syscall.SIGHUP)
+	defer signal.Stop(signals)
+
+	select {
+	case sig := <-signals:
+		logger.Infof("shutdown: received signal %s", sig)
+		return nil
+	case err := <-errCh:
+		return err
+	}
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shutdown
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestRunHooks(t *testing.T) {
+	Convey("TestRunHooks", t, func() {
+		Convey("hooks run in reverse order", func() {
+			var order []string
+			hs := []hook{
+				{name: "mysql", fn: func() error { order = append(order, "mysql"); return nil }},
+				{name: "redis", fn: func() error { order = append(order, "redis"); return errors.New("closed") }},
+				{name: "kafka", fn: func() error { order = append(order, "kafka"); return nil }},
+			}
+			runHooks(context.Background(), hs)
+			// 单个清理函数失败不影响后面的清理函数
+			So(order, ShouldResemble, []string{"kafka", "redis", "mysql"})
+		})
+
+		Convey("remaining hooks are skipped after timeout", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+			called := false
+			block := make(chan struct{})
+			defer close(block)
+			hs := []hook{
+				{name: "mysql", fn: func() error { called = true; return nil }},
+				{name: "kafka", fn: func() error { <-block; return nil }},
+			}
+			start := time.Now()
+			runHooks(ctx, hs)
+			So(time.Since(start), ShouldBeLessThan, time.Second)
+			So(called, ShouldBeFalse)
+		})
+	})
+}
@@ -23,56 +23,72 @@ import (
 
 	"github.com/golang-jwt/jwt"
 
-	"github.com/west2-online/DomTok/config"
 	"github.com/west2-online/DomTok/pkg/constants"
 	"github.com/west2-online/DomTok/pkg/errno"
+	"github.com/west2-online/DomTok/pkg/rbac"
 )
 
 type Claims struct {
-	Type   int64 `json:"type"`
-	UserID int64 `json:"user_id"`
+	Type        int64    `json:"type"`
+	UserID      int64    `json:"user_id"`
+	SessionID   string   `json:"sid,omitempty"`   // token 所属的登录会话
+	Role        int      `json:"role,omitempty"`  // 签发时用户的角色
+	Permissions []string `json:"perms,omitempty"` // 签发时用户角色拥有的权限, 仅 access token 携带
+	MFA         bool     `json:"mfa,omitempty"`   // 所属会话是否通过了两步验证
 	jwt.StandardClaims
 }
 
-// CreateAllToken 创建一对 token，第一个是 access token，第二个是 refresh token
-func CreateAllToken(uid int64) (string, string, error) {
-	accessToken, err := CreateToken(constants.TypeAccessToken, uid)
-	if err != nil {
-		return "", "", fmt.Errorf("failed to create access token: %w", err)
-	}
-
-	refreshToken, err := CreateToken(constants.TypeRefreshToken, uid)
-	if err != nil {
-		return "", "", fmt.Errorf("failed to create refresh token: %w", err)
-	}
-
-	return accessToken, refreshToken, nil
-}
-
 // CreateToken 根据 token 类型和用户 ID 创建 token
 func CreateToken(tokenType int64, uid int64) (string, error) {
-	if config.Server == nil {
-		return "", errno.NewErrNo(errno.AuthInvalidCode, "server config not found")
-	}
-
-	expireTime := time.Now().Add(getTokenTTL(tokenType))
-	claims := Claims{
+	return signToken(Claims{
 		Type:   tokenType,
 		UserID: uid,
+	})
+}
+
+// CreateAccessToken 创建一个属于 sessionID 会话的 access token, 角色对应的权限会一并写入 token
+// mfa 表示会话是否通过了两步验证, 管理类的权限只有在通过两步验证后才能使用
+func CreateAccessToken(uid int64, role int, sessionID string, mfa bool) (string, error) {
+	return signToken(Claims{
+		Type:        constants.TypeAccessToken,
+		UserID:      uid,
+		SessionID:   sessionID,
+		Role:        role,
+		Permissions: rbac.Permissions(role),
+		MFA:         mfa,
+	})
+}
+
+// CreateRefreshToken 创建一个属于 sessionID 会话的 refresh token, tokenID 会写入 jti 用于轮换时识别旧 token
+// mfa 会原样带入刷新后的 access token, 刷新不需要重新进行两步验证
+func CreateRefreshToken(uid int64, sessionID string, tokenID string, mfa bool) (string, error) {
+	return signToken(Claims{
+		Type:      constants.TypeRefreshToken,
+		UserID:    uid,
+		SessionID: sessionID,
+		MFA:       mfa,
 		StandardClaims: jwt.StandardClaims{
-			ExpiresAt: expireTime.Unix(),
-			IssuedAt:  time.Now().Unix(),
-			Issuer:    constants.Issuer,
+			Id: tokenID,
 		},
-	}
+	})
+}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims)
-	key, err := parsePrivateKey(config.Server.Secret)
+// signToken 补全 claims 中的签发信息并使用当前的签名密钥签名, 密钥的 kid 写入 token 头部
+func signToken(claims Claims) (string, error) {
+	keys, err := getJWTKeys()
 	if err != nil {
-		return "", fmt.Errorf("failed to parse private key: %w", err)
+		return "", fmt.Errorf("failed to load signing key: %w", err)
 	}
 
-	signedToken, err := token.SignedString(key)
+	now := time.Now()
+	claims.ExpiresAt = now.Add(getTokenTTL(claims.Type)).Unix()
+	claims.IssuedAt = now.Unix()
+	claims.Issuer = constants.Issuer
+
+	token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims)
+	token.Header["kid"] = keys.signing.id
+
+	signedToken, err := token.SignedString(keys.signing.private)
 	if err != nil {
 		return "", fmt.Errorf("failed to sign token: %w", err)
 	}
@@ -82,9 +98,6 @@ func CreateToken(tokenType int64, uid int64) (string, error) {
 
 // CheckToken 检查 token 是否有效，并返回 token 类型和用户 ID
 func CheckToken(token string) (int64, int64, error) {
-	if config.Server == nil {
-		return 0, 0, errno.NewErrNo(errno.AuthInvalidCode, "server config not found")
-	}
 	if token == "" {
 		return -1, 0, errno.NewErrNo(errno.AuthMissingTokenCode, "token is empty")
 	}
@@ -94,12 +107,12 @@ func CheckToken(token string) (int64, int64, error) {
 		return -1, 0, fmt.Errorf("failed to parse unverified claims: %w", err)
 	}
 
-	secret, err := parsePublicKey(config.Server.PublicKey)
+	keys, err := getJWTKeys()
 	if err != nil {
-		return -1, 0, fmt.Errorf("failed to parse public key: %w", err)
+		return -1, 0, fmt.Errorf("failed to load public keys: %w", err)
 	}
 
-	verifiedClaims, err := verifyToken(token, secret)
+	verifiedClaims, err := verifyToken(token, keys)
 	if err != nil {
 		tokenType, err := handleTokenError(err, unverifiedClaims.Type)
 		return tokenType, 0, err
@@ -108,6 +121,54 @@ func CheckToken(token string) (int64, int64, error) {
 	return verifiedClaims.Type, verifiedClaims.UserID, nil
 }
 
+// ParseToken 校验 token 并返回完整的 claims
+func ParseToken(token string) (*Claims, error) {
+	if token == "" {
+		return nil, errno.NewErrNo(errno.AuthMissingTokenCode, "token is empty")
+	}
+
+	unverifiedClaims, err := parseUnverifiedClaims(token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse unverified claims: %w", err)
+	}
+
+	keys, err := getJWTKeys()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load public keys: %w", err)
+	}
+
+	claims, err := verifyToken(token, keys)
+	if err != nil {
+		_, err = handleTokenError(err, unverifiedClaims.Type)
+		return nil, err
+	}
+	return claims, nil
+}
+
+// ParseAccessToken 校验 access token 并返回完整的 claims, 不属于任何会话的 token 会被拒绝
+func ParseAccessToken(token string) (*Claims, error) {
+	claims, err := ParseToken(token)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Type != constants.TypeAccessToken || claims.SessionID == "" {
+		return nil, errno.NewErrNo(errno.AuthInvalidCode, "not an access token")
+	}
+	return claims, nil
+}
+
+// ParseRefreshToken 校验 refresh token 并返回完整的 claims, 非 refresh token 类型的 token 会被拒绝
+func ParseRefreshToken(token string) (*Claims, error) {
+	claims, err := ParseToken(token)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Type != constants.TypeRefreshToken || claims.SessionID == "" || claims.Id == "" {
+		return nil, errno.NewErrNo(errno.AuthInvalidCode, "not a refresh token")
+	}
+	return claims, nil
+}
+
 // parsePrivateKey 解析 Ed25519 私钥
 func parsePrivateKey(key string) (interface{}, error) {
 	privateKey, err := jwt.ParseEdPrivateKeyFromPEM([]byte(key))
@@ -140,14 +201,9 @@ func parseUnverifiedClaims(token string) (*Claims, error) {
 	return claims, nil
 }
 
-// verifyToken 验证 token 并返回 claims
-func verifyToken(token string, key interface{}) (*Claims, error) {
-	parsedToken, err := jwt.ParseWithClaims(token, &Claims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodEd25519); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-		return key, nil
-	})
+// verifyToken 使用 token 头部 kid 对应的公钥验证 token 并返回 claims
+func verifyToken(token string, keys *jwtKeySet) (*Claims, error) {
+	parsedToken, err := jwt.ParseWithClaims(token, &Claims{}, keys.lookup)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse token: %w", err)
 	}
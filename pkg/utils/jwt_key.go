@@ -0,0 +1,211 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/golang-jwt/jwt"
+
+	"github.com/west2-online/DomTok/config"
+	"github.com/west2-online/DomTok/pkg/constants"
+	"github.com/west2-online/DomTok/pkg/errno"
+	"github.com/west2-online/DomTok/pkg/logger"
+)
+
+// jwtKey 是解析后的签名密钥, 退役的密钥没有私钥, 只能用于校验
+type jwtKey struct {
+	id      string
+	private ed25519.PrivateKey
+	public  ed25519.PublicKey
+}
+
+type jwtKeySet struct {
+	signing *jwtKey
+	keys    map[string]*jwtKey
+}
+
+// JWK 是 RFC 8037 中 Ed25519 公钥的 JWK 表示
+type JWK struct {
+	Kty string `json:"kty"`
+	Crv string `json:"crv"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	X   string `json:"x"`
+}
+
+var (
+	jwtKeysMu sync.RWMutex
+	jwtKeys   *jwtKeySet
+)
+
+func init() {
+	config.OnReload(reloadJWTKeys)
+}
+
+// reloadJWTKeys 在配置变更(如密钥轮换)后按新配置重新解析密钥, 解析失败时继续使用上一组可用的密钥
+func reloadJWTKeys() {
+	ks, err := loadJWTKeySet()
+	if err != nil {
+		logger.Errorf("utils: reload jwt keys failed, keep the previous keys: %v", err)
+		return
+	}
+	jwtKeysMu.Lock()
+	defer jwtKeysMu.Unlock()
+	jwtKeys = ks
+}
+
+// JWKS 返回所有可以用于校验 token 的公钥
+func JWKS() ([]JWK, error) {
+	ks, err := getJWTKeys()
+	if err != nil {
+		return nil, err
+	}
+	jwks := make([]JWK, 0, len(ks.keys))
+	for _, k := range ks.keys {
+		jwks = append(jwks, JWK{
+			Kty: "OKP",
+			Crv: "Ed25519",
+			Kid: k.id,
+			Use: "sig",
+			Alg: jwt.SigningMethodEdDSA.Alg(),
+			X:   base64.RawURLEncoding.EncodeToString(k.public),
+		})
+	}
+	slices.SortFunc(jwks, func(a, b JWK) int {
+		return strings.Compare(a.Kid, b.Kid)
+	})
+	return jwks, nil
+}
+
+// getJWTKeys 返回当前配置对应的密钥, 首次使用时解析并缓存
+func getJWTKeys() (*jwtKeySet, error) {
+	jwtKeysMu.RLock()
+	ks := jwtKeys
+	jwtKeysMu.RUnlock()
+	if ks != nil {
+		return ks, nil
+	}
+
+	jwtKeysMu.Lock()
+	defer jwtKeysMu.Unlock()
+	if jwtKeys != nil {
+		return jwtKeys, nil
+	}
+	ks, err := loadJWTKeySet()
+	if err != nil {
+		return nil, err
+	}
+	jwtKeys = ks
+	return ks, nil
+}
+
+// loadJWTKeySet 按当前配置解析所有密钥
+func loadJWTKeySet() (*jwtKeySet, error) {
+	srv := config.GetServer()
+	if srv == nil {
+		return nil, errno.NewErrNo(errno.AuthInvalidCode, "server config not found")
+	}
+	return buildJWTKeySet(srv.Secret, srv.PublicKey, srv.SigningKeyID, srv.JWTKeys)
+}
+
+func buildJWTKeySet(secret, publicKey, signingKeyID string, keys []config.JWTKey) (*jwtKeySet, error) {
+	ks := &jwtKeySet{keys: make(map[string]*jwtKey)}
+	if secret != "" || publicKey != "" {
+		k, err := parseJWTKey(constants.JWTLegacyKeyID, secret, publicKey)
+		if err != nil {
+			return nil, err
+		}
+		ks.keys[k.id] = k
+	}
+	for _, c := range keys {
+		if _, ok := ks.keys[c.ID]; ok || c.ID == "" {
+			return nil, fmt.Errorf("jwt key %q: empty or duplicated kid", c.ID)
+		}
+		k, err := parseJWTKey(c.ID, c.PrivateKey, c.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+		ks.keys[k.id] = k
+	}
+
+	if signingKeyID == "" {
+		signingKeyID = constants.JWTLegacyKeyID
+	}
+	ks.signing = ks.keys[signingKeyID]
+	if ks.signing == nil || ks.signing.private == nil {
+		return nil, fmt.Errorf("jwt signing key %q: private key not found", signingKeyID)
+	}
+	return ks, nil
+}
+
+// parseJWTKey 解析一对 Ed25519 密钥, 没有配置公钥时从私钥中导出
+func parseJWTKey(id, privatePEM, publicPEM string) (*jwtKey, error) {
+	k := &jwtKey{id: id}
+	if privatePEM != "" {
+		key, err := parsePrivateKey(privatePEM)
+		if err != nil {
+			return nil, fmt.Errorf("jwt key %q: %w", id, err)
+		}
+		private, ok := key.(ed25519.PrivateKey)
+		if !ok {
+			return nil, fmt.Errorf("jwt key %q: not an ed25519 private key", id)
+		}
+		k.private = private
+		k.public = private.Public().(ed25519.PublicKey) //nolint:forcetypeassert
+	}
+	if publicPEM != "" {
+		key, err := parsePublicKey(publicPEM)
+		if err != nil {
+			return nil, fmt.Errorf("jwt key %q: %w", id, err)
+		}
+		public, ok := key.(ed25519.PublicKey)
+		if !ok {
+			return nil, fmt.Errorf("jwt key %q: not an ed25519 public key", id)
+		}
+		if k.public != nil && !k.public.Equal(public) {
+			return nil, fmt.Errorf("jwt key %q: public key does not match private key", id)
+		}
+		k.public = public
+	}
+	if k.public == nil {
+		return nil, fmt.Errorf("jwt key %q: no key configured", id)
+	}
+	return k, nil
+}
+
+// lookup 根据 token 头部的 kid 查找校验用的公钥, 没有 kid 的 token 是轮换功能上线前签发的, 使用旧的单密钥校验
+func (ks *jwtKeySet) lookup(token *jwt.Token) (interface{}, error) {
+	if _, ok := token.Method.(*jwt.SigningMethodEd25519); !ok {
+		return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+	}
+	kid, _ := token.Header["kid"].(string)
+	if kid == "" {
+		kid = constants.JWTLegacyKeyID
+	}
+	k, ok := ks.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown jwt key: %q", kid)
+	}
+	return k.public, nil
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/golang-jwt/jwt"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/west2-online/DomTok/config"
+	"github.com/west2-online/DomTok/pkg/constants"
+)
+
+// newTestJWTKey 生成一对 PEM 格式的 Ed25519 密钥
+func newTestJWTKey() (privatePEM string, publicPEM string) {
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	So(err, ShouldBeNil)
+	privateDER, err := x509.MarshalPKCS8PrivateKey(private)
+	So(err, ShouldBeNil)
+	publicDER, err := x509.MarshalPKIXPublicKey(public)
+	So(err, ShouldBeNil)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateDER})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER}))
+}
+
+// signTestToken 使用 key 签发 token, kid 为空时不写入头部, 模拟轮换功能上线前签发的 token
+func signTestToken(key *jwtKey, kid string) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, Claims{Type: constants.TypeAccessToken, UserID: 1})
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	signed, err := token.SignedString(key.private)
+	So(err, ShouldBeNil)
+	return signed
+}
+
+func TestJWTKeySet_Verify(t *testing.T) {
+	Convey("TestJWTKeySet_Verify", t, func() {
+		legacyPrivate, _ := newTestJWTKey()
+		currentPrivate, currentPublic := newTestJWTKey()
+		ks, err := buildJWTKeySet(legacyPrivate, "", "current", []config.JWTKey{
+			{ID: "current", PrivateKey: currentPrivate, PublicKey: currentPublic},
+		})
+		So(err, ShouldBeNil)
+		So(ks.signing.id, ShouldEqual, "current")
+
+		legacy := ks.keys[constants.JWTLegacyKeyID]
+		current := ks.keys["current"]
+		testCases := []struct {
+			name  string
+			token string
+			valid bool
+		}{
+			{"token signed by the current key", signTestToken(current, "current"), true},
+			{"token without kid falls back to the legacy key", signTestToken(legacy, ""), true},
+			{"token with the legacy kid", signTestToken(legacy, constants.JWTLegacyKeyID), true},
+			{"unknown kid is rejected", signTestToken(current, "unknown"), false},
+			{"token without kid signed by another key is rejected", signTestToken(current, ""), false},
+			{"kid pointing to another key is rejected", signTestToken(legacy, "current"), false},
+		}
+		for _, tc := range testCases {
+			Convey(tc.name, func() {
+				claims, err := verifyToken(tc.token, ks)
+				if tc.valid {
+					So(err, ShouldBeNil)
+					So(claims.UserID, ShouldEqual, 1)
+				} else {
+					So(err, ShouldNotBeNil)
+				}
+			})
+		}
+	})
+}
+
+func TestBuildJWTKeySet(t *testing.T) {
+	Convey("TestBuildJWTKeySet", t, func() {
+		private, public := newTestJWTKey()
+		_, otherPublic := newTestJWTKey()
+		testCases := []struct {
+			name         string
+			secret       string
+			signingKeyID string
+			keys         []config.JWTKey
+			valid        bool
+		}{
+			{"legacy single key", private, "", nil, true},
+			{"retired key without private key", private, "", []config.JWTKey{{ID: "old", PublicKey: public}}, true},
+			{"signing key without private key", "", "old", []config.JWTKey{{ID: "old", PublicKey: public}}, false},
+			{"unknown signing key", private, "missing", nil, false},
+			{"duplicated kid", "", "k1", []config.JWTKey{{ID: "k1", PrivateKey: private}, {ID: "k1", PublicKey: public}}, false},
+			{"empty kid", private, "", []config.JWTKey{{PublicKey: public}}, false},
+			{"mismatched key pair", "", "k1", []config.JWTKey{{ID: "k1", PrivateKey: private, PublicKey: otherPublic}}, false},
+		}
+		for _, tc := range testCases {
+			Convey(tc.name, func() {
+				_, err := buildJWTKeySet(tc.secret, "", tc.signingKeyID, tc.keys)
+				if tc.valid {
+					So(err, ShouldBeNil)
+				} else {
+					So(err, ShouldNotBeNil)
+				}
+			})
+		}
+	})
+}
+
+func TestReloadJWTKeys(t *testing.T) {
+	mockey.PatchConvey("TestReloadJWTKeys", t, func() {
+		private, _ := newTestJWTKey()
+		previous, err := buildJWTKeySet(private, "", "", nil)
+		So(err, ShouldBeNil)
+		jwtKeysMu.Lock()
+		saved := jwtKeys
+		jwtKeys = previous
+		jwtKeysMu.Unlock()
+		defer func() {
+			jwtKeysMu.Lock()
+			jwtKeys = saved
+			jwtKeysMu.Unlock()
+		}()
+
+		Convey("failed reload keeps the previous keys", func() {
+			mockey.Mock(loadJWTKeySet).Return(nil, errors.New("invalid private key")).Build()
+			reloadJWTKeys()
+			ks, err := getJWTKeys()
+			So(err, ShouldBeNil)
+			So(ks, ShouldEqual, previous)
+		})
+		Convey("successful reload replaces the keys", func() {
+			next, err := buildJWTKeySet(private, "", "", nil)
+			So(err, ShouldBeNil)
+			mockey.Mock(loadJWTKeySet).Return(next, nil).Build()
+			reloadJWTKeys()
+			ks, err := getJWTKeys()
+			So(err, ShouldBeNil)
+			So(ks, ShouldEqual, next)
+		})
+	})
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"strings"
+	"syscall"
+)
+
+var errForbiddenAddress = errors.New("webhook address is not a public address")
+
+// forbiddenPrefixes 是 IsGlobalUnicast 和 IsPrivate 没有覆盖到的非公网地址段
+var forbiddenPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),     // 本网络, linux 上会被当作本机
+	netip.MustParsePrefix("100.64.0.0/10"), // 运营商级 NAT
+	netip.MustParsePrefix("198.18.0.0/15"), // 基准测试
+}
+
+// IsPublicAddr 判断 webhook 是否可以投递到该地址
+// 回环, 私有, 链路本地(包括云厂商的元数据服务 169.254.169.254)等地址都不允许, 避免商家借 webhook 访问内网服务
+func IsPublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() {
+		return false
+	}
+	for _, p := range forbiddenPrefixes {
+		if p.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// CheckHost 在注册端点时检查 URL 的主机名, 直接使用 IP 时必须是公网地址
+// 域名解析的结果可能会变化, 投递时还会通过 DialControl 检查实际连接的地址
+func CheckHost(host string) error {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return errForbiddenAddress
+	}
+	if addr, err := netip.ParseAddr(host); err == nil && !IsPublicAddr(addr) {
+		return errForbiddenAddress
+	}
+	return nil
+}
+
+// DialControl 用于投递时的 net.Dialer.Control, 在建立连接前检查域名解析后的地址, 避免 DNS 重绑定绕过注册时的检查
+func DialControl(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return err
+	}
+	if !IsPublicAddr(addr) {
+		return fmt.Errorf("%w: %s", errForbiddenAddress, addr)
+	}
+	return nil
+}
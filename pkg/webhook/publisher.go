@@ -0,0 +1,47 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+
+	"github.com/west2-online/DomTok/pkg/logger"
+)
+
+// Publisher 负责发布 webhook 事件, 由各服务的 infrastructure 实现
+type Publisher interface {
+	Publish(ctx context.Context, e *Event) error
+}
+
+// PublisherFunc 使普通函数可以作为 Publisher 使用, 如各服务 mq adapter 的 SendWebhookEvent
+type PublisherFunc func(ctx context.Context, e *Event) error
+
+func (f PublisherFunc) Publish(ctx context.Context, e *Event) error {
+	return f(ctx, e)
+}
+
+// Emit 构造并发布 webhook 事件, 发布失败不影响已经完成的操作, 只记录错误日志
+func Emit(ctx context.Context, p Publisher, eventType string, shopID int64, build func(eventID string, createdAt int64) any) {
+	e, err := NewEvent(eventType, shopID, build)
+	if err != nil {
+		logger.Errorf("webhook.Emit: %s shop %d: %v", eventType, shopID, err)
+		return
+	}
+	if err = p.Publish(ctx, e); err != nil {
+		logger.Errorf("webhook.Emit: %s shop %d: %v", eventType, shopID, err)
+	}
+}
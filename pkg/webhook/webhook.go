@@ -0,0 +1,130 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package webhook 为各服务提供商家 webhook 事件的构造, 解析和签名,
+// 事件由订单和支付服务通过自己的 mq adapter 发布到 kafka, 由用户服务按商家的订阅统一投递
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/west2-online/DomTok/pkg/constants"
+)
+
+// Event 是一条需要投递给某个店铺的 webhook 事件, Data 即投递时的请求体
+type Event struct {
+	EventID   string `json:"event_id"` // 同一事件重试或手动重新投递时保持不变, 商家可以据此去重
+	Type      string `json:"type"`
+	ShopID    int64  `json:"shop_id"`
+	Data      string `json:"data"` // JSON 格式, 见 OrderData 和 RefundData
+	CreatedAt int64  `json:"created_at"`
+}
+
+// OrderGoods 是订单中属于当前店铺的一件商品
+type OrderGoods struct {
+	GoodsID          int64   `json:"goods_id"`
+	StyleID          int64   `json:"style_id"`
+	PurchaseQuantity int64   `json:"purchase_quantity"`
+	PaymentAmount    float64 `json:"payment_amount"`
+}
+
+// OrderData 是 order.paid 和 order.canceled 事件的内容, 只包含属于当前店铺的商品
+type OrderData struct {
+	EventID      string        `json:"event_id"`
+	Type         string        `json:"type"`
+	OrderID      int64         `json:"order_id"`
+	ShopID       int64         `json:"shop_id"`
+	PaymentAt    int64         `json:"payment_at"`
+	PaymentStyle string        `json:"payment_style"`
+	Goods        []*OrderGoods `json:"goods"`
+	CreatedAt    int64         `json:"created_at"`
+}
+
+// RefundData 是 refund.approved 和 refund.rejected 事件的内容, 退款以整个订单为单位, 金额为整个订单的退款金额
+type RefundData struct {
+	EventID      string  `json:"event_id"`
+	Type         string  `json:"type"`
+	OrderID      int64   `json:"order_id"`
+	ShopID       int64   `json:"shop_id"`
+	RefundID     int64   `json:"refund_id"`
+	RefundAmount float64 `json:"refund_amount"`
+	RefundReason string  `json:"refund_reason"`
+	CreatedAt    int64   `json:"created_at"`
+}
+
+// NewEvent 构造 webhook 事件, build 根据生成的事件 id 和时间构造事件的内容
+func NewEvent(eventType string, shopID int64, build func(eventID string, createdAt int64) any) (*Event, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return nil, fmt.Errorf("webhook.NewEvent: generate event id failed: %w", err)
+	}
+	e := &Event{
+		EventID:   hex.EncodeToString(id),
+		Type:      eventType,
+		ShopID:    shopID,
+		CreatedAt: time.Now().UnixMilli(),
+	}
+	v, err := json.Marshal(build(e.EventID, e.CreatedAt))
+	if err != nil {
+		return nil, fmt.Errorf("webhook.NewEvent: marshal data failed: %w", err)
+	}
+	e.Data = string(v)
+	return e, nil
+}
+
+// Encode 将 webhook 事件编码为 kafka 的消息体
+func Encode(e *Event) ([]byte, error) {
+	v, err := json.Marshal(e)
+	if err != nil {
+		return nil, fmt.Errorf("webhook.Encode: marshal event failed: %w", err)
+	}
+	return v, nil
+}
+
+// Decode 解析从 kafka 中消费到的 webhook 事件
+func Decode(v []byte) (*Event, error) {
+	e := new(Event)
+	if err := json.Unmarshal(v, e); err != nil {
+		return nil, fmt.Errorf("webhook.Decode: unmarshal event failed: %w", err)
+	}
+	return e, nil
+}
+
+// Sign 计算请求体的签名, 返回值即 X-DomTok-Signature 请求头的内容
+// 时间戳参与签名, 商家可以拒绝时间戳过旧的请求以防止重放
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte{'.'})
+	mac.Write(body)
+	return constants.WebhookSignaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify 校验签名, 供商家的接收端和测试使用
+func Verify(secret string, timestamp int64, body []byte, signature string) bool {
+	if !strings.HasPrefix(signature, constants.WebhookSignaturePrefix) {
+		return false
+	}
+	return hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signature))
+}